@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	_ "time/tzdata"
 
 	"github.com/lpernett/godotenv"
 	"github.com/mnadev/limestone/internal/infrastructure/database"
@@ -31,8 +32,12 @@ func main() {
 	loadEnv()
 	db := database.SetupDatabase()
 
+	// Background work stops with the server.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	// Start gRPC
-	grpcServer, grpcListener := server.SetupGRPCServer(db, *grpcEndpoint)
+	grpcServer, grpcListener := server.SetupGRPCServer(ctx, db, *grpcEndpoint)
 	server.StartGRPCServer(grpcServer, grpcListener)
 
 	// Start REST Gateway
@@ -43,13 +48,17 @@ func main() {
 
 	mainMux := http.NewServeMux()
 
-	ctx := context.Background()
 	grpcGatewayMux := server.SetupRESTGateway(ctx, db)
 
 	restHandlerWithAuth := auth.VerifyJWTInterceptorRest(grpcGatewayMux)
 
 	mainMux.Handle("/", restHandlerWithAuth)
 
+	// Calendar feeds are protected by their tokens rather than by JWTs.
+	mainMux.Handle("GET /calendar/", server.SetupCalendarFeeds(db))
+	// Payment webhooks are authenticated by their signatures.
+	mainMux.Handle("POST /webhooks/payments", server.SetupPaymentWebhooks(db))
+
 	mainMux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
@@ -0,0 +1,45 @@
+// Command migrate_adhan_blobs moves adhan audio out of Postgres and into
+// the blob store configured by BLOB_STORE, then moves audio stored before
+// content was hashed to its content key so identical audio is kept once.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/lpernett/godotenv"
+	"github.com/mnadev/limestone/internal/application/services"
+	"github.com/mnadev/limestone/internal/infrastructure/blobstore"
+	"github.com/mnadev/limestone/internal/infrastructure/database"
+	"github.com/mnadev/limestone/internal/infrastructure/storage"
+)
+
+var dropColumn = flag.Bool("drop_column", false, "drop the adhans.file column once every row has been moved")
+
+func main() {
+	flag.Parse()
+	if err := godotenv.Load(); err != nil {
+		log.Fatal("Error loading .env file")
+	}
+	db := database.SetupDatabase()
+	if db == nil {
+		log.Fatal("failed to set up database")
+	}
+	store, err := blobstore.NewFromEnv()
+	if err != nil {
+		log.Fatalf("failed to set up blob store: %s", err)
+	}
+
+	moved, err := storage.MigrateAdhanBlobs(context.Background(), db, store, services.AdhanBlobKind, *dropColumn)
+	if err != nil {
+		log.Fatalf("moved %d adhans before failing: %s", moved, err)
+	}
+	log.Printf("moved %d adhans to the blob store", moved)
+
+	hashed, err := storage.HashAdhanBlobs(context.Background(), db, store, services.AdhanBlobKind)
+	if err != nil {
+		log.Fatalf("hashed %d adhan blobs before failing: %s", hashed, err)
+	}
+	log.Printf("hashed %d adhan blobs", hashed)
+}
@@ -0,0 +1,32 @@
+// Command migrate_event_types copies the types of events from the legacy
+// comma-joined events.event_types column into the event_types table.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/lpernett/godotenv"
+	"github.com/mnadev/limestone/internal/infrastructure/database"
+	"github.com/mnadev/limestone/internal/infrastructure/storage"
+)
+
+var dropColumn = flag.Bool("drop_column", false, "drop the events.event_types column once every event has been migrated")
+
+func main() {
+	flag.Parse()
+	if err := godotenv.Load(); err != nil {
+		log.Fatal("Error loading .env file")
+	}
+	db := database.SetupDatabase()
+	if db == nil {
+		log.Fatal("failed to set up database")
+	}
+
+	migrated, err := storage.MigrateEventTypes(context.Background(), db, *dropColumn)
+	if err != nil {
+		log.Fatalf("migrated %d events before failing: %s", migrated, err)
+	}
+	log.Printf("migrated the types of %d events", migrated)
+}
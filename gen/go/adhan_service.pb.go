@@ -23,6 +23,69 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AdhanFile_ProcessingStatus int32
+
+const (
+	AdhanFile_PROCESSING_STATUS_UNSPECIFIED AdhanFile_ProcessingStatus = 0
+	// Waiting to be processed.
+	AdhanFile_PENDING    AdhanFile_ProcessingStatus = 1
+	AdhanFile_PROCESSING AdhanFile_ProcessingStatus = 2
+	// The renditions are available.
+	AdhanFile_READY AdhanFile_ProcessingStatus = 3
+	// See processing_error. Only the original is available.
+	AdhanFile_FAILED AdhanFile_ProcessingStatus = 4
+	// The format cannot be processed yet, so only the original is
+	// available.
+	AdhanFile_SKIPPED AdhanFile_ProcessingStatus = 5
+)
+
+// Enum value maps for AdhanFile_ProcessingStatus.
+var (
+	AdhanFile_ProcessingStatus_name = map[int32]string{
+		0: "PROCESSING_STATUS_UNSPECIFIED",
+		1: "PENDING",
+		2: "PROCESSING",
+		3: "READY",
+		4: "FAILED",
+		5: "SKIPPED",
+	}
+	AdhanFile_ProcessingStatus_value = map[string]int32{
+		"PROCESSING_STATUS_UNSPECIFIED": 0,
+		"PENDING":                       1,
+		"PROCESSING":                    2,
+		"READY":                         3,
+		"FAILED":                        4,
+		"SKIPPED":                       5,
+	}
+)
+
+func (x AdhanFile_ProcessingStatus) Enum() *AdhanFile_ProcessingStatus {
+	p := new(AdhanFile_ProcessingStatus)
+	*p = x
+	return p
+}
+
+func (x AdhanFile_ProcessingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdhanFile_ProcessingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_adhan_service_proto_enumTypes[0].Descriptor()
+}
+
+func (AdhanFile_ProcessingStatus) Type() protoreflect.EnumType {
+	return &file_adhan_service_proto_enumTypes[0]
+}
+
+func (x AdhanFile_ProcessingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdhanFile_ProcessingStatus.Descriptor instead.
+func (AdhanFile_ProcessingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_adhan_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
 type StandardAdhanResponse struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -32,6 +95,8 @@ type StandardAdhanResponse struct {
 	//
 	//	*StandardAdhanResponse_AdhanFile
 	//	*StandardAdhanResponse_DeleteAdhanFileResponse
+	//	*StandardAdhanResponse_ListAdhansResponse
+	//	*StandardAdhanResponse_AdhanAssignments
 	Data          isStandardAdhanResponse_Data `protobuf_oneof:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -113,6 +178,24 @@ func (x *StandardAdhanResponse) GetDeleteAdhanFileResponse() *DeleteAdhanFileRes
 	return nil
 }
 
+func (x *StandardAdhanResponse) GetListAdhansResponse() *ListAdhansResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardAdhanResponse_ListAdhansResponse); ok {
+			return x.ListAdhansResponse
+		}
+	}
+	return nil
+}
+
+func (x *StandardAdhanResponse) GetAdhanAssignments() *AdhanAssignments {
+	if x != nil {
+		if x, ok := x.Data.(*StandardAdhanResponse_AdhanAssignments); ok {
+			return x.AdhanAssignments
+		}
+	}
+	return nil
+}
+
 type isStandardAdhanResponse_Data interface {
 	isStandardAdhanResponse_Data()
 }
@@ -125,17 +208,53 @@ type StandardAdhanResponse_DeleteAdhanFileResponse struct {
 	DeleteAdhanFileResponse *DeleteAdhanFileResponse `protobuf:"bytes,5,opt,name=delete_adhan_file_response,json=deleteAdhanFileResponse,proto3,oneof"`
 }
 
+type StandardAdhanResponse_ListAdhansResponse struct {
+	ListAdhansResponse *ListAdhansResponse `protobuf:"bytes,6,opt,name=list_adhans_response,json=listAdhansResponse,proto3,oneof"`
+}
+
+type StandardAdhanResponse_AdhanAssignments struct {
+	AdhanAssignments *AdhanAssignments `protobuf:"bytes,7,opt,name=adhan_assignments,json=adhanAssignments,proto3,oneof"`
+}
+
 func (*StandardAdhanResponse_AdhanFile) isStandardAdhanResponse_Data() {}
 
 func (*StandardAdhanResponse_DeleteAdhanFileResponse) isStandardAdhanResponse_Data() {}
 
+func (*StandardAdhanResponse_ListAdhansResponse) isStandardAdhanResponse_Data() {}
+
+func (*StandardAdhanResponse_AdhanAssignments) isStandardAdhanResponse_Data() {}
+
 type AdhanFile struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	MasjidId      string                 `protobuf:"bytes,2,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MasjidId string                 `protobuf:"bytes,2,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	// Audio of a CreateAdhan or UpdateAdhan request, limited to 5 MB. Never
+	// set in responses; use DownloadAdhan to fetch the audio.
 	File          []byte                 `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`
 	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	ContentType   string                 `protobuf:"bytes,7,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	AudioMetadata *AudioMetadata         `protobuf:"bytes,8,opt,name=audio_metadata,json=audioMetadata,proto3" json:"audio_metadata,omitempty"`
+	Title         string                 `protobuf:"bytes,9,opt,name=title,proto3" json:"title,omitempty"`
+	// The reciter.
+	Muezzin string `protobuf:"bytes,10,opt,name=muezzin,proto3" json:"muezzin,omitempty"`
+	// After each upload the audio is normalised to a standard loudness and
+	// transcoded into renditions in the background.
+	ProcessingStatus AdhanFile_ProcessingStatus `protobuf:"varint,11,opt,name=processing_status,json=processingStatus,proto3,enum=limestone.AdhanFile_ProcessingStatus" json:"processing_status,omitempty"`
+	ProcessingError  string                     `protobuf:"bytes,12,opt,name=processing_error,json=processingError,proto3" json:"processing_error,omitempty"`
+	// Integrated loudness of the uploaded audio per ITU-R BS.1770, in LUFS.
+	// Unset until processed, or if the audio is too quiet to measure.
+	LoudnessLufs *float64          `protobuf:"fixed64,13,opt,name=loudness_lufs,json=loudnessLufs,proto3,oneof" json:"loudness_lufs,omitempty"`
+	Renditions   []*AdhanRendition `protobuf:"bytes,14,rep,name=renditions,proto3" json:"renditions,omitempty"`
+	// Lowercase hex SHA-256 of the audio. Identical audio uploaded to several
+	// adhans is stored once. Unset for audio uploaded before hashes were
+	// recorded.
+	Sha256 string `protobuf:"bytes,15,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// Entity tag of the audio, as sent by DownloadAdhan and the
+	// /v1/adhan/{id}/audio endpoint. Clients that have downloaded the audio
+	// can pass it as if_none_match to skip downloading it again.
+	Etag          string `protobuf:"bytes,16,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -205,27 +324,115 @@ func (x *AdhanFile) GetUpdateTime() *timestamppb.Timestamp {
 	return nil
 }
 
-type CreateAdhanFileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AdhanFile     *AdhanFile             `protobuf:"bytes,1,opt,name=adhan_file,json=adhanFile,proto3" json:"adhan_file,omitempty"`
+func (x *AdhanFile) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *AdhanFile) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AdhanFile) GetAudioMetadata() *AudioMetadata {
+	if x != nil {
+		return x.AudioMetadata
+	}
+	return nil
+}
+
+func (x *AdhanFile) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AdhanFile) GetMuezzin() string {
+	if x != nil {
+		return x.Muezzin
+	}
+	return ""
+}
+
+func (x *AdhanFile) GetProcessingStatus() AdhanFile_ProcessingStatus {
+	if x != nil {
+		return x.ProcessingStatus
+	}
+	return AdhanFile_PROCESSING_STATUS_UNSPECIFIED
+}
+
+func (x *AdhanFile) GetProcessingError() string {
+	if x != nil {
+		return x.ProcessingError
+	}
+	return ""
+}
+
+func (x *AdhanFile) GetLoudnessLufs() float64 {
+	if x != nil && x.LoudnessLufs != nil {
+		return *x.LoudnessLufs
+	}
+	return 0
+}
+
+func (x *AdhanFile) GetRenditions() []*AdhanRendition {
+	if x != nil {
+		return x.Renditions
+	}
+	return nil
+}
+
+func (x *AdhanFile) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *AdhanFile) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// A loudness-normalised copy of an adhan's audio, available from
+// DownloadAdhan once processing_status is READY.
+type AdhanRendition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "normalized" keeps the original sample rate and channels as 16-bit PCM
+	// WAV. "speaker" is 16 kHz mono MP3 at 32 kbps, for low-bandwidth and
+	// public address speakers.
+	Name          string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SizeBytes     int64          `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	ContentType   string         `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	AudioMetadata *AudioMetadata `protobuf:"bytes,4,opt,name=audio_metadata,json=audioMetadata,proto3" json:"audio_metadata,omitempty"`
+	LoudnessLufs  *float64       `protobuf:"fixed64,5,opt,name=loudness_lufs,json=loudnessLufs,proto3,oneof" json:"loudness_lufs,omitempty"`
+	Sha256        string         `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Etag          string         `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAdhanFileRequest) Reset() {
-	*x = CreateAdhanFileRequest{}
+func (x *AdhanRendition) Reset() {
+	*x = AdhanRendition{}
 	mi := &file_adhan_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAdhanFileRequest) String() string {
+func (x *AdhanRendition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAdhanFileRequest) ProtoMessage() {}
+func (*AdhanRendition) ProtoMessage() {}
 
-func (x *CreateAdhanFileRequest) ProtoReflect() protoreflect.Message {
+func (x *AdhanRendition) ProtoReflect() protoreflect.Message {
 	mi := &file_adhan_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -237,40 +444,88 @@ func (x *CreateAdhanFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAdhanFileRequest.ProtoReflect.Descriptor instead.
-func (*CreateAdhanFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdhanRendition.ProtoReflect.Descriptor instead.
+func (*AdhanRendition) Descriptor() ([]byte, []int) {
 	return file_adhan_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateAdhanFileRequest) GetAdhanFile() *AdhanFile {
+func (x *AdhanRendition) GetName() string {
 	if x != nil {
-		return x.AdhanFile
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdhanRendition) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *AdhanRendition) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AdhanRendition) GetAudioMetadata() *AudioMetadata {
+	if x != nil {
+		return x.AudioMetadata
 	}
 	return nil
 }
 
-type UpdateAdhanFileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AdhanFile     *AdhanFile             `protobuf:"bytes,2,opt,name=adhan_file,json=adhanFile,proto3" json:"adhan_file,omitempty"`
+func (x *AdhanRendition) GetLoudnessLufs() float64 {
+	if x != nil && x.LoudnessLufs != nil {
+		return *x.LoudnessLufs
+	}
+	return 0
+}
+
+func (x *AdhanRendition) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *AdhanRendition) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// Technical details of an adhan's audio, read from the file on upload.
+type AudioMetadata struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	DurationMs   int64                  `protobuf:"varint,1,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	SampleRateHz int32                  `protobuf:"varint,2,opt,name=sample_rate_hz,json=sampleRateHz,proto3" json:"sample_rate_hz,omitempty"`
+	Channels     int32                  `protobuf:"varint,3,opt,name=channels,proto3" json:"channels,omitempty"`
+	// Average over the whole file.
+	BitrateBps int32 `protobuf:"varint,4,opt,name=bitrate_bps,json=bitrateBps,proto3" json:"bitrate_bps,omitempty"`
+	// For example mp3, aac, opus, vorbis, flac or pcm_s16le.
+	Codec         string `protobuf:"bytes,5,opt,name=codec,proto3" json:"codec,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAdhanFileRequest) Reset() {
-	*x = UpdateAdhanFileRequest{}
+func (x *AudioMetadata) Reset() {
+	*x = AudioMetadata{}
 	mi := &file_adhan_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAdhanFileRequest) String() string {
+func (x *AudioMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAdhanFileRequest) ProtoMessage() {}
+func (*AudioMetadata) ProtoMessage() {}
 
-func (x *UpdateAdhanFileRequest) ProtoReflect() protoreflect.Message {
+func (x *AudioMetadata) ProtoReflect() protoreflect.Message {
 	mi := &file_adhan_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -282,46 +537,71 @@ func (x *UpdateAdhanFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAdhanFileRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAdhanFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AudioMetadata.ProtoReflect.Descriptor instead.
+func (*AudioMetadata) Descriptor() ([]byte, []int) {
 	return file_adhan_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *UpdateAdhanFileRequest) GetId() string {
+func (x *AudioMetadata) GetDurationMs() int64 {
 	if x != nil {
-		return x.Id
+		return x.DurationMs
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateAdhanFileRequest) GetAdhanFile() *AdhanFile {
+func (x *AudioMetadata) GetSampleRateHz() int32 {
 	if x != nil {
-		return x.AdhanFile
+		return x.SampleRateHz
 	}
-	return nil
+	return 0
 }
 
-type GetAdhanFileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *AudioMetadata) GetChannels() int32 {
+	if x != nil {
+		return x.Channels
+	}
+	return 0
+}
+
+func (x *AudioMetadata) GetBitrateBps() int32 {
+	if x != nil {
+		return x.BitrateBps
+	}
+	return 0
+}
+
+func (x *AudioMetadata) GetCodec() string {
+	if x != nil {
+		return x.Codec
+	}
+	return ""
+}
+
+type UploadAdhanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadAdhanRequest_Metadata_
+	//	*UploadAdhanRequest_Chunk
+	Payload       isUploadAdhanRequest_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAdhanFileRequest) Reset() {
-	*x = GetAdhanFileRequest{}
+func (x *UploadAdhanRequest) Reset() {
+	*x = UploadAdhanRequest{}
 	mi := &file_adhan_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAdhanFileRequest) String() string {
+func (x *UploadAdhanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAdhanFileRequest) ProtoMessage() {}
+func (*UploadAdhanRequest) ProtoMessage() {}
 
-func (x *GetAdhanFileRequest) ProtoReflect() protoreflect.Message {
+func (x *UploadAdhanRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_adhan_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -333,39 +613,83 @@ func (x *GetAdhanFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAdhanFileRequest.ProtoReflect.Descriptor instead.
-func (*GetAdhanFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadAdhanRequest.ProtoReflect.Descriptor instead.
+func (*UploadAdhanRequest) Descriptor() ([]byte, []int) {
 	return file_adhan_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetAdhanFileRequest) GetId() string {
+func (x *UploadAdhanRequest) GetPayload() isUploadAdhanRequest_Payload {
 	if x != nil {
-		return x.Id
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-type DeleteAdhanFileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *UploadAdhanRequest) GetMetadata() *UploadAdhanRequest_Metadata {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAdhanRequest_Metadata_); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadAdhanRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAdhanRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAdhanRequest_Payload interface {
+	isUploadAdhanRequest_Payload()
+}
+
+type UploadAdhanRequest_Metadata_ struct {
+	Metadata *UploadAdhanRequest_Metadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadAdhanRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAdhanRequest_Metadata_) isUploadAdhanRequest_Payload() {}
+
+func (*UploadAdhanRequest_Chunk) isUploadAdhanRequest_Payload() {}
+
+type DownloadAdhanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Byte offset to start at, for resuming an interrupted download.
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Number of bytes to send. 0 sends the rest of the file.
+	Length int64 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	// Name of a rendition to send instead of the uploaded audio.
+	Rendition string `protobuf:"bytes,4,opt,name=rendition,proto3" json:"rendition,omitempty"`
+	// Entity tags of copies the client already has, comma separated as in
+	// the HTTP If-None-Match header. If the audio matches one of them, a
+	// single chunk with not_modified set and no data is sent.
+	IfNoneMatch   string `protobuf:"bytes,5,opt,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAdhanFileRequest) Reset() {
-	*x = DeleteAdhanFileRequest{}
+func (x *DownloadAdhanRequest) Reset() {
+	*x = DownloadAdhanRequest{}
 	mi := &file_adhan_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAdhanFileRequest) String() string {
+func (x *DownloadAdhanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAdhanFileRequest) ProtoMessage() {}
+func (*DownloadAdhanRequest) ProtoMessage() {}
 
-func (x *DeleteAdhanFileRequest) ProtoReflect() protoreflect.Message {
+func (x *DownloadAdhanRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_adhan_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -377,38 +701,76 @@ func (x *DeleteAdhanFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAdhanFileRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAdhanFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DownloadAdhanRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAdhanRequest) Descriptor() ([]byte, []int) {
 	return file_adhan_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DeleteAdhanFileRequest) GetId() string {
+func (x *DownloadAdhanRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteAdhanFileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *DownloadAdhanRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DownloadAdhanRequest) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *DownloadAdhanRequest) GetRendition() string {
+	if x != nil {
+		return x.Rendition
+	}
+	return ""
+}
+
+func (x *DownloadAdhanRequest) GetIfNoneMatch() string {
+	if x != nil {
+		return x.IfNoneMatch
+	}
+	return ""
+}
+
+type AdhanChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Data  []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Position of data within the file.
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Set on the first chunk of a stream.
+	TotalSize   int64  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Set on the first chunk of a stream, when the audio has a hash.
+	Etag string `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"`
+	// The audio matches if_none_match, so nothing more is sent.
+	NotModified   bool `protobuf:"varint,6,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAdhanFileResponse) Reset() {
-	*x = DeleteAdhanFileResponse{}
+func (x *AdhanChunk) Reset() {
+	*x = AdhanChunk{}
 	mi := &file_adhan_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAdhanFileResponse) String() string {
+func (x *AdhanChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAdhanFileResponse) ProtoMessage() {}
+func (*AdhanChunk) ProtoMessage() {}
 
-func (x *DeleteAdhanFileResponse) ProtoReflect() protoreflect.Message {
+func (x *AdhanChunk) ProtoReflect() protoreflect.Message {
 	mi := &file_adhan_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -420,35 +782,745 @@ func (x *DeleteAdhanFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAdhanFileResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAdhanFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdhanChunk.ProtoReflect.Descriptor instead.
+func (*AdhanChunk) Descriptor() ([]byte, []int) {
 	return file_adhan_service_proto_rawDescGZIP(), []int{6}
 }
 
-var File_adhan_service_proto protoreflect.FileDescriptor
+func (x *AdhanChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
 
-const file_adhan_service_proto_rawDesc = "" +
-	"\n" +
-	"\x13adhan_service.proto\x12\tlimestone\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xff\x01\n" +
-	"\x15StandardAdhanResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\x125\n" +
-	"\n" +
-	"adhan_file\x18\x04 \x01(\v2\x14.limestone.AdhanFileH\x00R\tadhanFile\x12a\n" +
-	"\x1adelete_adhan_file_response\x18\x05 \x01(\v2\".limestone.DeleteAdhanFileResponseH\x00R\x17deleteAdhanFileResponseB\x06\n" +
-	"\x04data\"\xc6\x01\n" +
-	"\tAdhanFile\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
-	"\tmasjid_id\x18\x02 \x01(\tR\bmasjidId\x12\x12\n" +
-	"\x04file\x18\x03 \x01(\fR\x04file\x12;\n" +
-	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"createTime\x12;\n" +
-	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"updateTime\"R\n" +
-	"\x16CreateAdhanFileRequest\x128\n" +
-	"\n" +
-	"adhan_file\x18\x01 \x01(\v2\x14.limestone.AdhanFileB\x03\xe0A\x02R\tadhanFile\"b\n" +
+func (x *AdhanChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *AdhanChunk) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *AdhanChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AdhanChunk) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *AdhanChunk) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+type CreateAdhanFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdhanFile     *AdhanFile             `protobuf:"bytes,1,opt,name=adhan_file,json=adhanFile,proto3" json:"adhan_file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAdhanFileRequest) Reset() {
+	*x = CreateAdhanFileRequest{}
+	mi := &file_adhan_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAdhanFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAdhanFileRequest) ProtoMessage() {}
+
+func (x *CreateAdhanFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adhan_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAdhanFileRequest.ProtoReflect.Descriptor instead.
+func (*CreateAdhanFileRequest) Descriptor() ([]byte, []int) {
+	return file_adhan_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateAdhanFileRequest) GetAdhanFile() *AdhanFile {
+	if x != nil {
+		return x.AdhanFile
+	}
+	return nil
+}
+
+type UpdateAdhanFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdhanFile     *AdhanFile             `protobuf:"bytes,2,opt,name=adhan_file,json=adhanFile,proto3" json:"adhan_file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAdhanFileRequest) Reset() {
+	*x = UpdateAdhanFileRequest{}
+	mi := &file_adhan_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAdhanFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAdhanFileRequest) ProtoMessage() {}
+
+func (x *UpdateAdhanFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adhan_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAdhanFileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAdhanFileRequest) Descriptor() ([]byte, []int) {
+	return file_adhan_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateAdhanFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAdhanFileRequest) GetAdhanFile() *AdhanFile {
+	if x != nil {
+		return x.AdhanFile
+	}
+	return nil
+}
+
+type GetAdhanFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdhanFileRequest) Reset() {
+	*x = GetAdhanFileRequest{}
+	mi := &file_adhan_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdhanFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdhanFileRequest) ProtoMessage() {}
+
+func (x *GetAdhanFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adhan_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdhanFileRequest.ProtoReflect.Descriptor instead.
+func (*GetAdhanFileRequest) Descriptor() ([]byte, []int) {
+	return file_adhan_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetAdhanFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteAdhanFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAdhanFileRequest) Reset() {
+	*x = DeleteAdhanFileRequest{}
+	mi := &file_adhan_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAdhanFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAdhanFileRequest) ProtoMessage() {}
+
+func (x *DeleteAdhanFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adhan_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAdhanFileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAdhanFileRequest) Descriptor() ([]byte, []int) {
+	return file_adhan_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteAdhanFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteAdhanFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAdhanFileResponse) Reset() {
+	*x = DeleteAdhanFileResponse{}
+	mi := &file_adhan_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAdhanFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAdhanFileResponse) ProtoMessage() {}
+
+func (x *DeleteAdhanFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adhan_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAdhanFileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAdhanFileResponse) Descriptor() ([]byte, []int) {
+	return file_adhan_service_proto_rawDescGZIP(), []int{11}
+}
+
+type ListAdhansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdhansRequest) Reset() {
+	*x = ListAdhansRequest{}
+	mi := &file_adhan_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdhansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdhansRequest) ProtoMessage() {}
+
+func (x *ListAdhansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adhan_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdhansRequest.ProtoReflect.Descriptor instead.
+func (*ListAdhansRequest) Descriptor() ([]byte, []int) {
+	return file_adhan_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListAdhansRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+type ListAdhansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Adhans        []*AdhanFile           `protobuf:"bytes,1,rep,name=adhans,proto3" json:"adhans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdhansResponse) Reset() {
+	*x = ListAdhansResponse{}
+	mi := &file_adhan_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdhansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdhansResponse) ProtoMessage() {}
+
+func (x *ListAdhansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adhan_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdhansResponse.ProtoReflect.Descriptor instead.
+func (*ListAdhansResponse) Descriptor() ([]byte, []int) {
+	return file_adhan_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListAdhansResponse) GetAdhans() []*AdhanFile {
+	if x != nil {
+		return x.Adhans
+	}
+	return nil
+}
+
+type GetAdhanAssignmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdhanAssignmentsRequest) Reset() {
+	*x = GetAdhanAssignmentsRequest{}
+	mi := &file_adhan_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdhanAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdhanAssignmentsRequest) ProtoMessage() {}
+
+func (x *GetAdhanAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adhan_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdhanAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetAdhanAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_adhan_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetAdhanAssignmentsRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+type AssignAdhanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	Prayer        Prayer                 `protobuf:"varint,2,opt,name=prayer,proto3,enum=limestone.Prayer" json:"prayer,omitempty"`
+	AdhanId       string                 `protobuf:"bytes,3,opt,name=adhan_id,json=adhanId,proto3" json:"adhan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignAdhanRequest) Reset() {
+	*x = AssignAdhanRequest{}
+	mi := &file_adhan_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignAdhanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignAdhanRequest) ProtoMessage() {}
+
+func (x *AssignAdhanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adhan_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignAdhanRequest.ProtoReflect.Descriptor instead.
+func (*AssignAdhanRequest) Descriptor() ([]byte, []int) {
+	return file_adhan_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AssignAdhanRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *AssignAdhanRequest) GetPrayer() Prayer {
+	if x != nil {
+		return x.Prayer
+	}
+	return Prayer_PRAYER_UNSPECIFIED
+}
+
+func (x *AssignAdhanRequest) GetAdhanId() string {
+	if x != nil {
+		return x.AdhanId
+	}
+	return ""
+}
+
+type AdhanAssignment struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Prayer Prayer                 `protobuf:"varint,1,opt,name=prayer,proto3,enum=limestone.Prayer" json:"prayer,omitempty"`
+	// Unset when no adhan is assigned.
+	Adhan *AdhanFile `protobuf:"bytes,2,opt,name=adhan,proto3" json:"adhan,omitempty"`
+	// Whether the adhan is the masjid's default rather than one assigned to
+	// this prayer.
+	Inherited     bool `protobuf:"varint,3,opt,name=inherited,proto3" json:"inherited,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdhanAssignment) Reset() {
+	*x = AdhanAssignment{}
+	mi := &file_adhan_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdhanAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdhanAssignment) ProtoMessage() {}
+
+func (x *AdhanAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_adhan_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdhanAssignment.ProtoReflect.Descriptor instead.
+func (*AdhanAssignment) Descriptor() ([]byte, []int) {
+	return file_adhan_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AdhanAssignment) GetPrayer() Prayer {
+	if x != nil {
+		return x.Prayer
+	}
+	return Prayer_PRAYER_UNSPECIFIED
+}
+
+func (x *AdhanAssignment) GetAdhan() *AdhanFile {
+	if x != nil {
+		return x.Adhan
+	}
+	return nil
+}
+
+func (x *AdhanAssignment) GetInherited() bool {
+	if x != nil {
+		return x.Inherited
+	}
+	return false
+}
+
+type AdhanAssignments struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	// One entry per prayer, Fajr first.
+	Assignments   []*AdhanAssignment `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdhanAssignments) Reset() {
+	*x = AdhanAssignments{}
+	mi := &file_adhan_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdhanAssignments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdhanAssignments) ProtoMessage() {}
+
+func (x *AdhanAssignments) ProtoReflect() protoreflect.Message {
+	mi := &file_adhan_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdhanAssignments.ProtoReflect.Descriptor instead.
+func (*AdhanAssignments) Descriptor() ([]byte, []int) {
+	return file_adhan_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AdhanAssignments) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *AdhanAssignments) GetAssignments() []*AdhanAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type UploadAdhanRequest_Metadata struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	// Replaces the audio of an existing adhan when set; creates a new adhan
+	// otherwise.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Left unchanged when replacing audio if empty.
+	Title         string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Muezzin       string `protobuf:"bytes,4,opt,name=muezzin,proto3" json:"muezzin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAdhanRequest_Metadata) Reset() {
+	*x = UploadAdhanRequest_Metadata{}
+	mi := &file_adhan_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAdhanRequest_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAdhanRequest_Metadata) ProtoMessage() {}
+
+func (x *UploadAdhanRequest_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_adhan_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAdhanRequest_Metadata.ProtoReflect.Descriptor instead.
+func (*UploadAdhanRequest_Metadata) Descriptor() ([]byte, []int) {
+	return file_adhan_service_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *UploadAdhanRequest_Metadata) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *UploadAdhanRequest_Metadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UploadAdhanRequest_Metadata) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UploadAdhanRequest_Metadata) GetMuezzin() string {
+	if x != nil {
+		return x.Muezzin
+	}
+	return ""
+}
+
+var File_adhan_service_proto protoreflect.FileDescriptor
+
+const file_adhan_service_proto_rawDesc = "" +
+	"\n" +
+	"\x13adhan_service.proto\x12\tlimestone\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14masjid_service.proto\"\x9e\x03\n" +
+	"\x15StandardAdhanResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x125\n" +
+	"\n" +
+	"adhan_file\x18\x04 \x01(\v2\x14.limestone.AdhanFileH\x00R\tadhanFile\x12a\n" +
+	"\x1adelete_adhan_file_response\x18\x05 \x01(\v2\".limestone.DeleteAdhanFileResponseH\x00R\x17deleteAdhanFileResponse\x12Q\n" +
+	"\x14list_adhans_response\x18\x06 \x01(\v2\x1d.limestone.ListAdhansResponseH\x00R\x12listAdhansResponse\x12J\n" +
+	"\x11adhan_assignments\x18\a \x01(\v2\x1b.limestone.AdhanAssignmentsH\x00R\x10adhanAssignmentsB\x06\n" +
+	"\x04data\"\xc0\x06\n" +
+	"\tAdhanFile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tmasjid_id\x18\x02 \x01(\tR\bmasjidId\x12\x12\n" +
+	"\x04file\x18\x03 \x01(\fR\x04file\x12;\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12\"\n" +
+	"\n" +
+	"size_bytes\x18\x06 \x01(\x03B\x03\xe0A\x03R\tsizeBytes\x12&\n" +
+	"\fcontent_type\x18\a \x01(\tB\x03\xe0A\x03R\vcontentType\x12D\n" +
+	"\x0eaudio_metadata\x18\b \x01(\v2\x18.limestone.AudioMetadataB\x03\xe0A\x03R\raudioMetadata\x12\x14\n" +
+	"\x05title\x18\t \x01(\tR\x05title\x12\x18\n" +
+	"\amuezzin\x18\n" +
+	" \x01(\tR\amuezzin\x12W\n" +
+	"\x11processing_status\x18\v \x01(\x0e2%.limestone.AdhanFile.ProcessingStatusB\x03\xe0A\x03R\x10processingStatus\x12.\n" +
+	"\x10processing_error\x18\f \x01(\tB\x03\xe0A\x03R\x0fprocessingError\x12-\n" +
+	"\rloudness_lufs\x18\r \x01(\x01B\x03\xe0A\x03H\x00R\floudnessLufs\x88\x01\x01\x12>\n" +
+	"\n" +
+	"renditions\x18\x0e \x03(\v2\x19.limestone.AdhanRenditionB\x03\xe0A\x03R\n" +
+	"renditions\x12\x1b\n" +
+	"\x06sha256\x18\x0f \x01(\tB\x03\xe0A\x03R\x06sha256\x12\x17\n" +
+	"\x04etag\x18\x10 \x01(\tB\x03\xe0A\x03R\x04etag\"v\n" +
+	"\x10ProcessingStatus\x12!\n" +
+	"\x1dPROCESSING_STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\x0e\n" +
+	"\n" +
+	"PROCESSING\x10\x02\x12\t\n" +
+	"\x05READY\x10\x03\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x04\x12\v\n" +
+	"\aSKIPPED\x10\x05B\x10\n" +
+	"\x0e_loudness_lufs\"\x8f\x02\n" +
+	"\x0eAdhanRendition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x02 \x01(\x03R\tsizeBytes\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12?\n" +
+	"\x0eaudio_metadata\x18\x04 \x01(\v2\x18.limestone.AudioMetadataR\raudioMetadata\x12(\n" +
+	"\rloudness_lufs\x18\x05 \x01(\x01H\x00R\floudnessLufs\x88\x01\x01\x12\x16\n" +
+	"\x06sha256\x18\x06 \x01(\tR\x06sha256\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etagB\x10\n" +
+	"\x0e_loudness_lufs\"\xa9\x01\n" +
+	"\rAudioMetadata\x12\x1f\n" +
+	"\vduration_ms\x18\x01 \x01(\x03R\n" +
+	"durationMs\x12$\n" +
+	"\x0esample_rate_hz\x18\x02 \x01(\x05R\fsampleRateHz\x12\x1a\n" +
+	"\bchannels\x18\x03 \x01(\x05R\bchannels\x12\x1f\n" +
+	"\vbitrate_bps\x18\x04 \x01(\x05R\n" +
+	"bitrateBps\x12\x14\n" +
+	"\x05codec\x18\x05 \x01(\tR\x05codec\"\xeb\x01\n" +
+	"\x12UploadAdhanRequest\x12D\n" +
+	"\bmetadata\x18\x01 \x01(\v2&.limestone.UploadAdhanRequest.MetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunk\x1al\n" +
+	"\bMetadata\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\amuezzin\x18\x04 \x01(\tR\amuezzinB\t\n" +
+	"\apayload\"\x9d\x01\n" +
+	"\x14DownloadAdhanRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\x03R\x06length\x12\x1c\n" +
+	"\trendition\x18\x04 \x01(\tR\trendition\x12\"\n" +
+	"\rif_none_match\x18\x05 \x01(\tR\vifNoneMatch\"\xb1\x01\n" +
+	"\n" +
+	"AdhanChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04etag\x18\x05 \x01(\tR\x04etag\x12!\n" +
+	"\fnot_modified\x18\x06 \x01(\bR\vnotModified\"R\n" +
+	"\x16CreateAdhanFileRequest\x128\n" +
+	"\n" +
+	"adhan_file\x18\x01 \x01(\v2\x14.limestone.AdhanFileB\x03\xe0A\x02R\tadhanFile\"b\n" +
 	"\x16UpdateAdhanFileRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x128\n" +
 	"\n" +
@@ -457,7 +1529,24 @@ const file_adhan_service_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"-\n" +
 	"\x16DeleteAdhanFileRequest\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\x19\n" +
-	"\x17DeleteAdhanFileResponse2\xf4\x03\n" +
+	"\x17DeleteAdhanFileResponse\"5\n" +
+	"\x11ListAdhansRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\"B\n" +
+	"\x12ListAdhansResponse\x12,\n" +
+	"\x06adhans\x18\x01 \x03(\v2\x14.limestone.AdhanFileR\x06adhans\">\n" +
+	"\x1aGetAdhanAssignmentsRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\"|\n" +
+	"\x12AssignAdhanRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12)\n" +
+	"\x06prayer\x18\x02 \x01(\x0e2\x11.limestone.PrayerR\x06prayer\x12\x19\n" +
+	"\badhan_id\x18\x03 \x01(\tR\aadhanId\"\x86\x01\n" +
+	"\x0fAdhanAssignment\x12)\n" +
+	"\x06prayer\x18\x01 \x01(\x0e2\x11.limestone.PrayerR\x06prayer\x12*\n" +
+	"\x05adhan\x18\x02 \x01(\v2\x14.limestone.AdhanFileR\x05adhan\x12\x1c\n" +
+	"\tinherited\x18\x03 \x01(\bR\tinherited\"m\n" +
+	"\x10AdhanAssignments\x12\x1b\n" +
+	"\tmasjid_id\x18\x01 \x01(\tR\bmasjidId\x12<\n" +
+	"\vassignments\x18\x02 \x03(\v2\x1a.limestone.AdhanAssignmentR\vassignments2\xe3\b\n" +
 	"\fAdhanService\x12~\n" +
 	"\vCreateAdhan\x12!.limestone.CreateAdhanFileRequest\x1a .limestone.StandardAdhanResponse\"*\xdaA\n" +
 	"adhan_file\x82\xd3\xe4\x93\x02\x17:\n" +
@@ -466,7 +1555,13 @@ const file_adhan_service_proto_rawDesc = "" +
 	"adhan_file\x82\xd3\xe4\x93\x02\x1c:\n" +
 	"adhan_file2\x0e/v1/adhan/{id}\x12m\n" +
 	"\fGetAdhanById\x12\x1e.limestone.GetAdhanFileRequest\x1a .limestone.StandardAdhanResponse\"\x1b\xdaA\x02id\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/adhan/{id}\x12o\n" +
-	"\vDeleteAdhan\x12!.limestone.DeleteAdhanFileRequest\x1a .limestone.StandardAdhanResponse\"\x1b\xdaA\x02id\x82\xd3\xe4\x93\x02\x10*\x0e/v1/adhan/{id}Bi\n" +
+	"\vDeleteAdhan\x12!.limestone.DeleteAdhanFileRequest\x1a .limestone.StandardAdhanResponse\"\x1b\xdaA\x02id\x82\xd3\xe4\x93\x02\x10*\x0e/v1/adhan/{id}\x12P\n" +
+	"\vUploadAdhan\x12\x1d.limestone.UploadAdhanRequest\x1a .limestone.StandardAdhanResponse(\x01\x12P\n" +
+	"\rDownloadAdhan\x12\x1f.limestone.DownloadAdhanRequest\x1a\x15.limestone.AdhanChunk\"\x05\xdaA\x02id0\x01\x12\x7f\n" +
+	"\n" +
+	"ListAdhans\x12\x1c.limestone.ListAdhansRequest\x1a .limestone.StandardAdhanResponse\"1\xdaA\tmasjid_id\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/masjid/{masjid_id}/adhans\x12\x9c\x01\n" +
+	"\x13GetAdhanAssignments\x12%.limestone.GetAdhanAssignmentsRequest\x1a .limestone.StandardAdhanResponse\"<\xdaA\tmasjid_id\x82\xd3\xe4\x93\x02*\x12(/v1/masjid/{masjid_id}/adhan_assignments\x12\xa8\x01\n" +
+	"\vAssignAdhan\x12\x1d.limestone.AssignAdhanRequest\x1a .limestone.StandardAdhanResponse\"X\xdaA\x19masjid_id,prayer,adhan_id\x82\xd3\xe4\x93\x026:\x01*\x1a1/v1/masjid/{masjid_id}/adhan_assignments/{prayer}Bi\n" +
 	"\rcom.limestoneB\x11AdhanServiceProtoP\x01Z\x01.\xa2\x02\x03LXX\xaa\x02\tLimestone\xca\x02\tLimestone\xe2\x02\x15Limestone\\GPBMetadata\xea\x02\tLimestoneb\x06proto3"
 
 var (
@@ -481,37 +1576,74 @@ func file_adhan_service_proto_rawDescGZIP() []byte {
 	return file_adhan_service_proto_rawDescData
 }
 
-var file_adhan_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_adhan_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_adhan_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_adhan_service_proto_goTypes = []any{
-	(*StandardAdhanResponse)(nil),   // 0: limestone.StandardAdhanResponse
-	(*AdhanFile)(nil),               // 1: limestone.AdhanFile
-	(*CreateAdhanFileRequest)(nil),  // 2: limestone.CreateAdhanFileRequest
-	(*UpdateAdhanFileRequest)(nil),  // 3: limestone.UpdateAdhanFileRequest
-	(*GetAdhanFileRequest)(nil),     // 4: limestone.GetAdhanFileRequest
-	(*DeleteAdhanFileRequest)(nil),  // 5: limestone.DeleteAdhanFileRequest
-	(*DeleteAdhanFileResponse)(nil), // 6: limestone.DeleteAdhanFileResponse
-	(*timestamppb.Timestamp)(nil),   // 7: google.protobuf.Timestamp
+	(AdhanFile_ProcessingStatus)(0),     // 0: limestone.AdhanFile.ProcessingStatus
+	(*StandardAdhanResponse)(nil),       // 1: limestone.StandardAdhanResponse
+	(*AdhanFile)(nil),                   // 2: limestone.AdhanFile
+	(*AdhanRendition)(nil),              // 3: limestone.AdhanRendition
+	(*AudioMetadata)(nil),               // 4: limestone.AudioMetadata
+	(*UploadAdhanRequest)(nil),          // 5: limestone.UploadAdhanRequest
+	(*DownloadAdhanRequest)(nil),        // 6: limestone.DownloadAdhanRequest
+	(*AdhanChunk)(nil),                  // 7: limestone.AdhanChunk
+	(*CreateAdhanFileRequest)(nil),      // 8: limestone.CreateAdhanFileRequest
+	(*UpdateAdhanFileRequest)(nil),      // 9: limestone.UpdateAdhanFileRequest
+	(*GetAdhanFileRequest)(nil),         // 10: limestone.GetAdhanFileRequest
+	(*DeleteAdhanFileRequest)(nil),      // 11: limestone.DeleteAdhanFileRequest
+	(*DeleteAdhanFileResponse)(nil),     // 12: limestone.DeleteAdhanFileResponse
+	(*ListAdhansRequest)(nil),           // 13: limestone.ListAdhansRequest
+	(*ListAdhansResponse)(nil),          // 14: limestone.ListAdhansResponse
+	(*GetAdhanAssignmentsRequest)(nil),  // 15: limestone.GetAdhanAssignmentsRequest
+	(*AssignAdhanRequest)(nil),          // 16: limestone.AssignAdhanRequest
+	(*AdhanAssignment)(nil),             // 17: limestone.AdhanAssignment
+	(*AdhanAssignments)(nil),            // 18: limestone.AdhanAssignments
+	(*UploadAdhanRequest_Metadata)(nil), // 19: limestone.UploadAdhanRequest.Metadata
+	(*timestamppb.Timestamp)(nil),       // 20: google.protobuf.Timestamp
+	(Prayer)(0),                         // 21: limestone.Prayer
 }
 var file_adhan_service_proto_depIdxs = []int32{
-	1,  // 0: limestone.StandardAdhanResponse.adhan_file:type_name -> limestone.AdhanFile
-	6,  // 1: limestone.StandardAdhanResponse.delete_adhan_file_response:type_name -> limestone.DeleteAdhanFileResponse
-	7,  // 2: limestone.AdhanFile.create_time:type_name -> google.protobuf.Timestamp
-	7,  // 3: limestone.AdhanFile.update_time:type_name -> google.protobuf.Timestamp
-	1,  // 4: limestone.CreateAdhanFileRequest.adhan_file:type_name -> limestone.AdhanFile
-	1,  // 5: limestone.UpdateAdhanFileRequest.adhan_file:type_name -> limestone.AdhanFile
-	2,  // 6: limestone.AdhanService.CreateAdhan:input_type -> limestone.CreateAdhanFileRequest
-	3,  // 7: limestone.AdhanService.UpdateAdhan:input_type -> limestone.UpdateAdhanFileRequest
-	4,  // 8: limestone.AdhanService.GetAdhanById:input_type -> limestone.GetAdhanFileRequest
-	5,  // 9: limestone.AdhanService.DeleteAdhan:input_type -> limestone.DeleteAdhanFileRequest
-	0,  // 10: limestone.AdhanService.CreateAdhan:output_type -> limestone.StandardAdhanResponse
-	0,  // 11: limestone.AdhanService.UpdateAdhan:output_type -> limestone.StandardAdhanResponse
-	0,  // 12: limestone.AdhanService.GetAdhanById:output_type -> limestone.StandardAdhanResponse
-	0,  // 13: limestone.AdhanService.DeleteAdhan:output_type -> limestone.StandardAdhanResponse
-	10, // [10:14] is the sub-list for method output_type
-	6,  // [6:10] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	2,  // 0: limestone.StandardAdhanResponse.adhan_file:type_name -> limestone.AdhanFile
+	12, // 1: limestone.StandardAdhanResponse.delete_adhan_file_response:type_name -> limestone.DeleteAdhanFileResponse
+	14, // 2: limestone.StandardAdhanResponse.list_adhans_response:type_name -> limestone.ListAdhansResponse
+	18, // 3: limestone.StandardAdhanResponse.adhan_assignments:type_name -> limestone.AdhanAssignments
+	20, // 4: limestone.AdhanFile.create_time:type_name -> google.protobuf.Timestamp
+	20, // 5: limestone.AdhanFile.update_time:type_name -> google.protobuf.Timestamp
+	4,  // 6: limestone.AdhanFile.audio_metadata:type_name -> limestone.AudioMetadata
+	0,  // 7: limestone.AdhanFile.processing_status:type_name -> limestone.AdhanFile.ProcessingStatus
+	3,  // 8: limestone.AdhanFile.renditions:type_name -> limestone.AdhanRendition
+	4,  // 9: limestone.AdhanRendition.audio_metadata:type_name -> limestone.AudioMetadata
+	19, // 10: limestone.UploadAdhanRequest.metadata:type_name -> limestone.UploadAdhanRequest.Metadata
+	2,  // 11: limestone.CreateAdhanFileRequest.adhan_file:type_name -> limestone.AdhanFile
+	2,  // 12: limestone.UpdateAdhanFileRequest.adhan_file:type_name -> limestone.AdhanFile
+	2,  // 13: limestone.ListAdhansResponse.adhans:type_name -> limestone.AdhanFile
+	21, // 14: limestone.AssignAdhanRequest.prayer:type_name -> limestone.Prayer
+	21, // 15: limestone.AdhanAssignment.prayer:type_name -> limestone.Prayer
+	2,  // 16: limestone.AdhanAssignment.adhan:type_name -> limestone.AdhanFile
+	17, // 17: limestone.AdhanAssignments.assignments:type_name -> limestone.AdhanAssignment
+	8,  // 18: limestone.AdhanService.CreateAdhan:input_type -> limestone.CreateAdhanFileRequest
+	9,  // 19: limestone.AdhanService.UpdateAdhan:input_type -> limestone.UpdateAdhanFileRequest
+	10, // 20: limestone.AdhanService.GetAdhanById:input_type -> limestone.GetAdhanFileRequest
+	11, // 21: limestone.AdhanService.DeleteAdhan:input_type -> limestone.DeleteAdhanFileRequest
+	5,  // 22: limestone.AdhanService.UploadAdhan:input_type -> limestone.UploadAdhanRequest
+	6,  // 23: limestone.AdhanService.DownloadAdhan:input_type -> limestone.DownloadAdhanRequest
+	13, // 24: limestone.AdhanService.ListAdhans:input_type -> limestone.ListAdhansRequest
+	15, // 25: limestone.AdhanService.GetAdhanAssignments:input_type -> limestone.GetAdhanAssignmentsRequest
+	16, // 26: limestone.AdhanService.AssignAdhan:input_type -> limestone.AssignAdhanRequest
+	1,  // 27: limestone.AdhanService.CreateAdhan:output_type -> limestone.StandardAdhanResponse
+	1,  // 28: limestone.AdhanService.UpdateAdhan:output_type -> limestone.StandardAdhanResponse
+	1,  // 29: limestone.AdhanService.GetAdhanById:output_type -> limestone.StandardAdhanResponse
+	1,  // 30: limestone.AdhanService.DeleteAdhan:output_type -> limestone.StandardAdhanResponse
+	1,  // 31: limestone.AdhanService.UploadAdhan:output_type -> limestone.StandardAdhanResponse
+	7,  // 32: limestone.AdhanService.DownloadAdhan:output_type -> limestone.AdhanChunk
+	1,  // 33: limestone.AdhanService.ListAdhans:output_type -> limestone.StandardAdhanResponse
+	1,  // 34: limestone.AdhanService.GetAdhanAssignments:output_type -> limestone.StandardAdhanResponse
+	1,  // 35: limestone.AdhanService.AssignAdhan:output_type -> limestone.StandardAdhanResponse
+	27, // [27:36] is the sub-list for method output_type
+	18, // [18:27] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_adhan_service_proto_init() }
@@ -519,22 +1651,32 @@ func file_adhan_service_proto_init() {
 	if File_adhan_service_proto != nil {
 		return
 	}
+	file_masjid_service_proto_init()
 	file_adhan_service_proto_msgTypes[0].OneofWrappers = []any{
 		(*StandardAdhanResponse_AdhanFile)(nil),
 		(*StandardAdhanResponse_DeleteAdhanFileResponse)(nil),
+		(*StandardAdhanResponse_ListAdhansResponse)(nil),
+		(*StandardAdhanResponse_AdhanAssignments)(nil),
+	}
+	file_adhan_service_proto_msgTypes[1].OneofWrappers = []any{}
+	file_adhan_service_proto_msgTypes[2].OneofWrappers = []any{}
+	file_adhan_service_proto_msgTypes[4].OneofWrappers = []any{
+		(*UploadAdhanRequest_Metadata_)(nil),
+		(*UploadAdhanRequest_Chunk)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_adhan_service_proto_rawDesc), len(file_adhan_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      1,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_adhan_service_proto_goTypes,
 		DependencyIndexes: file_adhan_service_proto_depIdxs,
+		EnumInfos:         file_adhan_service_proto_enumTypes,
 		MessageInfos:      file_adhan_service_proto_msgTypes,
 	}.Build()
 	File_adhan_service_proto = out.File
@@ -19,10 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdhanService_CreateAdhan_FullMethodName  = "/limestone.AdhanService/CreateAdhan"
-	AdhanService_UpdateAdhan_FullMethodName  = "/limestone.AdhanService/UpdateAdhan"
-	AdhanService_GetAdhanById_FullMethodName = "/limestone.AdhanService/GetAdhanById"
-	AdhanService_DeleteAdhan_FullMethodName  = "/limestone.AdhanService/DeleteAdhan"
+	AdhanService_CreateAdhan_FullMethodName         = "/limestone.AdhanService/CreateAdhan"
+	AdhanService_UpdateAdhan_FullMethodName         = "/limestone.AdhanService/UpdateAdhan"
+	AdhanService_GetAdhanById_FullMethodName        = "/limestone.AdhanService/GetAdhanById"
+	AdhanService_DeleteAdhan_FullMethodName         = "/limestone.AdhanService/DeleteAdhan"
+	AdhanService_UploadAdhan_FullMethodName         = "/limestone.AdhanService/UploadAdhan"
+	AdhanService_DownloadAdhan_FullMethodName       = "/limestone.AdhanService/DownloadAdhan"
+	AdhanService_ListAdhans_FullMethodName          = "/limestone.AdhanService/ListAdhans"
+	AdhanService_GetAdhanAssignments_FullMethodName = "/limestone.AdhanService/GetAdhanAssignments"
+	AdhanService_AssignAdhan_FullMethodName         = "/limestone.AdhanService/AssignAdhan"
 )
 
 // AdhanServiceClient is the client API for AdhanService service.
@@ -33,6 +38,22 @@ type AdhanServiceClient interface {
 	UpdateAdhan(ctx context.Context, in *UpdateAdhanFileRequest, opts ...grpc.CallOption) (*StandardAdhanResponse, error)
 	GetAdhanById(ctx context.Context, in *GetAdhanFileRequest, opts ...grpc.CallOption) (*StandardAdhanResponse, error)
 	DeleteAdhan(ctx context.Context, in *DeleteAdhanFileRequest, opts ...grpc.CallOption) (*StandardAdhanResponse, error)
+	// Uploads an adhan in chunks. The first message carries the metadata and
+	// every following one a chunk of audio. Over REST, use a multipart POST to
+	// /v1/adhan/upload instead.
+	UploadAdhan(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAdhanRequest, StandardAdhanResponse], error)
+	// Streams an adhan's audio in chunks. Over REST, GET
+	// /v1/adhan/{id}/audio, which honours Range requests.
+	DownloadAdhan(ctx context.Context, in *DownloadAdhanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AdhanChunk], error)
+	// Lists a masjid's adhan library by title.
+	ListAdhans(ctx context.Context, in *ListAdhansRequest, opts ...grpc.CallOption) (*StandardAdhanResponse, error)
+	// Returns the adhan a masjid plays for each of the five prayers.
+	GetAdhanAssignments(ctx context.Context, in *GetAdhanAssignmentsRequest, opts ...grpc.CallOption) (*StandardAdhanResponse, error)
+	// Assigns an adhan from the masjid's library to a prayer, or to
+	// PRAYER_UNSPECIFIED to set the default for Dhuhr through Isha. Fajr has
+	// no default, as its adhan differs. An empty adhan_id clears the
+	// assignment.
+	AssignAdhan(ctx context.Context, in *AssignAdhanRequest, opts ...grpc.CallOption) (*StandardAdhanResponse, error)
 }
 
 type adhanServiceClient struct {
@@ -83,6 +104,68 @@ func (c *adhanServiceClient) DeleteAdhan(ctx context.Context, in *DeleteAdhanFil
 	return out, nil
 }
 
+func (c *adhanServiceClient) UploadAdhan(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAdhanRequest, StandardAdhanResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdhanService_ServiceDesc.Streams[0], AdhanService_UploadAdhan_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAdhanRequest, StandardAdhanResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdhanService_UploadAdhanClient = grpc.ClientStreamingClient[UploadAdhanRequest, StandardAdhanResponse]
+
+func (c *adhanServiceClient) DownloadAdhan(ctx context.Context, in *DownloadAdhanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AdhanChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdhanService_ServiceDesc.Streams[1], AdhanService_DownloadAdhan_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAdhanRequest, AdhanChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdhanService_DownloadAdhanClient = grpc.ServerStreamingClient[AdhanChunk]
+
+func (c *adhanServiceClient) ListAdhans(ctx context.Context, in *ListAdhansRequest, opts ...grpc.CallOption) (*StandardAdhanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardAdhanResponse)
+	err := c.cc.Invoke(ctx, AdhanService_ListAdhans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adhanServiceClient) GetAdhanAssignments(ctx context.Context, in *GetAdhanAssignmentsRequest, opts ...grpc.CallOption) (*StandardAdhanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardAdhanResponse)
+	err := c.cc.Invoke(ctx, AdhanService_GetAdhanAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adhanServiceClient) AssignAdhan(ctx context.Context, in *AssignAdhanRequest, opts ...grpc.CallOption) (*StandardAdhanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardAdhanResponse)
+	err := c.cc.Invoke(ctx, AdhanService_AssignAdhan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdhanServiceServer is the server API for AdhanService service.
 // All implementations must embed UnimplementedAdhanServiceServer
 // for forward compatibility.
@@ -91,6 +174,22 @@ type AdhanServiceServer interface {
 	UpdateAdhan(context.Context, *UpdateAdhanFileRequest) (*StandardAdhanResponse, error)
 	GetAdhanById(context.Context, *GetAdhanFileRequest) (*StandardAdhanResponse, error)
 	DeleteAdhan(context.Context, *DeleteAdhanFileRequest) (*StandardAdhanResponse, error)
+	// Uploads an adhan in chunks. The first message carries the metadata and
+	// every following one a chunk of audio. Over REST, use a multipart POST to
+	// /v1/adhan/upload instead.
+	UploadAdhan(grpc.ClientStreamingServer[UploadAdhanRequest, StandardAdhanResponse]) error
+	// Streams an adhan's audio in chunks. Over REST, GET
+	// /v1/adhan/{id}/audio, which honours Range requests.
+	DownloadAdhan(*DownloadAdhanRequest, grpc.ServerStreamingServer[AdhanChunk]) error
+	// Lists a masjid's adhan library by title.
+	ListAdhans(context.Context, *ListAdhansRequest) (*StandardAdhanResponse, error)
+	// Returns the adhan a masjid plays for each of the five prayers.
+	GetAdhanAssignments(context.Context, *GetAdhanAssignmentsRequest) (*StandardAdhanResponse, error)
+	// Assigns an adhan from the masjid's library to a prayer, or to
+	// PRAYER_UNSPECIFIED to set the default for Dhuhr through Isha. Fajr has
+	// no default, as its adhan differs. An empty adhan_id clears the
+	// assignment.
+	AssignAdhan(context.Context, *AssignAdhanRequest) (*StandardAdhanResponse, error)
 	mustEmbedUnimplementedAdhanServiceServer()
 }
 
@@ -113,6 +212,21 @@ func (UnimplementedAdhanServiceServer) GetAdhanById(context.Context, *GetAdhanFi
 func (UnimplementedAdhanServiceServer) DeleteAdhan(context.Context, *DeleteAdhanFileRequest) (*StandardAdhanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAdhan not implemented")
 }
+func (UnimplementedAdhanServiceServer) UploadAdhan(grpc.ClientStreamingServer[UploadAdhanRequest, StandardAdhanResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAdhan not implemented")
+}
+func (UnimplementedAdhanServiceServer) DownloadAdhan(*DownloadAdhanRequest, grpc.ServerStreamingServer[AdhanChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAdhan not implemented")
+}
+func (UnimplementedAdhanServiceServer) ListAdhans(context.Context, *ListAdhansRequest) (*StandardAdhanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAdhans not implemented")
+}
+func (UnimplementedAdhanServiceServer) GetAdhanAssignments(context.Context, *GetAdhanAssignmentsRequest) (*StandardAdhanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdhanAssignments not implemented")
+}
+func (UnimplementedAdhanServiceServer) AssignAdhan(context.Context, *AssignAdhanRequest) (*StandardAdhanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignAdhan not implemented")
+}
 func (UnimplementedAdhanServiceServer) mustEmbedUnimplementedAdhanServiceServer() {}
 func (UnimplementedAdhanServiceServer) testEmbeddedByValue()                      {}
 
@@ -206,6 +320,78 @@ func _AdhanService_DeleteAdhan_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdhanService_UploadAdhan_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AdhanServiceServer).UploadAdhan(&grpc.GenericServerStream[UploadAdhanRequest, StandardAdhanResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdhanService_UploadAdhanServer = grpc.ClientStreamingServer[UploadAdhanRequest, StandardAdhanResponse]
+
+func _AdhanService_DownloadAdhan_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAdhanRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdhanServiceServer).DownloadAdhan(m, &grpc.GenericServerStream[DownloadAdhanRequest, AdhanChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdhanService_DownloadAdhanServer = grpc.ServerStreamingServer[AdhanChunk]
+
+func _AdhanService_ListAdhans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAdhansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdhanServiceServer).ListAdhans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdhanService_ListAdhans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdhanServiceServer).ListAdhans(ctx, req.(*ListAdhansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdhanService_GetAdhanAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdhanAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdhanServiceServer).GetAdhanAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdhanService_GetAdhanAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdhanServiceServer).GetAdhanAssignments(ctx, req.(*GetAdhanAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdhanService_AssignAdhan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignAdhanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdhanServiceServer).AssignAdhan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdhanService_AssignAdhan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdhanServiceServer).AssignAdhan(ctx, req.(*AssignAdhanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdhanService_ServiceDesc is the grpc.ServiceDesc for AdhanService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,7 +415,30 @@ var AdhanService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAdhan",
 			Handler:    _AdhanService_DeleteAdhan_Handler,
 		},
+		{
+			MethodName: "ListAdhans",
+			Handler:    _AdhanService_ListAdhans_Handler,
+		},
+		{
+			MethodName: "GetAdhanAssignments",
+			Handler:    _AdhanService_GetAdhanAssignments_Handler,
+		},
+		{
+			MethodName: "AssignAdhan",
+			Handler:    _AdhanService_AssignAdhan_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAdhan",
+			Handler:       _AdhanService_UploadAdhan_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAdhan",
+			Handler:       _AdhanService_DownloadAdhan_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "adhan_service.proto",
 }
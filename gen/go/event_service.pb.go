@@ -23,6 +23,54 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Which occurrences of a recurring event an update or delete of one of
+// them applies to.
+type RecurrenceScope int32
+
+const (
+	RecurrenceScope_THIS_EVENT         RecurrenceScope = 0
+	RecurrenceScope_THIS_AND_FOLLOWING RecurrenceScope = 1
+)
+
+// Enum value maps for RecurrenceScope.
+var (
+	RecurrenceScope_name = map[int32]string{
+		0: "THIS_EVENT",
+		1: "THIS_AND_FOLLOWING",
+	}
+	RecurrenceScope_value = map[string]int32{
+		"THIS_EVENT":         0,
+		"THIS_AND_FOLLOWING": 1,
+	}
+)
+
+func (x RecurrenceScope) Enum() *RecurrenceScope {
+	p := new(RecurrenceScope)
+	*p = x
+	return p
+}
+
+func (x RecurrenceScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecurrenceScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_service_proto_enumTypes[0].Descriptor()
+}
+
+func (RecurrenceScope) Type() protoreflect.EnumType {
+	return &file_event_service_proto_enumTypes[0]
+}
+
+func (x RecurrenceScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecurrenceScope.Descriptor instead.
+func (RecurrenceScope) EnumDescriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{0}
+}
+
 type Event_GenderRestriction int32
 
 const (
@@ -56,11 +104,11 @@ func (x Event_GenderRestriction) String() string {
 }
 
 func (Event_GenderRestriction) Descriptor() protoreflect.EnumDescriptor {
-	return file_event_service_proto_enumTypes[0].Descriptor()
+	return file_event_service_proto_enumTypes[1].Descriptor()
 }
 
 func (Event_GenderRestriction) Type() protoreflect.EnumType {
-	return &file_event_service_proto_enumTypes[0]
+	return &file_event_service_proto_enumTypes[1]
 }
 
 func (x Event_GenderRestriction) Number() protoreflect.EnumNumber {
@@ -123,11 +171,11 @@ func (x Event_EventType) String() string {
 }
 
 func (Event_EventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_event_service_proto_enumTypes[1].Descriptor()
+	return file_event_service_proto_enumTypes[2].Descriptor()
 }
 
 func (Event_EventType) Type() protoreflect.EnumType {
-	return &file_event_service_proto_enumTypes[1]
+	return &file_event_service_proto_enumTypes[2]
 }
 
 func (x Event_EventType) Number() protoreflect.EnumNumber {
@@ -139,6 +187,122 @@ func (Event_EventType) EnumDescriptor() ([]byte, []int) {
 	return file_event_service_proto_rawDescGZIP(), []int{1, 1}
 }
 
+type Rsvp_Status int32
+
+const (
+	Rsvp_STATUS_UNSPECIFIED Rsvp_Status = 0
+	// Holds one of the event's places.
+	Rsvp_CONFIRMED Rsvp_Status = 1
+	// Confirmed automatically, in turn, as confirmed RSVPs are cancelled.
+	Rsvp_WAITLISTED Rsvp_Status = 2
+	Rsvp_CANCELLED  Rsvp_Status = 3
+)
+
+// Enum value maps for Rsvp_Status.
+var (
+	Rsvp_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "CONFIRMED",
+		2: "WAITLISTED",
+		3: "CANCELLED",
+	}
+	Rsvp_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"CONFIRMED":          1,
+		"WAITLISTED":         2,
+		"CANCELLED":          3,
+	}
+)
+
+func (x Rsvp_Status) Enum() *Rsvp_Status {
+	p := new(Rsvp_Status)
+	*p = x
+	return p
+}
+
+func (x Rsvp_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rsvp_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_service_proto_enumTypes[3].Descriptor()
+}
+
+func (Rsvp_Status) Type() protoreflect.EnumType {
+	return &file_event_service_proto_enumTypes[3]
+}
+
+func (x Rsvp_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rsvp_Status.Descriptor instead.
+func (Rsvp_Status) EnumDescriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type Order_Status int32
+
+const (
+	Order_STATUS_UNSPECIFIED Order_Status = 0
+	// Awaiting payment, holding its tickets until expire_time.
+	Order_PENDING Order_Status = 1
+	// Paid, confirming the buyer's RSVP.
+	Order_PAID Order_Status = 2
+	// Ended unpaid.
+	Order_CANCELLED Order_Status = 3
+	// Refunded with the payment provider, which has yet to confirm it.
+	Order_REFUND_PENDING Order_Status = 4
+	Order_REFUNDED       Order_Status = 5
+)
+
+// Enum value maps for Order_Status.
+var (
+	Order_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "PENDING",
+		2: "PAID",
+		3: "CANCELLED",
+		4: "REFUND_PENDING",
+		5: "REFUNDED",
+	}
+	Order_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"PENDING":            1,
+		"PAID":               2,
+		"CANCELLED":          3,
+		"REFUND_PENDING":     4,
+		"REFUNDED":           5,
+	}
+)
+
+func (x Order_Status) Enum() *Order_Status {
+	p := new(Order_Status)
+	*p = x
+	return p
+}
+
+func (x Order_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Order_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_service_proto_enumTypes[4].Descriptor()
+}
+
+func (Order_Status) Type() protoreflect.EnumType {
+	return &file_event_service_proto_enumTypes[4]
+}
+
+func (x Order_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Order_Status.Descriptor instead.
+func (Order_Status) EnumDescriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{26, 0}
+}
+
 type StandardEventResponse struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -149,6 +313,13 @@ type StandardEventResponse struct {
 	//	*StandardEventResponse_Event
 	//	*StandardEventResponse_DeleteEventResponse
 	//	*StandardEventResponse_ListEventResponse
+	//	*StandardEventResponse_Rsvp
+	//	*StandardEventResponse_ListRsvpsResponse
+	//	*StandardEventResponse_CalendarFeed
+	//	*StandardEventResponse_CheckIn
+	//	*StandardEventResponse_TicketKey
+	//	*StandardEventResponse_Order
+	//	*StandardEventResponse_ListOrdersResponse
 	Data          isStandardEventResponse_Data `protobuf_oneof:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -239,6 +410,69 @@ func (x *StandardEventResponse) GetListEventResponse() *ListEventsResponse {
 	return nil
 }
 
+func (x *StandardEventResponse) GetRsvp() *Rsvp {
+	if x != nil {
+		if x, ok := x.Data.(*StandardEventResponse_Rsvp); ok {
+			return x.Rsvp
+		}
+	}
+	return nil
+}
+
+func (x *StandardEventResponse) GetListRsvpsResponse() *ListRsvpsResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardEventResponse_ListRsvpsResponse); ok {
+			return x.ListRsvpsResponse
+		}
+	}
+	return nil
+}
+
+func (x *StandardEventResponse) GetCalendarFeed() *CalendarFeed {
+	if x != nil {
+		if x, ok := x.Data.(*StandardEventResponse_CalendarFeed); ok {
+			return x.CalendarFeed
+		}
+	}
+	return nil
+}
+
+func (x *StandardEventResponse) GetCheckIn() *CheckIn {
+	if x != nil {
+		if x, ok := x.Data.(*StandardEventResponse_CheckIn); ok {
+			return x.CheckIn
+		}
+	}
+	return nil
+}
+
+func (x *StandardEventResponse) GetTicketKey() *TicketKey {
+	if x != nil {
+		if x, ok := x.Data.(*StandardEventResponse_TicketKey); ok {
+			return x.TicketKey
+		}
+	}
+	return nil
+}
+
+func (x *StandardEventResponse) GetOrder() *Order {
+	if x != nil {
+		if x, ok := x.Data.(*StandardEventResponse_Order); ok {
+			return x.Order
+		}
+	}
+	return nil
+}
+
+func (x *StandardEventResponse) GetListOrdersResponse() *ListOrdersResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardEventResponse_ListOrdersResponse); ok {
+			return x.ListOrdersResponse
+		}
+	}
+	return nil
+}
+
 type isStandardEventResponse_Data interface {
 	isStandardEventResponse_Data()
 }
@@ -255,12 +489,54 @@ type StandardEventResponse_ListEventResponse struct {
 	ListEventResponse *ListEventsResponse `protobuf:"bytes,6,opt,name=list_event_response,json=listEventResponse,proto3,oneof"`
 }
 
+type StandardEventResponse_Rsvp struct {
+	Rsvp *Rsvp `protobuf:"bytes,7,opt,name=rsvp,proto3,oneof"`
+}
+
+type StandardEventResponse_ListRsvpsResponse struct {
+	ListRsvpsResponse *ListRsvpsResponse `protobuf:"bytes,8,opt,name=list_rsvps_response,json=listRsvpsResponse,proto3,oneof"`
+}
+
+type StandardEventResponse_CalendarFeed struct {
+	CalendarFeed *CalendarFeed `protobuf:"bytes,9,opt,name=calendar_feed,json=calendarFeed,proto3,oneof"`
+}
+
+type StandardEventResponse_CheckIn struct {
+	CheckIn *CheckIn `protobuf:"bytes,10,opt,name=check_in,json=checkIn,proto3,oneof"`
+}
+
+type StandardEventResponse_TicketKey struct {
+	TicketKey *TicketKey `protobuf:"bytes,11,opt,name=ticket_key,json=ticketKey,proto3,oneof"`
+}
+
+type StandardEventResponse_Order struct {
+	Order *Order `protobuf:"bytes,12,opt,name=order,proto3,oneof"`
+}
+
+type StandardEventResponse_ListOrdersResponse struct {
+	ListOrdersResponse *ListOrdersResponse `protobuf:"bytes,13,opt,name=list_orders_response,json=listOrdersResponse,proto3,oneof"`
+}
+
 func (*StandardEventResponse_Event) isStandardEventResponse_Data() {}
 
 func (*StandardEventResponse_DeleteEventResponse) isStandardEventResponse_Data() {}
 
 func (*StandardEventResponse_ListEventResponse) isStandardEventResponse_Data() {}
 
+func (*StandardEventResponse_Rsvp) isStandardEventResponse_Data() {}
+
+func (*StandardEventResponse_ListRsvpsResponse) isStandardEventResponse_Data() {}
+
+func (*StandardEventResponse_CalendarFeed) isStandardEventResponse_Data() {}
+
+func (*StandardEventResponse_CheckIn) isStandardEventResponse_Data() {}
+
+func (*StandardEventResponse_TicketKey) isStandardEventResponse_Data() {}
+
+func (*StandardEventResponse_Order) isStandardEventResponse_Data() {}
+
+func (*StandardEventResponse_ListOrdersResponse) isStandardEventResponse_Data() {}
+
 type Event struct {
 	state             protoimpl.MessageState  `protogen:"open.v1"`
 	Id                string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -277,8 +553,37 @@ type Event struct {
 	LivestreamLink    string                  `protobuf:"bytes,13,opt,name=livestream_link,json=livestreamLink,proto3" json:"livestream_link,omitempty"`
 	CreateTime        *timestamppb.Timestamp  `protobuf:"bytes,14,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	UpdateTime        *timestamppb.Timestamp  `protobuf:"bytes,15,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Hijri dates of the start and end days in the masjid's time zone. Output
+	// only.
+	HijriStartDate *HijriDate `protobuf:"bytes,16,opt,name=hijri_start_date,json=hijriStartDate,proto3" json:"hijri_start_date,omitempty"`
+	HijriEndDate   *HijriDate `protobuf:"bytes,17,opt,name=hijri_end_date,json=hijriEndDate,proto3" json:"hijri_end_date,omitempty"`
+	// RRULE, RDATE and EXDATE lines as in RFC 5545, making the event repeat
+	// from start_time, e.g. "RRULE:FREQ=WEEKLY;BYDAY=FR". Dates without a
+	// zone are in the masjid's time zone. Empty for one-off events and
+	// occurrences.
+	Recurrence []string `protobuf:"bytes,18,rep,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// For an occurrence of a recurring event, the ID of the series and the
+	// start it has in the series. Occurrences that have not been edited have
+	// IDs of the form "<recurring_event_id>_<yyyymmddThhmmssZ>". Output only.
+	RecurringEventId  string                 `protobuf:"bytes,19,opt,name=recurring_event_id,json=recurringEventId,proto3" json:"recurring_event_id,omitempty"`
+	OriginalStartTime *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=original_start_time,json=originalStartTime,proto3" json:"original_start_time,omitempty"`
+	// Set by GetEvent and CheckInAttendee on events that require RSVP.
+	Attendance *Attendance `protobuf:"bytes,21,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	// The tickets the event sells, set with SetTicketTypes. Occurrences have
+	// those of their series.
+	TicketTypes []*TicketType `protobuf:"bytes,22,rep,name=ticket_types,json=ticketTypes,proto3" json:"ticket_types,omitempty"`
+	// The rooms of the event's masjid it is held in, booked for its times.
+	// Setting them on an update replaces the event's rooms; bookings are
+	// cancelled with CancelRoomBooking. Rooms already booked at the time are
+	// rejected. A recurring event books them for each of its occurrences
+	// that has not ended and starts within 400 days of now, or of the start
+	// of the series if it is later, up to 1000 occurrences. Occurrences
+	// after that are booked only once the series is changed again. An
+	// occurrence lists the rooms booked for it, and keeps them when edited
+	// on its own unless given others.
+	RoomIds       []string `protobuf:"bytes,23,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Event) Reset() {
@@ -409,6 +714,117 @@ func (x *Event) GetUpdateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Event) GetHijriStartDate() *HijriDate {
+	if x != nil {
+		return x.HijriStartDate
+	}
+	return nil
+}
+
+func (x *Event) GetHijriEndDate() *HijriDate {
+	if x != nil {
+		return x.HijriEndDate
+	}
+	return nil
+}
+
+func (x *Event) GetRecurrence() []string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *Event) GetRecurringEventId() string {
+	if x != nil {
+		return x.RecurringEventId
+	}
+	return ""
+}
+
+func (x *Event) GetOriginalStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OriginalStartTime
+	}
+	return nil
+}
+
+func (x *Event) GetAttendance() *Attendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+func (x *Event) GetTicketTypes() []*TicketType {
+	if x != nil {
+		return x.TicketTypes
+	}
+	return nil
+}
+
+func (x *Event) GetRoomIds() []string {
+	if x != nil {
+		return x.RoomIds
+	}
+	return nil
+}
+
+type Attendance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RSVPs holding a place. For an occurrence, those for the series.
+	ConfirmedCount int32 `protobuf:"varint,1,opt,name=confirmed_count,json=confirmedCount,proto3" json:"confirmed_count,omitempty"`
+	// Attendees checked in to the event, or to the occurrence. Zero for a
+	// recurring event as a whole.
+	CheckedInCount int32 `protobuf:"varint,2,opt,name=checked_in_count,json=checkedInCount,proto3" json:"checked_in_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Attendance) Reset() {
+	*x = Attendance{}
+	mi := &file_event_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attendance) ProtoMessage() {}
+
+func (x *Attendance) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attendance.ProtoReflect.Descriptor instead.
+func (*Attendance) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Attendance) GetConfirmedCount() int32 {
+	if x != nil {
+		return x.ConfirmedCount
+	}
+	return 0
+}
+
+func (x *Attendance) GetCheckedInCount() int32 {
+	if x != nil {
+		return x.CheckedInCount
+	}
+	return 0
+}
+
 type CreateEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
@@ -418,7 +834,7 @@ type CreateEventRequest struct {
 
 func (x *CreateEventRequest) Reset() {
 	*x = CreateEventRequest{}
-	mi := &file_event_service_proto_msgTypes[2]
+	mi := &file_event_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -430,7 +846,7 @@ func (x *CreateEventRequest) String() string {
 func (*CreateEventRequest) ProtoMessage() {}
 
 func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_service_proto_msgTypes[2]
+	mi := &file_event_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,7 +859,7 @@ func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
 func (*CreateEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_service_proto_rawDescGZIP(), []int{2}
+	return file_event_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateEventRequest) GetEvent() *Event {
@@ -454,16 +870,18 @@ func (x *CreateEventRequest) GetEvent() *Event {
 }
 
 type UpdateEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Event         *Event                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event *Event                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// Used when the event ID names an occurrence of a recurring event.
+	Scope         RecurrenceScope `protobuf:"varint,3,opt,name=scope,proto3,enum=limestone.RecurrenceScope" json:"scope,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateEventRequest) Reset() {
 	*x = UpdateEventRequest{}
-	mi := &file_event_service_proto_msgTypes[3]
+	mi := &file_event_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -475,7 +893,7 @@ func (x *UpdateEventRequest) String() string {
 func (*UpdateEventRequest) ProtoMessage() {}
 
 func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_service_proto_msgTypes[3]
+	mi := &file_event_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -488,7 +906,7 @@ func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_service_proto_rawDescGZIP(), []int{3}
+	return file_event_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdateEventRequest) GetId() string {
@@ -505,16 +923,25 @@ func (x *UpdateEventRequest) GetEvent() *Event {
 	return nil
 }
 
+func (x *UpdateEventRequest) GetScope() RecurrenceScope {
+	if x != nil {
+		return x.Scope
+	}
+	return RecurrenceScope_THIS_EVENT
+}
+
 type DeleteEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Used when the ID names an occurrence of a recurring event.
+	Scope         RecurrenceScope `protobuf:"varint,2,opt,name=scope,proto3,enum=limestone.RecurrenceScope" json:"scope,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteEventRequest) Reset() {
 	*x = DeleteEventRequest{}
-	mi := &file_event_service_proto_msgTypes[4]
+	mi := &file_event_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -526,7 +953,7 @@ func (x *DeleteEventRequest) String() string {
 func (*DeleteEventRequest) ProtoMessage() {}
 
 func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_service_proto_msgTypes[4]
+	mi := &file_event_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,7 +966,7 @@ func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
 func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_service_proto_rawDescGZIP(), []int{4}
+	return file_event_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DeleteEventRequest) GetId() string {
@@ -549,6 +976,13 @@ func (x *DeleteEventRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteEventRequest) GetScope() RecurrenceScope {
+	if x != nil {
+		return x.Scope
+	}
+	return RecurrenceScope_THIS_EVENT
+}
+
 type DeleteEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -557,7 +991,7 @@ type DeleteEventResponse struct {
 
 func (x *DeleteEventResponse) Reset() {
 	*x = DeleteEventResponse{}
-	mi := &file_event_service_proto_msgTypes[5]
+	mi := &file_event_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -569,7 +1003,1331 @@ func (x *DeleteEventResponse) String() string {
 func (*DeleteEventResponse) ProtoMessage() {}
 
 func (x *DeleteEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_service_proto_msgTypes[5]
+	mi := &file_event_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEventResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{6}
+}
+
+type GetEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventRequest) Reset() {
+	*x = GetEventRequest{}
+	mi := &file_event_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRequest) ProtoMessage() {}
+
+func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListEventsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	MasjidId  string                 `protobuf:"bytes,4,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	// Restricts the result to events starting in the given Hijri year, or in
+	// one month of it when hijri_month is also set. The masjid's Hijri offset
+	// applies when masjid_id is set.
+	HijriYear  int32 `protobuf:"varint,5,opt,name=hijri_year,json=hijriYear,proto3" json:"hijri_year,omitempty"`
+	HijriMonth int32 `protobuf:"varint,6,opt,name=hijri_month,json=hijriMonth,proto3" json:"hijri_month,omitempty"`
+	// Restricts the result to events starting in [start_from, start_before).
+	// When both are set, or the Hijri filter is, recurring events are
+	// expanded into their occurrences in that window, at most 400 days long,
+	// the result is ordered by start time and page tokens are offsets.
+	StartFrom   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_from,json=startFrom,proto3" json:"start_from,omitempty"`
+	StartBefore *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start_before,json=startBefore,proto3" json:"start_before,omitempty"`
+	// Conditions joined by AND, as in AIP-160, e.g.
+	// `masjid_id = "m1" AND types:(YOUTH OR EDUCATIONAL) AND is_paid = false`.
+	// Supports masjid_id (=), start_time (=, <, <=, >, >= an RFC 3339 time
+	// in quotes, setting start_from and start_before), types (: or = a type,
+	// or types joined by OR in parentheses), gender_restriction (=), and
+	// is_paid, requires_rsvp and has_livestream (= or != true or false).
+	// Each field but types is given once, start_time once on each side.
+	Filter string `protobuf:"bytes,9,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Fields separated by commas, each optionally followed by desc, from
+	// start_time, end_time, name and create_time. Page tokens are offsets
+	// when it is set.
+	OrderBy       string `protobuf:"bytes,10,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	mi := &file_event_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetHijriYear() int32 {
+	if x != nil {
+		return x.HijriYear
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetHijriMonth() int32 {
+	if x != nil {
+		return x.HijriMonth
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetStartFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartFrom
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetStartBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartBefore
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListEventsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Events []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The number of events, or occurrences, matching the request on all
+	// pages.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_event_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListEventsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ListWeekEventsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	// Any time in the week. Defaults to now.
+	WeekOf *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=week_of,json=weekOf,proto3" json:"week_of,omitempty"`
+	// As in ListEventsRequest. Conditions on masjid_id and start_time are not
+	// allowed.
+	Filter        string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWeekEventsRequest) Reset() {
+	*x = ListWeekEventsRequest{}
+	mi := &file_event_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWeekEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWeekEventsRequest) ProtoMessage() {}
+
+func (x *ListWeekEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWeekEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListWeekEventsRequest) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListWeekEventsRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *ListWeekEventsRequest) GetWeekOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WeekOf
+	}
+	return nil
+}
+
+func (x *ListWeekEventsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type Rsvp struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId  string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status  Rsvp_Status            `protobuf:"varint,4,opt,name=status,proto3,enum=limestone.Rsvp_Status" json:"status,omitempty"`
+	// Position on the waitlist, counting from 1. Set when status is
+	// WAITLISTED.
+	WaitlistPosition int32                  `protobuf:"varint,5,opt,name=waitlist_position,json=waitlistPosition,proto3" json:"waitlist_position,omitempty"`
+	CreateTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// The attendee's name. Set by ListEventAttendees.
+	FirstName string `protobuf:"bytes,8,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string `protobuf:"bytes,9,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	// Set by GetMyRsvps.
+	Event *Event `protobuf:"bytes,10,opt,name=event,proto3" json:"event,omitempty"`
+	// The signed ticket to show as a QR code at the door. Set for the
+	// attendee on confirmed RSVPs. It covers every occurrence of a recurring
+	// event.
+	Ticket        string `protobuf:"bytes,11,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rsvp) Reset() {
+	*x = Rsvp{}
+	mi := &file_event_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rsvp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rsvp) ProtoMessage() {}
+
+func (x *Rsvp) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rsvp.ProtoReflect.Descriptor instead.
+func (*Rsvp) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Rsvp) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Rsvp) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Rsvp) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Rsvp) GetStatus() Rsvp_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Rsvp_STATUS_UNSPECIFIED
+}
+
+func (x *Rsvp) GetWaitlistPosition() int32 {
+	if x != nil {
+		return x.WaitlistPosition
+	}
+	return 0
+}
+
+func (x *Rsvp) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Rsvp) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Rsvp) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Rsvp) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Rsvp) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *Rsvp) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+type RsvpEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RsvpEventRequest) Reset() {
+	*x = RsvpEventRequest{}
+	mi := &file_event_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RsvpEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsvpEventRequest) ProtoMessage() {}
+
+func (x *RsvpEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsvpEventRequest.ProtoReflect.Descriptor instead.
+func (*RsvpEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RsvpEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type CancelRsvpRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Cancels another user's RSVP. Only masjid admins and imams may set it;
+	// the caller's own RSVP is cancelled otherwise.
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRsvpRequest) Reset() {
+	*x = CancelRsvpRequest{}
+	mi := &file_event_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRsvpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRsvpRequest) ProtoMessage() {}
+
+func (x *CancelRsvpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRsvpRequest.ProtoReflect.Descriptor instead.
+func (*CancelRsvpRequest) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelRsvpRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CancelRsvpRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListEventAttendeesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Lists only RSVPs with this status. Confirmed and waitlisted RSVPs are
+	// listed when unspecified.
+	Status Rsvp_Status `protobuf:"varint,2,opt,name=status,proto3,enum=limestone.Rsvp_Status" json:"status,omitempty"`
+	// Defaults to 100.
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventAttendeesRequest) Reset() {
+	*x = ListEventAttendeesRequest{}
+	mi := &file_event_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventAttendeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventAttendeesRequest) ProtoMessage() {}
+
+func (x *ListEventAttendeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventAttendeesRequest.ProtoReflect.Descriptor instead.
+func (*ListEventAttendeesRequest) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListEventAttendeesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ListEventAttendeesRequest) GetStatus() Rsvp_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Rsvp_STATUS_UNSPECIFIED
+}
+
+func (x *ListEventAttendeesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEventAttendeesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetMyRsvpsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	IncludeCancelled bool                   `protobuf:"varint,1,opt,name=include_cancelled,json=includeCancelled,proto3" json:"include_cancelled,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetMyRsvpsRequest) Reset() {
+	*x = GetMyRsvpsRequest{}
+	mi := &file_event_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyRsvpsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyRsvpsRequest) ProtoMessage() {}
+
+func (x *GetMyRsvpsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyRsvpsRequest.ProtoReflect.Descriptor instead.
+func (*GetMyRsvpsRequest) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetMyRsvpsRequest) GetIncludeCancelled() bool {
+	if x != nil {
+		return x.IncludeCancelled
+	}
+	return false
+}
+
+type ListRsvpsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ListEventAttendees lists confirmed RSVPs first, then the waitlist in
+	// order. GetMyRsvps lists them by the start time of their events.
+	Rsvps         []*Rsvp `protobuf:"bytes,1,rep,name=rsvps,proto3" json:"rsvps,omitempty"`
+	NextPageToken string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRsvpsResponse) Reset() {
+	*x = ListRsvpsResponse{}
+	mi := &file_event_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRsvpsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRsvpsResponse) ProtoMessage() {}
+
+func (x *ListRsvpsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRsvpsResponse.ProtoReflect.Descriptor instead.
+func (*ListRsvpsResponse) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListRsvpsResponse) GetRsvps() []*Rsvp {
+	if x != nil {
+		return x.Rsvps
+	}
+	return nil
+}
+
+func (x *ListRsvpsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// A feed calendar clients can subscribe to. Anyone with its URL can read
+// it.
+type CalendarFeed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The feed's URL. Swapping https for webcal opens it in most calendar
+	// apps.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Set on a masjid's feed.
+	MasjidId string `protobuf:"bytes,2,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	// Set on a user's feed.
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarFeed) Reset() {
+	*x = CalendarFeed{}
+	mi := &file_event_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarFeed) ProtoMessage() {}
+
+func (x *CalendarFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarFeed.ProtoReflect.Descriptor instead.
+func (*CalendarFeed) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CalendarFeed) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CalendarFeed) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *CalendarFeed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CalendarFeed) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *CalendarFeed) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type GetCalendarFeedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The masjid whose feed to return. The caller's own feed is returned when
+	// empty.
+	MasjidId      string `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedRequest) Reset() {
+	*x = GetCalendarFeedRequest{}
+	mi := &file_event_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedRequest) ProtoMessage() {}
+
+func (x *GetCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCalendarFeedRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+type ResetCalendarFeedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The masjid whose feed to reset. The caller's own feed is reset when
+	// empty.
+	MasjidId      string `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetCalendarFeedRequest) Reset() {
+	*x = ResetCalendarFeedRequest{}
+	mi := &file_event_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetCalendarFeedRequest) ProtoMessage() {}
+
+func (x *ResetCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*ResetCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ResetCalendarFeedRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+type CheckInAttendeeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The event, or the occurrence ID of one occurrence of a recurring event.
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The ticket as read from its QR code.
+	Ticket        string `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInAttendeeRequest) Reset() {
+	*x = CheckInAttendeeRequest{}
+	mi := &file_event_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInAttendeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInAttendeeRequest) ProtoMessage() {}
+
+func (x *CheckInAttendeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInAttendeeRequest.ProtoReflect.Descriptor instead.
+func (*CheckInAttendeeRequest) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CheckInAttendeeRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CheckInAttendeeRequest) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+type CheckIn struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The event or occurrence checked in to.
+	EventId     string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	RsvpId      string                 `protobuf:"bytes,3,opt,name=rsvp_id,json=rsvpId,proto3" json:"rsvp_id,omitempty"`
+	UserId      string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FirstName   string                 `protobuf:"bytes,5,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName    string                 `protobuf:"bytes,6,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	CheckInTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=check_in_time,json=checkInTime,proto3" json:"check_in_time,omitempty"`
+	// The volunteer who scanned the ticket.
+	CheckedInBy string `protobuf:"bytes,8,opt,name=checked_in_by,json=checkedInBy,proto3" json:"checked_in_by,omitempty"`
+	// The event with its attendance after the check-in.
+	Event         *Event `protobuf:"bytes,9,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckIn) Reset() {
+	*x = CheckIn{}
+	mi := &file_event_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckIn) ProtoMessage() {}
+
+func (x *CheckIn) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckIn.ProtoReflect.Descriptor instead.
+func (*CheckIn) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CheckIn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CheckIn) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CheckIn) GetRsvpId() string {
+	if x != nil {
+		return x.RsvpId
+	}
+	return ""
+}
+
+func (x *CheckIn) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckIn) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *CheckIn) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *CheckIn) GetCheckInTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckInTime
+	}
+	return nil
+}
+
+func (x *CheckIn) GetCheckedInBy() string {
+	if x != nil {
+		return x.CheckedInBy
+	}
+	return ""
+}
+
+func (x *CheckIn) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type GetTicketKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketKeyRequest) Reset() {
+	*x = GetTicketKeyRequest{}
+	mi := &file_event_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketKeyRequest) ProtoMessage() {}
+
+func (x *GetTicketKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketKeyRequest) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{22}
+}
+
+type TicketKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Always "Ed25519".
+	Algorithm string `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// The raw 32-byte public key in standard base64.
+	PublicKey     string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketKey) Reset() {
+	*x = TicketKey{}
+	mi := &file_event_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketKey) ProtoMessage() {}
+
+func (x *TicketKey) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketKey.ProtoReflect.Descriptor instead.
+func (*TicketKey) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TicketKey) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *TicketKey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type TicketType struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// In the smallest unit of currency_code, e.g. cents. Zero for free
+	// tickets.
+	Price int64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	// An ISO 4217 code, the same for every ticket type of an event.
+	CurrencyCode string `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// How many tickets of the type may be sold, without limit when 0.
+	Quota int32 `protobuf:"varint,5,opt,name=quota,proto3" json:"quota,omitempty"`
+	// Tickets paid for or held by pending orders.
+	SoldCount int32 `protobuf:"varint,6,opt,name=sold_count,json=soldCount,proto3" json:"sold_count,omitempty"`
+	// Tickets left to sell, or -1 without a quota.
+	RemainingCount int32 `protobuf:"varint,7,opt,name=remaining_count,json=remainingCount,proto3" json:"remaining_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TicketType) Reset() {
+	*x = TicketType{}
+	mi := &file_event_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketType) ProtoMessage() {}
+
+func (x *TicketType) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketType.ProtoReflect.Descriptor instead.
+func (*TicketType) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TicketType) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TicketType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TicketType) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *TicketType) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *TicketType) GetQuota() int32 {
+	if x != nil {
+		return x.Quota
+	}
+	return 0
+}
+
+func (x *TicketType) GetSoldCount() int32 {
+	if x != nil {
+		return x.SoldCount
+	}
+	return 0
+}
+
+func (x *TicketType) GetRemainingCount() int32 {
+	if x != nil {
+		return x.RemainingCount
+	}
+	return 0
+}
+
+type SetTicketTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	TicketTypes   []*TicketType          `protobuf:"bytes,2,rep,name=ticket_types,json=ticketTypes,proto3" json:"ticket_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTicketTypesRequest) Reset() {
+	*x = SetTicketTypesRequest{}
+	mi := &file_event_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTicketTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTicketTypesRequest) ProtoMessage() {}
+
+func (x *SetTicketTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTicketTypesRequest.ProtoReflect.Descriptor instead.
+func (*SetTicketTypesRequest) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetTicketTypesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetTicketTypesRequest) GetTicketTypes() []*TicketType {
+	if x != nil {
+		return x.TicketTypes
+	}
+	return nil
+}
+
+type Order struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId  string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status  Order_Status           `protobuf:"varint,4,opt,name=status,proto3,enum=limestone.Order_Status" json:"status,omitempty"`
+	Items   []*OrderItem           `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	// In the smallest unit of currency_code.
+	Total        int64  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	CurrencyCode string `protobuf:"bytes,7,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Where the buyer pays for a pending order.
+	CheckoutUrl string `protobuf:"bytes,8,opt,name=checkout_url,json=checkoutUrl,proto3" json:"checkout_url,omitempty"`
+	// The RSVP the order confirmed, once paid.
+	RsvpId        string                 `protobuf:"bytes,9,opt,name=rsvp_id,json=rsvpId,proto3" json:"rsvp_id,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	PayTime       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=pay_time,json=payTime,proto3" json:"pay_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_event_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,33 +2338,247 @@ func (x *DeleteEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEventResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEventResponse) Descriptor() ([]byte, []int) {
-	return file_event_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{26}
 }
 
-type GetEventRequest struct {
+func (x *Order) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Order) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Order) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Order) GetStatus() Order_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Order_STATUS_UNSPECIFIED
+}
+
+func (x *Order) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Order) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Order) GetCheckoutUrl() string {
+	if x != nil {
+		return x.CheckoutUrl
+	}
+	return ""
+}
+
+func (x *Order) GetRsvpId() string {
+	if x != nil {
+		return x.RsvpId
+	}
+	return ""
+}
+
+func (x *Order) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Order) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Order) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *Order) GetPayTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PayTime
+	}
+	return nil
+}
+
+type OrderItem struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TicketTypeId string                 `protobuf:"bytes,1,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	Quantity     int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// The ticket type's name and price when it was ordered.
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UnitPrice     int64  `protobuf:"varint,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_event_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OrderItem) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderItem) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+type CreateOrderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The event, or an occurrence of a recurring event, whose tickets cover
+	// the whole series.
+	EventId       string       `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Items         []*OrderItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_event_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateOrderRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventRequest) Reset() {
-	*x = GetEventRequest{}
-	mi := &file_event_service_proto_msgTypes[6]
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_event_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventRequest) String() string {
+func (x *GetOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventRequest) ProtoMessage() {}
+func (*GetOrderRequest) ProtoMessage() {}
 
-func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_service_proto_msgTypes[6]
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,41 +2589,45 @@ func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
-func (*GetEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetEventRequest) GetId() string {
+func (x *GetOrderRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+type ListOrdersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lists the event's orders instead of the caller's. Only masjid admins
+	// and imams may set it.
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Defaults to 50.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventsRequest) Reset() {
-	*x = ListEventsRequest{}
-	mi := &file_event_service_proto_msgTypes[7]
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_event_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsRequest) String() string {
+func (x *ListOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsRequest) ProtoMessage() {}
+func (*ListOrdersRequest) ProtoMessage() {}
 
-func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_service_proto_msgTypes[7]
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -662,47 +2638,56 @@ func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListEventsRequest) Descriptor() ([]byte, []int) {
-	return file_event_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ListEventsRequest) GetPageSize() int32 {
+func (x *ListOrdersRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListEventsRequest) GetPageToken() string {
+func (x *ListOrdersRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-type ListEventsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+type ListOrdersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first.
+	Orders        []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	NextPageToken string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventsResponse) Reset() {
-	*x = ListEventsResponse{}
-	mi := &file_event_service_proto_msgTypes[8]
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_event_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsResponse) String() string {
+func (x *ListOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsResponse) ProtoMessage() {}
+func (*ListOrdersResponse) ProtoMessage() {}
 
-func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_service_proto_msgTypes[8]
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -713,31 +2698,91 @@ func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListEventsResponse) Descriptor() ([]byte, []int) {
-	return file_event_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListEventsResponse) GetEvents() []*Event {
+func (x *ListOrdersResponse) GetOrders() []*Order {
 	if x != nil {
-		return x.Events
+		return x.Orders
 	}
 	return nil
 }
 
+func (x *ListOrdersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_event_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_event_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CancelOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 var File_event_service_proto protoreflect.FileDescriptor
 
 const file_event_service_proto_rawDesc = "" +
 	"\n" +
-	"\x13event_service.proto\x12\tlimestone\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb6\x02\n" +
+	"\x13event_service.proto\x12\tlimestone\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x10hijri_date.proto\"\xd2\x05\n" +
 	"\x15StandardEventResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12(\n" +
 	"\x05event\x18\x04 \x01(\v2\x10.limestone.EventH\x00R\x05event\x12T\n" +
 	"\x15delete_event_response\x18\x05 \x01(\v2\x1e.limestone.DeleteEventResponseH\x00R\x13deleteEventResponse\x12O\n" +
-	"\x13list_event_response\x18\x06 \x01(\v2\x1d.limestone.ListEventsResponseH\x00R\x11listEventResponseB\x06\n" +
-	"\x04data\"\xd0\x06\n" +
+	"\x13list_event_response\x18\x06 \x01(\v2\x1d.limestone.ListEventsResponseH\x00R\x11listEventResponse\x12%\n" +
+	"\x04rsvp\x18\a \x01(\v2\x0f.limestone.RsvpH\x00R\x04rsvp\x12N\n" +
+	"\x13list_rsvps_response\x18\b \x01(\v2\x1c.limestone.ListRsvpsResponseH\x00R\x11listRsvpsResponse\x12>\n" +
+	"\rcalendar_feed\x18\t \x01(\v2\x17.limestone.CalendarFeedH\x00R\fcalendarFeed\x12/\n" +
+	"\bcheck_in\x18\n" +
+	" \x01(\v2\x12.limestone.CheckInH\x00R\acheckIn\x125\n" +
+	"\n" +
+	"ticket_key\x18\v \x01(\v2\x14.limestone.TicketKeyH\x00R\tticketKey\x12(\n" +
+	"\x05order\x18\f \x01(\v2\x10.limestone.OrderH\x00R\x05order\x12Q\n" +
+	"\x14list_orders_response\x18\r \x01(\v2\x1d.limestone.ListOrdersResponseH\x00R\x12listOrdersResponseB\x06\n" +
+	"\x04data\"\xfc\t\n" +
 	"\x05Event\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tmasjid_id\x18\x03 \x01(\tR\bmasjidId\x12\x12\n" +
@@ -756,7 +2801,19 @@ const file_event_service_proto_rawDesc = "" +
 	"\vcreate_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"createTime\x12;\n" +
 	"\vupdate_time\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"updateTime\"G\n" +
+	"updateTime\x12>\n" +
+	"\x10hijri_start_date\x18\x10 \x01(\v2\x14.limestone.HijriDateR\x0ehijriStartDate\x12:\n" +
+	"\x0ehijri_end_date\x18\x11 \x01(\v2\x14.limestone.HijriDateR\fhijriEndDate\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\x12 \x03(\tR\n" +
+	"recurrence\x12,\n" +
+	"\x12recurring_event_id\x18\x13 \x01(\tR\x10recurringEventId\x12J\n" +
+	"\x13original_start_time\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\x11originalStartTime\x12:\n" +
+	"\n" +
+	"attendance\x18\x15 \x01(\v2\x15.limestone.AttendanceB\x03\xe0A\x03R\n" +
+	"attendance\x12=\n" +
+	"\fticket_types\x18\x16 \x03(\v2\x15.limestone.TicketTypeB\x03\xe0A\x03R\vticketTypes\x12\x19\n" +
+	"\broom_ids\x18\x17 \x03(\tR\aroomIds\"G\n" +
 	"\x11GenderRestriction\x12\x12\n" +
 	"\x0eNO_RESTRICTION\x10\x00\x12\r\n" +
 	"\tMALE_ONLY\x10\x01\x12\x0f\n" +
@@ -770,30 +2827,204 @@ const file_event_service_proto_rawDesc = "" +
 	"\x05YOUTH\x10\x05\x12\x15\n" +
 	"\x11CHILDREN_SPECIFIC\x10\x06\x12\x0f\n" +
 	"\vMATRIMONIAL\x10\a\x12\v\n" +
-	"\aFUNERAL\x10\b\"A\n" +
+	"\aFUNERAL\x10\b\"_\n" +
+	"\n" +
+	"Attendance\x12'\n" +
+	"\x0fconfirmed_count\x18\x01 \x01(\x05R\x0econfirmedCount\x12(\n" +
+	"\x10checked_in_count\x18\x02 \x01(\x05R\x0echeckedInCount\"A\n" +
 	"\x12CreateEventRequest\x12+\n" +
-	"\x05event\x18\x01 \x01(\v2\x10.limestone.EventB\x03\xe0A\x02R\x05event\"Q\n" +
+	"\x05event\x18\x01 \x01(\v2\x10.limestone.EventB\x03\xe0A\x02R\x05event\"\x83\x01\n" +
 	"\x12UpdateEventRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
-	"\x05event\x18\x02 \x01(\v2\x10.limestone.EventB\x03\xe0A\x02R\x05event\")\n" +
+	"\x05event\x18\x02 \x01(\v2\x10.limestone.EventB\x03\xe0A\x02R\x05event\x120\n" +
+	"\x05scope\x18\x03 \x01(\x0e2\x1a.limestone.RecurrenceScopeR\x05scope\"[\n" +
 	"\x12DeleteEventRequest\x12\x13\n" +
-	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x120\n" +
+	"\x05scope\x18\x02 \x01(\x0e2\x1a.limestone.RecurrenceScopeR\x05scope\"\x15\n" +
 	"\x13DeleteEventResponse\"&\n" +
 	"\x0fGetEventRequest\x12\x13\n" +
-	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"O\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\xd9\x02\n" +
 	"\x11ListEventsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\">\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tmasjid_id\x18\x04 \x01(\tR\bmasjidId\x12\x1d\n" +
+	"\n" +
+	"hijri_year\x18\x05 \x01(\x05R\thijriYear\x12\x1f\n" +
+	"\vhijri_month\x18\x06 \x01(\x05R\n" +
+	"hijriMonth\x129\n" +
+	"\n" +
+	"start_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartFrom\x12=\n" +
+	"\fstart_before\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vstartBefore\x12\x16\n" +
+	"\x06filter\x18\t \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\n" +
+	" \x01(\tR\aorderBy\"\x85\x01\n" +
 	"\x12ListEventsResponse\x12(\n" +
-	"\x06events\x18\x01 \x03(\v2\x10.limestone.EventR\x06events2\xac\x04\n" +
+	"\x06events\x18\x01 \x03(\v2\x10.limestone.EventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\x86\x01\n" +
+	"\x15ListWeekEventsRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x123\n" +
+	"\aweek_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06weekOf\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\"\x8b\x04\n" +
+	"\x04Rsvp\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x123\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x16.limestone.Rsvp.StatusB\x03\xe0A\x03R\x06status\x120\n" +
+	"\x11waitlist_position\x18\x05 \x01(\x05B\x03\xe0A\x03R\x10waitlistPosition\x12;\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12\"\n" +
+	"\n" +
+	"first_name\x18\b \x01(\tB\x03\xe0A\x03R\tfirstName\x12 \n" +
+	"\tlast_name\x18\t \x01(\tB\x03\xe0A\x03R\blastName\x12+\n" +
+	"\x05event\x18\n" +
+	" \x01(\v2\x10.limestone.EventB\x03\xe0A\x03R\x05event\x12\x1b\n" +
+	"\x06ticket\x18\v \x01(\tB\x03\xe0A\x03R\x06ticket\"N\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tCONFIRMED\x10\x01\x12\x0e\n" +
+	"\n" +
+	"WAITLISTED\x10\x02\x12\r\n" +
+	"\tCANCELLED\x10\x03\"2\n" +
+	"\x10RsvpEventRequest\x12\x1e\n" +
+	"\bevent_id\x18\x01 \x01(\tB\x03\xe0A\x02R\aeventId\"L\n" +
+	"\x11CancelRsvpRequest\x12\x1e\n" +
+	"\bevent_id\x18\x01 \x01(\tB\x03\xe0A\x02R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xa7\x01\n" +
+	"\x19ListEventAttendeesRequest\x12\x1e\n" +
+	"\bevent_id\x18\x01 \x01(\tB\x03\xe0A\x02R\aeventId\x12.\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.limestone.Rsvp.StatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"@\n" +
+	"\x11GetMyRsvpsRequest\x12+\n" +
+	"\x11include_cancelled\x18\x01 \x01(\bR\x10includeCancelled\"b\n" +
+	"\x11ListRsvpsResponse\x12%\n" +
+	"\x05rsvps\x18\x01 \x03(\v2\x0f.limestone.RsvpR\x05rsvps\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xe9\x01\n" +
+	"\fCalendarFeed\x12\x15\n" +
+	"\x03url\x18\x01 \x01(\tB\x03\xe0A\x03R\x03url\x12 \n" +
+	"\tmasjid_id\x18\x02 \x01(\tB\x03\xe0A\x03R\bmasjidId\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\tB\x03\xe0A\x03R\x06userId\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\"5\n" +
+	"\x16GetCalendarFeedRequest\x12\x1b\n" +
+	"\tmasjid_id\x18\x01 \x01(\tR\bmasjidId\"7\n" +
+	"\x18ResetCalendarFeedRequest\x12\x1b\n" +
+	"\tmasjid_id\x18\x01 \x01(\tR\bmasjidId\"U\n" +
+	"\x16CheckInAttendeeRequest\x12\x1e\n" +
+	"\bevent_id\x18\x01 \x01(\tB\x03\xe0A\x02R\aeventId\x12\x1b\n" +
+	"\x06ticket\x18\x02 \x01(\tB\x03\xe0A\x02R\x06ticket\"\xae\x02\n" +
+	"\aCheckIn\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x17\n" +
+	"\arsvp_id\x18\x03 \x01(\tR\x06rsvpId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x05 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x06 \x01(\tR\blastName\x12>\n" +
+	"\rcheck_in_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcheckInTime\x12\"\n" +
+	"\rchecked_in_by\x18\b \x01(\tR\vcheckedInBy\x12&\n" +
+	"\x05event\x18\t \x01(\v2\x10.limestone.EventR\x05event\"\x15\n" +
+	"\x13GetTicketKeyRequest\"H\n" +
+	"\tTicketKey\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\tR\talgorithm\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\"\xdd\x01\n" +
+	"\n" +
+	"TicketType\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12(\n" +
+	"\rcurrency_code\x18\x04 \x01(\tB\x03\xe0A\x02R\fcurrencyCode\x12\x14\n" +
+	"\x05quota\x18\x05 \x01(\x05R\x05quota\x12\"\n" +
+	"\n" +
+	"sold_count\x18\x06 \x01(\x05B\x03\xe0A\x03R\tsoldCount\x12,\n" +
+	"\x0fremaining_count\x18\a \x01(\x05B\x03\xe0A\x03R\x0eremainingCount\"q\n" +
+	"\x15SetTicketTypesRequest\x12\x1e\n" +
+	"\bevent_id\x18\x01 \x01(\tB\x03\xe0A\x02R\aeventId\x128\n" +
+	"\fticket_types\x18\x02 \x03(\v2\x15.limestone.TicketTypeR\vticketTypes\"\xf7\x04\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12/\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.limestone.Order.StatusR\x06status\x12*\n" +
+	"\x05items\x18\x05 \x03(\v2\x14.limestone.OrderItemR\x05items\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x03R\x05total\x12#\n" +
+	"\rcurrency_code\x18\a \x01(\tR\fcurrencyCode\x12!\n" +
+	"\fcheckout_url\x18\b \x01(\tR\vcheckoutUrl\x12\x17\n" +
+	"\arsvp_id\x18\t \x01(\tR\x06rsvpId\x12;\n" +
+	"\vcreate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12;\n" +
+	"\vexpire_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x125\n" +
+	"\bpay_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\apayTime\"h\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\b\n" +
+	"\x04PAID\x10\x02\x12\r\n" +
+	"\tCANCELLED\x10\x03\x12\x12\n" +
+	"\x0eREFUND_PENDING\x10\x04\x12\f\n" +
+	"\bREFUNDED\x10\x05\"\x94\x01\n" +
+	"\tOrderItem\x12)\n" +
+	"\x0eticket_type_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fticketTypeId\x12\x1f\n" +
+	"\bquantity\x18\x02 \x01(\x05B\x03\xe0A\x02R\bquantity\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tB\x03\xe0A\x03R\x04name\x12\"\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\x03B\x03\xe0A\x03R\tunitPrice\"e\n" +
+	"\x12CreateOrderRequest\x12\x1e\n" +
+	"\bevent_id\x18\x01 \x01(\tB\x03\xe0A\x02R\aeventId\x12/\n" +
+	"\x05items\x18\x02 \x03(\v2\x14.limestone.OrderItemB\x03\xe0A\x02R\x05items\"&\n" +
+	"\x0fGetOrderRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"j\n" +
+	"\x11ListOrdersRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"f\n" +
+	"\x12ListOrdersResponse\x12(\n" +
+	"\x06orders\x18\x01 \x03(\v2\x10.limestone.OrderR\x06orders\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\")\n" +
+	"\x12CancelOrderRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id*9\n" +
+	"\x0fRecurrenceScope\x12\x0e\n" +
+	"\n" +
+	"THIS_EVENT\x10\x00\x12\x16\n" +
+	"\x12THIS_AND_FOLLOWING\x10\x012\x82\x12\n" +
 	"\fEventService\x12p\n" +
 	"\vCreateEvent\x12\x1d.limestone.CreateEventRequest\x1a .limestone.StandardEventResponse\" \xdaA\x05event\x82\xd3\xe4\x93\x02\x12:\x05event\"\t/v1/event\x12u\n" +
 	"\vUpdateEvent\x12\x1d.limestone.UpdateEventRequest\x1a .limestone.StandardEventResponse\"%\xdaA\x05event\x82\xd3\xe4\x93\x02\x17:\x05event2\x0e/v1/event/{id}\x12k\n" +
 	"\vDeleteEvent\x12\x1d.limestone.DeleteEventRequest\x1a .limestone.StandardEventResponse\"\x1b\xdaA\x02id\x82\xd3\xe4\x93\x02\x10*\x0e/v1/event/{id}\x12e\n" +
 	"\bGetEvent\x12\x1a.limestone.GetEventRequest\x1a .limestone.StandardEventResponse\"\x1b\xdaA\x02id\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/event/{id}\x12_\n" +
 	"\n" +
-	"ListEvents\x12\x1c.limestone.ListEventsRequest\x1a .limestone.StandardEventResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/eventBi\n" +
+	"ListEvents\x12\x1c.limestone.ListEventsRequest\x1a .limestone.StandardEventResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/event\x12\x8c\x01\n" +
+	"\x0eListWeekEvents\x12 .limestone.ListWeekEventsRequest\x1a .limestone.StandardEventResponse\"6\xdaA\tmasjid_id\x82\xd3\xe4\x93\x02$\x12\"/v1/masjid/{masjid_id}/events/week\x12{\n" +
+	"\tRsvpEvent\x12\x1b.limestone.RsvpEventRequest\x1a .limestone.StandardEventResponse\"/\xdaA\bevent_id\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/event/{event_id}/rsvp\x12z\n" +
+	"\n" +
+	"CancelRsvp\x12\x1c.limestone.CancelRsvpRequest\x1a .limestone.StandardEventResponse\",\xdaA\bevent_id\x82\xd3\xe4\x93\x02\x1b*\x19/v1/event/{event_id}/rsvp\x12\x8f\x01\n" +
+	"\x12ListEventAttendees\x12$.limestone.ListEventAttendeesRequest\x1a .limestone.StandardEventResponse\"1\xdaA\bevent_id\x82\xd3\xe4\x93\x02 \x12\x1e/v1/event/{event_id}/attendees\x12_\n" +
+	"\n" +
+	"GetMyRsvps\x12\x1c.limestone.GetMyRsvpsRequest\x1a .limestone.StandardEventResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/rsvps\x12q\n" +
+	"\x0fGetCalendarFeed\x12!.limestone.GetCalendarFeedRequest\x1a .limestone.StandardEventResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/calendar-feed\x12~\n" +
+	"\x11ResetCalendarFeed\x12#.limestone.ResetCalendarFeedRequest\x1a .limestone.StandardEventResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/calendar-feed/reset\x12\x92\x01\n" +
+	"\x0fCheckInAttendee\x12!.limestone.CheckInAttendeeRequest\x1a .limestone.StandardEventResponse\":\xdaA\x0fevent_id,ticket\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/event/{event_id}/check-in\x12h\n" +
+	"\fGetTicketKey\x12\x1e.limestone.GetTicketKeyRequest\x1a .limestone.StandardEventResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/ticket-key\x12\x9a\x01\n" +
+	"\x0eSetTicketTypes\x12 .limestone.SetTicketTypesRequest\x1a .limestone.StandardEventResponse\"D\xdaA\x15event_id,ticket_types\x82\xd3\xe4\x93\x02&:\x01*\x1a!/v1/event/{event_id}/ticket-types\x12\x87\x01\n" +
+	"\vCreateOrder\x12\x1d.limestone.CreateOrderRequest\x1a .limestone.StandardEventResponse\"7\xdaA\x0eevent_id,items\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/event/{event_id}/orders\x12f\n" +
+	"\bGetOrder\x12\x1a.limestone.GetOrderRequest\x1a .limestone.StandardEventResponse\"\x1c\xdaA\x02id\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/orders/{id}\x12`\n" +
+	"\n" +
+	"ListOrders\x12\x1c.limestone.ListOrdersRequest\x1a .limestone.StandardEventResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/orders\x12v\n" +
+	"\vCancelOrder\x12\x1d.limestone.CancelOrderRequest\x1a .limestone.StandardEventResponse\"&\xdaA\x02id\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/orders/{id}/cancelBi\n" +
 	"\rcom.limestoneB\x11EventServiceProtoP\x01Z\x01.\xa2\x02\x03LXX\xaa\x02\tLimestone\xca\x02\tLimestone\xe2\x02\x15Limestone\\GPBMetadata\xea\x02\tLimestoneb\x06proto3"
 
 var (
@@ -808,50 +3039,142 @@ func file_event_service_proto_rawDescGZIP() []byte {
 	return file_event_service_proto_rawDescData
 }
 
-var file_event_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_event_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_event_service_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_event_service_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_event_service_proto_goTypes = []any{
-	(Event_GenderRestriction)(0),  // 0: limestone.Event.GenderRestriction
-	(Event_EventType)(0),          // 1: limestone.Event.EventType
-	(*StandardEventResponse)(nil), // 2: limestone.StandardEventResponse
-	(*Event)(nil),                 // 3: limestone.Event
-	(*CreateEventRequest)(nil),    // 4: limestone.CreateEventRequest
-	(*UpdateEventRequest)(nil),    // 5: limestone.UpdateEventRequest
-	(*DeleteEventRequest)(nil),    // 6: limestone.DeleteEventRequest
-	(*DeleteEventResponse)(nil),   // 7: limestone.DeleteEventResponse
-	(*GetEventRequest)(nil),       // 8: limestone.GetEventRequest
-	(*ListEventsRequest)(nil),     // 9: limestone.ListEventsRequest
-	(*ListEventsResponse)(nil),    // 10: limestone.ListEventsResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(RecurrenceScope)(0),              // 0: limestone.RecurrenceScope
+	(Event_GenderRestriction)(0),      // 1: limestone.Event.GenderRestriction
+	(Event_EventType)(0),              // 2: limestone.Event.EventType
+	(Rsvp_Status)(0),                  // 3: limestone.Rsvp.Status
+	(Order_Status)(0),                 // 4: limestone.Order.Status
+	(*StandardEventResponse)(nil),     // 5: limestone.StandardEventResponse
+	(*Event)(nil),                     // 6: limestone.Event
+	(*Attendance)(nil),                // 7: limestone.Attendance
+	(*CreateEventRequest)(nil),        // 8: limestone.CreateEventRequest
+	(*UpdateEventRequest)(nil),        // 9: limestone.UpdateEventRequest
+	(*DeleteEventRequest)(nil),        // 10: limestone.DeleteEventRequest
+	(*DeleteEventResponse)(nil),       // 11: limestone.DeleteEventResponse
+	(*GetEventRequest)(nil),           // 12: limestone.GetEventRequest
+	(*ListEventsRequest)(nil),         // 13: limestone.ListEventsRequest
+	(*ListEventsResponse)(nil),        // 14: limestone.ListEventsResponse
+	(*ListWeekEventsRequest)(nil),     // 15: limestone.ListWeekEventsRequest
+	(*Rsvp)(nil),                      // 16: limestone.Rsvp
+	(*RsvpEventRequest)(nil),          // 17: limestone.RsvpEventRequest
+	(*CancelRsvpRequest)(nil),         // 18: limestone.CancelRsvpRequest
+	(*ListEventAttendeesRequest)(nil), // 19: limestone.ListEventAttendeesRequest
+	(*GetMyRsvpsRequest)(nil),         // 20: limestone.GetMyRsvpsRequest
+	(*ListRsvpsResponse)(nil),         // 21: limestone.ListRsvpsResponse
+	(*CalendarFeed)(nil),              // 22: limestone.CalendarFeed
+	(*GetCalendarFeedRequest)(nil),    // 23: limestone.GetCalendarFeedRequest
+	(*ResetCalendarFeedRequest)(nil),  // 24: limestone.ResetCalendarFeedRequest
+	(*CheckInAttendeeRequest)(nil),    // 25: limestone.CheckInAttendeeRequest
+	(*CheckIn)(nil),                   // 26: limestone.CheckIn
+	(*GetTicketKeyRequest)(nil),       // 27: limestone.GetTicketKeyRequest
+	(*TicketKey)(nil),                 // 28: limestone.TicketKey
+	(*TicketType)(nil),                // 29: limestone.TicketType
+	(*SetTicketTypesRequest)(nil),     // 30: limestone.SetTicketTypesRequest
+	(*Order)(nil),                     // 31: limestone.Order
+	(*OrderItem)(nil),                 // 32: limestone.OrderItem
+	(*CreateOrderRequest)(nil),        // 33: limestone.CreateOrderRequest
+	(*GetOrderRequest)(nil),           // 34: limestone.GetOrderRequest
+	(*ListOrdersRequest)(nil),         // 35: limestone.ListOrdersRequest
+	(*ListOrdersResponse)(nil),        // 36: limestone.ListOrdersResponse
+	(*CancelOrderRequest)(nil),        // 37: limestone.CancelOrderRequest
+	(*timestamppb.Timestamp)(nil),     // 38: google.protobuf.Timestamp
+	(*HijriDate)(nil),                 // 39: limestone.HijriDate
 }
 var file_event_service_proto_depIdxs = []int32{
-	3,  // 0: limestone.StandardEventResponse.event:type_name -> limestone.Event
-	7,  // 1: limestone.StandardEventResponse.delete_event_response:type_name -> limestone.DeleteEventResponse
-	10, // 2: limestone.StandardEventResponse.list_event_response:type_name -> limestone.ListEventsResponse
-	11, // 3: limestone.Event.start_time:type_name -> google.protobuf.Timestamp
-	11, // 4: limestone.Event.end_time:type_name -> google.protobuf.Timestamp
-	0,  // 5: limestone.Event.gender_restriction:type_name -> limestone.Event.GenderRestriction
-	1,  // 6: limestone.Event.types:type_name -> limestone.Event.EventType
-	11, // 7: limestone.Event.create_time:type_name -> google.protobuf.Timestamp
-	11, // 8: limestone.Event.update_time:type_name -> google.protobuf.Timestamp
-	3,  // 9: limestone.CreateEventRequest.event:type_name -> limestone.Event
-	3,  // 10: limestone.UpdateEventRequest.event:type_name -> limestone.Event
-	3,  // 11: limestone.ListEventsResponse.events:type_name -> limestone.Event
-	4,  // 12: limestone.EventService.CreateEvent:input_type -> limestone.CreateEventRequest
-	5,  // 13: limestone.EventService.UpdateEvent:input_type -> limestone.UpdateEventRequest
-	6,  // 14: limestone.EventService.DeleteEvent:input_type -> limestone.DeleteEventRequest
-	8,  // 15: limestone.EventService.GetEvent:input_type -> limestone.GetEventRequest
-	9,  // 16: limestone.EventService.ListEvents:input_type -> limestone.ListEventsRequest
-	2,  // 17: limestone.EventService.CreateEvent:output_type -> limestone.StandardEventResponse
-	2,  // 18: limestone.EventService.UpdateEvent:output_type -> limestone.StandardEventResponse
-	2,  // 19: limestone.EventService.DeleteEvent:output_type -> limestone.StandardEventResponse
-	2,  // 20: limestone.EventService.GetEvent:output_type -> limestone.StandardEventResponse
-	2,  // 21: limestone.EventService.ListEvents:output_type -> limestone.StandardEventResponse
-	17, // [17:22] is the sub-list for method output_type
-	12, // [12:17] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	6,  // 0: limestone.StandardEventResponse.event:type_name -> limestone.Event
+	11, // 1: limestone.StandardEventResponse.delete_event_response:type_name -> limestone.DeleteEventResponse
+	14, // 2: limestone.StandardEventResponse.list_event_response:type_name -> limestone.ListEventsResponse
+	16, // 3: limestone.StandardEventResponse.rsvp:type_name -> limestone.Rsvp
+	21, // 4: limestone.StandardEventResponse.list_rsvps_response:type_name -> limestone.ListRsvpsResponse
+	22, // 5: limestone.StandardEventResponse.calendar_feed:type_name -> limestone.CalendarFeed
+	26, // 6: limestone.StandardEventResponse.check_in:type_name -> limestone.CheckIn
+	28, // 7: limestone.StandardEventResponse.ticket_key:type_name -> limestone.TicketKey
+	31, // 8: limestone.StandardEventResponse.order:type_name -> limestone.Order
+	36, // 9: limestone.StandardEventResponse.list_orders_response:type_name -> limestone.ListOrdersResponse
+	38, // 10: limestone.Event.start_time:type_name -> google.protobuf.Timestamp
+	38, // 11: limestone.Event.end_time:type_name -> google.protobuf.Timestamp
+	1,  // 12: limestone.Event.gender_restriction:type_name -> limestone.Event.GenderRestriction
+	2,  // 13: limestone.Event.types:type_name -> limestone.Event.EventType
+	38, // 14: limestone.Event.create_time:type_name -> google.protobuf.Timestamp
+	38, // 15: limestone.Event.update_time:type_name -> google.protobuf.Timestamp
+	39, // 16: limestone.Event.hijri_start_date:type_name -> limestone.HijriDate
+	39, // 17: limestone.Event.hijri_end_date:type_name -> limestone.HijriDate
+	38, // 18: limestone.Event.original_start_time:type_name -> google.protobuf.Timestamp
+	7,  // 19: limestone.Event.attendance:type_name -> limestone.Attendance
+	29, // 20: limestone.Event.ticket_types:type_name -> limestone.TicketType
+	6,  // 21: limestone.CreateEventRequest.event:type_name -> limestone.Event
+	6,  // 22: limestone.UpdateEventRequest.event:type_name -> limestone.Event
+	0,  // 23: limestone.UpdateEventRequest.scope:type_name -> limestone.RecurrenceScope
+	0,  // 24: limestone.DeleteEventRequest.scope:type_name -> limestone.RecurrenceScope
+	38, // 25: limestone.ListEventsRequest.start_from:type_name -> google.protobuf.Timestamp
+	38, // 26: limestone.ListEventsRequest.start_before:type_name -> google.protobuf.Timestamp
+	6,  // 27: limestone.ListEventsResponse.events:type_name -> limestone.Event
+	38, // 28: limestone.ListWeekEventsRequest.week_of:type_name -> google.protobuf.Timestamp
+	3,  // 29: limestone.Rsvp.status:type_name -> limestone.Rsvp.Status
+	38, // 30: limestone.Rsvp.create_time:type_name -> google.protobuf.Timestamp
+	38, // 31: limestone.Rsvp.update_time:type_name -> google.protobuf.Timestamp
+	6,  // 32: limestone.Rsvp.event:type_name -> limestone.Event
+	3,  // 33: limestone.ListEventAttendeesRequest.status:type_name -> limestone.Rsvp.Status
+	16, // 34: limestone.ListRsvpsResponse.rsvps:type_name -> limestone.Rsvp
+	38, // 35: limestone.CalendarFeed.create_time:type_name -> google.protobuf.Timestamp
+	38, // 36: limestone.CalendarFeed.update_time:type_name -> google.protobuf.Timestamp
+	38, // 37: limestone.CheckIn.check_in_time:type_name -> google.protobuf.Timestamp
+	6,  // 38: limestone.CheckIn.event:type_name -> limestone.Event
+	29, // 39: limestone.SetTicketTypesRequest.ticket_types:type_name -> limestone.TicketType
+	4,  // 40: limestone.Order.status:type_name -> limestone.Order.Status
+	32, // 41: limestone.Order.items:type_name -> limestone.OrderItem
+	38, // 42: limestone.Order.create_time:type_name -> google.protobuf.Timestamp
+	38, // 43: limestone.Order.update_time:type_name -> google.protobuf.Timestamp
+	38, // 44: limestone.Order.expire_time:type_name -> google.protobuf.Timestamp
+	38, // 45: limestone.Order.pay_time:type_name -> google.protobuf.Timestamp
+	32, // 46: limestone.CreateOrderRequest.items:type_name -> limestone.OrderItem
+	31, // 47: limestone.ListOrdersResponse.orders:type_name -> limestone.Order
+	8,  // 48: limestone.EventService.CreateEvent:input_type -> limestone.CreateEventRequest
+	9,  // 49: limestone.EventService.UpdateEvent:input_type -> limestone.UpdateEventRequest
+	10, // 50: limestone.EventService.DeleteEvent:input_type -> limestone.DeleteEventRequest
+	12, // 51: limestone.EventService.GetEvent:input_type -> limestone.GetEventRequest
+	13, // 52: limestone.EventService.ListEvents:input_type -> limestone.ListEventsRequest
+	15, // 53: limestone.EventService.ListWeekEvents:input_type -> limestone.ListWeekEventsRequest
+	17, // 54: limestone.EventService.RsvpEvent:input_type -> limestone.RsvpEventRequest
+	18, // 55: limestone.EventService.CancelRsvp:input_type -> limestone.CancelRsvpRequest
+	19, // 56: limestone.EventService.ListEventAttendees:input_type -> limestone.ListEventAttendeesRequest
+	20, // 57: limestone.EventService.GetMyRsvps:input_type -> limestone.GetMyRsvpsRequest
+	23, // 58: limestone.EventService.GetCalendarFeed:input_type -> limestone.GetCalendarFeedRequest
+	24, // 59: limestone.EventService.ResetCalendarFeed:input_type -> limestone.ResetCalendarFeedRequest
+	25, // 60: limestone.EventService.CheckInAttendee:input_type -> limestone.CheckInAttendeeRequest
+	27, // 61: limestone.EventService.GetTicketKey:input_type -> limestone.GetTicketKeyRequest
+	30, // 62: limestone.EventService.SetTicketTypes:input_type -> limestone.SetTicketTypesRequest
+	33, // 63: limestone.EventService.CreateOrder:input_type -> limestone.CreateOrderRequest
+	34, // 64: limestone.EventService.GetOrder:input_type -> limestone.GetOrderRequest
+	35, // 65: limestone.EventService.ListOrders:input_type -> limestone.ListOrdersRequest
+	37, // 66: limestone.EventService.CancelOrder:input_type -> limestone.CancelOrderRequest
+	5,  // 67: limestone.EventService.CreateEvent:output_type -> limestone.StandardEventResponse
+	5,  // 68: limestone.EventService.UpdateEvent:output_type -> limestone.StandardEventResponse
+	5,  // 69: limestone.EventService.DeleteEvent:output_type -> limestone.StandardEventResponse
+	5,  // 70: limestone.EventService.GetEvent:output_type -> limestone.StandardEventResponse
+	5,  // 71: limestone.EventService.ListEvents:output_type -> limestone.StandardEventResponse
+	5,  // 72: limestone.EventService.ListWeekEvents:output_type -> limestone.StandardEventResponse
+	5,  // 73: limestone.EventService.RsvpEvent:output_type -> limestone.StandardEventResponse
+	5,  // 74: limestone.EventService.CancelRsvp:output_type -> limestone.StandardEventResponse
+	5,  // 75: limestone.EventService.ListEventAttendees:output_type -> limestone.StandardEventResponse
+	5,  // 76: limestone.EventService.GetMyRsvps:output_type -> limestone.StandardEventResponse
+	5,  // 77: limestone.EventService.GetCalendarFeed:output_type -> limestone.StandardEventResponse
+	5,  // 78: limestone.EventService.ResetCalendarFeed:output_type -> limestone.StandardEventResponse
+	5,  // 79: limestone.EventService.CheckInAttendee:output_type -> limestone.StandardEventResponse
+	5,  // 80: limestone.EventService.GetTicketKey:output_type -> limestone.StandardEventResponse
+	5,  // 81: limestone.EventService.SetTicketTypes:output_type -> limestone.StandardEventResponse
+	5,  // 82: limestone.EventService.CreateOrder:output_type -> limestone.StandardEventResponse
+	5,  // 83: limestone.EventService.GetOrder:output_type -> limestone.StandardEventResponse
+	5,  // 84: limestone.EventService.ListOrders:output_type -> limestone.StandardEventResponse
+	5,  // 85: limestone.EventService.CancelOrder:output_type -> limestone.StandardEventResponse
+	67, // [67:86] is the sub-list for method output_type
+	48, // [48:67] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_event_service_proto_init() }
@@ -859,18 +3182,26 @@ func file_event_service_proto_init() {
 	if File_event_service_proto != nil {
 		return
 	}
+	file_hijri_date_proto_init()
 	file_event_service_proto_msgTypes[0].OneofWrappers = []any{
 		(*StandardEventResponse_Event)(nil),
 		(*StandardEventResponse_DeleteEventResponse)(nil),
 		(*StandardEventResponse_ListEventResponse)(nil),
+		(*StandardEventResponse_Rsvp)(nil),
+		(*StandardEventResponse_ListRsvpsResponse)(nil),
+		(*StandardEventResponse_CalendarFeed)(nil),
+		(*StandardEventResponse_CheckIn)(nil),
+		(*StandardEventResponse_TicketKey)(nil),
+		(*StandardEventResponse_Order)(nil),
+		(*StandardEventResponse_ListOrdersResponse)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_event_service_proto_rawDesc), len(file_event_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   9,
+			NumEnums:      5,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EventService_CreateEvent_FullMethodName = "/limestone.EventService/CreateEvent"
-	EventService_UpdateEvent_FullMethodName = "/limestone.EventService/UpdateEvent"
-	EventService_DeleteEvent_FullMethodName = "/limestone.EventService/DeleteEvent"
-	EventService_GetEvent_FullMethodName    = "/limestone.EventService/GetEvent"
-	EventService_ListEvents_FullMethodName  = "/limestone.EventService/ListEvents"
+	EventService_CreateEvent_FullMethodName        = "/limestone.EventService/CreateEvent"
+	EventService_UpdateEvent_FullMethodName        = "/limestone.EventService/UpdateEvent"
+	EventService_DeleteEvent_FullMethodName        = "/limestone.EventService/DeleteEvent"
+	EventService_GetEvent_FullMethodName           = "/limestone.EventService/GetEvent"
+	EventService_ListEvents_FullMethodName         = "/limestone.EventService/ListEvents"
+	EventService_ListWeekEvents_FullMethodName     = "/limestone.EventService/ListWeekEvents"
+	EventService_RsvpEvent_FullMethodName          = "/limestone.EventService/RsvpEvent"
+	EventService_CancelRsvp_FullMethodName         = "/limestone.EventService/CancelRsvp"
+	EventService_ListEventAttendees_FullMethodName = "/limestone.EventService/ListEventAttendees"
+	EventService_GetMyRsvps_FullMethodName         = "/limestone.EventService/GetMyRsvps"
+	EventService_GetCalendarFeed_FullMethodName    = "/limestone.EventService/GetCalendarFeed"
+	EventService_ResetCalendarFeed_FullMethodName  = "/limestone.EventService/ResetCalendarFeed"
+	EventService_CheckInAttendee_FullMethodName    = "/limestone.EventService/CheckInAttendee"
+	EventService_GetTicketKey_FullMethodName       = "/limestone.EventService/GetTicketKey"
+	EventService_SetTicketTypes_FullMethodName     = "/limestone.EventService/SetTicketTypes"
+	EventService_CreateOrder_FullMethodName        = "/limestone.EventService/CreateOrder"
+	EventService_GetOrder_FullMethodName           = "/limestone.EventService/GetOrder"
+	EventService_ListOrders_FullMethodName         = "/limestone.EventService/ListOrders"
+	EventService_CancelOrder_FullMethodName        = "/limestone.EventService/CancelOrder"
 )
 
 // EventServiceClient is the client API for EventService service.
@@ -35,6 +49,51 @@ type EventServiceClient interface {
 	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
 	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
+	// Lists the occurrences of a masjid's events in the week, Monday to
+	// Sunday in the masjid's time zone, containing week_of.
+	ListWeekEvents(ctx context.Context, in *ListWeekEventsRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
+	// Registers the caller for an event that requires RSVP. The caller is
+	// confirmed while places remain and waitlisted after that.
+	RsvpEvent(ctx context.Context, in *RsvpEventRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
+	// Cancels an RSVP, confirming the first waitlisted RSVP into the place it
+	// frees.
+	CancelRsvp(ctx context.Context, in *CancelRsvpRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
+	ListEventAttendees(ctx context.Context, in *ListEventAttendeesRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
+	// Returns the caller's RSVPs with their events.
+	GetMyRsvps(ctx context.Context, in *GetMyRsvpsRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
+	// Returns the iCalendar feed of a masjid's events, or of the events the
+	// caller has RSVPed to, creating it the first time.
+	GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
+	// Gives a calendar feed a new URL, so that the old one stops working.
+	// Only masjid admins and imams may reset a masjid's feed.
+	ResetCalendarFeed(ctx context.Context, in *ResetCalendarFeedRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
+	// Checks in the holder of an RSVP ticket to an event, or to one
+	// occurrence of a recurring event by its occurrence ID. Each ticket is
+	// checked in once; scanning it again fails with ALREADY_EXISTS. Only
+	// masjid admins, imams and volunteers may check attendees in.
+	CheckInAttendee(ctx context.Context, in *CheckInAttendeeRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
+	// Returns the public key tickets are signed with, for checking them
+	// offline.
+	GetTicketKey(ctx context.Context, in *GetTicketKeyRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
+	// Replaces the ticket types an event sells. Types given with an id are
+	// updated, those without are added and the rest are removed. Types that
+	// have been ordered cannot be removed, change currency or have their
+	// quota lowered below the number sold. Selling tickets makes the event
+	// require RSVP. Only masjid admins and imams may set ticket types.
+	SetTicketTypes(ctx context.Context, in *SetTicketTypesRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
+	// Orders tickets for an event, holding them while the caller pays at the
+	// order's checkout_url. Paying confirms the caller's RSVP. Free orders
+	// are paid at once.
+	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
+	// Returns one of the caller's orders, or any order for masjid admins and
+	// imams.
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
+	// Lists the caller's orders, or an event's for masjid admins and imams.
+	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
+	// Cancels a pending order, or refunds a paid one in full. Buyers may
+	// refund their orders until the event starts; masjid admins and imams
+	// may refund any order at any time.
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*StandardEventResponse, error)
 }
 
 type eventServiceClient struct {
@@ -95,6 +154,146 @@ func (c *eventServiceClient) ListEvents(ctx context.Context, in *ListEventsReque
 	return out, nil
 }
 
+func (c *eventServiceClient) ListWeekEvents(ctx context.Context, in *ListWeekEventsRequest, opts ...grpc.CallOption) (*StandardEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardEventResponse)
+	err := c.cc.Invoke(ctx, EventService_ListWeekEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RsvpEvent(ctx context.Context, in *RsvpEventRequest, opts ...grpc.CallOption) (*StandardEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardEventResponse)
+	err := c.cc.Invoke(ctx, EventService_RsvpEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CancelRsvp(ctx context.Context, in *CancelRsvpRequest, opts ...grpc.CallOption) (*StandardEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardEventResponse)
+	err := c.cc.Invoke(ctx, EventService_CancelRsvp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListEventAttendees(ctx context.Context, in *ListEventAttendeesRequest, opts ...grpc.CallOption) (*StandardEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardEventResponse)
+	err := c.cc.Invoke(ctx, EventService_ListEventAttendees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetMyRsvps(ctx context.Context, in *GetMyRsvpsRequest, opts ...grpc.CallOption) (*StandardEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardEventResponse)
+	err := c.cc.Invoke(ctx, EventService_GetMyRsvps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*StandardEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardEventResponse)
+	err := c.cc.Invoke(ctx, EventService_GetCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ResetCalendarFeed(ctx context.Context, in *ResetCalendarFeedRequest, opts ...grpc.CallOption) (*StandardEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardEventResponse)
+	err := c.cc.Invoke(ctx, EventService_ResetCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CheckInAttendee(ctx context.Context, in *CheckInAttendeeRequest, opts ...grpc.CallOption) (*StandardEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardEventResponse)
+	err := c.cc.Invoke(ctx, EventService_CheckInAttendee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetTicketKey(ctx context.Context, in *GetTicketKeyRequest, opts ...grpc.CallOption) (*StandardEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardEventResponse)
+	err := c.cc.Invoke(ctx, EventService_GetTicketKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetTicketTypes(ctx context.Context, in *SetTicketTypesRequest, opts ...grpc.CallOption) (*StandardEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardEventResponse)
+	err := c.cc.Invoke(ctx, EventService_SetTicketTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*StandardEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardEventResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*StandardEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardEventResponse)
+	err := c.cc.Invoke(ctx, EventService_GetOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*StandardEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardEventResponse)
+	err := c.cc.Invoke(ctx, EventService_ListOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*StandardEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardEventResponse)
+	err := c.cc.Invoke(ctx, EventService_CancelOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventServiceServer is the server API for EventService service.
 // All implementations must embed UnimplementedEventServiceServer
 // for forward compatibility.
@@ -104,6 +303,51 @@ type EventServiceServer interface {
 	DeleteEvent(context.Context, *DeleteEventRequest) (*StandardEventResponse, error)
 	GetEvent(context.Context, *GetEventRequest) (*StandardEventResponse, error)
 	ListEvents(context.Context, *ListEventsRequest) (*StandardEventResponse, error)
+	// Lists the occurrences of a masjid's events in the week, Monday to
+	// Sunday in the masjid's time zone, containing week_of.
+	ListWeekEvents(context.Context, *ListWeekEventsRequest) (*StandardEventResponse, error)
+	// Registers the caller for an event that requires RSVP. The caller is
+	// confirmed while places remain and waitlisted after that.
+	RsvpEvent(context.Context, *RsvpEventRequest) (*StandardEventResponse, error)
+	// Cancels an RSVP, confirming the first waitlisted RSVP into the place it
+	// frees.
+	CancelRsvp(context.Context, *CancelRsvpRequest) (*StandardEventResponse, error)
+	ListEventAttendees(context.Context, *ListEventAttendeesRequest) (*StandardEventResponse, error)
+	// Returns the caller's RSVPs with their events.
+	GetMyRsvps(context.Context, *GetMyRsvpsRequest) (*StandardEventResponse, error)
+	// Returns the iCalendar feed of a masjid's events, or of the events the
+	// caller has RSVPed to, creating it the first time.
+	GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*StandardEventResponse, error)
+	// Gives a calendar feed a new URL, so that the old one stops working.
+	// Only masjid admins and imams may reset a masjid's feed.
+	ResetCalendarFeed(context.Context, *ResetCalendarFeedRequest) (*StandardEventResponse, error)
+	// Checks in the holder of an RSVP ticket to an event, or to one
+	// occurrence of a recurring event by its occurrence ID. Each ticket is
+	// checked in once; scanning it again fails with ALREADY_EXISTS. Only
+	// masjid admins, imams and volunteers may check attendees in.
+	CheckInAttendee(context.Context, *CheckInAttendeeRequest) (*StandardEventResponse, error)
+	// Returns the public key tickets are signed with, for checking them
+	// offline.
+	GetTicketKey(context.Context, *GetTicketKeyRequest) (*StandardEventResponse, error)
+	// Replaces the ticket types an event sells. Types given with an id are
+	// updated, those without are added and the rest are removed. Types that
+	// have been ordered cannot be removed, change currency or have their
+	// quota lowered below the number sold. Selling tickets makes the event
+	// require RSVP. Only masjid admins and imams may set ticket types.
+	SetTicketTypes(context.Context, *SetTicketTypesRequest) (*StandardEventResponse, error)
+	// Orders tickets for an event, holding them while the caller pays at the
+	// order's checkout_url. Paying confirms the caller's RSVP. Free orders
+	// are paid at once.
+	CreateOrder(context.Context, *CreateOrderRequest) (*StandardEventResponse, error)
+	// Returns one of the caller's orders, or any order for masjid admins and
+	// imams.
+	GetOrder(context.Context, *GetOrderRequest) (*StandardEventResponse, error)
+	// Lists the caller's orders, or an event's for masjid admins and imams.
+	ListOrders(context.Context, *ListOrdersRequest) (*StandardEventResponse, error)
+	// Cancels a pending order, or refunds a paid one in full. Buyers may
+	// refund their orders until the event starts; masjid admins and imams
+	// may refund any order at any time.
+	CancelOrder(context.Context, *CancelOrderRequest) (*StandardEventResponse, error)
 	mustEmbedUnimplementedEventServiceServer()
 }
 
@@ -129,6 +373,48 @@ func (UnimplementedEventServiceServer) GetEvent(context.Context, *GetEventReques
 func (UnimplementedEventServiceServer) ListEvents(context.Context, *ListEventsRequest) (*StandardEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
 }
+func (UnimplementedEventServiceServer) ListWeekEvents(context.Context, *ListWeekEventsRequest) (*StandardEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWeekEvents not implemented")
+}
+func (UnimplementedEventServiceServer) RsvpEvent(context.Context, *RsvpEventRequest) (*StandardEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RsvpEvent not implemented")
+}
+func (UnimplementedEventServiceServer) CancelRsvp(context.Context, *CancelRsvpRequest) (*StandardEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRsvp not implemented")
+}
+func (UnimplementedEventServiceServer) ListEventAttendees(context.Context, *ListEventAttendeesRequest) (*StandardEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventAttendees not implemented")
+}
+func (UnimplementedEventServiceServer) GetMyRsvps(context.Context, *GetMyRsvpsRequest) (*StandardEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyRsvps not implemented")
+}
+func (UnimplementedEventServiceServer) GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*StandardEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeed not implemented")
+}
+func (UnimplementedEventServiceServer) ResetCalendarFeed(context.Context, *ResetCalendarFeedRequest) (*StandardEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCalendarFeed not implemented")
+}
+func (UnimplementedEventServiceServer) CheckInAttendee(context.Context, *CheckInAttendeeRequest) (*StandardEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInAttendee not implemented")
+}
+func (UnimplementedEventServiceServer) GetTicketKey(context.Context, *GetTicketKeyRequest) (*StandardEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicketKey not implemented")
+}
+func (UnimplementedEventServiceServer) SetTicketTypes(context.Context, *SetTicketTypesRequest) (*StandardEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTicketTypes not implemented")
+}
+func (UnimplementedEventServiceServer) CreateOrder(context.Context, *CreateOrderRequest) (*StandardEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
+}
+func (UnimplementedEventServiceServer) GetOrder(context.Context, *GetOrderRequest) (*StandardEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedEventServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*StandardEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (UnimplementedEventServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*StandardEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
 func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
 func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
 
@@ -240,6 +526,258 @@ func _EventService_ListEvents_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_ListWeekEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWeekEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListWeekEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListWeekEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListWeekEvents(ctx, req.(*ListWeekEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RsvpEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RsvpEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RsvpEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RsvpEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RsvpEvent(ctx, req.(*RsvpEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CancelRsvp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRsvpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CancelRsvp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CancelRsvp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CancelRsvp(ctx, req.(*CancelRsvpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListEventAttendees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventAttendeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListEventAttendees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListEventAttendees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListEventAttendees(ctx, req.(*ListEventAttendeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetMyRsvps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyRsvpsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetMyRsvps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetMyRsvps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetMyRsvps(ctx, req.(*GetMyRsvpsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetCalendarFeed(ctx, req.(*GetCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ResetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ResetCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ResetCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ResetCalendarFeed(ctx, req.(*ResetCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CheckInAttendee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInAttendeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CheckInAttendee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CheckInAttendee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CheckInAttendee(ctx, req.(*CheckInAttendeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetTicketKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetTicketKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetTicketKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetTicketKey(ctx, req.(*GetTicketKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SetTicketTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTicketTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetTicketTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SetTicketTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetTicketTypes(ctx, req.(*SetTicketTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateOrder(ctx, req.(*CreateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +805,62 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEvents",
 			Handler:    _EventService_ListEvents_Handler,
 		},
+		{
+			MethodName: "ListWeekEvents",
+			Handler:    _EventService_ListWeekEvents_Handler,
+		},
+		{
+			MethodName: "RsvpEvent",
+			Handler:    _EventService_RsvpEvent_Handler,
+		},
+		{
+			MethodName: "CancelRsvp",
+			Handler:    _EventService_CancelRsvp_Handler,
+		},
+		{
+			MethodName: "ListEventAttendees",
+			Handler:    _EventService_ListEventAttendees_Handler,
+		},
+		{
+			MethodName: "GetMyRsvps",
+			Handler:    _EventService_GetMyRsvps_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeed",
+			Handler:    _EventService_GetCalendarFeed_Handler,
+		},
+		{
+			MethodName: "ResetCalendarFeed",
+			Handler:    _EventService_ResetCalendarFeed_Handler,
+		},
+		{
+			MethodName: "CheckInAttendee",
+			Handler:    _EventService_CheckInAttendee_Handler,
+		},
+		{
+			MethodName: "GetTicketKey",
+			Handler:    _EventService_GetTicketKey_Handler,
+		},
+		{
+			MethodName: "SetTicketTypes",
+			Handler:    _EventService_SetTicketTypes_Handler,
+		},
+		{
+			MethodName: "CreateOrder",
+			Handler:    _EventService_CreateOrder_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _EventService_GetOrder_Handler,
+		},
+		{
+			MethodName: "ListOrders",
+			Handler:    _EventService_ListOrders_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _EventService_CancelOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "event_service.proto",
@@ -0,0 +1,154 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: hijri_date.proto
+
+package __
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A day of the Umm al-Qura Hijri calendar, shifted by the masjid's moon
+// sighting offset where one applies.
+type HijriDate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Year  int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// Month of the year, 1 for Muharram through 12 for Dhu al-Hijjah.
+	Month         int32  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day           int32  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	MonthName     string `protobuf:"bytes,4,opt,name=month_name,json=monthName,proto3" json:"month_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HijriDate) Reset() {
+	*x = HijriDate{}
+	mi := &file_hijri_date_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HijriDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HijriDate) ProtoMessage() {}
+
+func (x *HijriDate) ProtoReflect() protoreflect.Message {
+	mi := &file_hijri_date_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HijriDate.ProtoReflect.Descriptor instead.
+func (*HijriDate) Descriptor() ([]byte, []int) {
+	return file_hijri_date_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HijriDate) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *HijriDate) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *HijriDate) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *HijriDate) GetMonthName() string {
+	if x != nil {
+		return x.MonthName
+	}
+	return ""
+}
+
+var File_hijri_date_proto protoreflect.FileDescriptor
+
+const file_hijri_date_proto_rawDesc = "" +
+	"\n" +
+	"\x10hijri_date.proto\x12\tlimestone\"f\n" +
+	"\tHijriDate\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x10\n" +
+	"\x03day\x18\x03 \x01(\x05R\x03day\x12\x1d\n" +
+	"\n" +
+	"month_name\x18\x04 \x01(\tR\tmonthNameBf\n" +
+	"\rcom.limestoneB\x0eHijriDateProtoP\x01Z\x01.\xa2\x02\x03LXX\xaa\x02\tLimestone\xca\x02\tLimestone\xe2\x02\x15Limestone\\GPBMetadata\xea\x02\tLimestoneb\x06proto3"
+
+var (
+	file_hijri_date_proto_rawDescOnce sync.Once
+	file_hijri_date_proto_rawDescData []byte
+)
+
+func file_hijri_date_proto_rawDescGZIP() []byte {
+	file_hijri_date_proto_rawDescOnce.Do(func() {
+		file_hijri_date_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hijri_date_proto_rawDesc), len(file_hijri_date_proto_rawDesc)))
+	})
+	return file_hijri_date_proto_rawDescData
+}
+
+var file_hijri_date_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_hijri_date_proto_goTypes = []any{
+	(*HijriDate)(nil), // 0: limestone.HijriDate
+}
+var file_hijri_date_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hijri_date_proto_init() }
+func file_hijri_date_proto_init() {
+	if File_hijri_date_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hijri_date_proto_rawDesc), len(file_hijri_date_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hijri_date_proto_goTypes,
+		DependencyIndexes: file_hijri_date_proto_depIdxs,
+		MessageInfos:      file_hijri_date_proto_msgTypes,
+	}.Build()
+	File_hijri_date_proto = out.File
+	file_hijri_date_proto_goTypes = nil
+	file_hijri_date_proto_depIdxs = nil
+}
@@ -0,0 +1,1031 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: jumuah_service.proto
+
+package __
+
+import (
+	_ "google.golang.org/genproto/googleapis/rpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PrayerSlot_SlotType int32
+
+const (
+	PrayerSlot_JUMUAH      PrayerSlot_SlotType = 0
+	PrayerSlot_EID_AL_FITR PrayerSlot_SlotType = 1
+	PrayerSlot_EID_AL_ADHA PrayerSlot_SlotType = 2
+)
+
+// Enum value maps for PrayerSlot_SlotType.
+var (
+	PrayerSlot_SlotType_name = map[int32]string{
+		0: "JUMUAH",
+		1: "EID_AL_FITR",
+		2: "EID_AL_ADHA",
+	}
+	PrayerSlot_SlotType_value = map[string]int32{
+		"JUMUAH":      0,
+		"EID_AL_FITR": 1,
+		"EID_AL_ADHA": 2,
+	}
+)
+
+func (x PrayerSlot_SlotType) Enum() *PrayerSlot_SlotType {
+	p := new(PrayerSlot_SlotType)
+	*p = x
+	return p
+}
+
+func (x PrayerSlot_SlotType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrayerSlot_SlotType) Descriptor() protoreflect.EnumDescriptor {
+	return file_jumuah_service_proto_enumTypes[0].Descriptor()
+}
+
+func (PrayerSlot_SlotType) Type() protoreflect.EnumType {
+	return &file_jumuah_service_proto_enumTypes[0]
+}
+
+func (x PrayerSlot_SlotType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrayerSlot_SlotType.Descriptor instead.
+func (PrayerSlot_SlotType) EnumDescriptor() ([]byte, []int) {
+	return file_jumuah_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type StandardJumuahResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Status  string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*StandardJumuahResponse_PrayerSlot
+	//	*StandardJumuahResponse_ListPrayerSlotsResponse
+	//	*StandardJumuahResponse_DeletePrayerSlotResponse
+	//	*StandardJumuahResponse_NextJumuahResponse
+	Data          isStandardJumuahResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StandardJumuahResponse) Reset() {
+	*x = StandardJumuahResponse{}
+	mi := &file_jumuah_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StandardJumuahResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandardJumuahResponse) ProtoMessage() {}
+
+func (x *StandardJumuahResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jumuah_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandardJumuahResponse.ProtoReflect.Descriptor instead.
+func (*StandardJumuahResponse) Descriptor() ([]byte, []int) {
+	return file_jumuah_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StandardJumuahResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StandardJumuahResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StandardJumuahResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StandardJumuahResponse) GetData() isStandardJumuahResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *StandardJumuahResponse) GetPrayerSlot() *PrayerSlot {
+	if x != nil {
+		if x, ok := x.Data.(*StandardJumuahResponse_PrayerSlot); ok {
+			return x.PrayerSlot
+		}
+	}
+	return nil
+}
+
+func (x *StandardJumuahResponse) GetListPrayerSlotsResponse() *ListPrayerSlotsResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardJumuahResponse_ListPrayerSlotsResponse); ok {
+			return x.ListPrayerSlotsResponse
+		}
+	}
+	return nil
+}
+
+func (x *StandardJumuahResponse) GetDeletePrayerSlotResponse() *DeletePrayerSlotResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardJumuahResponse_DeletePrayerSlotResponse); ok {
+			return x.DeletePrayerSlotResponse
+		}
+	}
+	return nil
+}
+
+func (x *StandardJumuahResponse) GetNextJumuahResponse() *NextJumuahResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardJumuahResponse_NextJumuahResponse); ok {
+			return x.NextJumuahResponse
+		}
+	}
+	return nil
+}
+
+type isStandardJumuahResponse_Data interface {
+	isStandardJumuahResponse_Data()
+}
+
+type StandardJumuahResponse_PrayerSlot struct {
+	PrayerSlot *PrayerSlot `protobuf:"bytes,4,opt,name=prayer_slot,json=prayerSlot,proto3,oneof"`
+}
+
+type StandardJumuahResponse_ListPrayerSlotsResponse struct {
+	ListPrayerSlotsResponse *ListPrayerSlotsResponse `protobuf:"bytes,5,opt,name=list_prayer_slots_response,json=listPrayerSlotsResponse,proto3,oneof"`
+}
+
+type StandardJumuahResponse_DeletePrayerSlotResponse struct {
+	DeletePrayerSlotResponse *DeletePrayerSlotResponse `protobuf:"bytes,6,opt,name=delete_prayer_slot_response,json=deletePrayerSlotResponse,proto3,oneof"`
+}
+
+type StandardJumuahResponse_NextJumuahResponse struct {
+	NextJumuahResponse *NextJumuahResponse `protobuf:"bytes,7,opt,name=next_jumuah_response,json=nextJumuahResponse,proto3,oneof"`
+}
+
+func (*StandardJumuahResponse_PrayerSlot) isStandardJumuahResponse_Data() {}
+
+func (*StandardJumuahResponse_ListPrayerSlotsResponse) isStandardJumuahResponse_Data() {}
+
+func (*StandardJumuahResponse_DeletePrayerSlotResponse) isStandardJumuahResponse_Data() {}
+
+func (*StandardJumuahResponse_NextJumuahResponse) isStandardJumuahResponse_Data() {}
+
+type PrayerSlot struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MasjidId string                 `protobuf:"bytes,2,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	Type     PrayerSlot_SlotType    `protobuf:"varint,3,opt,name=type,proto3,enum=limestone.PrayerSlot_SlotType" json:"type,omitempty"`
+	// Local clock time in 24-hour HH:MM format at which the khutbah starts.
+	StartTime string `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// YYYY-MM-DD date of an Eid prayer. Unused for Jumu'ah.
+	Date string `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	// Inclusive YYYY-MM-DD range a weekly Jumu'ah shift runs for, e.g. one
+	// season. An empty bound is open-ended. Unused for Eid.
+	StartDate string `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	KhateebId string `protobuf:"bytes,8,opt,name=khateeb_id,json=khateebId,proto3" json:"khateeb_id,omitempty"`
+	// Language of the khutbah, e.g. "en" or "Arabic and Urdu".
+	Language string `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"`
+	// Venue used instead of the masjid, e.g. a park or hall for Eid.
+	LocationOverride string `protobuf:"bytes,10,opt,name=location_override,json=locationOverride,proto3" json:"location_override,omitempty"`
+	// Maximum number of worshippers. 0 means no limit.
+	Capacity      int32                  `protobuf:"varint,11,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrayerSlot) Reset() {
+	*x = PrayerSlot{}
+	mi := &file_jumuah_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrayerSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrayerSlot) ProtoMessage() {}
+
+func (x *PrayerSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_jumuah_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrayerSlot.ProtoReflect.Descriptor instead.
+func (*PrayerSlot) Descriptor() ([]byte, []int) {
+	return file_jumuah_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PrayerSlot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PrayerSlot) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *PrayerSlot) GetType() PrayerSlot_SlotType {
+	if x != nil {
+		return x.Type
+	}
+	return PrayerSlot_JUMUAH
+}
+
+func (x *PrayerSlot) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *PrayerSlot) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PrayerSlot) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *PrayerSlot) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *PrayerSlot) GetKhateebId() string {
+	if x != nil {
+		return x.KhateebId
+	}
+	return ""
+}
+
+func (x *PrayerSlot) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *PrayerSlot) GetLocationOverride() string {
+	if x != nil {
+		return x.LocationOverride
+	}
+	return ""
+}
+
+func (x *PrayerSlot) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *PrayerSlot) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *PrayerSlot) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type CreatePrayerSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	Slot          *PrayerSlot            `protobuf:"bytes,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePrayerSlotRequest) Reset() {
+	*x = CreatePrayerSlotRequest{}
+	mi := &file_jumuah_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePrayerSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePrayerSlotRequest) ProtoMessage() {}
+
+func (x *CreatePrayerSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jumuah_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePrayerSlotRequest.ProtoReflect.Descriptor instead.
+func (*CreatePrayerSlotRequest) Descriptor() ([]byte, []int) {
+	return file_jumuah_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreatePrayerSlotRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *CreatePrayerSlotRequest) GetSlot() *PrayerSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+type UpdatePrayerSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Slot          *PrayerSlot            `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePrayerSlotRequest) Reset() {
+	*x = UpdatePrayerSlotRequest{}
+	mi := &file_jumuah_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrayerSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrayerSlotRequest) ProtoMessage() {}
+
+func (x *UpdatePrayerSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jumuah_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrayerSlotRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePrayerSlotRequest) Descriptor() ([]byte, []int) {
+	return file_jumuah_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdatePrayerSlotRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *UpdatePrayerSlotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePrayerSlotRequest) GetSlot() *PrayerSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+type GetPrayerSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrayerSlotRequest) Reset() {
+	*x = GetPrayerSlotRequest{}
+	mi := &file_jumuah_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrayerSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrayerSlotRequest) ProtoMessage() {}
+
+func (x *GetPrayerSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jumuah_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrayerSlotRequest.ProtoReflect.Descriptor instead.
+func (*GetPrayerSlotRequest) Descriptor() ([]byte, []int) {
+	return file_jumuah_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPrayerSlotRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *GetPrayerSlotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeletePrayerSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePrayerSlotRequest) Reset() {
+	*x = DeletePrayerSlotRequest{}
+	mi := &file_jumuah_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePrayerSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePrayerSlotRequest) ProtoMessage() {}
+
+func (x *DeletePrayerSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jumuah_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePrayerSlotRequest.ProtoReflect.Descriptor instead.
+func (*DeletePrayerSlotRequest) Descriptor() ([]byte, []int) {
+	return file_jumuah_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeletePrayerSlotRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *DeletePrayerSlotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeletePrayerSlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePrayerSlotResponse) Reset() {
+	*x = DeletePrayerSlotResponse{}
+	mi := &file_jumuah_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePrayerSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePrayerSlotResponse) ProtoMessage() {}
+
+func (x *DeletePrayerSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jumuah_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePrayerSlotResponse.ProtoReflect.Descriptor instead.
+func (*DeletePrayerSlotResponse) Descriptor() ([]byte, []int) {
+	return file_jumuah_service_proto_rawDescGZIP(), []int{6}
+}
+
+type ListPrayerSlotsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	// Restricts the result to one slot type when set.
+	Type          *PrayerSlot_SlotType `protobuf:"varint,2,opt,name=type,proto3,enum=limestone.PrayerSlot_SlotType,oneof" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPrayerSlotsRequest) Reset() {
+	*x = ListPrayerSlotsRequest{}
+	mi := &file_jumuah_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPrayerSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPrayerSlotsRequest) ProtoMessage() {}
+
+func (x *ListPrayerSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jumuah_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPrayerSlotsRequest.ProtoReflect.Descriptor instead.
+func (*ListPrayerSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_jumuah_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListPrayerSlotsRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *ListPrayerSlotsRequest) GetType() PrayerSlot_SlotType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return PrayerSlot_JUMUAH
+}
+
+type ListPrayerSlotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         []*PrayerSlot          `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPrayerSlotsResponse) Reset() {
+	*x = ListPrayerSlotsResponse{}
+	mi := &file_jumuah_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPrayerSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPrayerSlotsResponse) ProtoMessage() {}
+
+func (x *ListPrayerSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jumuah_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPrayerSlotsResponse.ProtoReflect.Descriptor instead.
+func (*ListPrayerSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_jumuah_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListPrayerSlotsResponse) GetSlots() []*PrayerSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type GetNextJumuahRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNextJumuahRequest) Reset() {
+	*x = GetNextJumuahRequest{}
+	mi := &file_jumuah_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextJumuahRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextJumuahRequest) ProtoMessage() {}
+
+func (x *GetNextJumuahRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jumuah_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextJumuahRequest.ProtoReflect.Descriptor instead.
+func (*GetNextJumuahRequest) Descriptor() ([]byte, []int) {
+	return file_jumuah_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetNextJumuahRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+type ScheduledPrayerSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          *PrayerSlot            `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledPrayerSlot) Reset() {
+	*x = ScheduledPrayerSlot{}
+	mi := &file_jumuah_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledPrayerSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledPrayerSlot) ProtoMessage() {}
+
+func (x *ScheduledPrayerSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_jumuah_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledPrayerSlot.ProtoReflect.Descriptor instead.
+func (*ScheduledPrayerSlot) Descriptor() ([]byte, []int) {
+	return file_jumuah_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ScheduledPrayerSlot) GetSlot() *PrayerSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *ScheduledPrayerSlot) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+type NextJumuahResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM-DD date of the Friday, in the masjid's time zone.
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Slots         []*ScheduledPrayerSlot `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextJumuahResponse) Reset() {
+	*x = NextJumuahResponse{}
+	mi := &file_jumuah_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextJumuahResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextJumuahResponse) ProtoMessage() {}
+
+func (x *NextJumuahResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jumuah_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextJumuahResponse.ProtoReflect.Descriptor instead.
+func (*NextJumuahResponse) Descriptor() ([]byte, []int) {
+	return file_jumuah_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NextJumuahResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *NextJumuahResponse) GetSlots() []*ScheduledPrayerSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+var File_jumuah_service_proto protoreflect.FileDescriptor
+
+const file_jumuah_service_proto_rawDesc = "" +
+	"\n" +
+	"\x14jumuah_service.proto\x12\tlimestone\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbc\x03\n" +
+	"\x16StandardJumuahResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x128\n" +
+	"\vprayer_slot\x18\x04 \x01(\v2\x15.limestone.PrayerSlotH\x00R\n" +
+	"prayerSlot\x12a\n" +
+	"\x1alist_prayer_slots_response\x18\x05 \x01(\v2\".limestone.ListPrayerSlotsResponseH\x00R\x17listPrayerSlotsResponse\x12d\n" +
+	"\x1bdelete_prayer_slot_response\x18\x06 \x01(\v2#.limestone.DeletePrayerSlotResponseH\x00R\x18deletePrayerSlotResponse\x12Q\n" +
+	"\x14next_jumuah_response\x18\a \x01(\v2\x1d.limestone.NextJumuahResponseH\x00R\x12nextJumuahResponseB\x06\n" +
+	"\x04data\"\x92\x04\n" +
+	"\n" +
+	"PrayerSlot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tmasjid_id\x18\x02 \x01(\tR\bmasjidId\x122\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1e.limestone.PrayerSlot.SlotTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\tR\tstartTime\x12\x12\n" +
+	"\x04date\x18\x05 \x01(\tR\x04date\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\a \x01(\tR\aendDate\x12\x1d\n" +
+	"\n" +
+	"khateeb_id\x18\b \x01(\tR\tkhateebId\x12\x1a\n" +
+	"\blanguage\x18\t \x01(\tR\blanguage\x12+\n" +
+	"\x11location_override\x18\n" +
+	" \x01(\tR\x10locationOverride\x12\x1a\n" +
+	"\bcapacity\x18\v \x01(\x05R\bcapacity\x12;\n" +
+	"\vcreate_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"8\n" +
+	"\bSlotType\x12\n" +
+	"\n" +
+	"\x06JUMUAH\x10\x00\x12\x0f\n" +
+	"\vEID_AL_FITR\x10\x01\x12\x0f\n" +
+	"\vEID_AL_ADHA\x10\x02\"k\n" +
+	"\x17CreatePrayerSlotRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12.\n" +
+	"\x04slot\x18\x02 \x01(\v2\x15.limestone.PrayerSlotB\x03\xe0A\x02R\x04slot\"\x80\x01\n" +
+	"\x17UpdatePrayerSlotRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12\x13\n" +
+	"\x02id\x18\x02 \x01(\tB\x03\xe0A\x02R\x02id\x12.\n" +
+	"\x04slot\x18\x03 \x01(\v2\x15.limestone.PrayerSlotB\x03\xe0A\x02R\x04slot\"M\n" +
+	"\x14GetPrayerSlotRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12\x13\n" +
+	"\x02id\x18\x02 \x01(\tB\x03\xe0A\x02R\x02id\"P\n" +
+	"\x17DeletePrayerSlotRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12\x13\n" +
+	"\x02id\x18\x02 \x01(\tB\x03\xe0A\x02R\x02id\"\x1a\n" +
+	"\x18DeletePrayerSlotResponse\"|\n" +
+	"\x16ListPrayerSlotsRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x127\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1e.limestone.PrayerSlot.SlotTypeH\x00R\x04type\x88\x01\x01B\a\n" +
+	"\x05_type\"F\n" +
+	"\x17ListPrayerSlotsResponse\x12+\n" +
+	"\x05slots\x18\x01 \x03(\v2\x15.limestone.PrayerSlotR\x05slots\"8\n" +
+	"\x14GetNextJumuahRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\"{\n" +
+	"\x13ScheduledPrayerSlot\x12)\n" +
+	"\x04slot\x18\x01 \x01(\v2\x15.limestone.PrayerSlotR\x04slot\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\"^\n" +
+	"\x12NextJumuahResponse\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x124\n" +
+	"\x05slots\x18\x02 \x03(\v2\x1e.limestone.ScheduledPrayerSlotR\x05slots2\xac\a\n" +
+	"\rJumuahService\x12\x9d\x01\n" +
+	"\x10CreatePrayerSlot\x12\".limestone.CreatePrayerSlotRequest\x1a!.limestone.StandardJumuahResponse\"B\xdaA\x0emasjid_id,slot\x82\xd3\xe4\x93\x02+:\x04slot\"#/v1/masjid/{masjid_id}/prayer_slots\x12\xa5\x01\n" +
+	"\x10UpdatePrayerSlot\x12\".limestone.UpdatePrayerSlotRequest\x1a!.limestone.StandardJumuahResponse\"J\xdaA\x11masjid_id,id,slot\x82\xd3\xe4\x93\x020:\x04slot2(/v1/masjid/{masjid_id}/prayer_slots/{id}\x12\x94\x01\n" +
+	"\rGetPrayerSlot\x12\x1f.limestone.GetPrayerSlotRequest\x1a!.limestone.StandardJumuahResponse\"?\xdaA\fmasjid_id,id\x82\xd3\xe4\x93\x02*\x12(/v1/masjid/{masjid_id}/prayer_slots/{id}\x12\x9a\x01\n" +
+	"\x10DeletePrayerSlot\x12\".limestone.DeletePrayerSlotRequest\x1a!.limestone.StandardJumuahResponse\"?\xdaA\fmasjid_id,id\x82\xd3\xe4\x93\x02**(/v1/masjid/{masjid_id}/prayer_slots/{id}\x12\x90\x01\n" +
+	"\x0fListPrayerSlots\x12!.limestone.ListPrayerSlotsRequest\x1a!.limestone.StandardJumuahResponse\"7\xdaA\tmasjid_id\x82\xd3\xe4\x93\x02%\x12#/v1/masjid/{masjid_id}/prayer_slots\x12\x8b\x01\n" +
+	"\rGetNextJumuah\x12\x1f.limestone.GetNextJumuahRequest\x1a!.limestone.StandardJumuahResponse\"6\xdaA\tmasjid_id\x82\xd3\xe4\x93\x02$\x12\"/v1/masjid/{masjid_id}/jumuah/nextBj\n" +
+	"\rcom.limestoneB\x12JumuahServiceProtoP\x01Z\x01.\xa2\x02\x03LXX\xaa\x02\tLimestone\xca\x02\tLimestone\xe2\x02\x15Limestone\\GPBMetadata\xea\x02\tLimestoneb\x06proto3"
+
+var (
+	file_jumuah_service_proto_rawDescOnce sync.Once
+	file_jumuah_service_proto_rawDescData []byte
+)
+
+func file_jumuah_service_proto_rawDescGZIP() []byte {
+	file_jumuah_service_proto_rawDescOnce.Do(func() {
+		file_jumuah_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_jumuah_service_proto_rawDesc), len(file_jumuah_service_proto_rawDesc)))
+	})
+	return file_jumuah_service_proto_rawDescData
+}
+
+var file_jumuah_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_jumuah_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_jumuah_service_proto_goTypes = []any{
+	(PrayerSlot_SlotType)(0),         // 0: limestone.PrayerSlot.SlotType
+	(*StandardJumuahResponse)(nil),   // 1: limestone.StandardJumuahResponse
+	(*PrayerSlot)(nil),               // 2: limestone.PrayerSlot
+	(*CreatePrayerSlotRequest)(nil),  // 3: limestone.CreatePrayerSlotRequest
+	(*UpdatePrayerSlotRequest)(nil),  // 4: limestone.UpdatePrayerSlotRequest
+	(*GetPrayerSlotRequest)(nil),     // 5: limestone.GetPrayerSlotRequest
+	(*DeletePrayerSlotRequest)(nil),  // 6: limestone.DeletePrayerSlotRequest
+	(*DeletePrayerSlotResponse)(nil), // 7: limestone.DeletePrayerSlotResponse
+	(*ListPrayerSlotsRequest)(nil),   // 8: limestone.ListPrayerSlotsRequest
+	(*ListPrayerSlotsResponse)(nil),  // 9: limestone.ListPrayerSlotsResponse
+	(*GetNextJumuahRequest)(nil),     // 10: limestone.GetNextJumuahRequest
+	(*ScheduledPrayerSlot)(nil),      // 11: limestone.ScheduledPrayerSlot
+	(*NextJumuahResponse)(nil),       // 12: limestone.NextJumuahResponse
+	(*timestamppb.Timestamp)(nil),    // 13: google.protobuf.Timestamp
+}
+var file_jumuah_service_proto_depIdxs = []int32{
+	2,  // 0: limestone.StandardJumuahResponse.prayer_slot:type_name -> limestone.PrayerSlot
+	9,  // 1: limestone.StandardJumuahResponse.list_prayer_slots_response:type_name -> limestone.ListPrayerSlotsResponse
+	7,  // 2: limestone.StandardJumuahResponse.delete_prayer_slot_response:type_name -> limestone.DeletePrayerSlotResponse
+	12, // 3: limestone.StandardJumuahResponse.next_jumuah_response:type_name -> limestone.NextJumuahResponse
+	0,  // 4: limestone.PrayerSlot.type:type_name -> limestone.PrayerSlot.SlotType
+	13, // 5: limestone.PrayerSlot.create_time:type_name -> google.protobuf.Timestamp
+	13, // 6: limestone.PrayerSlot.update_time:type_name -> google.protobuf.Timestamp
+	2,  // 7: limestone.CreatePrayerSlotRequest.slot:type_name -> limestone.PrayerSlot
+	2,  // 8: limestone.UpdatePrayerSlotRequest.slot:type_name -> limestone.PrayerSlot
+	0,  // 9: limestone.ListPrayerSlotsRequest.type:type_name -> limestone.PrayerSlot.SlotType
+	2,  // 10: limestone.ListPrayerSlotsResponse.slots:type_name -> limestone.PrayerSlot
+	2,  // 11: limestone.ScheduledPrayerSlot.slot:type_name -> limestone.PrayerSlot
+	13, // 12: limestone.ScheduledPrayerSlot.start_time:type_name -> google.protobuf.Timestamp
+	11, // 13: limestone.NextJumuahResponse.slots:type_name -> limestone.ScheduledPrayerSlot
+	3,  // 14: limestone.JumuahService.CreatePrayerSlot:input_type -> limestone.CreatePrayerSlotRequest
+	4,  // 15: limestone.JumuahService.UpdatePrayerSlot:input_type -> limestone.UpdatePrayerSlotRequest
+	5,  // 16: limestone.JumuahService.GetPrayerSlot:input_type -> limestone.GetPrayerSlotRequest
+	6,  // 17: limestone.JumuahService.DeletePrayerSlot:input_type -> limestone.DeletePrayerSlotRequest
+	8,  // 18: limestone.JumuahService.ListPrayerSlots:input_type -> limestone.ListPrayerSlotsRequest
+	10, // 19: limestone.JumuahService.GetNextJumuah:input_type -> limestone.GetNextJumuahRequest
+	1,  // 20: limestone.JumuahService.CreatePrayerSlot:output_type -> limestone.StandardJumuahResponse
+	1,  // 21: limestone.JumuahService.UpdatePrayerSlot:output_type -> limestone.StandardJumuahResponse
+	1,  // 22: limestone.JumuahService.GetPrayerSlot:output_type -> limestone.StandardJumuahResponse
+	1,  // 23: limestone.JumuahService.DeletePrayerSlot:output_type -> limestone.StandardJumuahResponse
+	1,  // 24: limestone.JumuahService.ListPrayerSlots:output_type -> limestone.StandardJumuahResponse
+	1,  // 25: limestone.JumuahService.GetNextJumuah:output_type -> limestone.StandardJumuahResponse
+	20, // [20:26] is the sub-list for method output_type
+	14, // [14:20] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_jumuah_service_proto_init() }
+func file_jumuah_service_proto_init() {
+	if File_jumuah_service_proto != nil {
+		return
+	}
+	file_jumuah_service_proto_msgTypes[0].OneofWrappers = []any{
+		(*StandardJumuahResponse_PrayerSlot)(nil),
+		(*StandardJumuahResponse_ListPrayerSlotsResponse)(nil),
+		(*StandardJumuahResponse_DeletePrayerSlotResponse)(nil),
+		(*StandardJumuahResponse_NextJumuahResponse)(nil),
+	}
+	file_jumuah_service_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jumuah_service_proto_rawDesc), len(file_jumuah_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_jumuah_service_proto_goTypes,
+		DependencyIndexes: file_jumuah_service_proto_depIdxs,
+		EnumInfos:         file_jumuah_service_proto_enumTypes,
+		MessageInfos:      file_jumuah_service_proto_msgTypes,
+	}.Build()
+	File_jumuah_service_proto = out.File
+	file_jumuah_service_proto_goTypes = nil
+	file_jumuah_service_proto_depIdxs = nil
+}
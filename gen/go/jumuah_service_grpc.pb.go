@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: jumuah_service.proto
+
+package __
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	JumuahService_CreatePrayerSlot_FullMethodName = "/limestone.JumuahService/CreatePrayerSlot"
+	JumuahService_UpdatePrayerSlot_FullMethodName = "/limestone.JumuahService/UpdatePrayerSlot"
+	JumuahService_GetPrayerSlot_FullMethodName    = "/limestone.JumuahService/GetPrayerSlot"
+	JumuahService_DeletePrayerSlot_FullMethodName = "/limestone.JumuahService/DeletePrayerSlot"
+	JumuahService_ListPrayerSlots_FullMethodName  = "/limestone.JumuahService/ListPrayerSlots"
+	JumuahService_GetNextJumuah_FullMethodName    = "/limestone.JumuahService/GetNextJumuah"
+)
+
+// JumuahServiceClient is the client API for JumuahService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type JumuahServiceClient interface {
+	CreatePrayerSlot(ctx context.Context, in *CreatePrayerSlotRequest, opts ...grpc.CallOption) (*StandardJumuahResponse, error)
+	UpdatePrayerSlot(ctx context.Context, in *UpdatePrayerSlotRequest, opts ...grpc.CallOption) (*StandardJumuahResponse, error)
+	GetPrayerSlot(ctx context.Context, in *GetPrayerSlotRequest, opts ...grpc.CallOption) (*StandardJumuahResponse, error)
+	DeletePrayerSlot(ctx context.Context, in *DeletePrayerSlotRequest, opts ...grpc.CallOption) (*StandardJumuahResponse, error)
+	ListPrayerSlots(ctx context.Context, in *ListPrayerSlotsRequest, opts ...grpc.CallOption) (*StandardJumuahResponse, error)
+	GetNextJumuah(ctx context.Context, in *GetNextJumuahRequest, opts ...grpc.CallOption) (*StandardJumuahResponse, error)
+}
+
+type jumuahServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJumuahServiceClient(cc grpc.ClientConnInterface) JumuahServiceClient {
+	return &jumuahServiceClient{cc}
+}
+
+func (c *jumuahServiceClient) CreatePrayerSlot(ctx context.Context, in *CreatePrayerSlotRequest, opts ...grpc.CallOption) (*StandardJumuahResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardJumuahResponse)
+	err := c.cc.Invoke(ctx, JumuahService_CreatePrayerSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jumuahServiceClient) UpdatePrayerSlot(ctx context.Context, in *UpdatePrayerSlotRequest, opts ...grpc.CallOption) (*StandardJumuahResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardJumuahResponse)
+	err := c.cc.Invoke(ctx, JumuahService_UpdatePrayerSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jumuahServiceClient) GetPrayerSlot(ctx context.Context, in *GetPrayerSlotRequest, opts ...grpc.CallOption) (*StandardJumuahResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardJumuahResponse)
+	err := c.cc.Invoke(ctx, JumuahService_GetPrayerSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jumuahServiceClient) DeletePrayerSlot(ctx context.Context, in *DeletePrayerSlotRequest, opts ...grpc.CallOption) (*StandardJumuahResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardJumuahResponse)
+	err := c.cc.Invoke(ctx, JumuahService_DeletePrayerSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jumuahServiceClient) ListPrayerSlots(ctx context.Context, in *ListPrayerSlotsRequest, opts ...grpc.CallOption) (*StandardJumuahResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardJumuahResponse)
+	err := c.cc.Invoke(ctx, JumuahService_ListPrayerSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jumuahServiceClient) GetNextJumuah(ctx context.Context, in *GetNextJumuahRequest, opts ...grpc.CallOption) (*StandardJumuahResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardJumuahResponse)
+	err := c.cc.Invoke(ctx, JumuahService_GetNextJumuah_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JumuahServiceServer is the server API for JumuahService service.
+// All implementations must embed UnimplementedJumuahServiceServer
+// for forward compatibility.
+type JumuahServiceServer interface {
+	CreatePrayerSlot(context.Context, *CreatePrayerSlotRequest) (*StandardJumuahResponse, error)
+	UpdatePrayerSlot(context.Context, *UpdatePrayerSlotRequest) (*StandardJumuahResponse, error)
+	GetPrayerSlot(context.Context, *GetPrayerSlotRequest) (*StandardJumuahResponse, error)
+	DeletePrayerSlot(context.Context, *DeletePrayerSlotRequest) (*StandardJumuahResponse, error)
+	ListPrayerSlots(context.Context, *ListPrayerSlotsRequest) (*StandardJumuahResponse, error)
+	GetNextJumuah(context.Context, *GetNextJumuahRequest) (*StandardJumuahResponse, error)
+	mustEmbedUnimplementedJumuahServiceServer()
+}
+
+// UnimplementedJumuahServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedJumuahServiceServer struct{}
+
+func (UnimplementedJumuahServiceServer) CreatePrayerSlot(context.Context, *CreatePrayerSlotRequest) (*StandardJumuahResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePrayerSlot not implemented")
+}
+func (UnimplementedJumuahServiceServer) UpdatePrayerSlot(context.Context, *UpdatePrayerSlotRequest) (*StandardJumuahResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrayerSlot not implemented")
+}
+func (UnimplementedJumuahServiceServer) GetPrayerSlot(context.Context, *GetPrayerSlotRequest) (*StandardJumuahResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrayerSlot not implemented")
+}
+func (UnimplementedJumuahServiceServer) DeletePrayerSlot(context.Context, *DeletePrayerSlotRequest) (*StandardJumuahResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePrayerSlot not implemented")
+}
+func (UnimplementedJumuahServiceServer) ListPrayerSlots(context.Context, *ListPrayerSlotsRequest) (*StandardJumuahResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPrayerSlots not implemented")
+}
+func (UnimplementedJumuahServiceServer) GetNextJumuah(context.Context, *GetNextJumuahRequest) (*StandardJumuahResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextJumuah not implemented")
+}
+func (UnimplementedJumuahServiceServer) mustEmbedUnimplementedJumuahServiceServer() {}
+func (UnimplementedJumuahServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeJumuahServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JumuahServiceServer will
+// result in compilation errors.
+type UnsafeJumuahServiceServer interface {
+	mustEmbedUnimplementedJumuahServiceServer()
+}
+
+func RegisterJumuahServiceServer(s grpc.ServiceRegistrar, srv JumuahServiceServer) {
+	// If the following call pancis, it indicates UnimplementedJumuahServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&JumuahService_ServiceDesc, srv)
+}
+
+func _JumuahService_CreatePrayerSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePrayerSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JumuahServiceServer).CreatePrayerSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JumuahService_CreatePrayerSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JumuahServiceServer).CreatePrayerSlot(ctx, req.(*CreatePrayerSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JumuahService_UpdatePrayerSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePrayerSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JumuahServiceServer).UpdatePrayerSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JumuahService_UpdatePrayerSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JumuahServiceServer).UpdatePrayerSlot(ctx, req.(*UpdatePrayerSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JumuahService_GetPrayerSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrayerSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JumuahServiceServer).GetPrayerSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JumuahService_GetPrayerSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JumuahServiceServer).GetPrayerSlot(ctx, req.(*GetPrayerSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JumuahService_DeletePrayerSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePrayerSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JumuahServiceServer).DeletePrayerSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JumuahService_DeletePrayerSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JumuahServiceServer).DeletePrayerSlot(ctx, req.(*DeletePrayerSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JumuahService_ListPrayerSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPrayerSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JumuahServiceServer).ListPrayerSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JumuahService_ListPrayerSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JumuahServiceServer).ListPrayerSlots(ctx, req.(*ListPrayerSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JumuahService_GetNextJumuah_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNextJumuahRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JumuahServiceServer).GetNextJumuah(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JumuahService_GetNextJumuah_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JumuahServiceServer).GetNextJumuah(ctx, req.(*GetNextJumuahRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JumuahService_ServiceDesc is the grpc.ServiceDesc for JumuahService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JumuahService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "limestone.JumuahService",
+	HandlerType: (*JumuahServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePrayerSlot",
+			Handler:    _JumuahService_CreatePrayerSlot_Handler,
+		},
+		{
+			MethodName: "UpdatePrayerSlot",
+			Handler:    _JumuahService_UpdatePrayerSlot_Handler,
+		},
+		{
+			MethodName: "GetPrayerSlot",
+			Handler:    _JumuahService_GetPrayerSlot_Handler,
+		},
+		{
+			MethodName: "DeletePrayerSlot",
+			Handler:    _JumuahService_DeletePrayerSlot_Handler,
+		},
+		{
+			MethodName: "ListPrayerSlots",
+			Handler:    _JumuahService_ListPrayerSlots_Handler,
+		},
+		{
+			MethodName: "GetNextJumuah",
+			Handler:    _JumuahService_GetNextJumuah_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "jumuah_service.proto",
+}
@@ -23,6 +23,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Prayer int32
+
+const (
+	Prayer_PRAYER_UNSPECIFIED Prayer = 0
+	Prayer_FAJR               Prayer = 1
+	Prayer_DHUHR              Prayer = 2
+	Prayer_ASR                Prayer = 3
+	Prayer_MAGHRIB            Prayer = 4
+	Prayer_ISHA               Prayer = 5
+)
+
+// Enum value maps for Prayer.
+var (
+	Prayer_name = map[int32]string{
+		0: "PRAYER_UNSPECIFIED",
+		1: "FAJR",
+		2: "DHUHR",
+		3: "ASR",
+		4: "MAGHRIB",
+		5: "ISHA",
+	}
+	Prayer_value = map[string]int32{
+		"PRAYER_UNSPECIFIED": 0,
+		"FAJR":               1,
+		"DHUHR":              2,
+		"ASR":                3,
+		"MAGHRIB":            4,
+		"ISHA":               5,
+	}
+)
+
+func (x Prayer) Enum() *Prayer {
+	p := new(Prayer)
+	*p = x
+	return p
+}
+
+func (x Prayer) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Prayer) Descriptor() protoreflect.EnumDescriptor {
+	return file_masjid_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Prayer) Type() protoreflect.EnumType {
+	return &file_masjid_service_proto_enumTypes[0]
+}
+
+func (x Prayer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Prayer.Descriptor instead.
+func (Prayer) EnumDescriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{0}
+}
+
 type PrayerTimesConfiguration_CalculationMethod int32
 
 const (
@@ -83,11 +141,11 @@ func (x PrayerTimesConfiguration_CalculationMethod) String() string {
 }
 
 func (PrayerTimesConfiguration_CalculationMethod) Descriptor() protoreflect.EnumDescriptor {
-	return file_masjid_service_proto_enumTypes[0].Descriptor()
+	return file_masjid_service_proto_enumTypes[1].Descriptor()
 }
 
 func (PrayerTimesConfiguration_CalculationMethod) Type() protoreflect.EnumType {
-	return &file_masjid_service_proto_enumTypes[0]
+	return &file_masjid_service_proto_enumTypes[1]
 }
 
 func (x PrayerTimesConfiguration_CalculationMethod) Number() protoreflect.EnumNumber {
@@ -129,11 +187,11 @@ func (x PrayerTimesConfiguration_AsrJuristicMethod) String() string {
 }
 
 func (PrayerTimesConfiguration_AsrJuristicMethod) Descriptor() protoreflect.EnumDescriptor {
-	return file_masjid_service_proto_enumTypes[1].Descriptor()
+	return file_masjid_service_proto_enumTypes[2].Descriptor()
 }
 
 func (PrayerTimesConfiguration_AsrJuristicMethod) Type() protoreflect.EnumType {
-	return &file_masjid_service_proto_enumTypes[1]
+	return &file_masjid_service_proto_enumTypes[2]
 }
 
 func (x PrayerTimesConfiguration_AsrJuristicMethod) Number() protoreflect.EnumNumber {
@@ -181,11 +239,11 @@ func (x PrayerTimesConfiguration_HighLatitudeRule) String() string {
 }
 
 func (PrayerTimesConfiguration_HighLatitudeRule) Descriptor() protoreflect.EnumDescriptor {
-	return file_masjid_service_proto_enumTypes[2].Descriptor()
+	return file_masjid_service_proto_enumTypes[3].Descriptor()
 }
 
 func (PrayerTimesConfiguration_HighLatitudeRule) Type() protoreflect.EnumType {
-	return &file_masjid_service_proto_enumTypes[2]
+	return &file_masjid_service_proto_enumTypes[3]
 }
 
 func (x PrayerTimesConfiguration_HighLatitudeRule) Number() protoreflect.EnumNumber {
@@ -197,6 +255,159 @@ func (PrayerTimesConfiguration_HighLatitudeRule) EnumDescriptor() ([]byte, []int
 	return file_masjid_service_proto_rawDescGZIP(), []int{1, 2}
 }
 
+type IqamahRule_RuleType int32
+
+const (
+	IqamahRule_FIXED_TIME          IqamahRule_RuleType = 0
+	IqamahRule_MINUTES_AFTER_ADHAN IqamahRule_RuleType = 1
+)
+
+// Enum value maps for IqamahRule_RuleType.
+var (
+	IqamahRule_RuleType_name = map[int32]string{
+		0: "FIXED_TIME",
+		1: "MINUTES_AFTER_ADHAN",
+	}
+	IqamahRule_RuleType_value = map[string]int32{
+		"FIXED_TIME":          0,
+		"MINUTES_AFTER_ADHAN": 1,
+	}
+)
+
+func (x IqamahRule_RuleType) Enum() *IqamahRule_RuleType {
+	p := new(IqamahRule_RuleType)
+	*p = x
+	return p
+}
+
+func (x IqamahRule_RuleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IqamahRule_RuleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_masjid_service_proto_enumTypes[4].Descriptor()
+}
+
+func (IqamahRule_RuleType) Type() protoreflect.EnumType {
+	return &file_masjid_service_proto_enumTypes[4]
+}
+
+func (x IqamahRule_RuleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IqamahRule_RuleType.Descriptor instead.
+func (IqamahRule_RuleType) EnumDescriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{17, 0}
+}
+
+type PrayerScheduleEvent_Kind int32
+
+const (
+	// The schedule of the day, sent first on every stream.
+	PrayerScheduleEvent_SNAPSHOT PrayerScheduleEvent_Kind = 0
+	// An admin changed the prayer configuration, iqamah rules or imported
+	// times. Replaces the previous schedule.
+	PrayerScheduleEvent_UPDATED PrayerScheduleEvent_Kind = 1
+	// An adhan time was reached. The schedule is unchanged and left unset.
+	PrayerScheduleEvent_ADHAN PrayerScheduleEvent_Kind = 2
+	// Midnight passed in the masjid's time zone; the schedule of the new
+	// day.
+	PrayerScheduleEvent_NEW_DAY PrayerScheduleEvent_Kind = 3
+)
+
+// Enum value maps for PrayerScheduleEvent_Kind.
+var (
+	PrayerScheduleEvent_Kind_name = map[int32]string{
+		0: "SNAPSHOT",
+		1: "UPDATED",
+		2: "ADHAN",
+		3: "NEW_DAY",
+	}
+	PrayerScheduleEvent_Kind_value = map[string]int32{
+		"SNAPSHOT": 0,
+		"UPDATED":  1,
+		"ADHAN":    2,
+		"NEW_DAY":  3,
+	}
+)
+
+func (x PrayerScheduleEvent_Kind) Enum() *PrayerScheduleEvent_Kind {
+	p := new(PrayerScheduleEvent_Kind)
+	*p = x
+	return p
+}
+
+func (x PrayerScheduleEvent_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrayerScheduleEvent_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_masjid_service_proto_enumTypes[5].Descriptor()
+}
+
+func (PrayerScheduleEvent_Kind) Type() protoreflect.EnumType {
+	return &file_masjid_service_proto_enumTypes[5]
+}
+
+func (x PrayerScheduleEvent_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrayerScheduleEvent_Kind.Descriptor instead.
+func (PrayerScheduleEvent_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{28, 0}
+}
+
+type ExportPrayerTimetableRequest_Format int32
+
+const (
+	ExportPrayerTimetableRequest_CSV ExportPrayerTimetableRequest_Format = 0
+	ExportPrayerTimetableRequest_ICS ExportPrayerTimetableRequest_Format = 1
+	ExportPrayerTimetableRequest_PDF ExportPrayerTimetableRequest_Format = 2
+)
+
+// Enum value maps for ExportPrayerTimetableRequest_Format.
+var (
+	ExportPrayerTimetableRequest_Format_name = map[int32]string{
+		0: "CSV",
+		1: "ICS",
+		2: "PDF",
+	}
+	ExportPrayerTimetableRequest_Format_value = map[string]int32{
+		"CSV": 0,
+		"ICS": 1,
+		"PDF": 2,
+	}
+)
+
+func (x ExportPrayerTimetableRequest_Format) Enum() *ExportPrayerTimetableRequest_Format {
+	p := new(ExportPrayerTimetableRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ExportPrayerTimetableRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportPrayerTimetableRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_masjid_service_proto_enumTypes[6].Descriptor()
+}
+
+func (ExportPrayerTimetableRequest_Format) Type() protoreflect.EnumType {
+	return &file_masjid_service_proto_enumTypes[6]
+}
+
+func (x ExportPrayerTimetableRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportPrayerTimetableRequest_Format.Descriptor instead.
+func (ExportPrayerTimetableRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{29, 0}
+}
+
 type StandardMasjidResponse struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -208,6 +419,15 @@ type StandardMasjidResponse struct {
 	//	*StandardMasjidResponse_DeleteMasjidResponse
 	//	*StandardMasjidResponse_ListMasjidResponse
 	//	*StandardMasjidResponse_GetMasjidResponse
+	//	*StandardMasjidResponse_PrayerTimes
+	//	*StandardMasjidResponse_SearchNearbyMasjidsResponse
+	//	*StandardMasjidResponse_IqamahRule
+	//	*StandardMasjidResponse_ListIqamahRulesResponse
+	//	*StandardMasjidResponse_DeleteIqamahRuleResponse
+	//	*StandardMasjidResponse_DailyPrayerSchedule
+	//	*StandardMasjidResponse_PrayerTimetableFile
+	//	*StandardMasjidResponse_ImportPrayerTimetableResponse
+	//	*StandardMasjidResponse_Qibla
 	Data          isStandardMasjidResponse_Data `protobuf_oneof:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -307,6 +527,87 @@ func (x *StandardMasjidResponse) GetGetMasjidResponse() *GetMasjidRequest {
 	return nil
 }
 
+func (x *StandardMasjidResponse) GetPrayerTimes() *PrayerTimes {
+	if x != nil {
+		if x, ok := x.Data.(*StandardMasjidResponse_PrayerTimes); ok {
+			return x.PrayerTimes
+		}
+	}
+	return nil
+}
+
+func (x *StandardMasjidResponse) GetSearchNearbyMasjidsResponse() *SearchNearbyMasjidsResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardMasjidResponse_SearchNearbyMasjidsResponse); ok {
+			return x.SearchNearbyMasjidsResponse
+		}
+	}
+	return nil
+}
+
+func (x *StandardMasjidResponse) GetIqamahRule() *IqamahRule {
+	if x != nil {
+		if x, ok := x.Data.(*StandardMasjidResponse_IqamahRule); ok {
+			return x.IqamahRule
+		}
+	}
+	return nil
+}
+
+func (x *StandardMasjidResponse) GetListIqamahRulesResponse() *ListIqamahRulesResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardMasjidResponse_ListIqamahRulesResponse); ok {
+			return x.ListIqamahRulesResponse
+		}
+	}
+	return nil
+}
+
+func (x *StandardMasjidResponse) GetDeleteIqamahRuleResponse() *DeleteIqamahRuleResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardMasjidResponse_DeleteIqamahRuleResponse); ok {
+			return x.DeleteIqamahRuleResponse
+		}
+	}
+	return nil
+}
+
+func (x *StandardMasjidResponse) GetDailyPrayerSchedule() *DailyPrayerSchedule {
+	if x != nil {
+		if x, ok := x.Data.(*StandardMasjidResponse_DailyPrayerSchedule); ok {
+			return x.DailyPrayerSchedule
+		}
+	}
+	return nil
+}
+
+func (x *StandardMasjidResponse) GetPrayerTimetableFile() *PrayerTimetableFile {
+	if x != nil {
+		if x, ok := x.Data.(*StandardMasjidResponse_PrayerTimetableFile); ok {
+			return x.PrayerTimetableFile
+		}
+	}
+	return nil
+}
+
+func (x *StandardMasjidResponse) GetImportPrayerTimetableResponse() *ImportPrayerTimetableResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardMasjidResponse_ImportPrayerTimetableResponse); ok {
+			return x.ImportPrayerTimetableResponse
+		}
+	}
+	return nil
+}
+
+func (x *StandardMasjidResponse) GetQibla() *Qibla {
+	if x != nil {
+		if x, ok := x.Data.(*StandardMasjidResponse_Qibla); ok {
+			return x.Qibla
+		}
+	}
+	return nil
+}
+
 type isStandardMasjidResponse_Data interface {
 	isStandardMasjidResponse_Data()
 }
@@ -327,6 +628,42 @@ type StandardMasjidResponse_GetMasjidResponse struct {
 	GetMasjidResponse *GetMasjidRequest `protobuf:"bytes,7,opt,name=get_masjid_response,json=getMasjidResponse,proto3,oneof"`
 }
 
+type StandardMasjidResponse_PrayerTimes struct {
+	PrayerTimes *PrayerTimes `protobuf:"bytes,8,opt,name=prayer_times,json=prayerTimes,proto3,oneof"`
+}
+
+type StandardMasjidResponse_SearchNearbyMasjidsResponse struct {
+	SearchNearbyMasjidsResponse *SearchNearbyMasjidsResponse `protobuf:"bytes,9,opt,name=search_nearby_masjids_response,json=searchNearbyMasjidsResponse,proto3,oneof"`
+}
+
+type StandardMasjidResponse_IqamahRule struct {
+	IqamahRule *IqamahRule `protobuf:"bytes,10,opt,name=iqamah_rule,json=iqamahRule,proto3,oneof"`
+}
+
+type StandardMasjidResponse_ListIqamahRulesResponse struct {
+	ListIqamahRulesResponse *ListIqamahRulesResponse `protobuf:"bytes,11,opt,name=list_iqamah_rules_response,json=listIqamahRulesResponse,proto3,oneof"`
+}
+
+type StandardMasjidResponse_DeleteIqamahRuleResponse struct {
+	DeleteIqamahRuleResponse *DeleteIqamahRuleResponse `protobuf:"bytes,12,opt,name=delete_iqamah_rule_response,json=deleteIqamahRuleResponse,proto3,oneof"`
+}
+
+type StandardMasjidResponse_DailyPrayerSchedule struct {
+	DailyPrayerSchedule *DailyPrayerSchedule `protobuf:"bytes,13,opt,name=daily_prayer_schedule,json=dailyPrayerSchedule,proto3,oneof"`
+}
+
+type StandardMasjidResponse_PrayerTimetableFile struct {
+	PrayerTimetableFile *PrayerTimetableFile `protobuf:"bytes,14,opt,name=prayer_timetable_file,json=prayerTimetableFile,proto3,oneof"`
+}
+
+type StandardMasjidResponse_ImportPrayerTimetableResponse struct {
+	ImportPrayerTimetableResponse *ImportPrayerTimetableResponse `protobuf:"bytes,15,opt,name=import_prayer_timetable_response,json=importPrayerTimetableResponse,proto3,oneof"`
+}
+
+type StandardMasjidResponse_Qibla struct {
+	Qibla *Qibla `protobuf:"bytes,16,opt,name=qibla,proto3,oneof"`
+}
+
 func (*StandardMasjidResponse_Masjid) isStandardMasjidResponse_Data() {}
 
 func (*StandardMasjidResponse_DeleteMasjidResponse) isStandardMasjidResponse_Data() {}
@@ -335,6 +672,24 @@ func (*StandardMasjidResponse_ListMasjidResponse) isStandardMasjidResponse_Data(
 
 func (*StandardMasjidResponse_GetMasjidResponse) isStandardMasjidResponse_Data() {}
 
+func (*StandardMasjidResponse_PrayerTimes) isStandardMasjidResponse_Data() {}
+
+func (*StandardMasjidResponse_SearchNearbyMasjidsResponse) isStandardMasjidResponse_Data() {}
+
+func (*StandardMasjidResponse_IqamahRule) isStandardMasjidResponse_Data() {}
+
+func (*StandardMasjidResponse_ListIqamahRulesResponse) isStandardMasjidResponse_Data() {}
+
+func (*StandardMasjidResponse_DeleteIqamahRuleResponse) isStandardMasjidResponse_Data() {}
+
+func (*StandardMasjidResponse_DailyPrayerSchedule) isStandardMasjidResponse_Data() {}
+
+func (*StandardMasjidResponse_PrayerTimetableFile) isStandardMasjidResponse_Data() {}
+
+func (*StandardMasjidResponse_ImportPrayerTimetableResponse) isStandardMasjidResponse_Data() {}
+
+func (*StandardMasjidResponse_Qibla) isStandardMasjidResponse_Data() {}
+
 type PrayerTimesConfiguration struct {
 	state            protoimpl.MessageState                      `protogen:"open.v1"`
 	Method           PrayerTimesConfiguration_CalculationMethod  `protobuf:"varint,1,opt,name=method,proto3,enum=limestone.PrayerTimesConfiguration_CalculationMethod" json:"method,omitempty"`
@@ -344,8 +699,10 @@ type PrayerTimesConfiguration struct {
 	AsrMethod        PrayerTimesConfiguration_AsrJuristicMethod  `protobuf:"varint,5,opt,name=asr_method,json=asrMethod,proto3,enum=limestone.PrayerTimesConfiguration_AsrJuristicMethod" json:"asr_method,omitempty"`
 	HighLatitudeRule PrayerTimesConfiguration_HighLatitudeRule   `protobuf:"varint,6,opt,name=high_latitude_rule,json=highLatitudeRule,proto3,enum=limestone.PrayerTimesConfiguration_HighLatitudeRule" json:"high_latitude_rule,omitempty"`
 	Adjustments      *PrayerTimesConfiguration_PrayerAdjustments `protobuf:"bytes,7,opt,name=adjustments,proto3" json:"adjustments,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Minutes before Fajr at which suhoor ends in Ramadan, from 0 to 60.
+	SuhoorMargin  int32 `protobuf:"varint,8,opt,name=suhoor_margin,json=suhoorMargin,proto3" json:"suhoor_margin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PrayerTimesConfiguration) Reset() {
@@ -427,17 +784,33 @@ func (x *PrayerTimesConfiguration) GetAdjustments() *PrayerTimesConfiguration_Pr
 	return nil
 }
 
+func (x *PrayerTimesConfiguration) GetSuhoorMargin() int32 {
+	if x != nil {
+		return x.SuhoorMargin
+	}
+	return 0
+}
+
 type Masjid struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Id            string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Location      string                    `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	IsVerified    bool                      `protobuf:"varint,4,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
-	Address       *Masjid_Address           `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	PhoneNumber   *Masjid_PhoneNumber       `protobuf:"bytes,6,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	PrayerConfig  *PrayerTimesConfiguration `protobuf:"bytes,7,opt,name=prayer_config,json=prayerConfig,proto3" json:"prayer_config,omitempty"`
-	CreateTime    *timestamppb.Timestamp    `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	UpdateTime    *timestamppb.Timestamp    `protobuf:"bytes,9,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	state        protoimpl.MessageState    `protogen:"open.v1"`
+	Id           string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Location     string                    `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	IsVerified   bool                      `protobuf:"varint,4,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	Address      *Masjid_Address           `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	PhoneNumber  *Masjid_PhoneNumber       `protobuf:"bytes,6,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	PrayerConfig *PrayerTimesConfiguration `protobuf:"bytes,7,opt,name=prayer_config,json=prayerConfig,proto3" json:"prayer_config,omitempty"`
+	CreateTime   *timestamppb.Timestamp    `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime   *timestamppb.Timestamp    `protobuf:"bytes,9,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Latitude     float64                   `protobuf:"fixed64,10,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude    float64                   `protobuf:"fixed64,11,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA time zone name, e.g. "America/Toronto".
+	TimeZone string `protobuf:"bytes,12,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Days, between -2 and 2, by which the masjid's moon sighting shifts the
+	// Umm al-Qura calendar. -1 means months start a day later locally.
+	HijriOffset *int32 `protobuf:"varint,13,opt,name=hijri_offset,json=hijriOffset,proto3,oneof" json:"hijri_offset,omitempty"`
+	// Direction of prayer from the masjid. Unset until it has coordinates.
+	Qibla         *Qibla `protobuf:"bytes,14,opt,name=qibla,proto3" json:"qibla,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -455,8 +828,1350 @@ func (x *Masjid) String() string {
 
 func (*Masjid) ProtoMessage() {}
 
-func (x *Masjid) ProtoReflect() protoreflect.Message {
-	mi := &file_masjid_service_proto_msgTypes[2]
+func (x *Masjid) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Masjid.ProtoReflect.Descriptor instead.
+func (*Masjid) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Masjid) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Masjid) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Masjid) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Masjid) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *Masjid) GetAddress() *Masjid_Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Masjid) GetPhoneNumber() *Masjid_PhoneNumber {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return nil
+}
+
+func (x *Masjid) GetPrayerConfig() *PrayerTimesConfiguration {
+	if x != nil {
+		return x.PrayerConfig
+	}
+	return nil
+}
+
+func (x *Masjid) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Masjid) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Masjid) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Masjid) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Masjid) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Masjid) GetHijriOffset() int32 {
+	if x != nil && x.HijriOffset != nil {
+		return *x.HijriOffset
+	}
+	return 0
+}
+
+func (x *Masjid) GetQibla() *Qibla {
+	if x != nil {
+		return x.Qibla
+	}
+	return nil
+}
+
+type Qibla struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Great-circle initial bearing to the Kaaba in degrees clockwise from true
+	// north, in [0, 360).
+	BearingDegrees float64 `protobuf:"fixed64,1,opt,name=bearing_degrees,json=bearingDegrees,proto3" json:"bearing_degrees,omitempty"`
+	// Great-circle distance to the Kaaba.
+	DistanceKm    float64 `protobuf:"fixed64,2,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Qibla) Reset() {
+	*x = Qibla{}
+	mi := &file_masjid_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Qibla) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Qibla) ProtoMessage() {}
+
+func (x *Qibla) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Qibla.ProtoReflect.Descriptor instead.
+func (*Qibla) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Qibla) GetBearingDegrees() float64 {
+	if x != nil {
+		return x.BearingDegrees
+	}
+	return 0
+}
+
+func (x *Qibla) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+type GetQiblaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQiblaRequest) Reset() {
+	*x = GetQiblaRequest{}
+	mi := &file_masjid_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQiblaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQiblaRequest) ProtoMessage() {}
+
+func (x *GetQiblaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQiblaRequest.ProtoReflect.Descriptor instead.
+func (*GetQiblaRequest) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetQiblaRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetQiblaRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type CreateMasjidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Masjid        *Masjid                `protobuf:"bytes,1,opt,name=masjid,proto3" json:"masjid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMasjidRequest) Reset() {
+	*x = CreateMasjidRequest{}
+	mi := &file_masjid_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMasjidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMasjidRequest) ProtoMessage() {}
+
+func (x *CreateMasjidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMasjidRequest.ProtoReflect.Descriptor instead.
+func (*CreateMasjidRequest) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateMasjidRequest) GetMasjid() *Masjid {
+	if x != nil {
+		return x.Masjid
+	}
+	return nil
+}
+
+type UpdateMasjidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Masjid        *Masjid                `protobuf:"bytes,1,opt,name=masjid,proto3" json:"masjid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMasjidRequest) Reset() {
+	*x = UpdateMasjidRequest{}
+	mi := &file_masjid_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMasjidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMasjidRequest) ProtoMessage() {}
+
+func (x *UpdateMasjidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMasjidRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMasjidRequest) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateMasjidRequest) GetMasjid() *Masjid {
+	if x != nil {
+		return x.Masjid
+	}
+	return nil
+}
+
+type DeleteMasjidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMasjidRequest) Reset() {
+	*x = DeleteMasjidRequest{}
+	mi := &file_masjid_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMasjidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMasjidRequest) ProtoMessage() {}
+
+func (x *DeleteMasjidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMasjidRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMasjidRequest) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteMasjidRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteMasjidResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMasjidResponse) Reset() {
+	*x = DeleteMasjidResponse{}
+	mi := &file_masjid_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMasjidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMasjidResponse) ProtoMessage() {}
+
+func (x *DeleteMasjidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMasjidResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMasjidResponse) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{8}
+}
+
+type GetMasjidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMasjidRequest) Reset() {
+	*x = GetMasjidRequest{}
+	mi := &file_masjid_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMasjidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMasjidRequest) ProtoMessage() {}
+
+func (x *GetMasjidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMasjidRequest.ProtoReflect.Descriptor instead.
+func (*GetMasjidRequest) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetMasjidRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListMasjidsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Name          *string                `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Location      *string                `protobuf:"bytes,5,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMasjidsRequest) Reset() {
+	*x = ListMasjidsRequest{}
+	mi := &file_masjid_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMasjidsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMasjidsRequest) ProtoMessage() {}
+
+func (x *ListMasjidsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMasjidsRequest.ProtoReflect.Descriptor instead.
+func (*ListMasjidsRequest) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListMasjidsRequest) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ListMasjidsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMasjidsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMasjidsRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ListMasjidsRequest) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+type ListMasjidsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Masjids       []*Masjid              `protobuf:"bytes,1,rep,name=masjids,proto3" json:"masjids,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	CurrentPage   int32                  `protobuf:"varint,3,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMasjidsResponse) Reset() {
+	*x = ListMasjidsResponse{}
+	mi := &file_masjid_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMasjidsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMasjidsResponse) ProtoMessage() {}
+
+func (x *ListMasjidsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMasjidsResponse.ProtoReflect.Descriptor instead.
+func (*ListMasjidsResponse) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListMasjidsResponse) GetMasjids() []*Masjid {
+	if x != nil {
+		return x.Masjids
+	}
+	return nil
+}
+
+func (x *ListMasjidsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListMasjidsResponse) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *ListMasjidsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type SearchNearbyMasjidsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Latitude  float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RadiusKm  float64                `protobuf:"fixed64,3,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	// Maximum number of results. Defaults to 20 and is capped at 100.
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNearbyMasjidsRequest) Reset() {
+	*x = SearchNearbyMasjidsRequest{}
+	mi := &file_masjid_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNearbyMasjidsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNearbyMasjidsRequest) ProtoMessage() {}
+
+func (x *SearchNearbyMasjidsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNearbyMasjidsRequest.ProtoReflect.Descriptor instead.
+func (*SearchNearbyMasjidsRequest) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchNearbyMasjidsRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *SearchNearbyMasjidsRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *SearchNearbyMasjidsRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *SearchNearbyMasjidsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type NearbyMasjid struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Masjid        *Masjid                `protobuf:"bytes,1,opt,name=masjid,proto3" json:"masjid,omitempty"`
+	DistanceKm    float64                `protobuf:"fixed64,2,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearbyMasjid) Reset() {
+	*x = NearbyMasjid{}
+	mi := &file_masjid_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyMasjid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyMasjid) ProtoMessage() {}
+
+func (x *NearbyMasjid) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyMasjid.ProtoReflect.Descriptor instead.
+func (*NearbyMasjid) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NearbyMasjid) GetMasjid() *Masjid {
+	if x != nil {
+		return x.Masjid
+	}
+	return nil
+}
+
+func (x *NearbyMasjid) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+type SearchNearbyMasjidsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Masjids       []*NearbyMasjid        `protobuf:"bytes,1,rep,name=masjids,proto3" json:"masjids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNearbyMasjidsResponse) Reset() {
+	*x = SearchNearbyMasjidsResponse{}
+	mi := &file_masjid_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNearbyMasjidsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNearbyMasjidsResponse) ProtoMessage() {}
+
+func (x *SearchNearbyMasjidsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNearbyMasjidsResponse.ProtoReflect.Descriptor instead.
+func (*SearchNearbyMasjidsResponse) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchNearbyMasjidsResponse) GetMasjids() []*NearbyMasjid {
+	if x != nil {
+		return x.Masjids
+	}
+	return nil
+}
+
+type GetPrayerTimesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	// Date in YYYY-MM-DD format. Defaults to today in the masjid's time zone.
+	Date          string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrayerTimesRequest) Reset() {
+	*x = GetPrayerTimesRequest{}
+	mi := &file_masjid_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrayerTimesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrayerTimesRequest) ProtoMessage() {}
+
+func (x *GetPrayerTimesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrayerTimesRequest.ProtoReflect.Descriptor instead.
+func (*GetPrayerTimesRequest) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetPrayerTimesRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *GetPrayerTimesRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type PrayerTimes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Fajr          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=fajr,proto3" json:"fajr,omitempty"`
+	Sunrise       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=sunrise,proto3" json:"sunrise,omitempty"`
+	Dhuhr         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=dhuhr,proto3" json:"dhuhr,omitempty"`
+	Asr           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=asr,proto3" json:"asr,omitempty"`
+	Maghrib       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=maghrib,proto3" json:"maghrib,omitempty"`
+	Isha          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=isha,proto3" json:"isha,omitempty"`
+	HijriDate     *HijriDate             `protobuf:"bytes,10,opt,name=hijri_date,json=hijriDate,proto3" json:"hijri_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrayerTimes) Reset() {
+	*x = PrayerTimes{}
+	mi := &file_masjid_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrayerTimes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrayerTimes) ProtoMessage() {}
+
+func (x *PrayerTimes) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrayerTimes.ProtoReflect.Descriptor instead.
+func (*PrayerTimes) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PrayerTimes) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *PrayerTimes) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PrayerTimes) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *PrayerTimes) GetFajr() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Fajr
+	}
+	return nil
+}
+
+func (x *PrayerTimes) GetSunrise() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Sunrise
+	}
+	return nil
+}
+
+func (x *PrayerTimes) GetDhuhr() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Dhuhr
+	}
+	return nil
+}
+
+func (x *PrayerTimes) GetAsr() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Asr
+	}
+	return nil
+}
+
+func (x *PrayerTimes) GetMaghrib() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Maghrib
+	}
+	return nil
+}
+
+func (x *PrayerTimes) GetIsha() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Isha
+	}
+	return nil
+}
+
+func (x *PrayerTimes) GetHijriDate() *HijriDate {
+	if x != nil {
+		return x.HijriDate
+	}
+	return nil
+}
+
+type IqamahRule struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MasjidId string                 `protobuf:"bytes,2,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	Prayer   Prayer                 `protobuf:"varint,3,opt,name=prayer,proto3,enum=limestone.Prayer" json:"prayer,omitempty"`
+	Type     IqamahRule_RuleType    `protobuf:"varint,4,opt,name=type,proto3,enum=limestone.IqamahRule_RuleType" json:"type,omitempty"`
+	// Local clock time in 24-hour HH:MM format, used by FIXED_TIME rules.
+	FixedTime string `protobuf:"bytes,5,opt,name=fixed_time,json=fixedTime,proto3" json:"fixed_time,omitempty"`
+	// Minutes after the calculated adhan, used by MINUTES_AFTER_ADHAN rules.
+	MinutesAfterAdhan int32 `protobuf:"varint,6,opt,name=minutes_after_adhan,json=minutesAfterAdhan,proto3" json:"minutes_after_adhan,omitempty"`
+	// Inclusive YYYY-MM-DD date range the rule applies to. An empty bound is
+	// open-ended. When ranges overlap, the rule with the latest start date wins.
+	StartDate     string                 `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IqamahRule) Reset() {
+	*x = IqamahRule{}
+	mi := &file_masjid_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IqamahRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IqamahRule) ProtoMessage() {}
+
+func (x *IqamahRule) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IqamahRule.ProtoReflect.Descriptor instead.
+func (*IqamahRule) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *IqamahRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IqamahRule) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *IqamahRule) GetPrayer() Prayer {
+	if x != nil {
+		return x.Prayer
+	}
+	return Prayer_PRAYER_UNSPECIFIED
+}
+
+func (x *IqamahRule) GetType() IqamahRule_RuleType {
+	if x != nil {
+		return x.Type
+	}
+	return IqamahRule_FIXED_TIME
+}
+
+func (x *IqamahRule) GetFixedTime() string {
+	if x != nil {
+		return x.FixedTime
+	}
+	return ""
+}
+
+func (x *IqamahRule) GetMinutesAfterAdhan() int32 {
+	if x != nil {
+		return x.MinutesAfterAdhan
+	}
+	return 0
+}
+
+func (x *IqamahRule) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *IqamahRule) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *IqamahRule) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *IqamahRule) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type CreateIqamahRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	Rule          *IqamahRule            `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIqamahRuleRequest) Reset() {
+	*x = CreateIqamahRuleRequest{}
+	mi := &file_masjid_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIqamahRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIqamahRuleRequest) ProtoMessage() {}
+
+func (x *CreateIqamahRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIqamahRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateIqamahRuleRequest) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateIqamahRuleRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *CreateIqamahRuleRequest) GetRule() *IqamahRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateIqamahRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Rule          *IqamahRule            `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIqamahRuleRequest) Reset() {
+	*x = UpdateIqamahRuleRequest{}
+	mi := &file_masjid_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIqamahRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIqamahRuleRequest) ProtoMessage() {}
+
+func (x *UpdateIqamahRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIqamahRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIqamahRuleRequest) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateIqamahRuleRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *UpdateIqamahRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateIqamahRuleRequest) GetRule() *IqamahRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteIqamahRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIqamahRuleRequest) Reset() {
+	*x = DeleteIqamahRuleRequest{}
+	mi := &file_masjid_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIqamahRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIqamahRuleRequest) ProtoMessage() {}
+
+func (x *DeleteIqamahRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIqamahRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIqamahRuleRequest) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteIqamahRuleRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *DeleteIqamahRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteIqamahRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIqamahRuleResponse) Reset() {
+	*x = DeleteIqamahRuleResponse{}
+	mi := &file_masjid_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIqamahRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIqamahRuleResponse) ProtoMessage() {}
+
+func (x *DeleteIqamahRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIqamahRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIqamahRuleResponse) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{21}
+}
+
+type ListIqamahRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIqamahRulesRequest) Reset() {
+	*x = ListIqamahRulesRequest{}
+	mi := &file_masjid_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIqamahRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIqamahRulesRequest) ProtoMessage() {}
+
+func (x *ListIqamahRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIqamahRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListIqamahRulesRequest) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListIqamahRulesRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+type ListIqamahRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*IqamahRule          `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIqamahRulesResponse) Reset() {
+	*x = ListIqamahRulesResponse{}
+	mi := &file_masjid_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIqamahRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIqamahRulesResponse) ProtoMessage() {}
+
+func (x *ListIqamahRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIqamahRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListIqamahRulesResponse) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListIqamahRulesResponse) GetRules() []*IqamahRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type GetDailyPrayerScheduleRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	// Date in YYYY-MM-DD format. Defaults to today in the masjid's time zone.
+	Date          string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyPrayerScheduleRequest) Reset() {
+	*x = GetDailyPrayerScheduleRequest{}
+	mi := &file_masjid_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyPrayerScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyPrayerScheduleRequest) ProtoMessage() {}
+
+func (x *GetDailyPrayerScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,96 +2182,124 @@ func (x *Masjid) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Masjid.ProtoReflect.Descriptor instead.
-func (*Masjid) Descriptor() ([]byte, []int) {
-	return file_masjid_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GetDailyPrayerScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyPrayerScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Masjid) GetId() string {
+func (x *GetDailyPrayerScheduleRequest) GetMasjidId() string {
 	if x != nil {
-		return x.Id
+		return x.MasjidId
 	}
 	return ""
 }
 
-func (x *Masjid) GetName() string {
+func (x *GetDailyPrayerScheduleRequest) GetDate() string {
 	if x != nil {
-		return x.Name
+		return x.Date
 	}
 	return ""
 }
 
-func (x *Masjid) GetLocation() string {
-	if x != nil {
-		return x.Location
-	}
-	return ""
+type Iqamah struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fajr          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=fajr,proto3" json:"fajr,omitempty"`
+	Dhuhr         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=dhuhr,proto3" json:"dhuhr,omitempty"`
+	Asr           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=asr,proto3" json:"asr,omitempty"`
+	Maghrib       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=maghrib,proto3" json:"maghrib,omitempty"`
+	Isha          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=isha,proto3" json:"isha,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Masjid) GetIsVerified() bool {
+func (x *Iqamah) Reset() {
+	*x = Iqamah{}
+	mi := &file_masjid_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Iqamah) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Iqamah) ProtoMessage() {}
+
+func (x *Iqamah) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[25]
 	if x != nil {
-		return x.IsVerified
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Masjid) GetAddress() *Masjid_Address {
+// Deprecated: Use Iqamah.ProtoReflect.Descriptor instead.
+func (*Iqamah) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Iqamah) GetFajr() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Address
+		return x.Fajr
 	}
 	return nil
 }
 
-func (x *Masjid) GetPhoneNumber() *Masjid_PhoneNumber {
+func (x *Iqamah) GetDhuhr() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PhoneNumber
+		return x.Dhuhr
 	}
 	return nil
 }
 
-func (x *Masjid) GetPrayerConfig() *PrayerTimesConfiguration {
+func (x *Iqamah) GetAsr() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PrayerConfig
+		return x.Asr
 	}
 	return nil
 }
 
-func (x *Masjid) GetCreateTime() *timestamppb.Timestamp {
+func (x *Iqamah) GetMaghrib() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreateTime
+		return x.Maghrib
 	}
 	return nil
 }
 
-func (x *Masjid) GetUpdateTime() *timestamppb.Timestamp {
+func (x *Iqamah) GetIsha() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdateTime
+		return x.Isha
 	}
 	return nil
 }
 
-type CreateMasjidRequest struct {
+type DailyPrayerSchedule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Masjid        *Masjid                `protobuf:"bytes,1,opt,name=masjid,proto3" json:"masjid,omitempty"`
+	Adhan         *PrayerTimes           `protobuf:"bytes,1,opt,name=adhan,proto3" json:"adhan,omitempty"`
+	Iqamah        *Iqamah                `protobuf:"bytes,2,opt,name=iqamah,proto3" json:"iqamah,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMasjidRequest) Reset() {
-	*x = CreateMasjidRequest{}
-	mi := &file_masjid_service_proto_msgTypes[3]
+func (x *DailyPrayerSchedule) Reset() {
+	*x = DailyPrayerSchedule{}
+	mi := &file_masjid_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMasjidRequest) String() string {
+func (x *DailyPrayerSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMasjidRequest) ProtoMessage() {}
+func (*DailyPrayerSchedule) ProtoMessage() {}
 
-func (x *CreateMasjidRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_masjid_service_proto_msgTypes[3]
+func (x *DailyPrayerSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,40 +2310,47 @@ func (x *CreateMasjidRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMasjidRequest.ProtoReflect.Descriptor instead.
-func (*CreateMasjidRequest) Descriptor() ([]byte, []int) {
-	return file_masjid_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DailyPrayerSchedule.ProtoReflect.Descriptor instead.
+func (*DailyPrayerSchedule) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CreateMasjidRequest) GetMasjid() *Masjid {
+func (x *DailyPrayerSchedule) GetAdhan() *PrayerTimes {
 	if x != nil {
-		return x.Masjid
+		return x.Adhan
 	}
 	return nil
 }
 
-type UpdateMasjidRequest struct {
+func (x *DailyPrayerSchedule) GetIqamah() *Iqamah {
+	if x != nil {
+		return x.Iqamah
+	}
+	return nil
+}
+
+type WatchPrayerScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Masjid        *Masjid                `protobuf:"bytes,1,opt,name=masjid,proto3" json:"masjid,omitempty"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMasjidRequest) Reset() {
-	*x = UpdateMasjidRequest{}
-	mi := &file_masjid_service_proto_msgTypes[4]
+func (x *WatchPrayerScheduleRequest) Reset() {
+	*x = WatchPrayerScheduleRequest{}
+	mi := &file_masjid_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMasjidRequest) String() string {
+func (x *WatchPrayerScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMasjidRequest) ProtoMessage() {}
+func (*WatchPrayerScheduleRequest) ProtoMessage() {}
 
-func (x *UpdateMasjidRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_masjid_service_proto_msgTypes[4]
+func (x *WatchPrayerScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,40 +2361,46 @@ func (x *UpdateMasjidRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMasjidRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMasjidRequest) Descriptor() ([]byte, []int) {
-	return file_masjid_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use WatchPrayerScheduleRequest.ProtoReflect.Descriptor instead.
+func (*WatchPrayerScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UpdateMasjidRequest) GetMasjid() *Masjid {
+func (x *WatchPrayerScheduleRequest) GetMasjidId() string {
 	if x != nil {
-		return x.Masjid
+		return x.MasjidId
 	}
-	return nil
+	return ""
 }
 
-type DeleteMasjidRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type PrayerScheduleEvent struct {
+	state    protoimpl.MessageState   `protogen:"open.v1"`
+	Kind     PrayerScheduleEvent_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=limestone.PrayerScheduleEvent_Kind" json:"kind,omitempty"`
+	Schedule *DailyPrayerSchedule     `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// The prayer whose adhan was reached, for ADHAN events.
+	Prayer Prayer `protobuf:"varint,3,opt,name=prayer,proto3,enum=limestone.Prayer" json:"prayer,omitempty"`
+	// The adhan time for ADHAN events and the time the event was sent
+	// otherwise.
+	Time          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMasjidRequest) Reset() {
-	*x = DeleteMasjidRequest{}
-	mi := &file_masjid_service_proto_msgTypes[5]
+func (x *PrayerScheduleEvent) Reset() {
+	*x = PrayerScheduleEvent{}
+	mi := &file_masjid_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMasjidRequest) String() string {
+func (x *PrayerScheduleEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMasjidRequest) ProtoMessage() {}
+func (*PrayerScheduleEvent) ProtoMessage() {}
 
-func (x *DeleteMasjidRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_masjid_service_proto_msgTypes[5]
+func (x *PrayerScheduleEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -655,39 +2411,65 @@ func (x *DeleteMasjidRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMasjidRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMasjidRequest) Descriptor() ([]byte, []int) {
-	return file_masjid_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PrayerScheduleEvent.ProtoReflect.Descriptor instead.
+func (*PrayerScheduleEvent) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *DeleteMasjidRequest) GetId() string {
+func (x *PrayerScheduleEvent) GetKind() PrayerScheduleEvent_Kind {
 	if x != nil {
-		return x.Id
+		return x.Kind
 	}
-	return ""
+	return PrayerScheduleEvent_SNAPSHOT
 }
 
-type DeleteMasjidResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *PrayerScheduleEvent) GetSchedule() *DailyPrayerSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *PrayerScheduleEvent) GetPrayer() Prayer {
+	if x != nil {
+		return x.Prayer
+	}
+	return Prayer_PRAYER_UNSPECIFIED
+}
+
+func (x *PrayerScheduleEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type ExportPrayerTimetableRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	Year     int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	// Month of the year, 1 for January through 12 for December.
+	Month         int32                               `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
+	Format        ExportPrayerTimetableRequest_Format `protobuf:"varint,4,opt,name=format,proto3,enum=limestone.ExportPrayerTimetableRequest_Format" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMasjidResponse) Reset() {
-	*x = DeleteMasjidResponse{}
-	mi := &file_masjid_service_proto_msgTypes[6]
+func (x *ExportPrayerTimetableRequest) Reset() {
+	*x = ExportPrayerTimetableRequest{}
+	mi := &file_masjid_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMasjidResponse) String() string {
+func (x *ExportPrayerTimetableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMasjidResponse) ProtoMessage() {}
+func (*ExportPrayerTimetableRequest) ProtoMessage() {}
 
-func (x *DeleteMasjidResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_masjid_service_proto_msgTypes[6]
+func (x *ExportPrayerTimetableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,33 +2480,63 @@ func (x *DeleteMasjidResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMasjidResponse.ProtoReflect.Descriptor instead.
-func (*DeleteMasjidResponse) Descriptor() ([]byte, []int) {
-	return file_masjid_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ExportPrayerTimetableRequest.ProtoReflect.Descriptor instead.
+func (*ExportPrayerTimetableRequest) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{29}
 }
 
-type GetMasjidRequest struct {
+func (x *ExportPrayerTimetableRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *ExportPrayerTimetableRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *ExportPrayerTimetableRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *ExportPrayerTimetableRequest) GetFormat() ExportPrayerTimetableRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ExportPrayerTimetableRequest_CSV
+}
+
+type PrayerTimetableFile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMasjidRequest) Reset() {
-	*x = GetMasjidRequest{}
-	mi := &file_masjid_service_proto_msgTypes[7]
+func (x *PrayerTimetableFile) Reset() {
+	*x = PrayerTimetableFile{}
+	mi := &file_masjid_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMasjidRequest) String() string {
+func (x *PrayerTimetableFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMasjidRequest) ProtoMessage() {}
+func (*PrayerTimetableFile) ProtoMessage() {}
 
-func (x *GetMasjidRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_masjid_service_proto_msgTypes[7]
+func (x *PrayerTimetableFile) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,44 +2547,59 @@ func (x *GetMasjidRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMasjidRequest.ProtoReflect.Descriptor instead.
-func (*GetMasjidRequest) Descriptor() ([]byte, []int) {
-	return file_masjid_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use PrayerTimetableFile.ProtoReflect.Descriptor instead.
+func (*PrayerTimetableFile) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetMasjidRequest) GetId() string {
+func (x *PrayerTimetableFile) GetFileName() string {
 	if x != nil {
-		return x.Id
+		return x.FileName
 	}
 	return ""
 }
 
-type ListMasjidsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	Name          *string                `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Location      *string                `protobuf:"bytes,5,opt,name=location,proto3,oneof" json:"location,omitempty"`
+func (x *PrayerTimetableFile) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *PrayerTimetableFile) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ImportPrayerTimetableRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	// CSV with a header row naming at least the date, fajr, sunrise, dhuhr,
+	// asr, maghrib and isha columns, and one row per consecutive day. Dates are
+	// YYYY-MM-DD; times may be 24-hour (13:05) or 12-hour (1:05 PM). The
+	// imported times replace the calculated ones for those days.
+	Csv           string `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMasjidsRequest) Reset() {
-	*x = ListMasjidsRequest{}
-	mi := &file_masjid_service_proto_msgTypes[8]
+func (x *ImportPrayerTimetableRequest) Reset() {
+	*x = ImportPrayerTimetableRequest{}
+	mi := &file_masjid_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMasjidsRequest) String() string {
+func (x *ImportPrayerTimetableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMasjidsRequest) ProtoMessage() {}
+func (*ImportPrayerTimetableRequest) ProtoMessage() {}
 
-func (x *ListMasjidsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_masjid_service_proto_msgTypes[8]
+func (x *ImportPrayerTimetableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,71 +2610,49 @@ func (x *ListMasjidsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMasjidsRequest.ProtoReflect.Descriptor instead.
-func (*ListMasjidsRequest) Descriptor() ([]byte, []int) {
-	return file_masjid_service_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *ListMasjidsRequest) GetStart() int32 {
-	if x != nil {
-		return x.Start
-	}
-	return 0
-}
-
-func (x *ListMasjidsRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+// Deprecated: Use ImportPrayerTimetableRequest.ProtoReflect.Descriptor instead.
+func (*ImportPrayerTimetableRequest) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListMasjidsRequest) GetPage() int32 {
+func (x *ImportPrayerTimetableRequest) GetMasjidId() string {
 	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListMasjidsRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+		return x.MasjidId
 	}
 	return ""
 }
 
-func (x *ListMasjidsRequest) GetLocation() string {
-	if x != nil && x.Location != nil {
-		return *x.Location
+func (x *ImportPrayerTimetableRequest) GetCsv() string {
+	if x != nil {
+		return x.Csv
 	}
 	return ""
 }
 
-type ListMasjidsResponse struct {
+type ImportPrayerTimetableResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Masjids       []*Masjid              `protobuf:"bytes,1,rep,name=masjids,proto3" json:"masjids,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	CurrentPage   int32                  `protobuf:"varint,3,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	ImportedDays  int32                  `protobuf:"varint,1,opt,name=imported_days,json=importedDays,proto3" json:"imported_days,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMasjidsResponse) Reset() {
-	*x = ListMasjidsResponse{}
-	mi := &file_masjid_service_proto_msgTypes[9]
+func (x *ImportPrayerTimetableResponse) Reset() {
+	*x = ImportPrayerTimetableResponse{}
+	mi := &file_masjid_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMasjidsResponse) String() string {
+func (x *ImportPrayerTimetableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMasjidsResponse) ProtoMessage() {}
+func (*ImportPrayerTimetableResponse) ProtoMessage() {}
 
-func (x *ListMasjidsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_masjid_service_proto_msgTypes[9]
+func (x *ImportPrayerTimetableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_masjid_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -858,37 +2663,30 @@ func (x *ListMasjidsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMasjidsResponse.ProtoReflect.Descriptor instead.
-func (*ListMasjidsResponse) Descriptor() ([]byte, []int) {
-	return file_masjid_service_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *ListMasjidsResponse) GetMasjids() []*Masjid {
-	if x != nil {
-		return x.Masjids
-	}
-	return nil
+// Deprecated: Use ImportPrayerTimetableResponse.ProtoReflect.Descriptor instead.
+func (*ImportPrayerTimetableResponse) Descriptor() ([]byte, []int) {
+	return file_masjid_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ListMasjidsResponse) GetTotalCount() int32 {
+func (x *ImportPrayerTimetableResponse) GetImportedDays() int32 {
 	if x != nil {
-		return x.TotalCount
+		return x.ImportedDays
 	}
 	return 0
 }
 
-func (x *ListMasjidsResponse) GetCurrentPage() int32 {
+func (x *ImportPrayerTimetableResponse) GetStartDate() string {
 	if x != nil {
-		return x.CurrentPage
+		return x.StartDate
 	}
-	return 0
+	return ""
 }
 
-func (x *ListMasjidsResponse) GetTotalPages() int32 {
+func (x *ImportPrayerTimetableResponse) GetEndDate() string {
 	if x != nil {
-		return x.TotalPages
+		return x.EndDate
 	}
-	return 0
+	return ""
 }
 
 type PrayerTimesConfiguration_PrayerAdjustments struct {
@@ -904,7 +2702,7 @@ type PrayerTimesConfiguration_PrayerAdjustments struct {
 
 func (x *PrayerTimesConfiguration_PrayerAdjustments) Reset() {
 	*x = PrayerTimesConfiguration_PrayerAdjustments{}
-	mi := &file_masjid_service_proto_msgTypes[10]
+	mi := &file_masjid_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -916,7 +2714,7 @@ func (x *PrayerTimesConfiguration_PrayerAdjustments) String() string {
 func (*PrayerTimesConfiguration_PrayerAdjustments) ProtoMessage() {}
 
 func (x *PrayerTimesConfiguration_PrayerAdjustments) ProtoReflect() protoreflect.Message {
-	mi := &file_masjid_service_proto_msgTypes[10]
+	mi := &file_masjid_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,7 +2779,7 @@ type Masjid_Address struct {
 
 func (x *Masjid_Address) Reset() {
 	*x = Masjid_Address{}
-	mi := &file_masjid_service_proto_msgTypes[11]
+	mi := &file_masjid_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -993,7 +2791,7 @@ func (x *Masjid_Address) String() string {
 func (*Masjid_Address) ProtoMessage() {}
 
 func (x *Masjid_Address) ProtoReflect() protoreflect.Message {
-	mi := &file_masjid_service_proto_msgTypes[11]
+	mi := &file_masjid_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1062,7 +2860,7 @@ type Masjid_PhoneNumber struct {
 
 func (x *Masjid_PhoneNumber) Reset() {
 	*x = Masjid_PhoneNumber{}
-	mi := &file_masjid_service_proto_msgTypes[12]
+	mi := &file_masjid_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1074,7 +2872,7 @@ func (x *Masjid_PhoneNumber) String() string {
 func (*Masjid_PhoneNumber) ProtoMessage() {}
 
 func (x *Masjid_PhoneNumber) ProtoReflect() protoreflect.Message {
-	mi := &file_masjid_service_proto_msgTypes[12]
+	mi := &file_masjid_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1115,7 +2913,7 @@ var File_masjid_service_proto protoreflect.FileDescriptor
 
 const file_masjid_service_proto_rawDesc = "" +
 	"\n" +
-	"\x14masjid_service.proto\x12\tlimestone\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8f\x03\n" +
+	"\x14masjid_service.proto\x12\tlimestone\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x10hijri_date.proto\"\x89\t\n" +
 	"\x16StandardMasjidResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
@@ -1123,8 +2921,19 @@ const file_masjid_service_proto_rawDesc = "" +
 	"\x06Masjid\x18\x04 \x01(\v2\x11.limestone.MasjidH\x00R\x06Masjid\x12W\n" +
 	"\x16delete_masjid_response\x18\x05 \x01(\v2\x1f.limestone.DeleteMasjidResponseH\x00R\x14deleteMasjidResponse\x12R\n" +
 	"\x14list_masjid_response\x18\x06 \x01(\v2\x1e.limestone.ListMasjidsResponseH\x00R\x12listMasjidResponse\x12M\n" +
-	"\x13get_masjid_response\x18\a \x01(\v2\x1b.limestone.GetMasjidRequestH\x00R\x11getMasjidResponseB\x06\n" +
-	"\x04data\"\xca\b\n" +
+	"\x13get_masjid_response\x18\a \x01(\v2\x1b.limestone.GetMasjidRequestH\x00R\x11getMasjidResponse\x12;\n" +
+	"\fprayer_times\x18\b \x01(\v2\x16.limestone.PrayerTimesH\x00R\vprayerTimes\x12m\n" +
+	"\x1esearch_nearby_masjids_response\x18\t \x01(\v2&.limestone.SearchNearbyMasjidsResponseH\x00R\x1bsearchNearbyMasjidsResponse\x128\n" +
+	"\viqamah_rule\x18\n" +
+	" \x01(\v2\x15.limestone.IqamahRuleH\x00R\n" +
+	"iqamahRule\x12a\n" +
+	"\x1alist_iqamah_rules_response\x18\v \x01(\v2\".limestone.ListIqamahRulesResponseH\x00R\x17listIqamahRulesResponse\x12d\n" +
+	"\x1bdelete_iqamah_rule_response\x18\f \x01(\v2#.limestone.DeleteIqamahRuleResponseH\x00R\x18deleteIqamahRuleResponse\x12T\n" +
+	"\x15daily_prayer_schedule\x18\r \x01(\v2\x1e.limestone.DailyPrayerScheduleH\x00R\x13dailyPrayerSchedule\x12T\n" +
+	"\x15prayer_timetable_file\x18\x0e \x01(\v2\x1e.limestone.PrayerTimetableFileH\x00R\x13prayerTimetableFile\x12s\n" +
+	" import_prayer_timetable_response\x18\x0f \x01(\v2(.limestone.ImportPrayerTimetableResponseH\x00R\x1dimportPrayerTimetableResponse\x12(\n" +
+	"\x05qibla\x18\x10 \x01(\v2\x10.limestone.QiblaH\x00R\x05qiblaB\x06\n" +
+	"\x04data\"\xef\b\n" +
 	"\x18PrayerTimesConfiguration\x12M\n" +
 	"\x06method\x18\x01 \x01(\x0e25.limestone.PrayerTimesConfiguration.CalculationMethodR\x06method\x12\x1d\n" +
 	"\n" +
@@ -1135,7 +2944,8 @@ const file_masjid_service_proto_rawDesc = "" +
 	"\n" +
 	"asr_method\x18\x05 \x01(\x0e25.limestone.PrayerTimesConfiguration.AsrJuristicMethodR\tasrMethod\x12b\n" +
 	"\x12high_latitude_rule\x18\x06 \x01(\x0e24.limestone.PrayerTimesConfiguration.HighLatitudeRuleR\x10highLatitudeRule\x12W\n" +
-	"\vadjustments\x18\a \x01(\v25.limestone.PrayerTimesConfiguration.PrayerAdjustmentsR\vadjustments\x1a\xe6\x01\n" +
+	"\vadjustments\x18\a \x01(\v25.limestone.PrayerTimesConfiguration.PrayerAdjustmentsR\vadjustments\x12#\n" +
+	"\rsuhoor_margin\x18\b \x01(\x05R\fsuhoorMargin\x1a\xe6\x01\n" +
 	"\x11PrayerAdjustments\x12'\n" +
 	"\x0ffajr_adjustment\x18\x01 \x01(\x05R\x0efajrAdjustment\x12)\n" +
 	"\x10dhuhr_adjustment\x18\x02 \x01(\x05R\x0fdhuhrAdjustment\x12%\n" +
@@ -1165,7 +2975,7 @@ const file_masjid_service_proto_rawDesc = "" +
 	"\x15NO_HIGH_LATITUDE_RULE\x10\x00\x12\x17\n" +
 	"\x13MIDDLE_OF_THE_NIGHT\x10\x01\x12\x18\n" +
 	"\x14SEVENTH_OF_THE_NIGHT\x10\x02\x12\x12\n" +
-	"\x0eTWILIGHT_ANGLE\x10\x03\"\xd9\x05\n" +
+	"\x0eTWILIGHT_ANGLE\x10\x03\"\x96\a\n" +
 	"\x06Masjid\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
@@ -1178,7 +2988,13 @@ const file_masjid_service_proto_rawDesc = "" +
 	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"createTime\x12;\n" +
 	"\vupdate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"updateTime\x1a\xca\x01\n" +
+	"updateTime\x12\x1a\n" +
+	"\blatitude\x18\n" +
+	" \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\v \x01(\x01R\tlongitude\x12\x1b\n" +
+	"\ttime_zone\x18\f \x01(\tR\btimeZone\x12&\n" +
+	"\fhijri_offset\x18\r \x01(\x05H\x00R\vhijriOffset\x88\x01\x01\x12+\n" +
+	"\x05qibla\x18\x0e \x01(\v2\x10.limestone.QiblaB\x03\xe0A\x03R\x05qibla\x1a\xca\x01\n" +
 	"\aAddress\x12$\n" +
 	"\x0eaddress_line_1\x18\x01 \x01(\tR\faddressLine1\x12$\n" +
 	"\x0eaddress_line_2\x18\x02 \x01(\tR\faddressLine2\x12\x1b\n" +
@@ -1190,7 +3006,15 @@ const file_masjid_service_proto_rawDesc = "" +
 	"\vPhoneNumber\x12!\n" +
 	"\fcountry_code\x18\x01 \x01(\tR\vcountryCode\x12\x16\n" +
 	"\x06number\x18\x02 \x01(\tR\x06number\x12\x1c\n" +
-	"\textension\x18\x03 \x01(\tR\textension\"E\n" +
+	"\textension\x18\x03 \x01(\tR\textensionB\x0f\n" +
+	"\r_hijri_offset\"Q\n" +
+	"\x05Qibla\x12'\n" +
+	"\x0fbearing_degrees\x18\x01 \x01(\x01R\x0ebearingDegrees\x12\x1f\n" +
+	"\vdistance_km\x18\x02 \x01(\x01R\n" +
+	"distanceKm\"U\n" +
+	"\x0fGetQiblaRequest\x12\x1f\n" +
+	"\blatitude\x18\x01 \x01(\x01B\x03\xe0A\x02R\blatitude\x12!\n" +
+	"\tlongitude\x18\x02 \x01(\x01B\x03\xe0A\x02R\tlongitude\"E\n" +
 	"\x13CreateMasjidRequest\x12.\n" +
 	"\x06masjid\x18\x01 \x01(\v2\x11.limestone.MasjidB\x03\xe0A\x02R\x06masjid\"E\n" +
 	"\x13UpdateMasjidRequest\x12.\n" +
@@ -1214,7 +3038,122 @@ const file_masjid_service_proto_rawDesc = "" +
 	"totalCount\x12!\n" +
 	"\fcurrent_page\x18\x03 \x01(\x05R\vcurrentPage\x12\x1f\n" +
 	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
-	"totalPages2\xc1\x04\n" +
+	"totalPages\"\x98\x01\n" +
+	"\x1aSearchNearbyMasjidsRequest\x12\x1f\n" +
+	"\blatitude\x18\x01 \x01(\x01B\x03\xe0A\x02R\blatitude\x12!\n" +
+	"\tlongitude\x18\x02 \x01(\x01B\x03\xe0A\x02R\tlongitude\x12 \n" +
+	"\tradius_km\x18\x03 \x01(\x01B\x03\xe0A\x02R\bradiusKm\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"Z\n" +
+	"\fNearbyMasjid\x12)\n" +
+	"\x06masjid\x18\x01 \x01(\v2\x11.limestone.MasjidR\x06masjid\x12\x1f\n" +
+	"\vdistance_km\x18\x02 \x01(\x01R\n" +
+	"distanceKm\"P\n" +
+	"\x1bSearchNearbyMasjidsResponse\x121\n" +
+	"\amasjids\x18\x01 \x03(\v2\x17.limestone.NearbyMasjidR\amasjids\"M\n" +
+	"\x15GetPrayerTimesRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"\xbc\x03\n" +
+	"\vPrayerTimes\x12\x1b\n" +
+	"\tmasjid_id\x18\x01 \x01(\tR\bmasjidId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\x12.\n" +
+	"\x04fajr\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04fajr\x124\n" +
+	"\asunrise\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\asunrise\x120\n" +
+	"\x05dhuhr\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05dhuhr\x12,\n" +
+	"\x03asr\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x03asr\x124\n" +
+	"\amaghrib\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\amaghrib\x12.\n" +
+	"\x04isha\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x04isha\x123\n" +
+	"\n" +
+	"hijri_date\x18\n" +
+	" \x01(\v2\x14.limestone.HijriDateR\thijriDate\"\xd0\x03\n" +
+	"\n" +
+	"IqamahRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tmasjid_id\x18\x02 \x01(\tR\bmasjidId\x12)\n" +
+	"\x06prayer\x18\x03 \x01(\x0e2\x11.limestone.PrayerR\x06prayer\x122\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1e.limestone.IqamahRule.RuleTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"fixed_time\x18\x05 \x01(\tR\tfixedTime\x12.\n" +
+	"\x13minutes_after_adhan\x18\x06 \x01(\x05R\x11minutesAfterAdhan\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\a \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\b \x01(\tR\aendDate\x12;\n" +
+	"\vcreate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"3\n" +
+	"\bRuleType\x12\x0e\n" +
+	"\n" +
+	"FIXED_TIME\x10\x00\x12\x17\n" +
+	"\x13MINUTES_AFTER_ADHAN\x10\x01\"k\n" +
+	"\x17CreateIqamahRuleRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12.\n" +
+	"\x04rule\x18\x02 \x01(\v2\x15.limestone.IqamahRuleB\x03\xe0A\x02R\x04rule\"\x80\x01\n" +
+	"\x17UpdateIqamahRuleRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12\x13\n" +
+	"\x02id\x18\x02 \x01(\tB\x03\xe0A\x02R\x02id\x12.\n" +
+	"\x04rule\x18\x03 \x01(\v2\x15.limestone.IqamahRuleB\x03\xe0A\x02R\x04rule\"P\n" +
+	"\x17DeleteIqamahRuleRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12\x13\n" +
+	"\x02id\x18\x02 \x01(\tB\x03\xe0A\x02R\x02id\"\x1a\n" +
+	"\x18DeleteIqamahRuleResponse\":\n" +
+	"\x16ListIqamahRulesRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\"F\n" +
+	"\x17ListIqamahRulesResponse\x12+\n" +
+	"\x05rules\x18\x01 \x03(\v2\x15.limestone.IqamahRuleR\x05rules\"U\n" +
+	"\x1dGetDailyPrayerScheduleRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"\xfe\x01\n" +
+	"\x06Iqamah\x12.\n" +
+	"\x04fajr\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04fajr\x120\n" +
+	"\x05dhuhr\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05dhuhr\x12,\n" +
+	"\x03asr\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03asr\x124\n" +
+	"\amaghrib\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\amaghrib\x12.\n" +
+	"\x04isha\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04isha\"n\n" +
+	"\x13DailyPrayerSchedule\x12,\n" +
+	"\x05adhan\x18\x01 \x01(\v2\x16.limestone.PrayerTimesR\x05adhan\x12)\n" +
+	"\x06iqamah\x18\x02 \x01(\v2\x11.limestone.IqamahR\x06iqamah\">\n" +
+	"\x1aWatchPrayerScheduleRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\"\xa0\x02\n" +
+	"\x13PrayerScheduleEvent\x127\n" +
+	"\x04kind\x18\x01 \x01(\x0e2#.limestone.PrayerScheduleEvent.KindR\x04kind\x12:\n" +
+	"\bschedule\x18\x02 \x01(\v2\x1e.limestone.DailyPrayerScheduleR\bschedule\x12)\n" +
+	"\x06prayer\x18\x03 \x01(\x0e2\x11.limestone.PrayerR\x06prayer\x12.\n" +
+	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"9\n" +
+	"\x04Kind\x12\f\n" +
+	"\bSNAPSHOT\x10\x00\x12\v\n" +
+	"\aUPDATED\x10\x01\x12\t\n" +
+	"\x05ADHAN\x10\x02\x12\v\n" +
+	"\aNEW_DAY\x10\x03\"\xe1\x01\n" +
+	"\x1cExportPrayerTimetableRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12\x17\n" +
+	"\x04year\x18\x02 \x01(\x05B\x03\xe0A\x02R\x04year\x12\x19\n" +
+	"\x05month\x18\x03 \x01(\x05B\x03\xe0A\x02R\x05month\x12F\n" +
+	"\x06format\x18\x04 \x01(\x0e2..limestone.ExportPrayerTimetableRequest.FormatR\x06format\"#\n" +
+	"\x06Format\x12\a\n" +
+	"\x03CSV\x10\x00\x12\a\n" +
+	"\x03ICS\x10\x01\x12\a\n" +
+	"\x03PDF\x10\x02\"o\n" +
+	"\x13PrayerTimetableFile\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"W\n" +
+	"\x1cImportPrayerTimetableRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12\x15\n" +
+	"\x03csv\x18\x02 \x01(\tB\x03\xe0A\x02R\x03csv\"~\n" +
+	"\x1dImportPrayerTimetableResponse\x12#\n" +
+	"\rimported_days\x18\x01 \x01(\x05R\fimportedDays\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate*U\n" +
+	"\x06Prayer\x12\x16\n" +
+	"\x12PRAYER_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04FAJR\x10\x01\x12\t\n" +
+	"\x05DHUHR\x10\x02\x12\a\n" +
+	"\x03ASR\x10\x03\x12\v\n" +
+	"\aMAGHRIB\x10\x04\x12\b\n" +
+	"\x04ISHA\x10\x052\xc0\x11\n" +
 	"\rMasjidService\x12v\n" +
 	"\fCreateMasjid\x12\x1e.limestone.CreateMasjidRequest\x1a!.limestone.StandardMasjidResponse\"#\xdaA\x06masjid\x82\xd3\xe4\x93\x02\x14:\x06masjid\"\n" +
 	"/v1/masjid\x12v\n" +
@@ -1222,7 +3161,18 @@ const file_masjid_service_proto_rawDesc = "" +
 	"/v1/masjid\x12i\n" +
 	"\tGetMasjid\x12\x1b.limestone.GetMasjidRequest\x1a!.limestone.StandardMasjidResponse\"\x1c\xdaA\x02id\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/masjid/{id}\x12o\n" +
 	"\fDeleteMasjid\x12\x1e.limestone.DeleteMasjidRequest\x1a!.limestone.StandardMasjidResponse\"\x1c\xdaA\x02id\x82\xd3\xe4\x93\x02\x11*\x0f/v1/masjid/{id}\x12d\n" +
-	"\vListMasjids\x12\x1d.limestone.ListMasjidsRequest\x1a!.limestone.StandardMasjidResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/masjidsBj\n" +
+	"\vListMasjids\x12\x1d.limestone.ListMasjidsRequest\x1a!.limestone.StandardMasjidResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/masjids\x12\x9a\x01\n" +
+	"\x13SearchNearbyMasjids\x12%.limestone.SearchNearbyMasjidsRequest\x1a!.limestone.StandardMasjidResponse\"9\xdaA\x1clatitude,longitude,radius_km\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/masjids/nearby\x12\x93\x01\n" +
+	"\x0eGetPrayerTimes\x12 .limestone.GetPrayerTimesRequest\x1a!.limestone.StandardMasjidResponse\"<\xdaA\x0emasjid_id,date\x82\xd3\xe4\x93\x02%\x12#/v1/masjid/{masjid_id}/prayer_times\x12\x9d\x01\n" +
+	"\x10CreateIqamahRule\x12\".limestone.CreateIqamahRuleRequest\x1a!.limestone.StandardMasjidResponse\"B\xdaA\x0emasjid_id,rule\x82\xd3\xe4\x93\x02+:\x04rule\"#/v1/masjid/{masjid_id}/iqamah_rules\x12\xa5\x01\n" +
+	"\x10UpdateIqamahRule\x12\".limestone.UpdateIqamahRuleRequest\x1a!.limestone.StandardMasjidResponse\"J\xdaA\x11masjid_id,id,rule\x82\xd3\xe4\x93\x020:\x04rule2(/v1/masjid/{masjid_id}/iqamah_rules/{id}\x12\x9a\x01\n" +
+	"\x10DeleteIqamahRule\x12\".limestone.DeleteIqamahRuleRequest\x1a!.limestone.StandardMasjidResponse\"?\xdaA\fmasjid_id,id\x82\xd3\xe4\x93\x02**(/v1/masjid/{masjid_id}/iqamah_rules/{id}\x12\x90\x01\n" +
+	"\x0fListIqamahRules\x12!.limestone.ListIqamahRulesRequest\x1a!.limestone.StandardMasjidResponse\"7\xdaA\tmasjid_id\x82\xd3\xe4\x93\x02%\x12#/v1/masjid/{masjid_id}/iqamah_rules\x12\x9f\x01\n" +
+	"\x16GetDailyPrayerSchedule\x12(.limestone.GetDailyPrayerScheduleRequest\x1a!.limestone.StandardMasjidResponse\"8\xdaA\x0emasjid_id,date\x82\xd3\xe4\x93\x02!\x12\x1f/v1/masjid/{masjid_id}/schedule\x12\xab\x01\n" +
+	"\x15ExportPrayerTimetable\x12'.limestone.ExportPrayerTimetableRequest\x1a!.limestone.StandardMasjidResponse\"F\xdaA\x1bmasjid_id,year,month,format\x82\xd3\xe4\x93\x02\"\x12 /v1/masjid/{masjid_id}/timetable\x12\xa0\x01\n" +
+	"\x15ImportPrayerTimetable\x12'.limestone.ImportPrayerTimetableRequest\x1a!.limestone.StandardMasjidResponse\";\xdaA\rmasjid_id,csv\x82\xd3\xe4\x93\x02%:\x01*\" /v1/masjid/{masjid_id}/timetable\x12q\n" +
+	"\bGetQibla\x12\x1a.limestone.GetQiblaRequest\x1a!.limestone.StandardMasjidResponse\"&\xdaA\x12latitude,longitude\x82\xd3\xe4\x93\x02\v\x12\t/v1/qibla\x12l\n" +
+	"\x13WatchPrayerSchedule\x12%.limestone.WatchPrayerScheduleRequest\x1a\x1e.limestone.PrayerScheduleEvent\"\f\xdaA\tmasjid_id0\x01Bj\n" +
 	"\rcom.limestoneB\x12MasjidServiceProtoP\x01Z\x01.\xa2\x02\x03LXX\xaa\x02\tLimestone\xca\x02\tLimestone\xe2\x02\x15Limestone\\GPBMetadata\xea\x02\tLimestoneb\x06proto3"
 
 var (
@@ -1237,59 +3187,147 @@ func file_masjid_service_proto_rawDescGZIP() []byte {
 	return file_masjid_service_proto_rawDescData
 }
 
-var file_masjid_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_masjid_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_masjid_service_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_masjid_service_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_masjid_service_proto_goTypes = []any{
-	(PrayerTimesConfiguration_CalculationMethod)(0),    // 0: limestone.PrayerTimesConfiguration.CalculationMethod
-	(PrayerTimesConfiguration_AsrJuristicMethod)(0),    // 1: limestone.PrayerTimesConfiguration.AsrJuristicMethod
-	(PrayerTimesConfiguration_HighLatitudeRule)(0),     // 2: limestone.PrayerTimesConfiguration.HighLatitudeRule
-	(*StandardMasjidResponse)(nil),                     // 3: limestone.StandardMasjidResponse
-	(*PrayerTimesConfiguration)(nil),                   // 4: limestone.PrayerTimesConfiguration
-	(*Masjid)(nil),                                     // 5: limestone.Masjid
-	(*CreateMasjidRequest)(nil),                        // 6: limestone.CreateMasjidRequest
-	(*UpdateMasjidRequest)(nil),                        // 7: limestone.UpdateMasjidRequest
-	(*DeleteMasjidRequest)(nil),                        // 8: limestone.DeleteMasjidRequest
-	(*DeleteMasjidResponse)(nil),                       // 9: limestone.DeleteMasjidResponse
-	(*GetMasjidRequest)(nil),                           // 10: limestone.GetMasjidRequest
-	(*ListMasjidsRequest)(nil),                         // 11: limestone.ListMasjidsRequest
-	(*ListMasjidsResponse)(nil),                        // 12: limestone.ListMasjidsResponse
-	(*PrayerTimesConfiguration_PrayerAdjustments)(nil), // 13: limestone.PrayerTimesConfiguration.PrayerAdjustments
-	(*Masjid_Address)(nil),                             // 14: limestone.Masjid.Address
-	(*Masjid_PhoneNumber)(nil),                         // 15: limestone.Masjid.PhoneNumber
-	(*timestamppb.Timestamp)(nil),                      // 16: google.protobuf.Timestamp
+	(Prayer)(0), // 0: limestone.Prayer
+	(PrayerTimesConfiguration_CalculationMethod)(0),    // 1: limestone.PrayerTimesConfiguration.CalculationMethod
+	(PrayerTimesConfiguration_AsrJuristicMethod)(0),    // 2: limestone.PrayerTimesConfiguration.AsrJuristicMethod
+	(PrayerTimesConfiguration_HighLatitudeRule)(0),     // 3: limestone.PrayerTimesConfiguration.HighLatitudeRule
+	(IqamahRule_RuleType)(0),                           // 4: limestone.IqamahRule.RuleType
+	(PrayerScheduleEvent_Kind)(0),                      // 5: limestone.PrayerScheduleEvent.Kind
+	(ExportPrayerTimetableRequest_Format)(0),           // 6: limestone.ExportPrayerTimetableRequest.Format
+	(*StandardMasjidResponse)(nil),                     // 7: limestone.StandardMasjidResponse
+	(*PrayerTimesConfiguration)(nil),                   // 8: limestone.PrayerTimesConfiguration
+	(*Masjid)(nil),                                     // 9: limestone.Masjid
+	(*Qibla)(nil),                                      // 10: limestone.Qibla
+	(*GetQiblaRequest)(nil),                            // 11: limestone.GetQiblaRequest
+	(*CreateMasjidRequest)(nil),                        // 12: limestone.CreateMasjidRequest
+	(*UpdateMasjidRequest)(nil),                        // 13: limestone.UpdateMasjidRequest
+	(*DeleteMasjidRequest)(nil),                        // 14: limestone.DeleteMasjidRequest
+	(*DeleteMasjidResponse)(nil),                       // 15: limestone.DeleteMasjidResponse
+	(*GetMasjidRequest)(nil),                           // 16: limestone.GetMasjidRequest
+	(*ListMasjidsRequest)(nil),                         // 17: limestone.ListMasjidsRequest
+	(*ListMasjidsResponse)(nil),                        // 18: limestone.ListMasjidsResponse
+	(*SearchNearbyMasjidsRequest)(nil),                 // 19: limestone.SearchNearbyMasjidsRequest
+	(*NearbyMasjid)(nil),                               // 20: limestone.NearbyMasjid
+	(*SearchNearbyMasjidsResponse)(nil),                // 21: limestone.SearchNearbyMasjidsResponse
+	(*GetPrayerTimesRequest)(nil),                      // 22: limestone.GetPrayerTimesRequest
+	(*PrayerTimes)(nil),                                // 23: limestone.PrayerTimes
+	(*IqamahRule)(nil),                                 // 24: limestone.IqamahRule
+	(*CreateIqamahRuleRequest)(nil),                    // 25: limestone.CreateIqamahRuleRequest
+	(*UpdateIqamahRuleRequest)(nil),                    // 26: limestone.UpdateIqamahRuleRequest
+	(*DeleteIqamahRuleRequest)(nil),                    // 27: limestone.DeleteIqamahRuleRequest
+	(*DeleteIqamahRuleResponse)(nil),                   // 28: limestone.DeleteIqamahRuleResponse
+	(*ListIqamahRulesRequest)(nil),                     // 29: limestone.ListIqamahRulesRequest
+	(*ListIqamahRulesResponse)(nil),                    // 30: limestone.ListIqamahRulesResponse
+	(*GetDailyPrayerScheduleRequest)(nil),              // 31: limestone.GetDailyPrayerScheduleRequest
+	(*Iqamah)(nil),                                     // 32: limestone.Iqamah
+	(*DailyPrayerSchedule)(nil),                        // 33: limestone.DailyPrayerSchedule
+	(*WatchPrayerScheduleRequest)(nil),                 // 34: limestone.WatchPrayerScheduleRequest
+	(*PrayerScheduleEvent)(nil),                        // 35: limestone.PrayerScheduleEvent
+	(*ExportPrayerTimetableRequest)(nil),               // 36: limestone.ExportPrayerTimetableRequest
+	(*PrayerTimetableFile)(nil),                        // 37: limestone.PrayerTimetableFile
+	(*ImportPrayerTimetableRequest)(nil),               // 38: limestone.ImportPrayerTimetableRequest
+	(*ImportPrayerTimetableResponse)(nil),              // 39: limestone.ImportPrayerTimetableResponse
+	(*PrayerTimesConfiguration_PrayerAdjustments)(nil), // 40: limestone.PrayerTimesConfiguration.PrayerAdjustments
+	(*Masjid_Address)(nil),                             // 41: limestone.Masjid.Address
+	(*Masjid_PhoneNumber)(nil),                         // 42: limestone.Masjid.PhoneNumber
+	(*timestamppb.Timestamp)(nil),                      // 43: google.protobuf.Timestamp
+	(*HijriDate)(nil),                                  // 44: limestone.HijriDate
 }
 var file_masjid_service_proto_depIdxs = []int32{
-	5,  // 0: limestone.StandardMasjidResponse.Masjid:type_name -> limestone.Masjid
-	9,  // 1: limestone.StandardMasjidResponse.delete_masjid_response:type_name -> limestone.DeleteMasjidResponse
-	12, // 2: limestone.StandardMasjidResponse.list_masjid_response:type_name -> limestone.ListMasjidsResponse
-	10, // 3: limestone.StandardMasjidResponse.get_masjid_response:type_name -> limestone.GetMasjidRequest
-	0,  // 4: limestone.PrayerTimesConfiguration.method:type_name -> limestone.PrayerTimesConfiguration.CalculationMethod
-	1,  // 5: limestone.PrayerTimesConfiguration.asr_method:type_name -> limestone.PrayerTimesConfiguration.AsrJuristicMethod
-	2,  // 6: limestone.PrayerTimesConfiguration.high_latitude_rule:type_name -> limestone.PrayerTimesConfiguration.HighLatitudeRule
-	13, // 7: limestone.PrayerTimesConfiguration.adjustments:type_name -> limestone.PrayerTimesConfiguration.PrayerAdjustments
-	14, // 8: limestone.Masjid.address:type_name -> limestone.Masjid.Address
-	15, // 9: limestone.Masjid.phone_number:type_name -> limestone.Masjid.PhoneNumber
-	4,  // 10: limestone.Masjid.prayer_config:type_name -> limestone.PrayerTimesConfiguration
-	16, // 11: limestone.Masjid.create_time:type_name -> google.protobuf.Timestamp
-	16, // 12: limestone.Masjid.update_time:type_name -> google.protobuf.Timestamp
-	5,  // 13: limestone.CreateMasjidRequest.masjid:type_name -> limestone.Masjid
-	5,  // 14: limestone.UpdateMasjidRequest.masjid:type_name -> limestone.Masjid
-	5,  // 15: limestone.ListMasjidsResponse.masjids:type_name -> limestone.Masjid
-	6,  // 16: limestone.MasjidService.CreateMasjid:input_type -> limestone.CreateMasjidRequest
-	7,  // 17: limestone.MasjidService.UpdateMasjid:input_type -> limestone.UpdateMasjidRequest
-	10, // 18: limestone.MasjidService.GetMasjid:input_type -> limestone.GetMasjidRequest
-	8,  // 19: limestone.MasjidService.DeleteMasjid:input_type -> limestone.DeleteMasjidRequest
-	11, // 20: limestone.MasjidService.ListMasjids:input_type -> limestone.ListMasjidsRequest
-	3,  // 21: limestone.MasjidService.CreateMasjid:output_type -> limestone.StandardMasjidResponse
-	3,  // 22: limestone.MasjidService.UpdateMasjid:output_type -> limestone.StandardMasjidResponse
-	3,  // 23: limestone.MasjidService.GetMasjid:output_type -> limestone.StandardMasjidResponse
-	3,  // 24: limestone.MasjidService.DeleteMasjid:output_type -> limestone.StandardMasjidResponse
-	3,  // 25: limestone.MasjidService.ListMasjids:output_type -> limestone.StandardMasjidResponse
-	21, // [21:26] is the sub-list for method output_type
-	16, // [16:21] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	9,  // 0: limestone.StandardMasjidResponse.Masjid:type_name -> limestone.Masjid
+	15, // 1: limestone.StandardMasjidResponse.delete_masjid_response:type_name -> limestone.DeleteMasjidResponse
+	18, // 2: limestone.StandardMasjidResponse.list_masjid_response:type_name -> limestone.ListMasjidsResponse
+	16, // 3: limestone.StandardMasjidResponse.get_masjid_response:type_name -> limestone.GetMasjidRequest
+	23, // 4: limestone.StandardMasjidResponse.prayer_times:type_name -> limestone.PrayerTimes
+	21, // 5: limestone.StandardMasjidResponse.search_nearby_masjids_response:type_name -> limestone.SearchNearbyMasjidsResponse
+	24, // 6: limestone.StandardMasjidResponse.iqamah_rule:type_name -> limestone.IqamahRule
+	30, // 7: limestone.StandardMasjidResponse.list_iqamah_rules_response:type_name -> limestone.ListIqamahRulesResponse
+	28, // 8: limestone.StandardMasjidResponse.delete_iqamah_rule_response:type_name -> limestone.DeleteIqamahRuleResponse
+	33, // 9: limestone.StandardMasjidResponse.daily_prayer_schedule:type_name -> limestone.DailyPrayerSchedule
+	37, // 10: limestone.StandardMasjidResponse.prayer_timetable_file:type_name -> limestone.PrayerTimetableFile
+	39, // 11: limestone.StandardMasjidResponse.import_prayer_timetable_response:type_name -> limestone.ImportPrayerTimetableResponse
+	10, // 12: limestone.StandardMasjidResponse.qibla:type_name -> limestone.Qibla
+	1,  // 13: limestone.PrayerTimesConfiguration.method:type_name -> limestone.PrayerTimesConfiguration.CalculationMethod
+	2,  // 14: limestone.PrayerTimesConfiguration.asr_method:type_name -> limestone.PrayerTimesConfiguration.AsrJuristicMethod
+	3,  // 15: limestone.PrayerTimesConfiguration.high_latitude_rule:type_name -> limestone.PrayerTimesConfiguration.HighLatitudeRule
+	40, // 16: limestone.PrayerTimesConfiguration.adjustments:type_name -> limestone.PrayerTimesConfiguration.PrayerAdjustments
+	41, // 17: limestone.Masjid.address:type_name -> limestone.Masjid.Address
+	42, // 18: limestone.Masjid.phone_number:type_name -> limestone.Masjid.PhoneNumber
+	8,  // 19: limestone.Masjid.prayer_config:type_name -> limestone.PrayerTimesConfiguration
+	43, // 20: limestone.Masjid.create_time:type_name -> google.protobuf.Timestamp
+	43, // 21: limestone.Masjid.update_time:type_name -> google.protobuf.Timestamp
+	10, // 22: limestone.Masjid.qibla:type_name -> limestone.Qibla
+	9,  // 23: limestone.CreateMasjidRequest.masjid:type_name -> limestone.Masjid
+	9,  // 24: limestone.UpdateMasjidRequest.masjid:type_name -> limestone.Masjid
+	9,  // 25: limestone.ListMasjidsResponse.masjids:type_name -> limestone.Masjid
+	9,  // 26: limestone.NearbyMasjid.masjid:type_name -> limestone.Masjid
+	20, // 27: limestone.SearchNearbyMasjidsResponse.masjids:type_name -> limestone.NearbyMasjid
+	43, // 28: limestone.PrayerTimes.fajr:type_name -> google.protobuf.Timestamp
+	43, // 29: limestone.PrayerTimes.sunrise:type_name -> google.protobuf.Timestamp
+	43, // 30: limestone.PrayerTimes.dhuhr:type_name -> google.protobuf.Timestamp
+	43, // 31: limestone.PrayerTimes.asr:type_name -> google.protobuf.Timestamp
+	43, // 32: limestone.PrayerTimes.maghrib:type_name -> google.protobuf.Timestamp
+	43, // 33: limestone.PrayerTimes.isha:type_name -> google.protobuf.Timestamp
+	44, // 34: limestone.PrayerTimes.hijri_date:type_name -> limestone.HijriDate
+	0,  // 35: limestone.IqamahRule.prayer:type_name -> limestone.Prayer
+	4,  // 36: limestone.IqamahRule.type:type_name -> limestone.IqamahRule.RuleType
+	43, // 37: limestone.IqamahRule.create_time:type_name -> google.protobuf.Timestamp
+	43, // 38: limestone.IqamahRule.update_time:type_name -> google.protobuf.Timestamp
+	24, // 39: limestone.CreateIqamahRuleRequest.rule:type_name -> limestone.IqamahRule
+	24, // 40: limestone.UpdateIqamahRuleRequest.rule:type_name -> limestone.IqamahRule
+	24, // 41: limestone.ListIqamahRulesResponse.rules:type_name -> limestone.IqamahRule
+	43, // 42: limestone.Iqamah.fajr:type_name -> google.protobuf.Timestamp
+	43, // 43: limestone.Iqamah.dhuhr:type_name -> google.protobuf.Timestamp
+	43, // 44: limestone.Iqamah.asr:type_name -> google.protobuf.Timestamp
+	43, // 45: limestone.Iqamah.maghrib:type_name -> google.protobuf.Timestamp
+	43, // 46: limestone.Iqamah.isha:type_name -> google.protobuf.Timestamp
+	23, // 47: limestone.DailyPrayerSchedule.adhan:type_name -> limestone.PrayerTimes
+	32, // 48: limestone.DailyPrayerSchedule.iqamah:type_name -> limestone.Iqamah
+	5,  // 49: limestone.PrayerScheduleEvent.kind:type_name -> limestone.PrayerScheduleEvent.Kind
+	33, // 50: limestone.PrayerScheduleEvent.schedule:type_name -> limestone.DailyPrayerSchedule
+	0,  // 51: limestone.PrayerScheduleEvent.prayer:type_name -> limestone.Prayer
+	43, // 52: limestone.PrayerScheduleEvent.time:type_name -> google.protobuf.Timestamp
+	6,  // 53: limestone.ExportPrayerTimetableRequest.format:type_name -> limestone.ExportPrayerTimetableRequest.Format
+	12, // 54: limestone.MasjidService.CreateMasjid:input_type -> limestone.CreateMasjidRequest
+	13, // 55: limestone.MasjidService.UpdateMasjid:input_type -> limestone.UpdateMasjidRequest
+	16, // 56: limestone.MasjidService.GetMasjid:input_type -> limestone.GetMasjidRequest
+	14, // 57: limestone.MasjidService.DeleteMasjid:input_type -> limestone.DeleteMasjidRequest
+	17, // 58: limestone.MasjidService.ListMasjids:input_type -> limestone.ListMasjidsRequest
+	19, // 59: limestone.MasjidService.SearchNearbyMasjids:input_type -> limestone.SearchNearbyMasjidsRequest
+	22, // 60: limestone.MasjidService.GetPrayerTimes:input_type -> limestone.GetPrayerTimesRequest
+	25, // 61: limestone.MasjidService.CreateIqamahRule:input_type -> limestone.CreateIqamahRuleRequest
+	26, // 62: limestone.MasjidService.UpdateIqamahRule:input_type -> limestone.UpdateIqamahRuleRequest
+	27, // 63: limestone.MasjidService.DeleteIqamahRule:input_type -> limestone.DeleteIqamahRuleRequest
+	29, // 64: limestone.MasjidService.ListIqamahRules:input_type -> limestone.ListIqamahRulesRequest
+	31, // 65: limestone.MasjidService.GetDailyPrayerSchedule:input_type -> limestone.GetDailyPrayerScheduleRequest
+	36, // 66: limestone.MasjidService.ExportPrayerTimetable:input_type -> limestone.ExportPrayerTimetableRequest
+	38, // 67: limestone.MasjidService.ImportPrayerTimetable:input_type -> limestone.ImportPrayerTimetableRequest
+	11, // 68: limestone.MasjidService.GetQibla:input_type -> limestone.GetQiblaRequest
+	34, // 69: limestone.MasjidService.WatchPrayerSchedule:input_type -> limestone.WatchPrayerScheduleRequest
+	7,  // 70: limestone.MasjidService.CreateMasjid:output_type -> limestone.StandardMasjidResponse
+	7,  // 71: limestone.MasjidService.UpdateMasjid:output_type -> limestone.StandardMasjidResponse
+	7,  // 72: limestone.MasjidService.GetMasjid:output_type -> limestone.StandardMasjidResponse
+	7,  // 73: limestone.MasjidService.DeleteMasjid:output_type -> limestone.StandardMasjidResponse
+	7,  // 74: limestone.MasjidService.ListMasjids:output_type -> limestone.StandardMasjidResponse
+	7,  // 75: limestone.MasjidService.SearchNearbyMasjids:output_type -> limestone.StandardMasjidResponse
+	7,  // 76: limestone.MasjidService.GetPrayerTimes:output_type -> limestone.StandardMasjidResponse
+	7,  // 77: limestone.MasjidService.CreateIqamahRule:output_type -> limestone.StandardMasjidResponse
+	7,  // 78: limestone.MasjidService.UpdateIqamahRule:output_type -> limestone.StandardMasjidResponse
+	7,  // 79: limestone.MasjidService.DeleteIqamahRule:output_type -> limestone.StandardMasjidResponse
+	7,  // 80: limestone.MasjidService.ListIqamahRules:output_type -> limestone.StandardMasjidResponse
+	7,  // 81: limestone.MasjidService.GetDailyPrayerSchedule:output_type -> limestone.StandardMasjidResponse
+	7,  // 82: limestone.MasjidService.ExportPrayerTimetable:output_type -> limestone.StandardMasjidResponse
+	7,  // 83: limestone.MasjidService.ImportPrayerTimetable:output_type -> limestone.StandardMasjidResponse
+	7,  // 84: limestone.MasjidService.GetQibla:output_type -> limestone.StandardMasjidResponse
+	35, // 85: limestone.MasjidService.WatchPrayerSchedule:output_type -> limestone.PrayerScheduleEvent
+	70, // [70:86] is the sub-list for method output_type
+	54, // [54:70] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_masjid_service_proto_init() }
@@ -1297,20 +3335,31 @@ func file_masjid_service_proto_init() {
 	if File_masjid_service_proto != nil {
 		return
 	}
+	file_hijri_date_proto_init()
 	file_masjid_service_proto_msgTypes[0].OneofWrappers = []any{
 		(*StandardMasjidResponse_Masjid)(nil),
 		(*StandardMasjidResponse_DeleteMasjidResponse)(nil),
 		(*StandardMasjidResponse_ListMasjidResponse)(nil),
 		(*StandardMasjidResponse_GetMasjidResponse)(nil),
-	}
-	file_masjid_service_proto_msgTypes[8].OneofWrappers = []any{}
+		(*StandardMasjidResponse_PrayerTimes)(nil),
+		(*StandardMasjidResponse_SearchNearbyMasjidsResponse)(nil),
+		(*StandardMasjidResponse_IqamahRule)(nil),
+		(*StandardMasjidResponse_ListIqamahRulesResponse)(nil),
+		(*StandardMasjidResponse_DeleteIqamahRuleResponse)(nil),
+		(*StandardMasjidResponse_DailyPrayerSchedule)(nil),
+		(*StandardMasjidResponse_PrayerTimetableFile)(nil),
+		(*StandardMasjidResponse_ImportPrayerTimetableResponse)(nil),
+		(*StandardMasjidResponse_Qibla)(nil),
+	}
+	file_masjid_service_proto_msgTypes[2].OneofWrappers = []any{}
+	file_masjid_service_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_masjid_service_proto_rawDesc), len(file_masjid_service_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   13,
+			NumEnums:      7,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
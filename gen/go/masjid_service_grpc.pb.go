@@ -19,11 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MasjidService_CreateMasjid_FullMethodName = "/limestone.MasjidService/CreateMasjid"
-	MasjidService_UpdateMasjid_FullMethodName = "/limestone.MasjidService/UpdateMasjid"
-	MasjidService_GetMasjid_FullMethodName    = "/limestone.MasjidService/GetMasjid"
-	MasjidService_DeleteMasjid_FullMethodName = "/limestone.MasjidService/DeleteMasjid"
-	MasjidService_ListMasjids_FullMethodName  = "/limestone.MasjidService/ListMasjids"
+	MasjidService_CreateMasjid_FullMethodName           = "/limestone.MasjidService/CreateMasjid"
+	MasjidService_UpdateMasjid_FullMethodName           = "/limestone.MasjidService/UpdateMasjid"
+	MasjidService_GetMasjid_FullMethodName              = "/limestone.MasjidService/GetMasjid"
+	MasjidService_DeleteMasjid_FullMethodName           = "/limestone.MasjidService/DeleteMasjid"
+	MasjidService_ListMasjids_FullMethodName            = "/limestone.MasjidService/ListMasjids"
+	MasjidService_SearchNearbyMasjids_FullMethodName    = "/limestone.MasjidService/SearchNearbyMasjids"
+	MasjidService_GetPrayerTimes_FullMethodName         = "/limestone.MasjidService/GetPrayerTimes"
+	MasjidService_CreateIqamahRule_FullMethodName       = "/limestone.MasjidService/CreateIqamahRule"
+	MasjidService_UpdateIqamahRule_FullMethodName       = "/limestone.MasjidService/UpdateIqamahRule"
+	MasjidService_DeleteIqamahRule_FullMethodName       = "/limestone.MasjidService/DeleteIqamahRule"
+	MasjidService_ListIqamahRules_FullMethodName        = "/limestone.MasjidService/ListIqamahRules"
+	MasjidService_GetDailyPrayerSchedule_FullMethodName = "/limestone.MasjidService/GetDailyPrayerSchedule"
+	MasjidService_ExportPrayerTimetable_FullMethodName  = "/limestone.MasjidService/ExportPrayerTimetable"
+	MasjidService_ImportPrayerTimetable_FullMethodName  = "/limestone.MasjidService/ImportPrayerTimetable"
+	MasjidService_GetQibla_FullMethodName               = "/limestone.MasjidService/GetQibla"
+	MasjidService_WatchPrayerSchedule_FullMethodName    = "/limestone.MasjidService/WatchPrayerSchedule"
 )
 
 // MasjidServiceClient is the client API for MasjidService service.
@@ -35,6 +46,19 @@ type MasjidServiceClient interface {
 	GetMasjid(ctx context.Context, in *GetMasjidRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error)
 	DeleteMasjid(ctx context.Context, in *DeleteMasjidRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error)
 	ListMasjids(ctx context.Context, in *ListMasjidsRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error)
+	SearchNearbyMasjids(ctx context.Context, in *SearchNearbyMasjidsRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error)
+	GetPrayerTimes(ctx context.Context, in *GetPrayerTimesRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error)
+	CreateIqamahRule(ctx context.Context, in *CreateIqamahRuleRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error)
+	UpdateIqamahRule(ctx context.Context, in *UpdateIqamahRuleRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error)
+	DeleteIqamahRule(ctx context.Context, in *DeleteIqamahRuleRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error)
+	ListIqamahRules(ctx context.Context, in *ListIqamahRulesRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error)
+	GetDailyPrayerSchedule(ctx context.Context, in *GetDailyPrayerScheduleRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error)
+	ExportPrayerTimetable(ctx context.Context, in *ExportPrayerTimetableRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error)
+	ImportPrayerTimetable(ctx context.Context, in *ImportPrayerTimetableRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error)
+	GetQibla(ctx context.Context, in *GetQiblaRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error)
+	// Streams the masjid's schedule to adhan devices and displays. Only
+	// available over gRPC.
+	WatchPrayerSchedule(ctx context.Context, in *WatchPrayerScheduleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PrayerScheduleEvent], error)
 }
 
 type masjidServiceClient struct {
@@ -95,6 +119,125 @@ func (c *masjidServiceClient) ListMasjids(ctx context.Context, in *ListMasjidsRe
 	return out, nil
 }
 
+func (c *masjidServiceClient) SearchNearbyMasjids(ctx context.Context, in *SearchNearbyMasjidsRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardMasjidResponse)
+	err := c.cc.Invoke(ctx, MasjidService_SearchNearbyMasjids_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masjidServiceClient) GetPrayerTimes(ctx context.Context, in *GetPrayerTimesRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardMasjidResponse)
+	err := c.cc.Invoke(ctx, MasjidService_GetPrayerTimes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masjidServiceClient) CreateIqamahRule(ctx context.Context, in *CreateIqamahRuleRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardMasjidResponse)
+	err := c.cc.Invoke(ctx, MasjidService_CreateIqamahRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masjidServiceClient) UpdateIqamahRule(ctx context.Context, in *UpdateIqamahRuleRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardMasjidResponse)
+	err := c.cc.Invoke(ctx, MasjidService_UpdateIqamahRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masjidServiceClient) DeleteIqamahRule(ctx context.Context, in *DeleteIqamahRuleRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardMasjidResponse)
+	err := c.cc.Invoke(ctx, MasjidService_DeleteIqamahRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masjidServiceClient) ListIqamahRules(ctx context.Context, in *ListIqamahRulesRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardMasjidResponse)
+	err := c.cc.Invoke(ctx, MasjidService_ListIqamahRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masjidServiceClient) GetDailyPrayerSchedule(ctx context.Context, in *GetDailyPrayerScheduleRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardMasjidResponse)
+	err := c.cc.Invoke(ctx, MasjidService_GetDailyPrayerSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masjidServiceClient) ExportPrayerTimetable(ctx context.Context, in *ExportPrayerTimetableRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardMasjidResponse)
+	err := c.cc.Invoke(ctx, MasjidService_ExportPrayerTimetable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masjidServiceClient) ImportPrayerTimetable(ctx context.Context, in *ImportPrayerTimetableRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardMasjidResponse)
+	err := c.cc.Invoke(ctx, MasjidService_ImportPrayerTimetable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masjidServiceClient) GetQibla(ctx context.Context, in *GetQiblaRequest, opts ...grpc.CallOption) (*StandardMasjidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardMasjidResponse)
+	err := c.cc.Invoke(ctx, MasjidService_GetQibla_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masjidServiceClient) WatchPrayerSchedule(ctx context.Context, in *WatchPrayerScheduleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PrayerScheduleEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MasjidService_ServiceDesc.Streams[0], MasjidService_WatchPrayerSchedule_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPrayerScheduleRequest, PrayerScheduleEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MasjidService_WatchPrayerScheduleClient = grpc.ServerStreamingClient[PrayerScheduleEvent]
+
 // MasjidServiceServer is the server API for MasjidService service.
 // All implementations must embed UnimplementedMasjidServiceServer
 // for forward compatibility.
@@ -104,6 +247,19 @@ type MasjidServiceServer interface {
 	GetMasjid(context.Context, *GetMasjidRequest) (*StandardMasjidResponse, error)
 	DeleteMasjid(context.Context, *DeleteMasjidRequest) (*StandardMasjidResponse, error)
 	ListMasjids(context.Context, *ListMasjidsRequest) (*StandardMasjidResponse, error)
+	SearchNearbyMasjids(context.Context, *SearchNearbyMasjidsRequest) (*StandardMasjidResponse, error)
+	GetPrayerTimes(context.Context, *GetPrayerTimesRequest) (*StandardMasjidResponse, error)
+	CreateIqamahRule(context.Context, *CreateIqamahRuleRequest) (*StandardMasjidResponse, error)
+	UpdateIqamahRule(context.Context, *UpdateIqamahRuleRequest) (*StandardMasjidResponse, error)
+	DeleteIqamahRule(context.Context, *DeleteIqamahRuleRequest) (*StandardMasjidResponse, error)
+	ListIqamahRules(context.Context, *ListIqamahRulesRequest) (*StandardMasjidResponse, error)
+	GetDailyPrayerSchedule(context.Context, *GetDailyPrayerScheduleRequest) (*StandardMasjidResponse, error)
+	ExportPrayerTimetable(context.Context, *ExportPrayerTimetableRequest) (*StandardMasjidResponse, error)
+	ImportPrayerTimetable(context.Context, *ImportPrayerTimetableRequest) (*StandardMasjidResponse, error)
+	GetQibla(context.Context, *GetQiblaRequest) (*StandardMasjidResponse, error)
+	// Streams the masjid's schedule to adhan devices and displays. Only
+	// available over gRPC.
+	WatchPrayerSchedule(*WatchPrayerScheduleRequest, grpc.ServerStreamingServer[PrayerScheduleEvent]) error
 	mustEmbedUnimplementedMasjidServiceServer()
 }
 
@@ -129,6 +285,39 @@ func (UnimplementedMasjidServiceServer) DeleteMasjid(context.Context, *DeleteMas
 func (UnimplementedMasjidServiceServer) ListMasjids(context.Context, *ListMasjidsRequest) (*StandardMasjidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMasjids not implemented")
 }
+func (UnimplementedMasjidServiceServer) SearchNearbyMasjids(context.Context, *SearchNearbyMasjidsRequest) (*StandardMasjidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchNearbyMasjids not implemented")
+}
+func (UnimplementedMasjidServiceServer) GetPrayerTimes(context.Context, *GetPrayerTimesRequest) (*StandardMasjidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrayerTimes not implemented")
+}
+func (UnimplementedMasjidServiceServer) CreateIqamahRule(context.Context, *CreateIqamahRuleRequest) (*StandardMasjidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIqamahRule not implemented")
+}
+func (UnimplementedMasjidServiceServer) UpdateIqamahRule(context.Context, *UpdateIqamahRuleRequest) (*StandardMasjidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIqamahRule not implemented")
+}
+func (UnimplementedMasjidServiceServer) DeleteIqamahRule(context.Context, *DeleteIqamahRuleRequest) (*StandardMasjidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIqamahRule not implemented")
+}
+func (UnimplementedMasjidServiceServer) ListIqamahRules(context.Context, *ListIqamahRulesRequest) (*StandardMasjidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIqamahRules not implemented")
+}
+func (UnimplementedMasjidServiceServer) GetDailyPrayerSchedule(context.Context, *GetDailyPrayerScheduleRequest) (*StandardMasjidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyPrayerSchedule not implemented")
+}
+func (UnimplementedMasjidServiceServer) ExportPrayerTimetable(context.Context, *ExportPrayerTimetableRequest) (*StandardMasjidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPrayerTimetable not implemented")
+}
+func (UnimplementedMasjidServiceServer) ImportPrayerTimetable(context.Context, *ImportPrayerTimetableRequest) (*StandardMasjidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportPrayerTimetable not implemented")
+}
+func (UnimplementedMasjidServiceServer) GetQibla(context.Context, *GetQiblaRequest) (*StandardMasjidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQibla not implemented")
+}
+func (UnimplementedMasjidServiceServer) WatchPrayerSchedule(*WatchPrayerScheduleRequest, grpc.ServerStreamingServer[PrayerScheduleEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPrayerSchedule not implemented")
+}
 func (UnimplementedMasjidServiceServer) mustEmbedUnimplementedMasjidServiceServer() {}
 func (UnimplementedMasjidServiceServer) testEmbeddedByValue()                       {}
 
@@ -240,6 +429,197 @@ func _MasjidService_ListMasjids_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MasjidService_SearchNearbyMasjids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchNearbyMasjidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasjidServiceServer).SearchNearbyMasjids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasjidService_SearchNearbyMasjids_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasjidServiceServer).SearchNearbyMasjids(ctx, req.(*SearchNearbyMasjidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasjidService_GetPrayerTimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrayerTimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasjidServiceServer).GetPrayerTimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasjidService_GetPrayerTimes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasjidServiceServer).GetPrayerTimes(ctx, req.(*GetPrayerTimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasjidService_CreateIqamahRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIqamahRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasjidServiceServer).CreateIqamahRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasjidService_CreateIqamahRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasjidServiceServer).CreateIqamahRule(ctx, req.(*CreateIqamahRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasjidService_UpdateIqamahRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIqamahRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasjidServiceServer).UpdateIqamahRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasjidService_UpdateIqamahRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasjidServiceServer).UpdateIqamahRule(ctx, req.(*UpdateIqamahRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasjidService_DeleteIqamahRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIqamahRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasjidServiceServer).DeleteIqamahRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasjidService_DeleteIqamahRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasjidServiceServer).DeleteIqamahRule(ctx, req.(*DeleteIqamahRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasjidService_ListIqamahRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIqamahRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasjidServiceServer).ListIqamahRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasjidService_ListIqamahRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasjidServiceServer).ListIqamahRules(ctx, req.(*ListIqamahRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasjidService_GetDailyPrayerSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyPrayerScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasjidServiceServer).GetDailyPrayerSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasjidService_GetDailyPrayerSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasjidServiceServer).GetDailyPrayerSchedule(ctx, req.(*GetDailyPrayerScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasjidService_ExportPrayerTimetable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPrayerTimetableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasjidServiceServer).ExportPrayerTimetable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasjidService_ExportPrayerTimetable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasjidServiceServer).ExportPrayerTimetable(ctx, req.(*ExportPrayerTimetableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasjidService_ImportPrayerTimetable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPrayerTimetableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasjidServiceServer).ImportPrayerTimetable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasjidService_ImportPrayerTimetable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasjidServiceServer).ImportPrayerTimetable(ctx, req.(*ImportPrayerTimetableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasjidService_GetQibla_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQiblaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasjidServiceServer).GetQibla(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasjidService_GetQibla_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasjidServiceServer).GetQibla(ctx, req.(*GetQiblaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasjidService_WatchPrayerSchedule_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPrayerScheduleRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MasjidServiceServer).WatchPrayerSchedule(m, &grpc.GenericServerStream[WatchPrayerScheduleRequest, PrayerScheduleEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MasjidService_WatchPrayerScheduleServer = grpc.ServerStreamingServer[PrayerScheduleEvent]
+
 // MasjidService_ServiceDesc is the grpc.ServiceDesc for MasjidService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,7 +647,53 @@ var MasjidService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMasjids",
 			Handler:    _MasjidService_ListMasjids_Handler,
 		},
+		{
+			MethodName: "SearchNearbyMasjids",
+			Handler:    _MasjidService_SearchNearbyMasjids_Handler,
+		},
+		{
+			MethodName: "GetPrayerTimes",
+			Handler:    _MasjidService_GetPrayerTimes_Handler,
+		},
+		{
+			MethodName: "CreateIqamahRule",
+			Handler:    _MasjidService_CreateIqamahRule_Handler,
+		},
+		{
+			MethodName: "UpdateIqamahRule",
+			Handler:    _MasjidService_UpdateIqamahRule_Handler,
+		},
+		{
+			MethodName: "DeleteIqamahRule",
+			Handler:    _MasjidService_DeleteIqamahRule_Handler,
+		},
+		{
+			MethodName: "ListIqamahRules",
+			Handler:    _MasjidService_ListIqamahRules_Handler,
+		},
+		{
+			MethodName: "GetDailyPrayerSchedule",
+			Handler:    _MasjidService_GetDailyPrayerSchedule_Handler,
+		},
+		{
+			MethodName: "ExportPrayerTimetable",
+			Handler:    _MasjidService_ExportPrayerTimetable_Handler,
+		},
+		{
+			MethodName: "ImportPrayerTimetable",
+			Handler:    _MasjidService_ImportPrayerTimetable_Handler,
+		},
+		{
+			MethodName: "GetQibla",
+			Handler:    _MasjidService_GetQibla_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPrayerSchedule",
+			Handler:       _MasjidService_WatchPrayerSchedule_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "masjid_service.proto",
 }
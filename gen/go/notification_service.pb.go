@@ -0,0 +1,960 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: notification_service.proto
+
+package __
+
+import (
+	_ "google.golang.org/genproto/googleapis/rpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StandardNotificationResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Status  string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*StandardNotificationResponse_Preferences
+	//	*StandardNotificationResponse_Follow
+	//	*StandardNotificationResponse_ListFollowsResponse
+	//	*StandardNotificationResponse_UnfollowResponse
+	//	*StandardNotificationResponse_Device
+	//	*StandardNotificationResponse_UnregisterDeviceResponse
+	Data          isStandardNotificationResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StandardNotificationResponse) Reset() {
+	*x = StandardNotificationResponse{}
+	mi := &file_notification_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StandardNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandardNotificationResponse) ProtoMessage() {}
+
+func (x *StandardNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandardNotificationResponse.ProtoReflect.Descriptor instead.
+func (*StandardNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StandardNotificationResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StandardNotificationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StandardNotificationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StandardNotificationResponse) GetData() isStandardNotificationResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *StandardNotificationResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		if x, ok := x.Data.(*StandardNotificationResponse_Preferences); ok {
+			return x.Preferences
+		}
+	}
+	return nil
+}
+
+func (x *StandardNotificationResponse) GetFollow() *MasjidFollow {
+	if x != nil {
+		if x, ok := x.Data.(*StandardNotificationResponse_Follow); ok {
+			return x.Follow
+		}
+	}
+	return nil
+}
+
+func (x *StandardNotificationResponse) GetListFollowsResponse() *ListFollowedMasjidsResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardNotificationResponse_ListFollowsResponse); ok {
+			return x.ListFollowsResponse
+		}
+	}
+	return nil
+}
+
+func (x *StandardNotificationResponse) GetUnfollowResponse() *UnfollowMasjidResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardNotificationResponse_UnfollowResponse); ok {
+			return x.UnfollowResponse
+		}
+	}
+	return nil
+}
+
+func (x *StandardNotificationResponse) GetDevice() *PushDevice {
+	if x != nil {
+		if x, ok := x.Data.(*StandardNotificationResponse_Device); ok {
+			return x.Device
+		}
+	}
+	return nil
+}
+
+func (x *StandardNotificationResponse) GetUnregisterDeviceResponse() *UnregisterPushDeviceResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardNotificationResponse_UnregisterDeviceResponse); ok {
+			return x.UnregisterDeviceResponse
+		}
+	}
+	return nil
+}
+
+type isStandardNotificationResponse_Data interface {
+	isStandardNotificationResponse_Data()
+}
+
+type StandardNotificationResponse_Preferences struct {
+	Preferences *NotificationPreferences `protobuf:"bytes,4,opt,name=preferences,proto3,oneof"`
+}
+
+type StandardNotificationResponse_Follow struct {
+	Follow *MasjidFollow `protobuf:"bytes,5,opt,name=follow,proto3,oneof"`
+}
+
+type StandardNotificationResponse_ListFollowsResponse struct {
+	ListFollowsResponse *ListFollowedMasjidsResponse `protobuf:"bytes,6,opt,name=list_follows_response,json=listFollowsResponse,proto3,oneof"`
+}
+
+type StandardNotificationResponse_UnfollowResponse struct {
+	UnfollowResponse *UnfollowMasjidResponse `protobuf:"bytes,7,opt,name=unfollow_response,json=unfollowResponse,proto3,oneof"`
+}
+
+type StandardNotificationResponse_Device struct {
+	Device *PushDevice `protobuf:"bytes,8,opt,name=device,proto3,oneof"`
+}
+
+type StandardNotificationResponse_UnregisterDeviceResponse struct {
+	UnregisterDeviceResponse *UnregisterPushDeviceResponse `protobuf:"bytes,9,opt,name=unregister_device_response,json=unregisterDeviceResponse,proto3,oneof"`
+}
+
+func (*StandardNotificationResponse_Preferences) isStandardNotificationResponse_Data() {}
+
+func (*StandardNotificationResponse_Follow) isStandardNotificationResponse_Data() {}
+
+func (*StandardNotificationResponse_ListFollowsResponse) isStandardNotificationResponse_Data() {}
+
+func (*StandardNotificationResponse_UnfollowResponse) isStandardNotificationResponse_Data() {}
+
+func (*StandardNotificationResponse_Device) isStandardNotificationResponse_Data() {}
+
+func (*StandardNotificationResponse_UnregisterDeviceResponse) isStandardNotificationResponse_Data() {}
+
+type NotificationPreferences struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Turn on the channels notifications are sent over.
+	Email bool `protobuf:"varint,1,opt,name=email,proto3" json:"email,omitempty"`
+	Push  bool `protobuf:"varint,2,opt,name=push,proto3" json:"push,omitempty"`
+	// Turns on reminders before events start.
+	Reminders bool `protobuf:"varint,3,opt,name=reminders,proto3" json:"reminders,omitempty"`
+	// How long before events reminders are sent, from 5 minutes to 48 hours.
+	ReminderMinutes int32 `protobuf:"varint,4,opt,name=reminder_minutes,json=reminderMinutes,proto3" json:"reminder_minutes,omitempty"`
+	// Turns on notices of events being rescheduled or cancelled.
+	Changes       bool                   `protobuf:"varint,5,opt,name=changes,proto3" json:"changes,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_notification_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_notification_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotificationPreferences) GetEmail() bool {
+	if x != nil {
+		return x.Email
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetPush() bool {
+	if x != nil {
+		return x.Push
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetReminders() bool {
+	if x != nil {
+		return x.Reminders
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetReminderMinutes() int32 {
+	if x != nil {
+		return x.ReminderMinutes
+	}
+	return 0
+}
+
+func (x *NotificationPreferences) GetChanges() bool {
+	if x != nil {
+		return x.Changes
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type MasjidFollow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MasjidFollow) Reset() {
+	*x = MasjidFollow{}
+	mi := &file_notification_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MasjidFollow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MasjidFollow) ProtoMessage() {}
+
+func (x *MasjidFollow) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MasjidFollow.ProtoReflect.Descriptor instead.
+func (*MasjidFollow) Descriptor() ([]byte, []int) {
+	return file_notification_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MasjidFollow) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *MasjidFollow) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type PushDevice struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The registration token the app was given by the push service.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// E.g. "android" or "ios".
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushDevice) Reset() {
+	*x = PushDevice{}
+	mi := &file_notification_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushDevice) ProtoMessage() {}
+
+func (x *PushDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushDevice.ProtoReflect.Descriptor instead.
+func (*PushDevice) Descriptor() ([]byte, []int) {
+	return file_notification_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PushDevice) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PushDevice) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *PushDevice) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_notification_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_service_proto_rawDescGZIP(), []int{4}
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_notification_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type FollowMasjidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowMasjidRequest) Reset() {
+	*x = FollowMasjidRequest{}
+	mi := &file_notification_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowMasjidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowMasjidRequest) ProtoMessage() {}
+
+func (x *FollowMasjidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowMasjidRequest.ProtoReflect.Descriptor instead.
+func (*FollowMasjidRequest) Descriptor() ([]byte, []int) {
+	return file_notification_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FollowMasjidRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+type UnfollowMasjidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowMasjidRequest) Reset() {
+	*x = UnfollowMasjidRequest{}
+	mi := &file_notification_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowMasjidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowMasjidRequest) ProtoMessage() {}
+
+func (x *UnfollowMasjidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowMasjidRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowMasjidRequest) Descriptor() ([]byte, []int) {
+	return file_notification_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UnfollowMasjidRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+type UnfollowMasjidResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowMasjidResponse) Reset() {
+	*x = UnfollowMasjidResponse{}
+	mi := &file_notification_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowMasjidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowMasjidResponse) ProtoMessage() {}
+
+func (x *UnfollowMasjidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowMasjidResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowMasjidResponse) Descriptor() ([]byte, []int) {
+	return file_notification_service_proto_rawDescGZIP(), []int{8}
+}
+
+type ListFollowedMasjidsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowedMasjidsRequest) Reset() {
+	*x = ListFollowedMasjidsRequest{}
+	mi := &file_notification_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowedMasjidsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowedMasjidsRequest) ProtoMessage() {}
+
+func (x *ListFollowedMasjidsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowedMasjidsRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowedMasjidsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_service_proto_rawDescGZIP(), []int{9}
+}
+
+type ListFollowedMasjidsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Follows       []*MasjidFollow        `protobuf:"bytes,1,rep,name=follows,proto3" json:"follows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowedMasjidsResponse) Reset() {
+	*x = ListFollowedMasjidsResponse{}
+	mi := &file_notification_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowedMasjidsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowedMasjidsResponse) ProtoMessage() {}
+
+func (x *ListFollowedMasjidsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowedMasjidsResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowedMasjidsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListFollowedMasjidsResponse) GetFollows() []*MasjidFollow {
+	if x != nil {
+		return x.Follows
+	}
+	return nil
+}
+
+type RegisterPushDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        *PushDevice            `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterPushDeviceRequest) Reset() {
+	*x = RegisterPushDeviceRequest{}
+	mi := &file_notification_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterPushDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPushDeviceRequest) ProtoMessage() {}
+
+func (x *RegisterPushDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPushDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RegisterPushDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_notification_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RegisterPushDeviceRequest) GetDevice() *PushDevice {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+type UnregisterPushDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterPushDeviceRequest) Reset() {
+	*x = UnregisterPushDeviceRequest{}
+	mi := &file_notification_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterPushDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterPushDeviceRequest) ProtoMessage() {}
+
+func (x *UnregisterPushDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterPushDeviceRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterPushDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_notification_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UnregisterPushDeviceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UnregisterPushDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterPushDeviceResponse) Reset() {
+	*x = UnregisterPushDeviceResponse{}
+	mi := &file_notification_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterPushDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterPushDeviceResponse) ProtoMessage() {}
+
+func (x *UnregisterPushDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterPushDeviceResponse.ProtoReflect.Descriptor instead.
+func (*UnregisterPushDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_notification_service_proto_rawDescGZIP(), []int{13}
+}
+
+var File_notification_service_proto protoreflect.FileDescriptor
+
+const file_notification_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1anotification_service.proto\x12\tlimestone\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb1\x04\n" +
+	"\x1cStandardNotificationResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12F\n" +
+	"\vpreferences\x18\x04 \x01(\v2\".limestone.NotificationPreferencesH\x00R\vpreferences\x121\n" +
+	"\x06follow\x18\x05 \x01(\v2\x17.limestone.MasjidFollowH\x00R\x06follow\x12\\\n" +
+	"\x15list_follows_response\x18\x06 \x01(\v2&.limestone.ListFollowedMasjidsResponseH\x00R\x13listFollowsResponse\x12P\n" +
+	"\x11unfollow_response\x18\a \x01(\v2!.limestone.UnfollowMasjidResponseH\x00R\x10unfollowResponse\x12/\n" +
+	"\x06device\x18\b \x01(\v2\x15.limestone.PushDeviceH\x00R\x06device\x12g\n" +
+	"\x1aunregister_device_response\x18\t \x01(\v2'.limestone.UnregisterPushDeviceResponseH\x00R\x18unregisterDeviceResponseB\x06\n" +
+	"\x04data\"\xe8\x01\n" +
+	"\x17NotificationPreferences\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\bR\x05email\x12\x12\n" +
+	"\x04push\x18\x02 \x01(\bR\x04push\x12\x1c\n" +
+	"\treminders\x18\x03 \x01(\bR\treminders\x12)\n" +
+	"\x10reminder_minutes\x18\x04 \x01(\x05R\x0freminderMinutes\x12\x18\n" +
+	"\achanges\x18\x05 \x01(\bR\achanges\x12@\n" +
+	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\"h\n" +
+	"\fMasjidFollow\x12\x1b\n" +
+	"\tmasjid_id\x18\x01 \x01(\tR\bmasjidId\x12;\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\x85\x01\n" +
+	"\n" +
+	"PushDevice\x12\x19\n" +
+	"\x05token\x18\x01 \x01(\tB\x03\xe0A\x02R\x05token\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12@\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\"#\n" +
+	"!GetNotificationPreferencesRequest\"q\n" +
+	"$UpdateNotificationPreferencesRequest\x12I\n" +
+	"\vpreferences\x18\x01 \x01(\v2\".limestone.NotificationPreferencesB\x03\xe0A\x02R\vpreferences\"7\n" +
+	"\x13FollowMasjidRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\"9\n" +
+	"\x15UnfollowMasjidRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\"\x18\n" +
+	"\x16UnfollowMasjidResponse\"\x1c\n" +
+	"\x1aListFollowedMasjidsRequest\"P\n" +
+	"\x1bListFollowedMasjidsResponse\x121\n" +
+	"\afollows\x18\x01 \x03(\v2\x17.limestone.MasjidFollowR\afollows\"O\n" +
+	"\x19RegisterPushDeviceRequest\x122\n" +
+	"\x06device\x18\x01 \x01(\v2\x15.limestone.PushDeviceB\x03\xe0A\x02R\x06device\"8\n" +
+	"\x1bUnregisterPushDeviceRequest\x12\x19\n" +
+	"\x05token\x18\x01 \x01(\tB\x03\xe0A\x02R\x05token\"\x1e\n" +
+	"\x1cUnregisterPushDeviceResponse2\xad\b\n" +
+	"\x13NotificationService\x12\x99\x01\n" +
+	"\x1aGetNotificationPreferences\x12,.limestone.GetNotificationPreferencesRequest\x1a'.limestone.StandardNotificationResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/notification-preferences\x12\xba\x01\n" +
+	"\x1dUpdateNotificationPreferences\x12/.limestone.UpdateNotificationPreferencesRequest\x1a'.limestone.StandardNotificationResponse\"?\xdaA\vpreferences\x82\xd3\xe4\x93\x02+:\vpreferences2\x1c/v1/notification-preferences\x12\x8a\x01\n" +
+	"\fFollowMasjid\x12\x1e.limestone.FollowMasjidRequest\x1a'.limestone.StandardNotificationResponse\"1\xdaA\tmasjid_id\x82\xd3\xe4\x93\x02\x1f\"\x1d/v1/masjid/{masjid_id}/follow\x12\x8e\x01\n" +
+	"\x0eUnfollowMasjid\x12 .limestone.UnfollowMasjidRequest\x1a'.limestone.StandardNotificationResponse\"1\xdaA\tmasjid_id\x82\xd3\xe4\x93\x02\x1f*\x1d/v1/masjid/{masjid_id}/follow\x12z\n" +
+	"\x13ListFollowedMasjids\x12%.limestone.ListFollowedMasjidsRequest\x1a'.limestone.StandardNotificationResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/follows\x12\x8e\x01\n" +
+	"\x12RegisterPushDevice\x12$.limestone.RegisterPushDeviceRequest\x1a'.limestone.StandardNotificationResponse\")\xdaA\x06device\x82\xd3\xe4\x93\x02\x1a:\x06device\"\x10/v1/push-devices\x12\x91\x01\n" +
+	"\x14UnregisterPushDevice\x12&.limestone.UnregisterPushDeviceRequest\x1a'.limestone.StandardNotificationResponse\"(\xdaA\x05token\x82\xd3\xe4\x93\x02\x1a*\x18/v1/push-devices/{token}Bp\n" +
+	"\rcom.limestoneB\x18NotificationServiceProtoP\x01Z\x01.\xa2\x02\x03LXX\xaa\x02\tLimestone\xca\x02\tLimestone\xe2\x02\x15Limestone\\GPBMetadata\xea\x02\tLimestoneb\x06proto3"
+
+var (
+	file_notification_service_proto_rawDescOnce sync.Once
+	file_notification_service_proto_rawDescData []byte
+)
+
+func file_notification_service_proto_rawDescGZIP() []byte {
+	file_notification_service_proto_rawDescOnce.Do(func() {
+		file_notification_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_service_proto_rawDesc), len(file_notification_service_proto_rawDesc)))
+	})
+	return file_notification_service_proto_rawDescData
+}
+
+var file_notification_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_notification_service_proto_goTypes = []any{
+	(*StandardNotificationResponse)(nil),         // 0: limestone.StandardNotificationResponse
+	(*NotificationPreferences)(nil),              // 1: limestone.NotificationPreferences
+	(*MasjidFollow)(nil),                         // 2: limestone.MasjidFollow
+	(*PushDevice)(nil),                           // 3: limestone.PushDevice
+	(*GetNotificationPreferencesRequest)(nil),    // 4: limestone.GetNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesRequest)(nil), // 5: limestone.UpdateNotificationPreferencesRequest
+	(*FollowMasjidRequest)(nil),                  // 6: limestone.FollowMasjidRequest
+	(*UnfollowMasjidRequest)(nil),                // 7: limestone.UnfollowMasjidRequest
+	(*UnfollowMasjidResponse)(nil),               // 8: limestone.UnfollowMasjidResponse
+	(*ListFollowedMasjidsRequest)(nil),           // 9: limestone.ListFollowedMasjidsRequest
+	(*ListFollowedMasjidsResponse)(nil),          // 10: limestone.ListFollowedMasjidsResponse
+	(*RegisterPushDeviceRequest)(nil),            // 11: limestone.RegisterPushDeviceRequest
+	(*UnregisterPushDeviceRequest)(nil),          // 12: limestone.UnregisterPushDeviceRequest
+	(*UnregisterPushDeviceResponse)(nil),         // 13: limestone.UnregisterPushDeviceResponse
+	(*timestamppb.Timestamp)(nil),                // 14: google.protobuf.Timestamp
+}
+var file_notification_service_proto_depIdxs = []int32{
+	1,  // 0: limestone.StandardNotificationResponse.preferences:type_name -> limestone.NotificationPreferences
+	2,  // 1: limestone.StandardNotificationResponse.follow:type_name -> limestone.MasjidFollow
+	10, // 2: limestone.StandardNotificationResponse.list_follows_response:type_name -> limestone.ListFollowedMasjidsResponse
+	8,  // 3: limestone.StandardNotificationResponse.unfollow_response:type_name -> limestone.UnfollowMasjidResponse
+	3,  // 4: limestone.StandardNotificationResponse.device:type_name -> limestone.PushDevice
+	13, // 5: limestone.StandardNotificationResponse.unregister_device_response:type_name -> limestone.UnregisterPushDeviceResponse
+	14, // 6: limestone.NotificationPreferences.update_time:type_name -> google.protobuf.Timestamp
+	14, // 7: limestone.MasjidFollow.create_time:type_name -> google.protobuf.Timestamp
+	14, // 8: limestone.PushDevice.create_time:type_name -> google.protobuf.Timestamp
+	1,  // 9: limestone.UpdateNotificationPreferencesRequest.preferences:type_name -> limestone.NotificationPreferences
+	2,  // 10: limestone.ListFollowedMasjidsResponse.follows:type_name -> limestone.MasjidFollow
+	3,  // 11: limestone.RegisterPushDeviceRequest.device:type_name -> limestone.PushDevice
+	4,  // 12: limestone.NotificationService.GetNotificationPreferences:input_type -> limestone.GetNotificationPreferencesRequest
+	5,  // 13: limestone.NotificationService.UpdateNotificationPreferences:input_type -> limestone.UpdateNotificationPreferencesRequest
+	6,  // 14: limestone.NotificationService.FollowMasjid:input_type -> limestone.FollowMasjidRequest
+	7,  // 15: limestone.NotificationService.UnfollowMasjid:input_type -> limestone.UnfollowMasjidRequest
+	9,  // 16: limestone.NotificationService.ListFollowedMasjids:input_type -> limestone.ListFollowedMasjidsRequest
+	11, // 17: limestone.NotificationService.RegisterPushDevice:input_type -> limestone.RegisterPushDeviceRequest
+	12, // 18: limestone.NotificationService.UnregisterPushDevice:input_type -> limestone.UnregisterPushDeviceRequest
+	0,  // 19: limestone.NotificationService.GetNotificationPreferences:output_type -> limestone.StandardNotificationResponse
+	0,  // 20: limestone.NotificationService.UpdateNotificationPreferences:output_type -> limestone.StandardNotificationResponse
+	0,  // 21: limestone.NotificationService.FollowMasjid:output_type -> limestone.StandardNotificationResponse
+	0,  // 22: limestone.NotificationService.UnfollowMasjid:output_type -> limestone.StandardNotificationResponse
+	0,  // 23: limestone.NotificationService.ListFollowedMasjids:output_type -> limestone.StandardNotificationResponse
+	0,  // 24: limestone.NotificationService.RegisterPushDevice:output_type -> limestone.StandardNotificationResponse
+	0,  // 25: limestone.NotificationService.UnregisterPushDevice:output_type -> limestone.StandardNotificationResponse
+	19, // [19:26] is the sub-list for method output_type
+	12, // [12:19] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_notification_service_proto_init() }
+func file_notification_service_proto_init() {
+	if File_notification_service_proto != nil {
+		return
+	}
+	file_notification_service_proto_msgTypes[0].OneofWrappers = []any{
+		(*StandardNotificationResponse_Preferences)(nil),
+		(*StandardNotificationResponse_Follow)(nil),
+		(*StandardNotificationResponse_ListFollowsResponse)(nil),
+		(*StandardNotificationResponse_UnfollowResponse)(nil),
+		(*StandardNotificationResponse_Device)(nil),
+		(*StandardNotificationResponse_UnregisterDeviceResponse)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_service_proto_rawDesc), len(file_notification_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_service_proto_goTypes,
+		DependencyIndexes: file_notification_service_proto_depIdxs,
+		MessageInfos:      file_notification_service_proto_msgTypes,
+	}.Build()
+	File_notification_service_proto = out.File
+	file_notification_service_proto_goTypes = nil
+	file_notification_service_proto_depIdxs = nil
+}
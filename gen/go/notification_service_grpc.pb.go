@@ -0,0 +1,377 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: notification_service.proto
+
+package __
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NotificationService_GetNotificationPreferences_FullMethodName    = "/limestone.NotificationService/GetNotificationPreferences"
+	NotificationService_UpdateNotificationPreferences_FullMethodName = "/limestone.NotificationService/UpdateNotificationPreferences"
+	NotificationService_FollowMasjid_FullMethodName                  = "/limestone.NotificationService/FollowMasjid"
+	NotificationService_UnfollowMasjid_FullMethodName                = "/limestone.NotificationService/UnfollowMasjid"
+	NotificationService_ListFollowedMasjids_FullMethodName           = "/limestone.NotificationService/ListFollowedMasjids"
+	NotificationService_RegisterPushDevice_FullMethodName            = "/limestone.NotificationService/RegisterPushDevice"
+	NotificationService_UnregisterPushDevice_FullMethodName          = "/limestone.NotificationService/UnregisterPushDevice"
+)
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Members hear about the events they have RSVPed to and every event of the
+// masjids they follow: a reminder before each starts, and a notice when one
+// is rescheduled or cancelled. Notifications go by email and by push to the
+// devices members register, as their preferences allow.
+type NotificationServiceClient interface {
+	// Returns the caller's notification preferences, the defaults if they
+	// have not set their own.
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*StandardNotificationResponse, error)
+	// Replaces the caller's notification preferences.
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*StandardNotificationResponse, error)
+	// Follows a masjid, to hear about all of its events.
+	FollowMasjid(ctx context.Context, in *FollowMasjidRequest, opts ...grpc.CallOption) (*StandardNotificationResponse, error)
+	UnfollowMasjid(ctx context.Context, in *UnfollowMasjidRequest, opts ...grpc.CallOption) (*StandardNotificationResponse, error)
+	// Lists the masjids the caller follows, oldest first.
+	ListFollowedMasjids(ctx context.Context, in *ListFollowedMasjidsRequest, opts ...grpc.CallOption) (*StandardNotificationResponse, error)
+	// Registers a device of the caller's for push notifications. A device
+	// registered by another member before is theirs no longer.
+	RegisterPushDevice(ctx context.Context, in *RegisterPushDeviceRequest, opts ...grpc.CallOption) (*StandardNotificationResponse, error)
+	// Stops push notifications to one of the caller's devices, as when they
+	// sign out of the app on it.
+	UnregisterPushDevice(ctx context.Context, in *UnregisterPushDeviceRequest, opts ...grpc.CallOption) (*StandardNotificationResponse, error)
+}
+
+type notificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*StandardNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_GetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*StandardNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_UpdateNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) FollowMasjid(ctx context.Context, in *FollowMasjidRequest, opts ...grpc.CallOption) (*StandardNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_FollowMasjid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) UnfollowMasjid(ctx context.Context, in *UnfollowMasjidRequest, opts ...grpc.CallOption) (*StandardNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_UnfollowMasjid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ListFollowedMasjids(ctx context.Context, in *ListFollowedMasjidsRequest, opts ...grpc.CallOption) (*StandardNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ListFollowedMasjids_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) RegisterPushDevice(ctx context.Context, in *RegisterPushDeviceRequest, opts ...grpc.CallOption) (*StandardNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_RegisterPushDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) UnregisterPushDevice(ctx context.Context, in *UnregisterPushDeviceRequest, opts ...grpc.CallOption) (*StandardNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_UnregisterPushDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationServiceServer is the server API for NotificationService service.
+// All implementations must embed UnimplementedNotificationServiceServer
+// for forward compatibility.
+//
+// Members hear about the events they have RSVPed to and every event of the
+// masjids they follow: a reminder before each starts, and a notice when one
+// is rescheduled or cancelled. Notifications go by email and by push to the
+// devices members register, as their preferences allow.
+type NotificationServiceServer interface {
+	// Returns the caller's notification preferences, the defaults if they
+	// have not set their own.
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*StandardNotificationResponse, error)
+	// Replaces the caller's notification preferences.
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*StandardNotificationResponse, error)
+	// Follows a masjid, to hear about all of its events.
+	FollowMasjid(context.Context, *FollowMasjidRequest) (*StandardNotificationResponse, error)
+	UnfollowMasjid(context.Context, *UnfollowMasjidRequest) (*StandardNotificationResponse, error)
+	// Lists the masjids the caller follows, oldest first.
+	ListFollowedMasjids(context.Context, *ListFollowedMasjidsRequest) (*StandardNotificationResponse, error)
+	// Registers a device of the caller's for push notifications. A device
+	// registered by another member before is theirs no longer.
+	RegisterPushDevice(context.Context, *RegisterPushDeviceRequest) (*StandardNotificationResponse, error)
+	// Stops push notifications to one of the caller's devices, as when they
+	// sign out of the app on it.
+	UnregisterPushDevice(context.Context, *UnregisterPushDeviceRequest) (*StandardNotificationResponse, error)
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+// UnimplementedNotificationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationServiceServer struct{}
+
+func (UnimplementedNotificationServiceServer) GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*StandardNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedNotificationServiceServer) UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*StandardNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationPreferences not implemented")
+}
+func (UnimplementedNotificationServiceServer) FollowMasjid(context.Context, *FollowMasjidRequest) (*StandardNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowMasjid not implemented")
+}
+func (UnimplementedNotificationServiceServer) UnfollowMasjid(context.Context, *UnfollowMasjidRequest) (*StandardNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowMasjid not implemented")
+}
+func (UnimplementedNotificationServiceServer) ListFollowedMasjids(context.Context, *ListFollowedMasjidsRequest) (*StandardNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowedMasjids not implemented")
+}
+func (UnimplementedNotificationServiceServer) RegisterPushDevice(context.Context, *RegisterPushDeviceRequest) (*StandardNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPushDevice not implemented")
+}
+func (UnimplementedNotificationServiceServer) UnregisterPushDevice(context.Context, *UnregisterPushDeviceRequest) (*StandardNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterPushDevice not implemented")
+}
+func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
+func (UnimplementedNotificationServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationServiceServer will
+// result in compilation errors.
+type UnsafeNotificationServiceServer interface {
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv NotificationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNotificationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationService_ServiceDesc, srv)
+}
+
+func _NotificationService_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_UpdateNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UpdateNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_UpdateNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UpdateNotificationPreferences(ctx, req.(*UpdateNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_FollowMasjid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowMasjidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).FollowMasjid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_FollowMasjid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).FollowMasjid(ctx, req.(*FollowMasjidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_UnfollowMasjid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowMasjidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UnfollowMasjid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_UnfollowMasjid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UnfollowMasjid(ctx, req.(*UnfollowMasjidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ListFollowedMasjids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowedMasjidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListFollowedMasjids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ListFollowedMasjids_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListFollowedMasjids(ctx, req.(*ListFollowedMasjidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_RegisterPushDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPushDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).RegisterPushDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_RegisterPushDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).RegisterPushDevice(ctx, req.(*RegisterPushDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_UnregisterPushDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterPushDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UnregisterPushDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_UnregisterPushDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UnregisterPushDevice(ctx, req.(*UnregisterPushDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "limestone.NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _NotificationService_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreferences",
+			Handler:    _NotificationService_UpdateNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "FollowMasjid",
+			Handler:    _NotificationService_FollowMasjid_Handler,
+		},
+		{
+			MethodName: "UnfollowMasjid",
+			Handler:    _NotificationService_UnfollowMasjid_Handler,
+		},
+		{
+			MethodName: "ListFollowedMasjids",
+			Handler:    _NotificationService_ListFollowedMasjids_Handler,
+		},
+		{
+			MethodName: "RegisterPushDevice",
+			Handler:    _NotificationService_RegisterPushDevice_Handler,
+		},
+		{
+			MethodName: "UnregisterPushDevice",
+			Handler:    _NotificationService_UnregisterPushDevice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "notification_service.proto",
+}
@@ -0,0 +1,758 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: ramadan_service.proto
+
+package __
+
+import (
+	_ "google.golang.org/genproto/googleapis/rpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StandardRamadanResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Status  string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*StandardRamadanResponse_RamadanSchedule
+	//	*StandardRamadanResponse_CreateIftarEventsResponse
+	Data          isStandardRamadanResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StandardRamadanResponse) Reset() {
+	*x = StandardRamadanResponse{}
+	mi := &file_ramadan_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StandardRamadanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandardRamadanResponse) ProtoMessage() {}
+
+func (x *StandardRamadanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ramadan_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandardRamadanResponse.ProtoReflect.Descriptor instead.
+func (*StandardRamadanResponse) Descriptor() ([]byte, []int) {
+	return file_ramadan_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StandardRamadanResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StandardRamadanResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StandardRamadanResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StandardRamadanResponse) GetData() isStandardRamadanResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *StandardRamadanResponse) GetRamadanSchedule() *RamadanSchedule {
+	if x != nil {
+		if x, ok := x.Data.(*StandardRamadanResponse_RamadanSchedule); ok {
+			return x.RamadanSchedule
+		}
+	}
+	return nil
+}
+
+func (x *StandardRamadanResponse) GetCreateIftarEventsResponse() *CreateIftarEventsResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardRamadanResponse_CreateIftarEventsResponse); ok {
+			return x.CreateIftarEventsResponse
+		}
+	}
+	return nil
+}
+
+type isStandardRamadanResponse_Data interface {
+	isStandardRamadanResponse_Data()
+}
+
+type StandardRamadanResponse_RamadanSchedule struct {
+	RamadanSchedule *RamadanSchedule `protobuf:"bytes,4,opt,name=ramadan_schedule,json=ramadanSchedule,proto3,oneof"`
+}
+
+type StandardRamadanResponse_CreateIftarEventsResponse struct {
+	CreateIftarEventsResponse *CreateIftarEventsResponse `protobuf:"bytes,5,opt,name=create_iftar_events_response,json=createIftarEventsResponse,proto3,oneof"`
+}
+
+func (*StandardRamadanResponse_RamadanSchedule) isStandardRamadanResponse_Data() {}
+
+func (*StandardRamadanResponse_CreateIftarEventsResponse) isStandardRamadanResponse_Data() {}
+
+// RamadanNight schedules the taraweeh and qiyam of one night. Night N is the
+// night before the Nth fast, so taraweeh of night 1 is prayed on the evening
+// before 1 Ramadan.
+type RamadanNight struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Night of Ramadan, from 1 to 30.
+	Night int32 `protobuf:"varint,1,opt,name=night,proto3" json:"night,omitempty"`
+	// Local clock times in 24-hour HH:MM format. Either may be empty. A qiyam
+	// time before 12:00 is after midnight.
+	TaraweehTime string `protobuf:"bytes,2,opt,name=taraweeh_time,json=taraweehTime,proto3" json:"taraweeh_time,omitempty"`
+	QiyamTime    string `protobuf:"bytes,3,opt,name=qiyam_time,json=qiyamTime,proto3" json:"qiyam_time,omitempty"`
+	// User leading the prayers.
+	ImamId        string `protobuf:"bytes,4,opt,name=imam_id,json=imamId,proto3" json:"imam_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RamadanNight) Reset() {
+	*x = RamadanNight{}
+	mi := &file_ramadan_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RamadanNight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RamadanNight) ProtoMessage() {}
+
+func (x *RamadanNight) ProtoReflect() protoreflect.Message {
+	mi := &file_ramadan_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RamadanNight.ProtoReflect.Descriptor instead.
+func (*RamadanNight) Descriptor() ([]byte, []int) {
+	return file_ramadan_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RamadanNight) GetNight() int32 {
+	if x != nil {
+		return x.Night
+	}
+	return 0
+}
+
+func (x *RamadanNight) GetTaraweehTime() string {
+	if x != nil {
+		return x.TaraweehTime
+	}
+	return ""
+}
+
+func (x *RamadanNight) GetQiyamTime() string {
+	if x != nil {
+		return x.QiyamTime
+	}
+	return ""
+}
+
+func (x *RamadanNight) GetImamId() string {
+	if x != nil {
+		return x.ImamId
+	}
+	return ""
+}
+
+type RamadanDay struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Day of Ramadan, from 1 to 29 or 30.
+	Day int32 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	// Gregorian YYYY-MM-DD date of the fast.
+	Date      string     `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	HijriDate *HijriDate `protobuf:"bytes,3,opt,name=hijri_date,json=hijriDate,proto3" json:"hijri_date,omitempty"`
+	// Fajr less the masjid's suhoor margin.
+	SuhoorEnd *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=suhoor_end,json=suhoorEnd,proto3" json:"suhoor_end,omitempty"`
+	// Maghrib.
+	Iftar *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=iftar,proto3" json:"iftar,omitempty"`
+	// Taraweeh and qiyam of the preceding night, unset when not scheduled.
+	Taraweeh      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=taraweeh,proto3" json:"taraweeh,omitempty"`
+	Qiyam         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=qiyam,proto3" json:"qiyam,omitempty"`
+	ImamId        string                 `protobuf:"bytes,8,opt,name=imam_id,json=imamId,proto3" json:"imam_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RamadanDay) Reset() {
+	*x = RamadanDay{}
+	mi := &file_ramadan_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RamadanDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RamadanDay) ProtoMessage() {}
+
+func (x *RamadanDay) ProtoReflect() protoreflect.Message {
+	mi := &file_ramadan_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RamadanDay.ProtoReflect.Descriptor instead.
+func (*RamadanDay) Descriptor() ([]byte, []int) {
+	return file_ramadan_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RamadanDay) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *RamadanDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *RamadanDay) GetHijriDate() *HijriDate {
+	if x != nil {
+		return x.HijriDate
+	}
+	return nil
+}
+
+func (x *RamadanDay) GetSuhoorEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuhoorEnd
+	}
+	return nil
+}
+
+func (x *RamadanDay) GetIftar() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Iftar
+	}
+	return nil
+}
+
+func (x *RamadanDay) GetTaraweeh() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Taraweeh
+	}
+	return nil
+}
+
+func (x *RamadanDay) GetQiyam() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Qiyam
+	}
+	return nil
+}
+
+func (x *RamadanDay) GetImamId() string {
+	if x != nil {
+		return x.ImamId
+	}
+	return ""
+}
+
+type RamadanSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	HijriYear     int32                  `protobuf:"varint,2,opt,name=hijri_year,json=hijriYear,proto3" json:"hijri_year,omitempty"`
+	Days          []*RamadanDay          `protobuf:"bytes,3,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RamadanSchedule) Reset() {
+	*x = RamadanSchedule{}
+	mi := &file_ramadan_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RamadanSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RamadanSchedule) ProtoMessage() {}
+
+func (x *RamadanSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_ramadan_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RamadanSchedule.ProtoReflect.Descriptor instead.
+func (*RamadanSchedule) Descriptor() ([]byte, []int) {
+	return file_ramadan_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RamadanSchedule) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *RamadanSchedule) GetHijriYear() int32 {
+	if x != nil {
+		return x.HijriYear
+	}
+	return 0
+}
+
+func (x *RamadanSchedule) GetDays() []*RamadanDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type GetRamadanScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	HijriYear     int32                  `protobuf:"varint,2,opt,name=hijri_year,json=hijriYear,proto3" json:"hijri_year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRamadanScheduleRequest) Reset() {
+	*x = GetRamadanScheduleRequest{}
+	mi := &file_ramadan_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRamadanScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRamadanScheduleRequest) ProtoMessage() {}
+
+func (x *GetRamadanScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ramadan_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRamadanScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetRamadanScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_ramadan_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRamadanScheduleRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *GetRamadanScheduleRequest) GetHijriYear() int32 {
+	if x != nil {
+		return x.HijriYear
+	}
+	return 0
+}
+
+type SetRamadanNightsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId  string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	HijriYear int32                  `protobuf:"varint,2,opt,name=hijri_year,json=hijriYear,proto3" json:"hijri_year,omitempty"`
+	// Replaces every night stored for the year. Nights left out have no
+	// taraweeh or qiyam.
+	Nights        []*RamadanNight `protobuf:"bytes,3,rep,name=nights,proto3" json:"nights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRamadanNightsRequest) Reset() {
+	*x = SetRamadanNightsRequest{}
+	mi := &file_ramadan_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRamadanNightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRamadanNightsRequest) ProtoMessage() {}
+
+func (x *SetRamadanNightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ramadan_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRamadanNightsRequest.ProtoReflect.Descriptor instead.
+func (*SetRamadanNightsRequest) Descriptor() ([]byte, []int) {
+	return file_ramadan_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetRamadanNightsRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *SetRamadanNightsRequest) GetHijriYear() int32 {
+	if x != nil {
+		return x.HijriYear
+	}
+	return 0
+}
+
+func (x *SetRamadanNightsRequest) GetNights() []*RamadanNight {
+	if x != nil {
+		return x.Nights
+	}
+	return nil
+}
+
+type CreateIftarEventsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId  string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	HijriYear int32                  `protobuf:"varint,2,opt,name=hijri_year,json=hijriYear,proto3" json:"hijri_year,omitempty"`
+	// Seats available at each iftar. 0 means no limit.
+	MaxParticipants int32 `protobuf:"varint,3,opt,name=max_participants,json=maxParticipants,proto3" json:"max_participants,omitempty"`
+	// Length of each event from Maghrib. Defaults to 90 minutes.
+	DurationMinutes int32  `protobuf:"varint,4,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	Description     string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// Rooms each iftar is held in. They are booked for every iftar created and
+	// must together seat max_participants.
+	RoomIds       []string `protobuf:"bytes,6,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIftarEventsRequest) Reset() {
+	*x = CreateIftarEventsRequest{}
+	mi := &file_ramadan_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIftarEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIftarEventsRequest) ProtoMessage() {}
+
+func (x *CreateIftarEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ramadan_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIftarEventsRequest.ProtoReflect.Descriptor instead.
+func (*CreateIftarEventsRequest) Descriptor() ([]byte, []int) {
+	return file_ramadan_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateIftarEventsRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *CreateIftarEventsRequest) GetHijriYear() int32 {
+	if x != nil {
+		return x.HijriYear
+	}
+	return 0
+}
+
+func (x *CreateIftarEventsRequest) GetMaxParticipants() int32 {
+	if x != nil {
+		return x.MaxParticipants
+	}
+	return 0
+}
+
+func (x *CreateIftarEventsRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *CreateIftarEventsRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateIftarEventsRequest) GetRoomIds() []string {
+	if x != nil {
+		return x.RoomIds
+	}
+	return nil
+}
+
+type CreateIftarEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Iftars created by this call. Nights that already had an iftar event are
+	// skipped, so the call can be repeated safely.
+	Events        []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIftarEventsResponse) Reset() {
+	*x = CreateIftarEventsResponse{}
+	mi := &file_ramadan_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIftarEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIftarEventsResponse) ProtoMessage() {}
+
+func (x *CreateIftarEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ramadan_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIftarEventsResponse.ProtoReflect.Descriptor instead.
+func (*CreateIftarEventsResponse) Descriptor() ([]byte, []int) {
+	return file_ramadan_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateIftarEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_ramadan_service_proto protoreflect.FileDescriptor
+
+const file_ramadan_service_proto_rawDesc = "" +
+	"\n" +
+	"\x15ramadan_service.proto\x12\tlimestone\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13event_service.proto\x1a\x10hijri_date.proto\"\x99\x02\n" +
+	"\x17StandardRamadanResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12G\n" +
+	"\x10ramadan_schedule\x18\x04 \x01(\v2\x1a.limestone.RamadanScheduleH\x00R\x0framadanSchedule\x12g\n" +
+	"\x1ccreate_iftar_events_response\x18\x05 \x01(\v2$.limestone.CreateIftarEventsResponseH\x00R\x19createIftarEventsResponseB\x06\n" +
+	"\x04data\"\x86\x01\n" +
+	"\fRamadanNight\x12\x19\n" +
+	"\x05night\x18\x01 \x01(\x05B\x03\xe0A\x02R\x05night\x12#\n" +
+	"\rtaraweeh_time\x18\x02 \x01(\tR\ftaraweehTime\x12\x1d\n" +
+	"\n" +
+	"qiyam_time\x18\x03 \x01(\tR\tqiyamTime\x12\x17\n" +
+	"\aimam_id\x18\x04 \x01(\tR\x06imamId\"\xd7\x02\n" +
+	"\n" +
+	"RamadanDay\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\x05R\x03day\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x123\n" +
+	"\n" +
+	"hijri_date\x18\x03 \x01(\v2\x14.limestone.HijriDateR\thijriDate\x129\n" +
+	"\n" +
+	"suhoor_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tsuhoorEnd\x120\n" +
+	"\x05iftar\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05iftar\x126\n" +
+	"\btaraweeh\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\btaraweeh\x120\n" +
+	"\x05qiyam\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05qiyam\x12\x17\n" +
+	"\aimam_id\x18\b \x01(\tR\x06imamId\"x\n" +
+	"\x0fRamadanSchedule\x12\x1b\n" +
+	"\tmasjid_id\x18\x01 \x01(\tR\bmasjidId\x12\x1d\n" +
+	"\n" +
+	"hijri_year\x18\x02 \x01(\x05R\thijriYear\x12)\n" +
+	"\x04days\x18\x03 \x03(\v2\x15.limestone.RamadanDayR\x04days\"a\n" +
+	"\x19GetRamadanScheduleRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12\"\n" +
+	"\n" +
+	"hijri_year\x18\x02 \x01(\x05B\x03\xe0A\x02R\thijriYear\"\x90\x01\n" +
+	"\x17SetRamadanNightsRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12\"\n" +
+	"\n" +
+	"hijri_year\x18\x02 \x01(\x05B\x03\xe0A\x02R\thijriYear\x12/\n" +
+	"\x06nights\x18\x03 \x03(\v2\x17.limestone.RamadanNightR\x06nights\"\xf3\x01\n" +
+	"\x18CreateIftarEventsRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12\"\n" +
+	"\n" +
+	"hijri_year\x18\x02 \x01(\x05B\x03\xe0A\x02R\thijriYear\x12)\n" +
+	"\x10max_participants\x18\x03 \x01(\x05R\x0fmaxParticipants\x12)\n" +
+	"\x10duration_minutes\x18\x04 \x01(\x05R\x0fdurationMinutes\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x19\n" +
+	"\broom_ids\x18\x06 \x03(\tR\aroomIds\"E\n" +
+	"\x19CreateIftarEventsResponse\x12(\n" +
+	"\x06events\x18\x01 \x03(\v2\x10.limestone.EventR\x06events2\xb2\x04\n" +
+	"\x0eRamadanService\x12\xaa\x01\n" +
+	"\x12GetRamadanSchedule\x12$.limestone.GetRamadanScheduleRequest\x1a\".limestone.StandardRamadanResponse\"J\xdaA\x14masjid_id,hijri_year\x82\xd3\xe4\x93\x02-\x12+/v1/masjid/{masjid_id}/ramadan/{hijri_year}\x12\xb7\x01\n" +
+	"\x10SetRamadanNights\x12\".limestone.SetRamadanNightsRequest\x1a\".limestone.StandardRamadanResponse\"[\xdaA\x1bmasjid_id,hijri_year,nights\x82\xd3\xe4\x93\x027:\x01*\x1a2/v1/masjid/{masjid_id}/ramadan/{hijri_year}/nights\x12\xb8\x01\n" +
+	"\x11CreateIftarEvents\x12#.limestone.CreateIftarEventsRequest\x1a\".limestone.StandardRamadanResponse\"Z\xdaA\x14masjid_id,hijri_year\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/masjid/{masjid_id}/ramadan/{hijri_year}/iftar_eventsBk\n" +
+	"\rcom.limestoneB\x13RamadanServiceProtoP\x01Z\x01.\xa2\x02\x03LXX\xaa\x02\tLimestone\xca\x02\tLimestone\xe2\x02\x15Limestone\\GPBMetadata\xea\x02\tLimestoneb\x06proto3"
+
+var (
+	file_ramadan_service_proto_rawDescOnce sync.Once
+	file_ramadan_service_proto_rawDescData []byte
+)
+
+func file_ramadan_service_proto_rawDescGZIP() []byte {
+	file_ramadan_service_proto_rawDescOnce.Do(func() {
+		file_ramadan_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ramadan_service_proto_rawDesc), len(file_ramadan_service_proto_rawDesc)))
+	})
+	return file_ramadan_service_proto_rawDescData
+}
+
+var file_ramadan_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_ramadan_service_proto_goTypes = []any{
+	(*StandardRamadanResponse)(nil),   // 0: limestone.StandardRamadanResponse
+	(*RamadanNight)(nil),              // 1: limestone.RamadanNight
+	(*RamadanDay)(nil),                // 2: limestone.RamadanDay
+	(*RamadanSchedule)(nil),           // 3: limestone.RamadanSchedule
+	(*GetRamadanScheduleRequest)(nil), // 4: limestone.GetRamadanScheduleRequest
+	(*SetRamadanNightsRequest)(nil),   // 5: limestone.SetRamadanNightsRequest
+	(*CreateIftarEventsRequest)(nil),  // 6: limestone.CreateIftarEventsRequest
+	(*CreateIftarEventsResponse)(nil), // 7: limestone.CreateIftarEventsResponse
+	(*HijriDate)(nil),                 // 8: limestone.HijriDate
+	(*timestamppb.Timestamp)(nil),     // 9: google.protobuf.Timestamp
+	(*Event)(nil),                     // 10: limestone.Event
+}
+var file_ramadan_service_proto_depIdxs = []int32{
+	3,  // 0: limestone.StandardRamadanResponse.ramadan_schedule:type_name -> limestone.RamadanSchedule
+	7,  // 1: limestone.StandardRamadanResponse.create_iftar_events_response:type_name -> limestone.CreateIftarEventsResponse
+	8,  // 2: limestone.RamadanDay.hijri_date:type_name -> limestone.HijriDate
+	9,  // 3: limestone.RamadanDay.suhoor_end:type_name -> google.protobuf.Timestamp
+	9,  // 4: limestone.RamadanDay.iftar:type_name -> google.protobuf.Timestamp
+	9,  // 5: limestone.RamadanDay.taraweeh:type_name -> google.protobuf.Timestamp
+	9,  // 6: limestone.RamadanDay.qiyam:type_name -> google.protobuf.Timestamp
+	2,  // 7: limestone.RamadanSchedule.days:type_name -> limestone.RamadanDay
+	1,  // 8: limestone.SetRamadanNightsRequest.nights:type_name -> limestone.RamadanNight
+	10, // 9: limestone.CreateIftarEventsResponse.events:type_name -> limestone.Event
+	4,  // 10: limestone.RamadanService.GetRamadanSchedule:input_type -> limestone.GetRamadanScheduleRequest
+	5,  // 11: limestone.RamadanService.SetRamadanNights:input_type -> limestone.SetRamadanNightsRequest
+	6,  // 12: limestone.RamadanService.CreateIftarEvents:input_type -> limestone.CreateIftarEventsRequest
+	0,  // 13: limestone.RamadanService.GetRamadanSchedule:output_type -> limestone.StandardRamadanResponse
+	0,  // 14: limestone.RamadanService.SetRamadanNights:output_type -> limestone.StandardRamadanResponse
+	0,  // 15: limestone.RamadanService.CreateIftarEvents:output_type -> limestone.StandardRamadanResponse
+	13, // [13:16] is the sub-list for method output_type
+	10, // [10:13] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_ramadan_service_proto_init() }
+func file_ramadan_service_proto_init() {
+	if File_ramadan_service_proto != nil {
+		return
+	}
+	file_event_service_proto_init()
+	file_hijri_date_proto_init()
+	file_ramadan_service_proto_msgTypes[0].OneofWrappers = []any{
+		(*StandardRamadanResponse_RamadanSchedule)(nil),
+		(*StandardRamadanResponse_CreateIftarEventsResponse)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ramadan_service_proto_rawDesc), len(file_ramadan_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ramadan_service_proto_goTypes,
+		DependencyIndexes: file_ramadan_service_proto_depIdxs,
+		MessageInfos:      file_ramadan_service_proto_msgTypes,
+	}.Build()
+	File_ramadan_service_proto = out.File
+	file_ramadan_service_proto_goTypes = nil
+	file_ramadan_service_proto_depIdxs = nil
+}
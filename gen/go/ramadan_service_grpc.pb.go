@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ramadan_service.proto
+
+package __
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RamadanService_GetRamadanSchedule_FullMethodName = "/limestone.RamadanService/GetRamadanSchedule"
+	RamadanService_SetRamadanNights_FullMethodName   = "/limestone.RamadanService/SetRamadanNights"
+	RamadanService_CreateIftarEvents_FullMethodName  = "/limestone.RamadanService/CreateIftarEvents"
+)
+
+// RamadanServiceClient is the client API for RamadanService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RamadanServiceClient interface {
+	GetRamadanSchedule(ctx context.Context, in *GetRamadanScheduleRequest, opts ...grpc.CallOption) (*StandardRamadanResponse, error)
+	SetRamadanNights(ctx context.Context, in *SetRamadanNightsRequest, opts ...grpc.CallOption) (*StandardRamadanResponse, error)
+	CreateIftarEvents(ctx context.Context, in *CreateIftarEventsRequest, opts ...grpc.CallOption) (*StandardRamadanResponse, error)
+}
+
+type ramadanServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRamadanServiceClient(cc grpc.ClientConnInterface) RamadanServiceClient {
+	return &ramadanServiceClient{cc}
+}
+
+func (c *ramadanServiceClient) GetRamadanSchedule(ctx context.Context, in *GetRamadanScheduleRequest, opts ...grpc.CallOption) (*StandardRamadanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardRamadanResponse)
+	err := c.cc.Invoke(ctx, RamadanService_GetRamadanSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ramadanServiceClient) SetRamadanNights(ctx context.Context, in *SetRamadanNightsRequest, opts ...grpc.CallOption) (*StandardRamadanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardRamadanResponse)
+	err := c.cc.Invoke(ctx, RamadanService_SetRamadanNights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ramadanServiceClient) CreateIftarEvents(ctx context.Context, in *CreateIftarEventsRequest, opts ...grpc.CallOption) (*StandardRamadanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardRamadanResponse)
+	err := c.cc.Invoke(ctx, RamadanService_CreateIftarEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RamadanServiceServer is the server API for RamadanService service.
+// All implementations must embed UnimplementedRamadanServiceServer
+// for forward compatibility.
+type RamadanServiceServer interface {
+	GetRamadanSchedule(context.Context, *GetRamadanScheduleRequest) (*StandardRamadanResponse, error)
+	SetRamadanNights(context.Context, *SetRamadanNightsRequest) (*StandardRamadanResponse, error)
+	CreateIftarEvents(context.Context, *CreateIftarEventsRequest) (*StandardRamadanResponse, error)
+	mustEmbedUnimplementedRamadanServiceServer()
+}
+
+// UnimplementedRamadanServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRamadanServiceServer struct{}
+
+func (UnimplementedRamadanServiceServer) GetRamadanSchedule(context.Context, *GetRamadanScheduleRequest) (*StandardRamadanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRamadanSchedule not implemented")
+}
+func (UnimplementedRamadanServiceServer) SetRamadanNights(context.Context, *SetRamadanNightsRequest) (*StandardRamadanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRamadanNights not implemented")
+}
+func (UnimplementedRamadanServiceServer) CreateIftarEvents(context.Context, *CreateIftarEventsRequest) (*StandardRamadanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIftarEvents not implemented")
+}
+func (UnimplementedRamadanServiceServer) mustEmbedUnimplementedRamadanServiceServer() {}
+func (UnimplementedRamadanServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeRamadanServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RamadanServiceServer will
+// result in compilation errors.
+type UnsafeRamadanServiceServer interface {
+	mustEmbedUnimplementedRamadanServiceServer()
+}
+
+func RegisterRamadanServiceServer(s grpc.ServiceRegistrar, srv RamadanServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRamadanServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RamadanService_ServiceDesc, srv)
+}
+
+func _RamadanService_GetRamadanSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRamadanScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RamadanServiceServer).GetRamadanSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RamadanService_GetRamadanSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RamadanServiceServer).GetRamadanSchedule(ctx, req.(*GetRamadanScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RamadanService_SetRamadanNights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRamadanNightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RamadanServiceServer).SetRamadanNights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RamadanService_SetRamadanNights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RamadanServiceServer).SetRamadanNights(ctx, req.(*SetRamadanNightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RamadanService_CreateIftarEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIftarEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RamadanServiceServer).CreateIftarEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RamadanService_CreateIftarEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RamadanServiceServer).CreateIftarEvents(ctx, req.(*CreateIftarEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RamadanService_ServiceDesc is the grpc.ServiceDesc for RamadanService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RamadanService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "limestone.RamadanService",
+	HandlerType: (*RamadanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRamadanSchedule",
+			Handler:    _RamadanService_GetRamadanSchedule_Handler,
+		},
+		{
+			MethodName: "SetRamadanNights",
+			Handler:    _RamadanService_SetRamadanNights_Handler,
+		},
+		{
+			MethodName: "CreateIftarEvents",
+			Handler:    _RamadanService_CreateIftarEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ramadan_service.proto",
+}
@@ -0,0 +1,1432 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: room_service.proto
+
+package __
+
+import (
+	_ "google.golang.org/genproto/googleapis/rpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Who the room is set aside for. Events for one gender cannot be held in
+// a room set aside for the other.
+type Room_Designation int32
+
+const (
+	Room_ANY      Room_Designation = 0
+	Room_BROTHERS Room_Designation = 1
+	Room_SISTERS  Room_Designation = 2
+)
+
+// Enum value maps for Room_Designation.
+var (
+	Room_Designation_name = map[int32]string{
+		0: "ANY",
+		1: "BROTHERS",
+		2: "SISTERS",
+	}
+	Room_Designation_value = map[string]int32{
+		"ANY":      0,
+		"BROTHERS": 1,
+		"SISTERS":  2,
+	}
+)
+
+func (x Room_Designation) Enum() *Room_Designation {
+	p := new(Room_Designation)
+	*p = x
+	return p
+}
+
+func (x Room_Designation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Room_Designation) Descriptor() protoreflect.EnumDescriptor {
+	return file_room_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Room_Designation) Type() protoreflect.EnumType {
+	return &file_room_service_proto_enumTypes[0]
+}
+
+func (x Room_Designation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Room_Designation.Descriptor instead.
+func (Room_Designation) EnumDescriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type StandardRoomResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Status  string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*StandardRoomResponse_Room
+	//	*StandardRoomResponse_ListRoomsResponse
+	//	*StandardRoomResponse_DeleteRoomResponse
+	//	*StandardRoomResponse_Booking
+	//	*StandardRoomResponse_ListBookingsResponse
+	//	*StandardRoomResponse_Availability
+	//	*StandardRoomResponse_CancelBookingResponse
+	Data          isStandardRoomResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StandardRoomResponse) Reset() {
+	*x = StandardRoomResponse{}
+	mi := &file_room_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StandardRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandardRoomResponse) ProtoMessage() {}
+
+func (x *StandardRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandardRoomResponse.ProtoReflect.Descriptor instead.
+func (*StandardRoomResponse) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StandardRoomResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StandardRoomResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StandardRoomResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StandardRoomResponse) GetData() isStandardRoomResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *StandardRoomResponse) GetRoom() *Room {
+	if x != nil {
+		if x, ok := x.Data.(*StandardRoomResponse_Room); ok {
+			return x.Room
+		}
+	}
+	return nil
+}
+
+func (x *StandardRoomResponse) GetListRoomsResponse() *ListRoomsResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardRoomResponse_ListRoomsResponse); ok {
+			return x.ListRoomsResponse
+		}
+	}
+	return nil
+}
+
+func (x *StandardRoomResponse) GetDeleteRoomResponse() *DeleteRoomResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardRoomResponse_DeleteRoomResponse); ok {
+			return x.DeleteRoomResponse
+		}
+	}
+	return nil
+}
+
+func (x *StandardRoomResponse) GetBooking() *RoomBooking {
+	if x != nil {
+		if x, ok := x.Data.(*StandardRoomResponse_Booking); ok {
+			return x.Booking
+		}
+	}
+	return nil
+}
+
+func (x *StandardRoomResponse) GetListBookingsResponse() *ListRoomBookingsResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardRoomResponse_ListBookingsResponse); ok {
+			return x.ListBookingsResponse
+		}
+	}
+	return nil
+}
+
+func (x *StandardRoomResponse) GetAvailability() *RoomAvailability {
+	if x != nil {
+		if x, ok := x.Data.(*StandardRoomResponse_Availability); ok {
+			return x.Availability
+		}
+	}
+	return nil
+}
+
+func (x *StandardRoomResponse) GetCancelBookingResponse() *CancelRoomBookingResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardRoomResponse_CancelBookingResponse); ok {
+			return x.CancelBookingResponse
+		}
+	}
+	return nil
+}
+
+type isStandardRoomResponse_Data interface {
+	isStandardRoomResponse_Data()
+}
+
+type StandardRoomResponse_Room struct {
+	Room *Room `protobuf:"bytes,4,opt,name=room,proto3,oneof"`
+}
+
+type StandardRoomResponse_ListRoomsResponse struct {
+	ListRoomsResponse *ListRoomsResponse `protobuf:"bytes,5,opt,name=list_rooms_response,json=listRoomsResponse,proto3,oneof"`
+}
+
+type StandardRoomResponse_DeleteRoomResponse struct {
+	DeleteRoomResponse *DeleteRoomResponse `protobuf:"bytes,6,opt,name=delete_room_response,json=deleteRoomResponse,proto3,oneof"`
+}
+
+type StandardRoomResponse_Booking struct {
+	Booking *RoomBooking `protobuf:"bytes,7,opt,name=booking,proto3,oneof"`
+}
+
+type StandardRoomResponse_ListBookingsResponse struct {
+	ListBookingsResponse *ListRoomBookingsResponse `protobuf:"bytes,8,opt,name=list_bookings_response,json=listBookingsResponse,proto3,oneof"`
+}
+
+type StandardRoomResponse_Availability struct {
+	Availability *RoomAvailability `protobuf:"bytes,9,opt,name=availability,proto3,oneof"`
+}
+
+type StandardRoomResponse_CancelBookingResponse struct {
+	CancelBookingResponse *CancelRoomBookingResponse `protobuf:"bytes,10,opt,name=cancel_booking_response,json=cancelBookingResponse,proto3,oneof"`
+}
+
+func (*StandardRoomResponse_Room) isStandardRoomResponse_Data() {}
+
+func (*StandardRoomResponse_ListRoomsResponse) isStandardRoomResponse_Data() {}
+
+func (*StandardRoomResponse_DeleteRoomResponse) isStandardRoomResponse_Data() {}
+
+func (*StandardRoomResponse_Booking) isStandardRoomResponse_Data() {}
+
+func (*StandardRoomResponse_ListBookingsResponse) isStandardRoomResponse_Data() {}
+
+func (*StandardRoomResponse_Availability) isStandardRoomResponse_Data() {}
+
+func (*StandardRoomResponse_CancelBookingResponse) isStandardRoomResponse_Data() {}
+
+type Room struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MasjidId string                 `protobuf:"bytes,2,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	// E.g. "Main prayer hall" or "Sisters' hall".
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// How many people the room holds.
+	Capacity      int32                  `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Designation   Room_Designation       `protobuf:"varint,6,opt,name=designation,proto3,enum=limestone.Room_Designation" json:"designation,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Room) Reset() {
+	*x = Room{}
+	mi := &file_room_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Room) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Room) ProtoMessage() {}
+
+func (x *Room) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Room.ProtoReflect.Descriptor instead.
+func (*Room) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Room) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Room) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *Room) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Room) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Room) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Room) GetDesignation() Room_Designation {
+	if x != nil {
+		return x.Designation
+	}
+	return Room_ANY
+}
+
+func (x *Room) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Room) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type RoomBooking struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RoomId string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// Set on the bookings of events, which are made by creating or updating
+	// the event.
+	EventId string `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The member who made the booking. Not set on the bookings of events.
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// What the room is booked for, e.g. "Nikkah ceremony". The name of the
+	// event for the bookings of events.
+	Purpose   string                 `protobuf:"bytes,5,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// How many people are expected, at most the room's capacity.
+	Guests     int32                  `protobuf:"varint,8,opt,name=guests,proto3" json:"guests,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Set by ListMyBookings.
+	Room          *Room `protobuf:"bytes,10,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomBooking) Reset() {
+	*x = RoomBooking{}
+	mi := &file_room_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomBooking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomBooking) ProtoMessage() {}
+
+func (x *RoomBooking) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomBooking.ProtoReflect.Descriptor instead.
+func (*RoomBooking) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RoomBooking) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoomBooking) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RoomBooking) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RoomBooking) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RoomBooking) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *RoomBooking) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *RoomBooking) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *RoomBooking) GetGuests() int32 {
+	if x != nil {
+		return x.Guests
+	}
+	return 0
+}
+
+func (x *RoomBooking) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *RoomBooking) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type CreateRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	Room          *Room                  `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
+	mi := &file_room_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRequest) ProtoMessage() {}
+
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateRoomRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type UpdateRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Room          *Room                  `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoomRequest) Reset() {
+	*x = UpdateRoomRequest{}
+	mi := &file_room_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomRequest) ProtoMessage() {}
+
+func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateRoomRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type DeleteRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomRequest) Reset() {
+	*x = DeleteRoomRequest{}
+	mi := &file_room_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomRequest) ProtoMessage() {}
+
+func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoomRequest) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteRoomRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomResponse) Reset() {
+	*x = DeleteRoomResponse{}
+	mi := &file_room_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomResponse) ProtoMessage() {}
+
+func (x *DeleteRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoomResponse) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{6}
+}
+
+type GetRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomRequest) Reset() {
+	*x = GetRoomRequest{}
+	mi := &file_room_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomRequest) ProtoMessage() {}
+
+func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomRequest) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetRoomRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListRoomsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasjidId      string                 `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomsRequest) Reset() {
+	*x = ListRoomsRequest{}
+	mi := &file_room_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsRequest) ProtoMessage() {}
+
+func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomsRequest) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListRoomsRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+type ListRoomsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rooms         []*Room                `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomsResponse) Reset() {
+	*x = ListRoomsResponse{}
+	mi := &file_room_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsResponse) ProtoMessage() {}
+
+func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomsResponse) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListRoomsResponse) GetRooms() []*Room {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+type GetRoomAvailabilityRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// The window, at most 400 days long, defaulting to the week from now.
+	StartFrom     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_from,json=startFrom,proto3" json:"start_from,omitempty"`
+	StartBefore   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_before,json=startBefore,proto3" json:"start_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomAvailabilityRequest) Reset() {
+	*x = GetRoomAvailabilityRequest{}
+	mi := &file_room_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetRoomAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetRoomAvailabilityRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GetRoomAvailabilityRequest) GetStartFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartFrom
+	}
+	return nil
+}
+
+func (x *GetRoomAvailabilityRequest) GetStartBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartBefore
+	}
+	return nil
+}
+
+type TimeRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	mi := &file_room_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRange) ProtoMessage() {}
+
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TimeRange) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *TimeRange) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type RoomAvailability struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Room        *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	StartFrom   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_from,json=startFrom,proto3" json:"start_from,omitempty"`
+	StartBefore *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_before,json=startBefore,proto3" json:"start_before,omitempty"`
+	// The bookings overlapping the window, by start time.
+	Bookings []*RoomBooking `protobuf:"bytes,4,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	// The times in the window the room is not booked, in order.
+	Free          []*TimeRange `protobuf:"bytes,5,rep,name=free,proto3" json:"free,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomAvailability) Reset() {
+	*x = RoomAvailability{}
+	mi := &file_room_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomAvailability) ProtoMessage() {}
+
+func (x *RoomAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomAvailability.ProtoReflect.Descriptor instead.
+func (*RoomAvailability) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RoomAvailability) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *RoomAvailability) GetStartFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartFrom
+	}
+	return nil
+}
+
+func (x *RoomAvailability) GetStartBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartBefore
+	}
+	return nil
+}
+
+func (x *RoomAvailability) GetBookings() []*RoomBooking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *RoomAvailability) GetFree() []*TimeRange {
+	if x != nil {
+		return x.Free
+	}
+	return nil
+}
+
+type BookRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Booking       *RoomBooking           `protobuf:"bytes,2,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookRoomRequest) Reset() {
+	*x = BookRoomRequest{}
+	mi := &file_room_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookRoomRequest) ProtoMessage() {}
+
+func (x *BookRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookRoomRequest.ProtoReflect.Descriptor instead.
+func (*BookRoomRequest) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BookRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *BookRoomRequest) GetBooking() *RoomBooking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type CancelRoomBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRoomBookingRequest) Reset() {
+	*x = CancelRoomBookingRequest{}
+	mi := &file_room_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRoomBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRoomBookingRequest) ProtoMessage() {}
+
+func (x *CancelRoomBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRoomBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelRoomBookingRequest) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CancelRoomBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelRoomBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRoomBookingResponse) Reset() {
+	*x = CancelRoomBookingResponse{}
+	mi := &file_room_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRoomBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRoomBookingResponse) ProtoMessage() {}
+
+func (x *CancelRoomBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRoomBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelRoomBookingResponse) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{15}
+}
+
+type ListMyBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyBookingsRequest) Reset() {
+	*x = ListMyBookingsRequest{}
+	mi := &file_room_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyBookingsRequest) ProtoMessage() {}
+
+func (x *ListMyBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{16}
+}
+
+type ListRoomBookingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// By start time.
+	Bookings      []*RoomBooking `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomBookingsResponse) Reset() {
+	*x = ListRoomBookingsResponse{}
+	mi := &file_room_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomBookingsResponse) ProtoMessage() {}
+
+func (x *ListRoomBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_room_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_room_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListRoomBookingsResponse) GetBookings() []*RoomBooking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+var File_room_service_proto protoreflect.FileDescriptor
+
+const file_room_service_proto_rawDesc = "" +
+	"\n" +
+	"\x12room_service.proto\x12\tlimestone\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe2\x04\n" +
+	"\x14StandardRoomResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12%\n" +
+	"\x04room\x18\x04 \x01(\v2\x0f.limestone.RoomH\x00R\x04room\x12N\n" +
+	"\x13list_rooms_response\x18\x05 \x01(\v2\x1c.limestone.ListRoomsResponseH\x00R\x11listRoomsResponse\x12Q\n" +
+	"\x14delete_room_response\x18\x06 \x01(\v2\x1d.limestone.DeleteRoomResponseH\x00R\x12deleteRoomResponse\x122\n" +
+	"\abooking\x18\a \x01(\v2\x16.limestone.RoomBookingH\x00R\abooking\x12[\n" +
+	"\x16list_bookings_response\x18\b \x01(\v2#.limestone.ListRoomBookingsResponseH\x00R\x14listBookingsResponse\x12A\n" +
+	"\favailability\x18\t \x01(\v2\x1b.limestone.RoomAvailabilityH\x00R\favailability\x12^\n" +
+	"\x17cancel_booking_response\x18\n" +
+	" \x01(\v2$.limestone.CancelRoomBookingResponseH\x00R\x15cancelBookingResponseB\x06\n" +
+	"\x04data\"\x8a\x03\n" +
+	"\x04Room\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\tmasjid_id\x18\x02 \x01(\tB\x03\xe0A\x03R\bmasjidId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tB\x03\xe0A\x02R\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\bcapacity\x18\x05 \x01(\x05B\x03\xe0A\x02R\bcapacity\x12=\n" +
+	"\vdesignation\x18\x06 \x01(\x0e2\x1b.limestone.Room.DesignationR\vdesignation\x12@\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\"1\n" +
+	"\vDesignation\x12\a\n" +
+	"\x03ANY\x10\x00\x12\f\n" +
+	"\bBROTHERS\x10\x01\x12\v\n" +
+	"\aSISTERS\x10\x02\"\x98\x03\n" +
+	"\vRoomBooking\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\aroom_id\x18\x02 \x01(\tB\x03\xe0A\x03R\x06roomId\x12\x1e\n" +
+	"\bevent_id\x18\x03 \x01(\tB\x03\xe0A\x03R\aeventId\x12\x1c\n" +
+	"\auser_id\x18\x04 \x01(\tB\x03\xe0A\x03R\x06userId\x12\x1d\n" +
+	"\apurpose\x18\x05 \x01(\tB\x03\xe0A\x02R\apurpose\x12>\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\tstartTime\x12:\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\aendTime\x12\x16\n" +
+	"\x06guests\x18\b \x01(\x05R\x06guests\x12@\n" +
+	"\vcreate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12(\n" +
+	"\x04room\x18\n" +
+	" \x01(\v2\x0f.limestone.RoomB\x03\xe0A\x03R\x04room\"_\n" +
+	"\x11CreateRoomRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\x12(\n" +
+	"\x04room\x18\x02 \x01(\v2\x0f.limestone.RoomB\x03\xe0A\x02R\x04room\"R\n" +
+	"\x11UpdateRoomRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12(\n" +
+	"\x04room\x18\x02 \x01(\v2\x0f.limestone.RoomB\x03\xe0A\x02R\x04room\"(\n" +
+	"\x11DeleteRoomRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\x14\n" +
+	"\x12DeleteRoomResponse\"%\n" +
+	"\x0eGetRoomRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"4\n" +
+	"\x10ListRoomsRequest\x12 \n" +
+	"\tmasjid_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmasjidId\":\n" +
+	"\x11ListRoomsResponse\x12%\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x0f.limestone.RoomR\x05rooms\"\xb4\x01\n" +
+	"\x1aGetRoomAvailabilityRequest\x12\x1c\n" +
+	"\aroom_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x06roomId\x129\n" +
+	"\n" +
+	"start_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartFrom\x12=\n" +
+	"\fstart_before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vstartBefore\"}\n" +
+	"\tTimeRange\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"\x8f\x02\n" +
+	"\x10RoomAvailability\x12#\n" +
+	"\x04room\x18\x01 \x01(\v2\x0f.limestone.RoomR\x04room\x129\n" +
+	"\n" +
+	"start_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartFrom\x12=\n" +
+	"\fstart_before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vstartBefore\x122\n" +
+	"\bbookings\x18\x04 \x03(\v2\x16.limestone.RoomBookingR\bbookings\x12(\n" +
+	"\x04free\x18\x05 \x03(\v2\x14.limestone.TimeRangeR\x04free\"f\n" +
+	"\x0fBookRoomRequest\x12\x1c\n" +
+	"\aroom_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x06roomId\x125\n" +
+	"\abooking\x18\x02 \x01(\v2\x16.limestone.RoomBookingB\x03\xe0A\x02R\abooking\"/\n" +
+	"\x18CancelRoomBookingRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\x1b\n" +
+	"\x19CancelRoomBookingResponse\"\x17\n" +
+	"\x15ListMyBookingsRequest\"N\n" +
+	"\x18ListRoomBookingsResponse\x122\n" +
+	"\bbookings\x18\x01 \x03(\v2\x16.limestone.RoomBookingR\bbookings2\xf5\b\n" +
+	"\vRoomService\x12\x88\x01\n" +
+	"\n" +
+	"CreateRoom\x12\x1c.limestone.CreateRoomRequest\x1a\x1f.limestone.StandardRoomResponse\";\xdaA\x0emasjid_id,room\x82\xd3\xe4\x93\x02$:\x04room\"\x1c/v1/masjid/{masjid_id}/rooms\x12s\n" +
+	"\n" +
+	"UpdateRoom\x12\x1c.limestone.UpdateRoomRequest\x1a\x1f.limestone.StandardRoomResponse\"&\xdaA\aid,room\x82\xd3\xe4\x93\x02\x16:\x04room2\x0e/v1/rooms/{id}\x12h\n" +
+	"\n" +
+	"DeleteRoom\x12\x1c.limestone.DeleteRoomRequest\x1a\x1f.limestone.StandardRoomResponse\"\x1b\xdaA\x02id\x82\xd3\xe4\x93\x02\x10*\x0e/v1/rooms/{id}\x12b\n" +
+	"\aGetRoom\x12\x19.limestone.GetRoomRequest\x1a\x1f.limestone.StandardRoomResponse\"\x1b\xdaA\x02id\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/rooms/{id}\x12{\n" +
+	"\tListRooms\x12\x1b.limestone.ListRoomsRequest\x1a\x1f.limestone.StandardRoomResponse\"0\xdaA\tmasjid_id\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/masjid/{masjid_id}/rooms\x12\xa9\x01\n" +
+	"\x13GetRoomAvailability\x12%.limestone.GetRoomAvailabilityRequest\x1a\x1f.limestone.StandardRoomResponse\"J\xdaA\x1froom_id,start_from,start_before\x82\xd3\xe4\x93\x02\"\x12 /v1/rooms/{room_id}/availability\x12\x88\x01\n" +
+	"\bBookRoom\x12\x1a.limestone.BookRoomRequest\x1a\x1f.limestone.StandardRoomResponse\"?\xdaA\x0froom_id,booking\x82\xd3\xe4\x93\x02':\abooking\"\x1c/v1/rooms/{room_id}/bookings\x12y\n" +
+	"\x11CancelRoomBooking\x12#.limestone.CancelRoomBookingRequest\x1a\x1f.limestone.StandardRoomResponse\"\x1e\xdaA\x02id\x82\xd3\xe4\x93\x02\x13*\x11/v1/bookings/{id}\x12i\n" +
+	"\x0eListMyBookings\x12 .limestone.ListMyBookingsRequest\x1a\x1f.limestone.StandardRoomResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/bookingsBh\n" +
+	"\rcom.limestoneB\x10RoomServiceProtoP\x01Z\x01.\xa2\x02\x03LXX\xaa\x02\tLimestone\xca\x02\tLimestone\xe2\x02\x15Limestone\\GPBMetadata\xea\x02\tLimestoneb\x06proto3"
+
+var (
+	file_room_service_proto_rawDescOnce sync.Once
+	file_room_service_proto_rawDescData []byte
+)
+
+func file_room_service_proto_rawDescGZIP() []byte {
+	file_room_service_proto_rawDescOnce.Do(func() {
+		file_room_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_room_service_proto_rawDesc), len(file_room_service_proto_rawDesc)))
+	})
+	return file_room_service_proto_rawDescData
+}
+
+var file_room_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_room_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_room_service_proto_goTypes = []any{
+	(Room_Designation)(0),              // 0: limestone.Room.Designation
+	(*StandardRoomResponse)(nil),       // 1: limestone.StandardRoomResponse
+	(*Room)(nil),                       // 2: limestone.Room
+	(*RoomBooking)(nil),                // 3: limestone.RoomBooking
+	(*CreateRoomRequest)(nil),          // 4: limestone.CreateRoomRequest
+	(*UpdateRoomRequest)(nil),          // 5: limestone.UpdateRoomRequest
+	(*DeleteRoomRequest)(nil),          // 6: limestone.DeleteRoomRequest
+	(*DeleteRoomResponse)(nil),         // 7: limestone.DeleteRoomResponse
+	(*GetRoomRequest)(nil),             // 8: limestone.GetRoomRequest
+	(*ListRoomsRequest)(nil),           // 9: limestone.ListRoomsRequest
+	(*ListRoomsResponse)(nil),          // 10: limestone.ListRoomsResponse
+	(*GetRoomAvailabilityRequest)(nil), // 11: limestone.GetRoomAvailabilityRequest
+	(*TimeRange)(nil),                  // 12: limestone.TimeRange
+	(*RoomAvailability)(nil),           // 13: limestone.RoomAvailability
+	(*BookRoomRequest)(nil),            // 14: limestone.BookRoomRequest
+	(*CancelRoomBookingRequest)(nil),   // 15: limestone.CancelRoomBookingRequest
+	(*CancelRoomBookingResponse)(nil),  // 16: limestone.CancelRoomBookingResponse
+	(*ListMyBookingsRequest)(nil),      // 17: limestone.ListMyBookingsRequest
+	(*ListRoomBookingsResponse)(nil),   // 18: limestone.ListRoomBookingsResponse
+	(*timestamppb.Timestamp)(nil),      // 19: google.protobuf.Timestamp
+}
+var file_room_service_proto_depIdxs = []int32{
+	2,  // 0: limestone.StandardRoomResponse.room:type_name -> limestone.Room
+	10, // 1: limestone.StandardRoomResponse.list_rooms_response:type_name -> limestone.ListRoomsResponse
+	7,  // 2: limestone.StandardRoomResponse.delete_room_response:type_name -> limestone.DeleteRoomResponse
+	3,  // 3: limestone.StandardRoomResponse.booking:type_name -> limestone.RoomBooking
+	18, // 4: limestone.StandardRoomResponse.list_bookings_response:type_name -> limestone.ListRoomBookingsResponse
+	13, // 5: limestone.StandardRoomResponse.availability:type_name -> limestone.RoomAvailability
+	16, // 6: limestone.StandardRoomResponse.cancel_booking_response:type_name -> limestone.CancelRoomBookingResponse
+	0,  // 7: limestone.Room.designation:type_name -> limestone.Room.Designation
+	19, // 8: limestone.Room.create_time:type_name -> google.protobuf.Timestamp
+	19, // 9: limestone.Room.update_time:type_name -> google.protobuf.Timestamp
+	19, // 10: limestone.RoomBooking.start_time:type_name -> google.protobuf.Timestamp
+	19, // 11: limestone.RoomBooking.end_time:type_name -> google.protobuf.Timestamp
+	19, // 12: limestone.RoomBooking.create_time:type_name -> google.protobuf.Timestamp
+	2,  // 13: limestone.RoomBooking.room:type_name -> limestone.Room
+	2,  // 14: limestone.CreateRoomRequest.room:type_name -> limestone.Room
+	2,  // 15: limestone.UpdateRoomRequest.room:type_name -> limestone.Room
+	2,  // 16: limestone.ListRoomsResponse.rooms:type_name -> limestone.Room
+	19, // 17: limestone.GetRoomAvailabilityRequest.start_from:type_name -> google.protobuf.Timestamp
+	19, // 18: limestone.GetRoomAvailabilityRequest.start_before:type_name -> google.protobuf.Timestamp
+	19, // 19: limestone.TimeRange.start_time:type_name -> google.protobuf.Timestamp
+	19, // 20: limestone.TimeRange.end_time:type_name -> google.protobuf.Timestamp
+	2,  // 21: limestone.RoomAvailability.room:type_name -> limestone.Room
+	19, // 22: limestone.RoomAvailability.start_from:type_name -> google.protobuf.Timestamp
+	19, // 23: limestone.RoomAvailability.start_before:type_name -> google.protobuf.Timestamp
+	3,  // 24: limestone.RoomAvailability.bookings:type_name -> limestone.RoomBooking
+	12, // 25: limestone.RoomAvailability.free:type_name -> limestone.TimeRange
+	3,  // 26: limestone.BookRoomRequest.booking:type_name -> limestone.RoomBooking
+	3,  // 27: limestone.ListRoomBookingsResponse.bookings:type_name -> limestone.RoomBooking
+	4,  // 28: limestone.RoomService.CreateRoom:input_type -> limestone.CreateRoomRequest
+	5,  // 29: limestone.RoomService.UpdateRoom:input_type -> limestone.UpdateRoomRequest
+	6,  // 30: limestone.RoomService.DeleteRoom:input_type -> limestone.DeleteRoomRequest
+	8,  // 31: limestone.RoomService.GetRoom:input_type -> limestone.GetRoomRequest
+	9,  // 32: limestone.RoomService.ListRooms:input_type -> limestone.ListRoomsRequest
+	11, // 33: limestone.RoomService.GetRoomAvailability:input_type -> limestone.GetRoomAvailabilityRequest
+	14, // 34: limestone.RoomService.BookRoom:input_type -> limestone.BookRoomRequest
+	15, // 35: limestone.RoomService.CancelRoomBooking:input_type -> limestone.CancelRoomBookingRequest
+	17, // 36: limestone.RoomService.ListMyBookings:input_type -> limestone.ListMyBookingsRequest
+	1,  // 37: limestone.RoomService.CreateRoom:output_type -> limestone.StandardRoomResponse
+	1,  // 38: limestone.RoomService.UpdateRoom:output_type -> limestone.StandardRoomResponse
+	1,  // 39: limestone.RoomService.DeleteRoom:output_type -> limestone.StandardRoomResponse
+	1,  // 40: limestone.RoomService.GetRoom:output_type -> limestone.StandardRoomResponse
+	1,  // 41: limestone.RoomService.ListRooms:output_type -> limestone.StandardRoomResponse
+	1,  // 42: limestone.RoomService.GetRoomAvailability:output_type -> limestone.StandardRoomResponse
+	1,  // 43: limestone.RoomService.BookRoom:output_type -> limestone.StandardRoomResponse
+	1,  // 44: limestone.RoomService.CancelRoomBooking:output_type -> limestone.StandardRoomResponse
+	1,  // 45: limestone.RoomService.ListMyBookings:output_type -> limestone.StandardRoomResponse
+	37, // [37:46] is the sub-list for method output_type
+	28, // [28:37] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_room_service_proto_init() }
+func file_room_service_proto_init() {
+	if File_room_service_proto != nil {
+		return
+	}
+	file_room_service_proto_msgTypes[0].OneofWrappers = []any{
+		(*StandardRoomResponse_Room)(nil),
+		(*StandardRoomResponse_ListRoomsResponse)(nil),
+		(*StandardRoomResponse_DeleteRoomResponse)(nil),
+		(*StandardRoomResponse_Booking)(nil),
+		(*StandardRoomResponse_ListBookingsResponse)(nil),
+		(*StandardRoomResponse_Availability)(nil),
+		(*StandardRoomResponse_CancelBookingResponse)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_room_service_proto_rawDesc), len(file_room_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_room_service_proto_goTypes,
+		DependencyIndexes: file_room_service_proto_depIdxs,
+		EnumInfos:         file_room_service_proto_enumTypes,
+		MessageInfos:      file_room_service_proto_msgTypes,
+	}.Build()
+	File_room_service_proto = out.File
+	file_room_service_proto_goTypes = nil
+	file_room_service_proto_depIdxs = nil
+}
@@ -0,0 +1,1519 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: volunteer_service.proto
+
+package __
+
+import (
+	_ "google.golang.org/genproto/googleapis/rpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ShiftSignup_Status int32
+
+const (
+	ShiftSignup_STATUS_UNSPECIFIED ShiftSignup_Status = 0
+	ShiftSignup_CLAIMED            ShiftSignup_Status = 1
+	ShiftSignup_RELEASED           ShiftSignup_Status = 2
+	// The volunteer's hours have been recorded.
+	ShiftSignup_WORKED ShiftSignup_Status = 3
+	// The volunteer did not come.
+	ShiftSignup_NO_SHOW ShiftSignup_Status = 4
+)
+
+// Enum value maps for ShiftSignup_Status.
+var (
+	ShiftSignup_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "CLAIMED",
+		2: "RELEASED",
+		3: "WORKED",
+		4: "NO_SHOW",
+	}
+	ShiftSignup_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"CLAIMED":            1,
+		"RELEASED":           2,
+		"WORKED":             3,
+		"NO_SHOW":            4,
+	}
+)
+
+func (x ShiftSignup_Status) Enum() *ShiftSignup_Status {
+	p := new(ShiftSignup_Status)
+	*p = x
+	return p
+}
+
+func (x ShiftSignup_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShiftSignup_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_volunteer_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ShiftSignup_Status) Type() protoreflect.EnumType {
+	return &file_volunteer_service_proto_enumTypes[0]
+}
+
+func (x ShiftSignup_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShiftSignup_Status.Descriptor instead.
+func (ShiftSignup_Status) EnumDescriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type StandardVolunteerResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Status  string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*StandardVolunteerResponse_Shift
+	//	*StandardVolunteerResponse_ListShiftsResponse
+	//	*StandardVolunteerResponse_DeleteShiftResponse
+	//	*StandardVolunteerResponse_Signup
+	//	*StandardVolunteerResponse_ListSignupsResponse
+	//	*StandardVolunteerResponse_VolunteerHoursResponse
+	Data          isStandardVolunteerResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StandardVolunteerResponse) Reset() {
+	*x = StandardVolunteerResponse{}
+	mi := &file_volunteer_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StandardVolunteerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandardVolunteerResponse) ProtoMessage() {}
+
+func (x *StandardVolunteerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandardVolunteerResponse.ProtoReflect.Descriptor instead.
+func (*StandardVolunteerResponse) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StandardVolunteerResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StandardVolunteerResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StandardVolunteerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StandardVolunteerResponse) GetData() isStandardVolunteerResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *StandardVolunteerResponse) GetShift() *VolunteerShift {
+	if x != nil {
+		if x, ok := x.Data.(*StandardVolunteerResponse_Shift); ok {
+			return x.Shift
+		}
+	}
+	return nil
+}
+
+func (x *StandardVolunteerResponse) GetListShiftsResponse() *ListVolunteerShiftsResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardVolunteerResponse_ListShiftsResponse); ok {
+			return x.ListShiftsResponse
+		}
+	}
+	return nil
+}
+
+func (x *StandardVolunteerResponse) GetDeleteShiftResponse() *DeleteVolunteerShiftResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardVolunteerResponse_DeleteShiftResponse); ok {
+			return x.DeleteShiftResponse
+		}
+	}
+	return nil
+}
+
+func (x *StandardVolunteerResponse) GetSignup() *ShiftSignup {
+	if x != nil {
+		if x, ok := x.Data.(*StandardVolunteerResponse_Signup); ok {
+			return x.Signup
+		}
+	}
+	return nil
+}
+
+func (x *StandardVolunteerResponse) GetListSignupsResponse() *ListShiftSignupsResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardVolunteerResponse_ListSignupsResponse); ok {
+			return x.ListSignupsResponse
+		}
+	}
+	return nil
+}
+
+func (x *StandardVolunteerResponse) GetVolunteerHoursResponse() *VolunteerHoursResponse {
+	if x != nil {
+		if x, ok := x.Data.(*StandardVolunteerResponse_VolunteerHoursResponse); ok {
+			return x.VolunteerHoursResponse
+		}
+	}
+	return nil
+}
+
+type isStandardVolunteerResponse_Data interface {
+	isStandardVolunteerResponse_Data()
+}
+
+type StandardVolunteerResponse_Shift struct {
+	Shift *VolunteerShift `protobuf:"bytes,4,opt,name=shift,proto3,oneof"`
+}
+
+type StandardVolunteerResponse_ListShiftsResponse struct {
+	ListShiftsResponse *ListVolunteerShiftsResponse `protobuf:"bytes,5,opt,name=list_shifts_response,json=listShiftsResponse,proto3,oneof"`
+}
+
+type StandardVolunteerResponse_DeleteShiftResponse struct {
+	DeleteShiftResponse *DeleteVolunteerShiftResponse `protobuf:"bytes,6,opt,name=delete_shift_response,json=deleteShiftResponse,proto3,oneof"`
+}
+
+type StandardVolunteerResponse_Signup struct {
+	Signup *ShiftSignup `protobuf:"bytes,7,opt,name=signup,proto3,oneof"`
+}
+
+type StandardVolunteerResponse_ListSignupsResponse struct {
+	ListSignupsResponse *ListShiftSignupsResponse `protobuf:"bytes,8,opt,name=list_signups_response,json=listSignupsResponse,proto3,oneof"`
+}
+
+type StandardVolunteerResponse_VolunteerHoursResponse struct {
+	VolunteerHoursResponse *VolunteerHoursResponse `protobuf:"bytes,9,opt,name=volunteer_hours_response,json=volunteerHoursResponse,proto3,oneof"`
+}
+
+func (*StandardVolunteerResponse_Shift) isStandardVolunteerResponse_Data() {}
+
+func (*StandardVolunteerResponse_ListShiftsResponse) isStandardVolunteerResponse_Data() {}
+
+func (*StandardVolunteerResponse_DeleteShiftResponse) isStandardVolunteerResponse_Data() {}
+
+func (*StandardVolunteerResponse_Signup) isStandardVolunteerResponse_Data() {}
+
+func (*StandardVolunteerResponse_ListSignupsResponse) isStandardVolunteerResponse_Data() {}
+
+func (*StandardVolunteerResponse_VolunteerHoursResponse) isStandardVolunteerResponse_Data() {}
+
+type VolunteerShift struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId  string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	MasjidId string                 `protobuf:"bytes,3,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	// The job, e.g. "Parking", "Food" or "Security".
+	Role        string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// The shift may start before or end after its event, e.g. for setup.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// How many volunteers the shift needs.
+	Headcount      int32                  `protobuf:"varint,8,opt,name=headcount,proto3" json:"headcount,omitempty"`
+	ClaimedCount   int32                  `protobuf:"varint,9,opt,name=claimed_count,json=claimedCount,proto3" json:"claimed_count,omitempty"`
+	RemainingCount int32                  `protobuf:"varint,10,opt,name=remaining_count,json=remainingCount,proto3" json:"remaining_count,omitempty"`
+	CreateTime     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// The volunteers holding places, in the order they claimed them. Set by
+	// GetShiftRoster.
+	Signups       []*ShiftSignup `protobuf:"bytes,13,rep,name=signups,proto3" json:"signups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolunteerShift) Reset() {
+	*x = VolunteerShift{}
+	mi := &file_volunteer_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolunteerShift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolunteerShift) ProtoMessage() {}
+
+func (x *VolunteerShift) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolunteerShift.ProtoReflect.Descriptor instead.
+func (*VolunteerShift) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VolunteerShift) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VolunteerShift) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *VolunteerShift) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *VolunteerShift) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *VolunteerShift) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *VolunteerShift) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *VolunteerShift) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *VolunteerShift) GetHeadcount() int32 {
+	if x != nil {
+		return x.Headcount
+	}
+	return 0
+}
+
+func (x *VolunteerShift) GetClaimedCount() int32 {
+	if x != nil {
+		return x.ClaimedCount
+	}
+	return 0
+}
+
+func (x *VolunteerShift) GetRemainingCount() int32 {
+	if x != nil {
+		return x.RemainingCount
+	}
+	return 0
+}
+
+func (x *VolunteerShift) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *VolunteerShift) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *VolunteerShift) GetSignups() []*ShiftSignup {
+	if x != nil {
+		return x.Signups
+	}
+	return nil
+}
+
+type ShiftSignup struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShiftId   string                 `protobuf:"bytes,2,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	UserId    string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status    ShiftSignup_Status     `protobuf:"varint,4,opt,name=status,proto3,enum=limestone.ShiftSignup_Status" json:"status,omitempty"`
+	ClaimTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=claim_time,json=claimTime,proto3" json:"claim_time,omitempty"`
+	// Set once hours are recorded, with the coordinator who recorded them.
+	MinutesWorked int32  `protobuf:"varint,6,opt,name=minutes_worked,json=minutesWorked,proto3" json:"minutes_worked,omitempty"`
+	RecordedBy    string `protobuf:"bytes,7,opt,name=recorded_by,json=recordedBy,proto3" json:"recorded_by,omitempty"`
+	// The volunteer's name. Set by GetShiftRoster.
+	FirstName string `protobuf:"bytes,8,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string `protobuf:"bytes,9,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	// Set by ListMyShifts.
+	Shift         *VolunteerShift `protobuf:"bytes,10,opt,name=shift,proto3" json:"shift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShiftSignup) Reset() {
+	*x = ShiftSignup{}
+	mi := &file_volunteer_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShiftSignup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShiftSignup) ProtoMessage() {}
+
+func (x *ShiftSignup) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShiftSignup.ProtoReflect.Descriptor instead.
+func (*ShiftSignup) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShiftSignup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShiftSignup) GetShiftId() string {
+	if x != nil {
+		return x.ShiftId
+	}
+	return ""
+}
+
+func (x *ShiftSignup) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ShiftSignup) GetStatus() ShiftSignup_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ShiftSignup_STATUS_UNSPECIFIED
+}
+
+func (x *ShiftSignup) GetClaimTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClaimTime
+	}
+	return nil
+}
+
+func (x *ShiftSignup) GetMinutesWorked() int32 {
+	if x != nil {
+		return x.MinutesWorked
+	}
+	return 0
+}
+
+func (x *ShiftSignup) GetRecordedBy() string {
+	if x != nil {
+		return x.RecordedBy
+	}
+	return ""
+}
+
+func (x *ShiftSignup) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *ShiftSignup) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *ShiftSignup) GetShift() *VolunteerShift {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+type CreateVolunteerShiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Shift         *VolunteerShift        `protobuf:"bytes,2,opt,name=shift,proto3" json:"shift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVolunteerShiftRequest) Reset() {
+	*x = CreateVolunteerShiftRequest{}
+	mi := &file_volunteer_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVolunteerShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVolunteerShiftRequest) ProtoMessage() {}
+
+func (x *CreateVolunteerShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVolunteerShiftRequest.ProtoReflect.Descriptor instead.
+func (*CreateVolunteerShiftRequest) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateVolunteerShiftRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CreateVolunteerShiftRequest) GetShift() *VolunteerShift {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+type UpdateVolunteerShiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Shift         *VolunteerShift        `protobuf:"bytes,2,opt,name=shift,proto3" json:"shift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVolunteerShiftRequest) Reset() {
+	*x = UpdateVolunteerShiftRequest{}
+	mi := &file_volunteer_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVolunteerShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVolunteerShiftRequest) ProtoMessage() {}
+
+func (x *UpdateVolunteerShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVolunteerShiftRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVolunteerShiftRequest) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateVolunteerShiftRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateVolunteerShiftRequest) GetShift() *VolunteerShift {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+type DeleteVolunteerShiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVolunteerShiftRequest) Reset() {
+	*x = DeleteVolunteerShiftRequest{}
+	mi := &file_volunteer_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVolunteerShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVolunteerShiftRequest) ProtoMessage() {}
+
+func (x *DeleteVolunteerShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVolunteerShiftRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVolunteerShiftRequest) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteVolunteerShiftRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteVolunteerShiftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVolunteerShiftResponse) Reset() {
+	*x = DeleteVolunteerShiftResponse{}
+	mi := &file_volunteer_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVolunteerShiftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVolunteerShiftResponse) ProtoMessage() {}
+
+func (x *DeleteVolunteerShiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVolunteerShiftResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVolunteerShiftResponse) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{6}
+}
+
+type ListVolunteerShiftsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolunteerShiftsRequest) Reset() {
+	*x = ListVolunteerShiftsRequest{}
+	mi := &file_volunteer_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolunteerShiftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolunteerShiftsRequest) ProtoMessage() {}
+
+func (x *ListVolunteerShiftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolunteerShiftsRequest.ProtoReflect.Descriptor instead.
+func (*ListVolunteerShiftsRequest) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListVolunteerShiftsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type GetShiftRosterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShiftRosterRequest) Reset() {
+	*x = GetShiftRosterRequest{}
+	mi := &file_volunteer_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShiftRosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShiftRosterRequest) ProtoMessage() {}
+
+func (x *GetShiftRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShiftRosterRequest.ProtoReflect.Descriptor instead.
+func (*GetShiftRosterRequest) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetShiftRosterRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ListVolunteerShiftsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// By start time.
+	Shifts        []*VolunteerShift `protobuf:"bytes,1,rep,name=shifts,proto3" json:"shifts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolunteerShiftsResponse) Reset() {
+	*x = ListVolunteerShiftsResponse{}
+	mi := &file_volunteer_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolunteerShiftsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolunteerShiftsResponse) ProtoMessage() {}
+
+func (x *ListVolunteerShiftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolunteerShiftsResponse.ProtoReflect.Descriptor instead.
+func (*ListVolunteerShiftsResponse) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListVolunteerShiftsResponse) GetShifts() []*VolunteerShift {
+	if x != nil {
+		return x.Shifts
+	}
+	return nil
+}
+
+type ClaimShiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShiftId       string                 `protobuf:"bytes,1,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimShiftRequest) Reset() {
+	*x = ClaimShiftRequest{}
+	mi := &file_volunteer_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimShiftRequest) ProtoMessage() {}
+
+func (x *ClaimShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimShiftRequest.ProtoReflect.Descriptor instead.
+func (*ClaimShiftRequest) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ClaimShiftRequest) GetShiftId() string {
+	if x != nil {
+		return x.ShiftId
+	}
+	return ""
+}
+
+type ReleaseShiftRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ShiftId string                 `protobuf:"bytes,1,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	// Releases another volunteer's place, at any time. Only masjid admins and
+	// imams may set it; the caller's own place is released otherwise.
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseShiftRequest) Reset() {
+	*x = ReleaseShiftRequest{}
+	mi := &file_volunteer_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseShiftRequest) ProtoMessage() {}
+
+func (x *ReleaseShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseShiftRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseShiftRequest) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReleaseShiftRequest) GetShiftId() string {
+	if x != nil {
+		return x.ShiftId
+	}
+	return ""
+}
+
+func (x *ReleaseShiftRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RecordShiftHoursRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ShiftId string                 `protobuf:"bytes,1,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	UserId  string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Defaults to the length of the shift.
+	MinutesWorked int32 `protobuf:"varint,3,opt,name=minutes_worked,json=minutesWorked,proto3" json:"minutes_worked,omitempty"`
+	// Records that the volunteer did not come.
+	NoShow        bool `protobuf:"varint,4,opt,name=no_show,json=noShow,proto3" json:"no_show,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordShiftHoursRequest) Reset() {
+	*x = RecordShiftHoursRequest{}
+	mi := &file_volunteer_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordShiftHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordShiftHoursRequest) ProtoMessage() {}
+
+func (x *RecordShiftHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordShiftHoursRequest.ProtoReflect.Descriptor instead.
+func (*RecordShiftHoursRequest) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RecordShiftHoursRequest) GetShiftId() string {
+	if x != nil {
+		return x.ShiftId
+	}
+	return ""
+}
+
+func (x *RecordShiftHoursRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecordShiftHoursRequest) GetMinutesWorked() int32 {
+	if x != nil {
+		return x.MinutesWorked
+	}
+	return 0
+}
+
+func (x *RecordShiftHoursRequest) GetNoShow() bool {
+	if x != nil {
+		return x.NoShow
+	}
+	return false
+}
+
+type ListMyShiftsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lists shifts ending after start_from, which defaults to now, and
+	// starting before start_before, which defaults to a year later.
+	StartFrom     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_from,json=startFrom,proto3" json:"start_from,omitempty"`
+	StartBefore   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_before,json=startBefore,proto3" json:"start_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyShiftsRequest) Reset() {
+	*x = ListMyShiftsRequest{}
+	mi := &file_volunteer_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyShiftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyShiftsRequest) ProtoMessage() {}
+
+func (x *ListMyShiftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyShiftsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyShiftsRequest) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListMyShiftsRequest) GetStartFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartFrom
+	}
+	return nil
+}
+
+func (x *ListMyShiftsRequest) GetStartBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartBefore
+	}
+	return nil
+}
+
+type ListShiftSignupsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// By the start time of their shifts.
+	Signups       []*ShiftSignup `protobuf:"bytes,1,rep,name=signups,proto3" json:"signups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShiftSignupsResponse) Reset() {
+	*x = ListShiftSignupsResponse{}
+	mi := &file_volunteer_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShiftSignupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShiftSignupsResponse) ProtoMessage() {}
+
+func (x *ListShiftSignupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShiftSignupsResponse.ProtoReflect.Descriptor instead.
+func (*ListShiftSignupsResponse) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListShiftSignupsResponse) GetSignups() []*ShiftSignup {
+	if x != nil {
+		return x.Signups
+	}
+	return nil
+}
+
+type GetVolunteerHoursRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Totals every volunteer's hours at the masjid, over its local year. Only
+	// masjid admins and imams may set it; the caller's own hours at every
+	// masjid are totalled otherwise.
+	MasjidId string `protobuf:"bytes,1,opt,name=masjid_id,json=masjidId,proto3" json:"masjid_id,omitempty"`
+	// Defaults to the current year.
+	Year          int32 `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolunteerHoursRequest) Reset() {
+	*x = GetVolunteerHoursRequest{}
+	mi := &file_volunteer_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolunteerHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolunteerHoursRequest) ProtoMessage() {}
+
+func (x *GetVolunteerHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolunteerHoursRequest.ProtoReflect.Descriptor instead.
+func (*GetVolunteerHoursRequest) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetVolunteerHoursRequest) GetMasjidId() string {
+	if x != nil {
+		return x.MasjidId
+	}
+	return ""
+}
+
+func (x *GetVolunteerHoursRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type VolunteerHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	MinutesWorked int64                  `protobuf:"varint,4,opt,name=minutes_worked,json=minutesWorked,proto3" json:"minutes_worked,omitempty"`
+	ShiftsWorked  int32                  `protobuf:"varint,5,opt,name=shifts_worked,json=shiftsWorked,proto3" json:"shifts_worked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolunteerHours) Reset() {
+	*x = VolunteerHours{}
+	mi := &file_volunteer_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolunteerHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolunteerHours) ProtoMessage() {}
+
+func (x *VolunteerHours) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolunteerHours.ProtoReflect.Descriptor instead.
+func (*VolunteerHours) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VolunteerHours) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VolunteerHours) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *VolunteerHours) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *VolunteerHours) GetMinutesWorked() int64 {
+	if x != nil {
+		return x.MinutesWorked
+	}
+	return 0
+}
+
+func (x *VolunteerHours) GetShiftsWorked() int32 {
+	if x != nil {
+		return x.ShiftsWorked
+	}
+	return 0
+}
+
+type VolunteerHoursResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Year  int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// Most hours first.
+	Volunteers    []*VolunteerHours `protobuf:"bytes,2,rep,name=volunteers,proto3" json:"volunteers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolunteerHoursResponse) Reset() {
+	*x = VolunteerHoursResponse{}
+	mi := &file_volunteer_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolunteerHoursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolunteerHoursResponse) ProtoMessage() {}
+
+func (x *VolunteerHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_volunteer_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolunteerHoursResponse.ProtoReflect.Descriptor instead.
+func (*VolunteerHoursResponse) Descriptor() ([]byte, []int) {
+	return file_volunteer_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VolunteerHoursResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *VolunteerHoursResponse) GetVolunteers() []*VolunteerHours {
+	if x != nil {
+		return x.Volunteers
+	}
+	return nil
+}
+
+var File_volunteer_service_proto protoreflect.FileDescriptor
+
+const file_volunteer_service_proto_rawDesc = "" +
+	"\n" +
+	"\x17volunteer_service.proto\x12\tlimestone\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc3\x04\n" +
+	"\x19StandardVolunteerResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x121\n" +
+	"\x05shift\x18\x04 \x01(\v2\x19.limestone.VolunteerShiftH\x00R\x05shift\x12Z\n" +
+	"\x14list_shifts_response\x18\x05 \x01(\v2&.limestone.ListVolunteerShiftsResponseH\x00R\x12listShiftsResponse\x12]\n" +
+	"\x15delete_shift_response\x18\x06 \x01(\v2'.limestone.DeleteVolunteerShiftResponseH\x00R\x13deleteShiftResponse\x120\n" +
+	"\x06signup\x18\a \x01(\v2\x16.limestone.ShiftSignupH\x00R\x06signup\x12Y\n" +
+	"\x15list_signups_response\x18\b \x01(\v2#.limestone.ListShiftSignupsResponseH\x00R\x13listSignupsResponse\x12]\n" +
+	"\x18volunteer_hours_response\x18\t \x01(\v2!.limestone.VolunteerHoursResponseH\x00R\x16volunteerHoursResponseB\x06\n" +
+	"\x04data\"\xcf\x04\n" +
+	"\x0eVolunteerShift\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\bevent_id\x18\x02 \x01(\tB\x03\xe0A\x03R\aeventId\x12 \n" +
+	"\tmasjid_id\x18\x03 \x01(\tB\x03\xe0A\x03R\bmasjidId\x12\x17\n" +
+	"\x04role\x18\x04 \x01(\tB\x03\xe0A\x02R\x04role\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12>\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\tstartTime\x12:\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\aendTime\x12!\n" +
+	"\theadcount\x18\b \x01(\x05B\x03\xe0A\x02R\theadcount\x12(\n" +
+	"\rclaimed_count\x18\t \x01(\x05B\x03\xe0A\x03R\fclaimedCount\x12,\n" +
+	"\x0fremaining_count\x18\n" +
+	" \x01(\x05B\x03\xe0A\x03R\x0eremainingCount\x12@\n" +
+	"\vcreate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\x125\n" +
+	"\asignups\x18\r \x03(\v2\x16.limestone.ShiftSignupB\x03\xe0A\x03R\asignups\"\xce\x03\n" +
+	"\vShiftSignup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bshift_id\x18\x02 \x01(\tR\ashiftId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x125\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1d.limestone.ShiftSignup.StatusR\x06status\x129\n" +
+	"\n" +
+	"claim_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tclaimTime\x12%\n" +
+	"\x0eminutes_worked\x18\x06 \x01(\x05R\rminutesWorked\x12\x1f\n" +
+	"\vrecorded_by\x18\a \x01(\tR\n" +
+	"recordedBy\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\b \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\t \x01(\tR\blastName\x12/\n" +
+	"\x05shift\x18\n" +
+	" \x01(\v2\x19.limestone.VolunteerShiftR\x05shift\"T\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aCLAIMED\x10\x01\x12\f\n" +
+	"\bRELEASED\x10\x02\x12\n" +
+	"\n" +
+	"\x06WORKED\x10\x03\x12\v\n" +
+	"\aNO_SHOW\x10\x04\"s\n" +
+	"\x1bCreateVolunteerShiftRequest\x12\x1e\n" +
+	"\bevent_id\x18\x01 \x01(\tB\x03\xe0A\x02R\aeventId\x124\n" +
+	"\x05shift\x18\x02 \x01(\v2\x19.limestone.VolunteerShiftB\x03\xe0A\x02R\x05shift\"h\n" +
+	"\x1bUpdateVolunteerShiftRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x124\n" +
+	"\x05shift\x18\x02 \x01(\v2\x19.limestone.VolunteerShiftB\x03\xe0A\x02R\x05shift\"2\n" +
+	"\x1bDeleteVolunteerShiftRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\x1e\n" +
+	"\x1cDeleteVolunteerShiftResponse\"<\n" +
+	"\x1aListVolunteerShiftsRequest\x12\x1e\n" +
+	"\bevent_id\x18\x01 \x01(\tB\x03\xe0A\x02R\aeventId\"7\n" +
+	"\x15GetShiftRosterRequest\x12\x1e\n" +
+	"\bevent_id\x18\x01 \x01(\tB\x03\xe0A\x02R\aeventId\"P\n" +
+	"\x1bListVolunteerShiftsResponse\x121\n" +
+	"\x06shifts\x18\x01 \x03(\v2\x19.limestone.VolunteerShiftR\x06shifts\"3\n" +
+	"\x11ClaimShiftRequest\x12\x1e\n" +
+	"\bshift_id\x18\x01 \x01(\tB\x03\xe0A\x02R\ashiftId\"N\n" +
+	"\x13ReleaseShiftRequest\x12\x1e\n" +
+	"\bshift_id\x18\x01 \x01(\tB\x03\xe0A\x02R\ashiftId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x97\x01\n" +
+	"\x17RecordShiftHoursRequest\x12\x1e\n" +
+	"\bshift_id\x18\x01 \x01(\tB\x03\xe0A\x02R\ashiftId\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x06userId\x12%\n" +
+	"\x0eminutes_worked\x18\x03 \x01(\x05R\rminutesWorked\x12\x17\n" +
+	"\ano_show\x18\x04 \x01(\bR\x06noShow\"\x8f\x01\n" +
+	"\x13ListMyShiftsRequest\x129\n" +
+	"\n" +
+	"start_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartFrom\x12=\n" +
+	"\fstart_before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vstartBefore\"L\n" +
+	"\x18ListShiftSignupsResponse\x120\n" +
+	"\asignups\x18\x01 \x03(\v2\x16.limestone.ShiftSignupR\asignups\"K\n" +
+	"\x18GetVolunteerHoursRequest\x12\x1b\n" +
+	"\tmasjid_id\x18\x01 \x01(\tR\bmasjidId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\"\xb1\x01\n" +
+	"\x0eVolunteerHours\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12%\n" +
+	"\x0eminutes_worked\x18\x04 \x01(\x03R\rminutesWorked\x12#\n" +
+	"\rshifts_worked\x18\x05 \x01(\x05R\fshiftsWorked\"g\n" +
+	"\x16VolunteerHoursResponse\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x129\n" +
+	"\n" +
+	"volunteers\x18\x02 \x03(\v2\x19.limestone.VolunteerHoursR\n" +
+	"volunteers2\x80\v\n" +
+	"\x10VolunteerService\x12\xa1\x01\n" +
+	"\x14CreateVolunteerShift\x12&.limestone.CreateVolunteerShiftRequest\x1a$.limestone.StandardVolunteerResponse\";\xdaA\x0eevent_id,shift\x82\xd3\xe4\x93\x02$:\x05shift\"\x1b/v1/event/{event_id}/shifts\x12\x8f\x01\n" +
+	"\x14UpdateVolunteerShift\x12&.limestone.UpdateVolunteerShiftRequest\x1a$.limestone.StandardVolunteerResponse\")\xdaA\bid,shift\x82\xd3\xe4\x93\x02\x18:\x05shift2\x0f/v1/shifts/{id}\x12\x82\x01\n" +
+	"\x14DeleteVolunteerShift\x12&.limestone.DeleteVolunteerShiftRequest\x1a$.limestone.StandardVolunteerResponse\"\x1c\xdaA\x02id\x82\xd3\xe4\x93\x02\x11*\x0f/v1/shifts/{id}\x12\x92\x01\n" +
+	"\x13ListVolunteerShifts\x12%.limestone.ListVolunteerShiftsRequest\x1a$.limestone.StandardVolunteerResponse\".\xdaA\bevent_id\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/event/{event_id}/shifts\x12\x88\x01\n" +
+	"\x0eGetShiftRoster\x12 .limestone.GetShiftRosterRequest\x1a$.limestone.StandardVolunteerResponse\".\xdaA\bevent_id\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/event/{event_id}/roster\x12\x83\x01\n" +
+	"\n" +
+	"ClaimShift\x12\x1c.limestone.ClaimShiftRequest\x1a$.limestone.StandardVolunteerResponse\"1\xdaA\bshift_id\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/shifts/{shift_id}/claim\x12\x89\x01\n" +
+	"\fReleaseShift\x12\x1e.limestone.ReleaseShiftRequest\x1a$.limestone.StandardVolunteerResponse\"3\xdaA\bshift_id\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/shifts/{shift_id}/release\x12\x97\x01\n" +
+	"\x10RecordShiftHours\x12\".limestone.RecordShiftHoursRequest\x1a$.limestone.StandardVolunteerResponse\"9\xdaA\x10shift_id,user_id\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/shifts/{shift_id}/hours\x12h\n" +
+	"\fListMyShifts\x12\x1e.limestone.ListMyShiftsRequest\x1a$.limestone.StandardVolunteerResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/shifts\x12{\n" +
+	"\x11GetVolunteerHours\x12#.limestone.GetVolunteerHoursRequest\x1a$.limestone.StandardVolunteerResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/volunteer-hoursBm\n" +
+	"\rcom.limestoneB\x15VolunteerServiceProtoP\x01Z\x01.\xa2\x02\x03LXX\xaa\x02\tLimestone\xca\x02\tLimestone\xe2\x02\x15Limestone\\GPBMetadata\xea\x02\tLimestoneb\x06proto3"
+
+var (
+	file_volunteer_service_proto_rawDescOnce sync.Once
+	file_volunteer_service_proto_rawDescData []byte
+)
+
+func file_volunteer_service_proto_rawDescGZIP() []byte {
+	file_volunteer_service_proto_rawDescOnce.Do(func() {
+		file_volunteer_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_volunteer_service_proto_rawDesc), len(file_volunteer_service_proto_rawDesc)))
+	})
+	return file_volunteer_service_proto_rawDescData
+}
+
+var file_volunteer_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_volunteer_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_volunteer_service_proto_goTypes = []any{
+	(ShiftSignup_Status)(0),              // 0: limestone.ShiftSignup.Status
+	(*StandardVolunteerResponse)(nil),    // 1: limestone.StandardVolunteerResponse
+	(*VolunteerShift)(nil),               // 2: limestone.VolunteerShift
+	(*ShiftSignup)(nil),                  // 3: limestone.ShiftSignup
+	(*CreateVolunteerShiftRequest)(nil),  // 4: limestone.CreateVolunteerShiftRequest
+	(*UpdateVolunteerShiftRequest)(nil),  // 5: limestone.UpdateVolunteerShiftRequest
+	(*DeleteVolunteerShiftRequest)(nil),  // 6: limestone.DeleteVolunteerShiftRequest
+	(*DeleteVolunteerShiftResponse)(nil), // 7: limestone.DeleteVolunteerShiftResponse
+	(*ListVolunteerShiftsRequest)(nil),   // 8: limestone.ListVolunteerShiftsRequest
+	(*GetShiftRosterRequest)(nil),        // 9: limestone.GetShiftRosterRequest
+	(*ListVolunteerShiftsResponse)(nil),  // 10: limestone.ListVolunteerShiftsResponse
+	(*ClaimShiftRequest)(nil),            // 11: limestone.ClaimShiftRequest
+	(*ReleaseShiftRequest)(nil),          // 12: limestone.ReleaseShiftRequest
+	(*RecordShiftHoursRequest)(nil),      // 13: limestone.RecordShiftHoursRequest
+	(*ListMyShiftsRequest)(nil),          // 14: limestone.ListMyShiftsRequest
+	(*ListShiftSignupsResponse)(nil),     // 15: limestone.ListShiftSignupsResponse
+	(*GetVolunteerHoursRequest)(nil),     // 16: limestone.GetVolunteerHoursRequest
+	(*VolunteerHours)(nil),               // 17: limestone.VolunteerHours
+	(*VolunteerHoursResponse)(nil),       // 18: limestone.VolunteerHoursResponse
+	(*timestamppb.Timestamp)(nil),        // 19: google.protobuf.Timestamp
+}
+var file_volunteer_service_proto_depIdxs = []int32{
+	2,  // 0: limestone.StandardVolunteerResponse.shift:type_name -> limestone.VolunteerShift
+	10, // 1: limestone.StandardVolunteerResponse.list_shifts_response:type_name -> limestone.ListVolunteerShiftsResponse
+	7,  // 2: limestone.StandardVolunteerResponse.delete_shift_response:type_name -> limestone.DeleteVolunteerShiftResponse
+	3,  // 3: limestone.StandardVolunteerResponse.signup:type_name -> limestone.ShiftSignup
+	15, // 4: limestone.StandardVolunteerResponse.list_signups_response:type_name -> limestone.ListShiftSignupsResponse
+	18, // 5: limestone.StandardVolunteerResponse.volunteer_hours_response:type_name -> limestone.VolunteerHoursResponse
+	19, // 6: limestone.VolunteerShift.start_time:type_name -> google.protobuf.Timestamp
+	19, // 7: limestone.VolunteerShift.end_time:type_name -> google.protobuf.Timestamp
+	19, // 8: limestone.VolunteerShift.create_time:type_name -> google.protobuf.Timestamp
+	19, // 9: limestone.VolunteerShift.update_time:type_name -> google.protobuf.Timestamp
+	3,  // 10: limestone.VolunteerShift.signups:type_name -> limestone.ShiftSignup
+	0,  // 11: limestone.ShiftSignup.status:type_name -> limestone.ShiftSignup.Status
+	19, // 12: limestone.ShiftSignup.claim_time:type_name -> google.protobuf.Timestamp
+	2,  // 13: limestone.ShiftSignup.shift:type_name -> limestone.VolunteerShift
+	2,  // 14: limestone.CreateVolunteerShiftRequest.shift:type_name -> limestone.VolunteerShift
+	2,  // 15: limestone.UpdateVolunteerShiftRequest.shift:type_name -> limestone.VolunteerShift
+	2,  // 16: limestone.ListVolunteerShiftsResponse.shifts:type_name -> limestone.VolunteerShift
+	19, // 17: limestone.ListMyShiftsRequest.start_from:type_name -> google.protobuf.Timestamp
+	19, // 18: limestone.ListMyShiftsRequest.start_before:type_name -> google.protobuf.Timestamp
+	3,  // 19: limestone.ListShiftSignupsResponse.signups:type_name -> limestone.ShiftSignup
+	17, // 20: limestone.VolunteerHoursResponse.volunteers:type_name -> limestone.VolunteerHours
+	4,  // 21: limestone.VolunteerService.CreateVolunteerShift:input_type -> limestone.CreateVolunteerShiftRequest
+	5,  // 22: limestone.VolunteerService.UpdateVolunteerShift:input_type -> limestone.UpdateVolunteerShiftRequest
+	6,  // 23: limestone.VolunteerService.DeleteVolunteerShift:input_type -> limestone.DeleteVolunteerShiftRequest
+	8,  // 24: limestone.VolunteerService.ListVolunteerShifts:input_type -> limestone.ListVolunteerShiftsRequest
+	9,  // 25: limestone.VolunteerService.GetShiftRoster:input_type -> limestone.GetShiftRosterRequest
+	11, // 26: limestone.VolunteerService.ClaimShift:input_type -> limestone.ClaimShiftRequest
+	12, // 27: limestone.VolunteerService.ReleaseShift:input_type -> limestone.ReleaseShiftRequest
+	13, // 28: limestone.VolunteerService.RecordShiftHours:input_type -> limestone.RecordShiftHoursRequest
+	14, // 29: limestone.VolunteerService.ListMyShifts:input_type -> limestone.ListMyShiftsRequest
+	16, // 30: limestone.VolunteerService.GetVolunteerHours:input_type -> limestone.GetVolunteerHoursRequest
+	1,  // 31: limestone.VolunteerService.CreateVolunteerShift:output_type -> limestone.StandardVolunteerResponse
+	1,  // 32: limestone.VolunteerService.UpdateVolunteerShift:output_type -> limestone.StandardVolunteerResponse
+	1,  // 33: limestone.VolunteerService.DeleteVolunteerShift:output_type -> limestone.StandardVolunteerResponse
+	1,  // 34: limestone.VolunteerService.ListVolunteerShifts:output_type -> limestone.StandardVolunteerResponse
+	1,  // 35: limestone.VolunteerService.GetShiftRoster:output_type -> limestone.StandardVolunteerResponse
+	1,  // 36: limestone.VolunteerService.ClaimShift:output_type -> limestone.StandardVolunteerResponse
+	1,  // 37: limestone.VolunteerService.ReleaseShift:output_type -> limestone.StandardVolunteerResponse
+	1,  // 38: limestone.VolunteerService.RecordShiftHours:output_type -> limestone.StandardVolunteerResponse
+	1,  // 39: limestone.VolunteerService.ListMyShifts:output_type -> limestone.StandardVolunteerResponse
+	1,  // 40: limestone.VolunteerService.GetVolunteerHours:output_type -> limestone.StandardVolunteerResponse
+	31, // [31:41] is the sub-list for method output_type
+	21, // [21:31] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_volunteer_service_proto_init() }
+func file_volunteer_service_proto_init() {
+	if File_volunteer_service_proto != nil {
+		return
+	}
+	file_volunteer_service_proto_msgTypes[0].OneofWrappers = []any{
+		(*StandardVolunteerResponse_Shift)(nil),
+		(*StandardVolunteerResponse_ListShiftsResponse)(nil),
+		(*StandardVolunteerResponse_DeleteShiftResponse)(nil),
+		(*StandardVolunteerResponse_Signup)(nil),
+		(*StandardVolunteerResponse_ListSignupsResponse)(nil),
+		(*StandardVolunteerResponse_VolunteerHoursResponse)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_volunteer_service_proto_rawDesc), len(file_volunteer_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_volunteer_service_proto_goTypes,
+		DependencyIndexes: file_volunteer_service_proto_depIdxs,
+		EnumInfos:         file_volunteer_service_proto_enumTypes,
+		MessageInfos:      file_volunteer_service_proto_msgTypes,
+	}.Build()
+	File_volunteer_service_proto = out.File
+	file_volunteer_service_proto_goTypes = nil
+	file_volunteer_service_proto_depIdxs = nil
+}
@@ -0,0 +1,501 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: volunteer_service.proto
+
+package __
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	VolunteerService_CreateVolunteerShift_FullMethodName = "/limestone.VolunteerService/CreateVolunteerShift"
+	VolunteerService_UpdateVolunteerShift_FullMethodName = "/limestone.VolunteerService/UpdateVolunteerShift"
+	VolunteerService_DeleteVolunteerShift_FullMethodName = "/limestone.VolunteerService/DeleteVolunteerShift"
+	VolunteerService_ListVolunteerShifts_FullMethodName  = "/limestone.VolunteerService/ListVolunteerShifts"
+	VolunteerService_GetShiftRoster_FullMethodName       = "/limestone.VolunteerService/GetShiftRoster"
+	VolunteerService_ClaimShift_FullMethodName           = "/limestone.VolunteerService/ClaimShift"
+	VolunteerService_ReleaseShift_FullMethodName         = "/limestone.VolunteerService/ReleaseShift"
+	VolunteerService_RecordShiftHours_FullMethodName     = "/limestone.VolunteerService/RecordShiftHours"
+	VolunteerService_ListMyShifts_FullMethodName         = "/limestone.VolunteerService/ListMyShifts"
+	VolunteerService_GetVolunteerHours_FullMethodName    = "/limestone.VolunteerService/GetVolunteerHours"
+)
+
+// VolunteerServiceClient is the client API for VolunteerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VolunteerServiceClient interface {
+	// Adds a volunteer shift to an event. Shifts of a recurring event are
+	// added to an occurrence once it has been edited on its own. Only masjid
+	// admins and imams may manage shifts.
+	CreateVolunteerShift(ctx context.Context, in *CreateVolunteerShiftRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error)
+	// Replaces the role, description, times and headcount of a shift. The
+	// headcount cannot drop below the volunteers who have claimed it.
+	UpdateVolunteerShift(ctx context.Context, in *UpdateVolunteerShiftRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error)
+	// Deletes a shift and its sign-ups. Shifts with hours recorded cannot be
+	// deleted.
+	DeleteVolunteerShift(ctx context.Context, in *DeleteVolunteerShiftRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error)
+	// Lists an event's shifts with the places left on each.
+	ListVolunteerShifts(ctx context.Context, in *ListVolunteerShiftsRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error)
+	// Lists an event's shifts with the volunteers signed up for each. Only
+	// masjid admins and imams may see the roster.
+	GetShiftRoster(ctx context.Context, in *GetShiftRosterRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error)
+	// Claims a place on a shift that has not started for the caller. A
+	// volunteer cannot hold places on two shifts at the same time.
+	ClaimShift(ctx context.Context, in *ClaimShiftRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error)
+	// Gives up a place on a shift. Volunteers may release their places until
+	// the shift starts.
+	ReleaseShift(ctx context.Context, in *ReleaseShiftRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error)
+	// Records the time a volunteer worked on a shift, once it has started.
+	// Recording again replaces what was recorded. Only masjid admins and
+	// imams may record hours.
+	RecordShiftHours(ctx context.Context, in *RecordShiftHoursRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error)
+	// Lists the shifts the caller holds places on.
+	ListMyShifts(ctx context.Context, in *ListMyShiftsRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error)
+	// Totals the hours volunteers worked over a year, for recognising them.
+	GetVolunteerHours(ctx context.Context, in *GetVolunteerHoursRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error)
+}
+
+type volunteerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVolunteerServiceClient(cc grpc.ClientConnInterface) VolunteerServiceClient {
+	return &volunteerServiceClient{cc}
+}
+
+func (c *volunteerServiceClient) CreateVolunteerShift(ctx context.Context, in *CreateVolunteerShiftRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardVolunteerResponse)
+	err := c.cc.Invoke(ctx, VolunteerService_CreateVolunteerShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volunteerServiceClient) UpdateVolunteerShift(ctx context.Context, in *UpdateVolunteerShiftRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardVolunteerResponse)
+	err := c.cc.Invoke(ctx, VolunteerService_UpdateVolunteerShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volunteerServiceClient) DeleteVolunteerShift(ctx context.Context, in *DeleteVolunteerShiftRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardVolunteerResponse)
+	err := c.cc.Invoke(ctx, VolunteerService_DeleteVolunteerShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volunteerServiceClient) ListVolunteerShifts(ctx context.Context, in *ListVolunteerShiftsRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardVolunteerResponse)
+	err := c.cc.Invoke(ctx, VolunteerService_ListVolunteerShifts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volunteerServiceClient) GetShiftRoster(ctx context.Context, in *GetShiftRosterRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardVolunteerResponse)
+	err := c.cc.Invoke(ctx, VolunteerService_GetShiftRoster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volunteerServiceClient) ClaimShift(ctx context.Context, in *ClaimShiftRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardVolunteerResponse)
+	err := c.cc.Invoke(ctx, VolunteerService_ClaimShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volunteerServiceClient) ReleaseShift(ctx context.Context, in *ReleaseShiftRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardVolunteerResponse)
+	err := c.cc.Invoke(ctx, VolunteerService_ReleaseShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volunteerServiceClient) RecordShiftHours(ctx context.Context, in *RecordShiftHoursRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardVolunteerResponse)
+	err := c.cc.Invoke(ctx, VolunteerService_RecordShiftHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volunteerServiceClient) ListMyShifts(ctx context.Context, in *ListMyShiftsRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardVolunteerResponse)
+	err := c.cc.Invoke(ctx, VolunteerService_ListMyShifts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volunteerServiceClient) GetVolunteerHours(ctx context.Context, in *GetVolunteerHoursRequest, opts ...grpc.CallOption) (*StandardVolunteerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StandardVolunteerResponse)
+	err := c.cc.Invoke(ctx, VolunteerService_GetVolunteerHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VolunteerServiceServer is the server API for VolunteerService service.
+// All implementations must embed UnimplementedVolunteerServiceServer
+// for forward compatibility.
+type VolunteerServiceServer interface {
+	// Adds a volunteer shift to an event. Shifts of a recurring event are
+	// added to an occurrence once it has been edited on its own. Only masjid
+	// admins and imams may manage shifts.
+	CreateVolunteerShift(context.Context, *CreateVolunteerShiftRequest) (*StandardVolunteerResponse, error)
+	// Replaces the role, description, times and headcount of a shift. The
+	// headcount cannot drop below the volunteers who have claimed it.
+	UpdateVolunteerShift(context.Context, *UpdateVolunteerShiftRequest) (*StandardVolunteerResponse, error)
+	// Deletes a shift and its sign-ups. Shifts with hours recorded cannot be
+	// deleted.
+	DeleteVolunteerShift(context.Context, *DeleteVolunteerShiftRequest) (*StandardVolunteerResponse, error)
+	// Lists an event's shifts with the places left on each.
+	ListVolunteerShifts(context.Context, *ListVolunteerShiftsRequest) (*StandardVolunteerResponse, error)
+	// Lists an event's shifts with the volunteers signed up for each. Only
+	// masjid admins and imams may see the roster.
+	GetShiftRoster(context.Context, *GetShiftRosterRequest) (*StandardVolunteerResponse, error)
+	// Claims a place on a shift that has not started for the caller. A
+	// volunteer cannot hold places on two shifts at the same time.
+	ClaimShift(context.Context, *ClaimShiftRequest) (*StandardVolunteerResponse, error)
+	// Gives up a place on a shift. Volunteers may release their places until
+	// the shift starts.
+	ReleaseShift(context.Context, *ReleaseShiftRequest) (*StandardVolunteerResponse, error)
+	// Records the time a volunteer worked on a shift, once it has started.
+	// Recording again replaces what was recorded. Only masjid admins and
+	// imams may record hours.
+	RecordShiftHours(context.Context, *RecordShiftHoursRequest) (*StandardVolunteerResponse, error)
+	// Lists the shifts the caller holds places on.
+	ListMyShifts(context.Context, *ListMyShiftsRequest) (*StandardVolunteerResponse, error)
+	// Totals the hours volunteers worked over a year, for recognising them.
+	GetVolunteerHours(context.Context, *GetVolunteerHoursRequest) (*StandardVolunteerResponse, error)
+	mustEmbedUnimplementedVolunteerServiceServer()
+}
+
+// UnimplementedVolunteerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVolunteerServiceServer struct{}
+
+func (UnimplementedVolunteerServiceServer) CreateVolunteerShift(context.Context, *CreateVolunteerShiftRequest) (*StandardVolunteerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolunteerShift not implemented")
+}
+func (UnimplementedVolunteerServiceServer) UpdateVolunteerShift(context.Context, *UpdateVolunteerShiftRequest) (*StandardVolunteerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVolunteerShift not implemented")
+}
+func (UnimplementedVolunteerServiceServer) DeleteVolunteerShift(context.Context, *DeleteVolunteerShiftRequest) (*StandardVolunteerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVolunteerShift not implemented")
+}
+func (UnimplementedVolunteerServiceServer) ListVolunteerShifts(context.Context, *ListVolunteerShiftsRequest) (*StandardVolunteerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVolunteerShifts not implemented")
+}
+func (UnimplementedVolunteerServiceServer) GetShiftRoster(context.Context, *GetShiftRosterRequest) (*StandardVolunteerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShiftRoster not implemented")
+}
+func (UnimplementedVolunteerServiceServer) ClaimShift(context.Context, *ClaimShiftRequest) (*StandardVolunteerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimShift not implemented")
+}
+func (UnimplementedVolunteerServiceServer) ReleaseShift(context.Context, *ReleaseShiftRequest) (*StandardVolunteerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseShift not implemented")
+}
+func (UnimplementedVolunteerServiceServer) RecordShiftHours(context.Context, *RecordShiftHoursRequest) (*StandardVolunteerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordShiftHours not implemented")
+}
+func (UnimplementedVolunteerServiceServer) ListMyShifts(context.Context, *ListMyShiftsRequest) (*StandardVolunteerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyShifts not implemented")
+}
+func (UnimplementedVolunteerServiceServer) GetVolunteerHours(context.Context, *GetVolunteerHoursRequest) (*StandardVolunteerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolunteerHours not implemented")
+}
+func (UnimplementedVolunteerServiceServer) mustEmbedUnimplementedVolunteerServiceServer() {}
+func (UnimplementedVolunteerServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeVolunteerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VolunteerServiceServer will
+// result in compilation errors.
+type UnsafeVolunteerServiceServer interface {
+	mustEmbedUnimplementedVolunteerServiceServer()
+}
+
+func RegisterVolunteerServiceServer(s grpc.ServiceRegistrar, srv VolunteerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedVolunteerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VolunteerService_ServiceDesc, srv)
+}
+
+func _VolunteerService_CreateVolunteerShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolunteerShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolunteerServiceServer).CreateVolunteerShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolunteerService_CreateVolunteerShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolunteerServiceServer).CreateVolunteerShift(ctx, req.(*CreateVolunteerShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolunteerService_UpdateVolunteerShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVolunteerShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolunteerServiceServer).UpdateVolunteerShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolunteerService_UpdateVolunteerShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolunteerServiceServer).UpdateVolunteerShift(ctx, req.(*UpdateVolunteerShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolunteerService_DeleteVolunteerShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolunteerShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolunteerServiceServer).DeleteVolunteerShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolunteerService_DeleteVolunteerShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolunteerServiceServer).DeleteVolunteerShift(ctx, req.(*DeleteVolunteerShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolunteerService_ListVolunteerShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVolunteerShiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolunteerServiceServer).ListVolunteerShifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolunteerService_ListVolunteerShifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolunteerServiceServer).ListVolunteerShifts(ctx, req.(*ListVolunteerShiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolunteerService_GetShiftRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShiftRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolunteerServiceServer).GetShiftRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolunteerService_GetShiftRoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolunteerServiceServer).GetShiftRoster(ctx, req.(*GetShiftRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolunteerService_ClaimShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolunteerServiceServer).ClaimShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolunteerService_ClaimShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolunteerServiceServer).ClaimShift(ctx, req.(*ClaimShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolunteerService_ReleaseShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolunteerServiceServer).ReleaseShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolunteerService_ReleaseShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolunteerServiceServer).ReleaseShift(ctx, req.(*ReleaseShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolunteerService_RecordShiftHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordShiftHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolunteerServiceServer).RecordShiftHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolunteerService_RecordShiftHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolunteerServiceServer).RecordShiftHours(ctx, req.(*RecordShiftHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolunteerService_ListMyShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyShiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolunteerServiceServer).ListMyShifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolunteerService_ListMyShifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolunteerServiceServer).ListMyShifts(ctx, req.(*ListMyShiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolunteerService_GetVolunteerHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolunteerHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolunteerServiceServer).GetVolunteerHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolunteerService_GetVolunteerHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolunteerServiceServer).GetVolunteerHours(ctx, req.(*GetVolunteerHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VolunteerService_ServiceDesc is the grpc.ServiceDesc for VolunteerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VolunteerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "limestone.VolunteerService",
+	HandlerType: (*VolunteerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVolunteerShift",
+			Handler:    _VolunteerService_CreateVolunteerShift_Handler,
+		},
+		{
+			MethodName: "UpdateVolunteerShift",
+			Handler:    _VolunteerService_UpdateVolunteerShift_Handler,
+		},
+		{
+			MethodName: "DeleteVolunteerShift",
+			Handler:    _VolunteerService_DeleteVolunteerShift_Handler,
+		},
+		{
+			MethodName: "ListVolunteerShifts",
+			Handler:    _VolunteerService_ListVolunteerShifts_Handler,
+		},
+		{
+			MethodName: "GetShiftRoster",
+			Handler:    _VolunteerService_GetShiftRoster_Handler,
+		},
+		{
+			MethodName: "ClaimShift",
+			Handler:    _VolunteerService_ClaimShift_Handler,
+		},
+		{
+			MethodName: "ReleaseShift",
+			Handler:    _VolunteerService_ReleaseShift_Handler,
+		},
+		{
+			MethodName: "RecordShiftHours",
+			Handler:    _VolunteerService_RecordShiftHours_Handler,
+		},
+		{
+			MethodName: "ListMyShifts",
+			Handler:    _VolunteerService_ListMyShifts_Handler,
+		},
+		{
+			MethodName: "GetVolunteerHours",
+			Handler:    _VolunteerService_GetVolunteerHours_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "volunteer_service.proto",
+}
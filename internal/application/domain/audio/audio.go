@@ -0,0 +1,207 @@
+// Package audio identifies uploaded audio files and reads their technical
+// metadata without decoding them.
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported audio format, expected MP3, WAV, FLAC, Ogg Opus, Ogg Vorbis, AAC or M4A")
+	ErrMalformed         = errors.New("malformed audio file")
+	ErrTruncated         = errors.New("audio file is truncated")
+	ErrTooLong           = errors.New("audio is too long")
+	ErrSilent            = errors.New("audio is silent")
+)
+
+// MaxDuration bounds the length of an adhan. The longest recitations run
+// to about five minutes.
+const MaxDuration = 10 * time.Minute
+
+// HeaderSize is enough of the start of a file for Detect.
+const HeaderSize = 12
+
+// Container formats.
+const (
+	FormatMP3  = "mp3"
+	FormatWAV  = "wav"
+	FormatFLAC = "flac"
+	FormatOgg  = "ogg"
+	FormatMP4  = "mp4"
+	FormatADTS = "aac"
+)
+
+var contentTypes = map[string]string{
+	FormatMP3:  "audio/mpeg",
+	FormatWAV:  "audio/wav",
+	FormatFLAC: "audio/flac",
+	FormatOgg:  "audio/ogg",
+	FormatMP4:  "audio/mp4",
+	FormatADTS: "audio/aac",
+}
+
+// Info describes an audio file.
+type Info struct {
+	Format   string
+	Codec    string
+	Duration time.Duration
+	// SampleRate is in Hz.
+	SampleRate int
+	Channels   int
+	// Bitrate is the average over the whole file, in bits per second.
+	Bitrate int
+	// Silent is set when the file is known to carry no sound. It is only
+	// determined where that is possible without decoding: PCM WAV, FLAC and
+	// MP3.
+	Silent bool
+}
+
+func (i *Info) ContentType() string {
+	return ContentType(i.Format)
+}
+
+// ContentType returns the MIME type of a container format, or
+// application/octet-stream if it is unknown.
+func ContentType(format string) string {
+	if t, ok := contentTypes[format]; ok {
+		return t
+	}
+	return "application/octet-stream"
+}
+
+// Detect returns the container format that header, the first HeaderSize
+// bytes of a file, appears to start, or "" if none does. An ID3 tag is
+// reported as MP3 although it may also precede raw AAC.
+func Detect(header []byte) string {
+	switch {
+	case bytes.HasPrefix(header, []byte("ID3")):
+		return FormatMP3
+	case bytes.HasPrefix(header, []byte("RIFF")):
+		if len(header) < 12 || string(header[8:12]) == "WAVE" {
+			return FormatWAV
+		}
+	case bytes.HasPrefix(header, []byte("fLaC")):
+		return FormatFLAC
+	case bytes.HasPrefix(header, []byte("OggS")):
+		return FormatOgg
+	case len(header) >= 8 && string(header[4:8]) == "ftyp":
+		return FormatMP4
+	case isADTSSync(header):
+		return FormatADTS
+	case isMPEGAudioSync(header):
+		return FormatMP3
+	}
+	return ""
+}
+
+// Probe reads the metadata of the size-byte file in r.
+func Probe(r io.ReaderAt, size int64) (*Info, error) {
+	header := make([]byte, HeaderSize)
+	n, err := r.ReadAt(header, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	header = header[:n]
+
+	var info *Info
+	switch Detect(header) {
+	case FormatMP3:
+		info, err = probeMPEG(r, size)
+	case FormatADTS:
+		info, err = probeADTS(r, 0, size)
+	case FormatWAV:
+		info, err = probeWAV(r, size)
+	case FormatFLAC:
+		info, err = probeFLAC(r, size)
+	case FormatOgg:
+		info, err = probeOgg(r, size)
+	case FormatMP4:
+		info, err = probeMP4(r, size)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if info.Duration <= 0 {
+		return nil, fmt.Errorf("%w: no audio found", ErrTruncated)
+	}
+	return info, nil
+}
+
+// Check rejects audio that is silent or longer than MaxDuration.
+func (i *Info) Check() error {
+	if i.Duration > MaxDuration {
+		return fmt.Errorf("%w: %s exceeds the limit of %s", ErrTooLong, i.Duration.Round(time.Second), MaxDuration)
+	}
+	if i.Silent {
+		return ErrSilent
+	}
+	return nil
+}
+
+// durationOf converts a sample count at rate Hz to a duration.
+func durationOf(samples uint64, rate int) time.Duration {
+	if rate <= 0 {
+		return 0
+	}
+	seconds := samples / uint64(rate)
+	rest := samples % uint64(rate)
+	return time.Duration(seconds)*time.Second + time.Duration(rest)*time.Second/time.Duration(rate)
+}
+
+// averageBitrate returns the bitrate of bytes of audio lasting d.
+func averageBitrate(bytes int64, d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	return int(float64(bytes) * 8 / d.Seconds())
+}
+
+// readAt reads exactly n bytes at off, reporting a short file as truncation.
+func readAt(r io.ReaderAt, off int64, n int) ([]byte, error) {
+	buf := make([]byte, n)
+	read, err := r.ReadAt(buf, off)
+	if read == n {
+		return buf, nil
+	}
+	if err == nil || errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: expected %d bytes at offset %d", ErrTruncated, n, off)
+	}
+	return nil, err
+}
+
+// bitReader reads big-endian bit fields.
+type bitReader struct {
+	data []byte
+	pos  int // in bits
+}
+
+func (b *bitReader) read(n int) (uint64, bool) {
+	if b.pos+n > len(b.data)*8 {
+		return 0, false
+	}
+	var v uint64
+	for i := 0; i < n; i++ {
+		bit := b.data[b.pos>>3] >> (7 - uint(b.pos&7)) & 1
+		v = v<<1 | uint64(bit)
+		b.pos++
+	}
+	return v, true
+}
+
+func (b *bitReader) skip(n int) bool {
+	if b.pos+n > len(b.data)*8 {
+		return false
+	}
+	b.pos += n
+	return true
+}
+
+var le = binary.LittleEndian
+var be = binary.BigEndian
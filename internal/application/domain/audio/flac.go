@@ -0,0 +1,297 @@
+package audio
+
+import (
+	"fmt"
+	"io"
+)
+
+type flacStreamInfo struct {
+	minBlockSize  int
+	maxFrameSize  int
+	sampleRate    int
+	channels      int
+	bitsPerSample int
+	totalSamples  uint64
+}
+
+func probeFLAC(r io.ReaderAt, size int64) (*Info, error) {
+	var (
+		stream     flacStreamInfo
+		haveStream bool
+	)
+	off := int64(4)
+	for {
+		header, err := readAt(r, off, 4)
+		if err != nil {
+			return nil, err
+		}
+		last := header[0]&0x80 != 0
+		blockType := header[0] & 0x7F
+		length := int64(header[1])<<16 | int64(header[2])<<8 | int64(header[3])
+		if blockType == 0 {
+			if length < 34 {
+				return nil, fmt.Errorf("%w: STREAMINFO is %d bytes", ErrMalformed, length)
+			}
+			block, err := readAt(r, off+4, 34)
+			if err != nil {
+				return nil, err
+			}
+			br := &bitReader{data: block}
+			minBlock, _ := br.read(16)
+			br.skip(16 + 24)
+			maxFrame, _ := br.read(24)
+			rate, _ := br.read(20)
+			channels, _ := br.read(3)
+			bps, _ := br.read(5)
+			total, _ := br.read(36)
+			stream = flacStreamInfo{
+				minBlockSize:  int(minBlock),
+				maxFrameSize:  int(maxFrame),
+				sampleRate:    int(rate),
+				channels:      int(channels) + 1,
+				bitsPerSample: int(bps) + 1,
+				totalSamples:  total,
+			}
+			haveStream = true
+		}
+		off += 4 + length
+		if last {
+			break
+		}
+	}
+	if !haveStream {
+		return nil, fmt.Errorf("%w: no STREAMINFO block", ErrMalformed)
+	}
+	if off >= size {
+		return nil, fmt.Errorf("%w: no audio frames", ErrTruncated)
+	}
+	if stream.totalSamples == 0 || stream.sampleRate == 0 {
+		return nil, fmt.Errorf("%w: the stream does not declare its length", ErrMalformed)
+	}
+
+	lastSample, err := flacLastSample(r, off, size, stream)
+	if err != nil {
+		return nil, err
+	}
+	if lastSample < stream.totalSamples {
+		return nil, fmt.Errorf("%w: %d of %d samples are present", ErrTruncated, lastSample, stream.totalSamples)
+	}
+	silent, err := flacSilent(r, off, size, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := durationOf(stream.totalSamples, stream.sampleRate)
+	return &Info{
+		Format:     FormatFLAC,
+		Codec:      "flac",
+		Duration:   duration,
+		SampleRate: stream.sampleRate,
+		Channels:   stream.channels,
+		Bitrate:    averageBitrate(size-off, duration),
+		Silent:     silent,
+	}, nil
+}
+
+type flacFrameHeader struct {
+	length        int // of the header, CRC included
+	blockSize     int
+	firstSample   uint64
+	channels      int
+	assignment    int
+	bitsPerSample int
+}
+
+// parseFLACFrameHeader decodes a frame header at the start of b, checking
+// its CRC so that sync codes inside audio data are not mistaken for frames.
+func parseFLACFrameHeader(b []byte, stream flacStreamInfo) (flacFrameHeader, bool) {
+	if len(b) < 6 || b[0] != 0xFF || b[1]&0xFE != 0xF8 || b[3]&1 != 0 {
+		return flacFrameHeader{}, false
+	}
+	variable := b[1]&1 == 1
+	blockCode := int(b[2] >> 4)
+	rateCode := int(b[2] & 0xF)
+	h := flacFrameHeader{assignment: int(b[3] >> 4)}
+	if blockCode == 0 || rateCode == 15 || h.assignment > 10 {
+		return flacFrameHeader{}, false
+	}
+	switch h.assignment {
+	case 8, 9, 10:
+		h.channels = 2
+	default:
+		h.channels = h.assignment + 1
+	}
+	switch sizeCode := int(b[3] >> 1 & 7); sizeCode {
+	case 0:
+		h.bitsPerSample = stream.bitsPerSample
+	case 3:
+		return flacFrameHeader{}, false
+	default:
+		h.bitsPerSample = [...]int{0, 8, 12, 0, 16, 20, 24, 32}[sizeCode]
+	}
+
+	// The frame or sample number is coded like UTF-8.
+	pos := 4
+	lead := b[pos]
+	extra := 0
+	var number uint64
+	switch {
+	case lead&0x80 == 0:
+		number = uint64(lead)
+	case lead&0xE0 == 0xC0:
+		number, extra = uint64(lead&0x1F), 1
+	case lead&0xF0 == 0xE0:
+		number, extra = uint64(lead&0x0F), 2
+	case lead&0xF8 == 0xF0:
+		number, extra = uint64(lead&0x07), 3
+	case lead&0xFC == 0xF8:
+		number, extra = uint64(lead&0x03), 4
+	case lead&0xFE == 0xFC:
+		number, extra = uint64(lead&0x01), 5
+	case lead == 0xFE:
+		extra = 6
+	default:
+		return flacFrameHeader{}, false
+	}
+	pos++
+	if len(b) < pos+extra+2 {
+		return flacFrameHeader{}, false
+	}
+	for i := 0; i < extra; i++ {
+		if b[pos]&0xC0 != 0x80 {
+			return flacFrameHeader{}, false
+		}
+		number = number<<6 | uint64(b[pos]&0x3F)
+		pos++
+	}
+
+	switch {
+	case blockCode == 1:
+		h.blockSize = 192
+	case blockCode <= 5:
+		h.blockSize = 576 << (blockCode - 2)
+	case blockCode == 6:
+		h.blockSize = int(b[pos]) + 1
+		pos++
+	case blockCode == 7:
+		if len(b) < pos+2 {
+			return flacFrameHeader{}, false
+		}
+		h.blockSize = int(be.Uint16(b[pos:])) + 1
+		pos += 2
+	default:
+		h.blockSize = 256 << (blockCode - 8)
+	}
+	switch rateCode {
+	case 12:
+		pos++
+	case 13, 14:
+		pos += 2
+	}
+	if len(b) < pos+1 || crc8(b[:pos]) != b[pos] {
+		return flacFrameHeader{}, false
+	}
+	h.length = pos + 1
+
+	if variable {
+		h.firstSample = number
+	} else {
+		h.firstSample = number * uint64(stream.minBlockSize)
+	}
+	return h, true
+}
+
+// flacLastSample finds the last frame of the stream and returns the sample
+// number just past it.
+func flacLastSample(r io.ReaderAt, start, size int64, stream flacStreamInfo) (uint64, error) {
+	window := int64(stream.maxFrameSize)
+	if window == 0 {
+		window = 1 << 20
+	}
+	window += 16
+	from := max(start, size-window)
+	tail, err := readAt(r, from, int(size-from))
+	if err != nil {
+		return 0, err
+	}
+	for i := len(tail) - 2; i >= 0; i-- {
+		if h, ok := parseFLACFrameHeader(tail[i:], stream); ok {
+			return h.firstSample + uint64(h.blockSize), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: no complete frame found", ErrTruncated)
+}
+
+// flacSilent reports whether every subframe of the stream is CONSTANT,
+// which is how encoders write silence. It stops at the first frame that is
+// not.
+func flacSilent(r io.ReaderAt, start, size int64, stream flacStreamInfo) (bool, error) {
+	for off := start; off < size; {
+		buf := make([]byte, min(int64(64), size-off))
+		if _, err := r.ReadAt(buf, off); err != nil && err != io.EOF {
+			return false, err
+		}
+		h, ok := parseFLACFrameHeader(buf, stream)
+		if !ok {
+			return false, nil
+		}
+		br := &bitReader{data: buf[h.length:]}
+		for ch := 0; ch < h.channels; ch++ {
+			header, ok := br.read(8)
+			if !ok || header>>1 != 0 {
+				// A non-zero pad bit or a non-CONSTANT subframe.
+				return false, nil
+			}
+			bits := h.bitsPerSample
+			if header&1 == 1 {
+				// Wasted bits, unary coded.
+				wasted := 1
+				for {
+					bit, ok := br.read(1)
+					if !ok {
+						return false, nil
+					}
+					if bit == 1 {
+						break
+					}
+					wasted++
+				}
+				bits -= wasted
+			}
+			if isSideChannel(h.assignment, ch) {
+				bits++
+			}
+			if !br.skip(bits) {
+				return false, nil
+			}
+		}
+		// Subframes are padded to a byte, then a CRC-16 ends the frame.
+		off += int64(h.length + (br.pos+7)/8 + 2)
+	}
+	return true, nil
+}
+
+func isSideChannel(assignment, channel int) bool {
+	switch assignment {
+	case 8, 10:
+		return channel == 1
+	case 9:
+		return channel == 0
+	}
+	return false
+}
+
+func crc8(data []byte) byte {
+	var crc byte
+	for _, b := range data {
+		crc ^= b
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,190 @@
+package audio
+
+import (
+	"fmt"
+	"io"
+)
+
+var mp4Codecs = map[string]string{
+	"mp4a": "aac",
+	"alac": "alac",
+	"Opus": "opus",
+	"fLaC": "flac",
+	"ac-3": "ac3",
+	"ec-3": "eac3",
+}
+
+type mp4Box struct {
+	kind  string
+	start int64 // of the payload
+	end   int64
+}
+
+// mp4Boxes lists the boxes between start and end, reporting any that
+// overrun end as truncation.
+func mp4Boxes(r io.ReaderAt, start, end int64) ([]mp4Box, error) {
+	var boxes []mp4Box
+	for off := start; off < end; {
+		header, err := readAt(r, off, 8)
+		if err != nil {
+			return nil, err
+		}
+		size := int64(be.Uint32(header))
+		kind := string(header[4:8])
+		payload := off + 8
+		switch size {
+		case 0:
+			size = end - off
+		case 1:
+			large, err := readAt(r, off+8, 8)
+			if err != nil {
+				return nil, err
+			}
+			size = int64(be.Uint64(large))
+			payload += 8
+		}
+		if size < payload-off {
+			return nil, fmt.Errorf("%w: %q box is %d bytes", ErrMalformed, kind, size)
+		}
+		if off+size > end {
+			return nil, fmt.Errorf("%w: %q box needs %d bytes but only %d are present", ErrTruncated, kind, size, end-off)
+		}
+		boxes = append(boxes, mp4Box{kind: kind, start: payload, end: off + size})
+		off += size
+	}
+	return boxes, nil
+}
+
+func findBox(boxes []mp4Box, kind string) (mp4Box, bool) {
+	for _, b := range boxes {
+		if b.kind == kind {
+			return b, true
+		}
+	}
+	return mp4Box{}, false
+}
+
+func probeMP4(r io.ReaderAt, size int64) (*Info, error) {
+	top, err := mp4Boxes(r, 0, size)
+	if err != nil {
+		return nil, err
+	}
+	moov, ok := findBox(top, "moov")
+	if !ok {
+		return nil, fmt.Errorf("%w: no moov box", ErrTruncated)
+	}
+	mdat, _ := findBox(top, "mdat")
+
+	tracks, err := mp4Boxes(r, moov.start, moov.end)
+	if err != nil {
+		return nil, err
+	}
+	for _, trak := range tracks {
+		if trak.kind != "trak" {
+			continue
+		}
+		info, err := probeMP4Track(r, trak)
+		if err != nil {
+			return nil, err
+		}
+		if info != nil {
+			info.Bitrate = averageBitrate(mdat.end-mdat.start, info.Duration)
+			return info, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no audio track", ErrUnsupportedFormat)
+}
+
+// probeMP4Track returns the metadata of a sound track, or nil for any other
+// kind of track.
+func probeMP4Track(r io.ReaderAt, trak mp4Box) (*Info, error) {
+	children, err := mp4Boxes(r, trak.start, trak.end)
+	if err != nil {
+		return nil, err
+	}
+	mdia, ok := findBox(children, "mdia")
+	if !ok {
+		return nil, nil
+	}
+	media, err := mp4Boxes(r, mdia.start, mdia.end)
+	if err != nil {
+		return nil, err
+	}
+	hdlr, ok := findBox(media, "hdlr")
+	if !ok {
+		return nil, nil
+	}
+	handler, err := readAt(r, hdlr.start+8, 4)
+	if err != nil {
+		return nil, err
+	}
+	if string(handler) != "soun" {
+		return nil, nil
+	}
+
+	mdhd, ok := findBox(media, "mdhd")
+	if !ok {
+		return nil, fmt.Errorf("%w: sound track without mdhd box", ErrMalformed)
+	}
+	header, err := readAt(r, mdhd.start, int(min(mdhd.end-mdhd.start, 32)))
+	if err != nil {
+		return nil, err
+	}
+	var timescale uint32
+	var duration uint64
+	if header[0] == 1 && len(header) >= 32 {
+		timescale = be.Uint32(header[20:])
+		duration = be.Uint64(header[24:])
+	} else if len(header) >= 20 {
+		timescale = be.Uint32(header[12:])
+		duration = uint64(be.Uint32(header[16:]))
+	}
+	if timescale == 0 {
+		return nil, fmt.Errorf("%w: sound track without a timescale", ErrMalformed)
+	}
+
+	info := &Info{
+		Format:   FormatMP4,
+		Duration: durationOf(duration, int(timescale)),
+	}
+	minf, ok := findBox(media, "minf")
+	if !ok {
+		return nil, fmt.Errorf("%w: sound track without minf box", ErrMalformed)
+	}
+	stsd, err := findNested(r, minf, "stbl", "stsd")
+	if err != nil {
+		return nil, err
+	}
+	// Full box header and entry count, then the first sample entry.
+	entry, err := readAt(r, stsd.start+8, int(min(stsd.end-stsd.start-8, 36)))
+	if err != nil {
+		return nil, err
+	}
+	if len(entry) < 36 {
+		return nil, fmt.Errorf("%w: short sample description", ErrMalformed)
+	}
+	kind := string(entry[4:8])
+	codec, ok := mp4Codecs[kind]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q audio", ErrUnsupportedFormat, kind)
+	}
+	info.Codec = codec
+	info.Channels = int(be.Uint16(entry[24:]))
+	info.SampleRate = int(be.Uint32(entry[32:]) >> 16)
+	return info, nil
+}
+
+func findNested(r io.ReaderAt, parent mp4Box, path ...string) (mp4Box, error) {
+	box := parent
+	for _, kind := range path {
+		children, err := mp4Boxes(r, box.start, box.end)
+		if err != nil {
+			return mp4Box{}, err
+		}
+		var ok bool
+		if box, ok = findBox(children, kind); !ok {
+			return mp4Box{}, fmt.Errorf("%w: no %s box", ErrMalformed, kind)
+		}
+	}
+	return box, nil
+}
@@ -0,0 +1,419 @@
+package audio
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// MPEG audio versions as coded in the frame header.
+const (
+	mpeg25 = 0
+	mpeg2  = 2
+	mpeg1  = 3
+)
+
+var mpegBitrates = map[[2]int][15]int{
+	// {version is MPEG-1, layer}: kbit/s by bitrate index.
+	{1, 1}: {0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448},
+	{1, 2}: {0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384},
+	{1, 3}: {0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+	{0, 1}: {0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256},
+	{0, 2}: {0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+	{0, 3}: {0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+}
+
+var mpegSampleRates = map[int][3]int{
+	mpeg1:  {44100, 48000, 32000},
+	mpeg2:  {22050, 24000, 16000},
+	mpeg25: {11025, 12000, 8000},
+}
+
+type mpegFrame struct {
+	version    int
+	layer      int
+	crc        bool
+	bitrate    int // bit/s
+	sampleRate int
+	channels   int
+	samples    int
+	length     int
+}
+
+func isMPEGAudioSync(b []byte) bool {
+	_, ok := parseMPEGFrame(b)
+	return ok
+}
+
+// parseMPEGFrame decodes the four-byte header of an MPEG audio frame.
+// Free-format streams are not supported.
+func parseMPEGFrame(b []byte) (mpegFrame, bool) {
+	if len(b) < 4 || b[0] != 0xFF || b[1]&0xE0 != 0xE0 {
+		return mpegFrame{}, false
+	}
+	f := mpegFrame{
+		version: int(b[1] >> 3 & 3),
+		layer:   4 - int(b[1]>>1&3),
+		crc:     b[1]&1 == 0,
+	}
+	bitrateIndex := int(b[2] >> 4)
+	rateIndex := int(b[2] >> 2 & 3)
+	padding := int(b[2] >> 1 & 1)
+	if f.version == 1 || f.layer == 4 || bitrateIndex == 0 || bitrateIndex == 15 || rateIndex == 3 {
+		return mpegFrame{}, false
+	}
+	isMPEG1 := 0
+	if f.version == mpeg1 {
+		isMPEG1 = 1
+	}
+	f.bitrate = mpegBitrates[[2]int{isMPEG1, f.layer}][bitrateIndex] * 1000
+	f.sampleRate = mpegSampleRates[f.version][rateIndex]
+	f.channels = 2
+	if b[3]>>6 == 3 {
+		f.channels = 1
+	}
+
+	switch {
+	case f.layer == 1:
+		f.samples = 384
+		f.length = (12*f.bitrate/f.sampleRate + padding) * 4
+	case f.layer == 2 || f.version == mpeg1:
+		f.samples = 1152
+		f.length = 144*f.bitrate/f.sampleRate + padding
+	default:
+		f.samples = 576
+		f.length = 72*f.bitrate/f.sampleRate + padding
+	}
+	return f, true
+}
+
+// sideInfoSize returns the size of the Layer III side information.
+func (f mpegFrame) sideInfoSize() int {
+	switch {
+	case f.version == mpeg1 && f.channels == 1:
+		return 17
+	case f.version == mpeg1:
+		return 32
+	case f.channels == 1:
+		return 9
+	default:
+		return 17
+	}
+}
+
+// silent reports whether a Layer III frame carries no Huffman-coded
+// samples in any granule, which is how encoders write digital silence.
+func (f mpegFrame) silent(frame []byte) bool {
+	if f.layer != 3 {
+		return false
+	}
+	start := 4
+	if f.crc {
+		start += 2
+	}
+	if len(frame) < start+f.sideInfoSize() {
+		return false
+	}
+	br := &bitReader{data: frame[start : start+f.sideInfoSize()]}
+	granules := 1
+	granuleBits := 63 - 12
+	if f.version == mpeg1 {
+		granules = 2
+		granuleBits = 59 - 12
+		private := 3
+		if f.channels == 1 {
+			private = 5
+		}
+		br.skip(9 + private + 4*f.channels)
+	} else {
+		private := 2
+		if f.channels == 1 {
+			private = 1
+		}
+		br.skip(8 + private)
+	}
+	for i := 0; i < granules*f.channels; i++ {
+		length, ok := br.read(12)
+		if !ok || length != 0 {
+			return false
+		}
+		br.skip(granuleBits)
+	}
+	return true
+}
+
+// skipID3 returns the offset just past an ID3v2 tag at off, or off if there
+// is none.
+func skipID3(r io.ReaderAt, off int64) (int64, error) {
+	header := make([]byte, 10)
+	if n, _ := r.ReadAt(header, off); n < 10 || string(header[:3]) != "ID3" {
+		return off, nil
+	}
+	size, err := id3Size(header)
+	if err != nil {
+		return 0, err
+	}
+	return off + size, nil
+}
+
+// id3Size returns the size of the ID3v2 tag whose ten-byte header is given.
+func id3Size(header []byte) (int64, error) {
+	var tagSize int64
+	for _, b := range header[6:10] {
+		if b&0x80 != 0 {
+			return 0, fmt.Errorf("%w: invalid ID3 tag size", ErrMalformed)
+		}
+		tagSize = tagSize<<7 | int64(b)
+	}
+	size := 10 + tagSize
+	if header[5]&0x10 != 0 {
+		size += 10 // footer
+	}
+	return size, nil
+}
+
+// ReadMPEGHeader skips an ID3v2 tag at the start of a stream and describes
+// the audio from the header of its first frame, which is left unread. The
+// stream may be MPEG audio or AAC in ADTS frames. Duration and Bitrate are
+// not set.
+func ReadMPEGHeader(br *bufio.Reader) (*Info, error) {
+	if header, _ := br.Peek(10); len(header) == 10 && string(header[:3]) == "ID3" {
+		size, err := id3Size(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := br.Discard(int(size)); err != nil {
+			return nil, fmt.Errorf("%w: no audio after the ID3 tag", ErrTruncated)
+		}
+	}
+	header, _ := br.Peek(7)
+	if f, ok := parseADTSFrame(header); ok {
+		return &Info{Format: FormatADTS, Codec: "aac", SampleRate: f.sampleRate, Channels: f.channels}, nil
+	}
+	f, ok := parseMPEGFrame(header)
+	if !ok {
+		if len(header) < 4 {
+			return nil, fmt.Errorf("%w: no audio frames", ErrTruncated)
+		}
+		return nil, fmt.Errorf("%w: no MPEG audio frame after the ID3 tag", ErrUnsupportedFormat)
+	}
+	return &Info{
+		Format:     FormatMP3,
+		Codec:      fmt.Sprintf("mp%d", f.layer),
+		SampleRate: f.sampleRate,
+		Channels:   f.channels,
+	}, nil
+}
+
+// audioEnd returns the end of the audio, excluding a trailing ID3v1 tag.
+func audioEnd(r io.ReaderAt, size int64) int64 {
+	if size < 128 {
+		return size
+	}
+	tag := make([]byte, 3)
+	if n, _ := r.ReadAt(tag, size-128); n == 3 && string(tag) == "TAG" {
+		return size - 128
+	}
+	return size
+}
+
+func probeMPEG(r io.ReaderAt, size int64) (*Info, error) {
+	start, err := skipID3(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	end := audioEnd(r, size)
+	if start >= end {
+		return nil, fmt.Errorf("%w: no audio after the ID3 tag", ErrTruncated)
+	}
+	header, err := readAt(r, start, 4)
+	if err != nil {
+		return nil, err
+	}
+	if isADTSSync(header) {
+		return probeADTS(r, start, end)
+	}
+	if _, ok := parseMPEGFrame(header); !ok {
+		return nil, fmt.Errorf("%w: no MPEG audio frame after the ID3 tag", ErrUnsupportedFormat)
+	}
+
+	br := bufio.NewReaderSize(io.NewSectionReader(r, start, end-start), 64<<10)
+	var (
+		first      mpegFrame
+		frames     uint64
+		samples    uint64
+		audioBytes int64
+		declared   uint64 // frame count of a Xing or Info header
+		silent     = true
+	)
+	for off := start; off < end; {
+		head, err := br.Peek(4)
+		if len(head) < 4 {
+			if errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("%w: %d stray bytes after the last frame", ErrTruncated, len(head))
+			}
+			return nil, err
+		}
+		f, ok := parseMPEGFrame(head)
+		if !ok {
+			if frames == 0 {
+				return nil, fmt.Errorf("%w: invalid MPEG frame header", ErrMalformed)
+			}
+			// Trailing tags such as APE; the audio is over.
+			break
+		}
+		if off+int64(f.length) > end {
+			return nil, fmt.Errorf("%w: the last frame needs %d bytes but only %d are present", ErrTruncated, f.length, end-off)
+		}
+		frame := make([]byte, f.length)
+		if _, err := io.ReadFull(br, frame); err != nil {
+			return nil, err
+		}
+		off += int64(f.length)
+
+		if frames == 0 && declared == 0 {
+			if n, ok := xingFrames(f, frame); ok {
+				// The first frame is a header, not audio.
+				declared = n
+				first = f
+				if declared == 0 {
+					declared = ^uint64(0)
+				}
+				continue
+			}
+		}
+		if frames == 0 {
+			first = f
+		}
+		frames++
+		samples += uint64(f.samples)
+		audioBytes += int64(f.length)
+		if silent && !f.silent(frame) {
+			silent = false
+		}
+	}
+	if declared != 0 && declared != ^uint64(0) && frames < declared {
+		return nil, fmt.Errorf("%w: %d of %d frames are present", ErrTruncated, frames, declared)
+	}
+	if frames == 0 {
+		return nil, fmt.Errorf("%w: no audio frames", ErrTruncated)
+	}
+
+	duration := durationOf(samples, first.sampleRate)
+	return &Info{
+		Format:     FormatMP3,
+		Codec:      fmt.Sprintf("mp%d", first.layer),
+		Duration:   duration,
+		SampleRate: first.sampleRate,
+		Channels:   first.channels,
+		Bitrate:    averageBitrate(audioBytes, duration),
+		Silent:     silent && first.layer == 3,
+	}, nil
+}
+
+// xingFrames returns the frame count of a Xing or Info header in frame, the
+// first frame of a file.
+func xingFrames(f mpegFrame, frame []byte) (uint64, bool) {
+	off := 4 + f.sideInfoSize()
+	if f.crc {
+		off += 2
+	}
+	if len(frame) < off+8 {
+		return 0, false
+	}
+	tag := string(frame[off : off+4])
+	if tag != "Xing" && tag != "Info" {
+		return 0, false
+	}
+	flags := be.Uint32(frame[off+4:])
+	if flags&1 == 0 || len(frame) < off+12 {
+		return 0, true
+	}
+	return uint64(be.Uint32(frame[off+8:])), true
+}
+
+var adtsSampleRates = [...]int{96000, 88200, 64000, 48000, 44100, 32000, 24000, 22050, 16000, 12000, 11025, 8000, 7350}
+
+type adtsFrame struct {
+	sampleRate int
+	channels   int
+	samples    int
+	length     int
+}
+
+func isADTSSync(b []byte) bool {
+	_, ok := parseADTSFrame(b)
+	return ok
+}
+
+func parseADTSFrame(b []byte) (adtsFrame, bool) {
+	if len(b) < 7 || b[0] != 0xFF || b[1]&0xF6 != 0xF0 {
+		return adtsFrame{}, false
+	}
+	rateIndex := int(b[2] >> 2 & 0xF)
+	if rateIndex >= len(adtsSampleRates) {
+		return adtsFrame{}, false
+	}
+	f := adtsFrame{
+		sampleRate: adtsSampleRates[rateIndex],
+		channels:   int(b[2]&1)<<2 | int(b[3]>>6),
+		length:     int(b[3]&3)<<11 | int(b[4])<<3 | int(b[5]>>5),
+		samples:    1024 * (int(b[6]&3) + 1),
+	}
+	if f.length < 7 {
+		return adtsFrame{}, false
+	}
+	return f, true
+}
+
+func probeADTS(r io.ReaderAt, start, end int64) (*Info, error) {
+	br := bufio.NewReaderSize(io.NewSectionReader(r, start, end-start), 64<<10)
+	var (
+		first   adtsFrame
+		frames  int
+		samples uint64
+		bytes   int64
+	)
+	for off := start; off < end; {
+		head, err := br.Peek(7)
+		if len(head) < 7 {
+			if errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("%w: %d stray bytes after the last frame", ErrTruncated, len(head))
+			}
+			return nil, err
+		}
+		f, ok := parseADTSFrame(head)
+		if !ok {
+			if frames == 0 {
+				return nil, fmt.Errorf("%w: invalid ADTS frame header", ErrMalformed)
+			}
+			break
+		}
+		if off+int64(f.length) > end {
+			return nil, fmt.Errorf("%w: the last frame needs %d bytes but only %d are present", ErrTruncated, f.length, end-off)
+		}
+		if _, err := br.Discard(f.length); err != nil {
+			return nil, err
+		}
+		off += int64(f.length)
+		if frames == 0 {
+			first = f
+		}
+		frames++
+		samples += uint64(f.samples)
+		bytes += int64(f.length)
+	}
+	if frames == 0 {
+		return nil, fmt.Errorf("%w: no audio frames", ErrTruncated)
+	}
+	duration := durationOf(samples, first.sampleRate)
+	return &Info{
+		Format:     FormatADTS,
+		Codec:      "aac",
+		Duration:   duration,
+		SampleRate: first.sampleRate,
+		Channels:   first.channels,
+		Bitrate:    averageBitrate(bytes, duration),
+	}, nil
+}
@@ -0,0 +1,121 @@
+package audio
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	oggEndOfStream = 0x04
+	// opusRate is the rate at which Opus counts granule positions,
+	// whatever the rate of the input.
+	opusRate = 48000
+)
+
+func probeOgg(r io.ReaderAt, size int64) (*Info, error) {
+	br := bufio.NewReaderSize(io.NewSectionReader(r, 0, size), 64<<10)
+	var (
+		info      *Info
+		serial    uint32
+		preSkip   uint64
+		granule   uint64
+		ended     bool
+		firstPage = true
+	)
+	for off := int64(0); off < size; {
+		header := make([]byte, 27)
+		if _, err := io.ReadFull(br, header); err != nil {
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil, fmt.Errorf("%w: incomplete page header at offset %d", ErrTruncated, off)
+			}
+			return nil, err
+		}
+		if string(header[:4]) != "OggS" || header[4] != 0 {
+			return nil, fmt.Errorf("%w: invalid page at offset %d", ErrMalformed, off)
+		}
+		flags := header[5]
+		pageGranule := le.Uint64(header[6:])
+		pageSerial := le.Uint32(header[14:])
+		segments := make([]byte, header[26])
+		if _, err := io.ReadFull(br, segments); err != nil {
+			return nil, fmt.Errorf("%w: incomplete page header at offset %d", ErrTruncated, off)
+		}
+		bodySize := 0
+		for _, s := range segments {
+			bodySize += int(s)
+		}
+		pageEnd := off + 27 + int64(len(segments)) + int64(bodySize)
+		if pageEnd > size {
+			return nil, fmt.Errorf("%w: the page at offset %d needs %d bytes but only %d are present", ErrTruncated, off, pageEnd-off, size-off)
+		}
+		body := make([]byte, bodySize)
+		if _, err := io.ReadFull(br, body); err != nil {
+			return nil, err
+		}
+		off = pageEnd
+
+		if firstPage {
+			firstPage = false
+			serial = pageSerial
+			var err error
+			info, preSkip, err = parseOggIDHeader(body)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if pageSerial != serial {
+			// Other logical streams, such as a video track, are ignored.
+			continue
+		}
+		// A page on which no packet ends has no granule position.
+		if pageGranule != ^uint64(0) {
+			granule = pageGranule
+		}
+		if flags&oggEndOfStream != 0 {
+			ended = true
+			break
+		}
+	}
+	if !ended {
+		return nil, fmt.Errorf("%w: the stream has no final page", ErrTruncated)
+	}
+
+	rate := info.SampleRate
+	if info.Codec == "opus" {
+		rate = opusRate
+	}
+	if granule > preSkip {
+		info.Duration = durationOf(granule-preSkip, rate)
+	}
+	info.Bitrate = averageBitrate(size, info.Duration)
+	return info, nil
+}
+
+// parseOggIDHeader reads the identification header that opens an Ogg
+// stream, returning the Opus pre-skip in samples at opusRate.
+func parseOggIDHeader(packet []byte) (*Info, uint64, error) {
+	switch {
+	case len(packet) >= 19 && string(packet[:8]) == "OpusHead":
+		rate := int(le.Uint32(packet[12:]))
+		if rate == 0 {
+			rate = opusRate
+		}
+		return &Info{
+			Format:     FormatOgg,
+			Codec:      "opus",
+			Channels:   int(packet[9]),
+			SampleRate: rate,
+		}, uint64(le.Uint16(packet[10:])), nil
+	case len(packet) >= 30 && string(packet[:7]) == "\x01vorbis":
+		return &Info{
+			Format:     FormatOgg,
+			Codec:      "vorbis",
+			Channels:   int(packet[11]),
+			SampleRate: int(le.Uint32(packet[12:])),
+		}, 0, nil
+	}
+	return nil, 0, fmt.Errorf("%w: Ogg streams must carry Opus or Vorbis", ErrUnsupportedFormat)
+}
@@ -0,0 +1,70 @@
+package pcm
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/hajimehoshi/go-mp3"
+
+	"github.com/mnadev/limestone/internal/application/domain/audio"
+)
+
+// DecodeMP3 reads MPEG-1 or MPEG-2 Layer III audio. MPEG-2.5, the other
+// layers and AAC behind an ID3 tag report ErrNoDecoder.
+func DecodeMP3(r io.Reader) (Reader, error) {
+	br := bufio.NewReaderSize(r, 64<<10)
+	info, err := audio.ReadMPEGHeader(br)
+	if err != nil {
+		return nil, err
+	}
+	if info.Codec != "mp3" || info.SampleRate < 16000 {
+		return nil, fmt.Errorf("%w: cannot decode %s at %d Hz", ErrNoDecoder, info.Codec, info.SampleRate)
+	}
+	d, err := mp3.NewDecoder(br)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", audio.ErrMalformed, err)
+	}
+	return &mp3Reader{d: d, f: Format{SampleRate: info.SampleRate, Channels: info.Channels}}, nil
+}
+
+type mp3Reader struct {
+	d   *mp3.Decoder
+	f   Format
+	buf []byte
+}
+
+func (d *mp3Reader) Format() Format {
+	return d.f
+}
+
+// mp3FrameSize is the size of a frame of the decoder's output, which is
+// always 16-bit stereo.
+const mp3FrameSize = 4
+
+func (d *mp3Reader) Read(buf []float64) (int, error) {
+	frames := len(buf) / d.f.Channels
+	if cap(d.buf) < frames*mp3FrameSize {
+		d.buf = make([]byte, frames*mp3FrameSize)
+	}
+	n, err := io.ReadFull(d.d, d.buf[:frames*mp3FrameSize])
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = nil
+		if n < mp3FrameSize {
+			err = io.EOF
+		}
+	}
+	frames = n / mp3FrameSize
+	for i := 0; i < frames; i++ {
+		frame := d.buf[i*mp3FrameSize:]
+		for c := 0; c < d.f.Channels; c++ {
+			// Mono audio is decoded to two identical channels.
+			buf[i*d.f.Channels+c] = float64(int16(le.Uint16(frame[c*2:]))) / 32768
+		}
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		err = fmt.Errorf("%w: %v", audio.ErrMalformed, err)
+	}
+	return frames * d.f.Channels, err
+}
@@ -0,0 +1,606 @@
+package pcm
+
+// Tables of the MPEG audio Layer III encoder, from ISO/IEC 11172-3 and
+// ISO/IEC 13818-3.
+
+// mp3Window is the analysis window C of the polyphase filterbank (ISO/IEC
+// 11172-3 Table C.1).
+var mp3Window = [512]float64{
+	0.000000000, -0.000000477, -0.000000477, -0.000000477, -0.000000477, -0.000000477, -0.000000477, -0.000000954,
+	-0.000000954, -0.000000954, -0.000000954, -0.000001430, -0.000001430, -0.000001907, -0.000001907, -0.000002384,
+	-0.000002384, -0.000002861, -0.000003338, -0.000003338, -0.000003815, -0.000004292, -0.000004768, -0.000005245,
+	-0.000006199, -0.000006676, -0.000007629, -0.000008106, -0.000009060, -0.000010014, -0.000011444, -0.000012398,
+	-0.000013828, -0.000014782, -0.000016689, -0.000018120, -0.000019550, -0.000021458, -0.000023365, -0.000025272,
+	-0.000027657, -0.000030041, -0.000032425, -0.000034809, -0.000037670, -0.000040531, -0.000043392, -0.000046253,
+	-0.000049591, -0.000052929, -0.000055790, -0.000059605, -0.000062942, -0.000066280, -0.000070095, -0.000073433,
+	-0.000076771, -0.000080585, -0.000083923, -0.000087261, -0.000090599, -0.000093460, -0.000096321, -0.000099182,
+	0.000101566, 0.000103951, 0.000105858, 0.000107288, 0.000108242, 0.000108719, 0.000108719, 0.000108242,
+	0.000106812, 0.000105381, 0.000102520, 0.000099182, 0.000095367, 0.000090122, 0.000084400, 0.000077724,
+	0.000069618, 0.000060558, 0.000050545, 0.000039577, 0.000027180, 0.000013828, -0.000000954, -0.000017166,
+	-0.000034332, -0.000052929, -0.000072956, -0.000093937, -0.000116348, -0.000140190, -0.000165462, -0.000191212,
+	-0.000218868, -0.000247479, -0.000277042, -0.000307560, -0.000339031, -0.000371456, -0.000404358, -0.000438213,
+	-0.000472546, -0.000507355, -0.000542164, -0.000576973, -0.000611782, -0.000646591, -0.000680923, -0.000714302,
+	-0.000747204, -0.000779152, -0.000809669, -0.000838757, -0.000866413, -0.000891685, -0.000915051, -0.000935555,
+	-0.000954151, -0.000968933, -0.000980854, -0.000989437, -0.000994205, -0.000995159, -0.000991821, -0.000983715,
+	0.000971317, 0.000953674, 0.000930786, 0.000902653, 0.000868797, 0.000829220, 0.000783920, 0.000731945,
+	0.000674248, 0.000610352, 0.000539303, 0.000462532, 0.000378609, 0.000288486, 0.000191689, 0.000088215,
+	-0.000021458, -0.000137329, -0.000259876, -0.000388145, -0.000522137, -0.000661850, -0.000806808, -0.000956535,
+	-0.001111031, -0.001269817, -0.001432419, -0.001597881, -0.001766682, -0.001937389, -0.002110004, -0.002283096,
+	-0.002457142, -0.002630711, -0.002803326, -0.002974033, -0.003141880, -0.003306866, -0.003467083, -0.003622532,
+	-0.003771782, -0.003914356, -0.004048824, -0.004174709, -0.004290581, -0.004395962, -0.004489899, -0.004570484,
+	-0.004638195, -0.004691124, -0.004728317, -0.004748821, -0.004752159, -0.004737377, -0.004703045, -0.004649162,
+	-0.004573822, -0.004477024, -0.004357815, -0.004215240, -0.004049301, -0.003858566, -0.003643036, -0.003401756,
+	0.003134727, 0.002841473, 0.002521515, 0.002174854, 0.001800537, 0.001399517, 0.000971317, 0.000515938,
+	0.000033379, -0.000475883, -0.001011848, -0.001573563, -0.002161503, -0.002774239, -0.003411293, -0.004072189,
+	-0.004756451, -0.005462170, -0.006189346, -0.006937027, -0.007703304, -0.008487225, -0.009287834, -0.010103703,
+	-0.010933399, -0.011775017, -0.012627602, -0.013489246, -0.014358521, -0.015233517, -0.016112804, -0.016994476,
+	-0.017876148, -0.018756866, -0.019634247, -0.020506859, -0.021372318, -0.022228718, -0.023074150, -0.023907185,
+	-0.024725437, -0.025527000, -0.026310921, -0.027073860, -0.027815342, -0.028532982, -0.029224873, -0.029890060,
+	-0.030526638, -0.031132698, -0.031706810, -0.032248020, -0.032754898, -0.033225536, -0.033659935, -0.034055710,
+	-0.034412861, -0.034730434, -0.035007000, -0.035242081, -0.035435200, -0.035586357, -0.035694122, -0.035758972,
+	0.035780907, 0.035758972, 0.035694122, 0.035586357, 0.035435200, 0.035242081, 0.035007000, 0.034730434,
+	0.034412861, 0.034055710, 0.033659935, 0.033225536, 0.032754898, 0.032248020, 0.031706810, 0.031132698,
+	0.030526638, 0.029890060, 0.029224873, 0.028532982, 0.027815342, 0.027073860, 0.026310921, 0.025527000,
+	0.024725437, 0.023907185, 0.023074150, 0.022228718, 0.021372318, 0.020506859, 0.019634247, 0.018756866,
+	0.017876148, 0.016994476, 0.016112804, 0.015233517, 0.014358521, 0.013489246, 0.012627602, 0.011775017,
+	0.010933399, 0.010103703, 0.009287834, 0.008487225, 0.007703304, 0.006937027, 0.006189346, 0.005462170,
+	0.004756451, 0.004072189, 0.003411293, 0.002774239, 0.002161503, 0.001573563, 0.001011848, 0.000475883,
+	-0.000033379, -0.000515938, -0.000971317, -0.001399517, -0.001800537, -0.002174854, -0.002521515, -0.002841473,
+	0.003134727, 0.003401756, 0.003643036, 0.003858566, 0.004049301, 0.004215240, 0.004357815, 0.004477024,
+	0.004573822, 0.004649162, 0.004703045, 0.004737377, 0.004752159, 0.004748821, 0.004728317, 0.004691124,
+	0.004638195, 0.004570484, 0.004489899, 0.004395962, 0.004290581, 0.004174709, 0.004048824, 0.003914356,
+	0.003771782, 0.003622532, 0.003467083, 0.003306866, 0.003141880, 0.002974033, 0.002803326, 0.002630711,
+	0.002457142, 0.002283096, 0.002110004, 0.001937389, 0.001766682, 0.001597881, 0.001432419, 0.001269817,
+	0.001111031, 0.000956535, 0.000806808, 0.000661850, 0.000522137, 0.000388145, 0.000259876, 0.000137329,
+	0.000021458, -0.000088215, -0.000191689, -0.000288486, -0.000378609, -0.000462532, -0.000539303, -0.000610352,
+	-0.000674248, -0.000731945, -0.000783920, -0.000829220, -0.000868797, -0.000902653, -0.000930786, -0.000953674,
+	0.000971317, 0.000983715, 0.000991821, 0.000995159, 0.000994205, 0.000989437, 0.000980854, 0.000968933,
+	0.000954151, 0.000935555, 0.000915051, 0.000891685, 0.000866413, 0.000838757, 0.000809669, 0.000779152,
+	0.000747204, 0.000714302, 0.000680923, 0.000646591, 0.000611782, 0.000576973, 0.000542164, 0.000507355,
+	0.000472546, 0.000438213, 0.000404358, 0.000371456, 0.000339031, 0.000307560, 0.000277042, 0.000247479,
+	0.000218868, 0.000191212, 0.000165462, 0.000140190, 0.000116348, 0.000093937, 0.000072956, 0.000052929,
+	0.000034332, 0.000017166, 0.000000954, -0.000013828, -0.000027180, -0.000039577, -0.000050545, -0.000060558,
+	-0.000069618, -0.000077724, -0.000084400, -0.000090122, -0.000095367, -0.000099182, -0.000102520, -0.000105381,
+	-0.000106812, -0.000108242, -0.000108719, -0.000108719, -0.000108242, -0.000107288, -0.000105858, -0.000103951,
+	0.000101566, 0.000099182, 0.000096321, 0.000093460, 0.000090599, 0.000087261, 0.000083923, 0.000080585,
+	0.000076771, 0.000073433, 0.000070095, 0.000066280, 0.000062942, 0.000059605, 0.000055790, 0.000052929,
+	0.000049591, 0.000046253, 0.000043392, 0.000040531, 0.000037670, 0.000034809, 0.000032425, 0.000030041,
+	0.000027657, 0.000025272, 0.000023365, 0.000021458, 0.000019550, 0.000018120, 0.000016689, 0.000014782,
+	0.000013828, 0.000012398, 0.000011444, 0.000010014, 0.000009060, 0.000008106, 0.000007629, 0.000006676,
+	0.000006199, 0.000005245, 0.000004768, 0.000004292, 0.000003815, 0.000003338, 0.000003338, 0.000002861,
+	0.000002384, 0.000002384, 0.000001907, 0.000001907, 0.000001430, 0.000001430, 0.000000954, 0.000000954,
+	0.000000954, 0.000000954, 0.000000477, 0.000000477, 0.000000477, 0.000000477, 0.000000477, 0.000000477,
+}
+
+// mp3Bands are the boundaries of the scale factor bands of long blocks, by
+// sample rate, which also bound the Huffman coding regions.
+var mp3Bands = map[int][23]int{
+	44100: {0, 4, 8, 12, 16, 20, 24, 30, 36, 44, 52, 62, 74, 90, 110, 134, 162, 196, 238, 288, 342, 418, 576},
+	48000: {0, 4, 8, 12, 16, 20, 24, 30, 36, 42, 50, 60, 72, 88, 106, 128, 156, 190, 230, 276, 330, 384, 576},
+	32000: {0, 4, 8, 12, 16, 20, 24, 30, 36, 44, 54, 66, 82, 102, 126, 156, 194, 240, 296, 364, 448, 550, 576},
+	22050: {0, 6, 12, 18, 24, 30, 36, 44, 54, 66, 80, 96, 116, 140, 168, 200, 238, 284, 336, 396, 464, 522, 576},
+	24000: {0, 6, 12, 18, 24, 30, 36, 44, 54, 66, 80, 96, 114, 136, 162, 194, 232, 278, 332, 394, 464, 540, 576},
+	16000: {0, 6, 12, 18, 24, 30, 36, 44, 54, 66, 80, 96, 116, 140, 168, 200, 238, 284, 336, 396, 464, 522, 576},
+}
+
+// mp3RegionCounts gives region0_count and region1_count for big values
+// ending in each scale factor band, as in the ISO reference encoder.
+var mp3RegionCounts = [23][2]int{
+	{0, 0}, {0, 0}, {0, 0}, {0, 0}, {0, 0}, {0, 1}, {1, 1}, {1, 1},
+	{1, 2}, {2, 2}, {2, 3}, {2, 3}, {3, 4}, {3, 4}, {3, 4}, {4, 5},
+	{4, 5}, {4, 6}, {5, 6}, {5, 6}, {5, 7}, {6, 7}, {6, 7},
+}
+
+type mp3HuffmanTable struct {
+	// xlen is the number of values each of a pair may take before escaping
+	// with linbits.
+	xlen int
+	// codes and lens are indexed by x*xlen+y.
+	codes []uint32
+	lens  []uint8
+}
+
+// mp3HuffmanTables are the codes of pairs of big values (ISO/IEC 11172-3
+// Table B.7). Tables 16 to 23 share the codes of 16 and tables 24 to 31
+// those of 24, and differ only in their linbits.
+var mp3HuffmanTables = map[int]*mp3HuffmanTable{
+	1: {
+		xlen: 2,
+		codes: []uint32{
+			0x1, 0x1,
+			0x1, 0x0,
+		},
+		lens: []uint8{
+			1, 3,
+			2, 3,
+		},
+	},
+	2: {
+		xlen: 3,
+		codes: []uint32{
+			0x1, 0x2, 0x1,
+			0x3, 0x1, 0x1,
+			0x3, 0x2, 0x0,
+		},
+		lens: []uint8{
+			1, 3, 6,
+			3, 3, 5,
+			5, 5, 6,
+		},
+	},
+	3: {
+		xlen: 3,
+		codes: []uint32{
+			0x3, 0x2, 0x1,
+			0x1, 0x1, 0x1,
+			0x3, 0x2, 0x0,
+		},
+		lens: []uint8{
+			2, 2, 6,
+			3, 2, 5,
+			5, 5, 6,
+		},
+	},
+	5: {
+		xlen: 4,
+		codes: []uint32{
+			0x1, 0x2, 0x6, 0x5,
+			0x3, 0x1, 0x4, 0x4,
+			0x7, 0x5, 0x7, 0x1,
+			0x6, 0x1, 0x1, 0x0,
+		},
+		lens: []uint8{
+			1, 3, 6, 7,
+			3, 3, 6, 7,
+			6, 6, 7, 8,
+			7, 6, 7, 8,
+		},
+	},
+	6: {
+		xlen: 4,
+		codes: []uint32{
+			0x7, 0x3, 0x5, 0x1,
+			0x6, 0x2, 0x3, 0x2,
+			0x5, 0x4, 0x4, 0x1,
+			0x3, 0x3, 0x2, 0x0,
+		},
+		lens: []uint8{
+			3, 3, 5, 7,
+			3, 2, 4, 5,
+			4, 4, 5, 6,
+			6, 5, 6, 7,
+		},
+	},
+	7: {
+		xlen: 6,
+		codes: []uint32{
+			0x1, 0x2, 0xa, 0x13, 0x10, 0xa,
+			0x3, 0x3, 0x7, 0xa, 0x5, 0x3,
+			0xb, 0x4, 0xd, 0x11, 0x8, 0x4,
+			0xc, 0xb, 0x12, 0xf, 0xb, 0x2,
+			0x7, 0x6, 0x9, 0xe, 0x3, 0x1,
+			0x6, 0x4, 0x5, 0x3, 0x2, 0x0,
+		},
+		lens: []uint8{
+			1, 3, 6, 8, 8, 9,
+			3, 4, 6, 7, 7, 8,
+			6, 5, 7, 8, 8, 9,
+			7, 7, 8, 9, 9, 9,
+			7, 7, 8, 9, 9, 10,
+			8, 8, 9, 10, 10, 10,
+		},
+	},
+	8: {
+		xlen: 6,
+		codes: []uint32{
+			0x3, 0x4, 0x6, 0x12, 0xc, 0x5,
+			0x5, 0x1, 0x2, 0x10, 0x9, 0x3,
+			0x7, 0x3, 0x5, 0xe, 0x7, 0x3,
+			0x13, 0x11, 0xf, 0xd, 0xa, 0x4,
+			0xd, 0x5, 0x8, 0xb, 0x5, 0x1,
+			0xc, 0x4, 0x4, 0x1, 0x1, 0x0,
+		},
+		lens: []uint8{
+			2, 3, 6, 8, 8, 9,
+			3, 2, 4, 8, 8, 8,
+			6, 4, 6, 8, 8, 9,
+			8, 8, 8, 9, 9, 10,
+			8, 7, 8, 9, 10, 10,
+			9, 8, 9, 9, 11, 11,
+		},
+	},
+	9: {
+		xlen: 6,
+		codes: []uint32{
+			0x7, 0x5, 0x9, 0xe, 0xf, 0x7,
+			0x6, 0x4, 0x5, 0x5, 0x6, 0x7,
+			0x7, 0x6, 0x8, 0x8, 0x8, 0x5,
+			0xf, 0x6, 0x9, 0xa, 0x5, 0x1,
+			0xb, 0x7, 0x9, 0x6, 0x4, 0x1,
+			0xe, 0x4, 0x6, 0x2, 0x6, 0x0,
+		},
+		lens: []uint8{
+			3, 3, 5, 6, 8, 9,
+			3, 3, 4, 5, 6, 8,
+			4, 4, 5, 6, 7, 8,
+			6, 5, 6, 7, 7, 8,
+			7, 6, 7, 7, 8, 9,
+			8, 7, 8, 8, 9, 9,
+		},
+	},
+	10: {
+		xlen: 8,
+		codes: []uint32{
+			0x1, 0x2, 0xa, 0x17, 0x23, 0x1e, 0xc, 0x11,
+			0x3, 0x3, 0x8, 0xc, 0x12, 0x15, 0xc, 0x7,
+			0xb, 0x9, 0xf, 0x15, 0x20, 0x28, 0x13, 0x6,
+			0xe, 0xd, 0x16, 0x22, 0x2e, 0x17, 0x12, 0x7,
+			0x14, 0x13, 0x21, 0x2f, 0x1b, 0x16, 0x9, 0x3,
+			0x1f, 0x16, 0x29, 0x1a, 0x15, 0x14, 0x5, 0x3,
+			0xe, 0xd, 0xa, 0xb, 0x10, 0x6, 0x5, 0x1,
+			0x9, 0x8, 0x7, 0x8, 0x4, 0x4, 0x2, 0x0,
+		},
+		lens: []uint8{
+			1, 3, 6, 8, 9, 9, 9, 10,
+			3, 4, 6, 7, 8, 9, 8, 8,
+			6, 6, 7, 8, 9, 10, 9, 9,
+			7, 7, 8, 9, 10, 10, 9, 10,
+			8, 8, 9, 10, 10, 10, 10, 10,
+			9, 9, 10, 10, 11, 11, 10, 11,
+			8, 8, 9, 10, 10, 10, 11, 11,
+			9, 8, 9, 10, 10, 11, 11, 11,
+		},
+	},
+	11: {
+		xlen: 8,
+		codes: []uint32{
+			0x3, 0x4, 0xa, 0x18, 0x22, 0x21, 0x15, 0xf,
+			0x5, 0x3, 0x4, 0xa, 0x20, 0x11, 0xb, 0xa,
+			0xb, 0x7, 0xd, 0x12, 0x1e, 0x1f, 0x14, 0x5,
+			0x19, 0xb, 0x13, 0x3b, 0x1b, 0x12, 0xc, 0x5,
+			0x23, 0x21, 0x1f, 0x3a, 0x1e, 0x10, 0x7, 0x5,
+			0x1c, 0x1a, 0x20, 0x13, 0x11, 0xf, 0x8, 0xe,
+			0xe, 0xc, 0x9, 0xd, 0xe, 0x9, 0x4, 0x1,
+			0xb, 0x4, 0x6, 0x6, 0x6, 0x3, 0x2, 0x0,
+		},
+		lens: []uint8{
+			2, 3, 5, 7, 8, 9, 8, 9,
+			3, 3, 4, 6, 8, 8, 7, 8,
+			5, 5, 6, 7, 8, 9, 8, 8,
+			7, 6, 7, 9, 8, 10, 8, 9,
+			8, 8, 8, 9, 9, 10, 9, 10,
+			8, 8, 9, 10, 10, 11, 10, 11,
+			8, 7, 7, 8, 9, 10, 10, 10,
+			8, 7, 8, 9, 10, 10, 10, 10,
+		},
+	},
+	12: {
+		xlen: 8,
+		codes: []uint32{
+			0x9, 0x6, 0x10, 0x21, 0x29, 0x27, 0x26, 0x1a,
+			0x7, 0x5, 0x6, 0x9, 0x17, 0x10, 0x1a, 0xb,
+			0x11, 0x7, 0xb, 0xe, 0x15, 0x1e, 0xa, 0x7,
+			0x11, 0xa, 0xf, 0xc, 0x12, 0x1c, 0xe, 0x5,
+			0x20, 0xd, 0x16, 0x13, 0x12, 0x10, 0x9, 0x5,
+			0x28, 0x11, 0x1f, 0x1d, 0x11, 0xd, 0x4, 0x2,
+			0x1b, 0xc, 0xb, 0xf, 0xa, 0x7, 0x4, 0x1,
+			0x1b, 0xc, 0x8, 0xc, 0x6, 0x3, 0x1, 0x0,
+		},
+		lens: []uint8{
+			4, 3, 5, 7, 8, 9, 9, 9,
+			3, 3, 4, 5, 7, 7, 8, 8,
+			5, 4, 5, 6, 7, 8, 7, 8,
+			6, 5, 6, 6, 7, 8, 8, 8,
+			7, 6, 7, 7, 8, 8, 8, 9,
+			8, 7, 8, 8, 8, 9, 8, 9,
+			8, 7, 7, 8, 8, 9, 9, 10,
+			9, 8, 8, 9, 9, 9, 9, 10,
+		},
+	},
+	13: {
+		xlen: 16,
+		codes: []uint32{
+			0x1, 0x5, 0xe, 0x15, 0x22, 0x33, 0x2e, 0x47,
+			0x2a, 0x34, 0x44, 0x34, 0x43, 0x2c, 0x2b, 0x13,
+			0x3, 0x4, 0xc, 0x13, 0x1f, 0x1a, 0x2c, 0x21,
+			0x1f, 0x18, 0x20, 0x18, 0x1f, 0x23, 0x16, 0xe,
+			0xf, 0xd, 0x17, 0x24, 0x3b, 0x31, 0x4d, 0x41,
+			0x1d, 0x28, 0x1e, 0x28, 0x1b, 0x21, 0x2a, 0x10,
+			0x16, 0x14, 0x25, 0x3d, 0x38, 0x4f, 0x49, 0x40,
+			0x2b, 0x4c, 0x38, 0x25, 0x1a, 0x1f, 0x19, 0xe,
+			0x23, 0x10, 0x3c, 0x39, 0x61, 0x4b, 0x72, 0x5b,
+			0x36, 0x49, 0x37, 0x29, 0x30, 0x35, 0x17, 0x18,
+			0x3a, 0x1b, 0x32, 0x60, 0x4c, 0x46, 0x5d, 0x54,
+			0x4d, 0x3a, 0x4f, 0x1d, 0x4a, 0x31, 0x29, 0x11,
+			0x2f, 0x2d, 0x4e, 0x4a, 0x73, 0x5e, 0x5a, 0x4f,
+			0x45, 0x53, 0x47, 0x32, 0x3b, 0x26, 0x24, 0xf,
+			0x48, 0x22, 0x38, 0x5f, 0x5c, 0x55, 0x5b, 0x5a,
+			0x56, 0x49, 0x4d, 0x41, 0x33, 0x2c, 0x2b, 0x2a,
+			0x2b, 0x14, 0x1e, 0x2c, 0x37, 0x4e, 0x48, 0x57,
+			0x4e, 0x3d, 0x2e, 0x36, 0x25, 0x1e, 0x14, 0x10,
+			0x35, 0x19, 0x29, 0x25, 0x2c, 0x3b, 0x36, 0x51,
+			0x42, 0x4c, 0x39, 0x36, 0x25, 0x12, 0x27, 0xb,
+			0x23, 0x21, 0x1f, 0x39, 0x2a, 0x52, 0x48, 0x50,
+			0x2f, 0x3a, 0x37, 0x15, 0x16, 0x1a, 0x26, 0x16,
+			0x35, 0x19, 0x17, 0x26, 0x46, 0x3c, 0x33, 0x24,
+			0x37, 0x1a, 0x22, 0x17, 0x1b, 0xe, 0x9, 0x7,
+			0x22, 0x20, 0x1c, 0x27, 0x31, 0x4b, 0x1e, 0x34,
+			0x30, 0x28, 0x34, 0x1c, 0x12, 0x11, 0x9, 0x5,
+			0x2d, 0x15, 0x22, 0x40, 0x38, 0x32, 0x31, 0x2d,
+			0x1f, 0x13, 0xc, 0xf, 0xa, 0x7, 0x6, 0x3,
+			0x30, 0x17, 0x14, 0x27, 0x24, 0x23, 0x35, 0x15,
+			0x10, 0x17, 0xd, 0xa, 0x6, 0x1, 0x4, 0x2,
+			0x10, 0xf, 0x11, 0x1b, 0x19, 0x14, 0x1d, 0xb,
+			0x11, 0xc, 0x10, 0x8, 0x1, 0x1, 0x0, 0x1,
+		},
+		lens: []uint8{
+			1, 4, 6, 7, 8, 9, 9, 10,
+			9, 10, 11, 11, 12, 12, 13, 13,
+			3, 4, 6, 7, 8, 8, 9, 9,
+			9, 9, 10, 10, 11, 12, 12, 12,
+			6, 6, 7, 8, 9, 9, 10, 10,
+			9, 10, 10, 11, 11, 12, 13, 13,
+			7, 7, 8, 9, 9, 10, 10, 10,
+			10, 11, 11, 11, 11, 12, 13, 13,
+			8, 7, 9, 9, 10, 10, 11, 11,
+			10, 11, 11, 12, 12, 13, 13, 14,
+			9, 8, 9, 10, 10, 10, 11, 11,
+			11, 11, 12, 11, 13, 13, 14, 14,
+			9, 9, 10, 10, 11, 11, 11, 11,
+			11, 12, 12, 12, 13, 13, 14, 14,
+			10, 9, 10, 11, 11, 11, 12, 12,
+			12, 12, 13, 13, 13, 14, 16, 16,
+			9, 8, 9, 10, 10, 11, 11, 12,
+			12, 12, 12, 13, 13, 14, 15, 15,
+			10, 9, 10, 10, 11, 11, 11, 13,
+			12, 13, 13, 14, 14, 14, 16, 15,
+			10, 10, 10, 11, 11, 12, 12, 13,
+			12, 13, 14, 13, 14, 15, 16, 17,
+			11, 10, 10, 11, 12, 12, 12, 12,
+			13, 13, 13, 14, 15, 15, 15, 16,
+			11, 11, 11, 12, 12, 13, 12, 13,
+			14, 14, 15, 15, 15, 16, 16, 16,
+			12, 11, 12, 13, 13, 13, 14, 14,
+			14, 14, 14, 15, 16, 15, 16, 16,
+			13, 12, 12, 13, 13, 13, 15, 14,
+			14, 17, 15, 15, 15, 17, 16, 16,
+			12, 12, 13, 14, 14, 14, 15, 14,
+			15, 15, 16, 16, 19, 18, 19, 16,
+		},
+	},
+	15: {
+		xlen: 16,
+		codes: []uint32{
+			0x7, 0xc, 0x12, 0x35, 0x2f, 0x4c, 0x7c, 0x6c,
+			0x59, 0x7b, 0x6c, 0x77, 0x6b, 0x51, 0x7a, 0x3f,
+			0xd, 0x5, 0x10, 0x1b, 0x2e, 0x24, 0x3d, 0x33,
+			0x2a, 0x46, 0x34, 0x53, 0x41, 0x29, 0x3b, 0x24,
+			0x13, 0x11, 0xf, 0x18, 0x29, 0x22, 0x3b, 0x30,
+			0x28, 0x40, 0x32, 0x4e, 0x3e, 0x50, 0x38, 0x21,
+			0x1d, 0x1c, 0x19, 0x2b, 0x27, 0x3f, 0x37, 0x5d,
+			0x4c, 0x3b, 0x5d, 0x48, 0x36, 0x4b, 0x32, 0x1d,
+			0x34, 0x16, 0x2a, 0x28, 0x43, 0x39, 0x5f, 0x4f,
+			0x48, 0x39, 0x59, 0x45, 0x31, 0x42, 0x2e, 0x1b,
+			0x4d, 0x25, 0x23, 0x42, 0x3a, 0x34, 0x5b, 0x4a,
+			0x3e, 0x30, 0x4f, 0x3f, 0x5a, 0x3e, 0x28, 0x26,
+			0x7d, 0x20, 0x3c, 0x38, 0x32, 0x5c, 0x4e, 0x41,
+			0x37, 0x57, 0x47, 0x33, 0x49, 0x33, 0x46, 0x1e,
+			0x6d, 0x35, 0x31, 0x5e, 0x58, 0x4b, 0x42, 0x7a,
+			0x5b, 0x49, 0x38, 0x2a, 0x40, 0x2c, 0x15, 0x19,
+			0x5a, 0x2b, 0x29, 0x4d, 0x49, 0x3f, 0x38, 0x5c,
+			0x4d, 0x42, 0x2f, 0x43, 0x30, 0x35, 0x24, 0x14,
+			0x47, 0x22, 0x43, 0x3c, 0x3a, 0x31, 0x58, 0x4c,
+			0x43, 0x6a, 0x47, 0x36, 0x26, 0x27, 0x17, 0xf,
+			0x6d, 0x35, 0x33, 0x2f, 0x5a, 0x52, 0x3a, 0x39,
+			0x30, 0x48, 0x39, 0x29, 0x17, 0x1b, 0x3e, 0x9,
+			0x56, 0x2a, 0x28, 0x25, 0x46, 0x40, 0x34, 0x2b,
+			0x46, 0x37, 0x2a, 0x19, 0x1d, 0x12, 0xb, 0xb,
+			0x76, 0x44, 0x1e, 0x37, 0x32, 0x2e, 0x4a, 0x41,
+			0x31, 0x27, 0x18, 0x10, 0x16, 0xd, 0xe, 0x7,
+			0x5b, 0x2c, 0x27, 0x26, 0x22, 0x3f, 0x34, 0x2d,
+			0x1f, 0x34, 0x1c, 0x13, 0xe, 0x8, 0x9, 0x3,
+			0x7b, 0x3c, 0x3a, 0x35, 0x2f, 0x2b, 0x20, 0x16,
+			0x25, 0x18, 0x11, 0xc, 0xf, 0xa, 0x2, 0x1,
+			0x47, 0x25, 0x22, 0x1e, 0x1c, 0x14, 0x11, 0x1a,
+			0x15, 0x10, 0xa, 0x6, 0x8, 0x6, 0x2, 0x0,
+		},
+		lens: []uint8{
+			3, 4, 5, 7, 7, 8, 9, 9,
+			9, 10, 10, 11, 11, 11, 12, 13,
+			4, 3, 5, 6, 7, 7, 8, 8,
+			8, 9, 9, 10, 10, 10, 11, 11,
+			5, 5, 5, 6, 7, 7, 8, 8,
+			8, 9, 9, 10, 10, 11, 11, 11,
+			6, 6, 6, 7, 7, 8, 8, 9,
+			9, 9, 10, 10, 10, 11, 11, 11,
+			7, 6, 7, 7, 8, 8, 9, 9,
+			9, 9, 10, 10, 10, 11, 11, 11,
+			8, 7, 7, 8, 8, 8, 9, 9,
+			9, 9, 10, 10, 11, 11, 11, 12,
+			9, 7, 8, 8, 8, 9, 9, 9,
+			9, 10, 10, 10, 11, 11, 12, 12,
+			9, 8, 8, 9, 9, 9, 9, 10,
+			10, 10, 10, 10, 11, 11, 11, 12,
+			9, 8, 8, 9, 9, 9, 9, 10,
+			10, 10, 10, 11, 11, 12, 12, 12,
+			9, 8, 9, 9, 9, 9, 10, 10,
+			10, 11, 11, 11, 11, 12, 12, 12,
+			10, 9, 9, 9, 10, 10, 10, 10,
+			10, 11, 11, 11, 11, 12, 13, 12,
+			10, 9, 9, 9, 10, 10, 10, 10,
+			11, 11, 11, 11, 12, 12, 12, 13,
+			11, 10, 9, 10, 10, 10, 11, 11,
+			11, 11, 11, 11, 12, 12, 13, 13,
+			11, 10, 10, 10, 10, 11, 11, 11,
+			11, 12, 12, 12, 12, 12, 13, 13,
+			12, 11, 11, 11, 11, 11, 11, 11,
+			12, 12, 12, 12, 13, 13, 12, 13,
+			12, 11, 11, 11, 11, 11, 11, 12,
+			12, 12, 12, 12, 13, 13, 13, 13,
+		},
+	},
+	16: {
+		xlen: 16,
+		codes: []uint32{
+			0x1, 0x5, 0xe, 0x2c, 0x4a, 0x3f, 0x6e, 0x5d,
+			0xac, 0x95, 0x8a, 0xf2, 0xe1, 0xc3, 0x178, 0x11,
+			0x3, 0x4, 0xc, 0x14, 0x23, 0x3e, 0x35, 0x2f,
+			0x53, 0x4b, 0x44, 0x77, 0xc9, 0x6b, 0xcf, 0x9,
+			0xf, 0xd, 0x17, 0x26, 0x43, 0x3a, 0x67, 0x5a,
+			0xa1, 0x48, 0x7f, 0x75, 0x6e, 0xd1, 0xce, 0x10,
+			0x2d, 0x15, 0x27, 0x45, 0x40, 0x72, 0x63, 0x57,
+			0x9e, 0x8c, 0xfc, 0xd4, 0xc7, 0x183, 0x16d, 0x1a,
+			0x4b, 0x24, 0x44, 0x41, 0x73, 0x65, 0xb3, 0xa4,
+			0x9b, 0x108, 0xf6, 0xe2, 0x18b, 0x17e, 0x16a, 0x9,
+			0x42, 0x1e, 0x3b, 0x38, 0x66, 0xb9, 0xad, 0x109,
+			0x8e, 0xfd, 0xe8, 0x190, 0x184, 0x17a, 0x1bd, 0x10,
+			0x6f, 0x36, 0x34, 0x64, 0xb8, 0xb2, 0xa0, 0x85,
+			0x101, 0xf4, 0xe4, 0xd9, 0x181, 0x16e, 0x2cb, 0xa,
+			0x62, 0x30, 0x5b, 0x58, 0xa5, 0x9d, 0x94, 0x105,
+			0xf8, 0x197, 0x18d, 0x174, 0x17c, 0x379, 0x374, 0x8,
+			0x55, 0x54, 0x51, 0x9f, 0x9c, 0x8f, 0x104, 0xf9,
+			0x1ab, 0x191, 0x188, 0x17f, 0x2d7, 0x2c9, 0x2c4, 0x7,
+			0x9a, 0x4c, 0x49, 0x8d, 0x83, 0x100, 0xf5, 0x1aa,
+			0x196, 0x18a, 0x180, 0x2df, 0x167, 0x2c6, 0x160, 0xb,
+			0x8b, 0x81, 0x43, 0x7d, 0xf7, 0xe9, 0xe5, 0xdb,
+			0x189, 0x2e7, 0x2e1, 0x2d0, 0x375, 0x372, 0x1b7, 0x4,
+			0xf3, 0x78, 0x76, 0x73, 0xe3, 0xdf, 0x18c, 0x2ea,
+			0x2e6, 0x2e0, 0x2d1, 0x2c8, 0x2c2, 0xdf, 0x1b4, 0x6,
+			0xca, 0xe0, 0xde, 0xda, 0xd8, 0x185, 0x182, 0x17d,
+			0x16c, 0x378, 0x1bb, 0x2c3, 0x1b8, 0x1b5, 0x6c0, 0x4,
+			0x2eb, 0xd3, 0xd2, 0xd0, 0x172, 0x17b, 0x2de, 0x2d3,
+			0x2ca, 0x6c7, 0x373, 0x36d, 0x36c, 0xd83, 0x361, 0x2,
+			0x179, 0x171, 0x66, 0xbb, 0x2d6, 0x2d2, 0x166, 0x2c7,
+			0x2c5, 0x362, 0x6c6, 0x367, 0xd82, 0x366, 0x1b2, 0x0,
+			0xc, 0xa, 0x7, 0xb, 0xa, 0x11, 0xb, 0x9,
+			0xd, 0xc, 0xa, 0x7, 0x5, 0x3, 0x1, 0x3,
+		},
+		lens: []uint8{
+			1, 4, 6, 8, 9, 9, 10, 10,
+			11, 11, 11, 12, 12, 12, 13, 9,
+			3, 4, 6, 7, 8, 9, 9, 9,
+			10, 10, 10, 11, 12, 11, 12, 8,
+			6, 6, 7, 8, 9, 9, 10, 10,
+			11, 10, 11, 11, 11, 12, 12, 9,
+			8, 7, 8, 9, 9, 10, 10, 10,
+			11, 11, 12, 12, 12, 13, 13, 10,
+			9, 8, 9, 9, 10, 10, 11, 11,
+			11, 12, 12, 12, 13, 13, 13, 9,
+			9, 8, 9, 9, 10, 11, 11, 12,
+			11, 12, 12, 13, 13, 13, 14, 10,
+			10, 9, 9, 10, 11, 11, 11, 11,
+			12, 12, 12, 12, 13, 13, 14, 10,
+			10, 9, 10, 10, 11, 11, 11, 12,
+			12, 13, 13, 13, 13, 15, 15, 10,
+			10, 10, 10, 11, 11, 11, 12, 12,
+			13, 13, 13, 13, 14, 14, 14, 10,
+			11, 10, 10, 11, 11, 12, 12, 13,
+			13, 13, 13, 14, 13, 14, 13, 11,
+			11, 11, 10, 11, 12, 12, 12, 12,
+			13, 14, 14, 14, 15, 15, 14, 10,
+			12, 11, 11, 11, 12, 12, 13, 14,
+			14, 14, 14, 14, 14, 13, 14, 11,
+			12, 12, 12, 12, 12, 13, 13, 13,
+			13, 15, 14, 14, 14, 14, 16, 11,
+			14, 12, 12, 12, 13, 13, 14, 14,
+			14, 16, 15, 15, 15, 17, 15, 11,
+			13, 13, 11, 12, 14, 14, 13, 14,
+			14, 15, 16, 15, 17, 15, 14, 11,
+			9, 8, 8, 9, 9, 10, 10, 10,
+			11, 11, 11, 11, 11, 11, 11, 8,
+		},
+	},
+	24: {
+		xlen: 16,
+		codes: []uint32{
+			0xf, 0xd, 0x2e, 0x50, 0x92, 0x106, 0xf8, 0x1b2,
+			0x1aa, 0x29d, 0x28d, 0x289, 0x26d, 0x205, 0x408, 0x58,
+			0xe, 0xc, 0x15, 0x26, 0x47, 0x82, 0x7a, 0xd8,
+			0xd1, 0xc6, 0x147, 0x159, 0x13f, 0x129, 0x117, 0x2a,
+			0x2f, 0x16, 0x29, 0x4a, 0x44, 0x80, 0x78, 0xdd,
+			0xcf, 0xc2, 0xb6, 0x154, 0x13b, 0x127, 0x21d, 0x12,
+			0x51, 0x27, 0x4b, 0x46, 0x86, 0x7d, 0x74, 0xdc,
+			0xcc, 0xbe, 0xb2, 0x145, 0x137, 0x125, 0x10f, 0x10,
+			0x93, 0x48, 0x45, 0x87, 0x7f, 0x76, 0x70, 0xd2,
+			0xc8, 0xbc, 0x160, 0x143, 0x132, 0x11d, 0x21c, 0xe,
+			0x107, 0x42, 0x81, 0x7e, 0x77, 0x72, 0xd6, 0xca,
+			0xc0, 0xb4, 0x155, 0x13d, 0x12d, 0x119, 0x106, 0xc,
+			0xf9, 0x7b, 0x79, 0x75, 0x71, 0xd7, 0xce, 0xc3,
+			0xb9, 0x15b, 0x14a, 0x134, 0x123, 0x110, 0x208, 0xa,
+			0x1b3, 0x73, 0x6f, 0x6d, 0xd3, 0xcb, 0xc4, 0xbb,
+			0x161, 0x14c, 0x139, 0x12a, 0x11b, 0x213, 0x17d, 0x11,
+			0x1ab, 0xd4, 0xd0, 0xcd, 0xc9, 0xc1, 0xba, 0xb1,
+			0xa9, 0x140, 0x12f, 0x11e, 0x10c, 0x202, 0x179, 0x10,
+			0x14f, 0xc7, 0xc5, 0xbf, 0xbd, 0xb5, 0xae, 0x14d,
+			0x141, 0x131, 0x121, 0x113, 0x209, 0x17b, 0x173, 0xb,
+			0x29c, 0xb8, 0xb7, 0xb3, 0xaf, 0x158, 0x14b, 0x13a,
+			0x130, 0x122, 0x115, 0x212, 0x17f, 0x175, 0x16e, 0xa,
+			0x28c, 0x15a, 0xab, 0xa8, 0xa4, 0x13e, 0x135, 0x12b,
+			0x11f, 0x114, 0x107, 0x201, 0x177, 0x170, 0x16a, 0x6,
+			0x288, 0x142, 0x13c, 0x138, 0x133, 0x12e, 0x124, 0x11c,
+			0x10d, 0x105, 0x200, 0x178, 0x172, 0x16c, 0x167, 0x4,
+			0x26c, 0x12c, 0x128, 0x126, 0x120, 0x11a, 0x111, 0x10a,
+			0x203, 0x17c, 0x176, 0x171, 0x16d, 0x169, 0x165, 0x2,
+			0x409, 0x118, 0x116, 0x112, 0x10b, 0x108, 0x103, 0x17e,
+			0x17a, 0x174, 0x16f, 0x16b, 0x168, 0x166, 0x164, 0x0,
+			0x2b, 0x14, 0x13, 0x11, 0xf, 0xd, 0xb, 0x9,
+			0x7, 0x6, 0x4, 0x7, 0x5, 0x3, 0x1, 0x3,
+		},
+		lens: []uint8{
+			4, 4, 6, 7, 8, 9, 9, 10,
+			10, 11, 11, 11, 11, 11, 12, 9,
+			4, 4, 5, 6, 7, 8, 8, 9,
+			9, 9, 10, 10, 10, 10, 10, 8,
+			6, 5, 6, 7, 7, 8, 8, 9,
+			9, 9, 9, 10, 10, 10, 11, 7,
+			7, 6, 7, 7, 8, 8, 8, 9,
+			9, 9, 9, 10, 10, 10, 10, 7,
+			8, 7, 7, 8, 8, 8, 8, 9,
+			9, 9, 10, 10, 10, 10, 11, 7,
+			9, 7, 8, 8, 8, 8, 9, 9,
+			9, 9, 10, 10, 10, 10, 10, 7,
+			9, 8, 8, 8, 8, 9, 9, 9,
+			9, 10, 10, 10, 10, 10, 11, 7,
+			10, 8, 8, 8, 9, 9, 9, 9,
+			10, 10, 10, 10, 10, 11, 11, 8,
+			10, 9, 9, 9, 9, 9, 9, 9,
+			9, 10, 10, 10, 10, 11, 11, 8,
+			10, 9, 9, 9, 9, 9, 9, 10,
+			10, 10, 10, 10, 11, 11, 11, 8,
+			11, 9, 9, 9, 9, 10, 10, 10,
+			10, 10, 10, 11, 11, 11, 11, 8,
+			11, 10, 9, 9, 9, 10, 10, 10,
+			10, 10, 10, 11, 11, 11, 11, 8,
+			11, 10, 10, 10, 10, 10, 10, 10,
+			10, 10, 11, 11, 11, 11, 11, 8,
+			11, 10, 10, 10, 10, 10, 10, 10,
+			11, 11, 11, 11, 11, 11, 11, 8,
+			12, 10, 10, 10, 10, 10, 10, 11,
+			11, 11, 11, 11, 11, 11, 11, 8,
+			8, 7, 7, 7, 7, 7, 7, 7,
+			7, 7, 7, 8, 8, 8, 8, 4,
+		},
+	},
+}
+
+// mp3Linbits are the escape lengths of tables 16 to 31.
+var mp3Linbits = [32]int{
+	16: 1, 17: 2, 18: 3, 19: 4, 20: 6, 21: 8, 22: 10, 23: 13,
+	24: 4, 25: 5, 26: 6, 27: 7, 28: 8, 29: 9, 30: 11, 31: 13,
+}
+
+// mp3Count1Tables are the codes A and B of quadruples of values no greater
+// than 1, indexed by 8v+4w+2x+y.
+var mp3Count1Tables = [2]struct {
+	codes [16]uint8
+	lens  [16]uint8
+}{
+	{
+		codes: [16]uint8{1, 5, 4, 5, 6, 5, 4, 4, 7, 3, 6, 0, 7, 2, 3, 1},
+		lens:  [16]uint8{1, 4, 4, 5, 4, 6, 5, 6, 4, 5, 5, 6, 5, 6, 6, 6},
+	},
+	{
+		codes: [16]uint8{15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+		lens:  [16]uint8{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4},
+	},
+}
@@ -0,0 +1,618 @@
+package pcm
+
+import (
+	"fmt"
+	"io"
+	"math"
+)
+
+// MPEG audio versions as coded in the frame header.
+const (
+	mp3VersionMPEG2 = 2
+	mp3VersionMPEG1 = 3
+)
+
+var (
+	mp3SampleRates = map[int]struct{ version, index int }{
+		44100: {mp3VersionMPEG1, 0},
+		48000: {mp3VersionMPEG1, 1},
+		32000: {mp3VersionMPEG1, 2},
+		22050: {mp3VersionMPEG2, 0},
+		24000: {mp3VersionMPEG2, 1},
+		16000: {mp3VersionMPEG2, 2},
+	}
+	// mp3Bitrates are in kbit/s by bitrate index.
+	mp3Bitrates = map[int][15]int{
+		mp3VersionMPEG1: {0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+		mp3VersionMPEG2: {0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+	}
+)
+
+const (
+	mp3GranuleSize = 576
+	// MP3Delay is the number of samples by which decoded MP3 lags the audio
+	// given to MP3Writer: a granule held back by the transform, and the
+	// delays of the analysis and synthesis filterbanks.
+	MP3Delay = 1057
+	// mp3MaxValue is the largest quantized value a Huffman table can code.
+	mp3MaxValue = 15 + 1<<13 - 1
+	// mp3MaxGranuleBits is the most part2_3_length can declare.
+	mp3MaxGranuleBits = 1<<12 - 1
+)
+
+// MP3Writer encodes audio as MPEG-1 or MPEG-2 Layer III at a constant
+// bitrate. It is a plain encoder for speech and recitation: it has no
+// psychoacoustic model or bit reservoir, and codes every granule with long
+// blocks and no scale factors, so the quantization noise is spread evenly
+// across frequencies.
+type MP3Writer struct {
+	w        io.Writer
+	f        Format
+	version  int
+	granules int
+	header   [4]byte
+	bands    *[23]int
+
+	// A frame holds frameSize bytes, plus one of padding whenever the
+	// remainders of frameSize carried in padLag reach a whole byte.
+	frameSize    int
+	padRemainder int
+	padLag       int
+	sideInfoSize int
+
+	pending []float64
+	// written and encoded count frames of input and of encoded audio.
+	written  int64
+	encoded  int64
+	channels []mp3Channel
+	coded    [2][2]mp3Granule
+	size     int64
+	bits     bitWriter
+}
+
+// mp3Channel is the state of the filterbank of one channel.
+type mp3Channel struct {
+	// x holds the last 512 input samples, newest first.
+	x [512]float64
+	// subbands holds the 18 samples of each of the 32 subbands of the
+	// previous granule, which its transform overlaps.
+	subbands [32][18]float64
+	xr       [mp3GranuleSize]float64
+}
+
+// NewMP3Writer returns a writer of audio of format f, of one or two
+// channels at 16, 22.05, 24, 32, 44.1 or 48 kHz, as MP3 at bitrate bits
+// per second, one of the bitrates of its MPEG version.
+func NewMP3Writer(w io.Writer, f Format, bitrate int) (*MP3Writer, error) {
+	rate, ok := mp3SampleRates[f.SampleRate]
+	if !ok || f.Channels < 1 || f.Channels > 2 {
+		return nil, fmt.Errorf("%w: cannot encode MP3 of %d channels at %d Hz", ErrNoEncoder, f.Channels, f.SampleRate)
+	}
+	bitrateIndex := -1
+	for i, kbps := range mp3Bitrates[rate.version] {
+		if i > 0 && kbps*1000 == bitrate {
+			bitrateIndex = i
+		}
+	}
+	if bitrateIndex < 0 {
+		return nil, fmt.Errorf("%w: cannot encode MP3 at %d Hz and %d bit/s", ErrNoEncoder, f.SampleRate, bitrate)
+	}
+
+	mw := &MP3Writer{
+		w:        w,
+		f:        f,
+		version:  rate.version,
+		granules: 1,
+		channels: make([]mp3Channel, f.Channels),
+	}
+	bands := mp3Bands[f.SampleRate]
+	mw.bands = &bands
+	// A frame carries 1152 samples in MPEG-1 and 576 in MPEG-2.
+	slots := 72 * bitrate
+	if rate.version == mp3VersionMPEG1 {
+		mw.granules = 2
+		slots *= 2
+	}
+	mw.frameSize = slots / f.SampleRate
+	mw.padRemainder = slots % f.SampleRate
+
+	switch {
+	case rate.version == mp3VersionMPEG1 && f.Channels == 1:
+		mw.sideInfoSize = 17
+	case rate.version == mp3VersionMPEG1:
+		mw.sideInfoSize = 32
+	case f.Channels == 1:
+		mw.sideInfoSize = 9
+	default:
+		mw.sideInfoSize = 17
+	}
+
+	mode := byte(0) // stereo
+	if f.Channels == 1 {
+		mode = 3
+	}
+	mw.header = [4]byte{
+		0xFF,
+		0xE0 | byte(rate.version)<<3 | 1<<1 | 1, // Layer III, no CRC
+		byte(bitrateIndex)<<4 | byte(rate.index)<<2,
+		mode << 6,
+	}
+	return mw, nil
+}
+
+func (w *MP3Writer) frameSamples() int {
+	return w.granules * mp3GranuleSize * w.f.Channels
+}
+
+func (w *MP3Writer) Write(samples []float64) error {
+	w.written += int64(len(samples) / w.f.Channels)
+	w.pending = append(w.pending, samples...)
+	n := w.frameSamples()
+	i := 0
+	for ; len(w.pending)-i >= n; i += n {
+		if err := w.writeFrame(w.pending[i : i+n]); err != nil {
+			return err
+		}
+	}
+	w.pending = append(w.pending[:0], w.pending[i:]...)
+	return nil
+}
+
+// Close encodes the remaining samples followed by enough silence to flush
+// them through the filterbanks. It does not close the underlying writer.
+func (w *MP3Writer) Close() error {
+	n := w.frameSamples()
+	w.pending = append(w.pending, make([]float64, n-len(w.pending))...)
+	for w.encoded < w.written+MP3Delay {
+		if err := w.writeFrame(w.pending); err != nil {
+			return err
+		}
+		clear(w.pending)
+	}
+	w.pending = w.pending[:0]
+	return nil
+}
+
+// Size returns the number of bytes written.
+func (w *MP3Writer) Size() int64 {
+	return w.size
+}
+
+func (w *MP3Writer) writeFrame(samples []float64) error {
+	size := w.frameSize
+	header := w.header
+	w.padLag += w.padRemainder
+	if w.padLag >= w.f.SampleRate {
+		w.padLag -= w.f.SampleRate
+		size++
+		header[2] |= 1 << 1
+	}
+
+	// Share the main data evenly between the granules and channels, each
+	// of which gets what the ones before it left over.
+	available := (size - len(header) - w.sideInfoSize) * 8
+	remaining := w.granules * w.f.Channels
+	for gr := 0; gr < w.granules; gr++ {
+		for ch := range w.channels {
+			c := &w.channels[ch]
+			c.analyze(samples[gr*mp3GranuleSize*w.f.Channels:], ch, w.f.Channels)
+			budget := min(available/remaining, mp3MaxGranuleBits)
+			g := &w.coded[gr][ch]
+			g.quantize(&c.xr, w.bands, budget)
+			available -= g.part23
+			remaining--
+		}
+	}
+
+	w.bits.reset()
+	w.bits.bytes = append(w.bits.bytes, header[:]...)
+	w.writeSideInfo()
+	for gr := 0; gr < w.granules; gr++ {
+		for ch := range w.channels {
+			w.coded[gr][ch].write(&w.bits, w.bands)
+		}
+	}
+	frame := w.bits.flush()
+	// The unused main data is padded with zero bits as ancillary data.
+	frame = append(frame, make([]byte, size-len(frame))...)
+	n, err := w.w.Write(frame)
+	w.size += int64(n)
+	w.encoded += int64(w.granules * mp3GranuleSize)
+	return err
+}
+
+func (w *MP3Writer) writeSideInfo() {
+	b := &w.bits
+	mpeg1 := w.version == mp3VersionMPEG1
+	// main_data_begin is always 0, as there is no bit reservoir.
+	if mpeg1 {
+		b.write(0, 9)
+		if len(w.channels) == 1 {
+			b.write(0, 5)
+		} else {
+			b.write(0, 3)
+		}
+		b.write(0, 4*len(w.channels)) // scfsi
+	} else {
+		b.write(0, 8)
+		b.write(0, len(w.channels))
+	}
+	for gr := 0; gr < w.granules; gr++ {
+		for ch := range w.channels {
+			g := &w.coded[gr][ch]
+			b.write(uint32(g.part23), 12)
+			b.write(uint32(g.bigValues), 9)
+			b.write(uint32(g.gain), 8)
+			if mpeg1 {
+				b.write(0, 4) // scalefac_compress
+			} else {
+				b.write(0, 9)
+			}
+			b.write(0, 1) // window_switching_flag
+			for _, t := range g.tables {
+				b.write(uint32(t), 5)
+			}
+			b.write(uint32(g.region0), 4)
+			b.write(uint32(g.region1), 3)
+			if mpeg1 {
+				b.write(0, 1) // preflag
+			}
+			b.write(0, 1) // scalefac_scale
+			b.write(uint32(g.count1Table), 1)
+		}
+	}
+}
+
+var (
+	// mp3Matrix is the cosine matrix of the polyphase filterbank.
+	mp3Matrix [32][64]float64
+	// mp3MDCT is the sine-windowed transform of long blocks, scaled so
+	// that decoding it restores the level of the input.
+	mp3MDCT [18][36]float64
+	// mp3AliasCS and mp3AliasCA are the coefficients of the alias
+	// reduction butterflies.
+	mp3AliasCS, mp3AliasCA [8]float64
+)
+
+func init() {
+	for i := range mp3Matrix {
+		for k := range mp3Matrix[i] {
+			mp3Matrix[i][k] = math.Cos(float64((2*i+1)*(k-16)) * math.Pi / 64)
+		}
+	}
+	for k := range mp3MDCT {
+		for n := range mp3MDCT[k] {
+			window := math.Sin(math.Pi / 36 * (float64(n) + 0.5))
+			mp3MDCT[k][n] = window * math.Cos(math.Pi/72*float64((2*n+19)*(2*k+1))) / 9
+		}
+	}
+	for i, c := range [8]float64{-0.6, -0.535, -0.33, -0.185, -0.095, -0.041, -0.0142, -0.0037} {
+		sq := math.Sqrt(1 + c*c)
+		mp3AliasCS[i] = 1 / sq
+		mp3AliasCA[i] = c / sq
+	}
+}
+
+// analyze runs one granule of channel ch of the interleaved samples through
+// the filterbank into c.xr.
+func (c *mp3Channel) analyze(samples []float64, ch, channels int) {
+	var subbands [32][18]float64
+	for t := 0; t < 18; t++ {
+		copy(c.x[32:], c.x[:480])
+		for i := 0; i < 32; i++ {
+			c.x[31-i] = samples[(t*32+i)*channels+ch]
+		}
+		var y [64]float64
+		for k := range y {
+			for j := 0; j < 8; j++ {
+				y[k] += mp3Window[k+64*j] * c.x[k+64*j]
+			}
+		}
+		for i := range subbands {
+			var s float64
+			for k, v := range y {
+				s += mp3Matrix[i][k] * v
+			}
+			// Odd subbands are inverted in frequency, which the decoder
+			// undoes by negating their odd samples.
+			if i&1 == 1 && t&1 == 1 {
+				s = -s
+			}
+			subbands[i][t] = s
+		}
+	}
+
+	for sb := range subbands {
+		var in [36]float64
+		copy(in[:18], c.subbands[sb][:])
+		copy(in[18:], subbands[sb][:])
+		xr := c.xr[sb*18 : (sb+1)*18]
+		for k := range xr {
+			var s float64
+			for n, v := range in {
+				s += mp3MDCT[k][n] * v
+			}
+			xr[k] = s
+		}
+	}
+	c.subbands = subbands
+
+	for sb := 1; sb < 32; sb++ {
+		for i := 0; i < 8; i++ {
+			lo, up := &c.xr[sb*18-1-i], &c.xr[sb*18+i]
+			*lo, *up = *lo*mp3AliasCS[i]+*up*mp3AliasCA[i], *up*mp3AliasCS[i]-*lo*mp3AliasCA[i]
+		}
+	}
+}
+
+// mp3Granule is a quantized granule of one channel and its side
+// information.
+type mp3Granule struct {
+	ix  [mp3GranuleSize]int
+	neg [mp3GranuleSize]bool
+
+	part23      int
+	bigValues   int
+	count1End   int
+	gain        int
+	tables      [3]int
+	region0     int
+	region1     int
+	count1Table int
+}
+
+// quantize finds the finest global gain at which xr codes in budget bits.
+func (g *mp3Granule) quantize(xr *[mp3GranuleSize]float64, bands *[23]int, budget int) {
+	var xr34 [mp3GranuleSize]float64
+	for i, v := range xr {
+		g.neg[i] = v < 0
+		xr34[i] = math.Pow(math.Abs(v), 0.75)
+	}
+	lo, hi := 0, 255
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if g.quantizeAt(&xr34, mid) && g.count(bands) <= budget {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	if !g.quantizeAt(&xr34, lo) || g.count(bands) > budget {
+		// Too loud to code at all; drop the granule rather than the frame.
+		g.ix = [mp3GranuleSize]int{}
+		g.count(bands)
+	}
+	g.gain = lo
+}
+
+// quantizeAt quantizes at the given global gain, reporting false if a value
+// is too large to code.
+func (g *mp3Granule) quantizeAt(xr34 *[mp3GranuleSize]float64, gain int) bool {
+	step := math.Pow(2, -float64(gain-210)*3/16)
+	for i, v := range xr34 {
+		q := v*step + 0.4054
+		if q > mp3MaxValue {
+			return false
+		}
+		g.ix[i] = int(q)
+	}
+	return true
+}
+
+// count divides the granule into its regions, chooses their Huffman tables
+// and returns the number of bits it codes to.
+func (g *mp3Granule) count(bands *[23]int) int {
+	end := mp3GranuleSize
+	for end > 1 && g.ix[end-1] == 0 && g.ix[end-2] == 0 {
+		end -= 2
+	}
+	bigEnd := end
+	for bigEnd > 3 && g.ix[bigEnd-1] <= 1 && g.ix[bigEnd-2] <= 1 && g.ix[bigEnd-3] <= 1 && g.ix[bigEnd-4] <= 1 {
+		bigEnd -= 4
+	}
+	g.bigValues = bigEnd / 2
+	g.count1End = end
+
+	g.region0, g.region1 = 0, 0
+	if bigEnd > 0 {
+		band := 0
+		for bands[band] < bigEnd {
+			band++
+		}
+		g.region0 = mp3RegionCounts[band][0]
+		for g.region0 > 0 && bands[g.region0+1] > bigEnd {
+			g.region0--
+		}
+		g.region1 = mp3RegionCounts[band][1]
+		for g.region1 > 0 && bands[g.region0+g.region1+2] > bigEnd {
+			g.region1--
+		}
+	}
+	bits := 0
+	for r, span := range g.regions(bands) {
+		t, n := bestTable(g.ix[span[0]:span[1]])
+		g.tables[r] = t
+		bits += n
+	}
+
+	var countA, countB int
+	for i := bigEnd; i < end; i += 4 {
+		q := g.ix[i]<<3 | g.ix[i+1]<<2 | g.ix[i+2]<<1 | g.ix[i+3]
+		signs := g.ix[i] + g.ix[i+1] + g.ix[i+2] + g.ix[i+3]
+		countA += int(mp3Count1Tables[0].lens[q]) + signs
+		countB += int(mp3Count1Tables[1].lens[q]) + signs
+	}
+	g.count1Table = 0
+	if countB < countA {
+		g.count1Table = 1
+		countA = countB
+	}
+	g.part23 = bits + countA
+	return g.part23
+}
+
+// regions returns the bounds of the three regions of big values.
+func (g *mp3Granule) regions(bands *[23]int) [3][2]int {
+	bigEnd := g.bigValues * 2
+	start1 := min(bands[g.region0+1], bigEnd)
+	start2 := min(bands[g.region0+g.region1+2], bigEnd)
+	return [3][2]int{{0, start1}, {start1, start2}, {start2, bigEnd}}
+}
+
+// bestTable returns the Huffman table that codes the pairs of values in
+// the fewest bits, and that number.
+func bestTable(values []int) (int, int) {
+	largest := 0
+	for _, v := range values {
+		largest = max(largest, v)
+	}
+	if largest == 0 {
+		return 0, 0
+	}
+	var candidates []int
+	if largest < 16 {
+		for _, t := range []int{1, 2, 3, 5, 6, 7, 8, 9, 10, 11, 12, 13, 15} {
+			if mp3HuffmanTables[t].xlen > largest {
+				candidates = append(candidates, t)
+			}
+		}
+	} else {
+		// The first table of each family whose linbits reach the largest
+		// value.
+		for _, family := range [][]int{{16, 17, 18, 19, 20, 21, 22, 23}, {24, 25, 26, 27, 28, 29, 30, 31}} {
+			for _, t := range family {
+				if largest-15 < 1<<mp3Linbits[t] {
+					candidates = append(candidates, t)
+					break
+				}
+			}
+		}
+	}
+	best, bestBits := 0, math.MaxInt
+	for _, t := range candidates {
+		if n := pairBits(t, values); n < bestBits {
+			best, bestBits = t, n
+		}
+	}
+	return best, bestBits
+}
+
+func huffmanTable(t int) *mp3HuffmanTable {
+	switch {
+	case t >= 24:
+		return mp3HuffmanTables[24]
+	case t >= 16:
+		return mp3HuffmanTables[16]
+	}
+	return mp3HuffmanTables[t]
+}
+
+func pairBits(t int, values []int) int {
+	table := huffmanTable(t)
+	linbits := mp3Linbits[t]
+	bits := 0
+	for i := 0; i < len(values); i += 2 {
+		x, y := values[i], values[i+1]
+		if linbits > 0 {
+			if x >= 15 {
+				bits += linbits
+				x = 15
+			}
+			if y >= 15 {
+				bits += linbits
+				y = 15
+			}
+		}
+		bits += int(table.lens[x*table.xlen+y])
+		if x != 0 {
+			bits++
+		}
+		if y != 0 {
+			bits++
+		}
+	}
+	return bits
+}
+
+// write appends the Huffman-coded values of the granule to b.
+func (g *mp3Granule) write(b *bitWriter, bands *[23]int) {
+	for r, span := range g.regions(bands) {
+		t := g.tables[r]
+		if t == 0 {
+			continue
+		}
+		table := huffmanTable(t)
+		linbits := mp3Linbits[t]
+		for i := span[0]; i < span[1]; i += 2 {
+			x, y := g.ix[i], g.ix[i+1]
+			hx, hy := min(x, 15), min(y, 15)
+			if linbits == 0 {
+				hx, hy = x, y
+			}
+			k := hx*table.xlen + hy
+			b.write(table.codes[k], int(table.lens[k]))
+			g.writeValue(b, i, x, linbits)
+			g.writeValue(b, i+1, y, linbits)
+		}
+	}
+	table := &mp3Count1Tables[g.count1Table]
+	for i := g.bigValues * 2; i < g.count1End; i += 4 {
+		q := g.ix[i]<<3 | g.ix[i+1]<<2 | g.ix[i+2]<<1 | g.ix[i+3]
+		b.write(uint32(table.codes[q]), int(table.lens[q]))
+		for j := i; j < i+4; j++ {
+			if g.ix[j] != 0 {
+				b.writeBool(g.neg[j])
+			}
+		}
+	}
+}
+
+// writeValue writes the escape and sign bits of the value v at index i.
+func (g *mp3Granule) writeValue(b *bitWriter, i, v, linbits int) {
+	if linbits > 0 && v >= 15 {
+		b.write(uint32(v-15), linbits)
+	}
+	if v != 0 {
+		b.writeBool(g.neg[i])
+	}
+}
+
+// bitWriter packs bits most significant first.
+type bitWriter struct {
+	bytes []byte
+	acc   uint64
+	n     int
+}
+
+func (b *bitWriter) reset() {
+	b.bytes = b.bytes[:0]
+	b.acc, b.n = 0, 0
+}
+
+func (b *bitWriter) write(v uint32, bits int) {
+	if bits == 0 {
+		return
+	}
+	b.acc = b.acc<<bits | uint64(v)&(1<<bits-1)
+	b.n += bits
+	for b.n >= 8 {
+		b.n -= 8
+		b.bytes = append(b.bytes, byte(b.acc>>b.n))
+	}
+}
+
+func (b *bitWriter) writeBool(v bool) {
+	if v {
+		b.write(1, 1)
+	} else {
+		b.write(0, 1)
+	}
+}
+
+// flush pads the last byte with zero bits and returns the bytes written.
+func (b *bitWriter) flush() []byte {
+	if b.n > 0 {
+		b.write(0, 8-b.n)
+	}
+	return b.bytes
+}
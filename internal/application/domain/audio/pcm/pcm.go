@@ -0,0 +1,185 @@
+// Package pcm decodes audio to floating-point samples, measures and
+// adjusts its loudness, and writes it back out as WAV or MP3.
+//
+// Samples are interleaved by channel and scaled to [-1, 1]. WAV and MP3
+// are decoded; other formats report ErrNoDecoder until a decoder is
+// registered for them.
+package pcm
+
+import (
+	"errors"
+	"io"
+
+	"github.com/mnadev/limestone/internal/application/domain/audio"
+)
+
+var (
+	ErrNoDecoder = errors.New("no decoder for this audio format")
+	ErrNoEncoder = errors.New("no encoder for this audio format")
+)
+
+// Format describes decoded audio.
+type Format struct {
+	// SampleRate is in Hz.
+	SampleRate int
+	Channels   int
+}
+
+// Reader reads decoded audio.
+type Reader interface {
+	Format() Format
+	// Read fills buf with whole frames of interleaved samples and returns
+	// the number of samples read. len(buf) must be a multiple of the
+	// number of channels. It returns io.EOF at the end of the audio.
+	Read(buf []float64) (int, error)
+}
+
+// Writer consumes decoded audio. Write is given whole frames of
+// interleaved samples.
+type Writer interface {
+	Write(samples []float64) error
+}
+
+// MultiWriter returns a Writer that writes to each of ws in turn.
+func MultiWriter(ws ...Writer) Writer {
+	return multiWriter(ws)
+}
+
+type multiWriter []Writer
+
+func (m multiWriter) Write(samples []float64) error {
+	for _, w := range m {
+		if err := w.Write(samples); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Decoder returns a Reader over the audio file read from r.
+type Decoder func(r io.Reader) (Reader, error)
+
+var decoders = map[string]Decoder{
+	audio.FormatWAV: DecodeWAV,
+	audio.FormatMP3: DecodeMP3,
+}
+
+// RegisterDecoder makes a decoder available for a container format, one of
+// the audio.Format constants.
+func RegisterDecoder(format string, decode Decoder) {
+	decoders[format] = decode
+}
+
+// CanDecode reports whether a decoder is registered for format.
+func CanDecode(format string) bool {
+	_, ok := decoders[format]
+	return ok
+}
+
+// Decode returns a Reader over the audio file read from r, whose container
+// format is one of the audio.Format constants.
+func Decode(format string, r io.Reader) (Reader, error) {
+	decode, ok := decoders[format]
+	if !ok {
+		return nil, ErrNoDecoder
+	}
+	return decode(r)
+}
+
+// bufferFrames is the number of frames the readers of this package process
+// at a time.
+const bufferFrames = 4096
+
+// Gain returns a Reader that scales the samples of r by the linear factor
+// gain.
+func Gain(r Reader, gain float64) Reader {
+	if gain == 1 {
+		return r
+	}
+	return &gainReader{Reader: r, gain: gain}
+}
+
+type gainReader struct {
+	Reader
+	gain float64
+}
+
+func (g *gainReader) Read(buf []float64) (int, error) {
+	n, err := g.Reader.Read(buf)
+	for i := range buf[:n] {
+		buf[i] *= g.gain
+	}
+	return n, err
+}
+
+// Mix returns a Reader of r with the given number of channels. Audio is
+// mixed down to mono by averaging its channels; mono audio is copied to
+// every channel. Other conversions keep the first channels, or repeat the
+// last.
+func Mix(r Reader, channels int) Reader {
+	from := r.Format().Channels
+	if channels == from {
+		return r
+	}
+	return &mixReader{src: r, from: from, to: channels}
+}
+
+type mixReader struct {
+	src      Reader
+	from, to int
+	buf      []float64
+}
+
+func (m *mixReader) Format() Format {
+	f := m.src.Format()
+	f.Channels = m.to
+	return f
+}
+
+func (m *mixReader) Read(buf []float64) (int, error) {
+	frames := len(buf) / m.to
+	if cap(m.buf) < frames*m.from {
+		m.buf = make([]float64, frames*m.from)
+	}
+	n, err := m.src.Read(m.buf[:frames*m.from])
+	frames = n / m.from
+	for i := 0; i < frames; i++ {
+		in := m.buf[i*m.from : (i+1)*m.from]
+		out := buf[i*m.to : (i+1)*m.to]
+		if m.to == 1 {
+			var sum float64
+			for _, v := range in {
+				sum += v
+			}
+			out[0] = sum / float64(m.from)
+			continue
+		}
+		for c := range out {
+			out[c] = in[min(c, m.from-1)]
+		}
+	}
+	return frames * m.to, err
+}
+
+// Copy writes every sample of r to w and returns the number of frames
+// copied.
+func Copy(w Writer, r Reader) (int64, error) {
+	channels := r.Format().Channels
+	buf := make([]float64, bufferFrames*channels)
+	var frames int64
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if err := w.Write(buf[:n]); err != nil {
+				return frames, err
+			}
+			frames += int64(n / channels)
+		}
+		if errors.Is(err, io.EOF) {
+			return frames, nil
+		}
+		if err != nil {
+			return frames, err
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package pcm
+
+import (
+	"errors"
+	"io"
+	"math"
+	"slices"
+)
+
+const (
+	// resampleZeros is the number of zero crossings of the windowed sinc
+	// kernel on either side of its centre. More give a steeper low-pass
+	// filter at a higher cost.
+	resampleZeros = 16
+	// resampleBandwidth is the cutoff of the anti-aliasing filter as a
+	// fraction of the lower of the two Nyquist frequencies, leaving room
+	// for the filter's transition band.
+	resampleBandwidth = 0.9
+	// kernelSteps is the resolution of the tabulated kernel, per input
+	// sample.
+	kernelSteps = 512
+)
+
+// Resample returns a Reader of r converted to the given sample rate by
+// band-limited interpolation with a Blackman-windowed sinc filter.
+func Resample(r Reader, rate int) Reader {
+	f := r.Format()
+	if rate == f.SampleRate {
+		return r
+	}
+	step := float64(f.SampleRate) / float64(rate)
+	// The cutoff as a fraction of the input sample rate's Nyquist frequency.
+	cutoff := resampleBandwidth * min(1, 1/step)
+	half := int(math.Ceil(resampleZeros / cutoff))
+
+	kernel := make([]float64, half*kernelSteps+2)
+	for i := range kernel {
+		x := float64(i) / kernelSteps
+		if x >= float64(half) {
+			break
+		}
+		kernel[i] = cutoff * sinc(cutoff*x) * blackman(x/float64(half))
+	}
+
+	return &resampler{
+		src:    r,
+		f:      Format{SampleRate: rate, Channels: f.Channels},
+		step:   step,
+		half:   half,
+		kernel: kernel,
+		in:     make([]float64, 0, (bufferFrames+2*half)*f.Channels),
+		total:  -1,
+	}
+}
+
+type resampler struct {
+	src    Reader
+	f      Format
+	step   float64
+	half   int
+	kernel []float64
+
+	// in holds interleaved input frames from frame index base onwards.
+	in   []float64
+	base int64
+	// total is the number of input frames once the input has ended, and -1
+	// before.
+	total int64
+	// next is the index of the next output frame.
+	next int64
+	err  error
+}
+
+func (r *resampler) Format() Format {
+	return r.f
+}
+
+func (r *resampler) Read(buf []float64) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	channels := r.f.Channels
+	n := 0
+	for n+channels <= len(buf) {
+		pos := float64(r.next) * r.step
+		centre := int64(math.Floor(pos))
+		if r.total >= 0 && centre >= r.total {
+			break
+		}
+		// The filter needs input up to centre+half.
+		if r.total < 0 && centre+int64(r.half) >= r.base+int64(len(r.in)/channels) {
+			if err := r.fill(centre - int64(r.half)); err != nil {
+				r.err = err
+				break
+			}
+			continue
+		}
+		r.interpolate(pos, centre, buf[n:n+channels])
+		n += channels
+		r.next++
+	}
+	if n > 0 {
+		return n, nil
+	}
+	if r.err != nil {
+		return 0, r.err
+	}
+	return 0, io.EOF
+}
+
+// fill drops input before frame keep and reads more.
+func (r *resampler) fill(keep int64) error {
+	channels := r.f.Channels
+	if drop := keep - r.base; drop > 0 {
+		drop = min(drop, int64(len(r.in)/channels))
+		r.in = append(r.in[:0], r.in[drop*int64(channels):]...)
+		r.base += drop
+	}
+	start := len(r.in)
+	r.in = slices.Grow(r.in, bufferFrames*channels)[:start+bufferFrames*channels]
+	n, err := r.src.Read(r.in[start:])
+	r.in = r.in[:start+n]
+	if errors.Is(err, io.EOF) {
+		r.total = r.base + int64(len(r.in)/channels)
+		return nil
+	}
+	return err
+}
+
+func (r *resampler) interpolate(pos float64, centre int64, out []float64) {
+	channels := r.f.Channels
+	for c := range out {
+		out[c] = 0
+	}
+	var weights float64
+	available := r.base + int64(len(r.in)/channels)
+	for j := centre - int64(r.half) + 1; j <= centre+int64(r.half); j++ {
+		w := r.weight(pos - float64(j))
+		if w == 0 {
+			continue
+		}
+		weights += w
+		if j < r.base || j >= available {
+			// Before the start or past the end of the audio.
+			continue
+		}
+		frame := r.in[(j-r.base)*int64(channels):]
+		for c := range out {
+			out[c] += w * frame[c]
+		}
+	}
+	if weights != 0 {
+		for c := range out {
+			out[c] /= weights
+		}
+	}
+}
+
+// weight returns the filter kernel at x input samples from its centre.
+func (r *resampler) weight(x float64) float64 {
+	x = math.Abs(x) * kernelSteps
+	i := int(x)
+	if i+1 >= len(r.kernel) {
+		return 0
+	}
+	frac := x - float64(i)
+	return r.kernel[i] + (r.kernel[i+1]-r.kernel[i])*frac
+}
+
+func sinc(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+	return math.Sin(math.Pi*x) / (math.Pi * x)
+}
+
+// blackman is the Blackman window over [-1, 1].
+func blackman(u float64) float64 {
+	return 0.42 + 0.5*math.Cos(math.Pi*u) + 0.08*math.Cos(2*math.Pi*u)
+}
@@ -0,0 +1,330 @@
+package pcm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/mnadev/limestone/internal/application/domain/audio"
+)
+
+const (
+	wavFormatPCM        = 0x0001
+	wavFormatFloat      = 0x0003
+	wavFormatMuLaw      = 0x0007
+	wavFormatExtensible = 0xFFFE
+)
+
+var le = binary.LittleEndian
+
+// DecodeWAV reads a WAV file of integer PCM of 8 to 32 bits, IEEE float or
+// G.711 µ-law samples.
+func DecodeWAV(r io.Reader) (Reader, error) {
+	var header [12]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, wavError(err)
+	}
+	if string(header[:4]) != "RIFF" || string(header[8:]) != "WAVE" {
+		return nil, audio.ErrUnsupportedFormat
+	}
+
+	d := &wavReader{}
+	haveFmt := false
+	for {
+		var chunk [8]byte
+		if _, err := io.ReadFull(r, chunk[:]); err != nil {
+			return nil, wavError(err)
+		}
+		size := int64(le.Uint32(chunk[4:]))
+		switch string(chunk[:4]) {
+		case "fmt ":
+			if size < 16 || size > 1024 {
+				return nil, fmt.Errorf("%w: fmt chunk is %d bytes", audio.ErrMalformed, size)
+			}
+			body := make([]byte, size+size&1)
+			if _, err := io.ReadFull(r, body); err != nil {
+				return nil, wavError(err)
+			}
+			d.format = int(le.Uint16(body[0:]))
+			d.f.Channels = int(le.Uint16(body[2:]))
+			d.f.SampleRate = int(le.Uint32(body[4:]))
+			d.width = (int(le.Uint16(body[14:])) + 7) / 8
+			if d.format == wavFormatExtensible && size >= 26 {
+				d.format = int(le.Uint16(body[24:]))
+			}
+			haveFmt = true
+
+		case "data":
+			if !haveFmt {
+				return nil, fmt.Errorf("%w: data chunk precedes fmt chunk", audio.ErrMalformed)
+			}
+			if err := d.checkFormat(); err != nil {
+				return nil, err
+			}
+			d.r = r
+			if size != math.MaxUint32 {
+				d.r = io.LimitReader(r, size)
+			}
+			return d, nil
+
+		default:
+			if _, err := io.CopyN(io.Discard, r, size+size&1); err != nil {
+				return nil, wavError(err)
+			}
+		}
+	}
+}
+
+func wavError(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("%w: no data chunk", audio.ErrTruncated)
+	}
+	return err
+}
+
+type wavReader struct {
+	r      io.Reader
+	f      Format
+	format int
+	width  int
+	buf    []byte
+}
+
+func (d *wavReader) checkFormat() error {
+	if d.f.SampleRate <= 0 || d.f.Channels <= 0 {
+		return fmt.Errorf("%w: fmt chunk declares no samples", audio.ErrMalformed)
+	}
+	switch {
+	case d.format == wavFormatPCM && d.width >= 1 && d.width <= 4,
+		d.format == wavFormatFloat && (d.width == 4 || d.width == 8),
+		d.format == wavFormatMuLaw && d.width == 1:
+		return nil
+	}
+	return fmt.Errorf("%w: cannot decode WAV format 0x%04x with %d-byte samples", ErrNoDecoder, d.format, d.width)
+}
+
+func (d *wavReader) Format() Format {
+	return d.f
+}
+
+func (d *wavReader) Read(buf []float64) (int, error) {
+	frameSize := d.width * d.f.Channels
+	frames := len(buf) / d.f.Channels
+	if cap(d.buf) < frames*frameSize {
+		d.buf = make([]byte, frames*frameSize)
+	}
+	n, err := io.ReadFull(d.r, d.buf[:frames*frameSize])
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		// A trailing partial frame is dropped.
+		err = nil
+		if n < frameSize {
+			err = io.EOF
+		}
+	}
+	samples := n / frameSize * d.f.Channels
+	data := d.buf[:samples*d.width]
+	for i := 0; i < samples; i++ {
+		buf[i] = d.sample(data[i*d.width : (i+1)*d.width])
+	}
+	return samples, err
+}
+
+func (d *wavReader) sample(b []byte) float64 {
+	switch d.format {
+	case wavFormatFloat:
+		if len(b) == 4 {
+			return float64(math.Float32frombits(le.Uint32(b)))
+		}
+		return math.Float64frombits(le.Uint64(b))
+	case wavFormatMuLaw:
+		return float64(muLawDecode(b[0])) / 32768
+	}
+	if len(b) == 1 {
+		// 8-bit PCM is unsigned around 128.
+		return float64(int(b[0])-128) / 128
+	}
+	var v int32
+	for i := len(b) - 1; i >= 0; i-- {
+		v = v<<8 | int32(b[i])
+	}
+	bits := uint(len(b) * 8)
+	v = v << (32 - bits) >> (32 - bits) // sign-extend
+	return float64(v) / float64(int64(1)<<(bits-1))
+}
+
+// Encoding is the sample format of a written WAV file.
+type Encoding int
+
+const (
+	// PCM16 is 16-bit signed integer PCM.
+	PCM16 Encoding = iota
+	// MuLaw is 8-bit G.711 µ-law, which keeps about 14 bits of dynamic
+	// range at half the size of PCM16. Telephone and public address
+	// equipment commonly plays it at 8 kHz.
+	MuLaw
+)
+
+// Codec names an encoding in the terms of audio.Info.
+func (e Encoding) Codec() string {
+	if e == MuLaw {
+		return "pcm_mulaw"
+	}
+	return "pcm_s16le"
+}
+
+func (e Encoding) bytesPerSample() int {
+	if e == MuLaw {
+		return 1
+	}
+	return 2
+}
+
+// Bitrate returns the bitrate of audio of format f in this encoding, in
+// bits per second.
+func (e Encoding) Bitrate(f Format) int {
+	return f.SampleRate * f.Channels * e.bytesPerSample() * 8
+}
+
+// WAVWriter writes a WAV file. The lengths in its header are filled in by
+// Close, so the file must be seekable.
+type WAVWriter struct {
+	w      io.WriteSeeker
+	f      Format
+	enc    Encoding
+	header int64
+	size   int64
+	buf    []byte
+}
+
+// NewWAVWriter writes the header of a WAV file of audio of format f to w.
+func NewWAVWriter(w io.WriteSeeker, f Format, enc Encoding) (*WAVWriter, error) {
+	ww := &WAVWriter{w: w, f: f, enc: enc}
+	header := ww.headerBytes(0)
+	ww.header = int64(len(header))
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+	return ww, nil
+}
+
+func (w *WAVWriter) headerBytes(dataSize int64) []byte {
+	width := w.enc.bytesPerSample()
+	format, fmtSize := wavFormatPCM, 16
+	if w.enc == MuLaw {
+		// Formats other than PCM need the cbSize field and a fact chunk.
+		format, fmtSize = wavFormatMuLaw, 18
+	}
+	var h []byte
+	h = append(h, "RIFF"...)
+	h = le.AppendUint32(h, 0) // filled in below
+	h = append(h, "WAVE"...)
+	h = append(h, "fmt "...)
+	h = le.AppendUint32(h, uint32(fmtSize))
+	h = le.AppendUint16(h, uint16(format))
+	h = le.AppendUint16(h, uint16(w.f.Channels))
+	h = le.AppendUint32(h, uint32(w.f.SampleRate))
+	h = le.AppendUint32(h, uint32(w.f.SampleRate*w.f.Channels*width))
+	h = le.AppendUint16(h, uint16(w.f.Channels*width))
+	h = le.AppendUint16(h, uint16(width*8))
+	if w.enc == MuLaw {
+		h = le.AppendUint16(h, 0)
+		h = append(h, "fact"...)
+		h = le.AppendUint32(h, 4)
+		h = le.AppendUint32(h, uint32(dataSize/int64(w.f.Channels)))
+	}
+	h = append(h, "data"...)
+	h = le.AppendUint32(h, uint32(dataSize))
+	le.PutUint32(h[4:], uint32(int64(len(h))-8+dataSize+dataSize&1))
+	return h
+}
+
+func (w *WAVWriter) Write(samples []float64) error {
+	width := w.enc.bytesPerSample()
+	if cap(w.buf) < len(samples)*width {
+		w.buf = make([]byte, len(samples)*width)
+	}
+	out := w.buf[:len(samples)*width]
+	for i, v := range samples {
+		s := toInt16(v)
+		if w.enc == MuLaw {
+			out[i] = muLawEncode(s)
+		} else {
+			le.PutUint16(out[i*2:], uint16(s))
+		}
+	}
+	n, err := w.w.Write(out)
+	w.size += int64(n)
+	return err
+}
+
+// Close pads the data chunk to an even length and writes the final header.
+// It does not close the underlying writer.
+func (w *WAVWriter) Close() error {
+	if w.size&1 == 1 {
+		if _, err := w.w.Write([]byte{0}); err != nil {
+			return err
+		}
+	}
+	if _, err := w.w.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(w.headerBytes(w.size)); err != nil {
+		return err
+	}
+	_, err := w.w.Seek(0, io.SeekEnd)
+	return err
+}
+
+// Size returns the size of the file once closed.
+func (w *WAVWriter) Size() int64 {
+	return w.header + w.size + w.size&1
+}
+
+func toInt16(v float64) int16 {
+	v = math.Round(v * 32767)
+	if v > math.MaxInt16 {
+		return math.MaxInt16
+	}
+	if v < math.MinInt16 {
+		return math.MinInt16
+	}
+	return int16(v)
+}
+
+const (
+	muLawBias = 0x84
+	muLawClip = 32635
+)
+
+// muLawEncode compresses a sample as in G.711.
+func muLawEncode(s int16) byte {
+	v := int(s)
+	var sign byte
+	if v < 0 {
+		sign = 0x80
+		v = -v
+	}
+	if v > muLawClip {
+		v = muLawClip
+	}
+	v += muLawBias
+	exponent := 7
+	for mask := 0x4000; v&mask == 0 && exponent > 0; mask >>= 1 {
+		exponent--
+	}
+	mantissa := (v >> (exponent + 3)) & 0x0F
+	return ^(sign | byte(exponent<<4) | byte(mantissa))
+}
+
+func muLawDecode(b byte) int16 {
+	b = ^b
+	exponent := int(b>>4) & 0x07
+	v := ((int(b&0x0F) << 3) + muLawBias) << exponent
+	v -= muLawBias
+	if b&0x80 != 0 {
+		return int16(-v)
+	}
+	return int16(v)
+}
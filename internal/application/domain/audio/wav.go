@@ -0,0 +1,161 @@
+package audio
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+)
+
+const (
+	wavFormatPCM        = 0x0001
+	wavFormatFloat      = 0x0003
+	wavFormatALaw       = 0x0006
+	wavFormatMuLaw      = 0x0007
+	wavFormatExtensible = 0xFFFE
+)
+
+// silenceThreshold is the peak amplitude, relative to full scale, below
+// which PCM audio counts as silent: -60 dBFS.
+const silenceThreshold = 0.001
+
+func probeWAV(r io.ReaderAt, size int64) (*Info, error) {
+	header, err := readAt(r, 0, 12)
+	if err != nil {
+		return nil, err
+	}
+	if string(header[8:12]) != "WAVE" {
+		return nil, ErrUnsupportedFormat
+	}
+
+	var (
+		format, channels, bitsPerSample int
+		sampleRate, byteRate            int
+		haveFmt                         bool
+	)
+	for off := int64(12); off+8 <= size; {
+		chunk, err := readAt(r, off, 8)
+		if err != nil {
+			return nil, err
+		}
+		id := string(chunk[:4])
+		chunkSize := int64(le.Uint32(chunk[4:]))
+		body := off + 8
+
+		switch id {
+		case "fmt ":
+			if chunkSize < 16 {
+				return nil, fmt.Errorf("%w: fmt chunk is %d bytes", ErrMalformed, chunkSize)
+			}
+			fmtChunk, err := readAt(r, body, int(min(chunkSize, 40)))
+			if err != nil {
+				return nil, err
+			}
+			format = int(le.Uint16(fmtChunk[0:]))
+			channels = int(le.Uint16(fmtChunk[2:]))
+			sampleRate = int(le.Uint32(fmtChunk[4:]))
+			byteRate = int(le.Uint32(fmtChunk[8:]))
+			bitsPerSample = int(le.Uint16(fmtChunk[14:]))
+			if format == wavFormatExtensible && len(fmtChunk) >= 26 {
+				// The sub-format GUID starts with the format code.
+				format = int(le.Uint16(fmtChunk[24:]))
+			}
+			haveFmt = true
+
+		case "data":
+			if !haveFmt {
+				return nil, fmt.Errorf("%w: data chunk precedes fmt chunk", ErrMalformed)
+			}
+			if chunkSize == math.MaxUint32 {
+				// Written by a streaming encoder that never went back to
+				// fill in the length.
+				chunkSize = size - body
+			}
+			if body+chunkSize > size {
+				return nil, fmt.Errorf("%w: data chunk declares %d bytes but only %d are present", ErrTruncated, chunkSize, size-body)
+			}
+			if sampleRate <= 0 || channels <= 0 || byteRate <= 0 {
+				return nil, fmt.Errorf("%w: fmt chunk declares no samples", ErrMalformed)
+			}
+			info := &Info{
+				Format:     FormatWAV,
+				Codec:      wavCodec(format, bitsPerSample),
+				Duration:   durationOf(uint64(chunkSize), byteRate),
+				SampleRate: sampleRate,
+				Channels:   channels,
+				Bitrate:    byteRate * 8,
+			}
+			if format == wavFormatPCM || format == wavFormatFloat {
+				silent, err := pcmSilent(io.NewSectionReader(r, body, chunkSize), format, bitsPerSample)
+				if err != nil {
+					return nil, err
+				}
+				info.Silent = silent
+			}
+			return info, nil
+		}
+		// Chunks are padded to an even length.
+		off = body + chunkSize + chunkSize&1
+	}
+	return nil, fmt.Errorf("%w: no data chunk", ErrTruncated)
+}
+
+func wavCodec(format, bitsPerSample int) string {
+	switch format {
+	case wavFormatPCM:
+		return fmt.Sprintf("pcm_s%dle", bitsPerSample)
+	case wavFormatFloat:
+		return fmt.Sprintf("pcm_f%dle", bitsPerSample)
+	case wavFormatALaw:
+		return "pcm_alaw"
+	case wavFormatMuLaw:
+		return "pcm_mulaw"
+	}
+	return fmt.Sprintf("wav_0x%04x", format)
+}
+
+// pcmSilent reports whether no sample of the little-endian PCM in r reaches
+// silenceThreshold.
+func pcmSilent(r io.Reader, format, bitsPerSample int) (bool, error) {
+	width := (bitsPerSample + 7) / 8
+	if width < 1 || width > 8 {
+		return false, nil
+	}
+	br := bufio.NewReaderSize(r, 64<<10)
+	sample := make([]byte, width)
+	for {
+		if _, err := io.ReadFull(br, sample); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return true, nil
+			}
+			return false, err
+		}
+		if pcmLevel(sample, format) >= silenceThreshold {
+			return false, nil
+		}
+	}
+}
+
+// pcmLevel returns the magnitude of a sample relative to full scale.
+func pcmLevel(sample []byte, format int) float64 {
+	if format == wavFormatFloat {
+		switch len(sample) {
+		case 4:
+			return math.Abs(float64(math.Float32frombits(le.Uint32(sample))))
+		case 8:
+			return math.Abs(math.Float64frombits(le.Uint64(sample)))
+		}
+		return 1
+	}
+	if len(sample) == 1 {
+		// 8-bit PCM is unsigned around 128.
+		return math.Abs(float64(int(sample[0])-128)) / 128
+	}
+	var v int64
+	for i := len(sample) - 1; i >= 0; i-- {
+		v = v<<8 | int64(sample[i])
+	}
+	bits := uint(len(sample) * 8)
+	v = v << (64 - bits) >> (64 - bits) // sign-extend
+	return math.Abs(float64(v)) / float64(int64(1)<<(bits-1))
+}
@@ -6,9 +6,108 @@ import (
 )
 
 type Adhan struct {
-	ID        uuid.UUID `gorm:"primaryKey;type:char(36)"`
-	MasjidId  string    `gorm:"type:varchar(320)"`
-	File      []byte
+	ID       uuid.UUID `gorm:"primaryKey;type:char(36)"`
+	MasjidId string    `gorm:"type:varchar(320);index"`
+	Title    string    `gorm:"type:varchar(255);not null;default:''"`
+	Muezzin  string    `gorm:"type:varchar(255);not null;default:''"`
+	// StorageKey locates the audio in the blob store. It is empty for rows
+	// whose audio is still in the legacy file column; see
+	// cmd/migrate_adhan_blobs.
+	StorageKey string `gorm:"type:varchar(255);not null;default:''"`
+	// Sha256 is the lowercase hex SHA-256 of the audio, and names the
+	// AdhanBlob holding it. It is empty for audio stored before content was
+	// hashed, which is kept under a key of its own.
+	Sha256      string `gorm:"type:char(64);not null;default:'';index"`
+	Size        int64  `gorm:"not null;default:0"`
+	ContentType string `gorm:"type:varchar(100);not null;default:''"`
+	// Audio metadata read from the file on upload.
+	Codec      string `gorm:"type:varchar(32);not null;default:''"`
+	DurationMs int64  `gorm:"not null;default:0"`
+	SampleRate int32  `gorm:"not null;default:0"`
+	Channels   int32  `gorm:"not null;default:0"`
+	Bitrate    int32  `gorm:"not null;default:0"`
+	// Set by the background processing that follows each upload.
+	ProcessingStatus AdhanProcessingStatus `gorm:"not null;default:0;index"`
+	ProcessingError  string                `gorm:"type:text;not null;default:''"`
+	// LoudnessLufs is the integrated loudness of the uploaded audio. It is
+	// nil until processed, or if the audio is too quiet to measure.
+	LoudnessLufs *float64
+	Renditions   []AdhanRendition `gorm:"foreignKey:AdhanId"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type AdhanProcessingStatus int
+
+const (
+	// AdhanProcessingUnspecified marks adhans uploaded before processing
+	// was introduced.
+	AdhanProcessingUnspecified AdhanProcessingStatus = iota
+	AdhanProcessingPending
+	AdhanProcessingRunning
+	AdhanProcessingReady
+	AdhanProcessingFailed
+	// AdhanProcessingSkipped means the audio is in a format that cannot be
+	// decoded, so only the original is available.
+	AdhanProcessingSkipped
+)
+
+// AdhanRendition is a loudness-normalised copy of an adhan's audio, stored
+// in the blob store alongside the original.
+type AdhanRendition struct {
+	AdhanId      uuid.UUID `gorm:"primaryKey;type:char(36)"`
+	Name         string    `gorm:"primaryKey;type:varchar(32)"`
+	StorageKey   string    `gorm:"type:varchar(255);not null"`
+	Sha256       string    `gorm:"type:char(64);not null;default:''"`
+	Size         int64     `gorm:"not null;default:0"`
+	ContentType  string    `gorm:"type:varchar(100);not null;default:''"`
+	Codec        string    `gorm:"type:varchar(32);not null;default:''"`
+	DurationMs   int64     `gorm:"not null;default:0"`
+	SampleRate   int32     `gorm:"not null;default:0"`
+	Channels     int32     `gorm:"not null;default:0"`
+	Bitrate      int32     `gorm:"not null;default:0"`
+	LoudnessLufs *float64
+	CreatedAt    time.Time
+}
+
+// AdhanBlob is audio in the blob store, stored once however many adhans
+// and renditions share it. RefCount counts the Adhan and AdhanRendition
+// rows whose Sha256 names it; the blob is deleted when it reaches zero.
+type AdhanBlob struct {
+	Sha256     string `gorm:"primaryKey;type:char(64)"`
+	StorageKey string `gorm:"type:varchar(255);not null"`
+	Size       int64  `gorm:"not null;default:0"`
+	RefCount   int64  `gorm:"not null;default:0"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Rendition returns the rendition with the given name, or nil.
+func (a *Adhan) Rendition(name string) *AdhanRendition {
+	for i := range a.Renditions {
+		if a.Renditions[i].Name == name {
+			return &a.Renditions[i]
+		}
+	}
+	return nil
+}
+
+// AdhanAssignment picks the adhan a masjid plays for one prayer. An
+// assignment for PRAYER_UNSPECIFIED is the masjid's default for Dhuhr
+// through Isha. Fajr never falls back to it, since the Fajr adhan adds
+// "as-salatu khayrun min an-nawm".
+type AdhanAssignment struct {
+	MasjidId  string    `gorm:"primaryKey;type:char(36)"`
+	Prayer    Prayer    `sql:"type:ENUM('PRAYER_UNSPECIFIED','FAJR','DHUHR','ASR','MAGHRIB','ISHA')" gorm:"primaryKey;column:prayer"`
+	AdhanId   uuid.UUID `gorm:"type:char(36);index"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// ResolvedAdhanAssignment is the adhan played for a prayer after falling
+// back to the default. Adhan is nil when nothing is assigned.
+type ResolvedAdhanAssignment struct {
+	Prayer    Prayer
+	Adhan     *Adhan
+	Inherited bool
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CalendarFeed is an iCalendar feed of a masjid's events, or of the events
+// a user has RSVPed to, served to anyone holding its token. A masjid or
+// user has at most one; resetting it gives it a new token.
+type CalendarFeed struct {
+	ID        uuid.UUID  `gorm:"primaryKey;type:char(36)"`
+	Token     string     `gorm:"type:varchar(64);not null;uniqueIndex"`
+	MasjidId  string     `gorm:"uniqueIndex:idx_calendar_feeds_masjid,where:masjid_id <> ''"`
+	UserId    *uuid.UUID `gorm:"type:char(36);uniqueIndex"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
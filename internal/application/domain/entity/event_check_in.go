@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mnadev/limestone/internal/application/domain/recurrence"
+)
+
+// EventCheckIn records an attendee's arrival at an event, or at one
+// occurrence of a recurring event, on the ticket of their RSVP. An RSVP is
+// checked in at most once to each occurrence.
+type EventCheckIn struct {
+	ID     uuid.UUID `gorm:"primaryKey;type:char(36)"`
+	RsvpId uuid.UUID `gorm:"type:char(36);not null;uniqueIndex:idx_event_check_ins_rsvp_occurrence"`
+	// EventId is the event the RSVP is for, the series for a recurring
+	// event. Occurrence is the key OccurrenceKey gives the occurrence
+	// checked in to, and empty for an event that does not recur.
+	EventId     uuid.UUID  `gorm:"type:char(36);not null;index:idx_event_check_ins_event_occurrence"`
+	Occurrence  string     `gorm:"type:varchar(16);not null;default:'';uniqueIndex:idx_event_check_ins_rsvp_occurrence;index:idx_event_check_ins_event_occurrence"`
+	UserId      uuid.UUID  `gorm:"type:char(36);not null"`
+	CheckedInBy uuid.UUID  `gorm:"type:char(36)"`
+	CheckedInAt time.Time  `gorm:"not null"`
+	Rsvp        *EventRsvp `gorm:"foreignKey:RsvpId;constraint:OnDelete:CASCADE"`
+	User        *User      `gorm:"foreignKey:UserId;constraint:OnDelete:CASCADE"`
+}
+
+// Attendance counts the confirmed RSVPs for an event and how many of them
+// have checked in.
+type Attendance struct {
+	Confirmed int64
+	CheckedIn int64
+}
+
+// RsvpEventId returns the ID of the event RSVPs to e are for, and the key
+// of the occurrence e is, empty when e does not recur. RSVPs to a
+// recurring event are for the whole series.
+func (e *Event) RsvpEventId() (uuid.UUID, string) {
+	if e.RecurringEventId != nil && e.OriginalStartTime != nil {
+		return *e.RecurringEventId, OccurrenceKey(*e.OriginalStartTime)
+	}
+	return e.ID, ""
+}
+
+// OccurrenceKey identifies the occurrence of a series originally starting
+// at start.
+func OccurrenceKey(start time.Time) string {
+	return recurrence.FormatTime(start)
+}
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TicketType is a kind of ticket an event sells, such as adult, child or
+// family, each admitting its buyer's RSVP.
+type TicketType struct {
+	ID      uuid.UUID `gorm:"primaryKey;type:char(36)"`
+	EventId uuid.UUID `gorm:"type:char(36);not null;index"`
+	Name    string    `gorm:"not null"`
+	// Price is in the smallest unit of Currency, e.g. cents. Currency is an
+	// ISO 4217 code, the same for every ticket type of an event.
+	Price    int64  `gorm:"not null"`
+	Currency string `gorm:"type:char(3);not null"`
+	// Quota is how many tickets of the type may be sold, without limit
+	// when 0.
+	Quota     int32 `gorm:"not null;default:0"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// Sold counts the tickets paid for or held by pending orders. It is
+	// derived on read and not stored.
+	Sold int64 `gorm:"-"`
+}
+
+// Remaining returns how many more tickets of the type may be sold, or -1
+// if they are not limited.
+func (t *TicketType) Remaining() int64 {
+	if t.Quota <= 0 {
+		return -1
+	}
+	return max(0, int64(t.Quota)-t.Sold)
+}
+
+type OrderStatus int
+
+const (
+	OrderStatusUnspecified OrderStatus = iota
+	// OrderPending awaits payment, holding its tickets until ExpiresAt.
+	OrderPending
+	// OrderPaid has confirmed the buyer's RSVP.
+	OrderPaid
+	// OrderCancelled ended unpaid.
+	OrderCancelled
+	// OrderRefundPending has been refunded with the provider, which has
+	// yet to confirm it. Its tickets are released.
+	OrderRefundPending
+	OrderRefunded
+)
+
+// Order is a purchase of tickets for an event. Paying for it confirms the
+// buyer's RSVP; refunding their last paid order cancels it. Orders are
+// kept when their event is deleted, as a record of the payments.
+type Order struct {
+	ID      uuid.UUID   `gorm:"primaryKey;type:char(36)"`
+	EventId uuid.UUID   `gorm:"type:char(36);not null;index"`
+	UserId  uuid.UUID   `gorm:"type:char(36);not null;index"`
+	Status  OrderStatus `gorm:"not null;default:0"`
+	// Total is in the smallest unit of Currency.
+	Total    int64  `gorm:"not null"`
+	Currency string `gorm:"type:char(3);not null"`
+	// Provider is the name of the payment provider taking payment, empty
+	// for free orders. CheckoutId identifies the buyer's checkout with it,
+	// and PaymentId the payment once made.
+	Provider    string
+	CheckoutId  string `gorm:"index"`
+	CheckoutURL string
+	PaymentId   string `gorm:"index"`
+	// RsvpId is set once the order is paid.
+	RsvpId    *uuid.UUID `gorm:"type:char(36)"`
+	ExpiresAt time.Time  `gorm:"not null"`
+	PaidAt    *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Items     []OrderItem `gorm:"foreignKey:OrderId;constraint:OnDelete:CASCADE"`
+}
+
+// OrderItem is a number of tickets of one type in an order, at the name
+// and price the type had when it was ordered.
+type OrderItem struct {
+	ID           uuid.UUID `gorm:"primaryKey;type:char(36)"`
+	OrderId      uuid.UUID `gorm:"type:char(36);not null;index"`
+	TicketTypeId uuid.UUID `gorm:"type:char(36);not null;index"`
+	Name         string    `gorm:"not null"`
+	Quantity     int32     `gorm:"not null"`
+	UnitPrice    int64     `gorm:"not null"`
+}
+
+// HoldsTickets reports whether the order counts against the quotas of its
+// ticket types at now.
+func (o *Order) HoldsTickets(now time.Time) bool {
+	return o.Status == OrderPaid || o.Status == OrderPending && now.Before(o.ExpiresAt)
+}
+
+// Unfilled reports whether the order was paid for after its tickets or its
+// buyer's place had gone, so that its payment is being refunded instead of
+// confirming an RSVP.
+func (o *Order) Unfilled() bool {
+	return o.Status == OrderRefundPending && o.RsvpId == nil
+}
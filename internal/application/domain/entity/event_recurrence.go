@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mnadev/limestone/internal/application/domain/recurrence"
+)
+
+// RecurrenceScope says which occurrences of a recurring event a change to
+// one of them applies to.
+type RecurrenceScope int
+
+const (
+	ThisEvent RecurrenceScope = iota
+	ThisAndFollowing
+)
+
+// IsRecurring reports whether e is the first occurrence of a series.
+func (e *Event) IsRecurring() bool {
+	return e.Recurrence != ""
+}
+
+// IsOccurrence reports whether e was expanded from a series rather than
+// stored. Such occurrences share the ID of their series.
+func (e *Event) IsOccurrence() bool {
+	return e.RecurringEventId != nil && *e.RecurringEventId == e.ID
+}
+
+// RecurrenceLines returns the lines of e.Recurrence.
+func (e *Event) RecurrenceLines() []string {
+	if e.Recurrence == "" {
+		return nil
+	}
+	return strings.Split(e.Recurrence, "\n")
+}
+
+// PublicID returns the ID clients know e by: its own, or for an occurrence
+// expanded from a series, one made of the series ID and its start.
+func (e *Event) PublicID() string {
+	if e.IsOccurrence() {
+		return OccurrenceID(e.ID, *e.OriginalStartTime)
+	}
+	return e.ID.String()
+}
+
+// Occurrence returns the occurrence of series e starting at start, with
+// the bookings of the series for it.
+func (e *Event) Occurrence(start time.Time) *Event {
+	seriesID := e.ID
+	occurrence := *e
+	occurrence.Types = slices.Clone(e.Types)
+	occurrence.Bookings = nil
+	for _, booking := range e.Bookings {
+		if booking.StartTime.Equal(start) {
+			occurrence.Bookings = append(occurrence.Bookings, booking)
+		}
+	}
+	occurrence.StartTime = start
+	occurrence.EndTime = start.Add(e.EndTime.Sub(e.StartTime))
+	occurrence.Recurrence = ""
+	occurrence.SeriesEnd = nil
+	occurrence.RecurringEventId = &seriesID
+	occurrence.OriginalStartTime = &start
+	occurrence.ScheduleKey = nil
+	return &occurrence
+}
+
+// OccurrenceID returns the ID of the occurrence of a series starting at
+// start.
+func OccurrenceID(seriesID uuid.UUID, start time.Time) string {
+	return seriesID.String() + "_" + recurrence.FormatTime(start)
+}
+
+// ParseOccurrenceID splits an ID made by OccurrenceID into the series ID
+// and start, reporting whether id is one.
+func ParseOccurrenceID(id string) (uuid.UUID, time.Time, bool) {
+	series, start, ok := strings.Cut(id, "_")
+	if !ok {
+		return uuid.Nil, time.Time{}, false
+	}
+	seriesID, err := uuid.Parse(series)
+	if err != nil {
+		return uuid.Nil, time.Time{}, false
+	}
+	t, err := recurrence.ParseTime(start)
+	if err != nil {
+		return uuid.Nil, time.Time{}, false
+	}
+	return seriesID, t, true
+}
@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RsvpStatus int
+
+const (
+	RsvpStatusUnspecified RsvpStatus = iota
+	// RsvpConfirmed holds one of the event's places.
+	RsvpConfirmed
+	// RsvpWaitlisted is confirmed in turn as places are freed.
+	RsvpWaitlisted
+	RsvpCancelled
+)
+
+// EventRsvp records a user's registration for an event. A user has at most
+// one per event: cancelling keeps it, and registering again renews it at
+// the back of the waitlist if the event is full.
+type EventRsvp struct {
+	ID      uuid.UUID  `gorm:"primaryKey;type:char(36)"`
+	EventId uuid.UUID  `gorm:"type:char(36);not null;uniqueIndex:idx_event_rsvps_event_user"`
+	UserId  uuid.UUID  `gorm:"type:char(36);not null;uniqueIndex:idx_event_rsvps_event_user;index"`
+	Status  RsvpStatus `gorm:"not null;default:0"`
+	// RequestedAt orders the waitlist.
+	RequestedAt time.Time `gorm:"not null"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	// WaitlistPosition counts from 1 for waitlisted RSVPs. It is derived on
+	// read and not stored.
+	WaitlistPosition int `gorm:"-"`
+	// Ticket is the signed ticket of a confirmed RSVP, set for its user.
+	// It is derived on read and not stored.
+	Ticket string `gorm:"-"`
+	Event  *Event `gorm:"foreignKey:EventId;constraint:OnDelete:CASCADE"`
+	User   *User  `gorm:"foreignKey:UserId;constraint:OnDelete:CASCADE"`
+}
+
+// PlacesLeft returns how many more RSVPs the event can confirm when
+// confirmed are already, or -1 if its places are not limited.
+func (e *Event) PlacesLeft(confirmed int64) int64 {
+	if e.MaxParticipants <= 0 {
+		return -1
+	}
+	return max(0, int64(e.MaxParticipants)-confirmed)
+}
+
+// AdmitsGender reports whether someone of the given gender may attend the
+// event.
+func (e *Event) AdmitsGender(g Gender) bool {
+	switch e.GenderRestriction {
+	case MALE_ONLY:
+		return g == Male
+	case FEMALE_ONLY:
+		return g == Female
+	}
+	return true
+}
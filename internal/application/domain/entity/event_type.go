@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// EventType gives an event one of its types, such as "EDUCATIONAL". Types
+// have a table of their own so that events can be filtered by type with an
+// index.
+type EventType struct {
+	EventId uuid.UUID `gorm:"primaryKey;type:char(36)"`
+	Type    string    `gorm:"primaryKey;index"`
+}
+
+// TypeNames returns the names of e's types.
+func (e *Event) TypeNames() []string {
+	names := make([]string, 0, len(e.Types))
+	for _, t := range e.Types {
+		names = append(names, t.Type)
+	}
+	return names
+}
+
+// SetTypes gives e the types named, leaving out blanks and repeats.
+func (e *Event) SetTypes(names ...string) {
+	e.Types = nil
+	for _, name := range names {
+		if name == "" || slices.Contains(e.TypeNames(), name) {
+			continue
+		}
+		e.Types = append(e.Types, EventType{EventId: e.ID, Type: name})
+	}
+}
+
+// Matches reports whether e passes the type, gender, payment, RSVP and
+// livestream filters of p.
+func (p *ListEventsQueryParams) Matches(e *Event) bool {
+	for _, group := range p.TypeGroups {
+		if !slices.ContainsFunc(group, func(name string) bool { return slices.Contains(e.TypeNames(), name) }) {
+			return false
+		}
+	}
+	switch {
+	case p.GenderRestriction != nil && e.GenderRestriction != *p.GenderRestriction,
+		p.IsPaid != nil && e.IsPaid != *p.IsPaid,
+		p.RequiresRsvp != nil && e.RequiresRsvp != *p.RequiresRsvp,
+		p.HasLivestream != nil && (e.LivestreamLink != "") != *p.HasLivestream:
+		return false
+	}
+	return true
+}
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/hijri"
 )
 
 type GenderRestriction int64
@@ -28,13 +29,80 @@ type Event struct {
 	StartTime         time.Time
 	EndTime           time.Time
 	GenderRestriction GenderRestriction `sql:"type:ENUM('NO_RESTRICTION','MALE_ONLY','FEMALE_ONLY')" gorm:"column:gender_restriction"`
-	EventTypes        string
+	Types             []EventType       `gorm:"foreignKey:EventId;constraint:OnDelete:CASCADE"`
 	IsPaid            bool
 	RequiresRsvp      bool
 	MaxParticipants   int32
 	LivestreamLink    string
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
+	// HijriStart and HijriEnd are the Hijri dates of StartTime and EndTime
+	// in the masjid's time zone. They are derived on read and not stored.
+	HijriStart hijri.Date `gorm:"-"`
+	HijriEnd   hijri.Date `gorm:"-"`
+	// Recurrence holds the RRULE, RDATE and EXDATE lines of a recurring
+	// event, one per line. SeriesEnd is the latest start of a series that
+	// ends and is nil for one that does not.
+	Recurrence string     `gorm:"not null;default:''"`
+	SeriesEnd  *time.Time `gorm:"index"`
+	// RecurringEventId and OriginalStartTime are set on an exception, an
+	// occurrence of a recurring event that has been edited on its own, and
+	// on occurrences expanded from a series.
+	RecurringEventId  *uuid.UUID `gorm:"type:char(36);index"`
+	OriginalStartTime *time.Time
+	// TicketTypes are the tickets the event sells. A recurring event sells
+	// them for the whole series.
+	TicketTypes []TicketType `gorm:"foreignKey:EventId;constraint:OnDelete:CASCADE"`
+	// Bookings hold the rooms the event is held in for its times. A
+	// recurring event holds one for each room and occurrence booked.
+	Bookings []RoomBooking `gorm:"foreignKey:EventId;constraint:OnDelete:CASCADE"`
+	// Attendance is derived on read for events that require RSVP and is
+	// not stored. It is nil when it has not been counted.
+	Attendance *Attendance `gorm:"-"`
+	// ScheduleKey identifies an event created from a masjid's schedule,
+	// such as its iftar on a given day, so that it is never created twice.
+	// It is nil for events created otherwise.
+	ScheduleKey *string `gorm:"uniqueIndex"`
+}
+
+type ListEventsQueryParams struct {
+	PageSize  int32
+	PageToken string
+	MasjidId  string
+	// HijriYear and HijriMonth restrict the result to events starting in a
+	// Hijri year or month. The service turns them into StartFrom and
+	// StartBefore.
+	HijriYear   int
+	HijriMonth  int
+	StartFrom   time.Time
+	StartBefore time.Time
+	// TypeGroups restricts the result to events having a type from each
+	// group. The filters below apply when they are not nil.
+	TypeGroups        [][]string
+	GenderRestriction *GenderRestriction
+	IsPaid            *bool
+	RequiresRsvp      *bool
+	HasLivestream     *bool
+	// OrderBy orders the result, by ID when empty. Pages are then found by
+	// Offset rather than PageToken.
+	OrderBy []EventOrder
+	Offset  int
+}
+
+// EventOrderField is a field events can be ordered by.
+type EventOrderField string
+
+const (
+	OrderByStartTime  EventOrderField = "start_time"
+	OrderByEndTime    EventOrderField = "end_time"
+	OrderByName       EventOrderField = "name"
+	OrderByCreateTime EventOrderField = "create_time"
+)
+
+// EventOrder is one key of the order events are listed in.
+type EventOrder struct {
+	Field EventOrderField
+	Desc  bool
 }
 
 func NewEvent(ep *pb.Event) (*Event, error) {
@@ -49,6 +117,7 @@ func NewEvent(ep *pb.Event) (*Event, error) {
 		RequiresRsvp:      ep.GetRequiresRsvp(),
 		MaxParticipants:   ep.GetMaxParticipants(),
 		LivestreamLink:    ep.GetLivestreamLink(),
+		Recurrence:        strings.Join(ep.GetRecurrence(), "\n"),
 	}
 
 	types := []string{}
@@ -57,14 +126,14 @@ func NewEvent(ep *pb.Event) (*Event, error) {
 		types = append(types, FromProtoToInternalEventType(t))
 	}
 
-	e.EventTypes = strings.Join(types, ",")
+	e.SetTypes(types...)
 
 	return &e, status.Error(codes.OK, codes.OK.String())
 }
 
 func (e *Event) ToProto() *pb.Event {
 	ep := pb.Event{
-		Id:                e.ID.String(),
+		Id:                e.PublicID(),
 		MasjidId:          e.MasjidId,
 		Name:              e.Name,
 		Description:       e.Description,
@@ -77,11 +146,12 @@ func (e *Event) ToProto() *pb.Event {
 		LivestreamLink:    e.LivestreamLink,
 		CreateTime:        timestamppb.New(e.CreatedAt),
 		UpdateTime:        timestamppb.New(e.UpdatedAt),
+		Recurrence:        e.RecurrenceLines(),
+		RoomIds:           e.RoomIds(),
 	}
 
-	types := e.EventTypes
 	typespb := []pb.Event_EventType{}
-	for _, t := range strings.Split(types, ",") {
+	for _, t := range e.TypeNames() {
 		typespb = append(typespb, FromInternalToProtoEvent(t))
 	}
 	ep.Types = typespb
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Prayer int64
+
+const (
+	PRAYER_UNSPECIFIED Prayer = iota
+	FAJR
+	DHUHR
+	ASR
+	MAGHRIB
+	ISHA
+)
+
+func (p Prayer) String() string {
+	switch p {
+	case FAJR:
+		return "FAJR"
+	case DHUHR:
+		return "DHUHR"
+	case ASR:
+		return "ASR"
+	case MAGHRIB:
+		return "MAGHRIB"
+	case ISHA:
+		return "ISHA"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+type IqamahRuleType int64
+
+const (
+	FIXED_TIME IqamahRuleType = iota
+	MINUTES_AFTER_ADHAN
+)
+
+// IqamahRule sets when the congregation prays one prayer at a masjid over an
+// inclusive range of dates. StartDate and EndDate are YYYY-MM-DD strings; an
+// empty bound leaves that side of the range open.
+type IqamahRule struct {
+	ID                uuid.UUID      `gorm:"primaryKey;type:char(36)"`
+	MasjidId          string         `gorm:"type:char(36);index"`
+	Prayer            Prayer         `sql:"type:ENUM('PRAYER_UNSPECIFIED','FAJR','DHUHR','ASR','MAGHRIB','ISHA')" gorm:"column:prayer"`
+	Type              IqamahRuleType `sql:"type:ENUM('FIXED_TIME','MINUTES_AFTER_ADHAN')" gorm:"column:type"`
+	FixedTime         string         `gorm:"type:varchar(5)"`
+	MinutesAfterAdhan int32          `gorm:"default:0"`
+	StartDate         string         `gorm:"type:varchar(10)"`
+	EndDate           string         `gorm:"type:varchar(10)"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// AppliesOn reports whether the rule covers the given YYYY-MM-DD date.
+func (r *IqamahRule) AppliesOn(date string) bool {
+	return (r.StartDate == "" || r.StartDate <= date) && (r.EndDate == "" || date <= r.EndDate)
+}
@@ -14,6 +14,13 @@ type ListMasjidsQueryParams struct {
 	Location string
 }
 
+type SearchNearbyMasjidsQueryParams struct {
+	Latitude  float64
+	Longitude float64
+	RadiusKm  float64
+	Limit     int32
+}
+
 type Address struct {
 	AddressLine1 string
 	AddressLine2 string
@@ -37,6 +44,28 @@ type Masjid struct {
 	Address      Address                  `gorm:"embedded"`
 	PhoneNumber  PhoneNumber              `gorm:"embedded"`
 	PrayerConfig PrayerTimesConfiguration `gorm:"embedded"`
-	CreatedAt    time.Time                `gorm:"default:CURRENT_TIMESTAMP"`
-	UpdatedAt    time.Time                `gorm:"default:CURRENT_TIMESTAMP"`
+	Latitude     float64                  `gorm:"default:0;index:idx_masjids_coordinates"`
+	Longitude    float64                  `gorm:"default:0;index:idx_masjids_coordinates"`
+	TimeZone     string                   `gorm:"type:varchar(64)"`
+	// HijriOffset shifts the Umm al-Qura calendar by up to two days to follow
+	// local moon sighting. It is a pointer so that an update can reset it
+	// to 0.
+	HijriOffset *int32    `gorm:"default:0"`
+	CreatedAt   time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+	UpdatedAt   time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+// NearbyMasjid is a masjid returned by a proximity search together with its
+// great-circle distance from the search point.
+type NearbyMasjid struct {
+	Masjid     Masjid `gorm:"embedded"`
+	DistanceKm float64
+}
+
+// HijriDayOffset returns the masjid's Hijri offset in days.
+func (m *Masjid) HijriDayOffset() int {
+	if m.HijriOffset == nil {
+		return 0
+	}
+	return int(*m.HijriOffset)
 }
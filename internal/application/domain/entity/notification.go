@@ -0,0 +1,136 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationPreference holds how a member wants to hear about the events
+// they have RSVPed to and those of the masjids they follow. Members who
+// have not set theirs get DefaultNotificationPreference.
+type NotificationPreference struct {
+	UserId uuid.UUID `gorm:"primaryKey;type:char(36)"`
+	// Email and Push turn on the channels notifications are sent over.
+	Email bool `gorm:"not null"`
+	Push  bool `gorm:"not null"`
+	// Reminders turns on reminders ReminderMinutes before events start.
+	Reminders       bool  `gorm:"not null"`
+	ReminderMinutes int32 `gorm:"not null"`
+	// Changes turns on notices of events being rescheduled or cancelled.
+	Changes   bool `gorm:"not null"`
+	UpdatedAt time.Time
+}
+
+// DefaultNotificationPreference is the preference of members who have not
+// set their own: everything on, with reminders an hour ahead.
+func DefaultNotificationPreference(userID uuid.UUID) *NotificationPreference {
+	return &NotificationPreference{
+		UserId:          userID,
+		Email:           true,
+		Push:            true,
+		Reminders:       true,
+		ReminderMinutes: 60,
+		Changes:         true,
+	}
+}
+
+// ReminderLead returns how long before events the member is reminded of
+// them.
+func (p *NotificationPreference) ReminderLead() time.Duration {
+	return time.Duration(p.ReminderMinutes) * time.Minute
+}
+
+// MasjidFollow records that a member follows a masjid, hearing about all of
+// its events as if they had RSVPed to them.
+type MasjidFollow struct {
+	UserId    uuid.UUID `gorm:"primaryKey;type:char(36)"`
+	MasjidId  string    `gorm:"primaryKey;type:char(36);index"`
+	CreatedAt time.Time
+}
+
+// PushDevice is a device a member receives push notifications on, known by
+// the registration token its app was given. A token belongs to the member
+// who registered it last.
+type PushDevice struct {
+	Token  string    `gorm:"primaryKey;type:varchar(512)"`
+	UserId uuid.UUID `gorm:"type:char(36);not null;index"`
+	// Platform is the app's platform, e.g. "android" or "ios".
+	Platform  string `gorm:"type:varchar(16)"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type NotificationKind int
+
+const (
+	NotificationKindUnspecified NotificationKind = iota
+	EventReminder
+	EventRescheduled
+	EventCancelled
+)
+
+type NotificationStatus int
+
+const (
+	NotificationPending NotificationStatus = iota
+	NotificationSent
+	// NotificationFailed could not be sent after every attempt.
+	NotificationFailed
+	// NotificationSkipped was not sent, as the member turned off what it
+	// was for or the event started before it could be.
+	NotificationSkipped
+)
+
+// EventNotification is a notification about an event for a member. It is
+// queued when due and sent by the notification scheduler, and kept
+// afterwards so that it is not sent twice.
+type EventNotification struct {
+	ID     uuid.UUID `gorm:"primaryKey;type:char(36)"`
+	UserId uuid.UUID `gorm:"type:char(36);not null;uniqueIndex:idx_event_notifications_user_key"`
+	// Key identifies what the notification is about, so that it is queued
+	// at most once per member.
+	Key  string           `gorm:"type:varchar(160);not null;uniqueIndex:idx_event_notifications_user_key"`
+	Kind NotificationKind `gorm:"not null"`
+	// EventId is the public ID of the event or occurrence, as given by
+	// Event.PublicID.
+	EventId   string `gorm:"type:varchar(64);not null;index"`
+	MasjidId  string `gorm:"type:char(36)"`
+	EventName string
+	StartTime time.Time `gorm:"not null"`
+	// PreviousStartTime is when a rescheduled event started before it was
+	// moved.
+	PreviousStartTime *time.Time
+	Status            NotificationStatus `gorm:"not null;default:0;index"`
+	Attempts          int32              `gorm:"not null;default:0"`
+	SentAt            *time.Time
+	CreatedAt         time.Time
+}
+
+// NewEventNotification returns a notification of the given kind about
+// event for a member, as it is after being rescheduled. The key of a
+// rescheduling names the start it was moved to, so that moving an event
+// again is notified again, and that of a reminder names the start it
+// reminds of, so that a rescheduled event is reminded of again.
+func NewEventNotification(kind NotificationKind, event *Event, userID uuid.UUID, now time.Time) EventNotification {
+	key := event.PublicID()
+	switch kind {
+	case EventReminder:
+		key = "reminder/" + key + "/" + event.StartTime.UTC().Format(time.RFC3339)
+	case EventRescheduled:
+		key = "rescheduled/" + key + "/" + event.StartTime.UTC().Format(time.RFC3339)
+	case EventCancelled:
+		key = "cancelled/" + key
+	}
+	return EventNotification{
+		ID:        uuid.New(),
+		UserId:    userID,
+		Key:       key,
+		Kind:      kind,
+		EventId:   event.PublicID(),
+		MasjidId:  event.MasjidId,
+		EventName: event.Name,
+		StartTime: event.StartTime,
+		CreatedAt: now,
+	}
+}
@@ -49,4 +49,6 @@ type PrayerTimesConfiguration struct {
 	AsrMethod         AsrJuristicMethod `sql:"type:ENUM('SHAFI_HANBALI_MALIKI','HANAFI')" gorm:"column:asr_method"`
 	HighLatitudeRule  HighLatitudeRule  `sql:"type:ENUM('NO_HIGH_LATITUDE_RULE','MIDDLE_OF_THE_NIGHT','SEVENTH_OF_THE_NIGHT','TWILIGHT_ANGLE')" gorm:"column:high_latitude_rule"`
 	Adjustments       PrayerAdjustments `gorm:"embedded"`
+	// SuhoorMargin is how many minutes before Fajr suhoor ends in Ramadan.
+	SuhoorMargin int32 `gorm:"default:0"`
 }
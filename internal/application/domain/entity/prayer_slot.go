@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PrayerSlotType int64
+
+const (
+	JUMUAH PrayerSlotType = iota
+	EID_AL_FITR
+	EID_AL_ADHA
+)
+
+// PrayerSlot is one congregation of a Jumu'ah or Eid prayer at a masjid. A
+// masjid running several Jumu'ah shifts has one slot per shift.
+//
+// StartTime is the local HH:MM time the khutbah starts. Jumu'ah slots repeat
+// every Friday between the inclusive YYYY-MM-DD StartDate and EndDate, either
+// of which may be empty; Eid slots happen once, on Date.
+type PrayerSlot struct {
+	ID               uuid.UUID      `gorm:"primaryKey;type:char(36)"`
+	MasjidId         string         `gorm:"type:char(36);index"`
+	Type             PrayerSlotType `sql:"type:ENUM('JUMUAH','EID_AL_FITR','EID_AL_ADHA')" gorm:"column:type"`
+	StartTime        string         `gorm:"type:varchar(5)"`
+	Date             string         `gorm:"type:varchar(10)"`
+	StartDate        string         `gorm:"type:varchar(10)"`
+	EndDate          string         `gorm:"type:varchar(10)"`
+	KhateebId        string         `gorm:"type:char(36)"`
+	Language         string         `gorm:"type:varchar(64)"`
+	LocationOverride string         `gorm:"type:varchar(255)"`
+	Capacity         int32          `gorm:"default:0"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// AppliesOn reports whether the slot is held on the given YYYY-MM-DD date.
+func (s *PrayerSlot) AppliesOn(date string) bool {
+	if s.Type != JUMUAH {
+		return s.Date == date
+	}
+	return (s.StartDate == "" || s.StartDate <= date) && (s.EndDate == "" || date <= s.EndDate)
+}
+
+type ListPrayerSlotsQueryParams struct {
+	MasjidId string
+	Type     *PrayerSlotType
+}
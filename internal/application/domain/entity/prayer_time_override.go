@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PrayerTimeOverride stores the adhan times a masjid publishes for one day,
+// replacing the calculated ones. Date is YYYY-MM-DD and every time is a local
+// HH:MM clock time in the masjid's time zone.
+type PrayerTimeOverride struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:char(36)"`
+	MasjidId  string    `gorm:"type:char(36);uniqueIndex:idx_prayer_time_overrides_masjid_date"`
+	Date      string    `gorm:"type:varchar(10);uniqueIndex:idx_prayer_time_overrides_masjid_date"`
+	Fajr      string    `gorm:"type:varchar(5)"`
+	Sunrise   string    `gorm:"type:varchar(5)"`
+	Dhuhr     string    `gorm:"type:varchar(5)"`
+	Asr       string    `gorm:"type:varchar(5)"`
+	Maghrib   string    `gorm:"type:varchar(5)"`
+	Isha      string    `gorm:"type:varchar(5)"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RamadanNight schedules the taraweeh and qiyam prayers a masjid holds on one
+// night of Ramadan. As the Islamic day begins at sunset, night N is the night
+// before the Nth fast: taraweeh of night 1 is prayed on the evening before 1
+// Ramadan and there is none on the eve of Eid.
+//
+// TaraweehTime and QiyamTime are local HH:MM clock times and either may be
+// empty. A QiyamTime before noon falls after midnight, on the morning of the
+// fast.
+type RamadanNight struct {
+	ID           uuid.UUID `gorm:"primaryKey;type:char(36)"`
+	MasjidId     string    `gorm:"type:char(36);uniqueIndex:idx_ramadan_nights_masjid_year_night"`
+	HijriYear    int32     `gorm:"uniqueIndex:idx_ramadan_nights_masjid_year_night"`
+	Night        int32     `gorm:"uniqueIndex:idx_ramadan_nights_masjid_year_night"`
+	TaraweehTime string    `gorm:"type:varchar(5)"`
+	QiyamTime    string    `gorm:"type:varchar(5)"`
+	ImamId       string    `gorm:"type:char(36)"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
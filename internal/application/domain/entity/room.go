@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Room is a hall, classroom or other facility of a masjid that events and
+// members book.
+type Room struct {
+	ID          uuid.UUID `gorm:"primaryKey;type:char(36)"`
+	MasjidId    string    `gorm:"type:char(36);not null;index"`
+	Name        string    `gorm:"type:varchar(64);not null"`
+	Description string
+	// Capacity is how many people the room holds.
+	Capacity int32 `gorm:"not null"`
+	// Designation is the gender the room is set aside for, if any.
+	Designation GenderRestriction `gorm:"not null;default:0"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Suits reports whether an event with the given gender restriction may be
+// held in the room. Events open to all may use any room, such as the
+// sisters' hall alongside the main hall.
+func (r *Room) Suits(restriction GenderRestriction) bool {
+	return r.Designation == NO_RESTRICTION || restriction == NO_RESTRICTION || restriction == r.Designation
+}
+
+// RoomBooking holds a room from StartTime to EndTime, for an event or for
+// a member. Bookings of a room never overlap.
+type RoomBooking struct {
+	ID     uuid.UUID `gorm:"primaryKey;type:char(36)"`
+	RoomId uuid.UUID `gorm:"type:char(36);not null;index"`
+	// EventId is set on the bookings of events, which are deleted with
+	// them. UserId is set on those of members.
+	EventId *uuid.UUID `gorm:"type:char(36);index"`
+	UserId  *uuid.UUID `gorm:"type:char(36);index"`
+	// Purpose says what the room is booked for, the event's name for the
+	// bookings of events.
+	Purpose   string    `gorm:"not null"`
+	StartTime time.Time `gorm:"not null;index"`
+	EndTime   time.Time `gorm:"not null"`
+	// Guests is how many people a member expects.
+	Guests    int32 `gorm:"not null;default:0"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Room      *Room `gorm:"foreignKey:RoomId;constraint:OnDelete:CASCADE"`
+}
+
+// Overlaps reports whether the booking holds its room at any time from
+// start to end.
+func (b *RoomBooking) Overlaps(start, end time.Time) bool {
+	return b.StartTime.Before(end) && b.EndTime.After(start)
+}
+
+// TimeRange is the time from Start up to End.
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// RoomAvailability is what a room is booked for over a window, and when it
+// is free.
+type RoomAvailability struct {
+	Room     *Room
+	From     time.Time
+	To       time.Time
+	Bookings []RoomBooking
+	Free     []TimeRange
+}
+
+// SetRooms sets the rooms the event is held in, leaving out repeats, as
+// bookings for the event yet to be made.
+func (e *Event) SetRooms(ids ...uuid.UUID) {
+	e.Bookings = make([]RoomBooking, 0, len(ids))
+	for _, id := range ids {
+		if !slices.Contains(e.RoomIds(), id.String()) {
+			e.Bookings = append(e.Bookings, RoomBooking{RoomId: id})
+		}
+	}
+}
+
+// RoomIds returns the IDs of the rooms the event is held in, once each
+// however many of its occurrences they are booked for.
+func (e *Event) RoomIds() []string {
+	ids := make([]string, 0, len(e.Bookings))
+	for _, b := range e.Bookings {
+		if !slices.Contains(ids, b.RoomId.String()) {
+			ids = append(ids, b.RoomId.String())
+		}
+	}
+	return ids
+}
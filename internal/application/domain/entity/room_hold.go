@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/mnadev/limestone/internal/application/domain/recurrence"
+)
+
+// maxHeldOccurrences bounds the occurrences of each series compared when
+// two series hold a room by their rules.
+const maxHeldOccurrences = 1000
+
+// SeriesHold is the hold a recurring event has on a room beyond the
+// occurrences booked for it, which are booked only some way ahead. The
+// room is held for each occurrence of the series starting after
+// BookedUntil, the start of the last occurrence booked, other than those
+// in Except, which have exceptions of their own.
+type SeriesHold struct {
+	Series      *Event
+	BookedUntil time.Time
+	Except      []time.Time
+	set         *recurrence.Set
+}
+
+// NewSeriesHold returns the hold of series on a room it is booked in up to
+// bookedUntil, reading its recurrence in loc, the time zone of its masjid.
+func NewSeriesHold(series *Event, loc *time.Location, bookedUntil time.Time, except []time.Time) (*SeriesHold, error) {
+	set, err := recurrence.Parse(series.RecurrenceLines(), series.StartTime.In(loc))
+	if err != nil {
+		return nil, err
+	}
+	return &SeriesHold{Series: series, BookedUntil: bookedUntil, Except: except, set: set}, nil
+}
+
+// Length returns how long each occurrence of the series lasts.
+func (h *SeriesHold) Length() time.Duration {
+	return h.Series.EndTime.Sub(h.Series.StartTime)
+}
+
+// Conflict returns the start of the first occurrence held that overlaps
+// start to end, reporting whether there is one.
+func (h *SeriesHold) Conflict(start, end time.Time) (time.Time, bool) {
+	starts := h.held(start.Add(-h.Length()).Add(time.Nanosecond), end, 1)
+	if len(starts) == 0 {
+		return time.Time{}, false
+	}
+	return starts[0], true
+}
+
+// ConflictWith returns the start of the first occurrence other holds that
+// overlaps one h holds, reporting whether there is one. No more than
+// maxHeldOccurrences of each are compared.
+func (h *SeriesHold) ConflictWith(other *SeriesHold) (time.Time, bool) {
+	from := h.BookedUntil
+	if other.BookedUntil.After(from) {
+		from = other.BookedUntil
+	}
+	before := from.AddDate(1000, 0, 0)
+	ours := h.held(from.Add(-h.Length()), before, maxHeldOccurrences)
+	theirs := other.held(from.Add(-other.Length()), before, maxHeldOccurrences)
+	for i, j := 0, 0; i < len(ours) && j < len(theirs); {
+		oursEnd, theirsEnd := ours[i].Add(h.Length()), theirs[j].Add(other.Length())
+		if ours[i].Before(theirsEnd) && theirs[j].Before(oursEnd) {
+			return theirs[j], true
+		}
+		if oursEnd.Before(theirsEnd) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return time.Time{}, false
+}
+
+// held returns the starts of the occurrences held from from up to before,
+// no more than limit when it is positive.
+func (h *SeriesHold) held(from, before time.Time, limit int) []time.Time {
+	if !from.After(h.BookedUntil) {
+		from = h.BookedUntil.Add(time.Nanosecond)
+	}
+	n := 0
+	if limit > 0 {
+		n = limit + len(h.Except)
+	}
+	starts := slices.DeleteFunc(h.set.Between(from, before, n), func(start time.Time) bool {
+		return slices.ContainsFunc(h.Except, start.Equal)
+	})
+	if limit > 0 && len(starts) > limit {
+		starts = starts[:limit]
+	}
+	return starts
+}
@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VolunteerShift is a job at an event that volunteers sign up for, such as
+// parking, food, setup or security, from StartTime to EndTime, which may
+// fall outside the event itself. Shifts are kept when their event is
+// deleted, as a record of the hours worked on them.
+type VolunteerShift struct {
+	ID       uuid.UUID `gorm:"primaryKey;type:char(36)"`
+	EventId  uuid.UUID `gorm:"type:char(36);not null;index"`
+	MasjidId string    `gorm:"type:char(36);index"`
+	// Role names the job, e.g. "Parking".
+	Role        string `gorm:"type:varchar(64);not null"`
+	Description string
+	StartTime   time.Time `gorm:"not null"`
+	EndTime     time.Time `gorm:"not null"`
+	// Headcount is how many volunteers the shift needs.
+	Headcount int32 `gorm:"not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Signups   []ShiftSignup `gorm:"foreignKey:ShiftId;constraint:OnDelete:CASCADE"`
+	// Claimed counts the volunteers holding a place on the shift. It is
+	// derived on read and not stored.
+	Claimed int64 `gorm:"-"`
+}
+
+// PlacesLeft returns how many more volunteers may claim the shift.
+func (s *VolunteerShift) PlacesLeft() int64 {
+	return max(0, int64(s.Headcount)-s.Claimed)
+}
+
+// Minutes returns the length of the shift in minutes.
+func (s *VolunteerShift) Minutes() int32 {
+	return int32(s.EndTime.Sub(s.StartTime) / time.Minute)
+}
+
+type ShiftSignupStatus int
+
+const (
+	ShiftSignupStatusUnspecified ShiftSignupStatus = iota
+	// ShiftClaimed holds one of the shift's places.
+	ShiftClaimed
+	ShiftReleased
+	// ShiftWorked has the minutes the volunteer worked recorded.
+	ShiftWorked
+	// ShiftNoShow was claimed by a volunteer who did not come.
+	ShiftNoShow
+)
+
+// HoldsPlace reports whether a signup with the status counts against the
+// shift's headcount.
+func (s ShiftSignupStatus) HoldsPlace() bool {
+	return s != ShiftReleased && s != ShiftSignupStatusUnspecified
+}
+
+// ShiftSignup records a volunteer's claim on a shift. A volunteer has at
+// most one per shift: releasing keeps it, and claiming again renews it.
+type ShiftSignup struct {
+	ID        uuid.UUID         `gorm:"primaryKey;type:char(36)"`
+	ShiftId   uuid.UUID         `gorm:"type:char(36);not null;uniqueIndex:idx_shift_signups_shift_user"`
+	UserId    uuid.UUID         `gorm:"type:char(36);not null;uniqueIndex:idx_shift_signups_shift_user;index"`
+	Status    ShiftSignupStatus `gorm:"not null;default:0"`
+	ClaimedAt time.Time         `gorm:"not null"`
+	// MinutesWorked and RecordedBy are set by the coordinator recording
+	// the volunteer's hours after the shift.
+	MinutesWorked int32      `gorm:"not null;default:0"`
+	RecordedBy    *uuid.UUID `gorm:"type:char(36)"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Shift         *VolunteerShift `gorm:"foreignKey:ShiftId"`
+	User          *User           `gorm:"foreignKey:UserId;constraint:OnDelete:CASCADE"`
+}
+
+// VolunteerHours totals the shifts a volunteer worked over a period.
+type VolunteerHours struct {
+	UserId        uuid.UUID
+	MinutesWorked int64
+	ShiftsWorked  int64
+	User          *User `gorm:"-"`
+}
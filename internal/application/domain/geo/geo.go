@@ -0,0 +1,89 @@
+// Package geo holds the great-circle calculations shared by masjid search,
+// prayer times and the Qibla.
+package geo
+
+import (
+	"errors"
+	"math"
+)
+
+var ErrInvalidCoordinates = errors.New("latitude must be within [-90, 90] and longitude within [-180, 180]")
+
+// EarthRadiusKm is the mean radius of the Earth.
+const EarthRadiusKm = 6371.0
+
+type Coordinates struct {
+	Latitude  float64
+	Longitude float64
+}
+
+func (c Coordinates) Valid() bool {
+	return c.Latitude >= -90 && c.Latitude <= 90 && c.Longitude >= -180 && c.Longitude <= 180
+}
+
+// DistanceKm returns the great-circle distance between a and b using the
+// haversine formula.
+func DistanceKm(a, b Coordinates) float64 {
+	lat1 := radians(a.Latitude)
+	lat2 := radians(b.Latitude)
+	dLat := lat2 - lat1
+	dLng := radians(b.Longitude - a.Longitude)
+
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// BoundingBox returns the latitude and longitude ranges that contain every
+// point within radiusKm of c. wraps is true when the box crosses a pole or
+// the antimeridian, in which case the longitude range should not be used as
+// a filter.
+func BoundingBox(c Coordinates, radiusKm float64) (minLat, maxLat, minLng, maxLng float64, wraps bool) {
+	latDelta := degrees(radiusKm / EarthRadiusKm)
+	minLat = c.Latitude - latDelta
+	maxLat = c.Latitude + latDelta
+	if minLat < -90 || maxLat > 90 {
+		return math.Max(minLat, -90), math.Min(maxLat, 90), -180, 180, true
+	}
+
+	lngDelta := degrees(radiusKm / (EarthRadiusKm * math.Cos(radians(c.Latitude))))
+	minLng = c.Longitude - lngDelta
+	maxLng = c.Longitude + lngDelta
+	if minLng < -180 || maxLng > 180 {
+		return minLat, maxLat, -180, 180, true
+	}
+	return minLat, maxLat, minLng, maxLng, false
+}
+
+func radians(d float64) float64 { return d * math.Pi / 180 }
+func degrees(r float64) float64 { return r * 180 / math.Pi }
+
+// Kaaba is the location of the Kaaba in Makkah, the direction of prayer.
+var Kaaba = Coordinates{Latitude: 21.422487, Longitude: 39.826206}
+
+// Qibla is the direction and distance to the Kaaba from a point.
+type Qibla struct {
+	// BearingDegrees is the great-circle initial bearing, clockwise from
+	// true north in [0, 360).
+	BearingDegrees float64
+	DistanceKm     float64
+}
+
+// QiblaFrom returns the Qibla at c. The bearing is 0 at the Kaaba itself.
+func QiblaFrom(c Coordinates) Qibla {
+	return Qibla{BearingDegrees: InitialBearing(c, Kaaba), DistanceKm: DistanceKm(c, Kaaba)}
+}
+
+// InitialBearing returns the bearing in degrees, clockwise from true north in
+// [0, 360), at which the great circle from a to b leaves a.
+func InitialBearing(a, b Coordinates) float64 {
+	lat1 := radians(a.Latitude)
+	lat2 := radians(b.Latitude)
+	dLng := radians(b.Longitude - a.Longitude)
+
+	y := math.Sin(dLng) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLng)
+	if x == 0 && y == 0 {
+		return 0
+	}
+	return math.Mod(degrees(math.Atan2(y, x))+360, 360)
+}
@@ -0,0 +1,190 @@
+// Package hijri converts between Gregorian dates and the Umm al-Qura Hijri
+// calendar, optionally shifted by a few days to follow a masjid's local moon
+// sighting.
+package hijri
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	ummalqura "github.com/hablullah/go-hijri"
+)
+
+var (
+	ErrOutOfRange    = errors.New("date is outside the supported Hijri range 1356-1500 AH (1937-2077)")
+	ErrInvalidDate   = errors.New("invalid Hijri date")
+	ErrInvalidOffset = errors.New("hijri offset must be between -2 and 2 days")
+)
+
+// MaxOffset is the largest number of days a masjid may shift the calendar by.
+const MaxOffset = 2
+
+const (
+	MinYear = 1356
+	MaxYear = 1500
+)
+
+// The Umm al-Qura tables cover 1 Muharram 1356 to 29 Dhu al-Hijjah 1500.
+var (
+	firstDay = time.Date(1937, time.March, 14, 0, 0, 0, 0, time.UTC)
+	lastDay  = time.Date(2077, time.November, 15, 0, 0, 0, 0, time.UTC)
+)
+
+var monthNames = [...]string{
+	"Muharram",
+	"Safar",
+	"Rabi al-Awwal",
+	"Rabi al-Thani",
+	"Jumada al-Ula",
+	"Jumada al-Thaniyah",
+	"Rajab",
+	"Shaban",
+	"Ramadan",
+	"Shawwal",
+	"Dhu al-Qadah",
+	"Dhu al-Hijjah",
+}
+
+// Ramadan is the month number of Ramadan.
+const Ramadan = 9
+
+// Date is a day of the Hijri calendar. Month runs from 1 (Muharram) to 12
+// (Dhu al-Hijjah). The zero Date means no date is known.
+type Date struct {
+	Year  int
+	Month int
+	Day   int
+}
+
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// MonthName returns the transliterated name of the month.
+func (d Date) MonthName() string {
+	return MonthName(d.Month)
+}
+
+// String formats the date as YYYY-MM-DD.
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// Long formats the date for display, e.g. "5 Ramadan 1445".
+func (d Date) Long() string {
+	return fmt.Sprintf("%d %s %d", d.Day, d.MonthName(), d.Year)
+}
+
+func MonthName(month int) string {
+	if month < 1 || month > 12 {
+		return ""
+	}
+	return monthNames[month-1]
+}
+
+// ValidateOffset checks a masjid's moon sighting offset.
+func ValidateOffset(offset int) error {
+	if offset < -MaxOffset || offset > MaxOffset {
+		return ErrInvalidOffset
+	}
+	return nil
+}
+
+// FromGregorian returns the Hijri date of the calendar day named by t's year,
+// month and day. A negative offset moves every month start later, for a
+// community whose moon sighting runs behind Umm al-Qura, and a positive one
+// earlier.
+func FromGregorian(t time.Time, offset int) (Date, error) {
+	if err := ValidateOffset(offset); err != nil {
+		return Date{}, err
+	}
+	year, month, day := t.Date()
+	g := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).AddDate(0, 0, offset)
+	if g.Before(firstDay) || g.After(lastDay) {
+		return Date{}, ErrOutOfRange
+	}
+
+	uq, err := ummalqura.CreateUmmAlQuraDate(g.Add(12 * time.Hour))
+	if err != nil {
+		return Date{}, ErrOutOfRange
+	}
+	return Date{Year: int(uq.Year), Month: int(uq.Month), Day: int(uq.Day)}, nil
+}
+
+// ToGregorian returns the Gregorian day of d, as midnight UTC, under the
+// given offset. It is the inverse of FromGregorian.
+func ToGregorian(d Date, offset int) (time.Time, error) {
+	if err := ValidateOffset(offset); err != nil {
+		return time.Time{}, err
+	}
+	length, err := MonthLength(d.Year, d.Month)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if d.Day < 1 || d.Day > length {
+		return time.Time{}, fmt.Errorf("%w: %s %d has %d days", ErrInvalidDate, MonthName(d.Month), d.Year, length)
+	}
+	return toGregorian(d).AddDate(0, 0, -offset), nil
+}
+
+// MonthLength returns the number of days, 29 or 30, in a Hijri month.
+func MonthLength(year, month int) (int, error) {
+	if month < 1 || month > 12 {
+		return 0, fmt.Errorf("%w: month must be between 1 and 12", ErrInvalidDate)
+	}
+	if year < MinYear || year > MaxYear {
+		return 0, ErrOutOfRange
+	}
+
+	start := toGregorian(Date{Year: year, Month: month, Day: 1})
+	var next time.Time
+	if year == MaxYear && month == 12 {
+		next = lastDay.AddDate(0, 0, 1)
+	} else {
+		ny, nm := year, month+1
+		if nm > 12 {
+			ny, nm = year+1, 1
+		}
+		next = toGregorian(Date{Year: ny, Month: nm, Day: 1})
+	}
+	return int(next.Sub(start).Hours() / 24), nil
+}
+
+// MonthRange returns the first Gregorian day of a Hijri month, or of the
+// whole year when month is 0, and the first day after it, both as midnight
+// UTC.
+func MonthRange(year, month, offset int) (time.Time, time.Time, error) {
+	if month == 0 {
+		start, err := ToGregorian(Date{Year: year, Month: 1, Day: 1}, offset)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		last, err := MonthLength(year, 12)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		end, err := ToGregorian(Date{Year: year, Month: 12, Day: last}, offset)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		return start, end.AddDate(0, 0, 1), nil
+	}
+
+	start, err := ToGregorian(Date{Year: year, Month: month, Day: 1}, offset)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	length, err := MonthLength(year, month)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start, start.AddDate(0, 0, length), nil
+}
+
+// toGregorian converts a date known to be within the tables.
+func toGregorian(d Date) time.Time {
+	g := ummalqura.UmmAlQuraDate{Year: int64(d.Year), Month: int64(d.Month), Day: int64(d.Day)}.ToGregorian()
+	year, month, day := g.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,263 @@
+// Package ical writes calendars in the iCalendar format of RFC 5545, for
+// calendar clients to subscribe to. Events are written in the time zones
+// of their start times, each described by a VTIMEZONE, so that recurrence
+// rules keep their local time of day across daylight saving changes.
+package ical
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	utcFormat   = "20060102T150405Z"
+	localFormat = "20060102T150405"
+)
+
+// timeZoneYears is how many years past the last event starts a VTIMEZONE
+// describes, to cover the occurrences of events that recur after it.
+const timeZoneYears = 10
+
+// Calendar is a calendar of events.
+type Calendar struct {
+	// Name and TimeZone are suggested to clients as the calendar's name and
+	// default time zone. Either may be left empty.
+	Name     string
+	TimeZone *time.Location
+	Events   []Event
+}
+
+// Event is a VEVENT. Start and End are written in Start's location, in UTC
+// when that is UTC.
+type Event struct {
+	UID         string
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Location    string
+	// Livestream is a link to watch the event, added to the description
+	// as well for clients that do not show URL or CONFERENCE.
+	Livestream string
+	Categories []string
+	// Status is CONFIRMED, TENTATIVE or CANCELLED, or empty.
+	Status string
+	// Recurrence holds the RRULE, RDATE and EXDATE lines of a recurring
+	// event.
+	Recurrence []string
+	// RecurrenceID is set on an exception to the occurrence of the
+	// recurring event with the same UID that it replaces.
+	RecurrenceID time.Time
+	// Updated is the last time the event changed. It is written as
+	// DTSTAMP and LAST-MODIFIED so that an unchanged calendar encodes the
+	// same each time.
+	Updated time.Time
+}
+
+// Encode writes c as an iCalendar object.
+func (c *Calendar) Encode() []byte {
+	var b strings.Builder
+	line := func(s string) {
+		b.WriteString(FoldLine(s))
+		b.WriteString("\r\n")
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//Limestone//Events//EN")
+	line("CALSCALE:GREGORIAN")
+	if c.Name != "" {
+		line("X-WR-CALNAME:" + EscapeText(c.Name))
+	}
+	if c.TimeZone != nil && c.TimeZone != time.UTC {
+		line("X-WR-TIMEZONE:" + c.TimeZone.String())
+	}
+	for _, tz := range c.timeZones() {
+		tz.encode(line)
+	}
+	for _, e := range c.Events {
+		e.encode(line)
+	}
+	line("END:VCALENDAR")
+	return []byte(b.String())
+}
+
+func (e *Event) encode(line func(string)) {
+	line("BEGIN:VEVENT")
+	line("UID:" + EscapeText(e.UID))
+	line("DTSTAMP:" + FormatUTC(e.Updated))
+	line("LAST-MODIFIED:" + FormatUTC(e.Updated))
+	line("DTSTART" + timeValue(e.Start))
+	line("DTEND" + timeValue(e.End.In(e.Start.Location())))
+	if !e.RecurrenceID.IsZero() {
+		line("RECURRENCE-ID" + timeValue(e.RecurrenceID.In(e.Start.Location())))
+	}
+	for _, r := range e.Recurrence {
+		line(r)
+	}
+	line("SUMMARY:" + EscapeText(e.Summary))
+	description := e.Description
+	if e.Livestream != "" {
+		if description != "" {
+			description += "\n\n"
+		}
+		description += "Livestream: " + e.Livestream
+	}
+	if description != "" {
+		line("DESCRIPTION:" + EscapeText(description))
+	}
+	if e.Location != "" {
+		line("LOCATION:" + EscapeText(e.Location))
+	}
+	if e.Livestream != "" {
+		line("URL;VALUE=URI:" + e.Livestream)
+		line("CONFERENCE;VALUE=URI;FEATURE=VIDEO;LABEL=Livestream:" + e.Livestream)
+	}
+	if len(e.Categories) > 0 {
+		categories := make([]string, len(e.Categories))
+		for i, c := range e.Categories {
+			categories[i] = EscapeText(c)
+		}
+		line("CATEGORIES:" + strings.Join(categories, ","))
+	}
+	if e.Status != "" {
+		line("STATUS:" + e.Status)
+	}
+	line("END:VEVENT")
+}
+
+// timeZone is the part of a location's history a calendar needs.
+type timeZone struct {
+	loc      *time.Location
+	from, to time.Time
+}
+
+// timeZones returns the time zones of the calendar's events other than
+// UTC, by name, each spanning the events in it.
+func (c *Calendar) timeZones() []timeZone {
+	spans := map[string]*timeZone{}
+	for _, e := range c.Events {
+		loc := e.Start.Location()
+		if loc == time.UTC {
+			continue
+		}
+		tz, ok := spans[loc.String()]
+		if !ok {
+			tz = &timeZone{loc: loc, from: e.Start, to: e.Start}
+			spans[loc.String()] = tz
+		}
+		for _, t := range []time.Time{e.Start, e.RecurrenceID} {
+			if t.IsZero() {
+				continue
+			}
+			if t.Before(tz.from) {
+				tz.from = t
+			}
+			if t.After(tz.to) {
+				tz.to = t
+			}
+		}
+	}
+
+	var zones []timeZone
+	for _, tz := range spans {
+		zones = append(zones, timeZone{loc: tz.loc, from: tz.from, to: tz.to.AddDate(timeZoneYears, 0, 0)})
+	}
+	slices.SortFunc(zones, func(a, b timeZone) int { return strings.Compare(a.loc.String(), b.loc.String()) })
+	return zones
+}
+
+// encode writes tz as a VTIMEZONE with an observance for the offset in
+// force at its start and one for each change of offset after that.
+func (tz timeZone) encode(line func(string)) {
+	line("BEGIN:VTIMEZONE")
+	line("TZID:" + tz.loc.String())
+	start := tz.from.In(tz.loc)
+	onset, _ := start.ZoneBounds()
+	if onset.IsZero() {
+		onset = start
+	}
+	_, offset := onset.Zone()
+	observance(line, onset, offset)
+	for t := onset; ; {
+		_, end := t.ZoneBounds()
+		if end.IsZero() || end.After(tz.to) {
+			break
+		}
+		_, before := t.Zone()
+		observance(line, end, before)
+		t = end
+	}
+	line("END:VTIMEZONE")
+}
+
+// observance writes the STANDARD or DAYLIGHT observance starting at onset,
+// when the offset changes from offsetFrom seconds.
+func observance(line func(string), onset time.Time, offsetFrom int) {
+	kind := "STANDARD"
+	if onset.IsDST() {
+		kind = "DAYLIGHT"
+	}
+	name, offsetTo := onset.Zone()
+	line("BEGIN:" + kind)
+	line("DTSTART:" + onset.UTC().Add(time.Duration(offsetFrom)*time.Second).Format(localFormat))
+	line("TZOFFSETFROM:" + formatOffset(offsetFrom))
+	line("TZOFFSETTO:" + formatOffset(offsetTo))
+	line("TZNAME:" + EscapeText(name))
+	line("END:" + kind)
+}
+
+// timeValue formats the parameters and value of a DATE-TIME property, in
+// UTC or with a TZID.
+func timeValue(t time.Time) string {
+	if t.Location() == time.UTC {
+		return ":" + FormatUTC(t)
+	}
+	return ";TZID=" + t.Location().String() + ":" + t.Format(localFormat)
+}
+
+func formatOffset(seconds int) string {
+	sign := '+'
+	if seconds < 0 {
+		sign, seconds = '-', -seconds
+	}
+	s := fmt.Sprintf("%c%02d%02d", sign, seconds/3600, seconds/60%60)
+	if seconds%60 != 0 {
+		s += fmt.Sprintf("%02d", seconds%60)
+	}
+	return s
+}
+
+// FormatUTC formats t as a DATE-TIME in UTC.
+func FormatUTC(t time.Time) string {
+	return t.UTC().Format(utcFormat)
+}
+
+// EscapeText escapes s for use as a TEXT value.
+func EscapeText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// FoldLine splits a content line into lines of at most 75 octets as
+// required by RFC 5545, without breaking a UTF-8 sequence.
+func FoldLine(s string) string {
+	const limit = 75
+	if len(s) <= limit {
+		return s
+	}
+
+	var b strings.Builder
+	width := 0
+	for _, r := range s {
+		n := len(string(r))
+		if width+n > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += n
+	}
+	return b.String()
+}
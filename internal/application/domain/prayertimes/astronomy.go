@@ -0,0 +1,89 @@
+package prayertimes
+
+import "math"
+
+// The solar position formulas below follow the low-precision algorithm
+// published by the U.S. Naval Observatory, which is accurate to about one
+// arc minute between 1950 and 2050 and is what most adhan apps build on.
+
+func dsin(d float64) float64 { return math.Sin(d * math.Pi / 180) }
+func dcos(d float64) float64 { return math.Cos(d * math.Pi / 180) }
+func dtan(d float64) float64 { return math.Tan(d * math.Pi / 180) }
+
+func darcsin(x float64) float64     { return math.Asin(x) * 180 / math.Pi }
+func darccos(x float64) float64     { return math.Acos(x) * 180 / math.Pi }
+func darctan2(y, x float64) float64 { return math.Atan2(y, x) * 180 / math.Pi }
+func darccot(x float64) float64     { return math.Atan(1/x) * 180 / math.Pi }
+
+func fixAngle(a float64) float64 { return fix(a, 360) }
+func fixHour(h float64) float64  { return fix(h, 24) }
+
+func fix(a, b float64) float64 {
+	a = a - b*math.Floor(a/b)
+	if a < 0 {
+		return a + b
+	}
+	return a
+}
+
+// julianDate returns the Julian date at 00:00 UTC of the given Gregorian date.
+func julianDate(year, month, day int) float64 {
+	if month <= 2 {
+		year--
+		month += 12
+	}
+	a := math.Floor(float64(year) / 100)
+	b := 2 - a + math.Floor(a/4)
+	return math.Floor(365.25*float64(year+4716)) + math.Floor(30.6001*float64(month+1)) + float64(day) + b - 1524.5
+}
+
+// sunPosition returns the sun's declination in degrees and the equation of
+// time in hours for the given Julian date.
+func sunPosition(jd float64) (declination, equation float64) {
+	d := jd - 2451545.0
+	g := fixAngle(357.529 + 0.98560028*d)
+	q := fixAngle(280.459 + 0.98564736*d)
+	l := fixAngle(q + 1.915*dsin(g) + 0.020*dsin(2*g))
+
+	e := 23.439 - 0.00000036*d
+	ra := darctan2(dcos(e)*dsin(l), dcos(l)) / 15
+
+	equation = q/15 - fixHour(ra)
+	declination = darcsin(dsin(e) * dsin(l))
+	return declination, equation
+}
+
+// solar holds the astronomical state needed to compute every prayer time of
+// a single day at a single place.
+type solar struct {
+	jd       float64
+	latitude float64
+}
+
+// midDay returns the time of solar transit in local solar hours, where t is
+// an estimate of the time of day expressed as a fraction of a day.
+func (s solar) midDay(t float64) float64 {
+	_, eqt := sunPosition(s.jd + t)
+	return fixHour(12 - eqt)
+}
+
+// sunAngleTime returns the time at which the sun is the given number of
+// degrees below the horizon. ccw selects the morning side of transit. The
+// result is NaN when the sun never reaches that angle on this day.
+func (s solar) sunAngleTime(angle, t float64, ccw bool) float64 {
+	decl, _ := sunPosition(s.jd + t)
+	noon := s.midDay(t)
+	ha := darccos((-dsin(angle)-dsin(decl)*dsin(s.latitude))/(dcos(decl)*dcos(s.latitude))) / 15
+	if ccw {
+		return noon - ha
+	}
+	return noon + ha
+}
+
+// asrTime returns the time at which an object's shadow equals factor times
+// its length plus its length at noon.
+func (s solar) asrTime(factor, t float64) float64 {
+	decl, _ := sunPosition(s.jd + t)
+	angle := -darccot(factor + dtan(math.Abs(s.latitude-decl)))
+	return s.sunAngleTime(angle, t, false)
+}
@@ -0,0 +1,92 @@
+package prayertimes
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+)
+
+var ErrInvalidIqamahRule = errors.New("invalid iqamah rule")
+
+// Iqamah holds the congregational prayer times of a single day. A prayer
+// without a matching rule is left as the zero time.
+type Iqamah struct {
+	Fajr    time.Time
+	Dhuhr   time.Time
+	Asr     time.Time
+	Maghrib time.Time
+	Isha    time.Time
+}
+
+// ResolveIqamah picks, for each prayer, the rule that applies on the day of
+// times and applies it. When several rules cover the day, the one with the
+// latest start date wins, so a seasonal override beats an open-ended default.
+// A fixed iqamah that would fall before the adhan, as can happen for a few
+// days around a daylight saving change, is moved to the adhan time.
+func ResolveIqamah(rules []entity.IqamahRule, times *Times) (*Iqamah, error) {
+	date := times.Date.Format("2006-01-02")
+
+	chosen := map[entity.Prayer]*entity.IqamahRule{}
+	for i := range rules {
+		rule := &rules[i]
+		if !rule.AppliesOn(date) {
+			continue
+		}
+		if current, ok := chosen[rule.Prayer]; !ok || rule.StartDate > current.StartDate {
+			chosen[rule.Prayer] = rule
+		}
+	}
+
+	iqamah := &Iqamah{}
+	slots := map[entity.Prayer]struct {
+		adhan time.Time
+		out   *time.Time
+	}{
+		entity.FAJR:    {times.Fajr, &iqamah.Fajr},
+		entity.DHUHR:   {times.Dhuhr, &iqamah.Dhuhr},
+		entity.ASR:     {times.Asr, &iqamah.Asr},
+		entity.MAGHRIB: {times.Maghrib, &iqamah.Maghrib},
+		entity.ISHA:    {times.Isha, &iqamah.Isha},
+	}
+	for prayer, rule := range chosen {
+		slot, ok := slots[prayer]
+		if !ok {
+			continue
+		}
+		at, err := applyIqamahRule(rule, times.Date, slot.adhan)
+		if err != nil {
+			return nil, err
+		}
+		*slot.out = at
+	}
+	return iqamah, nil
+}
+
+func applyIqamahRule(rule *entity.IqamahRule, day, adhan time.Time) (time.Time, error) {
+	switch rule.Type {
+	case entity.MINUTES_AFTER_ADHAN:
+		return adhan.Add(time.Duration(rule.MinutesAfterAdhan) * time.Minute), nil
+	case entity.FIXED_TIME:
+		clock, err := ParseClock(rule.FixedTime)
+		if err != nil {
+			return time.Time{}, err
+		}
+		at := time.Date(day.Year(), day.Month(), day.Day(), clock.Hour(), clock.Minute(), 0, 0, day.Location())
+		if at.Before(adhan) {
+			return adhan, nil
+		}
+		return at, nil
+	}
+	return time.Time{}, fmt.Errorf("%w: unknown rule type %d", ErrInvalidIqamahRule, rule.Type)
+}
+
+// ParseClock parses a 24-hour HH:MM clock time.
+func ParseClock(s string) (time.Time, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: time %q must be in HH:MM format", ErrInvalidIqamahRule, s)
+	}
+	return t, nil
+}
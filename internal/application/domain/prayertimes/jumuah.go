@@ -0,0 +1,59 @@
+package prayertimes
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+)
+
+var ErrNoUpcomingJumuah = errors.New("no upcoming jumuah is scheduled")
+
+// jumuahHorizon bounds how many Fridays NextJumuah looks ahead, so that a
+// masjid whose only shifts lie in a season that has ended does not loop
+// forever.
+const jumuahHorizon = 53
+
+// ScheduledSlot is a prayer slot placed on a concrete day.
+type ScheduledSlot struct {
+	Slot  entity.PrayerSlot
+	Start time.Time
+}
+
+// NextJumuah returns the first Friday, in loc, on which at least one Jumu'ah
+// slot starts after now, together with the slots of that day that are still
+// to come, earliest first. Slots whose StartTime cannot be parsed are
+// skipped.
+func NextJumuah(slots []entity.PrayerSlot, now time.Time, loc *time.Location) (time.Time, []ScheduledSlot, error) {
+	now = now.In(loc)
+	friday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	friday = friday.AddDate(0, 0, (int(time.Friday)-int(friday.Weekday())+7)%7)
+
+	for week := 0; week < jumuahHorizon; week++ {
+		date := friday.Format("2006-01-02")
+
+		var upcoming []ScheduledSlot
+		for _, slot := range slots {
+			if slot.Type != entity.JUMUAH || !slot.AppliesOn(date) {
+				continue
+			}
+			clock, err := time.Parse("15:04", slot.StartTime)
+			if err != nil {
+				continue
+			}
+			start := time.Date(friday.Year(), friday.Month(), friday.Day(), clock.Hour(), clock.Minute(), 0, 0, loc)
+			if start.After(now) {
+				upcoming = append(upcoming, ScheduledSlot{Slot: slot, Start: start})
+			}
+		}
+		if len(upcoming) > 0 {
+			sort.SliceStable(upcoming, func(i, j int) bool {
+				return upcoming[i].Start.Before(upcoming[j].Start)
+			})
+			return friday, upcoming, nil
+		}
+		friday = friday.AddDate(0, 0, 7)
+	}
+	return time.Time{}, nil, ErrNoUpcomingJumuah
+}
@@ -0,0 +1,89 @@
+package prayertimes
+
+import "github.com/mnadev/limestone/internal/application/domain/entity"
+
+// methodParams are the twilight angles, Isha interval and rounding offsets
+// prescribed by a calculation method.
+type methodParams struct {
+	FajrAngle    float64
+	IshaAngle    float64
+	IshaInterval int32
+	// Offsets are the minutes each authority adds on top of the
+	// astronomical result, e.g. the minute after zawal most methods add to
+	// Dhuhr.
+	Offsets entity.PrayerAdjustments
+	// Seasonal selects the Moonsighting Committee's seasonal twilight
+	// limits for Fajr and Isha.
+	Seasonal bool
+}
+
+var methods = map[entity.CalculationMethod]methodParams{
+	entity.MUSLIM_WORLD_LEAGUE: {
+		FajrAngle: 18, IshaAngle: 17,
+		Offsets: entity.PrayerAdjustments{DhuhrAdjustment: 1},
+	},
+	entity.EGYPTIAN: {
+		FajrAngle: 19.5, IshaAngle: 17.5,
+		Offsets: entity.PrayerAdjustments{DhuhrAdjustment: 1},
+	},
+	entity.KARACHI: {
+		FajrAngle: 18, IshaAngle: 18,
+		Offsets: entity.PrayerAdjustments{DhuhrAdjustment: 1},
+	},
+	entity.UMM_AL_QURA: {
+		FajrAngle: 18.5, IshaInterval: 90,
+	},
+	entity.DUBAI: {
+		FajrAngle: 18.2, IshaAngle: 18.2,
+		Offsets: entity.PrayerAdjustments{DhuhrAdjustment: 3, AsrAdjustment: 3, MaghribAdjustment: 3},
+	},
+	entity.MOON_SIGHTING_COMMITTEE: {
+		FajrAngle: 18, IshaAngle: 18, Seasonal: true,
+		Offsets: entity.PrayerAdjustments{DhuhrAdjustment: 5, MaghribAdjustment: 3},
+	},
+	entity.NORTH_AMERICA: {
+		FajrAngle: 15, IshaAngle: 15,
+		Offsets: entity.PrayerAdjustments{DhuhrAdjustment: 1},
+	},
+	entity.KUWAIT: {
+		FajrAngle: 18, IshaAngle: 17.5,
+	},
+	entity.QATAR: {
+		FajrAngle: 18, IshaInterval: 90,
+	},
+	entity.SINGAPORE: {
+		FajrAngle: 20, IshaAngle: 18,
+		Offsets: entity.PrayerAdjustments{DhuhrAdjustment: 1},
+	},
+	entity.UOIF: {
+		FajrAngle: 12, IshaAngle: 12,
+	},
+}
+
+// resolveParams merges the preset for cfg.CalculationMethod with any angles
+// or interval set explicitly on the configuration. Explicit values win, so
+// an admin can keep a preset's offsets while tuning its Fajr angle. OTHER
+// has no preset and must supply both a Fajr angle and an Isha angle or
+// interval.
+func resolveParams(cfg entity.PrayerTimesConfiguration) (methodParams, error) {
+	p, ok := methods[cfg.CalculationMethod]
+	if !ok && cfg.CalculationMethod != entity.OTHER {
+		return methodParams{}, ErrUnknownMethod
+	}
+
+	if cfg.FajrAngle > 0 {
+		p.FajrAngle = cfg.FajrAngle
+	}
+	if cfg.IshaInterval > 0 {
+		p.IshaAngle = 0
+		p.IshaInterval = cfg.IshaInterval
+	} else if cfg.IshaAngle > 0 {
+		p.IshaAngle = cfg.IshaAngle
+		p.IshaInterval = 0
+	}
+
+	if p.FajrAngle <= 0 || (p.IshaAngle <= 0 && p.IshaInterval <= 0) {
+		return methodParams{}, ErrIncompleteParams
+	}
+	return p, nil
+}
@@ -0,0 +1,54 @@
+package prayertimes
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+)
+
+var ErrInvalidOverride = errors.New("invalid prayer time override")
+
+// FromOverride builds the Times of date from an imported override, placing
+// each HH:MM clock time on that day in loc. The times must be in prayer
+// order. Isha alone may fall after midnight, as it does in summer at high
+// latitudes; it is then moved to the following day.
+func FromOverride(date time.Time, loc *time.Location, o *entity.PrayerTimeOverride) (*Times, error) {
+	year, month, day := date.Date()
+	at := func(name, s string) (time.Time, error) {
+		clock, err := time.Parse("15:04", s)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %s time %q must be in HH:MM format", ErrInvalidOverride, name, s)
+		}
+		return time.Date(year, month, day, clock.Hour(), clock.Minute(), 0, 0, loc), nil
+	}
+
+	t := &Times{Date: time.Date(year, month, day, 0, 0, 0, 0, loc)}
+	fields := []struct {
+		name  string
+		value string
+		dst   *time.Time
+	}{
+		{"fajr", o.Fajr, &t.Fajr},
+		{"sunrise", o.Sunrise, &t.Sunrise},
+		{"dhuhr", o.Dhuhr, &t.Dhuhr},
+		{"asr", o.Asr, &t.Asr},
+		{"maghrib", o.Maghrib, &t.Maghrib},
+		{"isha", o.Isha, &t.Isha},
+	}
+	for i, f := range fields {
+		v, err := at(f.name, f.value)
+		if err != nil {
+			return nil, err
+		}
+		if i == len(fields)-1 && v.Before(t.Fajr) {
+			v = v.AddDate(0, 0, 1)
+		}
+		if i > 0 && !v.After(*fields[i-1].dst) {
+			return nil, fmt.Errorf("%w: %s must be later than %s", ErrInvalidOverride, f.name, fields[i-1].name)
+		}
+		*f.dst = v
+	}
+	return t, nil
+}
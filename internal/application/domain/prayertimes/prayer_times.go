@@ -0,0 +1,258 @@
+// Package prayertimes computes the daily prayer times of a place from a
+// masjid's entity.PrayerTimesConfiguration.
+package prayertimes
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/geo"
+	"github.com/mnadev/limestone/internal/application/domain/hijri"
+)
+
+var (
+	ErrUnknownMethod     = errors.New("unknown prayer time calculation method")
+	ErrIncompleteParams  = errors.New("calculation method requires a fajr angle and either an isha angle or an isha interval")
+	ErrNoSunriseOrSunset = errors.New("the sun does not rise or set at this latitude on this date")
+)
+
+// riseSetAngle is the depression of the sun's centre at apparent sunrise and
+// sunset, accounting for atmospheric refraction and the solar radius.
+const riseSetAngle = 0.833
+
+// Times holds the prayer times of a single day. Every time is expressed in
+// the location passed to Calculate and rounded to the nearest minute. Hijri is
+// left for the caller to fill in, as it depends on the masjid's moon sighting
+// offset.
+type Times struct {
+	Date    time.Time
+	Hijri   hijri.Date
+	Fajr    time.Time
+	Sunrise time.Time
+	Dhuhr   time.Time
+	Asr     time.Time
+	Maghrib time.Time
+	Isha    time.Time
+}
+
+// NextAdhan returns the first of the day's five adhans strictly after the
+// given time. ok is false once Isha has passed.
+func (t *Times) NextAdhan(after time.Time) (prayer entity.Prayer, at time.Time, ok bool) {
+	adhans := []struct {
+		prayer entity.Prayer
+		at     time.Time
+	}{
+		{entity.FAJR, t.Fajr},
+		{entity.DHUHR, t.Dhuhr},
+		{entity.ASR, t.Asr},
+		{entity.MAGHRIB, t.Maghrib},
+		{entity.ISHA, t.Isha},
+	}
+	for _, a := range adhans {
+		if a.at.After(after) {
+			return a.prayer, a.at, true
+		}
+	}
+	return entity.PRAYER_UNSPECIFIED, time.Time{}, false
+}
+
+// Calculate returns the prayer times at the given coordinates for the calendar
+// day named by date's year, month and day, expressed in loc.
+func Calculate(date time.Time, coords geo.Coordinates, loc *time.Location, cfg entity.PrayerTimesConfiguration) (*Times, error) {
+	if !coords.Valid() {
+		return nil, geo.ErrInvalidCoordinates
+	}
+	params, err := resolveParams(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	year, month, day := date.Date()
+	s := solar{
+		jd:       julianDate(year, int(month), day) - coords.Longitude/(15*24),
+		latitude: coords.Latitude,
+	}
+
+	asrFactor := 1.0
+	if cfg.AsrMethod == entity.HANAFI {
+		asrFactor = 2
+	}
+
+	// Each time is computed from the sun's position at a guess of that
+	// time, so a second pass using the first pass as the guess tightens the
+	// result to well under a minute.
+	fajr, sunrise, dhuhr, asr, sunset, isha := 5.0, 6.0, 12.0, 13.0, 18.0, 18.0
+	for i := 0; i < 2; i++ {
+		fajr = guess(s.sunAngleTime(params.FajrAngle, fajr/24, true), fajr)
+		sunrise = guess(s.sunAngleTime(riseSetAngle, sunrise/24, true), sunrise)
+		dhuhr = s.midDay(dhuhr / 24)
+		asr = guess(s.asrTime(asrFactor, asr/24), asr)
+		sunset = guess(s.sunAngleTime(riseSetAngle, sunset/24, false), sunset)
+		if params.IshaInterval == 0 {
+			isha = guess(s.sunAngleTime(params.IshaAngle, isha/24, false), isha)
+		}
+	}
+
+	// A second pass cannot recover a time the sun never reaches, so check
+	// the final values directly.
+	fajr = s.sunAngleTime(params.FajrAngle, fajr/24, true)
+	if math.IsNaN(s.sunAngleTime(riseSetAngle, sunrise/24, true)) || math.IsNaN(s.sunAngleTime(riseSetAngle, sunset/24, false)) {
+		return nil, ErrNoSunriseOrSunset
+	}
+	if params.IshaInterval > 0 {
+		isha = sunset + float64(params.IshaInterval)/60
+	} else {
+		isha = s.sunAngleTime(params.IshaAngle, isha/24, false)
+	}
+
+	night := 24 - sunset + sunrise
+	if params.Seasonal {
+		fajr, isha = seasonalTwilight(fajr, isha, sunrise, sunset, night, coords.Latitude, year, date.YearDay(), params.IshaInterval > 0)
+	} else {
+		fajr = adjustHighLatitude(fajr, sunrise, night, params.FajrAngle, cfg.HighLatitudeRule, true)
+		if params.IshaInterval == 0 {
+			isha = adjustHighLatitude(isha, sunset, night, params.IshaAngle, cfg.HighLatitudeRule, false)
+		}
+	}
+
+	base := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	at := func(hours float64, offsetMinutes int32) time.Time {
+		utc := hours - coords.Longitude/15 + float64(offsetMinutes)/60
+		return base.Add(time.Duration(utc * float64(time.Hour))).Round(time.Minute).In(loc)
+	}
+
+	adj := cfg.Adjustments
+	off := params.Offsets
+	return &Times{
+		Date:    time.Date(year, month, day, 0, 0, 0, 0, loc),
+		Fajr:    at(fajr, off.FajrAdjustment+adj.FajrAdjustment),
+		Sunrise: at(sunrise, 0),
+		Dhuhr:   at(dhuhr, off.DhuhrAdjustment+adj.DhuhrAdjustment),
+		Asr:     at(asr, off.AsrAdjustment+adj.AsrAdjustment),
+		Maghrib: at(sunset, off.MaghribAdjustment+adj.MaghribAdjustment),
+		Isha:    at(isha, off.IshaAdjustment+adj.IshaAdjustment),
+	}, nil
+}
+
+func guess(v, fallback float64) float64 {
+	if math.IsNaN(v) {
+		return fallback
+	}
+	return v
+}
+
+// adjustHighLatitude clamps a twilight time to the share of the night given
+// by rule. With NO_HIGH_LATITUDE_RULE the computed time is kept whenever it
+// exists; when the sun never reaches the twilight angle the middle of the
+// night is used, so that a masjid that has not picked a rule still gets a
+// usable timetable in summer.
+func adjustHighLatitude(t, base, night, angle float64, rule entity.HighLatitudeRule, morning bool) float64 {
+	var portion float64
+	switch rule {
+	case entity.MIDDLE_OF_THE_NIGHT:
+		portion = night / 2
+	case entity.SEVENTH_OF_THE_NIGHT:
+		portion = night / 7
+	case entity.TWILIGHT_ANGLE:
+		portion = night * angle / 60
+	default:
+		if math.IsNaN(t) {
+			portion = night / 2
+			break
+		}
+		return t
+	}
+
+	diff := t - base
+	if morning {
+		diff = base - t
+	}
+	if !math.IsNaN(t) && diff <= portion {
+		return t
+	}
+	if morning {
+		return base - portion
+	}
+	return base + portion
+}
+
+// seasonalTwilight applies the Moonsighting Committee's limits, which cap
+// how far Fajr may precede sunrise and Isha may follow sunset by a number of
+// minutes that varies with latitude and season. Above 55 degrees a seventh of
+// the night is used instead.
+func seasonalTwilight(fajr, isha, sunrise, sunset, night, latitude float64, year, dayOfYear int, ishaByInterval bool) (float64, float64) {
+	if math.Abs(latitude) >= 55 {
+		fajr = sunrise - night/7
+		if !ishaByInterval {
+			isha = sunset + night/7
+		}
+		return fajr, isha
+	}
+
+	dyy := daysSinceSolstice(dayOfYear, year, latitude)
+	lat := math.Abs(latitude)
+
+	safeFajr := sunrise - seasonalMinutes(dyy,
+		75+28.65/55*lat,
+		75+19.44/55*lat,
+		75+32.74/55*lat,
+		75+48.10/55*lat)/60
+	if math.IsNaN(fajr) || fajr < safeFajr {
+		fajr = safeFajr
+	}
+
+	if !ishaByInterval {
+		safeIsha := sunset + seasonalMinutes(dyy,
+			75+25.60/55*lat,
+			75+2.050/55*lat,
+			75-9.210/55*lat,
+			75+6.140/55*lat)/60
+		if math.IsNaN(isha) || isha > safeIsha {
+			isha = safeIsha
+		}
+	}
+	return fajr, isha
+}
+
+// seasonalMinutes interpolates between the committee's four seasonal anchor
+// values a (winter solstice) through d (summer solstice).
+func seasonalMinutes(dyy int, a, b, c, d float64) float64 {
+	x := float64(dyy)
+	switch {
+	case dyy < 91:
+		return a + (b-a)/91*x
+	case dyy < 137:
+		return b + (c-b)/46*(x-91)
+	case dyy < 183:
+		return c + (d-c)/46*(x-137)
+	case dyy < 229:
+		return d + (c-d)/46*(x-183)
+	case dyy < 275:
+		return c + (b-c)/46*(x-229)
+	}
+	return b + (a-b)/91*(x-275)
+}
+
+func daysSinceSolstice(dayOfYear, year int, latitude float64) int {
+	daysInYear := 365
+	southernOffset := 172
+	if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+		daysInYear = 366
+		southernOffset = 173
+	}
+
+	if latitude >= 0 {
+		d := dayOfYear + 10
+		if d >= daysInYear {
+			d -= daysInYear
+		}
+		return d
+	}
+	d := dayOfYear - southernOffset
+	if d < 0 {
+		d += daysInYear
+	}
+	return d
+}
@@ -0,0 +1,76 @@
+package prayertimes
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+)
+
+var ErrInvalidRamadanNight = errors.New("invalid ramadan night")
+
+// MaxSuhoorMargin is the largest number of minutes suhoor may end before Fajr.
+const MaxSuhoorMargin = 60
+
+// RamadanDay is one fast of Ramadan together with the taraweeh and qiyam of
+// the night preceding it. Taraweeh and Qiyam are zero on nights the masjid has
+// not scheduled them.
+type RamadanDay struct {
+	// Day is the day of Ramadan, from 1 to 29 or 30.
+	Day       int
+	Times     Times
+	SuhoorEnd time.Time
+	Iftar     time.Time
+	Taraweeh  time.Time
+	Qiyam     time.Time
+	ImamId    string
+}
+
+// NewRamadanDay derives the suhoor end and iftar of the fast on times.Date,
+// suhoorMargin minutes before Fajr and at Maghrib, and places the night's
+// taraweeh and qiyam, if any, on the evening before. A qiyam clock time
+// before noon is taken to be after midnight, on the morning of the fast.
+func NewRamadanDay(day int, times *Times, suhoorMargin int32, night *entity.RamadanNight) (*RamadanDay, error) {
+	r := &RamadanDay{
+		Day:       day,
+		Times:     *times,
+		SuhoorEnd: times.Fajr.Add(-time.Duration(suhoorMargin) * time.Minute),
+		Iftar:     times.Maghrib,
+	}
+	if night == nil {
+		return r, nil
+	}
+
+	eve := times.Date.AddDate(0, 0, -1)
+	at := func(name, s string, morning bool) (time.Time, error) {
+		if s == "" {
+			return time.Time{}, nil
+		}
+		clock, err := time.Parse("15:04", s)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %s time %q must be in HH:MM format", ErrInvalidRamadanNight, name, s)
+		}
+		d := eve
+		if morning && clock.Hour() < 12 {
+			d = times.Date
+		}
+		return time.Date(d.Year(), d.Month(), d.Day(), clock.Hour(), clock.Minute(), 0, 0, d.Location()), nil
+	}
+
+	var err error
+	if r.Taraweeh, err = at("taraweeh", night.TaraweehTime, false); err != nil {
+		return nil, err
+	}
+	if r.Qiyam, err = at("qiyam", night.QiyamTime, true); err != nil {
+		return nil, err
+	}
+	if !r.Qiyam.IsZero() && !r.Qiyam.Before(r.SuhoorEnd) {
+		return nil, fmt.Errorf("%w: qiyam of night %d must start before suhoor ends", ErrInvalidRamadanNight, day)
+	}
+	if !r.Taraweeh.IsZero() && !r.Qiyam.IsZero() && !r.Qiyam.After(r.Taraweeh) {
+		return nil, fmt.Errorf("%w: qiyam of night %d must start after taraweeh", ErrInvalidRamadanNight, day)
+	}
+	r.ImamId = night.ImamId
+	return r, nil
+}
@@ -0,0 +1,405 @@
+// Package recurrence parses and expands the RRULE, RDATE and EXDATE
+// properties of RFC 5545 that make an event repeat. Rules may recur daily,
+// weekly, monthly or yearly and narrow that with BYDAY, BYMONTHDAY,
+// BYMONTH and BYSETPOS. BYHOUR, BYMINUTE, BYSECOND, BYWEEKNO and BYYEARDAY
+// are not supported, so every occurrence starts at the time of day of the
+// first.
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidRule = errors.New("invalid recurrence rule")
+	ErrUnsupported = errors.New("unsupported recurrence rule")
+)
+
+// maxPeriods bounds the days, weeks, months or years a rule is followed
+// for, so that rules that match nothing, such as every 30 February, end.
+const maxPeriods = 100000
+
+// Frequency is the FREQ of a rule.
+type Frequency int
+
+const (
+	Daily Frequency = iota + 1
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencyNames = map[Frequency]string{
+	Daily:   "DAILY",
+	Weekly:  "WEEKLY",
+	Monthly: "MONTHLY",
+	Yearly:  "YEARLY",
+}
+
+var weekdayNames = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// WeekdayNum is a BYDAY entry: a weekday, and for monthly and yearly rules
+// optionally which of them in the month or year, counting from the end
+// when N is negative. N is 0 for every such weekday.
+type WeekdayNum struct {
+	N   int
+	Day time.Weekday
+}
+
+func (w WeekdayNum) String() string {
+	if w.N == 0 {
+		return weekdayNames[w.Day]
+	}
+	return strconv.Itoa(w.N) + weekdayNames[w.Day]
+}
+
+// Rule is a parsed RRULE. Interval is at least 1, Count is 0 and Until the
+// zero time when the rule does not end by them.
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      time.Time
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []time.Month
+	BySetPos   []int
+	WeekStart  time.Weekday
+}
+
+// ParseRule parses the value of an RRULE, with or without the "RRULE:"
+// prefix. An UNTIL without a zone is read in loc; an UNTIL date includes
+// the whole of that day.
+func ParseRule(s string, loc *time.Location) (*Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	r := &Rule{Interval: 1, WeekStart: time.Monday}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%w: %q is not NAME=VALUE", ErrInvalidRule, part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%w: %s given twice", ErrInvalidRule, name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			r.Freq, err = parseFrequency(value)
+		case "INTERVAL":
+			r.Interval, err = parseInt(value, 1, 1000)
+		case "COUNT":
+			r.Count, err = parseInt(value, 1, maxPeriods)
+		case "UNTIL":
+			var date bool
+			r.Until, date, err = parseValue(value, loc)
+			if date {
+				r.Until = r.Until.AddDate(0, 0, 1).Add(-time.Second)
+			}
+		case "BYDAY":
+			r.ByDay, err = parseList(value, parseWeekdayNum)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseList(value, func(v string) (int, error) {
+				n, err := parseInt(v, -31, 31)
+				if n == 0 {
+					return 0, fmt.Errorf("%w: BYMONTHDAY 0", ErrInvalidRule)
+				}
+				return n, err
+			})
+		case "BYMONTH":
+			r.ByMonth, err = parseList(value, func(v string) (time.Month, error) {
+				n, err := parseInt(v, 1, 12)
+				return time.Month(n), err
+			})
+		case "BYSETPOS":
+			r.BySetPos, err = parseList(value, func(v string) (int, error) {
+				n, err := parseInt(v, -366, 366)
+				if n == 0 {
+					return 0, fmt.Errorf("%w: BYSETPOS 0", ErrInvalidRule)
+				}
+				return n, err
+			})
+		case "WKST":
+			r.WeekStart, err = parseWeekday(value)
+		case "BYHOUR", "BYMINUTE", "BYSECOND", "BYWEEKNO", "BYYEARDAY":
+			err = fmt.Errorf("%w: %s", ErrUnsupported, name)
+		default:
+			err = fmt.Errorf("%w: unknown part %s", ErrInvalidRule, name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case r.Freq == 0:
+		return nil, fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	case r.Count != 0 && !r.Until.IsZero():
+		return nil, fmt.Errorf("%w: COUNT and UNTIL cannot both be given", ErrInvalidRule)
+	case r.Freq == Weekly && len(r.ByMonthDay) > 0:
+		return nil, fmt.Errorf("%w: BYMONTHDAY cannot be used with FREQ=WEEKLY", ErrInvalidRule)
+	case len(r.BySetPos) > 0 && len(r.ByDay)+len(r.ByMonthDay)+len(r.ByMonth) == 0:
+		return nil, fmt.Errorf("%w: BYSETPOS needs another BY part", ErrInvalidRule)
+	}
+	for _, w := range r.ByDay {
+		if w.N == 0 {
+			continue
+		}
+		if r.Freq != Monthly && r.Freq != Yearly {
+			return nil, fmt.Errorf("%w: BYDAY %s needs FREQ=MONTHLY or FREQ=YEARLY", ErrInvalidRule, w)
+		}
+		if r.Freq == Monthly && (w.N < -5 || w.N > 5) {
+			return nil, fmt.Errorf("%w: BYDAY %s", ErrInvalidRule, w)
+		}
+	}
+	return r, nil
+}
+
+// String formats the rule as an RRULE value, with UNTIL in UTC.
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + frequencyNames[r.Freq]}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+FormatTime(r.Until))
+	}
+	if len(r.ByMonth) > 0 {
+		parts = append(parts, "BYMONTH="+joinList(r.ByMonth, func(m time.Month) string { return strconv.Itoa(int(m)) }))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinList(r.ByMonthDay, strconv.Itoa))
+	}
+	if len(r.ByDay) > 0 {
+		parts = append(parts, "BYDAY="+joinList(r.ByDay, WeekdayNum.String))
+	}
+	if len(r.BySetPos) > 0 {
+		parts = append(parts, "BYSETPOS="+joinList(r.BySetPos, strconv.Itoa))
+	}
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayNames[r.WeekStart])
+	}
+	return strings.Join(parts, ";")
+}
+
+// each calls yield with the starts of the rule's occurrences in order,
+// beginning with start itself, until yield returns false or the rule ends.
+func (r *Rule) each(start time.Time, yield func(time.Time) bool) {
+	n := 0
+	emit := func(t time.Time) bool {
+		if !r.Until.IsZero() && t.After(r.Until) {
+			return false
+		}
+		n++
+		return yield(t) && (r.Count == 0 || n < r.Count)
+	}
+	if !emit(start) {
+		return
+	}
+
+	loc := start.Location()
+	hour, minute, sec := start.Clock()
+	first := civil(start)
+	for p := 0; p < maxPeriods; p++ {
+		for _, day := range r.candidates(first, start, p) {
+			t := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, sec, start.Nanosecond(), loc)
+			if !t.After(start) {
+				continue
+			}
+			if !emit(t) {
+				return
+			}
+		}
+	}
+}
+
+// candidates returns the days of the p-th period after the one holding
+// first that the rule picks, in order.
+func (r *Rule) candidates(first, start time.Time, p int) []time.Time {
+	var from time.Time
+	var length int
+	switch r.Freq {
+	case Daily:
+		from, length = first.AddDate(0, 0, p*r.Interval), 1
+	case Weekly:
+		weekStart := first.AddDate(0, 0, -int((first.Weekday()-r.WeekStart+7)%7))
+		from, length = weekStart.AddDate(0, 0, 7*p*r.Interval), 7
+	case Monthly:
+		from = time.Date(first.Year(), first.Month()+time.Month(p*r.Interval), 1, 0, 0, 0, 0, time.UTC)
+		length = daysIn(from.Year(), from.Month())
+	case Yearly:
+		from = time.Date(first.Year()+p*r.Interval, time.January, 1, 0, 0, 0, 0, time.UTC)
+		length = daysInYear(from.Year())
+	}
+
+	var days []time.Time
+	for i := 0; i < length; i++ {
+		day := from.AddDate(0, 0, i)
+		if r.matches(day, start) {
+			days = append(days, day)
+		}
+	}
+	if len(r.BySetPos) == 0 {
+		return days
+	}
+
+	var picked []time.Time
+	for _, pos := range r.BySetPos {
+		i := pos - 1
+		if pos < 0 {
+			i = len(days) + pos
+		}
+		if i >= 0 && i < len(days) && !slices.Contains(picked, days[i]) {
+			picked = append(picked, days[i])
+		}
+	}
+	slices.SortFunc(picked, time.Time.Compare)
+	return picked
+}
+
+// matches reports whether the rule picks day, a midnight in UTC, for a
+// series starting at start.
+func (r *Rule) matches(day, start time.Time) bool {
+	if len(r.ByMonth) > 0 && !slices.Contains(r.ByMonth, day.Month()) {
+		return false
+	}
+
+	// Parts left out are taken from the start, as RFC 5545 requires.
+	if len(r.ByDay) == 0 && len(r.ByMonthDay) == 0 {
+		switch r.Freq {
+		case Weekly:
+			return day.Weekday() == start.Weekday()
+		case Monthly:
+			return day.Day() == start.Day()
+		case Yearly:
+			if len(r.ByMonth) == 0 && day.Month() != start.Month() {
+				return false
+			}
+			return day.Day() == start.Day()
+		}
+	}
+
+	if len(r.ByMonthDay) > 0 {
+		dim := daysIn(day.Year(), day.Month())
+		if !slices.ContainsFunc(r.ByMonthDay, func(md int) bool {
+			return md == day.Day() || md < 0 && dim+md+1 == day.Day()
+		}) {
+			return false
+		}
+	}
+	if len(r.ByDay) > 0 {
+		return slices.ContainsFunc(r.ByDay, func(w WeekdayNum) bool {
+			if w.Day != day.Weekday() {
+				return false
+			}
+			if w.N == 0 {
+				return true
+			}
+			// The n-th weekday counts within the month for monthly rules
+			// and yearly rules limited to months, and within the year
+			// otherwise.
+			index, length := day.Day(), daysIn(day.Year(), day.Month())
+			if r.Freq == Yearly && len(r.ByMonth) == 0 {
+				index, length = day.YearDay(), daysInYear(day.Year())
+			}
+			if w.N > 0 {
+				return (index-1)/7+1 == w.N
+			}
+			return -((length-index)/7 + 1) == w.N
+		})
+	}
+	return true
+}
+
+// civil returns the calendar day of t as a midnight in UTC, on which days
+// can be added without daylight saving time getting in the way.
+func civil(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func daysInYear(year int) int {
+	return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+func parseFrequency(v string) (Frequency, error) {
+	for f, name := range frequencyNames {
+		if strings.EqualFold(v, name) {
+			return f, nil
+		}
+	}
+	switch strings.ToUpper(v) {
+	case "SECONDLY", "MINUTELY", "HOURLY":
+		return 0, fmt.Errorf("%w: FREQ=%s", ErrUnsupported, v)
+	}
+	return 0, fmt.Errorf("%w: FREQ=%s", ErrInvalidRule, v)
+}
+
+func parseInt(v string, lo, hi int) (int, error) {
+	n, err := strconv.Atoi(v)
+	if err != nil || n < lo || n > hi {
+		return 0, fmt.Errorf("%w: %q is not a number from %d to %d", ErrInvalidRule, v, lo, hi)
+	}
+	return n, nil
+}
+
+func parseWeekday(v string) (time.Weekday, error) {
+	for i, name := range weekdayNames {
+		if strings.EqualFold(v, name) {
+			return time.Weekday(i), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %q is not a weekday", ErrInvalidRule, v)
+}
+
+func parseWeekdayNum(v string) (WeekdayNum, error) {
+	if len(v) < 2 {
+		return WeekdayNum{}, fmt.Errorf("%w: %q is not a weekday", ErrInvalidRule, v)
+	}
+	day, err := parseWeekday(v[len(v)-2:])
+	if err != nil {
+		return WeekdayNum{}, err
+	}
+	w := WeekdayNum{Day: day}
+	if n := v[:len(v)-2]; n != "" {
+		w.N, err = parseInt(strings.TrimPrefix(n, "+"), -53, 53)
+		if err != nil || w.N == 0 {
+			return WeekdayNum{}, fmt.Errorf("%w: %q is not a weekday", ErrInvalidRule, v)
+		}
+	}
+	return w, nil
+}
+
+func parseList[T any](v string, parse func(string) (T, error)) ([]T, error) {
+	var list []T
+	for _, item := range strings.Split(v, ",") {
+		x, err := parse(strings.TrimSpace(item))
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, x)
+	}
+	return list, nil
+}
+
+func joinList[T any](list []T, format func(T) string) string {
+	items := make([]string, len(list))
+	for i, x := range list {
+		items[i] = format(x)
+	}
+	return strings.Join(items, ",")
+}
@@ -0,0 +1,274 @@
+package recurrence
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	dateTimeUTC   = "20060102T150405Z"
+	dateTimeLocal = "20060102T150405"
+	dateOnly      = "20060102"
+)
+
+// Set is the recurrence of an event: the start of its first occurrence,
+// the rule the rest follow, and dates added to and taken out of it.
+type Set struct {
+	Start   time.Time
+	Rule    *Rule
+	RDates  []time.Time
+	ExDates []time.Time
+}
+
+// Parse reads the RRULE, RDATE and EXDATE lines of an event whose first
+// occurrence starts at start. Dates without a zone or TZID are read in
+// start's location, and a DATE stands for the occurrence starting on that
+// day at start's time of day.
+func Parse(lines []string, start time.Time) (*Set, error) {
+	s := &Set{Start: start}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		head, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q has no value", ErrInvalidRule, line)
+		}
+		params := strings.Split(head, ";")
+		loc := start.Location()
+		for _, param := range params[1:] {
+			name, arg, _ := strings.Cut(param, "=")
+			if strings.EqualFold(name, "TZID") {
+				var err error
+				if loc, err = time.LoadLocation(arg); err != nil {
+					return nil, fmt.Errorf("%w: unknown TZID %q", ErrInvalidRule, arg)
+				}
+			}
+		}
+
+		switch name := strings.ToUpper(params[0]); name {
+		case "RRULE":
+			if s.Rule != nil {
+				return nil, fmt.Errorf("%w: only one RRULE is supported", ErrUnsupported)
+			}
+			rule, err := ParseRule(value, loc)
+			if err != nil {
+				return nil, err
+			}
+			s.Rule = rule
+		case "RDATE", "EXDATE":
+			dates, err := parseList(value, func(v string) (time.Time, error) {
+				t, date, err := parseValue(v, loc)
+				if date {
+					hour, minute, sec := start.In(loc).Clock()
+					t = time.Date(t.Year(), t.Month(), t.Day(), hour, minute, sec, 0, loc)
+				}
+				return t.In(start.Location()), err
+			})
+			if err != nil {
+				return nil, err
+			}
+			if name == "RDATE" {
+				s.RDates = append(s.RDates, dates...)
+			} else {
+				s.ExDates = append(s.ExDates, dates...)
+			}
+		case "EXRULE":
+			return nil, fmt.Errorf("%w: EXRULE", ErrUnsupported)
+		default:
+			return nil, fmt.Errorf("%w: unknown property %s", ErrInvalidRule, params[0])
+		}
+	}
+	if s.Rule == nil && len(s.RDates) == 0 {
+		return nil, fmt.Errorf("%w: an RRULE or RDATE is required", ErrInvalidRule)
+	}
+	return s, nil
+}
+
+// Lines formats the set as RRULE, RDATE and EXDATE lines with dates in
+// UTC, the form Parse reads back.
+func (s *Set) Lines() []string {
+	var lines []string
+	if s.Rule != nil {
+		lines = append(lines, "RRULE:"+s.Rule.String())
+	}
+	if len(s.RDates) > 0 {
+		lines = append(lines, "RDATE:"+joinList(s.RDates, FormatTime))
+	}
+	if len(s.ExDates) > 0 {
+		lines = append(lines, "EXDATE:"+joinList(s.ExDates, FormatTime))
+	}
+	return lines
+}
+
+// Between returns the starts of the occurrences from from up to but not
+// including before, in order and without those excluded. When limit is
+// positive no more than limit are returned.
+func (s *Set) Between(from, before time.Time, limit int) []time.Time {
+	var starts []time.Time
+	add := func(t time.Time) {
+		if !t.Before(from) && t.Before(before) && !s.Excludes(t) {
+			starts = append(starts, t)
+		}
+	}
+	if s.Rule == nil {
+		add(s.Start)
+	} else {
+		s.Rule.each(s.Start, func(t time.Time) bool {
+			if !t.Before(before) {
+				return false
+			}
+			add(t)
+			return limit <= 0 || len(starts) < limit
+		})
+	}
+	for _, t := range s.RDates {
+		add(t)
+	}
+
+	slices.SortFunc(starts, time.Time.Compare)
+	starts = slices.CompactFunc(starts, time.Time.Equal)
+	if limit > 0 && len(starts) > limit {
+		starts = starts[:limit]
+	}
+	return starts
+}
+
+// Includes reports whether an occurrence starts at t.
+func (s *Set) Includes(t time.Time) bool {
+	return len(s.Between(t, t.Add(time.Nanosecond), 1)) == 1
+}
+
+// Excludes reports whether t is one of the set's EXDATEs.
+func (s *Set) Excludes(t time.Time) bool {
+	return slices.ContainsFunc(s.ExDates, t.Equal)
+}
+
+// Exclude adds t to the set's EXDATEs.
+func (s *Set) Exclude(t time.Time) {
+	if !s.Excludes(t) {
+		s.ExDates = append(s.ExDates, t)
+	}
+}
+
+// End returns a time no occurrence starts after, and false when the set
+// goes on for ever.
+func (s *Set) End() (time.Time, bool) {
+	var end time.Time
+	switch {
+	case s.Rule == nil:
+		end = s.Start
+	case !s.Rule.Until.IsZero():
+		end = s.Rule.Until
+	case s.Rule.Count > 0:
+		s.Rule.each(s.Start, func(t time.Time) bool {
+			end = t
+			return true
+		})
+	default:
+		return time.Time{}, false
+	}
+	for _, t := range s.RDates {
+		if t.After(end) {
+			end = t
+		}
+	}
+	return end, true
+}
+
+// Split divides the set at at, the start of one of its occurrences after
+// the first. head keeps the occurrences before at and tail, which starts
+// at at, the rest. A COUNT is shared out between them.
+func (s *Set) Split(at time.Time) (head, tail *Set) {
+	head, tail = &Set{Start: s.Start}, &Set{Start: at}
+	if s.Rule != nil {
+		headRule, tailRule := *s.Rule, *s.Rule
+		if s.Rule.Count > 0 {
+			n := 0
+			s.Rule.each(s.Start, func(t time.Time) bool {
+				if !t.Before(at) {
+					return false
+				}
+				n++
+				return true
+			})
+			headRule.Count, tailRule.Count = n, s.Rule.Count-n
+		} else {
+			headRule.Until = at.Add(-time.Second)
+		}
+		head.Rule, tail.Rule = &headRule, &tailRule
+	}
+	for _, t := range s.RDates {
+		if t.Before(at) {
+			head.RDates = append(head.RDates, t)
+		} else {
+			tail.RDates = append(tail.RDates, t)
+		}
+	}
+	for _, t := range s.ExDates {
+		if t.Before(at) {
+			head.ExDates = append(head.ExDates, t)
+		} else {
+			tail.ExDates = append(tail.ExDates, t)
+		}
+	}
+	return head, tail
+}
+
+// MoveTo returns a copy of the set starting at start, with its UNTIL,
+// RDATEs and EXDATEs moved by as much as its start.
+func (s *Set) MoveTo(start time.Time) *Set {
+	shift := start.Sub(s.Start)
+	moved := &Set{Start: start}
+	if s.Rule != nil {
+		rule := *s.Rule
+		if !rule.Until.IsZero() {
+			rule.Until = rule.Until.Add(shift)
+		}
+		moved.Rule = &rule
+	}
+	for _, t := range s.RDates {
+		moved.RDates = append(moved.RDates, t.Add(shift))
+	}
+	for _, t := range s.ExDates {
+		moved.ExDates = append(moved.ExDates, t.Add(shift))
+	}
+	return moved
+}
+
+// FormatTime formats t as an RFC 5545 date-time in UTC.
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(dateTimeUTC)
+}
+
+// ParseTime parses an RFC 5545 date-time in UTC, as FormatTime writes it.
+func ParseTime(v string) (time.Time, error) {
+	t, err := time.Parse(dateTimeUTC, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %q is not a UTC date-time", ErrInvalidRule, v)
+	}
+	return t, nil
+}
+
+// parseValue parses an RFC 5545 date-time, reading it in loc unless it
+// ends in Z, or a date, reporting which it was.
+func parseValue(v string, loc *time.Location) (time.Time, bool, error) {
+	var t time.Time
+	var err error
+	date := len(v) == len(dateOnly)
+	switch {
+	case date:
+		t, err = time.ParseInLocation(dateOnly, v, loc)
+	case strings.HasSuffix(v, "Z"):
+		t, err = time.Parse(dateTimeUTC, v)
+	default:
+		t, err = time.ParseInLocation(dateTimeLocal, v, loc)
+	}
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("%w: %q is not a date or date-time", ErrInvalidRule, v)
+	}
+	return t, date, nil
+}
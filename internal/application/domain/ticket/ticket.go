@@ -0,0 +1,96 @@
+// Package ticket signs the tickets of event RSVPs and checks them. A
+// ticket is the text "LT1.<claims>.<signature>", short enough for a QR
+// code: claims is the JSON encoding of Claims and signature the Ed25519
+// signature of everything before it, both in unpadded base64url. Anyone
+// with the public key can check a ticket offline.
+package ticket
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Algorithm names the signature algorithm of tickets.
+const Algorithm = "Ed25519"
+
+const prefix = "LT1."
+
+var (
+	ErrInvalidKey = errors.New("ticket signing key must be the base64 encoding of a 32-byte Ed25519 seed")
+	ErrInvalid    = errors.New("invalid ticket")
+)
+
+// Claims are what a ticket vouches for: that the user holds the RSVP for
+// the event. EventID is the series for RSVPs to a recurring event.
+type Claims struct {
+	RsvpID  uuid.UUID `json:"rsvp"`
+	EventID uuid.UUID `json:"event"`
+	UserID  uuid.UUID `json:"user"`
+}
+
+// Signer issues tickets.
+type Signer struct {
+	key ed25519.PrivateKey
+}
+
+// ParseSigningKey returns a signer using the Ed25519 private key whose
+// seed is encoded in standard base64, as made by "openssl rand -base64
+// 32".
+func ParseSigningKey(encoded string) (*Signer, error) {
+	seed, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, ErrInvalidKey
+	}
+	return &Signer{key: ed25519.NewKeyFromSeed(seed)}, nil
+}
+
+// PublicKey returns the key tickets are checked with.
+func (s *Signer) PublicKey() ed25519.PublicKey {
+	return s.key.Public().(ed25519.PublicKey)
+}
+
+// Sign returns the ticket for claims. Ed25519 signatures are
+// deterministic, so an RSVP's ticket is the same each time it is signed.
+func (s *Signer) Sign(claims Claims) string {
+	body, err := json.Marshal(claims)
+	if err != nil {
+		panic(err) // Claims always encode.
+	}
+	signed := prefix + base64.RawURLEncoding.EncodeToString(body)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(ed25519.Sign(s.key, []byte(signed)))
+}
+
+// Verify checks a ticket against the public key and returns its claims.
+func Verify(key ed25519.PublicKey, ticket string) (*Claims, error) {
+	ticket = strings.TrimSpace(ticket)
+	if !strings.HasPrefix(ticket, prefix) {
+		return nil, ErrInvalid
+	}
+	i := strings.LastIndexByte(ticket, '.')
+	if i < len(prefix) {
+		return nil, ErrInvalid
+	}
+	signed, encodedSig := ticket[:i], ticket[i+1:]
+	sig, err := base64.RawURLEncoding.DecodeString(encodedSig)
+	if err != nil || !ed25519.Verify(key, []byte(signed), sig) {
+		return nil, ErrInvalid
+	}
+	body, err := base64.RawURLEncoding.DecodeString(signed[len(prefix):])
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	var claims Claims
+	if err := json.Unmarshal(body, &claims); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	if claims.RsvpID == uuid.Nil || claims.EventID == uuid.Nil || claims.UserID == uuid.Nil {
+		return nil, ErrInvalid
+	}
+	return &claims, nil
+}
@@ -0,0 +1,53 @@
+package timetable
+
+import (
+	"bytes"
+	"encoding/csv"
+)
+
+var csvHeader = []string{
+	"Date", "Hijri Date",
+	"Fajr", "Fajr Iqamah",
+	"Sunrise",
+	"Dhuhr", "Dhuhr Iqamah",
+	"Asr", "Asr Iqamah",
+	"Maghrib", "Maghrib Iqamah",
+	"Isha", "Isha Iqamah",
+}
+
+var csvRamadanHeader = []string{"Suhoor Ends", "Iftar", "Taraweeh", "Qiyam"}
+
+func renderCSV(t *Timetable) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	ramadan := t.hasRamadan()
+	header := csvHeader
+	if ramadan {
+		header = append(append([]string{}, csvHeader...), csvRamadanHeader...)
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	for _, day := range t.Days {
+		row := []string{day.Times.Date.Format("2006-01-02"), day.Times.Hijri.String()}
+		for i, p := range day.prayers() {
+			row = append(row, clock(p.Adhan), clock(p.Iqamah))
+			if i == 0 {
+				row = append(row, clock(day.Times.Sunrise))
+			}
+		}
+		if ramadan {
+			row = append(row, clock(day.SuhoorEnd), clock(day.Iftar), clock(day.Taraweeh), clock(day.Qiyam))
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,76 @@
+package timetable
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mnadev/limestone/internal/application/domain/ical"
+)
+
+const (
+	icsTimeFormat = "20060102T150405Z"
+	// icsDefaultDuration is the length of a prayer event that has no iqamah
+	// to end at.
+	icsDefaultDuration = 15 * time.Minute
+	// icsNightPrayerDuration is the length given to taraweeh and qiyam.
+	icsNightPrayerDuration = time.Hour
+)
+
+// renderICS emits one event per prayer per day, running from the adhan to
+// the iqamah, plus the taraweeh and qiyam of Ramadan nights. Times are
+// written in UTC so that calendar clients do not need the masjid's
+// VTIMEZONE definition.
+func renderICS(t *Timetable) []byte {
+	var b strings.Builder
+	line := func(s string) {
+		b.WriteString(ical.FoldLine(s))
+		b.WriteString("\r\n")
+	}
+
+	stamp := time.Now().UTC().Format(icsTimeFormat)
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//Limestone//Prayer Timetable//EN")
+	line("CALSCALE:GREGORIAN")
+	line("X-WR-CALNAME:" + ical.EscapeText(t.MasjidName+" prayer times"))
+
+	for _, day := range t.Days {
+		date := day.Times.Date.Format("2006-01-02")
+		for _, p := range day.prayers() {
+			end := p.Adhan.Add(icsDefaultDuration)
+			description := "Adhan " + clock(p.Adhan)
+			if !p.Iqamah.IsZero() {
+				description += ", iqamah " + clock(p.Iqamah)
+				if p.Iqamah.After(p.Adhan) {
+					end = p.Iqamah
+				}
+			}
+			description += " (" + day.Times.Hijri.Long() + ")"
+
+			line("BEGIN:VEVENT")
+			line(fmt.Sprintf("UID:%s-%s@%s", date, strings.ToLower(p.Name), t.MasjidId))
+			line("DTSTAMP:" + stamp)
+			line("DTSTART:" + p.Adhan.UTC().Format(icsTimeFormat))
+			line("DTEND:" + end.UTC().Format(icsTimeFormat))
+			line("SUMMARY:" + ical.EscapeText(p.Name))
+			line("DESCRIPTION:" + ical.EscapeText(description))
+			line("TRANSP:TRANSPARENT")
+			line("END:VEVENT")
+		}
+
+		for _, p := range day.nightPrayers() {
+			line("BEGIN:VEVENT")
+			line(fmt.Sprintf("UID:%s-%s@%s", date, strings.ToLower(p.Name), t.MasjidId))
+			line("DTSTAMP:" + stamp)
+			line("DTSTART:" + p.Adhan.UTC().Format(icsTimeFormat))
+			line("DTEND:" + p.Adhan.Add(icsNightPrayerDuration).UTC().Format(icsTimeFormat))
+			line("SUMMARY:" + ical.EscapeText(p.Name))
+			line("TRANSP:TRANSPARENT")
+			line("END:VEVENT")
+		}
+	}
+
+	line("END:VCALENDAR")
+	return []byte(b.String())
+}
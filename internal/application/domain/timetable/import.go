@@ -0,0 +1,128 @@
+package timetable
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+)
+
+var ErrInvalidTimetable = errors.New("invalid prayer timetable")
+
+// MaxImportDays caps how many days a single import may cover.
+const MaxImportDays = 731
+
+// importColumns are the columns an imported CSV must have. Matching is case
+// insensitive and other columns, such as the iqamah columns written by the
+// CSV export, are ignored, so an exported file can be edited and imported
+// back.
+var importColumns = []string{"date", "fajr", "sunrise", "dhuhr", "asr", "maghrib", "isha"}
+
+// clockLayouts are the time formats accepted in an imported CSV.
+var clockLayouts = []string{"15:04", "15:04:05", "3:04 PM", "3:04PM", "3:04:05 PM", "3:04:05PM"}
+
+// ParseCSV reads a timetable with one row per day. Dates must be YYYY-MM-DD,
+// consecutive and without gaps or repeats, and each day's times must be in
+// prayer order. The returned overrides have no ID or masjid set.
+func ParseCSV(r io.Reader) ([]entity.PrayerTimeOverride, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("%w: file is empty", ErrInvalidTimetable)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTimetable, err)
+	}
+	index := map[string]int{}
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, name := range importColumns {
+		if _, ok := index[name]; !ok {
+			return nil, fmt.Errorf("%w: missing %q column", ErrInvalidTimetable, name)
+		}
+	}
+
+	var (
+		overrides []entity.PrayerTimeOverride
+		previous  time.Time
+	)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidTimetable, err)
+		}
+		line, _ := reader.FieldPos(0)
+		if len(overrides) == MaxImportDays {
+			return nil, fmt.Errorf("%w: a timetable may cover at most %d days", ErrInvalidTimetable, MaxImportDays)
+		}
+
+		cell := func(name string) string {
+			if i := index[name]; i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		date, err := time.Parse("2006-01-02", cell("date"))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: date %q must be in YYYY-MM-DD format", ErrInvalidTimetable, line, cell("date"))
+		}
+		if !previous.IsZero() && !date.Equal(previous.AddDate(0, 0, 1)) {
+			return nil, fmt.Errorf("%w: line %d: expected %s after %s", ErrInvalidTimetable, line,
+				previous.AddDate(0, 0, 1).Format("2006-01-02"), previous.Format("2006-01-02"))
+		}
+		previous = date
+
+		o := entity.PrayerTimeOverride{Date: date.Format("2006-01-02")}
+		for _, f := range []struct {
+			name string
+			dst  *string
+		}{
+			{"fajr", &o.Fajr},
+			{"sunrise", &o.Sunrise},
+			{"dhuhr", &o.Dhuhr},
+			{"asr", &o.Asr},
+			{"maghrib", &o.Maghrib},
+			{"isha", &o.Isha},
+		} {
+			clock, err := parseClock(cell(f.name))
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: unrecognised %s time %q", ErrInvalidTimetable, line, f.name, cell(f.name))
+			}
+			*f.dst = clock
+		}
+		if _, err := prayertimes.FromOverride(date, time.UTC, &o); err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidTimetable, line, err)
+		}
+		overrides = append(overrides, o)
+	}
+
+	if len(overrides) == 0 {
+		return nil, fmt.Errorf("%w: no days found", ErrInvalidTimetable)
+	}
+	return overrides, nil
+}
+
+// parseClock normalises a time in any of clockLayouts to HH:MM. Seconds are
+// dropped.
+func parseClock(s string) (string, error) {
+	s = strings.ToUpper(s)
+	for _, layout := range clockLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.Format("15:04"), nil
+		}
+	}
+	return "", fmt.Errorf("unrecognised time %q", s)
+}
@@ -0,0 +1,166 @@
+package timetable
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// The PDF is a single landscape A4 page laid out with the standard Courier
+// and Helvetica fonts, which every viewer ships, so no font is embedded.
+const (
+	pdfPageWidth  = 842
+	pdfPageHeight = 595
+	pdfMargin     = 36
+	pdfRowHeight  = 13
+	pdfBodySize   = 8.5
+)
+
+// pdfColumns are measured in Courier characters, 150 of which fit between
+// the margins at pdfBodySize. The Hijri column holds the longest date,
+// such as "30 Jumada al-Thaniyah 1446".
+var pdfColumns = []struct {
+	Title string
+	Width int
+}{
+	{"Date", 11}, {"Hijri", 27},
+	{"Fajr", 8}, {"Iqamah", 8},
+	{"Sunrise", 9},
+	{"Dhuhr", 8}, {"Iqamah", 8},
+	{"Asr", 8}, {"Iqamah", 8},
+	{"Maghrib", 8}, {"Iqamah", 8},
+	{"Isha", 8}, {"Iqamah", 8},
+	{"Suhoor", 8}, {"Taraweeh", 10}, {"Qiyam", 8},
+}
+
+// pdfRamadanColumns is the number of trailing pdfColumns only shown when the
+// month overlaps Ramadan. Iftar is Maghrib and needs no column of its own.
+const pdfRamadanColumns = 3
+
+func renderPDF(t *Timetable) []byte {
+	var content strings.Builder
+	text := func(font string, size float64, x, y int, s string) {
+		fmt.Fprintf(&content, "BT /%s %.1f Tf %d %d Td (%s) Tj ET\n", font, size, x, y, pdfString(s))
+	}
+
+	top := pdfPageHeight - pdfMargin
+	text("F2", 16, pdfMargin, top-12, t.MasjidName)
+	text("F2", 10, pdfMargin, top-30, "Prayer timetable - "+pdfPeriod(t))
+
+	y := top - 60
+	columns := len(pdfColumns)
+	ramadan := t.hasRamadan()
+	if !ramadan {
+		columns -= pdfRamadanColumns
+	}
+	header := make([]string, columns)
+	for i := range header {
+		header[i] = pdfColumns[i].Title
+	}
+	text("F3", pdfBodySize, pdfMargin, y, pdfRow(header))
+	fmt.Fprintf(&content, "0.5 w %d %d m %d %d l S\n", pdfMargin, y-4, pdfPageWidth-pdfMargin, y-4)
+
+	for _, day := range t.Days {
+		y -= pdfRowHeight
+		cells := []string{day.Times.Date.Format("Mon Jan 02"), day.Times.Hijri.Long()}
+		for i, p := range day.prayers() {
+			cells = append(cells, clock(p.Adhan), clock(p.Iqamah))
+			if i == 0 {
+				cells = append(cells, clock(day.Times.Sunrise))
+			}
+		}
+		if ramadan {
+			cells = append(cells, clock(day.SuhoorEnd), clock(day.Taraweeh), clock(day.Qiyam))
+		}
+		text("F1", pdfBodySize, pdfMargin, y, pdfRow(cells))
+	}
+
+	text("F1", 7, pdfMargin, pdfMargin, "Times are in "+pdfTimeZone(t)+". Hijri dates are calculated and may differ by a day from local moon sighting.")
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Contents 4 0 R /Resources << /Font << /F1 5 0 R /F2 6 0 R /F3 7 0 R >> >> >>", pdfPageWidth, pdfPageHeight),
+		fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier-Bold /Encoding /WinAnsiEncoding >>",
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+func pdfRow(cells []string) string {
+	var b strings.Builder
+	for i, c := range cells {
+		if i < len(pdfColumns) {
+			width := pdfColumns[i].Width
+			if len(c) >= width {
+				// Keep a space before the next column.
+				c = c[:width-1]
+			}
+			fmt.Fprintf(&b, "%-*s", width, c)
+		}
+	}
+	return strings.TrimRight(b.String(), " ")
+}
+
+// pdfPeriod names the Gregorian month and the Hijri months it overlaps.
+func pdfPeriod(t *Timetable) string {
+	period := fmt.Sprintf("%s %d", t.Month, t.Year)
+	if len(t.Days) == 0 {
+		return period
+	}
+
+	first, last := t.Days[0].Times.Hijri, t.Days[len(t.Days)-1].Times.Hijri
+	switch {
+	case first.Year != last.Year:
+		return fmt.Sprintf("%s (%s %d - %s %d AH)", period, first.MonthName(), first.Year, last.MonthName(), last.Year)
+	case first.Month != last.Month:
+		return fmt.Sprintf("%s (%s - %s %d AH)", period, first.MonthName(), last.MonthName(), last.Year)
+	}
+	return fmt.Sprintf("%s (%s %d AH)", period, first.MonthName(), first.Year)
+}
+
+func pdfTimeZone(t *Timetable) string {
+	if len(t.Days) == 0 {
+		return "local time"
+	}
+	return t.Days[0].Times.Date.Location().String()
+}
+
+// pdfString escapes s for use in a PDF literal string. Characters outside
+// Latin-1 have no glyph in the standard fonts' WinAnsiEncoding and are
+// replaced with '?'.
+func pdfString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20:
+			b.WriteByte(' ')
+		case r < 0x80:
+			b.WriteRune(r)
+		case r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,144 @@
+// Package timetable renders a month of a masjid's adhan and iqamah times as a
+// downloadable file.
+package timetable
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported timetable format")
+	ErrInvalidPeriod     = errors.New("year must be between 1938 and 2076 and month between 1 and 12")
+)
+
+type Format int64
+
+const (
+	CSV Format = iota
+	ICS
+	PDF
+)
+
+// Day is one row of a timetable. Iqamah times without a rule are zero.
+//
+// SuhoorEnd and Iftar are set on the fasting days of Ramadan. Taraweeh and
+// Qiyam are those prayed on the night that begins on the evening of the day,
+// so the eve of the first fast carries the first taraweeh and the last fast
+// carries none.
+type Day struct {
+	Times     prayertimes.Times
+	Iqamah    prayertimes.Iqamah
+	SuhoorEnd time.Time
+	Iftar     time.Time
+	Taraweeh  time.Time
+	Qiyam     time.Time
+}
+
+type Timetable struct {
+	MasjidId   string
+	MasjidName string
+	Year       int
+	Month      time.Month
+	Days       []Day
+}
+
+// File is a rendered timetable ready to be sent to a client.
+type File struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
+
+// ValidatePeriod checks that year and month name a calendar month covered by
+// the Hijri tables.
+func ValidatePeriod(year, month int) error {
+	if year < 1938 || year > 2076 || month < 1 || month > 12 {
+		return ErrInvalidPeriod
+	}
+	return nil
+}
+
+// Render encodes t in the given format.
+func Render(t *Timetable, format Format) (*File, error) {
+	var (
+		content     []byte
+		contentType string
+		ext         string
+		err         error
+	)
+	switch format {
+	case CSV:
+		content, err = renderCSV(t)
+		contentType, ext = "text/csv; charset=utf-8", "csv"
+	case ICS:
+		content = renderICS(t)
+		contentType, ext = "text/calendar; charset=utf-8", "ics"
+	case PDF:
+		content = renderPDF(t)
+		contentType, ext = "application/pdf", "pdf"
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &File{
+		Name:        fmt.Sprintf("prayer-timetable-%04d-%02d.%s", t.Year, int(t.Month), ext),
+		ContentType: contentType,
+		Content:     content,
+	}, nil
+}
+
+// prayer is one adhan and its iqamah within a Day.
+type prayer struct {
+	Name   string
+	Adhan  time.Time
+	Iqamah time.Time
+}
+
+func (d Day) prayers() []prayer {
+	return []prayer{
+		{"Fajr", d.Times.Fajr, d.Iqamah.Fajr},
+		{"Dhuhr", d.Times.Dhuhr, d.Iqamah.Dhuhr},
+		{"Asr", d.Times.Asr, d.Iqamah.Asr},
+		{"Maghrib", d.Times.Maghrib, d.Iqamah.Maghrib},
+		{"Isha", d.Times.Isha, d.Iqamah.Isha},
+	}
+}
+
+// nightPrayers returns the day's scheduled taraweeh and qiyam, with the start
+// time in Adhan.
+func (d Day) nightPrayers() []prayer {
+	var prayers []prayer
+	if !d.Taraweeh.IsZero() {
+		prayers = append(prayers, prayer{Name: "Taraweeh", Adhan: d.Taraweeh})
+	}
+	if !d.Qiyam.IsZero() {
+		prayers = append(prayers, prayer{Name: "Qiyam", Adhan: d.Qiyam})
+	}
+	return prayers
+}
+
+// hasRamadan reports whether any day of t carries Ramadan times, in which
+// case the renderers add columns for them.
+func (t *Timetable) hasRamadan() bool {
+	for _, d := range t.Days {
+		if !d.SuhoorEnd.IsZero() || !d.Taraweeh.IsZero() || !d.Qiyam.IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
+// clock formats t as HH:MM, or an empty string for the zero time.
+func clock(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("15:04")
+}
@@ -2,15 +2,20 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"github.com/google/uuid"
 	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/audio"
 	"github.com/mnadev/limestone/internal/application/domain/entity"
 	"github.com/mnadev/limestone/internal/application/helper"
 	"github.com/mnadev/limestone/internal/application/services"
+	"github.com/mnadev/limestone/internal/infrastructure/auth"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"io"
 	"strings"
-	"time"
 )
 
 type AdhanGrpcHandler struct {
@@ -50,20 +55,18 @@ func (h *AdhanGrpcHandler) CreateAdhan(ctx context.Context, req *pb.CreateAdhanF
 	}
 
 	if !helper.IsAudioFile(audioBytes) {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid adhan file type. Only MP3 and WAV are supported for now.")
+		return nil, adhanError(audio.ErrUnsupportedFormat, "")
 	}
 
 	adhanEntity := &entity.Adhan{
-		ID:        uuid.New(),
-		MasjidId:  masjidID,
-		File:      audioBytes,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		MasjidId: masjidID,
+		Title:    adhanFile.GetTitle(),
+		Muezzin:  adhanFile.GetMuezzin(),
 	}
 
-	createdAdhan, err := h.Svc.CreateAdhan(ctx, adhanEntity)
+	createdAdhan, err := h.Svc.CreateAdhan(ctx, adhanEntity, audioBytes)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create adhan file: %v", err)
+		return nil, adhanError(err, "create adhan file")
 	}
 
 	return helper.StandardAdhanResponse(codes.OK, "success", "adhan file created successfully", createdAdhan, nil)
@@ -88,7 +91,8 @@ func (h *AdhanGrpcHandler) UpdateAdhan(ctx context.Context, req *pb.UpdateAdhanF
 	masjidID := adhanFile.GetMasjidId()
 	audioBytes := adhanFile.GetFile()
 
-	if len(audioBytes) == 0 {
+	// Audio may be left out when only the title or muezzin change.
+	if len(audioBytes) == 0 && adhanFile.GetTitle() == "" && adhanFile.GetMuezzin() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "adhan file content is required")
 	}
 
@@ -100,31 +104,23 @@ func (h *AdhanGrpcHandler) UpdateAdhan(ctx context.Context, req *pb.UpdateAdhanF
 		return nil, status.Errorf(codes.InvalidArgument, "adhan file size exceeds maximum allowed size (%d MB)", maxAdhanFileSizeMB)
 	}
 
-	if !helper.IsAudioFile(audioBytes) {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid adhan file type. Only MP3 and WAV are supported for now.")
-	}
-
-	existingAdhan, err := h.Svc.GetAdhanByID(ctx, idStr)
-	if err != nil {
-		if status.Code(err) == codes.NotFound {
-			return nil, status.Errorf(codes.NotFound, "adhan file with ID %s not found", id)
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get existing adhan file: %v", err)
+	if len(audioBytes) > 0 && !helper.IsAudioFile(audioBytes) {
+		return nil, adhanError(audio.ErrUnsupportedFormat, "")
 	}
 
 	updatedAdhanEntity := &entity.Adhan{
-		ID:        id,
-		MasjidId:  masjidID,
-		File:      audioBytes,
-		UpdatedAt: time.Now(),
-	}
-	if len(audioBytes) == 0 {
-		updatedAdhanEntity.File = existingAdhan.File
+		ID:       id,
+		MasjidId: masjidID,
+		Title:    adhanFile.GetTitle(),
+		Muezzin:  adhanFile.GetMuezzin(),
 	}
 
-	updatedAdhan, err := h.Svc.UpdateAdhan(ctx, updatedAdhanEntity)
+	updatedAdhan, err := h.Svc.UpdateAdhan(ctx, updatedAdhanEntity, audioBytes)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update adhan file: %v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "adhan file with ID %s not found", id)
+		}
+		return nil, adhanError(err, "update adhan file")
 	}
 
 	return helper.StandardAdhanResponse(codes.OK, "success", "adhan file updated successfully", updatedAdhan, nil)
@@ -166,3 +162,255 @@ func (h *AdhanGrpcHandler) DeleteAdhan(ctx context.Context, req *pb.DeleteAdhanF
 
 	return helper.StandardAdhanResponse(codes.OK, "success", "adhan file deleted successfully", nil, &pb.DeleteAdhanFileResponse{})
 }
+
+func (h *AdhanGrpcHandler) UploadAdhan(stream pb.AdhanService_UploadAdhanServer) error {
+	ctx := stream.Context()
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "UploadAdhan"); err != nil {
+		return err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	first, err := stream.Recv()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to receive adhan metadata: %v", err)
+	}
+	metadata := first.GetMetadata()
+	if metadata == nil {
+		return status.Errorf(codes.InvalidArgument, "the first message must carry the adhan metadata")
+	}
+
+	adhan, err := uploadedAdhan(metadata.GetId(), metadata.GetMasjidId())
+	if err != nil {
+		return err
+	}
+	adhan.Title = metadata.GetTitle()
+	adhan.Muezzin = metadata.GetMuezzin()
+
+	next := func() ([]byte, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if req.GetMetadata() != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "adhan metadata may only be sent once")
+		}
+		return req.GetChunk(), nil
+	}
+	uploaded, err := h.Svc.UploadAdhan(ctx, adhan, next)
+	if err != nil {
+		return adhanError(err, "upload adhan file")
+	}
+
+	resp, err := helper.StandardAdhanResponse(codes.OK, "success", "adhan file uploaded successfully", uploaded, nil)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+func (h *AdhanGrpcHandler) DownloadAdhan(req *pb.DownloadAdhanRequest, stream pb.AdhanService_DownloadAdhanServer) error {
+	ctx := stream.Context()
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "DownloadAdhan"); err != nil {
+		return err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	if req.GetId() == "" {
+		return status.Errorf(codes.InvalidArgument, "adhan file ID is required")
+	}
+	if req.GetOffset() < 0 || req.GetLength() < 0 {
+		return status.Errorf(codes.InvalidArgument, "offset and length must not be negative")
+	}
+
+	audio, err := h.Svc.OpenAdhanAudio(ctx, req.GetId(), req.GetRendition())
+	if err != nil {
+		return adhanError(err, "open adhan file")
+	}
+	defer audio.Close()
+	if helper.ETagMatches(req.GetIfNoneMatch(), audio.ETag) {
+		return stream.Send(&pb.AdhanChunk{
+			TotalSize:   audio.Size,
+			ContentType: audio.ContentType,
+			Etag:        audio.ETag,
+			NotModified: true,
+		})
+	}
+	if req.GetOffset() > audio.Size {
+		return status.Errorf(codes.OutOfRange, "offset %d is past the end of the %d byte file", req.GetOffset(), audio.Size)
+	}
+	if _, err := audio.Seek(req.GetOffset(), io.SeekStart); err != nil {
+		return status.Errorf(codes.Internal, "failed to seek adhan file: %v", err)
+	}
+	var reader io.Reader = audio
+	if req.GetLength() > 0 {
+		reader = io.LimitReader(audio, req.GetLength())
+	}
+
+	offset := req.GetOffset()
+	first := true
+	buf := make([]byte, services.AdhanChunkSize)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 || first {
+			chunk := &pb.AdhanChunk{Data: buf[:n], Offset: offset}
+			if first {
+				chunk.TotalSize = audio.Size
+				chunk.ContentType = audio.ContentType
+				chunk.Etag = audio.ETag
+				first = false
+			}
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+			offset += int64(n)
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return adhanError(err, "read adhan file")
+		}
+	}
+}
+
+func (h *AdhanGrpcHandler) ListAdhans(ctx context.Context, req *pb.ListAdhansRequest) (*pb.StandardAdhanResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "ListAdhans"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	if req.GetMasjidId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "masjid ID is required")
+	}
+
+	adhans, err := h.Svc.ListAdhans(ctx, req.GetMasjidId())
+	if err != nil {
+		return nil, adhanError(err, "list adhan files")
+	}
+
+	return helper.StandardListAdhansResponse(codes.OK, "success", "adhan files retrieved successfully", adhans)
+}
+
+func (h *AdhanGrpcHandler) GetAdhanAssignments(ctx context.Context, req *pb.GetAdhanAssignmentsRequest) (*pb.StandardAdhanResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "GetAdhanAssignments"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	if req.GetMasjidId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "masjid ID is required")
+	}
+
+	assignments, err := h.Svc.GetAdhanAssignments(ctx, req.GetMasjidId())
+	if err != nil {
+		return nil, adhanError(err, "get adhan assignments")
+	}
+
+	return helper.StandardAdhanAssignmentsResponse(codes.OK, "success", "adhan assignments retrieved successfully", req.GetMasjidId(), assignments)
+}
+
+func (h *AdhanGrpcHandler) AssignAdhan(ctx context.Context, req *pb.AssignAdhanRequest) (*pb.StandardAdhanResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "AssignAdhan"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	if req.GetMasjidId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "masjid ID is required")
+	}
+	if req.GetAdhanId() != "" {
+		if _, err := uuid.Parse(req.GetAdhanId()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid adhan file ID format: %v", err)
+		}
+	}
+
+	assignments, err := h.Svc.AssignAdhan(ctx, req.GetMasjidId(), entity.Prayer(req.GetPrayer()), req.GetAdhanId())
+	if err != nil {
+		return nil, adhanError(err, "assign adhan")
+	}
+
+	return helper.StandardAdhanAssignmentsResponse(codes.OK, "success", "adhan assigned successfully", req.GetMasjidId(), assignments)
+}
+
+// uploadedAdhan validates the metadata of an upload and returns the adhan
+// it describes. An empty id creates a new adhan.
+func uploadedAdhan(idStr, masjidID string) (*entity.Adhan, error) {
+	adhan := &entity.Adhan{MasjidId: masjidID}
+	if idStr == "" {
+		if masjidID == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "masjid ID is required")
+		}
+		return adhan, nil
+	}
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid adhan file ID format: %v", err)
+	}
+	adhan.ID = id
+	return adhan, nil
+}
+
+// invalidAdhanAudio rejects an uploaded file, naming it as the offending
+// field so that clients can show the reason next to their upload.
+func invalidAdhanAudio(err error) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+	detailed, detailErr := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       "file",
+			Description: err.Error(),
+		}},
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func adhanError(err error, action string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Errorf(codes.NotFound, "adhan file not found")
+	case errors.Is(err, helper.ErrAdhanRenditionNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, audio.ErrUnsupportedFormat), errors.Is(err, audio.ErrMalformed),
+		errors.Is(err, audio.ErrTruncated), errors.Is(err, audio.ErrTooLong),
+		errors.Is(err, audio.ErrSilent), errors.Is(err, helper.ErrEmptyAdhanAudio):
+		return invalidAdhanAudio(err)
+	case errors.Is(err, helper.ErrInvalidPrayer), errors.Is(err, helper.ErrAdhanNotInMasjid):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, helper.ErrAdhanTooLarge):
+		return status.Errorf(codes.InvalidArgument, "%v (%d MB)", err, services.MaxStreamedAdhanSize>>20)
+	case errors.Is(err, context.Canceled):
+		return status.Errorf(codes.Canceled, "%s: %v", action, err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
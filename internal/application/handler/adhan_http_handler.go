@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"errors"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/infrastructure/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+// RegisterAdhanHTTPRoutes adds the REST counterparts of UploadAdhan and
+// DownloadAdhan to mux. The generated gateway cannot serve streaming
+// methods in-process, so these stream the request and response bodies
+// directly instead.
+func RegisterAdhanHTTPRoutes(mux *runtime.ServeMux, h *AdhanGrpcHandler) error {
+	if err := mux.HandlePath(http.MethodPost, "/v1/adhan/upload", h.uploadAdhanHTTP); err != nil {
+		return err
+	}
+	return mux.HandlePath(http.MethodGet, "/v1/adhan/{id}/audio", h.downloadAdhanHTTP)
+}
+
+// uploadAdhanHTTP accepts a multipart/form-data body. The masjid_id, id,
+// title and muezzin fields, which may also be given in the query string,
+// must precede the file part; the file part is stored as it is read.
+func (h *AdhanGrpcHandler) uploadAdhanHTTP(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	ctx := r.Context()
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "UploadAdhan"); err != nil {
+		writeAdhanHTTPError(w, err)
+		return
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	reader, err := r.MultipartReader()
+	if err != nil {
+		writeAdhanHTTPError(w, status.Errorf(codes.InvalidArgument, "expected a multipart/form-data body: %v", err))
+		return
+	}
+
+	fields := map[string]string{
+		"masjid_id": r.URL.Query().Get("masjid_id"),
+		"id":        r.URL.Query().Get("id"),
+		"title":     r.URL.Query().Get("title"),
+		"muezzin":   r.URL.Query().Get("muezzin"),
+	}
+	var file *multipart.Part
+	for file == nil {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			writeAdhanHTTPError(w, status.Errorf(codes.InvalidArgument, "the file part is required"))
+			return
+		}
+		if err != nil {
+			writeAdhanHTTPError(w, status.Errorf(codes.InvalidArgument, "malformed multipart body: %v", err))
+			return
+		}
+		switch part.FormName() {
+		case "file":
+			file = part
+		case "masjid_id", "id", "title", "muezzin":
+			value, err := io.ReadAll(io.LimitReader(part, 255))
+			if err != nil {
+				writeAdhanHTTPError(w, status.Errorf(codes.InvalidArgument, "malformed multipart body: %v", err))
+				return
+			}
+			fields[part.FormName()] = string(value)
+		}
+	}
+
+	adhan, err := uploadedAdhan(fields["id"], fields["masjid_id"])
+	if err != nil {
+		writeAdhanHTTPError(w, err)
+		return
+	}
+	adhan.Title = fields["title"]
+	adhan.Muezzin = fields["muezzin"]
+	buf := make([]byte, 32<<10)
+	next := func() ([]byte, error) {
+		n, err := file.Read(buf)
+		if n > 0 {
+			return buf[:n], nil
+		}
+		return nil, err
+	}
+	uploaded, err := h.Svc.UploadAdhan(ctx, adhan, next)
+	if err != nil {
+		writeAdhanHTTPError(w, adhanError(err, "upload adhan file"))
+		return
+	}
+
+	resp, err := helper.StandardAdhanResponse(codes.OK, "success", "adhan file uploaded successfully", uploaded, nil)
+	if err != nil {
+		writeAdhanHTTPError(w, err)
+		return
+	}
+	body, err := (&runtime.JSONPb{}).Marshal(resp)
+	if err != nil {
+		writeAdhanHTTPError(w, status.Errorf(codes.Internal, "failed to encode response: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// downloadAdhanHTTP serves an adhan's audio, or the rendition named by the
+// rendition query parameter, honouring Range and conditional requests.
+// Audio with a hash is tagged with it, so If-None-Match and If-Range match
+// on content rather than on modification time.
+func (h *AdhanGrpcHandler) downloadAdhanHTTP(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx := r.Context()
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "DownloadAdhan"); err != nil {
+		writeAdhanHTTPError(w, err)
+		return
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	audio, err := h.Svc.OpenAdhanAudio(ctx, pathParams["id"], r.URL.Query().Get("rendition"))
+	if err != nil {
+		writeAdhanHTTPError(w, adhanError(err, "open adhan file"))
+		return
+	}
+	defer audio.Close()
+	w.Header().Set("Content-Type", audio.ContentType)
+	if audio.ETag != "" {
+		w.Header().Set("ETag", audio.ETag)
+	}
+	http.ServeContent(w, r, "", audio.ModTime, audio)
+}
+
+func writeAdhanHTTPError(w http.ResponseWriter, err error) {
+	s, _ := status.FromError(err)
+	helper.WriteJSONError(w, runtime.HTTPStatusFromCode(s.Code()), s.Code().String(), s.Message())
+}
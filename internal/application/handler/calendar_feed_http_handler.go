@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/services"
+	"gorm.io/gorm"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// calendarFeedMaxAge is how long calendar clients may use a feed before
+// asking for it again.
+const calendarFeedMaxAge = "max-age=900"
+
+// CalendarFeedHTTPHandler serves calendar feeds at
+// services.CalendarFeedPath followed by a feed's token and ".ics". Feeds
+// are read by calendar clients that cannot log in, so the token is all
+// that protects them and the handler is served outside the JWT
+// interceptor.
+type CalendarFeedHTTPHandler struct {
+	Svc *services.CalendarFeedService
+}
+
+func NewCalendarFeedHTTPHandler(svc *services.CalendarFeedService) *CalendarFeedHTTPHandler {
+	return &CalendarFeedHTTPHandler{Svc: svc}
+}
+
+// ServeHTTP serves the feed named by the last element of the path, tagged
+// with its content so that clients polling with If-None-Match are answered
+// 304 Not Modified until the feed changes.
+func (h *CalendarFeedHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, services.CalendarFeedPath)
+	token, ok := strings.CutSuffix(name, ".ics")
+	if !ok || token == "" || strings.Contains(token, "/") {
+		helper.WriteJSONError(w, http.StatusNotFound, "NotFound", "calendar feed not found")
+		return
+	}
+
+	feed, err := h.Svc.Render(r.Context(), token)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		helper.WriteJSONError(w, http.StatusNotFound, "NotFound", "calendar feed not found")
+		return
+	}
+	if err != nil {
+		log.Printf("failed to render calendar feed: %v", err)
+		helper.WriteJSONError(w, http.StatusInternalServerError, "Internal", "failed to render calendar feed")
+		return
+	}
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Cache-Control", calendarFeedMaxAge)
+	w.Header().Set("ETag", feed.ETag)
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(feed.Body))
+}
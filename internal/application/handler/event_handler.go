@@ -2,26 +2,33 @@ package handler
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"github.com/google/uuid"
 	pb "github.com/mnadev/limestone/gen/go"
 	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/hijri"
+	"github.com/mnadev/limestone/internal/application/domain/recurrence"
+	"github.com/mnadev/limestone/internal/application/domain/ticket"
 	"github.com/mnadev/limestone/internal/application/helper"
 	"github.com/mnadev/limestone/internal/application/services"
+	"github.com/mnadev/limestone/internal/infrastructure/auth"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
+	"strings"
 	"time"
 )
 
 type EventGrpcHandler struct {
 	pb.UnimplementedEventServiceServer
-	Svc *services.EventService
+	Svc    *services.EventService
+	Feeds  *services.CalendarFeedService
+	Orders *services.OrderService
 }
 
-func NewEventGrpcHandler(svc *services.EventService) *EventGrpcHandler {
-	return &EventGrpcHandler{Svc: svc}
+func NewEventGrpcHandler(svc *services.EventService, feeds *services.CalendarFeedService, orders *services.OrderService) *EventGrpcHandler {
+	return &EventGrpcHandler{Svc: svc, Feeds: feeds, Orders: orders}
 }
 
 func (h *EventGrpcHandler) CreateEvent(ctx context.Context, req *pb.CreateEventRequest) (*pb.StandardEventResponse, error) {
@@ -39,12 +46,27 @@ func (h *EventGrpcHandler) CreateEvent(ctx context.Context, req *pb.CreateEventR
 		RequiresRsvp:      event.GetRequiresRsvp(),
 		MaxParticipants:   event.GetMaxParticipants(),
 		LivestreamLink:    event.GetLivestreamLink(),
+		Recurrence:        strings.Join(event.GetRecurrence(), "\n"),
 		CreatedAt:         time.Now(),
 		UpdatedAt:         time.Now(),
 	}
+	eventEntity.SetTypes(eventTypeNames(event.GetTypes())...)
+	if len(event.GetRoomIds()) > 0 {
+		rooms, err := roomIDs(event.GetRoomIds())
+		if err != nil {
+			return nil, err
+		}
+		eventEntity.SetRooms(rooms...)
+	}
 
 	createdEvent, err := h.Svc.Create(ctx, eventEntity)
 	if err != nil {
+		switch {
+		case isRecurrenceError(err):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid recurrence: %v", err)
+		case isBookingError(err):
+			return nil, bookingError(err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to create event: %v", err)
 	}
 
@@ -62,9 +84,14 @@ func (h *EventGrpcHandler) UpdateEvent(ctx context.Context, req *pb.UpdateEventR
 		return nil, status.Errorf(codes.InvalidArgument, "event ID is required for update")
 	}
 
-	eventID, err := uuid.Parse(eventIDStr)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid event ID format: %v", err)
+	seriesID, occurrenceStart, isOccurrence := entity.ParseOccurrenceID(eventIDStr)
+	eventID := seriesID
+	if !isOccurrence {
+		var err error
+		eventID, err = uuid.Parse(eventIDStr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid event ID format: %v", err)
+		}
 	}
 
 	eventEntity := &entity.Event{
@@ -102,9 +129,36 @@ func (h *EventGrpcHandler) UpdateEvent(ctx context.Context, req *pb.UpdateEventR
 	if event.GetLivestreamLink() != "" {
 		eventEntity.LivestreamLink = event.GetLivestreamLink()
 	}
+	if len(event.GetRecurrence()) > 0 {
+		eventEntity.Recurrence = strings.Join(event.GetRecurrence(), "\n")
+	}
+	if len(event.GetTypes()) > 0 {
+		eventEntity.SetTypes(eventTypeNames(event.GetTypes())...)
+	}
+	if len(event.GetRoomIds()) > 0 {
+		rooms, err := roomIDs(event.GetRoomIds())
+		if err != nil {
+			return nil, err
+		}
+		eventEntity.SetRooms(rooms...)
+	}
 
-	updatedEvent, err := h.Svc.Update(ctx, eventEntity)
+	var updatedEvent *entity.Event
+	var err error
+	if isOccurrence {
+		updatedEvent, err = h.Svc.UpdateOccurrence(ctx, seriesID.String(), occurrenceStart, entity.RecurrenceScope(req.GetScope()), eventEntity)
+	} else {
+		updatedEvent, err = h.Svc.Update(ctx, eventEntity)
+	}
 	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, status.Errorf(codes.NotFound, "event not found")
+		case isRecurrenceError(err):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid recurrence: %v", err)
+		case isBookingError(err):
+			return nil, bookingError(err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update event: %v", err)
 	}
 
@@ -117,18 +171,22 @@ func (h *EventGrpcHandler) GetEvent(ctx context.Context, req *pb.GetEventRequest
 		return nil, status.Errorf(codes.InvalidArgument, "event ID is required")
 	}
 
-	eventID, err := uuid.Parse(eventIDStr)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid event ID format: %v", err)
+	if _, _, ok := entity.ParseOccurrenceID(eventIDStr); !ok {
+		if _, err := uuid.Parse(eventIDStr); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid event ID format: %v", err)
+		}
 	}
 
-	event, err := h.Svc.GetById(ctx, eventID.String())
+	event, err := h.Svc.GetById(ctx, eventIDStr)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, status.Errorf(codes.NotFound, "event not found")
 		}
 		return nil, status.Errorf(codes.Internal, "failed to get event: %v", err)
 	}
+	if err := h.Orders.SetTicketsSold(ctx, event); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count tickets sold: %v", err)
+	}
 	return helper.StandardEventResponse(codes.OK, "success", "event retrieved successfully", event, nil, nil)
 }
 
@@ -138,41 +196,613 @@ func (h *EventGrpcHandler) DeleteEvent(ctx context.Context, req *pb.DeleteEventR
 		return nil, status.Errorf(codes.InvalidArgument, "event ID is required")
 	}
 
-	eventID, err := uuid.Parse(eventIDStr)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid event ID format: %v", err)
+	var err error
+	if seriesID, start, ok := entity.ParseOccurrenceID(eventIDStr); ok {
+		err = h.Svc.DeleteOccurrence(ctx, seriesID.String(), start, entity.RecurrenceScope(req.GetScope()))
+	} else {
+		eventID, parseErr := uuid.Parse(eventIDStr)
+		if parseErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid event ID format: %v", parseErr)
+		}
+		err = h.Svc.Delete(ctx, eventID.String())
 	}
-
-	err = h.Svc.Delete(ctx, eventID.String())
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "event not found")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to delete event: %v", err)
 	}
 	return helper.StandardEventResponse(codes.OK, "success", "event deleted successfully", nil, nil, &pb.DeleteEventResponse{})
 }
 
 func (h *EventGrpcHandler) ListEvents(ctx context.Context, req *pb.ListEventsRequest) (*pb.StandardEventResponse, error) {
-	events, err := h.Svc.ListEvents(ctx, req.GetPageSize(), req.GetPageToken())
+	params := &entity.ListEventsQueryParams{
+		PageSize:   req.GetPageSize(),
+		PageToken:  req.GetPageToken(),
+		MasjidId:   req.GetMasjidId(),
+		HijriYear:  int(req.GetHijriYear()),
+		HijriMonth: int(req.GetHijriMonth()),
+	}
+	if req.GetStartFrom() != nil {
+		params.StartFrom = req.GetStartFrom().AsTime()
+	}
+	if req.GetStartBefore() != nil {
+		params.StartBefore = req.GetStartBefore().AsTime()
+	}
+	if err := services.ParseEventFilter(req.GetFilter(), params); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	orderBy, err := services.ParseEventOrder(req.GetOrderBy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	params.OrderBy = orderBy
+
+	events, nextPageToken, total, err := h.Svc.ListEvents(ctx, params)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list masjids: %v", err)
+		return nil, listEventsError(err)
 	}
 
-	protoEvents := &pb.ListEventsResponse{}
+	protoEvents := &pb.ListEventsResponse{NextPageToken: nextPageToken, TotalSize: int32(total)}
 	for _, event := range events {
-		protoEvents.Events = append(protoEvents.Events, &pb.Event{
-			Id:                event.ID.String(),
-			MasjidId:          event.MasjidId,
-			Name:              event.Name,
-			Description:       event.Description,
-			StartTime:         timestamppb.New(event.StartTime),
-			EndTime:           timestamppb.New(event.EndTime),
-			GenderRestriction: pb.Event_GenderRestriction(event.GenderRestriction),
-			IsPaid:            event.IsPaid,
-			RequiresRsvp:      event.RequiresRsvp,
-			MaxParticipants:   event.MaxParticipants,
-			LivestreamLink:    event.LivestreamLink,
-			CreateTime:        timestamppb.New(event.CreatedAt),
-			UpdateTime:        timestamppb.New(event.UpdatedAt),
-		})
+		protoEvents.Events = append(protoEvents.Events, helper.ToProtoEvent(event))
 	}
 	return helper.StandardEventResponse(codes.OK, "success", "events retrieved successfully", nil, protoEvents, nil)
 }
+
+func (h *EventGrpcHandler) ListWeekEvents(ctx context.Context, req *pb.ListWeekEventsRequest) (*pb.StandardEventResponse, error) {
+	if req.GetMasjidId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "masjid ID is required")
+	}
+	weekOf := time.Now()
+	if req.GetWeekOf() != nil {
+		weekOf = req.GetWeekOf().AsTime()
+	}
+	params := &entity.ListEventsQueryParams{}
+	if err := services.ParseEventFilter(req.GetFilter(), params); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if params.MasjidId != "" || !params.StartFrom.IsZero() || !params.StartBefore.IsZero() {
+		return nil, status.Errorf(codes.InvalidArgument, "%v: masjid_id and start_time are set by the week", helper.ErrInvalidFilter)
+	}
+
+	events, err := h.Svc.ListWeekEvents(ctx, req.GetMasjidId(), weekOf, params)
+	if err != nil {
+		return nil, listEventsError(err)
+	}
+
+	protoEvents := &pb.ListEventsResponse{TotalSize: int32(len(events))}
+	for _, event := range events {
+		protoEvents.Events = append(protoEvents.Events, helper.ToProtoEvent(event))
+	}
+	return helper.StandardEventResponse(codes.OK, "success", "events retrieved successfully", nil, protoEvents, nil)
+}
+
+func (h *EventGrpcHandler) RsvpEvent(ctx context.Context, req *pb.RsvpEventRequest) (*pb.StandardEventResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "RsvpEvent"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+	eventID, err := uuid.Parse(req.GetEventId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid event ID format: %v", err)
+	}
+
+	rsvp, err := h.Svc.Rsvp(ctx, eventID.String(), userID)
+	if err != nil {
+		return nil, rsvpError(err, "rsvp to event")
+	}
+	message := "rsvp confirmed"
+	if rsvp.Status == entity.RsvpWaitlisted {
+		message = "event is full; rsvp waitlisted"
+	}
+	return helper.StandardRsvpResponse(codes.OK, "success", message, rsvp)
+}
+
+func (h *EventGrpcHandler) CancelRsvp(ctx context.Context, req *pb.CancelRsvpRequest) (*pb.StandardEventResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "CancelRsvp"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+	eventID, err := uuid.Parse(req.GetEventId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid event ID format: %v", err)
+	}
+	if req.GetUserId() != "" && req.GetUserId() != userID {
+		// Organisers may cancel the RSVPs of others.
+		organisers := []string{
+			string(entity.MASJID_ADMIN),
+			string(entity.MASJID_IMAM),
+		}
+		if err := auth.RequireRole(ctx, organisers, "CancelRsvp for another user"); err != nil {
+			return nil, err
+		}
+		if _, err := uuid.Parse(req.GetUserId()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format: %v", err)
+		}
+		userID = req.GetUserId()
+	}
+
+	rsvp, err := h.Svc.CancelRsvp(ctx, eventID.String(), userID)
+	if err != nil {
+		return nil, rsvpError(err, "cancel rsvp")
+	}
+	return helper.StandardRsvpResponse(codes.OK, "success", "rsvp cancelled successfully", rsvp)
+}
+
+func (h *EventGrpcHandler) ListEventAttendees(ctx context.Context, req *pb.ListEventAttendeesRequest) (*pb.StandardEventResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "ListEventAttendees"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	eventID, err := uuid.Parse(req.GetEventId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid event ID format: %v", err)
+	}
+	if req.GetPageSize() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page size must not be negative")
+	}
+
+	rsvps, next, err := h.Svc.ListEventAttendees(ctx, eventID.String(), entity.RsvpStatus(req.GetStatus()), int(req.GetPageSize()), req.GetPageToken())
+	if err != nil {
+		return nil, rsvpError(err, "list event attendees")
+	}
+	return helper.StandardListRsvpsResponse(codes.OK, "success", "event attendees retrieved successfully", rsvps, next)
+}
+
+func (h *EventGrpcHandler) GetMyRsvps(ctx context.Context, req *pb.GetMyRsvpsRequest) (*pb.StandardEventResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "GetMyRsvps"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	rsvps, err := h.Svc.GetMyRsvps(ctx, userID, req.GetIncludeCancelled())
+	if err != nil {
+		return nil, rsvpError(err, "get rsvps")
+	}
+	return helper.StandardListRsvpsResponse(codes.OK, "success", "rsvps retrieved successfully", rsvps, "")
+}
+
+func (h *EventGrpcHandler) GetCalendarFeed(ctx context.Context, req *pb.GetCalendarFeedRequest) (*pb.StandardEventResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "GetCalendarFeed"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	feed, err := h.Feeds.GetFeed(ctx, req.GetMasjidId(), userID)
+	if err != nil {
+		return nil, calendarFeedError(err, "get calendar feed")
+	}
+	return helper.StandardCalendarFeedResponse(codes.OK, "success", "calendar feed retrieved successfully", helper.ToProtoCalendarFeed(feed, h.Feeds.URL(feed)))
+}
+
+func (h *EventGrpcHandler) ResetCalendarFeed(ctx context.Context, req *pb.ResetCalendarFeedRequest) (*pb.StandardEventResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if req.GetMasjidId() != "" {
+		allowedRolesForAnyUser = []string{
+			string(entity.MASJID_ADMIN),
+			string(entity.MASJID_IMAM),
+		}
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "ResetCalendarFeed"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	feed, err := h.Feeds.ResetFeed(ctx, req.GetMasjidId(), userID)
+	if err != nil {
+		return nil, calendarFeedError(err, "reset calendar feed")
+	}
+	return helper.StandardCalendarFeedResponse(codes.OK, "success", "calendar feed reset successfully", helper.ToProtoCalendarFeed(feed, h.Feeds.URL(feed)))
+}
+
+func (h *EventGrpcHandler) CheckInAttendee(ctx context.Context, req *pb.CheckInAttendeeRequest) (*pb.StandardEventResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForCheckIn := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForCheckIn, "CheckInAttendee"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+	if req.GetEventId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "event ID is required")
+	}
+	if req.GetTicket() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "ticket is required")
+	}
+
+	checkIn, event, err := h.Svc.CheckIn(ctx, req.GetEventId(), req.GetTicket(), userID)
+	if errors.Is(err, helper.ErrAlreadyCheckedIn) {
+		name := ""
+		if checkIn.User != nil {
+			name = strings.TrimSpace(checkIn.User.FirstName+" "+checkIn.User.LastName) + " "
+		}
+		return nil, status.Errorf(codes.AlreadyExists, "%v: %swas checked in at %s", err, name, checkIn.CheckedInAt.UTC().Format(time.RFC3339))
+	}
+	if err != nil {
+		return nil, checkInError(err)
+	}
+	return helper.StandardCheckInResponse(codes.OK, "success", "attendee checked in successfully", helper.ToProtoCheckIn(checkIn, event))
+}
+
+func (h *EventGrpcHandler) GetTicketKey(ctx context.Context, req *pb.GetTicketKeyRequest) (*pb.StandardEventResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "GetTicketKey"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	if h.Svc.Tickets == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", helper.ErrTicketsNotConfigured)
+	}
+
+	return helper.StandardTicketKeyResponse(codes.OK, "success", "ticket key retrieved successfully", &pb.TicketKey{
+		Algorithm: ticket.Algorithm,
+		PublicKey: base64.StdEncoding.EncodeToString(h.Svc.Tickets.PublicKey()),
+	})
+}
+
+func (h *EventGrpcHandler) SetTicketTypes(ctx context.Context, req *pb.SetTicketTypesRequest) (*pb.StandardEventResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForTicketTypes := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForTicketTypes, "SetTicketTypes"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	eventID, err := uuid.Parse(req.GetEventId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid event ID format: %v", err)
+	}
+	types := make([]entity.TicketType, 0, len(req.GetTicketTypes()))
+	for _, t := range req.GetTicketTypes() {
+		tt := entity.TicketType{
+			Name:     t.GetName(),
+			Price:    t.GetPrice(),
+			Currency: t.GetCurrencyCode(),
+			Quota:    t.GetQuota(),
+		}
+		if t.GetId() != "" {
+			if tt.ID, err = uuid.Parse(t.GetId()); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid ticket type ID format: %v", err)
+			}
+		}
+		types = append(types, tt)
+	}
+
+	event, err := h.Orders.SetTicketTypes(ctx, eventID.String(), types)
+	if err != nil {
+		return nil, orderError(err, "set ticket types")
+	}
+	return helper.StandardEventResponse(codes.OK, "success", "ticket types set successfully", event, nil, nil)
+}
+
+func (h *EventGrpcHandler) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.StandardEventResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "CreateOrder"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+	if req.GetEventId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "event ID is required")
+	}
+	items := make([]entity.OrderItem, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		ticketTypeID, err := uuid.Parse(item.GetTicketTypeId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid ticket type ID format: %v", err)
+		}
+		items = append(items, entity.OrderItem{TicketTypeId: ticketTypeID, Quantity: item.GetQuantity()})
+	}
+
+	order, err := h.Orders.CreateOrder(ctx, req.GetEventId(), userID, items)
+	if err != nil {
+		return nil, orderError(err, "create order")
+	}
+	return helper.StandardOrderResponse(codes.OK, "success", "order created successfully", order)
+}
+
+func (h *EventGrpcHandler) GetOrder(ctx context.Context, req *pb.GetOrderRequest) (*pb.StandardEventResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "GetOrder"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+	orderID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order ID format: %v", err)
+	}
+
+	order, err := h.Orders.GetOrder(ctx, orderID.String(), userID, isOrganiser(ctx))
+	if err != nil {
+		return nil, orderError(err, "get order")
+	}
+	return helper.StandardOrderResponse(codes.OK, "success", "order retrieved successfully", order)
+}
+
+func (h *EventGrpcHandler) ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb.StandardEventResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "ListOrders"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+	if req.GetEventId() != "" {
+		// Organisers may list everyone's orders for an event.
+		organisers := []string{
+			string(entity.MASJID_ADMIN),
+			string(entity.MASJID_IMAM),
+		}
+		if err := auth.RequireRole(ctx, organisers, "ListOrders for an event"); err != nil {
+			return nil, err
+		}
+		if _, err := uuid.Parse(req.GetEventId()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid event ID format: %v", err)
+		}
+	}
+
+	orders, next, err := h.Orders.ListOrders(ctx, req.GetEventId(), userID, int(req.GetPageSize()), req.GetPageToken())
+	if err != nil {
+		return nil, orderError(err, "list orders")
+	}
+	return helper.StandardListOrdersResponse(codes.OK, "success", "orders retrieved successfully", orders, next)
+}
+
+func (h *EventGrpcHandler) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.StandardEventResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "CancelOrder"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+	orderID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order ID format: %v", err)
+	}
+
+	order, err := h.Orders.CancelOrder(ctx, orderID.String(), userID, isOrganiser(ctx))
+	if err != nil {
+		return nil, orderError(err, "cancel order")
+	}
+	return helper.StandardOrderResponse(codes.OK, "success", "order cancelled successfully", order)
+}
+
+// isOrganiser reports whether the caller may manage the orders of others.
+func isOrganiser(ctx context.Context) bool {
+	role, _ := ctx.Value(auth.UserRoleContextKey).(string)
+	return role == string(entity.MASJID_ADMIN) || role == string(entity.MASJID_IMAM)
+}
+
+// orderError maps the errors of OrderService to gRPC statuses.
+func orderError(err error, action string) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound), errors.Is(err, helper.ErrNotFound):
+		return status.Errorf(codes.NotFound, "event, user or order not found")
+	case errors.Is(err, helper.ErrInvalidTicketTypes), errors.Is(err, helper.ErrInvalidOrder),
+		errors.Is(err, helper.ErrInvalidPageToken):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, helper.ErrTicketsSoldOut):
+		return status.Errorf(codes.ResourceExhausted, "%v", err)
+	case errors.Is(err, helper.ErrTicketTypeInUse), errors.Is(err, helper.ErrNoTicketTypes),
+		errors.Is(err, helper.ErrPaymentsNotConfigured), errors.Is(err, helper.ErrOrderNotCancellable),
+		errors.Is(err, helper.ErrEventEnded), errors.Is(err, helper.ErrGenderNotSet):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, helper.ErrGenderRestricted):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
+
+// checkInError maps the errors of EventService.CheckIn to gRPC statuses.
+func checkInError(err error) error {
+	switch {
+	case errors.Is(err, ticket.ErrInvalid):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Errorf(codes.NotFound, "event not found")
+	case errors.Is(err, helper.ErrCheckInToSeries):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, helper.ErrTicketForAnotherEvent), errors.Is(err, helper.ErrTicketNotConfirmed),
+		errors.Is(err, helper.ErrTicketsNotConfigured):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to check in attendee: %v", err)
+}
+
+// calendarFeedError maps the errors of CalendarFeedService to gRPC
+// statuses.
+func calendarFeedError(err error, action string) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) || errors.Is(err, helper.ErrNotFound) {
+		return status.Errorf(codes.NotFound, "masjid or user not found")
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
+
+// rsvpError maps the errors of the RSVP methods of EventService to gRPC
+// statuses.
+func rsvpError(err error, action string) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound), errors.Is(err, helper.ErrNotFound):
+		return status.Errorf(codes.NotFound, "event, user or rsvp not found")
+	case errors.Is(err, helper.ErrRsvpNotRequired), errors.Is(err, helper.ErrEventEnded),
+		errors.Is(err, helper.ErrGenderNotSet), errors.Is(err, helper.ErrTicketsRequired):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, helper.ErrGenderRestricted):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	case errors.Is(err, helper.ErrInvalidPageToken):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
+
+// listEventsError maps the errors of the listing methods of EventService
+// to gRPC statuses.
+func listEventsError(err error) error {
+	switch {
+	case errors.Is(err, hijri.ErrInvalidDate), errors.Is(err, hijri.ErrOutOfRange), errors.Is(err, hijri.ErrInvalidOffset):
+		return status.Errorf(codes.InvalidArgument, "invalid hijri filter: %v", err)
+	case errors.Is(err, helper.ErrInvalidTimeWindow), errors.Is(err, helper.ErrInvalidPageToken):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Errorf(codes.NotFound, "masjid not found")
+	}
+	return status.Errorf(codes.Internal, "failed to list events: %v", err)
+}
+
+// eventTypeNames returns the names events store for types.
+func eventTypeNames(types []pb.Event_EventType) []string {
+	names := make([]string, 0, len(types))
+	for _, t := range types {
+		names = append(names, entity.FromProtoToInternalEventType(t))
+	}
+	return names
+}
+
+// roomIDs parses the IDs of the rooms an event is held in.
+func roomIDs(ids []string) ([]uuid.UUID, error) {
+	rooms := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		room, err := uuid.Parse(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid room ID format: %v", err)
+		}
+		rooms = append(rooms, room)
+	}
+	return rooms, nil
+}
+
+// isBookingError reports whether err is due to the rooms given for an
+// event.
+func isBookingError(err error) bool {
+	return errors.Is(err, helper.ErrInvalidBooking) ||
+		errors.Is(err, helper.ErrRoomUnavailable)
+}
+
+// bookingError maps an error booking the rooms of an event to a gRPC
+// status.
+func bookingError(err error) error {
+	if errors.Is(err, helper.ErrRoomUnavailable) {
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	return status.Errorf(codes.InvalidArgument, "%v", err)
+}
+
+// isRecurrenceError reports whether err is due to the recurrence given for
+// an event.
+func isRecurrenceError(err error) bool {
+	return errors.Is(err, recurrence.ErrInvalidRule) ||
+		errors.Is(err, recurrence.ErrUnsupported) ||
+		errors.Is(err, helper.ErrRecurringException)
+}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/services"
+	"github.com/mnadev/limestone/internal/infrastructure/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"time"
+)
+
+type JumuahGrpcHandler struct {
+	pb.UnimplementedJumuahServiceServer
+	Svc *services.JumuahService
+}
+
+func NewJumuahGrpcHandler(svc *services.JumuahService) *JumuahGrpcHandler {
+	return &JumuahGrpcHandler{Svc: svc}
+}
+
+func (h *JumuahGrpcHandler) CreatePrayerSlot(ctx context.Context, req *pb.CreatePrayerSlotRequest) (*pb.StandardJumuahResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "CreatePrayerSlot"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	if req.GetMasjidId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "masjid ID is required")
+	}
+	if req.GetSlot() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "prayer slot data is required")
+	}
+
+	slot, err := h.Svc.CreatePrayerSlot(ctx, helper.ToEntityPrayerSlot(req.GetMasjidId(), uuid.Nil, req.GetSlot()))
+	if err != nil {
+		return nil, prayerSlotError(err, "create")
+	}
+	return helper.StandardJumuahResponse(codes.OK, "success", "prayer slot created successfully", slot)
+}
+
+func (h *JumuahGrpcHandler) UpdatePrayerSlot(ctx context.Context, req *pb.UpdatePrayerSlotRequest) (*pb.StandardJumuahResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "UpdatePrayerSlot"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	if req.GetMasjidId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "masjid ID is required")
+	}
+	if req.GetSlot() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "prayer slot data is required")
+	}
+	slotID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid prayer slot ID format")
+	}
+
+	slot, err := h.Svc.UpdatePrayerSlot(ctx, helper.ToEntityPrayerSlot(req.GetMasjidId(), slotID, req.GetSlot()))
+	if err != nil {
+		return nil, prayerSlotError(err, "update")
+	}
+	return helper.StandardJumuahResponse(codes.OK, "success", "prayer slot updated successfully", slot)
+}
+
+func (h *JumuahGrpcHandler) GetPrayerSlot(ctx context.Context, req *pb.GetPrayerSlotRequest) (*pb.StandardJumuahResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "GetPrayerSlot"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	if _, err := uuid.Parse(req.GetId()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid prayer slot ID format")
+	}
+
+	slot, err := h.Svc.GetPrayerSlot(ctx, req.GetMasjidId(), req.GetId())
+	if err != nil {
+		return nil, prayerSlotError(err, "get")
+	}
+	return helper.StandardJumuahResponse(codes.OK, "success", "prayer slot retrieved successfully", slot)
+}
+
+func (h *JumuahGrpcHandler) DeletePrayerSlot(ctx context.Context, req *pb.DeletePrayerSlotRequest) (*pb.StandardJumuahResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "DeletePrayerSlot"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	if _, err := uuid.Parse(req.GetId()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid prayer slot ID format")
+	}
+
+	if err := h.Svc.DeletePrayerSlot(ctx, req.GetMasjidId(), req.GetId()); err != nil {
+		return nil, prayerSlotError(err, "delete")
+	}
+	return helper.StandardJumuahResponse(codes.OK, "success", "prayer slot deleted successfully", &pb.DeletePrayerSlotResponse{})
+}
+
+func (h *JumuahGrpcHandler) ListPrayerSlots(ctx context.Context, req *pb.ListPrayerSlotsRequest) (*pb.StandardJumuahResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "ListPrayerSlots"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	if req.GetMasjidId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "masjid ID is required")
+	}
+
+	params := &entity.ListPrayerSlotsQueryParams{MasjidId: req.GetMasjidId()}
+	if req.Type != nil {
+		slotType := entity.PrayerSlotType(req.GetType())
+		params.Type = &slotType
+	}
+
+	slots, err := h.Svc.ListPrayerSlots(ctx, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list prayer slots: %v", err)
+	}
+	return helper.StandardJumuahResponse(codes.OK, "success", "prayer slots retrieved successfully", slots)
+}
+
+func (h *JumuahGrpcHandler) GetNextJumuah(ctx context.Context, req *pb.GetNextJumuahRequest) (*pb.StandardJumuahResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "GetNextJumuah"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	if req.GetMasjidId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "masjid ID is required")
+	}
+
+	date, slots, err := h.Svc.GetNextJumuah(ctx, req.GetMasjidId(), time.Now())
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, status.Errorf(codes.NotFound, "masjid not found")
+		case errors.Is(err, prayertimes.ErrNoUpcomingJumuah):
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		case errors.Is(err, helper.ErrMasjidLocationNotSet), errors.Is(err, helper.ErrInvalidTimeZone):
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot schedule jumuah: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get next jumuah: %v", err)
+	}
+	return helper.StandardJumuahResponse(codes.OK, "success", "next jumuah retrieved successfully", helper.ToProtoNextJumuah(date, slots))
+}
+
+func prayerSlotError(err error, action string) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Errorf(codes.NotFound, "masjid or prayer slot not found")
+	case errors.Is(err, helper.ErrInvalidPrayerSlot):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s prayer slot: %v", action, err)
+}
@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/services"
+	"github.com/mnadev/limestone/internal/infrastructure/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type NotificationGrpcHandler struct {
+	pb.UnimplementedNotificationServiceServer
+	Svc *services.NotificationService
+}
+
+func NewNotificationGrpcHandler(svc *services.NotificationService) *NotificationGrpcHandler {
+	return &NotificationGrpcHandler{Svc: svc}
+}
+
+func (h *NotificationGrpcHandler) GetNotificationPreferences(ctx context.Context, req *pb.GetNotificationPreferencesRequest) (*pb.StandardNotificationResponse, error) {
+	userID, err := notificationUser(ctx, "GetNotificationPreferences")
+	if err != nil {
+		return nil, err
+	}
+
+	pref, err := h.Svc.GetPreference(ctx, userID.String())
+	if err != nil {
+		return nil, notificationError(err, "get notification preferences")
+	}
+	return helper.StandardNotificationResponse(codes.OK, "success", "notification preferences retrieved successfully", pref)
+}
+
+func (h *NotificationGrpcHandler) UpdateNotificationPreferences(ctx context.Context, req *pb.UpdateNotificationPreferencesRequest) (*pb.StandardNotificationResponse, error) {
+	userID, err := notificationUser(ctx, "UpdateNotificationPreferences")
+	if err != nil {
+		return nil, err
+	}
+	if req.GetPreferences() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "preferences are required")
+	}
+
+	pref, err := h.Svc.UpdatePreference(ctx, helper.ToEntityNotificationPreference(userID, req.GetPreferences()))
+	if err != nil {
+		return nil, notificationError(err, "update notification preferences")
+	}
+	return helper.StandardNotificationResponse(codes.OK, "success", "notification preferences updated successfully", pref)
+}
+
+func (h *NotificationGrpcHandler) FollowMasjid(ctx context.Context, req *pb.FollowMasjidRequest) (*pb.StandardNotificationResponse, error) {
+	userID, err := notificationUser(ctx, "FollowMasjid")
+	if err != nil {
+		return nil, err
+	}
+	masjidID, err := uuid.Parse(req.GetMasjidId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid masjid ID format: %v", err)
+	}
+
+	follow, err := h.Svc.FollowMasjid(ctx, userID.String(), masjidID.String())
+	if err != nil {
+		return nil, notificationError(err, "follow masjid")
+	}
+	return helper.StandardNotificationResponse(codes.OK, "success", "masjid followed successfully", follow)
+}
+
+func (h *NotificationGrpcHandler) UnfollowMasjid(ctx context.Context, req *pb.UnfollowMasjidRequest) (*pb.StandardNotificationResponse, error) {
+	userID, err := notificationUser(ctx, "UnfollowMasjid")
+	if err != nil {
+		return nil, err
+	}
+	masjidID, err := uuid.Parse(req.GetMasjidId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid masjid ID format: %v", err)
+	}
+
+	if err := h.Svc.UnfollowMasjid(ctx, userID.String(), masjidID.String()); err != nil {
+		return nil, notificationError(err, "unfollow masjid")
+	}
+	return helper.StandardNotificationResponse(codes.OK, "success", "masjid unfollowed successfully", &pb.UnfollowMasjidResponse{})
+}
+
+func (h *NotificationGrpcHandler) ListFollowedMasjids(ctx context.Context, req *pb.ListFollowedMasjidsRequest) (*pb.StandardNotificationResponse, error) {
+	userID, err := notificationUser(ctx, "ListFollowedMasjids")
+	if err != nil {
+		return nil, err
+	}
+
+	follows, err := h.Svc.ListFollowedMasjids(ctx, userID.String())
+	if err != nil {
+		return nil, notificationError(err, "list followed masjids")
+	}
+	return helper.StandardNotificationResponse(codes.OK, "success", "followed masjids retrieved successfully", follows)
+}
+
+func (h *NotificationGrpcHandler) RegisterPushDevice(ctx context.Context, req *pb.RegisterPushDeviceRequest) (*pb.StandardNotificationResponse, error) {
+	userID, err := notificationUser(ctx, "RegisterPushDevice")
+	if err != nil {
+		return nil, err
+	}
+	if req.GetDevice() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "device data is required")
+	}
+
+	device, err := h.Svc.RegisterPushDevice(ctx, helper.ToEntityPushDevice(userID, req.GetDevice()))
+	if err != nil {
+		return nil, notificationError(err, "register push device")
+	}
+	return helper.StandardNotificationResponse(codes.OK, "success", "push device registered successfully", device)
+}
+
+func (h *NotificationGrpcHandler) UnregisterPushDevice(ctx context.Context, req *pb.UnregisterPushDeviceRequest) (*pb.StandardNotificationResponse, error) {
+	userID, err := notificationUser(ctx, "UnregisterPushDevice")
+	if err != nil {
+		return nil, err
+	}
+	if req.GetToken() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+
+	if err := h.Svc.UnregisterPushDevice(ctx, userID.String(), req.GetToken()); err != nil {
+		return nil, notificationError(err, "unregister push device")
+	}
+	return helper.StandardNotificationResponse(codes.OK, "success", "push device unregistered successfully", &pb.UnregisterPushDeviceResponse{})
+}
+
+// notificationUser checks that the caller is signed in and returns their
+// ID. Every notification RPC acts for the caller alone.
+func notificationUser(ctx context.Context, method string) (uuid.UUID, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, method); err != nil {
+		return uuid.Nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return uuid.Nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.Unauthenticated, "invalid user ID in context")
+	}
+	return id, nil
+}
+
+// notificationError maps the errors of NotificationService to gRPC
+// statuses.
+func notificationError(err error, action string) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Errorf(codes.NotFound, "masjid or follow not found")
+	case errors.Is(err, helper.ErrInvalidPreferences), errors.Is(err, helper.ErrInvalidPushDevice):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
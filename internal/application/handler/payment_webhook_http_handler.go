@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/services"
+)
+
+// maxWebhookBody bounds the size of payment webhooks read.
+const maxWebhookBody = 1 << 20
+
+// PaymentWebhookHTTPHandler receives the events of the payment provider.
+// The provider cannot log in, so requests are authenticated by their
+// signature instead and the handler is served outside the JWT interceptor.
+type PaymentWebhookHTTPHandler struct {
+	Svc *services.OrderService
+}
+
+func NewPaymentWebhookHTTPHandler(svc *services.OrderService) *PaymentWebhookHTTPHandler {
+	return &PaymentWebhookHTTPHandler{Svc: svc}
+}
+
+// ServeHTTP applies the event in the request. Failures other than a bad
+// signature are answered 500, so that the provider delivers the event
+// again later.
+func (h *PaymentWebhookHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.Svc.Payments == nil {
+		helper.WriteJSONError(w, http.StatusNotFound, "NotFound", helper.ErrPaymentsNotConfigured.Error())
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+	if err != nil {
+		helper.WriteJSONError(w, http.StatusRequestEntityTooLarge, "InvalidArgument", "webhook body too large")
+		return
+	}
+
+	// Unsigned and malformed events alike are refused.
+	event, err := h.Svc.Payments.ParseWebhook(r.Header, body)
+	if err != nil {
+		helper.WriteJSONError(w, http.StatusBadRequest, "InvalidArgument", err.Error())
+		return
+	}
+	if event != nil {
+		if err := h.Svc.HandlePaymentEvent(r.Context(), event); err != nil {
+			log.Printf("failed to handle payment event %s: %v", event.ID, err)
+			helper.WriteJSONError(w, http.StatusInternalServerError, "Internal", "failed to handle payment event")
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
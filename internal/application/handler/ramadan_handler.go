@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/hijri"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/services"
+	"github.com/mnadev/limestone/internal/infrastructure/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"time"
+)
+
+type RamadanGrpcHandler struct {
+	pb.UnimplementedRamadanServiceServer
+	Svc *services.RamadanService
+}
+
+func NewRamadanGrpcHandler(svc *services.RamadanService) *RamadanGrpcHandler {
+	return &RamadanGrpcHandler{Svc: svc}
+}
+
+func (h *RamadanGrpcHandler) GetRamadanSchedule(ctx context.Context, req *pb.GetRamadanScheduleRequest) (*pb.StandardRamadanResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "GetRamadanSchedule"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	if req.GetMasjidId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "masjid ID is required")
+	}
+
+	days, err := h.Svc.GetRamadanSchedule(ctx, req.GetMasjidId(), int(req.GetHijriYear()))
+	if err != nil {
+		return nil, ramadanError(err, "get ramadan schedule")
+	}
+	schedule := helper.ToProtoRamadanSchedule(req.GetMasjidId(), req.GetHijriYear(), days)
+	return helper.StandardRamadanResponse(codes.OK, "success", "ramadan schedule retrieved successfully", schedule)
+}
+
+func (h *RamadanGrpcHandler) SetRamadanNights(ctx context.Context, req *pb.SetRamadanNightsRequest) (*pb.StandardRamadanResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "SetRamadanNights"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	if req.GetMasjidId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "masjid ID is required")
+	}
+
+	nights := helper.ToEntityRamadanNights(req.GetNights())
+	days, err := h.Svc.SetRamadanNights(ctx, req.GetMasjidId(), int(req.GetHijriYear()), nights)
+	if err != nil {
+		return nil, ramadanError(err, "set ramadan nights")
+	}
+	schedule := helper.ToProtoRamadanSchedule(req.GetMasjidId(), req.GetHijriYear(), days)
+	return helper.StandardRamadanResponse(codes.OK, "success", "ramadan nights updated successfully", schedule)
+}
+
+func (h *RamadanGrpcHandler) CreateIftarEvents(ctx context.Context, req *pb.CreateIftarEventsRequest) (*pb.StandardRamadanResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "CreateIftarEvents"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	if req.GetMasjidId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "masjid ID is required")
+	}
+	if req.GetMaxParticipants() < 0 || req.GetDurationMinutes() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max participants and duration must not be negative")
+	}
+
+	template := &entity.Event{
+		Description:     req.GetDescription(),
+		MaxParticipants: req.GetMaxParticipants(),
+	}
+	rooms, err := roomIDs(req.GetRoomIds())
+	if err != nil {
+		return nil, err
+	}
+	template.SetRooms(rooms...)
+	duration := time.Duration(req.GetDurationMinutes()) * time.Minute
+	events, err := h.Svc.CreateIftarEvents(ctx, req.GetMasjidId(), int(req.GetHijriYear()), template, duration)
+	if err != nil {
+		return nil, ramadanError(err, "create iftar events")
+	}
+	return helper.StandardRamadanResponse(codes.OK, "success", "iftar events created successfully", events)
+}
+
+func ramadanError(err error, action string) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Errorf(codes.NotFound, "masjid not found")
+	case errors.Is(err, hijri.ErrOutOfRange), errors.Is(err, hijri.ErrInvalidDate):
+		return status.Errorf(codes.InvalidArgument, "invalid hijri year: %v", err)
+	case errors.Is(err, prayertimes.ErrInvalidRamadanNight):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case isBookingError(err):
+		return bookingError(err)
+	}
+	if s := prayerTimesError(err); status.Code(s) != codes.Internal {
+		return s
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
@@ -0,0 +1,256 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/services"
+	"github.com/mnadev/limestone/internal/infrastructure/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type RoomGrpcHandler struct {
+	pb.UnimplementedRoomServiceServer
+	Svc *services.RoomService
+}
+
+func NewRoomGrpcHandler(svc *services.RoomService) *RoomGrpcHandler {
+	return &RoomGrpcHandler{Svc: svc}
+}
+
+func (h *RoomGrpcHandler) CreateRoom(ctx context.Context, req *pb.CreateRoomRequest) (*pb.StandardRoomResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAdmins := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAdmins, "CreateRoom"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	masjidID, err := uuid.Parse(req.GetMasjidId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid masjid ID format: %v", err)
+	}
+	if req.GetRoom() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "room data is required")
+	}
+
+	room, err := h.Svc.CreateRoom(ctx, helper.ToEntityRoom(masjidID.String(), uuid.Nil, req.GetRoom()))
+	if err != nil {
+		return nil, roomError(err, "create room")
+	}
+	return helper.StandardRoomResponse(codes.OK, "success", "room created successfully", room)
+}
+
+func (h *RoomGrpcHandler) UpdateRoom(ctx context.Context, req *pb.UpdateRoomRequest) (*pb.StandardRoomResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAdmins := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAdmins, "UpdateRoom"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	roomID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid room ID format: %v", err)
+	}
+	if req.GetRoom() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "room data is required")
+	}
+
+	room, err := h.Svc.UpdateRoom(ctx, helper.ToEntityRoom("", roomID, req.GetRoom()))
+	if err != nil {
+		return nil, roomError(err, "update room")
+	}
+	return helper.StandardRoomResponse(codes.OK, "success", "room updated successfully", room)
+}
+
+func (h *RoomGrpcHandler) DeleteRoom(ctx context.Context, req *pb.DeleteRoomRequest) (*pb.StandardRoomResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAdmins := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAdmins, "DeleteRoom"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	roomID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid room ID format: %v", err)
+	}
+
+	if err := h.Svc.DeleteRoom(ctx, roomID.String()); err != nil {
+		return nil, roomError(err, "delete room")
+	}
+	return helper.StandardRoomResponse(codes.OK, "success", "room deleted successfully", &pb.DeleteRoomResponse{})
+}
+
+func (h *RoomGrpcHandler) GetRoom(ctx context.Context, req *pb.GetRoomRequest) (*pb.StandardRoomResponse, error) {
+	roomID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid room ID format: %v", err)
+	}
+
+	room, err := h.Svc.GetRoom(ctx, roomID.String())
+	if err != nil {
+		return nil, roomError(err, "get room")
+	}
+	return helper.StandardRoomResponse(codes.OK, "success", "room retrieved successfully", room)
+}
+
+func (h *RoomGrpcHandler) ListRooms(ctx context.Context, req *pb.ListRoomsRequest) (*pb.StandardRoomResponse, error) {
+	masjidID, err := uuid.Parse(req.GetMasjidId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid masjid ID format: %v", err)
+	}
+
+	rooms, err := h.Svc.ListRooms(ctx, masjidID.String())
+	if err != nil {
+		return nil, roomError(err, "list rooms")
+	}
+	return helper.StandardRoomResponse(codes.OK, "success", "rooms retrieved successfully", rooms)
+}
+
+func (h *RoomGrpcHandler) GetRoomAvailability(ctx context.Context, req *pb.GetRoomAvailabilityRequest) (*pb.StandardRoomResponse, error) {
+	roomID, err := uuid.Parse(req.GetRoomId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid room ID format: %v", err)
+	}
+	var from, to time.Time
+	if req.GetStartFrom() != nil {
+		from = req.GetStartFrom().AsTime()
+	}
+	if req.GetStartBefore() != nil {
+		to = req.GetStartBefore().AsTime()
+	}
+
+	availability, err := h.Svc.GetRoomAvailability(ctx, roomID.String(), from, to)
+	if err != nil {
+		return nil, roomError(err, "get room availability")
+	}
+	if !isOrganiser(ctx) {
+		// Members see when a room is taken, not who by or what for, except
+		// for their own bookings and those of events.
+		userID, _ := ctx.Value(auth.UserIDContextKey).(string)
+		for i := range availability.Bookings {
+			booking := &availability.Bookings[i]
+			if booking.UserId != nil && booking.UserId.String() != userID {
+				booking.UserId = nil
+				booking.Purpose = ""
+				booking.Guests = 0
+			}
+		}
+	}
+	return helper.StandardRoomResponse(codes.OK, "success", "room availability retrieved successfully", availability)
+}
+
+func (h *RoomGrpcHandler) BookRoom(ctx context.Context, req *pb.BookRoomRequest) (*pb.StandardRoomResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "BookRoom"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid user ID in context")
+	}
+	roomID, err := uuid.Parse(req.GetRoomId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid room ID format: %v", err)
+	}
+	if req.GetBooking() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "booking data is required")
+	}
+
+	booking, err := h.Svc.BookRoom(ctx, helper.ToEntityRoomBooking(roomID, req.GetBooking()), userID)
+	if err != nil {
+		return nil, roomError(err, "book room")
+	}
+	return helper.StandardRoomResponse(codes.OK, "success", "room booked successfully", booking)
+}
+
+func (h *RoomGrpcHandler) CancelRoomBooking(ctx context.Context, req *pb.CancelRoomBookingRequest) (*pb.StandardRoomResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "CancelRoomBooking"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+	bookingID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid booking ID format: %v", err)
+	}
+
+	if err := h.Svc.CancelBooking(ctx, bookingID.String(), userID, isOrganiser(ctx)); err != nil {
+		return nil, roomError(err, "cancel booking")
+	}
+	return helper.StandardRoomResponse(codes.OK, "success", "booking cancelled successfully", &pb.CancelRoomBookingResponse{})
+}
+
+func (h *RoomGrpcHandler) ListMyBookings(ctx context.Context, req *pb.ListMyBookingsRequest) (*pb.StandardRoomResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForAnyUser := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_MEMBER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForAnyUser, "ListMyBookings"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	bookings, err := h.Svc.ListMyBookings(ctx, userID)
+	if err != nil {
+		return nil, roomError(err, "list bookings")
+	}
+	return helper.StandardRoomResponse(codes.OK, "success", "bookings retrieved successfully", bookings)
+}
+
+// roomError maps the errors of RoomService to gRPC statuses.
+func roomError(err error, action string) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Errorf(codes.NotFound, "masjid, room or booking not found")
+	case errors.Is(err, helper.ErrInvalidRoom), errors.Is(err, helper.ErrInvalidBooking),
+		errors.Is(err, helper.ErrInvalidTimeWindow):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, helper.ErrRoomUnavailable), errors.Is(err, helper.ErrRoomHasBookings),
+		errors.Is(err, helper.ErrBookingStarted):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
@@ -0,0 +1,313 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/services"
+	"github.com/mnadev/limestone/internal/infrastructure/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type VolunteerGrpcHandler struct {
+	pb.UnimplementedVolunteerServiceServer
+	Svc *services.VolunteerService
+}
+
+func NewVolunteerGrpcHandler(svc *services.VolunteerService) *VolunteerGrpcHandler {
+	return &VolunteerGrpcHandler{Svc: svc}
+}
+
+func (h *VolunteerGrpcHandler) CreateVolunteerShift(ctx context.Context, req *pb.CreateVolunteerShiftRequest) (*pb.StandardVolunteerResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForCoordinators := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForCoordinators, "CreateVolunteerShift"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	eventID, err := uuid.Parse(req.GetEventId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid event ID format: %v", err)
+	}
+	if req.GetShift() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "shift data is required")
+	}
+
+	shift, err := h.Svc.CreateShift(ctx, helper.ToEntityVolunteerShift(eventID, uuid.Nil, req.GetShift()))
+	if err != nil {
+		return nil, volunteerError(err, "create shift")
+	}
+	return helper.StandardVolunteerResponse(codes.OK, "success", "shift created successfully", shift)
+}
+
+func (h *VolunteerGrpcHandler) UpdateVolunteerShift(ctx context.Context, req *pb.UpdateVolunteerShiftRequest) (*pb.StandardVolunteerResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForCoordinators := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForCoordinators, "UpdateVolunteerShift"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	shiftID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid shift ID format: %v", err)
+	}
+	if req.GetShift() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "shift data is required")
+	}
+
+	shift, err := h.Svc.UpdateShift(ctx, helper.ToEntityVolunteerShift(uuid.Nil, shiftID, req.GetShift()))
+	if err != nil {
+		return nil, volunteerError(err, "update shift")
+	}
+	return helper.StandardVolunteerResponse(codes.OK, "success", "shift updated successfully", shift)
+}
+
+func (h *VolunteerGrpcHandler) DeleteVolunteerShift(ctx context.Context, req *pb.DeleteVolunteerShiftRequest) (*pb.StandardVolunteerResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForCoordinators := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForCoordinators, "DeleteVolunteerShift"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	shiftID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid shift ID format: %v", err)
+	}
+
+	if err := h.Svc.DeleteShift(ctx, shiftID.String()); err != nil {
+		return nil, volunteerError(err, "delete shift")
+	}
+	return helper.StandardVolunteerResponse(codes.OK, "success", "shift deleted successfully", &pb.DeleteVolunteerShiftResponse{})
+}
+
+func (h *VolunteerGrpcHandler) ListVolunteerShifts(ctx context.Context, req *pb.ListVolunteerShiftsRequest) (*pb.StandardVolunteerResponse, error) {
+	eventID, err := uuid.Parse(req.GetEventId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid event ID format: %v", err)
+	}
+
+	shifts, err := h.Svc.ListShifts(ctx, eventID.String(), false)
+	if err != nil {
+		return nil, volunteerError(err, "list shifts")
+	}
+	return helper.StandardVolunteerResponse(codes.OK, "success", "shifts retrieved successfully", shifts)
+}
+
+func (h *VolunteerGrpcHandler) GetShiftRoster(ctx context.Context, req *pb.GetShiftRosterRequest) (*pb.StandardVolunteerResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForCoordinators := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForCoordinators, "GetShiftRoster"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	eventID, err := uuid.Parse(req.GetEventId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid event ID format: %v", err)
+	}
+
+	shifts, err := h.Svc.ListShifts(ctx, eventID.String(), true)
+	if err != nil {
+		return nil, volunteerError(err, "get roster")
+	}
+	return helper.StandardVolunteerResponse(codes.OK, "success", "roster retrieved successfully", shifts)
+}
+
+func (h *VolunteerGrpcHandler) ClaimShift(ctx context.Context, req *pb.ClaimShiftRequest) (*pb.StandardVolunteerResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForVolunteers := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForVolunteers, "ClaimShift"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+	shiftID, err := uuid.Parse(req.GetShiftId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid shift ID format: %v", err)
+	}
+
+	signup, err := h.Svc.ClaimShift(ctx, shiftID.String(), userID)
+	if err != nil {
+		return nil, volunteerError(err, "claim shift")
+	}
+	return helper.StandardVolunteerResponse(codes.OK, "success", "shift claimed successfully", signup)
+}
+
+func (h *VolunteerGrpcHandler) ReleaseShift(ctx context.Context, req *pb.ReleaseShiftRequest) (*pb.StandardVolunteerResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForVolunteers := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForVolunteers, "ReleaseShift"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+	shiftID, err := uuid.Parse(req.GetShiftId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid shift ID format: %v", err)
+	}
+	if req.GetUserId() != "" && req.GetUserId() != userID {
+		// Coordinators may release the places of others.
+		coordinators := []string{
+			string(entity.MASJID_ADMIN),
+			string(entity.MASJID_IMAM),
+		}
+		if err := auth.RequireRole(ctx, coordinators, "ReleaseShift for another user"); err != nil {
+			return nil, err
+		}
+		if _, err := uuid.Parse(req.GetUserId()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format: %v", err)
+		}
+		userID = req.GetUserId()
+	}
+
+	signup, err := h.Svc.ReleaseShift(ctx, shiftID.String(), userID, isOrganiser(ctx))
+	if err != nil {
+		return nil, volunteerError(err, "release shift")
+	}
+	return helper.StandardVolunteerResponse(codes.OK, "success", "shift released successfully", signup)
+}
+
+func (h *VolunteerGrpcHandler) RecordShiftHours(ctx context.Context, req *pb.RecordShiftHoursRequest) (*pb.StandardVolunteerResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForCoordinators := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForCoordinators, "RecordShiftHours"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	recorderID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || recorderID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+	shiftID, err := uuid.Parse(req.GetShiftId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid shift ID format: %v", err)
+	}
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID format: %v", err)
+	}
+
+	signup, err := h.Svc.RecordHours(ctx, shiftID.String(), userID.String(), req.GetMinutesWorked(), req.GetNoShow(), recorderID)
+	if err != nil {
+		return nil, volunteerError(err, "record hours")
+	}
+	return helper.StandardVolunteerResponse(codes.OK, "success", "hours recorded successfully", signup)
+}
+
+func (h *VolunteerGrpcHandler) ListMyShifts(ctx context.Context, req *pb.ListMyShiftsRequest) (*pb.StandardVolunteerResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForVolunteers := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForVolunteers, "ListMyShifts"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+	var from, to time.Time
+	if req.GetStartFrom() != nil {
+		from = req.GetStartFrom().AsTime()
+	}
+	if req.GetStartBefore() != nil {
+		to = req.GetStartBefore().AsTime()
+	}
+
+	signups, err := h.Svc.ListMyShifts(ctx, userID, from, to)
+	if err != nil {
+		return nil, volunteerError(err, "list shifts")
+	}
+	return helper.StandardVolunteerResponse(codes.OK, "success", "shifts retrieved successfully", signups)
+}
+
+func (h *VolunteerGrpcHandler) GetVolunteerHours(ctx context.Context, req *pb.GetVolunteerHoursRequest) (*pb.StandardVolunteerResponse, error) {
+	// --- Start Authorization (Coarse-Grained) ---
+	allowedRolesForVolunteers := []string{
+		string(entity.MASJID_ADMIN),
+		string(entity.MASJID_VOLUNTEER),
+		string(entity.MASJID_IMAM),
+	}
+	if err := auth.RequireRole(ctx, allowedRolesForVolunteers, "GetVolunteerHours"); err != nil {
+		return nil, err
+	}
+	// --- End Authorization (Coarse-Grained) ---
+	userID, ok := ctx.Value(auth.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID not found in context")
+	}
+	if req.GetMasjidId() != "" {
+		// Coordinators may see every volunteer's hours at a masjid.
+		coordinators := []string{
+			string(entity.MASJID_ADMIN),
+			string(entity.MASJID_IMAM),
+		}
+		if err := auth.RequireRole(ctx, coordinators, "GetVolunteerHours for a masjid"); err != nil {
+			return nil, err
+		}
+	}
+	if req.GetYear() < 0 || req.GetYear() > 9999 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid year: %d", req.GetYear())
+	}
+
+	hours, year, err := h.Svc.GetVolunteerHours(ctx, req.GetMasjidId(), userID, int(req.GetYear()))
+	if err != nil {
+		return nil, volunteerError(err, "get volunteer hours")
+	}
+	return helper.StandardVolunteerResponse(codes.OK, "success", "volunteer hours retrieved successfully", helper.ToProtoVolunteerHours(year, hours))
+}
+
+// volunteerError maps the errors of VolunteerService to gRPC statuses.
+func volunteerError(err error, action string) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Errorf(codes.NotFound, "event, masjid, shift or sign-up not found")
+	case errors.Is(err, helper.ErrInvalidShift), errors.Is(err, helper.ErrInvalidTimeWindow):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, helper.ErrShiftFull):
+		return status.Errorf(codes.ResourceExhausted, "%v", err)
+	case errors.Is(err, helper.ErrShiftOnRecurringEvent), errors.Is(err, helper.ErrShiftHeadcountTooLow),
+		errors.Is(err, helper.ErrShiftHasHours), errors.Is(err, helper.ErrShiftOverlap),
+		errors.Is(err, helper.ErrShiftStarted), errors.Is(err, helper.ErrShiftNotStarted):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
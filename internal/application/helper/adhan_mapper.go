@@ -0,0 +1,70 @@
+package helper
+
+import (
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToProtoAdhanFile(e *entity.Adhan) *pb.AdhanFile {
+	return &pb.AdhanFile{
+		Id:          e.ID.String(),
+		MasjidId:    e.MasjidId,
+		CreateTime:  timestamppb.New(e.CreatedAt),
+		UpdateTime:  timestamppb.New(e.UpdatedAt),
+		SizeBytes:   e.Size,
+		ContentType: e.ContentType,
+		AudioMetadata: &pb.AudioMetadata{
+			DurationMs:   e.DurationMs,
+			SampleRateHz: e.SampleRate,
+			Channels:     e.Channels,
+			BitrateBps:   e.Bitrate,
+			Codec:        e.Codec,
+		},
+		Title:            e.Title,
+		Muezzin:          e.Muezzin,
+		ProcessingStatus: pb.AdhanFile_ProcessingStatus(e.ProcessingStatus),
+		ProcessingError:  e.ProcessingError,
+		LoudnessLufs:     e.LoudnessLufs,
+		Renditions:       ToProtoAdhanRenditions(e.Renditions),
+		Sha256:           e.Sha256,
+		Etag:             ContentETag(e.Sha256),
+	}
+}
+
+func ToProtoAdhanRenditions(renditions []entity.AdhanRendition) []*pb.AdhanRendition {
+	var resp []*pb.AdhanRendition
+	for _, r := range renditions {
+		resp = append(resp, &pb.AdhanRendition{
+			Name:        r.Name,
+			SizeBytes:   r.Size,
+			ContentType: r.ContentType,
+			AudioMetadata: &pb.AudioMetadata{
+				DurationMs:   r.DurationMs,
+				SampleRateHz: r.SampleRate,
+				Channels:     r.Channels,
+				BitrateBps:   r.Bitrate,
+				Codec:        r.Codec,
+			},
+			LoudnessLufs: r.LoudnessLufs,
+			Sha256:       r.Sha256,
+			Etag:         ContentETag(r.Sha256),
+		})
+	}
+	return resp
+}
+
+func ToProtoAdhanAssignments(masjidID string, assignments []entity.ResolvedAdhanAssignment) *pb.AdhanAssignments {
+	resp := &pb.AdhanAssignments{MasjidId: masjidID}
+	for _, a := range assignments {
+		assignment := &pb.AdhanAssignment{
+			Prayer:    pb.Prayer(a.Prayer),
+			Inherited: a.Inherited,
+		}
+		if a.Adhan != nil {
+			assignment.Adhan = ToProtoAdhanFile(a.Adhan)
+		}
+		resp.Assignments = append(resp.Assignments, assignment)
+	}
+	return resp
+}
@@ -0,0 +1,144 @@
+package helper
+
+import (
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToProtoEvent(e *entity.Event) *pb.Event {
+	if e == nil {
+		return nil
+	}
+
+	event := &pb.Event{
+		Id:                e.PublicID(),
+		MasjidId:          e.MasjidId,
+		Name:              e.Name,
+		Description:       e.Description,
+		StartTime:         timestamppb.New(e.StartTime),
+		EndTime:           timestamppb.New(e.EndTime),
+		GenderRestriction: pb.Event_GenderRestriction(e.GenderRestriction),
+		IsPaid:            e.IsPaid,
+		RequiresRsvp:      e.RequiresRsvp,
+		MaxParticipants:   e.MaxParticipants,
+		LivestreamLink:    e.LivestreamLink,
+		CreateTime:        timestamppb.New(e.CreatedAt),
+		UpdateTime:        timestamppb.New(e.UpdatedAt),
+		HijriStartDate:    ToProtoHijriDate(e.HijriStart),
+		HijriEndDate:      ToProtoHijriDate(e.HijriEnd),
+		Recurrence:        e.RecurrenceLines(),
+	}
+	for _, t := range e.TypeNames() {
+		event.Types = append(event.Types, entity.FromInternalToProtoEvent(t))
+	}
+	if e.RecurringEventId != nil {
+		event.RecurringEventId = e.RecurringEventId.String()
+	}
+	if e.OriginalStartTime != nil {
+		event.OriginalStartTime = timestamppb.New(*e.OriginalStartTime)
+	}
+	if e.Attendance != nil {
+		event.Attendance = &pb.Attendance{
+			ConfirmedCount: int32(e.Attendance.Confirmed),
+			CheckedInCount: int32(e.Attendance.CheckedIn),
+		}
+	}
+	for i := range e.TicketTypes {
+		event.TicketTypes = append(event.TicketTypes, ToProtoTicketType(&e.TicketTypes[i]))
+	}
+	return event
+}
+
+func ToProtoTicketType(t *entity.TicketType) *pb.TicketType {
+	return &pb.TicketType{
+		Id:             t.ID.String(),
+		Name:           t.Name,
+		Price:          t.Price,
+		CurrencyCode:   t.Currency,
+		Quota:          t.Quota,
+		SoldCount:      int32(t.Sold),
+		RemainingCount: int32(t.Remaining()),
+	}
+}
+
+func ToProtoOrder(o *entity.Order) *pb.Order {
+	resp := &pb.Order{
+		Id:           o.ID.String(),
+		EventId:      o.EventId.String(),
+		UserId:       o.UserId.String(),
+		Status:       pb.Order_Status(o.Status),
+		Total:        o.Total,
+		CurrencyCode: o.Currency,
+		CreateTime:   timestamppb.New(o.CreatedAt),
+		UpdateTime:   timestamppb.New(o.UpdatedAt),
+		ExpireTime:   timestamppb.New(o.ExpiresAt),
+	}
+	if o.Status == entity.OrderPending {
+		resp.CheckoutUrl = o.CheckoutURL
+	}
+	if o.RsvpId != nil {
+		resp.RsvpId = o.RsvpId.String()
+	}
+	if o.PaidAt != nil {
+		resp.PayTime = timestamppb.New(*o.PaidAt)
+	}
+	for _, item := range o.Items {
+		resp.Items = append(resp.Items, &pb.OrderItem{
+			TicketTypeId: item.TicketTypeId.String(),
+			Quantity:     item.Quantity,
+			Name:         item.Name,
+			UnitPrice:    item.UnitPrice,
+		})
+	}
+	return resp
+}
+
+func ToProtoRsvp(r *entity.EventRsvp) *pb.Rsvp {
+	resp := &pb.Rsvp{
+		Id:               r.ID.String(),
+		EventId:          r.EventId.String(),
+		UserId:           r.UserId.String(),
+		Status:           pb.Rsvp_Status(r.Status),
+		WaitlistPosition: int32(r.WaitlistPosition),
+		CreateTime:       timestamppb.New(r.CreatedAt),
+		UpdateTime:       timestamppb.New(r.UpdatedAt),
+		Event:            ToProtoEvent(r.Event),
+		Ticket:           r.Ticket,
+	}
+	if r.User != nil {
+		resp.FirstName = r.User.FirstName
+		resp.LastName = r.User.LastName
+	}
+	return resp
+}
+
+func ToProtoCheckIn(c *entity.EventCheckIn, event *entity.Event) *pb.CheckIn {
+	resp := &pb.CheckIn{
+		Id:          c.ID.String(),
+		EventId:     event.PublicID(),
+		RsvpId:      c.RsvpId.String(),
+		UserId:      c.UserId.String(),
+		CheckInTime: timestamppb.New(c.CheckedInAt),
+		CheckedInBy: c.CheckedInBy.String(),
+		Event:       ToProtoEvent(event),
+	}
+	if c.User != nil {
+		resp.FirstName = c.User.FirstName
+		resp.LastName = c.User.LastName
+	}
+	return resp
+}
+
+func ToProtoCalendarFeed(f *entity.CalendarFeed, url string) *pb.CalendarFeed {
+	resp := &pb.CalendarFeed{
+		Url:        url,
+		MasjidId:   f.MasjidId,
+		CreateTime: timestamppb.New(f.CreatedAt),
+		UpdateTime: timestamppb.New(f.UpdatedAt),
+	}
+	if f.UserId != nil {
+		resp.UserId = f.UserId.String()
+	}
+	return resp
+}
@@ -1,32 +1,45 @@
 package helper
 
 import (
-	"bytes"
 	"encoding/json"
 	"errors"
+	"github.com/mnadev/limestone/internal/application/domain/audio"
 	"log"
 	"net/http"
+	"strings"
 )
 
-var mp3MagicBytes = [][]byte{
-	{0xFF, 0xFB},
-	{0xFF, 0xF3},
-	{0xFF, 0xF2},
-	{'I', 'D', '3'},
+// IsAudioFile reports whether data starts like one of the audio formats
+// accepted for adhans.
+func IsAudioFile(data []byte) bool {
+	return audio.Detect(data) != ""
 }
 
-var wavMagicBytes = [][]byte{
-	{'R', 'I', 'F', 'F'},
+// AudioContentType returns the MIME type of the audio format data starts
+// with, or application/octet-stream for anything else.
+func AudioContentType(data []byte) string {
+	return audio.ContentType(audio.Detect(data))
 }
 
-func IsAudioFile(data []byte) bool {
-	for _, magic := range mp3MagicBytes {
-		if len(data) >= len(magic) && bytes.Equal(data[:len(magic)], magic) {
-			return true
-		}
+// ContentETag returns the strong entity tag of content with the given hex
+// SHA-256, or "" if the hash is unknown.
+func ContentETag(sha256 string) string {
+	if sha256 == "" {
+		return ""
+	}
+	return `"` + sha256 + `"`
+}
+
+// ETagMatches reports whether etag matches the value of an If-None-Match
+// header: "*" or a comma-separated list of entity tags, compared weakly as
+// RFC 9110 requires for If-None-Match.
+func ETagMatches(ifNoneMatch, etag string) bool {
+	if etag == "" {
+		return false
 	}
-	for _, magic := range wavMagicBytes {
-		if len(data) >= len(magic) && bytes.Equal(data[:len(magic)], magic) {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
 			return true
 		}
 	}
@@ -48,6 +61,54 @@ var (
 	ErrMatchNotInitiated          = errors.New("revert match is not in initiated status")
 	ErrMatchNotAccepted           = errors.New("revert match is not in accepted status")
 	ErrProfileNotFound            = errors.New("profile not found")
+	ErrMasjidLocationNotSet       = errors.New("masjid coordinates and time zone are not set")
+	ErrInvalidTimeZone            = errors.New("invalid IANA time zone")
+	ErrInvalidSearchRadius        = errors.New("search radius must be greater than 0 and at most 500 km")
+	ErrInvalidPrayerSlot          = errors.New("invalid prayer slot")
+	ErrInvalidSuhoorMargin        = errors.New("suhoor margin must be between 0 and 60 minutes")
+	ErrAdhanTooLarge              = errors.New("adhan file exceeds the maximum allowed size")
+	ErrEmptyAdhanAudio            = errors.New("adhan file content is required")
+	ErrInvalidPrayer              = errors.New("invalid prayer")
+	ErrAdhanNotInMasjid           = errors.New("adhan does not belong to this masjid")
+	ErrAdhanRenditionNotFound     = errors.New("adhan rendition not found; it may still be processing")
+	ErrAdhanChecksumMismatch      = errors.New("stored adhan audio does not match its SHA-256")
+	ErrRsvpNotRequired            = errors.New("event does not take RSVPs")
+	ErrEventEnded                 = errors.New("event has already ended")
+	ErrGenderRestricted           = errors.New("event is restricted to another gender")
+	ErrGenderNotSet               = errors.New("event is restricted by gender; set a gender on your profile to RSVP")
+	ErrInvalidPageToken           = errors.New("invalid page token")
+	ErrInvalidTimeWindow          = errors.New("start_before must be after start_from and at most 400 days later")
+	ErrRecurringException         = errors.New("an occurrence edited on its own cannot have a recurrence")
+	ErrInvalidFilter              = errors.New("invalid filter")
+	ErrInvalidOrderBy             = errors.New("invalid order_by")
+	ErrTicketsNotConfigured       = errors.New("event tickets are not configured on this server")
+	ErrTicketForAnotherEvent      = errors.New("ticket is for another event")
+	ErrTicketNotConfirmed         = errors.New("the rsvp on this ticket is not confirmed")
+	ErrAlreadyCheckedIn           = errors.New("ticket has already been checked in")
+	ErrCheckInToSeries            = errors.New("check in to a recurring event by the ID of its occurrence")
+	ErrInvalidTicketTypes         = errors.New("invalid ticket types")
+	ErrTicketTypeInUse            = errors.New("ordered ticket types cannot be removed, change currency or have their quota lowered below the number ordered")
+	ErrTicketsRequired            = errors.New("event sells tickets; order one to attend")
+	ErrNoTicketTypes              = errors.New("event does not sell tickets")
+	ErrInvalidOrder               = errors.New("an order needs a positive quantity of each of the event's ticket types it names")
+	ErrTicketsSoldOut             = errors.New("not enough tickets left")
+	ErrPaymentsNotConfigured      = errors.New("payments are not configured on this server")
+	ErrOrderNotCancellable        = errors.New("paid orders can only be cancelled by their buyer before the event starts")
+	ErrInvalidShift               = errors.New("invalid volunteer shift")
+	ErrShiftOnRecurringEvent      = errors.New("shifts are added to single events or to an occurrence edited on its own")
+	ErrShiftHeadcountTooLow       = errors.New("headcount is below the volunteers who have claimed the shift")
+	ErrShiftHasHours              = errors.New("shifts with hours recorded cannot be deleted")
+	ErrShiftFull                  = errors.New("shift has no places left")
+	ErrShiftOverlap               = errors.New("you have claimed another shift at the same time")
+	ErrShiftStarted               = errors.New("shift has already started")
+	ErrShiftNotStarted            = errors.New("hours can only be recorded once the shift has started")
+	ErrInvalidRoom                = errors.New("invalid room")
+	ErrInvalidBooking             = errors.New("invalid room booking")
+	ErrRoomUnavailable            = errors.New("room is already booked at that time")
+	ErrRoomHasBookings            = errors.New("rooms booked from now on cannot be deleted")
+	ErrBookingStarted             = errors.New("bookings can only be cancelled by their member before they start")
+	ErrInvalidPreferences         = errors.New("invalid notification preferences")
+	ErrInvalidPushDevice          = errors.New("invalid push device")
 )
 
 type ErrorResponse struct {
@@ -0,0 +1,19 @@
+package helper
+
+import (
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/hijri"
+)
+
+func ToProtoHijriDate(d hijri.Date) *pb.HijriDate {
+	if d.IsZero() {
+		return nil
+	}
+
+	return &pb.HijriDate{
+		Year:      int32(d.Year),
+		Month:     int32(d.Month),
+		Day:       int32(d.Day),
+		MonthName: d.MonthName(),
+	}
+}
@@ -0,0 +1,81 @@
+package helper
+
+import (
+	"github.com/google/uuid"
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func ToEntityIqamahRule(masjidID string, id uuid.UUID, p *pb.IqamahRule) *entity.IqamahRule {
+	return &entity.IqamahRule{
+		ID:                id,
+		MasjidId:          masjidID,
+		Prayer:            entity.Prayer(p.GetPrayer()),
+		Type:              entity.IqamahRuleType(p.GetType()),
+		FixedTime:         p.GetFixedTime(),
+		MinutesAfterAdhan: p.GetMinutesAfterAdhan(),
+		StartDate:         p.GetStartDate(),
+		EndDate:           p.GetEndDate(),
+	}
+}
+
+func ToProtoIqamahRule(e *entity.IqamahRule) *pb.IqamahRule {
+	if e == nil {
+		return nil
+	}
+
+	return &pb.IqamahRule{
+		Id:                e.ID.String(),
+		MasjidId:          e.MasjidId,
+		Prayer:            pb.Prayer(e.Prayer),
+		Type:              pb.IqamahRule_RuleType(e.Type),
+		FixedTime:         e.FixedTime,
+		MinutesAfterAdhan: e.MinutesAfterAdhan,
+		StartDate:         e.StartDate,
+		EndDate:           e.EndDate,
+		CreateTime:        timestamppb.New(e.CreatedAt),
+		UpdateTime:        timestamppb.New(e.UpdatedAt),
+	}
+}
+
+func ToProtoPrayerTimes(masjidID string, times *prayertimes.Times) *pb.PrayerTimes {
+	if times == nil {
+		return nil
+	}
+
+	return &pb.PrayerTimes{
+		MasjidId:  masjidID,
+		Date:      times.Date.Format("2006-01-02"),
+		TimeZone:  times.Date.Location().String(),
+		Fajr:      timestamppb.New(times.Fajr),
+		Sunrise:   timestamppb.New(times.Sunrise),
+		Dhuhr:     timestamppb.New(times.Dhuhr),
+		Asr:       timestamppb.New(times.Asr),
+		Maghrib:   timestamppb.New(times.Maghrib),
+		Isha:      timestamppb.New(times.Isha),
+		HijriDate: ToProtoHijriDate(times.Hijri),
+	}
+}
+
+func ToProtoIqamah(iqamah *prayertimes.Iqamah) *pb.Iqamah {
+	if iqamah == nil {
+		return nil
+	}
+
+	optional := func(t time.Time) *timestamppb.Timestamp {
+		if t.IsZero() {
+			return nil
+		}
+		return timestamppb.New(t)
+	}
+	return &pb.Iqamah{
+		Fajr:    optional(iqamah.Fajr),
+		Dhuhr:   optional(iqamah.Dhuhr),
+		Asr:     optional(iqamah.Asr),
+		Maghrib: optional(iqamah.Maghrib),
+		Isha:    optional(iqamah.Isha),
+	}
+}
@@ -0,0 +1,59 @@
+package helper
+
+import (
+	"github.com/google/uuid"
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func ToEntityPrayerSlot(masjidID string, id uuid.UUID, p *pb.PrayerSlot) *entity.PrayerSlot {
+	return &entity.PrayerSlot{
+		ID:               id,
+		MasjidId:         masjidID,
+		Type:             entity.PrayerSlotType(p.GetType()),
+		StartTime:        p.GetStartTime(),
+		Date:             p.GetDate(),
+		StartDate:        p.GetStartDate(),
+		EndDate:          p.GetEndDate(),
+		KhateebId:        p.GetKhateebId(),
+		Language:         p.GetLanguage(),
+		LocationOverride: p.GetLocationOverride(),
+		Capacity:         p.GetCapacity(),
+	}
+}
+
+func ToProtoPrayerSlot(e *entity.PrayerSlot) *pb.PrayerSlot {
+	if e == nil {
+		return nil
+	}
+
+	return &pb.PrayerSlot{
+		Id:               e.ID.String(),
+		MasjidId:         e.MasjidId,
+		Type:             pb.PrayerSlot_SlotType(e.Type),
+		StartTime:        e.StartTime,
+		Date:             e.Date,
+		StartDate:        e.StartDate,
+		EndDate:          e.EndDate,
+		KhateebId:        e.KhateebId,
+		Language:         e.Language,
+		LocationOverride: e.LocationOverride,
+		Capacity:         e.Capacity,
+		CreateTime:       timestamppb.New(e.CreatedAt),
+		UpdateTime:       timestamppb.New(e.UpdatedAt),
+	}
+}
+
+func ToProtoNextJumuah(date time.Time, slots []prayertimes.ScheduledSlot) *pb.NextJumuahResponse {
+	resp := &pb.NextJumuahResponse{Date: date.Format("2006-01-02")}
+	for i := range slots {
+		resp.Slots = append(resp.Slots, &pb.ScheduledPrayerSlot{
+			Slot:      ToProtoPrayerSlot(&slots[i].Slot),
+			StartTime: timestamppb.New(slots[i].Start),
+		})
+	}
+	return resp
+}
@@ -0,0 +1,75 @@
+package helper
+
+import (
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/geo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToProtoMasjid(masjid *entity.Masjid) *pb.Masjid {
+	if masjid == nil {
+		return nil
+	}
+
+	protoMasjid := &pb.Masjid{
+		Id:         masjid.ID.String(),
+		Name:       masjid.Name,
+		IsVerified: masjid.IsVerified,
+		Location:   masjid.Location,
+		Address: &pb.Masjid_Address{
+			AddressLine_1: masjid.Address.AddressLine1,
+			AddressLine_2: masjid.Address.AddressLine2,
+			ZoneCode:      masjid.Address.ZoneCode,
+			PostalCode:    masjid.Address.PostalCode,
+			City:          masjid.Address.City,
+			CountryCode:   masjid.Address.CountryCode,
+		},
+		PhoneNumber: &pb.Masjid_PhoneNumber{
+			CountryCode: masjid.PhoneNumber.PhoneCountryCode,
+			Number:      masjid.PhoneNumber.Number,
+			Extension:   masjid.PhoneNumber.Extension,
+		},
+		PrayerConfig: &pb.PrayerTimesConfiguration{
+			Method:           pb.PrayerTimesConfiguration_CalculationMethod(int32(masjid.PrayerConfig.CalculationMethod)),
+			FajrAngle:        masjid.PrayerConfig.FajrAngle,
+			IshaAngle:        masjid.PrayerConfig.IshaAngle,
+			IshaInterval:     masjid.PrayerConfig.IshaInterval,
+			AsrMethod:        pb.PrayerTimesConfiguration_AsrJuristicMethod(int32(masjid.PrayerConfig.AsrMethod)),
+			HighLatitudeRule: pb.PrayerTimesConfiguration_HighLatitudeRule(int32(masjid.PrayerConfig.HighLatitudeRule)),
+			Adjustments: &pb.PrayerTimesConfiguration_PrayerAdjustments{
+				FajrAdjustment:    masjid.PrayerConfig.Adjustments.FajrAdjustment,
+				DhuhrAdjustment:   masjid.PrayerConfig.Adjustments.DhuhrAdjustment,
+				AsrAdjustment:     masjid.PrayerConfig.Adjustments.AsrAdjustment,
+				MaghribAdjustment: masjid.PrayerConfig.Adjustments.MaghribAdjustment,
+				IshaAdjustment:    masjid.PrayerConfig.Adjustments.IshaAdjustment,
+			},
+			SuhoorMargin: masjid.PrayerConfig.SuhoorMargin,
+		},
+		CreateTime:  timestamppb.New(masjid.CreatedAt),
+		UpdateTime:  timestamppb.New(masjid.UpdatedAt),
+		Latitude:    masjid.Latitude,
+		Longitude:   masjid.Longitude,
+		TimeZone:    masjid.TimeZone,
+		HijriOffset: masjid.HijriOffset,
+	}
+	if masjid.Latitude != 0 || masjid.Longitude != 0 {
+		protoMasjid.Qibla = ToProtoQibla(geo.QiblaFrom(geo.Coordinates{Latitude: masjid.Latitude, Longitude: masjid.Longitude}))
+	}
+	return protoMasjid
+}
+
+func ToProtoQibla(q geo.Qibla) *pb.Qibla {
+	return &pb.Qibla{BearingDegrees: q.BearingDegrees, DistanceKm: q.DistanceKm}
+}
+
+func ToProtoNearbyMasjids(results []entity.NearbyMasjid) *pb.SearchNearbyMasjidsResponse {
+	resp := &pb.SearchNearbyMasjidsResponse{}
+	for i := range results {
+		resp.Masjids = append(resp.Masjids, &pb.NearbyMasjid{
+			Masjid:     ToProtoMasjid(&results[i].Masjid),
+			DistanceKm: results[i].DistanceKm,
+		})
+	}
+	return resp
+}
@@ -0,0 +1,56 @@
+package helper
+
+import (
+	"github.com/google/uuid"
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToEntityNotificationPreference(userID uuid.UUID, p *pb.NotificationPreferences) *entity.NotificationPreference {
+	return &entity.NotificationPreference{
+		UserId:          userID,
+		Email:           p.GetEmail(),
+		Push:            p.GetPush(),
+		Reminders:       p.GetReminders(),
+		ReminderMinutes: p.GetReminderMinutes(),
+		Changes:         p.GetChanges(),
+	}
+}
+
+func ToProtoNotificationPreferences(p *entity.NotificationPreference) *pb.NotificationPreferences {
+	resp := &pb.NotificationPreferences{
+		Email:           p.Email,
+		Push:            p.Push,
+		Reminders:       p.Reminders,
+		ReminderMinutes: p.ReminderMinutes,
+		Changes:         p.Changes,
+	}
+	if !p.UpdatedAt.IsZero() {
+		resp.UpdateTime = timestamppb.New(p.UpdatedAt)
+	}
+	return resp
+}
+
+func ToProtoMasjidFollow(f *entity.MasjidFollow) *pb.MasjidFollow {
+	return &pb.MasjidFollow{
+		MasjidId:   f.MasjidId,
+		CreateTime: timestamppb.New(f.CreatedAt),
+	}
+}
+
+func ToEntityPushDevice(userID uuid.UUID, p *pb.PushDevice) *entity.PushDevice {
+	return &entity.PushDevice{
+		Token:    p.GetToken(),
+		UserId:   userID,
+		Platform: p.GetPlatform(),
+	}
+}
+
+func ToProtoPushDevice(d *entity.PushDevice) *pb.PushDevice {
+	return &pb.PushDevice{
+		Token:      d.Token,
+		Platform:   d.Platform,
+		CreateTime: timestamppb.New(d.CreatedAt),
+	}
+}
@@ -0,0 +1,47 @@
+package helper
+
+import (
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func ToEntityRamadanNights(nights []*pb.RamadanNight) []entity.RamadanNight {
+	out := make([]entity.RamadanNight, 0, len(nights))
+	for _, n := range nights {
+		out = append(out, entity.RamadanNight{
+			Night:        n.GetNight(),
+			TaraweehTime: n.GetTaraweehTime(),
+			QiyamTime:    n.GetQiyamTime(),
+			ImamId:       n.GetImamId(),
+		})
+	}
+	return out
+}
+
+func ToProtoRamadanSchedule(masjidID string, hijriYear int32, days []prayertimes.RamadanDay) *pb.RamadanSchedule {
+	schedule := &pb.RamadanSchedule{MasjidId: masjidID, HijriYear: hijriYear}
+	for i := range days {
+		d := &days[i]
+		schedule.Days = append(schedule.Days, &pb.RamadanDay{
+			Day:       int32(d.Day),
+			Date:      d.Times.Date.Format("2006-01-02"),
+			HijriDate: ToProtoHijriDate(d.Times.Hijri),
+			SuhoorEnd: timestamppb.New(d.SuhoorEnd),
+			Iftar:     timestamppb.New(d.Iftar),
+			Taraweeh:  optionalTimestamp(d.Taraweeh),
+			Qiyam:     optionalTimestamp(d.Qiyam),
+			ImamId:    d.ImamId,
+		})
+	}
+	return schedule
+}
+
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,83 @@
+package helper
+
+import (
+	"github.com/google/uuid"
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToEntityRoom(masjidID string, id uuid.UUID, p *pb.Room) *entity.Room {
+	return &entity.Room{
+		ID:          id,
+		MasjidId:    masjidID,
+		Name:        p.GetName(),
+		Description: p.GetDescription(),
+		Capacity:    p.GetCapacity(),
+		Designation: entity.GenderRestriction(p.GetDesignation()),
+	}
+}
+
+func ToProtoRoom(r *entity.Room) *pb.Room {
+	if r == nil {
+		return nil
+	}
+	return &pb.Room{
+		Id:          r.ID.String(),
+		MasjidId:    r.MasjidId,
+		Name:        r.Name,
+		Description: r.Description,
+		Capacity:    r.Capacity,
+		Designation: pb.Room_Designation(r.Designation),
+		CreateTime:  timestamppb.New(r.CreatedAt),
+		UpdateTime:  timestamppb.New(r.UpdatedAt),
+	}
+}
+
+func ToEntityRoomBooking(roomID uuid.UUID, p *pb.RoomBooking) *entity.RoomBooking {
+	return &entity.RoomBooking{
+		RoomId:    roomID,
+		Purpose:   p.GetPurpose(),
+		StartTime: p.GetStartTime().AsTime(),
+		EndTime:   p.GetEndTime().AsTime(),
+		Guests:    p.GetGuests(),
+	}
+}
+
+func ToProtoRoomBooking(b *entity.RoomBooking) *pb.RoomBooking {
+	resp := &pb.RoomBooking{
+		Id:         b.ID.String(),
+		RoomId:     b.RoomId.String(),
+		Purpose:    b.Purpose,
+		StartTime:  timestamppb.New(b.StartTime),
+		EndTime:    timestamppb.New(b.EndTime),
+		Guests:     b.Guests,
+		CreateTime: timestamppb.New(b.CreatedAt),
+		Room:       ToProtoRoom(b.Room),
+	}
+	if b.EventId != nil {
+		resp.EventId = b.EventId.String()
+	}
+	if b.UserId != nil {
+		resp.UserId = b.UserId.String()
+	}
+	return resp
+}
+
+func ToProtoRoomAvailability(a *entity.RoomAvailability) *pb.RoomAvailability {
+	resp := &pb.RoomAvailability{
+		Room:        ToProtoRoom(a.Room),
+		StartFrom:   timestamppb.New(a.From),
+		StartBefore: timestamppb.New(a.To),
+	}
+	for i := range a.Bookings {
+		resp.Bookings = append(resp.Bookings, ToProtoRoomBooking(&a.Bookings[i]))
+	}
+	for _, free := range a.Free {
+		resp.Free = append(resp.Free, &pb.TimeRange{
+			StartTime: timestamppb.New(free.Start),
+			EndTime:   timestamppb.New(free.End),
+		})
+	}
+	return resp
+}
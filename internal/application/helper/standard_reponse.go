@@ -4,6 +4,9 @@ import (
 	"fmt"
 	pb "github.com/mnadev/limestone/gen/go"
 	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/geo"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+	"github.com/mnadev/limestone/internal/application/domain/timetable"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -46,42 +49,7 @@ func StandardMasjidResponse(code codes.Code, status string, message string, masj
 
 	if masjid != nil {
 		resp.Data = &pb.StandardMasjidResponse_Masjid{
-			Masjid: &pb.Masjid{
-				Id:         masjid.ID.String(),
-				Name:       masjid.Name,
-				IsVerified: masjid.IsVerified,
-				Location:   masjid.Location,
-				Address: &pb.Masjid_Address{
-					AddressLine_1: masjid.Address.AddressLine1,
-					AddressLine_2: masjid.Address.AddressLine2,
-					ZoneCode:      masjid.Address.ZoneCode,
-					PostalCode:    masjid.Address.PostalCode,
-					City:          masjid.Address.City,
-					CountryCode:   masjid.Address.CountryCode,
-				},
-				PhoneNumber: &pb.Masjid_PhoneNumber{
-					CountryCode: masjid.PhoneNumber.PhoneCountryCode,
-					Number:      masjid.PhoneNumber.Number,
-					Extension:   masjid.PhoneNumber.Extension,
-				},
-				PrayerConfig: &pb.PrayerTimesConfiguration{
-					Method:           pb.PrayerTimesConfiguration_CalculationMethod(int32(masjid.PrayerConfig.CalculationMethod)),
-					FajrAngle:        masjid.PrayerConfig.FajrAngle,
-					IshaAngle:        masjid.PrayerConfig.IshaAngle,
-					IshaInterval:     masjid.PrayerConfig.IshaInterval,
-					AsrMethod:        pb.PrayerTimesConfiguration_AsrJuristicMethod(int32(masjid.PrayerConfig.AsrMethod)),
-					HighLatitudeRule: pb.PrayerTimesConfiguration_HighLatitudeRule(int32(masjid.PrayerConfig.HighLatitudeRule)),
-					Adjustments: &pb.PrayerTimesConfiguration_PrayerAdjustments{
-						FajrAdjustment:    masjid.PrayerConfig.Adjustments.FajrAdjustment,
-						DhuhrAdjustment:   masjid.PrayerConfig.Adjustments.DhuhrAdjustment,
-						AsrAdjustment:     masjid.PrayerConfig.Adjustments.AsrAdjustment,
-						MaghribAdjustment: masjid.PrayerConfig.Adjustments.MaghribAdjustment,
-						IshaAdjustment:    masjid.PrayerConfig.Adjustments.IshaAdjustment,
-					},
-				},
-				CreateTime: timestamppb.New(masjid.CreatedAt),
-				UpdateTime: timestamppb.New(masjid.UpdatedAt),
-			},
+			Masjid: ToProtoMasjid(masjid),
 		}
 	} else if listMasjidsResponse != nil {
 		resp.Data = &pb.StandardMasjidResponse_ListMasjidResponse{
@@ -96,6 +64,110 @@ func StandardMasjidResponse(code codes.Code, status string, message string, masj
 	return resp, nil
 }
 
+func StandardNearbyMasjidsResponse(code codes.Code, status string, message string, results []entity.NearbyMasjid) (*pb.StandardMasjidResponse, error) {
+	return &pb.StandardMasjidResponse{
+		Code:    code.String(),
+		Status:  status,
+		Message: message,
+		Data: &pb.StandardMasjidResponse_SearchNearbyMasjidsResponse{
+			SearchNearbyMasjidsResponse: ToProtoNearbyMasjids(results),
+		},
+	}, nil
+}
+
+func StandardPrayerTimesResponse(code codes.Code, status string, message string, masjidID string, times *prayertimes.Times) (*pb.StandardMasjidResponse, error) {
+	resp := &pb.StandardMasjidResponse{
+		Code:    code.String(),
+		Status:  status,
+		Message: message,
+	}
+
+	if times != nil {
+		resp.Data = &pb.StandardMasjidResponse_PrayerTimes{
+			PrayerTimes: ToProtoPrayerTimes(masjidID, times),
+		}
+	}
+
+	return resp, nil
+}
+
+func StandardPrayerTimetableResponse(code codes.Code, status string, message string, file *timetable.File) (*pb.StandardMasjidResponse, error) {
+	resp := &pb.StandardMasjidResponse{
+		Code:    code.String(),
+		Status:  status,
+		Message: message,
+	}
+
+	if file != nil {
+		resp.Data = &pb.StandardMasjidResponse_PrayerTimetableFile{
+			PrayerTimetableFile: &pb.PrayerTimetableFile{
+				FileName:    file.Name,
+				ContentType: file.ContentType,
+				Content:     file.Content,
+			},
+		}
+	}
+
+	return resp, nil
+}
+
+func StandardQiblaResponse(code codes.Code, status string, message string, qibla geo.Qibla) (*pb.StandardMasjidResponse, error) {
+	return &pb.StandardMasjidResponse{
+		Code:    code.String(),
+		Status:  status,
+		Message: message,
+		Data:    &pb.StandardMasjidResponse_Qibla{Qibla: ToProtoQibla(qibla)},
+	}, nil
+}
+
+func StandardImportPrayerTimetableResponse(code codes.Code, status string, message string, overrides []entity.PrayerTimeOverride) (*pb.StandardMasjidResponse, error) {
+	resp := &pb.StandardMasjidResponse{
+		Code:    code.String(),
+		Status:  status,
+		Message: message,
+	}
+
+	if len(overrides) > 0 {
+		resp.Data = &pb.StandardMasjidResponse_ImportPrayerTimetableResponse{
+			ImportPrayerTimetableResponse: &pb.ImportPrayerTimetableResponse{
+				ImportedDays: int32(len(overrides)),
+				StartDate:    overrides[0].Date,
+				EndDate:      overrides[len(overrides)-1].Date,
+			},
+		}
+	}
+
+	return resp, nil
+}
+
+func StandardIqamahResponse(code codes.Code, status string, message string, data interface{}) (*pb.StandardMasjidResponse, error) {
+	resp := &pb.StandardMasjidResponse{
+		Code:    code.String(),
+		Status:  status,
+		Message: message,
+	}
+
+	if data != nil {
+		switch d := data.(type) {
+		case *entity.IqamahRule:
+			resp.Data = &pb.StandardMasjidResponse_IqamahRule{IqamahRule: ToProtoIqamahRule(d)}
+		case []entity.IqamahRule:
+			list := &pb.ListIqamahRulesResponse{}
+			for i := range d {
+				list.Rules = append(list.Rules, ToProtoIqamahRule(&d[i]))
+			}
+			resp.Data = &pb.StandardMasjidResponse_ListIqamahRulesResponse{ListIqamahRulesResponse: list}
+		case *pb.DeleteIqamahRuleResponse:
+			resp.Data = &pb.StandardMasjidResponse_DeleteIqamahRuleResponse{DeleteIqamahRuleResponse: d}
+		case *pb.DailyPrayerSchedule:
+			resp.Data = &pb.StandardMasjidResponse_DailyPrayerSchedule{DailyPrayerSchedule: d}
+		default:
+			return nil, fmt.Errorf("unsupported data type for StandardIqamahResponse: %T", d)
+		}
+	}
+	return resp, nil
+}
+
 func StandardEventResponse(code codes.Code, statusMessage string, message string, eventEntity *entity.Event, listResponse *pb.ListEventsResponse, deleteResponse *pb.DeleteEventResponse) (*pb.StandardEventResponse, error) {
 	resp := &pb.StandardEventResponse{
 		Code:    code.String(),
@@ -104,22 +176,7 @@ func StandardEventResponse(code codes.Code, statusMessage string, message string
 	}
 
 	if eventEntity != nil {
-		protoEvent := &pb.Event{
-			Id:                eventEntity.ID.String(),
-			MasjidId:          eventEntity.MasjidId,
-			Name:              eventEntity.Name,
-			Description:       eventEntity.Description,
-			StartTime:         timestamppb.New(eventEntity.StartTime),
-			EndTime:           timestamppb.New(eventEntity.EndTime),
-			GenderRestriction: pb.Event_GenderRestriction(eventEntity.GenderRestriction),
-			IsPaid:            eventEntity.IsPaid,
-			RequiresRsvp:      eventEntity.RequiresRsvp,
-			MaxParticipants:   eventEntity.MaxParticipants,
-			LivestreamLink:    eventEntity.LivestreamLink,
-			CreateTime:        timestamppb.New(eventEntity.CreatedAt),
-			UpdateTime:        timestamppb.New(eventEntity.UpdatedAt),
-		}
-		resp.Data = &pb.StandardEventResponse_Event{Event: protoEvent}
+		resp.Data = &pb.StandardEventResponse_Event{Event: ToProtoEvent(eventEntity)}
 	} else if listResponse != nil {
 		resp.Data = &pb.StandardEventResponse_ListEventResponse{ListEventResponse: listResponse}
 	} else if deleteResponse != nil {
@@ -129,6 +186,77 @@ func StandardEventResponse(code codes.Code, statusMessage string, message string
 	return resp, nil
 }
 
+func StandardRsvpResponse(code codes.Code, statusMessage string, message string, rsvp *entity.EventRsvp) (*pb.StandardEventResponse, error) {
+	return &pb.StandardEventResponse{
+		Code:    code.String(),
+		Status:  statusMessage,
+		Message: message,
+		Data:    &pb.StandardEventResponse_Rsvp{Rsvp: ToProtoRsvp(rsvp)},
+	}, nil
+}
+
+func StandardListRsvpsResponse(code codes.Code, statusMessage string, message string, rsvps []entity.EventRsvp, nextPageToken string) (*pb.StandardEventResponse, error) {
+	list := &pb.ListRsvpsResponse{NextPageToken: nextPageToken}
+	for i := range rsvps {
+		list.Rsvps = append(list.Rsvps, ToProtoRsvp(&rsvps[i]))
+	}
+	return &pb.StandardEventResponse{
+		Code:    code.String(),
+		Status:  statusMessage,
+		Message: message,
+		Data:    &pb.StandardEventResponse_ListRsvpsResponse{ListRsvpsResponse: list},
+	}, nil
+}
+
+func StandardCalendarFeedResponse(code codes.Code, statusMessage string, message string, feed *pb.CalendarFeed) (*pb.StandardEventResponse, error) {
+	return &pb.StandardEventResponse{
+		Code:    code.String(),
+		Status:  statusMessage,
+		Message: message,
+		Data:    &pb.StandardEventResponse_CalendarFeed{CalendarFeed: feed},
+	}, nil
+}
+
+func StandardCheckInResponse(code codes.Code, statusMessage string, message string, checkIn *pb.CheckIn) (*pb.StandardEventResponse, error) {
+	return &pb.StandardEventResponse{
+		Code:    code.String(),
+		Status:  statusMessage,
+		Message: message,
+		Data:    &pb.StandardEventResponse_CheckIn{CheckIn: checkIn},
+	}, nil
+}
+
+func StandardTicketKeyResponse(code codes.Code, statusMessage string, message string, key *pb.TicketKey) (*pb.StandardEventResponse, error) {
+	return &pb.StandardEventResponse{
+		Code:    code.String(),
+		Status:  statusMessage,
+		Message: message,
+		Data:    &pb.StandardEventResponse_TicketKey{TicketKey: key},
+	}, nil
+}
+
+func StandardOrderResponse(code codes.Code, statusMessage string, message string, order *entity.Order) (*pb.StandardEventResponse, error) {
+	return &pb.StandardEventResponse{
+		Code:    code.String(),
+		Status:  statusMessage,
+		Message: message,
+		Data:    &pb.StandardEventResponse_Order{Order: ToProtoOrder(order)},
+	}, nil
+}
+
+func StandardListOrdersResponse(code codes.Code, statusMessage string, message string, orders []entity.Order, nextPageToken string) (*pb.StandardEventResponse, error) {
+	list := &pb.ListOrdersResponse{NextPageToken: nextPageToken}
+	for i := range orders {
+		list.Orders = append(list.Orders, ToProtoOrder(&orders[i]))
+	}
+	return &pb.StandardEventResponse{
+		Code:    code.String(),
+		Status:  statusMessage,
+		Message: message,
+		Data:    &pb.StandardEventResponse_ListOrdersResponse{ListOrdersResponse: list},
+	}, nil
+}
+
 func StandardAdhanResponse(code codes.Code, statusMessage string, message string, adhanEntity *entity.Adhan, deleteResponse *pb.DeleteAdhanFileResponse) (*pb.StandardAdhanResponse, error) {
 	resp := &pb.StandardAdhanResponse{
 		Code:    code.String(),
@@ -137,14 +265,7 @@ func StandardAdhanResponse(code codes.Code, statusMessage string, message string
 	}
 
 	if adhanEntity != nil {
-		protoAdhan := &pb.AdhanFile{
-			Id:         adhanEntity.ID.String(),
-			MasjidId:   adhanEntity.MasjidId,
-			File:       adhanEntity.File,
-			CreateTime: timestamppb.New(adhanEntity.CreatedAt),
-			UpdateTime: timestamppb.New(adhanEntity.UpdatedAt),
-		}
-		resp.Data = &pb.StandardAdhanResponse_AdhanFile{AdhanFile: protoAdhan}
+		resp.Data = &pb.StandardAdhanResponse_AdhanFile{AdhanFile: ToProtoAdhanFile(adhanEntity)}
 	} else if deleteResponse != nil {
 		resp.Data = &pb.StandardAdhanResponse_DeleteAdhanFileResponse{DeleteAdhanFileResponse: deleteResponse}
 	}
@@ -152,6 +273,30 @@ func StandardAdhanResponse(code codes.Code, statusMessage string, message string
 	return resp, nil
 }
 
+func StandardListAdhansResponse(code codes.Code, statusMessage string, message string, adhans []entity.Adhan) (*pb.StandardAdhanResponse, error) {
+	list := &pb.ListAdhansResponse{}
+	for i := range adhans {
+		list.Adhans = append(list.Adhans, ToProtoAdhanFile(&adhans[i]))
+	}
+	return &pb.StandardAdhanResponse{
+		Code:    code.String(),
+		Status:  statusMessage,
+		Message: message,
+		Data:    &pb.StandardAdhanResponse_ListAdhansResponse{ListAdhansResponse: list},
+	}, nil
+}
+
+func StandardAdhanAssignmentsResponse(code codes.Code, statusMessage string, message string, masjidID string, assignments []entity.ResolvedAdhanAssignment) (*pb.StandardAdhanResponse, error) {
+	return &pb.StandardAdhanResponse{
+		Code:    code.String(),
+		Status:  statusMessage,
+		Message: message,
+		Data: &pb.StandardAdhanResponse_AdhanAssignments{
+			AdhanAssignments: ToProtoAdhanAssignments(masjidID, assignments),
+		},
+	}, nil
+}
+
 func StandardNikkahResponse(code codes.Code, statusMessage string, message string, entityData interface{}) (*pb.StandardNikkahResponse, error) {
 	resp := &pb.StandardNikkahResponse{
 		Code:    code.String(),
@@ -242,3 +387,161 @@ func StandardRevertResponse(code codes.Code, statusStr string, message string, d
 	}
 	return resp, nil
 }
+
+func StandardJumuahResponse(code codes.Code, status string, message string, data interface{}) (*pb.StandardJumuahResponse, error) {
+	resp := &pb.StandardJumuahResponse{
+		Code:    code.String(),
+		Status:  status,
+		Message: message,
+	}
+
+	if data != nil {
+		switch d := data.(type) {
+		case *entity.PrayerSlot:
+			resp.Data = &pb.StandardJumuahResponse_PrayerSlot{PrayerSlot: ToProtoPrayerSlot(d)}
+		case []entity.PrayerSlot:
+			list := &pb.ListPrayerSlotsResponse{}
+			for i := range d {
+				list.Slots = append(list.Slots, ToProtoPrayerSlot(&d[i]))
+			}
+			resp.Data = &pb.StandardJumuahResponse_ListPrayerSlotsResponse{ListPrayerSlotsResponse: list}
+		case *pb.DeletePrayerSlotResponse:
+			resp.Data = &pb.StandardJumuahResponse_DeletePrayerSlotResponse{DeletePrayerSlotResponse: d}
+		case *pb.NextJumuahResponse:
+			resp.Data = &pb.StandardJumuahResponse_NextJumuahResponse{NextJumuahResponse: d}
+		default:
+			return nil, fmt.Errorf("unsupported data type for StandardJumuahResponse: %T", d)
+		}
+	}
+	return resp, nil
+}
+
+func StandardVolunteerResponse(code codes.Code, status string, message string, data interface{}) (*pb.StandardVolunteerResponse, error) {
+	resp := &pb.StandardVolunteerResponse{
+		Code:    code.String(),
+		Status:  status,
+		Message: message,
+	}
+
+	if data != nil {
+		switch d := data.(type) {
+		case *entity.VolunteerShift:
+			resp.Data = &pb.StandardVolunteerResponse_Shift{Shift: ToProtoVolunteerShift(d)}
+		case []entity.VolunteerShift:
+			list := &pb.ListVolunteerShiftsResponse{}
+			for i := range d {
+				list.Shifts = append(list.Shifts, ToProtoVolunteerShift(&d[i]))
+			}
+			resp.Data = &pb.StandardVolunteerResponse_ListShiftsResponse{ListShiftsResponse: list}
+		case *pb.DeleteVolunteerShiftResponse:
+			resp.Data = &pb.StandardVolunteerResponse_DeleteShiftResponse{DeleteShiftResponse: d}
+		case *entity.ShiftSignup:
+			resp.Data = &pb.StandardVolunteerResponse_Signup{Signup: ToProtoShiftSignup(d)}
+		case []entity.ShiftSignup:
+			list := &pb.ListShiftSignupsResponse{}
+			for i := range d {
+				list.Signups = append(list.Signups, ToProtoShiftSignup(&d[i]))
+			}
+			resp.Data = &pb.StandardVolunteerResponse_ListSignupsResponse{ListSignupsResponse: list}
+		case *pb.VolunteerHoursResponse:
+			resp.Data = &pb.StandardVolunteerResponse_VolunteerHoursResponse{VolunteerHoursResponse: d}
+		default:
+			return nil, fmt.Errorf("unsupported data type for StandardVolunteerResponse: %T", d)
+		}
+	}
+	return resp, nil
+}
+
+func StandardRoomResponse(code codes.Code, status string, message string, data interface{}) (*pb.StandardRoomResponse, error) {
+	resp := &pb.StandardRoomResponse{
+		Code:    code.String(),
+		Status:  status,
+		Message: message,
+	}
+
+	if data != nil {
+		switch d := data.(type) {
+		case *entity.Room:
+			resp.Data = &pb.StandardRoomResponse_Room{Room: ToProtoRoom(d)}
+		case []entity.Room:
+			list := &pb.ListRoomsResponse{}
+			for i := range d {
+				list.Rooms = append(list.Rooms, ToProtoRoom(&d[i]))
+			}
+			resp.Data = &pb.StandardRoomResponse_ListRoomsResponse{ListRoomsResponse: list}
+		case *pb.DeleteRoomResponse:
+			resp.Data = &pb.StandardRoomResponse_DeleteRoomResponse{DeleteRoomResponse: d}
+		case *entity.RoomBooking:
+			resp.Data = &pb.StandardRoomResponse_Booking{Booking: ToProtoRoomBooking(d)}
+		case []entity.RoomBooking:
+			list := &pb.ListRoomBookingsResponse{}
+			for i := range d {
+				list.Bookings = append(list.Bookings, ToProtoRoomBooking(&d[i]))
+			}
+			resp.Data = &pb.StandardRoomResponse_ListBookingsResponse{ListBookingsResponse: list}
+		case *entity.RoomAvailability:
+			resp.Data = &pb.StandardRoomResponse_Availability{Availability: ToProtoRoomAvailability(d)}
+		case *pb.CancelRoomBookingResponse:
+			resp.Data = &pb.StandardRoomResponse_CancelBookingResponse{CancelBookingResponse: d}
+		default:
+			return nil, fmt.Errorf("unsupported data type for StandardRoomResponse: %T", d)
+		}
+	}
+	return resp, nil
+}
+
+func StandardNotificationResponse(code codes.Code, status string, message string, data interface{}) (*pb.StandardNotificationResponse, error) {
+	resp := &pb.StandardNotificationResponse{
+		Code:    code.String(),
+		Status:  status,
+		Message: message,
+	}
+
+	if data != nil {
+		switch d := data.(type) {
+		case *entity.NotificationPreference:
+			resp.Data = &pb.StandardNotificationResponse_Preferences{Preferences: ToProtoNotificationPreferences(d)}
+		case *entity.MasjidFollow:
+			resp.Data = &pb.StandardNotificationResponse_Follow{Follow: ToProtoMasjidFollow(d)}
+		case []entity.MasjidFollow:
+			list := &pb.ListFollowedMasjidsResponse{}
+			for i := range d {
+				list.Follows = append(list.Follows, ToProtoMasjidFollow(&d[i]))
+			}
+			resp.Data = &pb.StandardNotificationResponse_ListFollowsResponse{ListFollowsResponse: list}
+		case *pb.UnfollowMasjidResponse:
+			resp.Data = &pb.StandardNotificationResponse_UnfollowResponse{UnfollowResponse: d}
+		case *entity.PushDevice:
+			resp.Data = &pb.StandardNotificationResponse_Device{Device: ToProtoPushDevice(d)}
+		case *pb.UnregisterPushDeviceResponse:
+			resp.Data = &pb.StandardNotificationResponse_UnregisterDeviceResponse{UnregisterDeviceResponse: d}
+		default:
+			return nil, fmt.Errorf("unsupported data type for StandardNotificationResponse: %T", d)
+		}
+	}
+	return resp, nil
+}
+
+func StandardRamadanResponse(code codes.Code, status string, message string, data interface{}) (*pb.StandardRamadanResponse, error) {
+	resp := &pb.StandardRamadanResponse{
+		Code:    code.String(),
+		Status:  status,
+		Message: message,
+	}
+
+	if data != nil {
+		switch d := data.(type) {
+		case *pb.RamadanSchedule:
+			resp.Data = &pb.StandardRamadanResponse_RamadanSchedule{RamadanSchedule: d}
+		case []*entity.Event:
+			list := &pb.CreateIftarEventsResponse{}
+			for _, event := range d {
+				list.Events = append(list.Events, ToProtoEvent(event))
+			}
+			resp.Data = &pb.StandardRamadanResponse_CreateIftarEventsResponse{CreateIftarEventsResponse: list}
+		default:
+			return nil, fmt.Errorf("unsupported data type for StandardRamadanResponse: %T", d)
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,82 @@
+package helper
+
+import (
+	"github.com/google/uuid"
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToEntityVolunteerShift(eventID, id uuid.UUID, p *pb.VolunteerShift) *entity.VolunteerShift {
+	return &entity.VolunteerShift{
+		ID:          id,
+		EventId:     eventID,
+		Role:        p.GetRole(),
+		Description: p.GetDescription(),
+		StartTime:   p.GetStartTime().AsTime(),
+		EndTime:     p.GetEndTime().AsTime(),
+		Headcount:   p.GetHeadcount(),
+	}
+}
+
+func ToProtoVolunteerShift(s *entity.VolunteerShift) *pb.VolunteerShift {
+	if s == nil {
+		return nil
+	}
+
+	resp := &pb.VolunteerShift{
+		Id:             s.ID.String(),
+		EventId:        s.EventId.String(),
+		MasjidId:       s.MasjidId,
+		Role:           s.Role,
+		Description:    s.Description,
+		StartTime:      timestamppb.New(s.StartTime),
+		EndTime:        timestamppb.New(s.EndTime),
+		Headcount:      s.Headcount,
+		ClaimedCount:   int32(s.Claimed),
+		RemainingCount: int32(s.PlacesLeft()),
+		CreateTime:     timestamppb.New(s.CreatedAt),
+		UpdateTime:     timestamppb.New(s.UpdatedAt),
+	}
+	for i := range s.Signups {
+		resp.Signups = append(resp.Signups, ToProtoShiftSignup(&s.Signups[i]))
+	}
+	return resp
+}
+
+func ToProtoShiftSignup(s *entity.ShiftSignup) *pb.ShiftSignup {
+	resp := &pb.ShiftSignup{
+		Id:            s.ID.String(),
+		ShiftId:       s.ShiftId.String(),
+		UserId:        s.UserId.String(),
+		Status:        pb.ShiftSignup_Status(s.Status),
+		ClaimTime:     timestamppb.New(s.ClaimedAt),
+		MinutesWorked: s.MinutesWorked,
+		Shift:         ToProtoVolunteerShift(s.Shift),
+	}
+	if s.RecordedBy != nil {
+		resp.RecordedBy = s.RecordedBy.String()
+	}
+	if s.User != nil {
+		resp.FirstName = s.User.FirstName
+		resp.LastName = s.User.LastName
+	}
+	return resp
+}
+
+func ToProtoVolunteerHours(year int, hours []entity.VolunteerHours) *pb.VolunteerHoursResponse {
+	resp := &pb.VolunteerHoursResponse{Year: int32(year)}
+	for _, h := range hours {
+		volunteer := &pb.VolunteerHours{
+			UserId:        h.UserId.String(),
+			MinutesWorked: h.MinutesWorked,
+			ShiftsWorked:  int32(h.ShiftsWorked),
+		}
+		if h.User != nil {
+			volunteer.FirstName = h.User.FirstName
+			volunteer.LastName = h.User.LastName
+		}
+		resp.Volunteers = append(resp.Volunteers, volunteer)
+	}
+	return resp
+}
@@ -10,4 +10,27 @@ type AdhanRepository interface {
 	UpdateAdhan(ctx context.Context, adhan *entity.Adhan) (*entity.Adhan, error)
 	GetByIDAdhan(ctx context.Context, id string) (*entity.Adhan, error)
 	DeleteAdhan(ctx context.Context, id string) error
+	ListAdhans(ctx context.Context, masjidID string) ([]entity.Adhan, error)
+	ListAdhanAssignments(ctx context.Context, masjidID string) ([]entity.AdhanAssignment, error)
+	// SetAdhanAssignment replaces the assignment of the same masjid and
+	// prayer.
+	SetAdhanAssignment(ctx context.Context, assignment *entity.AdhanAssignment) error
+	DeleteAdhanAssignment(ctx context.Context, masjidID string, prayer entity.Prayer) error
+	ListAdhansByProcessingStatus(ctx context.Context, statuses ...entity.AdhanProcessingStatus) ([]entity.Adhan, error)
+	// UpdateAdhanProcessing records the processing status, error, loudness
+	// and renditions of an adhan, replacing its renditions, and returns the
+	// renditions replaced. It writes nothing and returns
+	// gorm.ErrRecordNotFound unless the adhan's audio is still stored under
+	// adhan.StorageKey.
+	UpdateAdhanProcessing(ctx context.Context, adhan *entity.Adhan) ([]entity.AdhanRendition, error)
+	// AcquireAdhanBlob adds a reference to the blob with blob.Sha256. If
+	// there is no such blob, store is called to write its content first,
+	// and blob is recorded with a single reference. Concurrent releases of
+	// the same blob wait for it.
+	AcquireAdhanBlob(ctx context.Context, blob *entity.AdhanBlob, store func() error) error
+	// ReleaseAdhanBlob drops a reference to the blob with the given hash.
+	// Once none remain, remove is called with its storage key and the blob
+	// is forgotten; if remove fails, the reference is kept and its error
+	// returned. Releasing an unknown blob does nothing.
+	ReleaseAdhanBlob(ctx context.Context, sha256 string, remove func(storageKey string) error) error
 }
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+)
+
+type CalendarFeedRepository interface {
+	// GetCalendarFeed returns the feed of a masjid, or of a user when
+	// masjidID is empty.
+	GetCalendarFeed(ctx context.Context, masjidID, userID string) (*entity.CalendarFeed, error)
+	GetCalendarFeedByToken(ctx context.Context, token string) (*entity.CalendarFeed, error)
+	// CreateCalendarFeed creates feed unless its masjid or user already has
+	// one, and returns the feed they have.
+	CreateCalendarFeed(ctx context.Context, feed *entity.CalendarFeed) (*entity.CalendarFeed, error)
+	// UpdateCalendarFeedToken stores the new token of a feed.
+	UpdateCalendarFeedToken(ctx context.Context, feed *entity.CalendarFeed) (*entity.CalendarFeed, error)
+}
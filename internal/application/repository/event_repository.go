@@ -2,13 +2,93 @@ package repository
 
 import (
 	"context"
+	"time"
+
 	"github.com/mnadev/limestone/internal/application/domain/entity"
 )
 
 type EventRepository interface {
+	// Create stores an event with its types and room bookings, returning
+	// helper.ErrRoomUnavailable if a room is booked at the time. Rooms are
+	// locked while their bookings are checked, as by
+	// RoomRepository.CreateBooking, and a recurring event is also checked
+	// by its rule beyond the occurrences it books. An exception frees the
+	// rooms its series booked for the occurrence it replaces.
 	Create(ctx context.Context, event *entity.Event) (*entity.Event, error)
+	// Update stores the event's fields that are set and replaces its types
+	// and room bookings when they are not nil, checking the rooms as Create
+	// does.
 	Update(ctx context.Context, event *entity.Event) (*entity.Event, error)
 	GetByID(ctx context.Context, id string) (*entity.Event, error)
 	Delete(ctx context.Context, id string) error
-	ListEvents(ctx context.Context, pageSize int32, pageToken string) ([]*entity.Event, error)
+	// ListEvents returns a page of the events matching params, without
+	// expanding recurring events. Pages start after the ID in
+	// params.PageToken, or at params.Offset when params.OrderBy is set.
+	ListEvents(ctx context.Context, params *entity.ListEventsQueryParams) ([]*entity.Event, error)
+	// CountEvents counts the events ListEvents would return on every page.
+	CountEvents(ctx context.Context, params *entity.ListEventsQueryParams) (int64, error)
+	// ListEventsBetween returns the events of params.MasjidId, or of every
+	// masjid, that may have an occurrence starting from params.StartFrom
+	// up to params.StartBefore: one-off events and exceptions starting
+	// then, recurring events starting before its end and not ending before
+	// its start, and exceptions replacing occurrences originally starting
+	// then, wherever they have been moved to. Filters other than the
+	// masjid are left to the caller, since an exception may no longer
+	// match them where its series does.
+	ListEventsBetween(ctx context.Context, params *entity.ListEventsQueryParams) ([]*entity.Event, error)
+	// ListByScheduleKeys returns the events created under any of the given
+	// schedule keys.
+	ListByScheduleKeys(ctx context.Context, keys []string) ([]*entity.Event, error)
+	// GetException returns the exception replacing the occurrence of a
+	// recurring event originally starting at originalStart.
+	GetException(ctx context.Context, seriesID string, originalStart time.Time) (*entity.Event, error)
+	// ListExceptions returns the exceptions of the given recurring events.
+	ListExceptions(ctx context.Context, seriesIDs []string) ([]*entity.Event, error)
+	// SaveRecurrence stores series.Recurrence and series.SeriesEnd.
+	SaveRecurrence(ctx context.Context, series *entity.Event) error
+	// ExcludeOccurrence stores the recurrence of series, which no longer
+	// includes the occurrence originally starting at originalStart, and
+	// deletes the room bookings of series for it and its exception if it
+	// has one.
+	ExcludeOccurrence(ctx context.Context, series *entity.Event, originalStart time.Time) error
+	// EndSeries stores the recurrence of series, which now ends before at,
+	// and deletes the room bookings and exceptions of occurrences from at
+	// on.
+	EndSeries(ctx context.Context, series *entity.Event, at time.Time) error
+	// SplitSeries stores the recurrence of head, which now ends before at,
+	// and creates tail, the series continuing it, whose room bookings are
+	// checked as by Create once those of head from at on are deleted. The
+	// exceptions of occurrences after at move to tail, shifted by as much
+	// as tail starts after at; the one at at is deleted.
+	SplitSeries(ctx context.Context, head, tail *entity.Event, at time.Time) error
+	// CreateRsvp records an RSVP for rsvp.EventId and rsvp.UserId,
+	// confirmed if the event has a place left and waitlisted otherwise. The
+	// event is locked while its places are counted, so concurrent RSVPs
+	// cannot overbook it. An RSVP the user already has is returned unchanged
+	// unless it was cancelled, in which case it is renewed.
+	CreateRsvp(ctx context.Context, rsvp *entity.EventRsvp) (*entity.EventRsvp, error)
+	// CancelRsvp cancels a user's RSVP for an event and confirms waitlisted
+	// RSVPs, oldest first, into any places that frees. It returns
+	// gorm.ErrRecordNotFound if the user has no RSVP for the event.
+	CancelRsvp(ctx context.Context, eventID, userID string) (*entity.EventRsvp, error)
+	// PromoteWaitlist confirms waitlisted RSVPs, oldest first, into the
+	// places left, as after the event's capacity is raised.
+	PromoteWaitlist(ctx context.Context, eventID string) error
+	// ListRsvps returns a page of the RSVPs for an event with the given
+	// status, or those confirmed and waitlisted when it is unspecified,
+	// with their users. Confirmed RSVPs come first, then the waitlist in
+	// order.
+	ListRsvps(ctx context.Context, eventID string, status entity.RsvpStatus, offset, limit int) ([]entity.EventRsvp, error)
+	// ListUserRsvps returns a user's RSVPs with their events, by the start
+	// time of the event.
+	ListUserRsvps(ctx context.Context, userID string, includeCancelled bool) ([]entity.EventRsvp, error)
+	// GetRsvp returns an RSVP with its user.
+	GetRsvp(ctx context.Context, id string) (*entity.EventRsvp, error)
+	// CreateCheckIn records a check-in unless its RSVP is already checked
+	// in to the occurrence, in which case it returns the earlier check-in
+	// and false.
+	CreateCheckIn(ctx context.Context, checkIn *entity.EventCheckIn) (*entity.EventCheckIn, bool, error)
+	// CountAttendance counts the confirmed RSVPs for an event and those
+	// checked in to the given occurrence of it.
+	CountAttendance(ctx context.Context, eventID, occurrence string) (*entity.Attendance, error)
 }
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+)
+
+type JumuahRepository interface {
+	Create(ctx context.Context, slot *entity.PrayerSlot) (*entity.PrayerSlot, error)
+	Update(ctx context.Context, slot *entity.PrayerSlot) (*entity.PrayerSlot, error)
+	GetByID(ctx context.Context, id string) (*entity.PrayerSlot, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, params *entity.ListPrayerSlotsQueryParams) ([]entity.PrayerSlot, error)
+}
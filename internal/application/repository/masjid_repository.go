@@ -12,5 +12,15 @@ type MasjidRepository interface {
 	GetByID(ctx context.Context, id string) (*entity.Masjid, error)
 	Delete(ctx context.Context, id string) error
 	ListMasjids(ctx context.Context, params *entity.ListMasjidsQueryParams) ([]entity.Masjid, int32, error)
+	SearchNearby(ctx context.Context, params *entity.SearchNearbyMasjidsQueryParams) ([]entity.NearbyMasjid, error)
+	CreateIqamahRule(ctx context.Context, rule *entity.IqamahRule) (*entity.IqamahRule, error)
+	UpdateIqamahRule(ctx context.Context, rule *entity.IqamahRule) (*entity.IqamahRule, error)
+	GetIqamahRuleByID(ctx context.Context, id string) (*entity.IqamahRule, error)
+	DeleteIqamahRule(ctx context.Context, id string) error
+	ListIqamahRules(ctx context.Context, masjidID string) ([]entity.IqamahRule, error)
+	ReplacePrayerTimeOverrides(ctx context.Context, masjidID string, overrides []entity.PrayerTimeOverride) error
+	ListPrayerTimeOverrides(ctx context.Context, masjidID string, from string, to string) ([]entity.PrayerTimeOverride, error)
+	ReplaceRamadanNights(ctx context.Context, masjidID string, hijriYear int32, nights []entity.RamadanNight) error
+	ListRamadanNights(ctx context.Context, masjidID string, hijriYear int32) ([]entity.RamadanNight, error)
 	GetDB() *gorm.DB
 }
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+)
+
+type NotificationRepository interface {
+	// GetPreference returns a member's notification preference, or
+	// gorm.ErrRecordNotFound if they have not set one.
+	GetPreference(ctx context.Context, userID string) (*entity.NotificationPreference, error)
+	// ListPreferences returns the preferences the given members have set.
+	ListPreferences(ctx context.Context, userIDs []uuid.UUID) ([]entity.NotificationPreference, error)
+	SavePreference(ctx context.Context, pref *entity.NotificationPreference) (*entity.NotificationPreference, error)
+	// CreateFollow records that a member follows a masjid. Following a
+	// masjid again keeps the earlier follow.
+	CreateFollow(ctx context.Context, follow *entity.MasjidFollow) (*entity.MasjidFollow, error)
+	// DeleteFollow returns gorm.ErrRecordNotFound if the member does not
+	// follow the masjid.
+	DeleteFollow(ctx context.Context, userID, masjidID string) error
+	// ListFollows returns the follows of a member, oldest first.
+	ListFollows(ctx context.Context, userID string) ([]entity.MasjidFollow, error)
+	// SavePushDevice stores a device for its member, taking it from any
+	// member who registered its token before.
+	SavePushDevice(ctx context.Context, device *entity.PushDevice) (*entity.PushDevice, error)
+	// DeletePushDevices deletes devices by token, only those of userID
+	// unless it is empty.
+	DeletePushDevices(ctx context.Context, userID string, tokens ...string) error
+	ListPushDevices(ctx context.Context, userID string) ([]entity.PushDevice, error)
+	// ListAudience returns the members to tell about an event: those with a
+	// confirmed or waitlisted RSVP for rsvpEventID and those following
+	// masjidID.
+	ListAudience(ctx context.Context, rsvpEventID uuid.UUID, masjidID string) ([]uuid.UUID, error)
+	// QueueNotifications stores notifications, skipping those whose member
+	// already has one with the same key.
+	QueueNotifications(ctx context.Context, notifications []entity.EventNotification) error
+	// ListPendingNotifications returns up to limit pending notifications,
+	// oldest first.
+	ListPendingNotifications(ctx context.Context, limit int) ([]entity.EventNotification, error)
+	// UpdateNotification stores a notification's status, attempts and
+	// sending time.
+	UpdateNotification(ctx context.Context, notification *entity.EventNotification) error
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+)
+
+type OrderRepository interface {
+	// ReplaceTicketTypes makes types the ticket types of an event, creating,
+	// updating and deleting them by ID. The event becomes paid if any type
+	// has a price, and requires RSVP if it has any.
+	ReplaceTicketTypes(ctx context.Context, eventID string, types []entity.TicketType) ([]entity.TicketType, error)
+	// CountTicketsSold counts the tickets of each of an event's ticket
+	// types held by orders at now.
+	CountTicketsSold(ctx context.Context, eventID string, now time.Time) (map[uuid.UUID]int64, error)
+	// CreateOrder stores a pending order if the ticket types ordered have
+	// enough tickets left at order.CreatedAt and the event has a place for
+	// the buyer, counting those held by other pending orders, and returns
+	// helper.ErrTicketsSoldOut otherwise. The event is locked while they
+	// are counted, so concurrent orders cannot oversell it.
+	CreateOrder(ctx context.Context, order *entity.Order) (*entity.Order, error)
+	// GetOrder returns an order with its items.
+	GetOrder(ctx context.Context, id string) (*entity.Order, error)
+	// GetOrderByCheckout and GetOrderByPayment find the order paid for
+	// through a provider's checkout or payment.
+	GetOrderByCheckout(ctx context.Context, provider, checkoutID string) (*entity.Order, error)
+	GetOrderByPayment(ctx context.Context, provider, paymentID string) (*entity.Order, error)
+	// ListOrders returns a page of the orders for an event or, when eventID
+	// is empty, of a user, newest first, with their items.
+	ListOrders(ctx context.Context, eventID, userID string, offset, limit int) ([]entity.Order, error)
+	// SaveCheckout stores the checkout of a pending order.
+	SaveCheckout(ctx context.Context, order *entity.Order) error
+	// PayOrder marks a pending or cancelled order paid by paymentID at
+	// paidAt and confirms the buyer's RSVP, creating or renewing it. The
+	// tickets and place are checked again as by CreateOrder: if they have
+	// gone, as they may for a cancelled order, the order is marked as being
+	// refunded instead, without an RSVP, and the payment must be refunded.
+	// Orders in other states are returned unchanged.
+	PayOrder(ctx context.Context, orderID, paymentID string, paidAt time.Time) (*entity.Order, error)
+	// CancelOrder marks a pending order cancelled. Orders in other states
+	// are returned unchanged.
+	CancelOrder(ctx context.Context, orderID string) (*entity.Order, error)
+	// RefundOrder marks a paid order as being refunded. The buyer's RSVP is
+	// cancelled unless they have another paid order for the event, and
+	// waitlisted RSVPs are confirmed into the place it frees. Orders in
+	// other states are returned unchanged.
+	RefundOrder(ctx context.Context, orderID string) (*entity.Order, error)
+	// CompleteRefund marks an order being refunded, or a paid order
+	// refunded with the provider directly, as refunded. The RSVP of a paid
+	// order is cancelled as by RefundOrder.
+	CompleteRefund(ctx context.Context, orderID string) (*entity.Order, error)
+}
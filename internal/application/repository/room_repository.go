@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+)
+
+type RoomRepository interface {
+	CreateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error)
+	// UpdateRoom stores a room's name, description, capacity and
+	// designation.
+	UpdateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error)
+	GetRoom(ctx context.Context, id string) (*entity.Room, error)
+	// DeleteRoom deletes a room unless it has bookings ending after now,
+	// returning helper.ErrRoomHasBookings then.
+	DeleteRoom(ctx context.Context, id string, now time.Time) error
+	// ListRooms returns a masjid's rooms by name.
+	ListRooms(ctx context.Context, masjidID string) ([]entity.Room, error)
+	// CreateBooking stores a member's booking unless the room is booked at
+	// the time, returning helper.ErrRoomUnavailable then. Recurring events
+	// hold their rooms by their rules beyond the occurrences booked for
+	// them. The room is locked while its bookings are checked, so
+	// concurrent bookings cannot overlap.
+	CreateBooking(ctx context.Context, booking *entity.RoomBooking) (*entity.RoomBooking, error)
+	GetBooking(ctx context.Context, id string) (*entity.RoomBooking, error)
+	DeleteBooking(ctx context.Context, id string) error
+	// ListBookings returns the bookings of a room overlapping from up to
+	// to, by start time.
+	ListBookings(ctx context.Context, roomID string, from, to time.Time) ([]entity.RoomBooking, error)
+	// ListUserBookings returns a member's bookings ending after from, by
+	// start time, with their rooms.
+	ListUserBookings(ctx context.Context, userID string, from time.Time) ([]entity.RoomBooking, error)
+}
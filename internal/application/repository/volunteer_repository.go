@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+)
+
+type VolunteerRepository interface {
+	CreateShift(ctx context.Context, shift *entity.VolunteerShift) (*entity.VolunteerShift, error)
+	// UpdateShift stores a shift's role, description, times and headcount
+	// unless the headcount is below the volunteers already holding places,
+	// returning helper.ErrShiftHeadcountTooLow then.
+	UpdateShift(ctx context.Context, shift *entity.VolunteerShift) (*entity.VolunteerShift, error)
+	// GetShift returns a shift with its claimed count.
+	GetShift(ctx context.Context, id string) (*entity.VolunteerShift, error)
+	// DeleteShift deletes a shift and its signups unless hours have been
+	// recorded on it, returning helper.ErrShiftHasHours then.
+	DeleteShift(ctx context.Context, id string) error
+	// ListShifts returns an event's shifts by start time with their claimed
+	// counts and, for a roster, the signups holding places with their
+	// users.
+	ListShifts(ctx context.Context, eventID string, roster bool) ([]entity.VolunteerShift, error)
+	// ClaimShift gives a user a place on a shift, returning the signup they
+	// already hold if any, helper.ErrShiftFull if no place is left and
+	// helper.ErrShiftOverlap if they hold a place on another shift at the
+	// same time. The shift and the user are locked while places are
+	// counted, so concurrent claims cannot overfill the shift or give the
+	// user overlapping places.
+	ClaimShift(ctx context.Context, shiftID, userID string, now time.Time) (*entity.ShiftSignup, error)
+	// ReleaseShift gives up a user's claimed place on a shift. Signups
+	// already released are returned unchanged.
+	ReleaseShift(ctx context.Context, shiftID, userID string) (*entity.ShiftSignup, error)
+	// RecordHours records the minutes a volunteer holding a place on a
+	// shift worked, as a no-show when 0.
+	RecordHours(ctx context.Context, shiftID, userID string, minutes int32, recordedBy string) (*entity.ShiftSignup, error)
+	// ListUserSignups returns the signups holding places of a user on
+	// shifts ending after from and starting before to, by start time, with
+	// their shifts.
+	ListUserSignups(ctx context.Context, userID string, from, to time.Time) ([]entity.ShiftSignup, error)
+	// SumHours totals the hours worked on shifts starting in [from, to) by
+	// user, most first, at one masjid or, when masjidID is empty, by one
+	// user.
+	SumHours(ctx context.Context, masjidID, userID string, from, to time.Time) ([]entity.VolunteerHours, error)
+}
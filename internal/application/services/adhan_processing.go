@@ -0,0 +1,425 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/mnadev/limestone/internal/application/domain/audio"
+	"github.com/mnadev/limestone/internal/application/domain/audio/pcm"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"gorm.io/gorm"
+)
+
+// AdhanTargetLoudness is the integrated loudness adhans are normalised to,
+// in LUFS. It is the level commonly used for speech on mobile devices.
+const AdhanTargetLoudness = -16.0
+
+// AdhanPeakCeiling bounds the sample peak of normalised audio, in dBFS.
+// Quiet recordings with loud peaks are raised only as far as it allows.
+const AdhanPeakCeiling = -1.0
+
+// AdhanRenditionSpec describes a rendition made of every processed adhan.
+type AdhanRenditionSpec struct {
+	Name string
+	// SampleRate and Channels are those of the upload when 0.
+	SampleRate int
+	Channels   int
+	// Format is audio.FormatWAV, written with Encoding, or audio.FormatMP3,
+	// written at Bitrate bits per second.
+	Format   string
+	Encoding pcm.Encoding
+	Bitrate  int
+}
+
+// AdhanRenditions are made of every adhan whose audio can be decoded.
+var AdhanRenditions = []AdhanRenditionSpec{
+	{Name: "normalized", Format: audio.FormatWAV, Encoding: pcm.PCM16},
+	// For public address and other low-bandwidth speakers. 16 kHz keeps the
+	// whole range of the voice.
+	{Name: "speaker", SampleRate: 16000, Channels: 1, Format: audio.FormatMP3, Bitrate: 32000},
+}
+
+// renditionWriter writes the audio of a rendition.
+type renditionWriter interface {
+	pcm.Writer
+	Close() error
+	Size() int64
+}
+
+func (spec AdhanRenditionSpec) newWriter(out io.WriteSeeker, f pcm.Format) (renditionWriter, error) {
+	if spec.Format == audio.FormatMP3 {
+		return pcm.NewMP3Writer(out, f, spec.Bitrate)
+	}
+	return pcm.NewWAVWriter(out, f, spec.Encoding)
+}
+
+func (spec AdhanRenditionSpec) codec() string {
+	if spec.Format == audio.FormatMP3 {
+		return "mp3"
+	}
+	return spec.Encoding.Codec()
+}
+
+func (spec AdhanRenditionSpec) bitrate(f pcm.Format) int {
+	if spec.Format == audio.FormatMP3 {
+		return spec.Bitrate
+	}
+	return spec.Encoding.Bitrate(f)
+}
+
+// AdhanProcessingQueue processes adhans one at a time in the background, in
+// the order they were queued. An adhan queued again while waiting is
+// processed once.
+type AdhanProcessingQueue struct {
+	process func(ctx context.Context, id string) error
+
+	mu      sync.Mutex
+	pending []string
+	queued  map[string]bool
+	wake    chan struct{}
+}
+
+func NewAdhanProcessingQueue(process func(ctx context.Context, id string) error) *AdhanProcessingQueue {
+	return &AdhanProcessingQueue{
+		process: process,
+		queued:  map[string]bool{},
+		wake:    make(chan struct{}, 1),
+	}
+}
+
+// Enqueue adds the adhan with the given ID to the queue.
+func (q *AdhanProcessingQueue) Enqueue(id string) {
+	q.mu.Lock()
+	if !q.queued[id] {
+		q.queued[id] = true
+		q.pending = append(q.pending, id)
+	}
+	q.mu.Unlock()
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run processes queued adhans until ctx is done.
+func (q *AdhanProcessingQueue) Run(ctx context.Context) {
+	for {
+		q.mu.Lock()
+		var id string
+		if len(q.pending) > 0 {
+			id = q.pending[0]
+			q.pending = q.pending[1:]
+			delete(q.queued, id)
+		}
+		q.mu.Unlock()
+
+		if id == "" {
+			select {
+			case <-ctx.Done():
+				return
+			case <-q.wake:
+			}
+			continue
+		}
+		if err := q.process(ctx, id); err != nil {
+			log.Printf("failed to process adhan %s: %v", id, err)
+		}
+	}
+}
+
+// AdhanProcessingSweepInterval is how often RunProcessing queues the adhans
+// uploaded through AdhanServices without a queue of their own.
+const AdhanProcessingSweepInterval = time.Minute
+
+// RunProcessing processes the adhans queued on r until ctx is done. It
+// first resumes the processing left over by an earlier run of the server,
+// then every AdhanProcessingSweepInterval queues the adhans other services
+// left pending.
+func (r *AdhanService) RunProcessing(ctx context.Context) {
+	if r.Processing == nil {
+		return
+	}
+	if err := r.ResumeProcessing(ctx); err != nil {
+		log.Printf("failed to resume adhan processing: %v", err)
+	}
+	done := make(chan struct{})
+	go func() {
+		r.Processing.Run(ctx)
+		close(done)
+	}()
+	defer func() { <-done }()
+
+	ticker := time.NewTicker(AdhanProcessingSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		// Adhans being processed are RUNNING, so none is queued twice.
+		if err := r.queueAdhans(ctx, entity.AdhanProcessingPending); err != nil {
+			log.Printf("failed to queue pending adhans: %v", err)
+		}
+	}
+}
+
+// ResumeProcessing queues the adhans left unprocessed by an earlier run of
+// the server, including those uploaded before processing was introduced.
+func (r *AdhanService) ResumeProcessing(ctx context.Context) error {
+	return r.queueAdhans(ctx,
+		entity.AdhanProcessingUnspecified, entity.AdhanProcessingPending, entity.AdhanProcessingRunning)
+}
+
+func (r *AdhanService) queueAdhans(ctx context.Context, statuses ...entity.AdhanProcessingStatus) error {
+	if r.Processing == nil {
+		return nil
+	}
+	adhans, err := r.Repo.ListAdhansByProcessingStatus(ctx, statuses...)
+	if err != nil {
+		return err
+	}
+	for _, adhan := range adhans {
+		if adhan.StorageKey != "" {
+			r.Processing.Enqueue(adhan.ID.String())
+		}
+	}
+	return nil
+}
+
+// ProcessAdhan normalises the loudness of an adhan's audio to
+// AdhanTargetLoudness and stores the AdhanRenditions made from it,
+// replacing any made before. Audio that cannot be decoded is marked
+// skipped, and audio that fails to process is marked failed; neither
+// returns an error. If the audio is replaced or the adhan deleted while it
+// is processed, the result is discarded.
+func (r *AdhanService) ProcessAdhan(ctx context.Context, id string) error {
+	adhan, err := r.Repo.GetByIDAdhan(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if adhan.StorageKey == "" {
+		// The audio is still in the database; see cmd/migrate_adhan_blobs.
+		return nil
+	}
+
+	adhan.ProcessingStatus = entity.AdhanProcessingRunning
+	adhan.ProcessingError = ""
+	adhan.LoudnessLufs = nil
+	adhan.Renditions = nil
+	if err := r.saveAdhanProcessing(ctx, adhan); err != nil {
+		return ignoreStale(err)
+	}
+
+	renditions, loudness, err := r.renderAdhan(ctx, adhan)
+	switch {
+	case errors.Is(err, pcm.ErrNoDecoder):
+		adhan.ProcessingStatus = entity.AdhanProcessingSkipped
+		adhan.ProcessingError = err.Error()
+	case errors.Is(err, context.Canceled):
+		// Left running, to be resumed on restart.
+		return err
+	case err != nil:
+		adhan.ProcessingStatus = entity.AdhanProcessingFailed
+		adhan.ProcessingError = err.Error()
+	default:
+		adhan.ProcessingStatus = entity.AdhanProcessingReady
+		adhan.LoudnessLufs = loudness
+		adhan.Renditions = renditions
+	}
+	if err := r.saveAdhanProcessing(ctx, adhan); err != nil {
+		r.releaseRenditions(context.WithoutCancel(ctx), renditions)
+		return ignoreStale(err)
+	}
+	return nil
+}
+
+// saveAdhanProcessing records the processing state of an adhan and releases
+// the audio of the renditions it replaces.
+func (r *AdhanService) saveAdhanProcessing(ctx context.Context, adhan *entity.Adhan) error {
+	replaced, err := r.Repo.UpdateAdhanProcessing(ctx, adhan)
+	if err != nil {
+		return err
+	}
+	r.releaseRenditions(ctx, replaced)
+	return nil
+}
+
+// ignoreStale drops the error reported when an adhan was deleted or its
+// audio replaced during processing; a replacement is processed in turn.
+func ignoreStale(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	return err
+}
+
+// renderAdhan stores the renditions of an adhan's audio and returns them
+// with the loudness of the original.
+func (r *AdhanService) renderAdhan(ctx context.Context, adhan *entity.Adhan) ([]entity.AdhanRendition, *float64, error) {
+	src, err := r.spoolBlob(ctx, adhan.StorageKey, adhan.Sha256)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer os.Remove(src.Name())
+	defer src.Close()
+
+	header := make([]byte, audio.HeaderSize)
+	n, err := src.ReadAt(header, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, nil, err
+	}
+	format := audio.Detect(header[:n])
+	if !pcm.CanDecode(format) {
+		return nil, nil, pcm.ErrNoDecoder
+	}
+
+	decoded, err := decodeFrom(src, format)
+	if err != nil {
+		return nil, nil, err
+	}
+	meter := pcm.NewMeter(decoded.Format())
+	if _, err := pcm.Copy(meter, decoded); err != nil {
+		return nil, nil, err
+	}
+	gain := pcm.NormalizationGain(meter.Loudness(), meter.Peak(), AdhanTargetLoudness, AdhanPeakCeiling)
+
+	var renditions []entity.AdhanRendition
+	for _, spec := range AdhanRenditions {
+		rendition, err := r.renderRendition(ctx, adhan, src, format, gain, spec)
+		if err != nil {
+			r.releaseRenditions(context.WithoutCancel(ctx), renditions)
+			return nil, nil, err
+		}
+		renditions = append(renditions, *rendition)
+	}
+	return renditions, finite(meter.Loudness()), nil
+}
+
+func (r *AdhanService) renderRendition(ctx context.Context, adhan *entity.Adhan, src *os.File, format string, gain float64, spec AdhanRenditionSpec) (*entity.AdhanRendition, error) {
+	decoded, err := decodeFrom(src, format)
+	if err != nil {
+		return nil, err
+	}
+	reader := pcm.Gain(decoded, gain)
+	if spec.Channels > 0 {
+		reader = pcm.Mix(reader, spec.Channels)
+	}
+	if spec.SampleRate > 0 {
+		reader = pcm.Resample(reader, spec.SampleRate)
+	}
+	f := reader.Format()
+
+	out, err := os.CreateTemp("", "adhan-rendition-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(out.Name())
+	defer out.Close()
+
+	w, err := spec.newWriter(out, f)
+	if err != nil {
+		return nil, err
+	}
+	meter := pcm.NewMeter(f)
+	frames, err := pcm.Copy(pcm.MultiWriter(w, meter), reader)
+	if err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	if _, err := out.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(hash, out); err != nil {
+		return nil, err
+	}
+	if _, err := out.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	rendition := &entity.AdhanRendition{
+		AdhanId:      adhan.ID,
+		Name:         spec.Name,
+		Sha256:       hex.EncodeToString(hash.Sum(nil)),
+		Size:         w.Size(),
+		ContentType:  audio.ContentType(spec.Format),
+		Codec:        spec.codec(),
+		DurationMs:   frames * 1000 / int64(f.SampleRate),
+		SampleRate:   int32(f.SampleRate),
+		Channels:     int32(f.Channels),
+		Bitrate:      int32(spec.bitrate(f)),
+		LoudnessLufs: finite(meter.Loudness()),
+		CreatedAt:    time.Now(),
+	}
+	rendition.StorageKey, err = r.acquireBlob(ctx, rendition.Sha256, out, rendition.Size, rendition.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	return rendition, nil
+}
+
+func (r *AdhanService) releaseRenditions(ctx context.Context, renditions []entity.AdhanRendition) {
+	for _, rendition := range renditions {
+		r.releaseBlob(ctx, rendition.Sha256, rendition.StorageKey)
+	}
+}
+
+// spoolBlob copies a blob to a temporary file, which the caller removes.
+// Unless sha256 is empty, the copy must have that hash, so that audio
+// damaged in the blob store fails processing with
+// helper.ErrAdhanChecksumMismatch rather than being processed.
+func (r *AdhanService) spoolBlob(ctx context.Context, key, sha256Hex string) (*os.File, error) {
+	body, err := r.Blobs.Get(ctx, key, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	spool, err := os.CreateTemp("", "adhan-processing-*")
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(spool, hash), body)
+	if err == nil && sha256Hex != "" && hex.EncodeToString(hash.Sum(nil)) != sha256Hex {
+		err = helper.ErrAdhanChecksumMismatch
+	}
+	if err != nil {
+		spool.Close()
+		os.Remove(spool.Name())
+		return nil, err
+	}
+	return spool, nil
+}
+
+// decodeFrom decodes src from its start.
+func decodeFrom(src *os.File, format string) (pcm.Reader, error) {
+	if _, err := src.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return pcm.Decode(format, src)
+}
+
+// finite returns v, or nil if it is infinite.
+func finite(v float64) *float64 {
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return nil
+	}
+	return &v
+}
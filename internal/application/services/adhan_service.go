@@ -2,30 +2,488 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mnadev/limestone/internal/application/domain/audio"
 	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
 	"github.com/mnadev/limestone/internal/application/repository"
+	"github.com/mnadev/limestone/internal/infrastructure/blobstore"
+	"io"
+	"log"
+	"os"
+	"time"
 )
 
+// MaxStreamedAdhanSize bounds the audio accepted by UploadAdhan. Unlike
+// CreateAdhan, the upload is never held in memory whole, so the limit is
+// well above the 5 MB of a single request.
+const MaxStreamedAdhanSize = 50 << 20
+
+// AdhanChunkSize is the number of bytes sent at a time when streaming audio
+// back out.
+const AdhanChunkSize = 64 << 10
+
+// AdhanBlobKind prefixes the blob keys of adhan audio.
+const AdhanBlobKind = "adhans"
+
 type AdhanService struct {
-	Repo repository.AdhanRepository
+	Repo  repository.AdhanRepository
+	Blobs blobstore.Store
+	// Processing is given each adhan whose audio is uploaded. Audio is
+	// left unprocessed when it is nil.
+	Processing *AdhanProcessingQueue
 }
 
-func NewAdhanService(repo repository.AdhanRepository) *AdhanService {
-	return &AdhanService{Repo: repo}
+// NewAdhanService returns an AdhanService that queues uploaded audio for
+// processing by RunProcessing.
+func NewAdhanService(repo repository.AdhanRepository, blobs blobstore.Store) *AdhanService {
+	svc := &AdhanService{Repo: repo, Blobs: blobs}
+	svc.Processing = NewAdhanProcessingQueue(svc.ProcessAdhan)
+	return svc
 }
 
-func (r *AdhanService) CreateAdhan(ctx context.Context, adhan *entity.Adhan) (*entity.Adhan, error) {
-	return r.Repo.CreateAdhan(ctx, adhan)
+// CreateAdhan stores audio as the adhan's file and records the adhan.
+func (r *AdhanService) CreateAdhan(ctx context.Context, adhan *entity.Adhan, audio []byte) (*entity.Adhan, error) {
+	return r.UploadAdhan(ctx, adhan, singleChunk(audio))
 }
 
-func (r *AdhanService) UpdateAdhan(ctx context.Context, adhan *entity.Adhan) (*entity.Adhan, error) {
-	return r.Repo.UpdateAdhan(ctx, adhan)
+// UpdateAdhan replaces the audio of an existing adhan, along with its title
+// and muezzin when they are set. Without audio only those are updated.
+func (r *AdhanService) UpdateAdhan(ctx context.Context, adhan *entity.Adhan, audio []byte) (*entity.Adhan, error) {
+	if len(audio) > 0 {
+		return r.UploadAdhan(ctx, adhan, singleChunk(audio))
+	}
+	if _, err := r.Repo.GetByIDAdhan(ctx, adhan.ID.String()); err != nil {
+		return nil, err
+	}
+	// Only the fields set are written, leaving those that processing may
+	// be changing meanwhile.
+	changes := &entity.Adhan{
+		ID:        adhan.ID,
+		Title:     adhan.Title,
+		Muezzin:   adhan.Muezzin,
+		UpdatedAt: time.Now(),
+	}
+	if _, err := r.Repo.UpdateAdhan(ctx, changes); err != nil {
+		return nil, err
+	}
+	return r.Repo.GetByIDAdhan(ctx, adhan.ID.String())
+}
+
+// ListAdhans returns the adhan library of a masjid.
+func (r *AdhanService) ListAdhans(ctx context.Context, masjidID string) ([]entity.Adhan, error) {
+	return r.Repo.ListAdhans(ctx, masjidID)
+}
+
+// AssignAdhan plays the adhan with the given ID for prayer at the masjid,
+// or for Dhuhr through Isha by default when prayer is PRAYER_UNSPECIFIED.
+// An empty adhanID clears the assignment. The adhan must be in the masjid's
+// own library.
+func (r *AdhanService) AssignAdhan(ctx context.Context, masjidID string, prayer entity.Prayer, adhanID string) ([]entity.ResolvedAdhanAssignment, error) {
+	if prayer < entity.PRAYER_UNSPECIFIED || prayer > entity.ISHA {
+		return nil, helper.ErrInvalidPrayer
+	}
+	if adhanID == "" {
+		if err := r.Repo.DeleteAdhanAssignment(ctx, masjidID, prayer); err != nil {
+			return nil, err
+		}
+		return r.GetAdhanAssignments(ctx, masjidID)
+	}
+
+	adhan, err := r.Repo.GetByIDAdhan(ctx, adhanID)
+	if err != nil {
+		return nil, err
+	}
+	if adhan.MasjidId != masjidID {
+		return nil, helper.ErrAdhanNotInMasjid
+	}
+	now := time.Now()
+	err = r.Repo.SetAdhanAssignment(ctx, &entity.AdhanAssignment{
+		MasjidId:  masjidID,
+		Prayer:    prayer,
+		AdhanId:   adhan.ID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetAdhanAssignments(ctx, masjidID)
+}
+
+// GetAdhanAssignments returns the adhan played for each prayer from Fajr to
+// Isha. Prayers other than Fajr without an adhan of their own inherit the
+// masjid's default.
+func (r *AdhanService) GetAdhanAssignments(ctx context.Context, masjidID string) ([]entity.ResolvedAdhanAssignment, error) {
+	assignments, err := r.Repo.ListAdhanAssignments(ctx, masjidID)
+	if err != nil {
+		return nil, err
+	}
+	adhans, err := r.Repo.ListAdhans(ctx, masjidID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*entity.Adhan, len(adhans))
+	for i := range adhans {
+		byID[adhans[i].ID] = &adhans[i]
+	}
+	assigned := make(map[entity.Prayer]*entity.Adhan, len(assignments))
+	for _, a := range assignments {
+		if adhan, ok := byID[a.AdhanId]; ok {
+			assigned[a.Prayer] = adhan
+		}
+	}
+
+	resolved := make([]entity.ResolvedAdhanAssignment, 0, entity.ISHA)
+	for prayer := entity.FAJR; prayer <= entity.ISHA; prayer++ {
+		entry := entity.ResolvedAdhanAssignment{Prayer: prayer, Adhan: assigned[prayer]}
+		if entry.Adhan == nil && prayer != entity.FAJR {
+			entry.Adhan = assigned[entity.PRAYER_UNSPECIFIED]
+			entry.Inherited = entry.Adhan != nil
+		}
+		resolved = append(resolved, entry)
+	}
+	return resolved, nil
 }
 
 func (r *AdhanService) GetAdhanByID(ctx context.Context, id string) (*entity.Adhan, error) {
 	return r.Repo.GetByIDAdhan(ctx, id)
 }
 
+// DeleteAdhan removes the adhan and then releases its audio and
+// renditions, which are deleted unless other adhans share them. A failure
+// to remove audio only leaves an unreferenced blob behind, so it is logged
+// rather than returned.
 func (r *AdhanService) DeleteAdhan(ctx context.Context, id string) error {
-	return r.Repo.DeleteAdhan(ctx, id)
+	adhan, err := r.Repo.GetByIDAdhan(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := r.Repo.DeleteAdhan(ctx, id); err != nil {
+		return err
+	}
+	r.releaseBlob(ctx, adhan.Sha256, adhan.StorageKey)
+	r.releaseRenditions(ctx, adhan.Renditions)
+	return nil
+}
+
+// UploadAdhan stores the audio returned chunk by chunk by next, which
+// signals the end of the upload with io.EOF. An adhan without an ID is
+// created; otherwise the audio of the existing adhan is replaced. The upload
+// is rejected as a whole if it is empty or exceeds MaxStreamedAdhanSize,
+// and with one of the audio package's errors if it is not a supported,
+// complete, audible recording of at most audio.MaxDuration.
+//
+// The audio is spooled to a temporary file while it is validated and
+// hashed, then written to the blob store under a key derived from its
+// SHA-256, so a failed upload never disturbs the audio already stored.
+// Audio already stored for another adhan is shared rather than written
+// again. Once stored, it is queued for processing, and the audio and
+// renditions it replaces are released. Uploading the audio an adhan already
+// has only updates its title and muezzin.
+func (r *AdhanService) UploadAdhan(ctx context.Context, adhan *entity.Adhan, next func() ([]byte, error)) (*entity.Adhan, error) {
+	var existing *entity.Adhan
+	if adhan.ID != uuid.Nil {
+		var err error
+		existing, err = r.Repo.GetByIDAdhan(ctx, adhan.ID.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	spool, err := os.CreateTemp("", "adhan-upload-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(spool.Name())
+	defer spool.Close()
+
+	hash := sha256.New()
+	check := &adhanUploadCheck{next: next}
+	for {
+		chunk, err := check.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, err := spool.Write(chunk); err != nil {
+			return nil, err
+		}
+		hash.Write(chunk)
+	}
+	info, err := audio.Probe(spool, check.size)
+	if err != nil {
+		return nil, err
+	}
+	if err := info.Check(); err != nil {
+		return nil, err
+	}
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+	if existing != nil && existing.Sha256 == sum {
+		return r.UpdateAdhan(ctx, adhan, nil)
+	}
+
+	now := time.Now()
+	if existing == nil {
+		adhan.ID = uuid.New()
+		adhan.CreatedAt = now
+	} else {
+		if adhan.MasjidId == "" {
+			adhan.MasjidId = existing.MasjidId
+		}
+		if adhan.Title == "" {
+			adhan.Title = existing.Title
+		}
+		if adhan.Muezzin == "" {
+			adhan.Muezzin = existing.Muezzin
+		}
+		adhan.CreatedAt = existing.CreatedAt
+	}
+	adhan.UpdatedAt = now
+	adhan.ProcessingStatus = entity.AdhanProcessingPending
+	adhan.ProcessingError = ""
+	adhan.LoudnessLufs = nil
+	adhan.Renditions = nil
+	adhan.Size = check.size
+	setAdhanAudioInfo(adhan, info)
+	adhan.Sha256 = sum
+	adhan.StorageKey, err = r.acquireBlob(ctx, sum, spool, adhan.Size, adhan.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	var saved *entity.Adhan
+	if existing == nil {
+		saved, err = r.Repo.CreateAdhan(ctx, adhan)
+	} else {
+		saved, err = r.Repo.UpdateAdhan(ctx, adhan)
+	}
+	if err != nil {
+		r.releaseBlob(ctx, adhan.Sha256, adhan.StorageKey)
+		return nil, err
+	}
+	if existing != nil {
+		r.releaseBlob(ctx, existing.Sha256, existing.StorageKey)
+		// Clears the processing state of the replaced audio, which the
+		// update leaves in place.
+		if err := r.saveAdhanProcessing(ctx, adhan); err != nil {
+			return nil, err
+		}
+	}
+	if r.Processing != nil {
+		r.Processing.Enqueue(saved.ID.String())
+	}
+	return saved, nil
+}
+
+// setAdhanAudioInfo records the metadata of an adhan's audio.
+func setAdhanAudioInfo(adhan *entity.Adhan, info *audio.Info) {
+	adhan.ContentType = info.ContentType()
+	adhan.Codec = info.Codec
+	adhan.DurationMs = info.Duration.Milliseconds()
+	adhan.SampleRate = int32(info.SampleRate)
+	adhan.Channels = int32(info.Channels)
+	adhan.Bitrate = int32(info.Bitrate)
+}
+
+// acquireBlob adds a reference to the audio with the given hash, writing
+// content to the blob store unless it is there already, and returns its
+// key.
+func (r *AdhanService) acquireBlob(ctx context.Context, sha256 string, content io.Reader, size int64, contentType string) (string, error) {
+	key := blobstore.ContentKey(AdhanBlobKind, sha256)
+	blob := &entity.AdhanBlob{Sha256: sha256, StorageKey: key, Size: size}
+	err := r.Repo.AcquireAdhanBlob(ctx, blob, func() error {
+		return r.Blobs.Put(ctx, key, content, size, contentType)
+	})
+	if err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// releaseBlob drops a reference to audio, deleting it once nothing else
+// refers to it. Audio stored before content was hashed belongs to a single
+// adhan and is deleted outright.
+func (r *AdhanService) releaseBlob(ctx context.Context, sha256, key string) {
+	if sha256 == "" {
+		r.deleteBlob(ctx, key)
+		return
+	}
+	err := r.Repo.ReleaseAdhanBlob(ctx, sha256, func(key string) error {
+		return r.Blobs.Delete(ctx, key)
+	})
+	if err != nil {
+		log.Printf("failed to release adhan blob %s: %v", key, err)
+	}
+}
+
+func (r *AdhanService) deleteBlob(ctx context.Context, key string) {
+	if key == "" {
+		return
+	}
+	if err := r.Blobs.Delete(ctx, key); err != nil {
+		log.Printf("failed to delete adhan blob %s: %v", key, err)
+	}
+}
+
+func singleChunk(data []byte) func() ([]byte, error) {
+	sent := false
+	return func() ([]byte, error) {
+		if sent {
+			return nil, io.EOF
+		}
+		sent = true
+		return data, nil
+	}
+}
+
+// adhanUploadCheck enforces the size limit and rejects unknown formats as
+// an upload passes through, before the whole file has been received.
+type adhanUploadCheck struct {
+	next   func() ([]byte, error)
+	header []byte
+	size   int64
+}
+
+func (c *adhanUploadCheck) Next() ([]byte, error) {
+	chunk, err := c.next()
+	if errors.Is(err, io.EOF) {
+		if c.size == 0 {
+			return nil, helper.ErrEmptyAdhanAudio
+		}
+		if len(c.header) < audio.HeaderSize && !helper.IsAudioFile(c.header) {
+			return nil, audio.ErrUnsupportedFormat
+		}
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c.size += int64(len(chunk))
+	if c.size > MaxStreamedAdhanSize {
+		return nil, helper.ErrAdhanTooLarge
+	}
+	if len(c.header) < audio.HeaderSize {
+		missing := audio.HeaderSize - len(c.header)
+		if missing > len(chunk) {
+			missing = len(chunk)
+		}
+		c.header = append(c.header, chunk[:missing]...)
+		if len(c.header) == audio.HeaderSize && !helper.IsAudioFile(c.header) {
+			return nil, audio.ErrUnsupportedFormat
+		}
+	}
+	return chunk, nil
+}
+
+// AdhanAudio reads an adhan's audio from the blob store. It implements
+// io.ReadSeeker so that it can back ranged HTTP responses as well as gRPC
+// streams; each seek starts a new ranged read. Close releases the current
+// read.
+type AdhanAudio struct {
+	ctx   context.Context
+	blobs blobstore.Store
+	key   string
+
+	Size        int64
+	ContentType string
+	ModTime     time.Time
+	// ETag is the entity tag of the audio, or "" if it was stored before
+	// content was hashed.
+	ETag string
+
+	offset int64
+	body   io.ReadCloser
+}
+
+// OpenAdhanAudio returns a reader over the audio of the adhan with the given
+// ID, or over one of its renditions when rendition is set. Reads use ctx.
+func (r *AdhanService) OpenAdhanAudio(ctx context.Context, id string, rendition string) (*AdhanAudio, error) {
+	adhan, err := r.Repo.GetByIDAdhan(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rendition != "" {
+		found := adhan.Rendition(rendition)
+		if found == nil {
+			return nil, helper.ErrAdhanRenditionNotFound
+		}
+		return &AdhanAudio{
+			ctx:         ctx,
+			blobs:       r.Blobs,
+			key:         found.StorageKey,
+			Size:        found.Size,
+			ContentType: found.ContentType,
+			ModTime:     found.CreatedAt,
+			ETag:        helper.ContentETag(found.Sha256),
+		}, nil
+	}
+	return &AdhanAudio{
+		ctx:         ctx,
+		blobs:       r.Blobs,
+		key:         adhan.StorageKey,
+		Size:        adhan.Size,
+		ContentType: adhan.ContentType,
+		ModTime:     adhan.UpdatedAt,
+		ETag:        helper.ContentETag(adhan.Sha256),
+	}, nil
+}
+
+func (a *AdhanAudio) Read(p []byte) (int, error) {
+	if a.offset >= a.Size {
+		return 0, io.EOF
+	}
+	if a.body == nil {
+		body, err := a.blobs.Get(a.ctx, a.key, a.offset, 0)
+		if err != nil {
+			return 0, err
+		}
+		a.body = body
+	}
+	if int64(len(p)) > a.Size-a.offset {
+		p = p[:a.Size-a.offset]
+	}
+	n, err := a.body.Read(p)
+	a.offset += int64(n)
+	if errors.Is(err, io.EOF) && a.offset < a.Size {
+		return n, io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (a *AdhanAudio) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += a.offset
+	case io.SeekEnd:
+		offset += a.Size
+	default:
+		return 0, errors.New("adhan audio: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("adhan audio: negative position")
+	}
+	if offset != a.offset {
+		a.Close()
+		a.offset = offset
+	}
+	return offset, nil
+}
+
+func (a *AdhanAudio) Close() error {
+	if a.body == nil {
+		return nil
+	}
+	err := a.body.Close()
+	a.body = nil
+	return err
 }
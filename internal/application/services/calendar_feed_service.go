@@ -0,0 +1,251 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/ical"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/repository"
+	"gorm.io/gorm"
+)
+
+// CalendarFeedHistory is how long a masjid's feed keeps listing one-off
+// events and series after they have ended.
+const CalendarFeedHistory = 90 * 24 * time.Hour
+
+// CalendarFeedHorizon is how far ahead a masjid's feed lists events.
+const CalendarFeedHorizon = 2 * 366 * 24 * time.Hour
+
+// CalendarFeedPath is the path calendar feeds are served under, followed
+// by the feed's token and ".ics".
+const CalendarFeedPath = "/calendar/"
+
+type CalendarFeedService struct {
+	Repo   repository.CalendarFeedRepository
+	Events *EventService
+	// BaseURL is the scheme and host feed URLs start with.
+	BaseURL string
+}
+
+func NewCalendarFeedService(repo repository.CalendarFeedRepository, events *EventService, baseURL string) *CalendarFeedService {
+	return &CalendarFeedService{Repo: repo, Events: events, BaseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+// RenderedCalendarFeed is a feed encoded as iCalendar.
+type RenderedCalendarFeed struct {
+	Body []byte
+	// ETag is a strong entity tag of Body.
+	ETag string
+}
+
+// GetFeed returns the feed of a masjid, or of a user when masjidID is
+// empty, creating it the first time.
+func (s *CalendarFeedService) GetFeed(ctx context.Context, masjidID, userID string) (*entity.CalendarFeed, error) {
+	feed, err := s.Repo.GetCalendarFeed(ctx, masjidID, userID)
+	if err == nil || !errors.Is(err, gorm.ErrRecordNotFound) {
+		return feed, err
+	}
+
+	feed = &entity.CalendarFeed{ID: uuid.New(), MasjidId: masjidID}
+	if masjidID != "" {
+		if _, err := s.Events.MasjidRepo.GetByID(ctx, masjidID); err != nil {
+			return nil, err
+		}
+	} else {
+		id, err := uuid.Parse(userID)
+		if err != nil {
+			return nil, helper.ErrNotFound
+		}
+		feed.UserId = &id
+	}
+	if feed.Token, err = newFeedToken(); err != nil {
+		return nil, err
+	}
+	return s.Repo.CreateCalendarFeed(ctx, feed)
+}
+
+// ResetFeed gives the feed of a masjid, or of a user when masjidID is
+// empty, a new token.
+func (s *CalendarFeedService) ResetFeed(ctx context.Context, masjidID, userID string) (*entity.CalendarFeed, error) {
+	feed, err := s.GetFeed(ctx, masjidID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if feed.Token, err = newFeedToken(); err != nil {
+		return nil, err
+	}
+	feed.UpdatedAt = time.Now()
+	return s.Repo.UpdateCalendarFeedToken(ctx, feed)
+}
+
+// URL returns the URL a feed is served at.
+func (s *CalendarFeedService) URL(feed *entity.CalendarFeed) string {
+	return s.BaseURL + CalendarFeedPath + feed.Token + ".ics"
+}
+
+// Render encodes the feed with the given token. A masjid's feed lists its
+// events from CalendarFeedHistory ago to CalendarFeedHorizon ahead, and a
+// user's feed the events they have RSVPed to, tentatively while they are
+// waitlisted. It returns gorm.ErrRecordNotFound if no feed has the token.
+func (s *CalendarFeedService) Render(ctx context.Context, token string) (*RenderedCalendarFeed, error) {
+	feed, err := s.Repo.GetCalendarFeedByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	var calendar *ical.Calendar
+	if feed.MasjidId != "" {
+		calendar, err = s.masjidCalendar(ctx, feed.MasjidId, time.Now())
+	} else {
+		calendar, err = s.userCalendar(ctx, feed.UserId.String())
+	}
+	if err != nil {
+		return nil, err
+	}
+	body := calendar.Encode()
+	sum := sha256.Sum256(body)
+	return &RenderedCalendarFeed{Body: body, ETag: helper.ContentETag(hex.EncodeToString(sum[:]))}, nil
+}
+
+func (s *CalendarFeedService) masjidCalendar(ctx context.Context, masjidID string, now time.Time) (*ical.Calendar, error) {
+	masjid, err := s.Events.MasjidRepo.GetByID(ctx, masjidID)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.Events.Repo.ListEventsBetween(ctx, &entity.ListEventsQueryParams{
+		MasjidId:    masjidID,
+		StartFrom:   now.Add(-CalendarFeedHistory),
+		StartBefore: now.Add(CalendarFeedHorizon),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	loc, _ := eventCalendar(masjid)
+	calendar := &ical.Calendar{Name: masjid.Name + " events", TimeZone: loc}
+	for _, event := range events {
+		calendar.Events = append(calendar.Events, feedEvent(event, loc, masjidAddress(masjid), ""))
+	}
+	sortFeedEvents(calendar.Events)
+	return calendar, nil
+}
+
+func (s *CalendarFeedService) userCalendar(ctx context.Context, userID string) (*ical.Calendar, error) {
+	rsvps, err := s.Events.Repo.ListUserRsvps(ctx, userID, false)
+	if err != nil {
+		return nil, err
+	}
+	status := map[uuid.UUID]string{}
+	var events []*entity.Event
+	var seriesIDs []string
+	for _, rsvp := range rsvps {
+		if rsvp.Event == nil {
+			continue
+		}
+		status[rsvp.EventId] = "CONFIRMED"
+		if rsvp.Status == entity.RsvpWaitlisted {
+			status[rsvp.EventId] = "TENTATIVE"
+		}
+		events = append(events, rsvp.Event)
+		if rsvp.Event.IsRecurring() {
+			seriesIDs = append(seriesIDs, rsvp.EventId.String())
+		}
+	}
+	exceptions, err := s.Events.Repo.ListExceptions(ctx, seriesIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, exception := range exceptions {
+		status[exception.ID] = status[*exception.RecurringEventId]
+	}
+	events = append(events, exceptions...)
+
+	masjids := map[string]*entity.Masjid{}
+	calendar := &ical.Calendar{Name: "My events"}
+	for _, event := range events {
+		masjid, ok := masjids[event.MasjidId]
+		if !ok && event.MasjidId != "" {
+			masjid, err = s.Events.MasjidRepo.GetByID(ctx, event.MasjidId)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, err
+			}
+			masjids[event.MasjidId] = masjid
+		}
+		loc, address := time.UTC, ""
+		if masjid != nil {
+			loc, _ = eventCalendar(masjid)
+			address = masjidAddress(masjid)
+		}
+		calendar.Events = append(calendar.Events, feedEvent(event, loc, address, status[event.ID]))
+	}
+	sortFeedEvents(calendar.Events)
+	return calendar, nil
+}
+
+// feedEvent describes an event, or an exception to a recurring event, in
+// the time zone of its masjid.
+func feedEvent(event *entity.Event, loc *time.Location, address, status string) ical.Event {
+	e := ical.Event{
+		UID:         event.ID.String(),
+		Start:       event.StartTime.In(loc),
+		End:         event.EndTime,
+		Summary:     event.Name,
+		Description: event.Description,
+		Location:    address,
+		Livestream:  event.LivestreamLink,
+		Categories:  event.TypeNames(),
+		Status:      status,
+		Recurrence:  event.RecurrenceLines(),
+		Updated:     event.UpdatedAt,
+	}
+	if event.RecurringEventId != nil && event.OriginalStartTime != nil {
+		e.UID = event.RecurringEventId.String()
+		e.RecurrenceID = *event.OriginalStartTime
+	}
+	return e
+}
+
+// sortFeedEvents orders events by start, so that feeds encode the same
+// when nothing has changed.
+func sortFeedEvents(events []ical.Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		a, b := events[i], events[j]
+		if !a.Start.Equal(b.Start) {
+			return a.Start.Before(b.Start)
+		}
+		if a.UID != b.UID {
+			return a.UID < b.UID
+		}
+		return a.RecurrenceID.Before(b.RecurrenceID)
+	})
+}
+
+// masjidAddress returns the name and street address of a masjid on one
+// line.
+func masjidAddress(masjid *entity.Masjid) string {
+	var parts []string
+	for _, part := range []string{masjid.Name, masjid.Address.AddressLine1, masjid.Address.AddressLine2, masjid.Address.City} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// newFeedToken returns a random, unguessable feed token.
+func newFeedToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/ticket"
+	"github.com/mnadev/limestone/internal/application/helper"
+)
+
+// CheckIn checks in the holder of a ticket to an event, or to the
+// occurrence of a recurring event named by an occurrence ID, on behalf of
+// the volunteer scanning it. The ticket's RSVP must still be confirmed.
+// It returns the check-in with its user and the event with its
+// attendance. Scanning a ticket again returns the first check-in with
+// helper.ErrAlreadyCheckedIn.
+func (s *EventService) CheckIn(ctx context.Context, eventID, text, volunteerID string) (*entity.EventCheckIn, *entity.Event, error) {
+	if s.Tickets == nil {
+		return nil, nil, helper.ErrTicketsNotConfigured
+	}
+	claims, err := ticket.Verify(s.Tickets.PublicKey(), text)
+	if err != nil {
+		return nil, nil, err
+	}
+	volunteer, err := uuid.Parse(volunteerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	event, err := s.GetById(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if event.IsRecurring() {
+		return nil, nil, helper.ErrCheckInToSeries
+	}
+	rsvpEventID, occurrence := event.RsvpEventId()
+	if claims.EventID != rsvpEventID {
+		return nil, nil, helper.ErrTicketForAnotherEvent
+	}
+
+	// A ticket outlives its RSVP being cancelled, so the RSVP is checked
+	// as it is now.
+	rsvp, err := s.Repo.GetRsvp(ctx, claims.RsvpID.String())
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, nil, helper.ErrTicketNotConfirmed
+	case err != nil:
+		return nil, nil, err
+	case rsvp.EventId != claims.EventID || rsvp.UserId != claims.UserID:
+		return nil, nil, ticket.ErrInvalid
+	case rsvp.Status != entity.RsvpConfirmed:
+		return nil, nil, helper.ErrTicketNotConfirmed
+	}
+
+	checkIn, created, err := s.Repo.CreateCheckIn(ctx, &entity.EventCheckIn{
+		ID:          uuid.New(),
+		RsvpId:      rsvp.ID,
+		EventId:     rsvpEventID,
+		Occurrence:  occurrence,
+		UserId:      rsvp.UserId,
+		CheckedInBy: volunteer,
+		CheckedInAt: time.Now(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	checkIn.User = rsvp.User
+	if event.Attendance, err = s.Repo.CountAttendance(ctx, rsvpEventID.String(), occurrence); err != nil {
+		return nil, nil, err
+	}
+	if !created {
+		return checkIn, event, helper.ErrAlreadyCheckedIn
+	}
+	return checkIn, event, nil
+}
+
+// setTickets signs the tickets of confirmed RSVPs.
+func (s *EventService) setTickets(rsvps ...*entity.EventRsvp) {
+	for _, rsvp := range rsvps {
+		rsvp.Ticket = ""
+		if s.Tickets == nil || rsvp.Status != entity.RsvpConfirmed {
+			continue
+		}
+		rsvp.Ticket = s.Tickets.Sign(ticket.Claims{RsvpID: rsvp.ID, EventID: rsvp.EventId, UserID: rsvp.UserId})
+	}
+}
@@ -0,0 +1,331 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+)
+
+// ParseEventFilter applies the filter of a ListEvents request to params.
+// In the style of AIP-160, a filter is a list of conditions joined by AND:
+//
+//	masjid_id = "m1" AND start_time >= "2026-01-05T00:00:00Z" AND types:(YOUTH OR CHILDREN_SPECIFIC) AND is_paid = false
+//
+// masjid_id takes =. start_time takes =, <, <=, > or >= and an RFC 3339
+// time in quotes. types takes : or = and a type, or types joined by OR in
+// parentheses of which an event must have one. gender_restriction takes =
+// and NO_RESTRICTION, MALE_ONLY or FEMALE_ONLY. is_paid, requires_rsvp and
+// has_livestream take = or != and true or false.
+//
+// Each field but types may be given once, except that start_time may
+// bound the window from each side; the window must not be empty.
+func ParseEventFilter(filter string, params *entity.ListEventsQueryParams) error {
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return err
+	}
+	p := &filterParser{tokens: tokens, seen: map[string]bool{}}
+	for !p.done() {
+		if err := p.condition(params); err != nil {
+			return err
+		}
+		if p.done() {
+			break
+		}
+		if p.next().text != "AND" {
+			return fmt.Errorf("%w: conditions must be joined by AND", helper.ErrInvalidFilter)
+		}
+		if p.done() {
+			return fmt.Errorf("%w: the filter ends with AND", helper.ErrInvalidFilter)
+		}
+	}
+	if (p.seen[startTimeFrom] || p.seen[startTimeBefore]) &&
+		!params.StartBefore.IsZero() && !params.StartFrom.Before(params.StartBefore) {
+		return fmt.Errorf("%w: no start_time is in the range given", helper.ErrInvalidFilter)
+	}
+	return nil
+}
+
+// ParseEventOrder parses the order_by of a ListEvents request: fields
+// separated by commas, each followed by desc to reverse it, as in
+// "start_time desc, name".
+func ParseEventOrder(orderBy string) ([]entity.EventOrder, error) {
+	var order []entity.EventOrder
+	if strings.TrimSpace(orderBy) == "" {
+		return nil, nil
+	}
+	for _, key := range strings.Split(orderBy, ",") {
+		words := strings.Fields(key)
+		if len(words) == 0 || len(words) > 2 {
+			return nil, fmt.Errorf("%w: %q", helper.ErrInvalidOrderBy, key)
+		}
+		field := entity.EventOrderField(words[0])
+		switch field {
+		case entity.OrderByStartTime, entity.OrderByEndTime, entity.OrderByName, entity.OrderByCreateTime:
+		default:
+			return nil, fmt.Errorf("%w: cannot order by %s", helper.ErrInvalidOrderBy, words[0])
+		}
+		desc := false
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				desc = true
+			default:
+				return nil, fmt.Errorf("%w: %q", helper.ErrInvalidOrderBy, key)
+			}
+		}
+		order = append(order, entity.EventOrder{Field: field, Desc: desc})
+	}
+	return order, nil
+}
+
+type filterToken struct {
+	text   string
+	quoted bool
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+	// seen holds the fields given so far, and for start_time the sides of
+	// the window it has bounded.
+	seen map[string]bool
+}
+
+const (
+	startTimeFrom   = "start_time >"
+	startTimeBefore = "start_time <"
+)
+
+// once records a condition on field, or on the given parts of it, failing
+// if one was already given.
+func (p *filterParser) once(field string, parts ...string) error {
+	if len(parts) == 0 {
+		parts = []string{field}
+	}
+	for _, part := range parts {
+		if p.seen[part] {
+			return fmt.Errorf("%w: %s is given more than once", helper.ErrInvalidFilter, field)
+		}
+		p.seen[part] = true
+	}
+	return nil
+}
+
+func (p *filterParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *filterParser) next() filterToken {
+	if p.done() {
+		return filterToken{}
+	}
+	p.pos++
+	return p.tokens[p.pos-1]
+}
+
+// condition reads one "field op value" into params.
+func (p *filterParser) condition(params *entity.ListEventsQueryParams) error {
+	field, op := p.next(), p.next().text
+	if field.quoted || field.text == "" || op == "" {
+		return fmt.Errorf("%w: expected a field and an operator", helper.ErrInvalidFilter)
+	}
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w: %s", helper.ErrInvalidFilter, fmt.Sprintf(format, args...))
+	}
+	wantOp := func(ops ...string) error {
+		for _, o := range ops {
+			if op == o {
+				return nil
+			}
+		}
+		return invalid("%s cannot be compared with %s", field.text, op)
+	}
+
+	switch field.text {
+	case "masjid_id":
+		if err := wantOp("="); err != nil {
+			return err
+		}
+		if err := p.once(field.text); err != nil {
+			return err
+		}
+		value := p.next().text
+		if value == "" || params.MasjidId != "" && params.MasjidId != value {
+			return invalid("masjid_id must name one masjid")
+		}
+		params.MasjidId = value
+
+	case "start_time":
+		if err := wantOp("=", "<", "<=", ">", ">="); err != nil {
+			return err
+		}
+		value := p.next()
+		t, err := time.Parse(time.RFC3339, value.text)
+		if err != nil || !value.quoted {
+			return invalid("start_time must be compared with an RFC 3339 time in quotes")
+		}
+		var sides []string
+		if op != "<" && op != "<=" {
+			sides = append(sides, startTimeFrom)
+		}
+		if op != ">" && op != ">=" {
+			sides = append(sides, startTimeBefore)
+		}
+		if err := p.once(field.text, sides...); err != nil {
+			return err
+		}
+		if op == "=" || op == ">=" || op == ">" {
+			if op == ">" {
+				t = t.Add(time.Nanosecond)
+			}
+			if t.After(params.StartFrom) {
+				params.StartFrom = t
+			}
+		}
+		if op == "=" || op == "<=" || op == "<" {
+			if op != "<" {
+				t = t.Add(time.Nanosecond)
+			}
+			if params.StartBefore.IsZero() || t.Before(params.StartBefore) {
+				params.StartBefore = t
+			}
+		}
+
+	case "types", "type":
+		if err := wantOp(":", "="); err != nil {
+			return err
+		}
+		group, err := p.typeGroup()
+		if err != nil {
+			return err
+		}
+		params.TypeGroups = append(params.TypeGroups, group)
+
+	case "gender_restriction":
+		if err := wantOp("="); err != nil {
+			return err
+		}
+		if err := p.once(field.text); err != nil {
+			return err
+		}
+		value := p.next().text
+		restriction, ok := pb.Event_GenderRestriction_value[value]
+		if !ok {
+			return invalid("unknown gender_restriction %q", value)
+		}
+		r := entity.GenderRestriction(restriction)
+		params.GenderRestriction = &r
+
+	case "is_paid", "requires_rsvp", "has_livestream":
+		if err := wantOp("=", "!="); err != nil {
+			return err
+		}
+		if err := p.once(field.text); err != nil {
+			return err
+		}
+		var b bool
+		switch p.next().text {
+		case "true":
+			b = true
+		case "false":
+		default:
+			return invalid("%s must be compared with true or false", field.text)
+		}
+		b = b == (op == "=")
+		switch field.text {
+		case "is_paid":
+			params.IsPaid = &b
+		case "requires_rsvp":
+			params.RequiresRsvp = &b
+		default:
+			params.HasLivestream = &b
+		}
+
+	default:
+		return invalid("cannot filter by %s", field.text)
+	}
+	return nil
+}
+
+// typeGroup reads one event type, or several joined by OR in parentheses.
+func (p *filterParser) typeGroup() ([]string, error) {
+	typeName := func(t filterToken) (string, error) {
+		if _, ok := pb.Event_EventType_value[t.text]; !ok {
+			return "", fmt.Errorf("%w: unknown event type %q", helper.ErrInvalidFilter, t.text)
+		}
+		return t.text, nil
+	}
+	first := p.next()
+	if first.quoted || first.text != "(" {
+		name, err := typeName(first)
+		return []string{name}, err
+	}
+
+	var group []string
+	for {
+		name, err := typeName(p.next())
+		if err != nil {
+			return nil, err
+		}
+		group = append(group, name)
+		switch p.next().text {
+		case "OR":
+		case ")":
+			return group, nil
+		default:
+			return nil, fmt.Errorf("%w: event types must be joined by OR", helper.ErrInvalidFilter)
+		}
+	}
+}
+
+// tokenizeFilter splits a filter into words, quoted strings, parentheses
+// and comparison operators.
+func tokenizeFilter(filter string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(filter)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("%w: unterminated string", helper.ErrInvalidFilter)
+			}
+			tokens = append(tokens, filterToken{text: string(runes[i+1 : end]), quoted: true})
+			i = end + 1
+		case r == '(' || r == ')' || r == ':':
+			tokens = append(tokens, filterToken{text: string(r)})
+			i++
+		case strings.ContainsRune("<>=!", r):
+			op := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("%w: unknown operator !", helper.ErrInvalidFilter)
+			}
+			tokens = append(tokens, filterToken{text: op})
+			i += len(op)
+		default:
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && !strings.ContainsRune(`"():<>=!`, runes[end]) {
+				end++
+			}
+			tokens = append(tokens, filterToken{text: string(runes[i:end])})
+			i = end
+		}
+	}
+	return tokens, nil
+}
@@ -2,34 +2,986 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
 	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/hijri"
+	"github.com/mnadev/limestone/internal/application/domain/recurrence"
+	"github.com/mnadev/limestone/internal/application/domain/ticket"
+	"github.com/mnadev/limestone/internal/application/helper"
 	"github.com/mnadev/limestone/internal/application/repository"
+	"gorm.io/gorm"
+	"log"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// DefaultAttendeesPageSize is the number of attendees listed at a time
+// when no page size is given.
+const DefaultAttendeesPageSize = 100
+
+// DefaultEventsPageSize is the number of events listed at a time from a
+// time window when no page size is given.
+const DefaultEventsPageSize = 100
+
+// MaxRecurrenceWindow is the longest time window recurring events are
+// expanded in, enough for a Hijri year.
+const MaxRecurrenceWindow = 400 * 24 * time.Hour
+
+// maxOccurrences bounds the occurrences of one recurring event listed from
+// a time window.
+const maxOccurrences = 1000
+
 type EventService struct {
-	Repo repository.EventRepository
+	Repo       repository.EventRepository
+	MasjidRepo repository.MasjidRepository
+	UserRepo   repository.UserRepository
+	// RoomRepo looks up the rooms events are held in.
+	RoomRepo repository.RoomRepository
+	// Tickets signs the tickets of confirmed RSVPs. Tickets are not issued
+	// or checked when it is nil.
+	Tickets *ticket.Signer
+	// Notifications is told of events being rescheduled and cancelled, to
+	// tell their audience. Nobody is told when it is nil. It is set once
+	// the NotificationService, which lists events with this service, has
+	// been made.
+	Notifications *NotificationService
 }
 
-func NewEventService(repo repository.EventRepository) *EventService {
-	return &EventService{Repo: repo}
+func NewEventService(repo repository.EventRepository, masjidRepo repository.MasjidRepository, userRepo repository.UserRepository, roomRepo repository.RoomRepository, tickets *ticket.Signer) *EventService {
+	return &EventService{Repo: repo, MasjidRepo: masjidRepo, UserRepo: userRepo, RoomRepo: roomRepo, Tickets: tickets}
 }
 
+// Create stores a new event. The recurrence of a recurring event is
+// checked and stored with its dates in UTC. The rooms it is held in are
+// booked for its times, unless they are booked already.
 func (r *EventService) Create(ctx context.Context, event *entity.Event) (*entity.Event, error) {
-	return r.Repo.Create(ctx, event)
+	if event.IsRecurring() {
+		if _, err := r.setRecurrence(ctx, event); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.checkRooms(ctx, event); err != nil {
+		return nil, err
+	}
+	created, err := r.Repo.Create(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	return created, r.setHijriDates(ctx, created)
 }
 
+// Update changes the fields of event that are set. Raising its capacity
+// confirms waitlisted RSVPs into the new places; lowering it below the
+// RSVPs already confirmed cancels none of them. Changes to a recurring
+// event apply to all of its occurrences but its exceptions. The event's
+// rooms are replaced by those in event when it names any, and move with
+// its times otherwise. Moving the event, or the next occurrence of a
+// recurring one, is notified to its audience.
 func (r *EventService) Update(ctx context.Context, event *entity.Event) (*entity.Event, error) {
-	return r.Repo.Update(ctx, event)
+	var series, before *entity.Event
+	if event.IsRecurring() || !event.StartTime.IsZero() {
+		stored, err := r.Repo.GetByID(ctx, event.ID.String())
+		if err != nil {
+			return nil, err
+		}
+		if event.IsRecurring() && stored.RecurringEventId != nil {
+			return nil, helper.ErrRecurringException
+		}
+		before = stored
+		if event.IsRecurring() || stored.IsRecurring() {
+			before = r.nextOccurrence(ctx, stored)
+			series = stored
+			applyEventChanges(series, event)
+			if _, err := r.setRecurrence(ctx, series); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := r.rebookRooms(ctx, event); err != nil {
+		return nil, err
+	}
+
+	updated, err := r.Repo.Update(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	if series != nil {
+		if err := r.Repo.SaveRecurrence(ctx, series); err != nil {
+			return nil, err
+		}
+		updated.Recurrence, updated.SeriesEnd = series.Recurrence, series.SeriesEnd
+		r.notifyRescheduled(ctx, before, r.nextOccurrence(ctx, series))
+	} else if before != nil {
+		after := *before
+		applyEventChanges(&after, event)
+		r.notifyRescheduled(ctx, before, &after)
+	}
+	if event.MaxParticipants != 0 {
+		if err := r.Repo.PromoteWaitlist(ctx, event.ID.String()); err != nil {
+			return nil, err
+		}
+	}
+	return updated, r.setHijriDates(ctx, updated)
 }
 
+// GetById returns an event, or the occurrence of a recurring event named
+// by an occurrence ID, with its attendance if it requires RSVP.
 func (r *EventService) GetById(ctx context.Context, id string) (*entity.Event, error) {
-	return r.Repo.GetByID(ctx, id)
+	var event *entity.Event
+	var err error
+	if seriesID, start, ok := entity.ParseOccurrenceID(id); ok {
+		event, _, _, err = r.getOccurrence(ctx, seriesID.String(), start)
+	} else {
+		event, err = r.Repo.GetByID(ctx, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if event.RequiresRsvp {
+		rsvpEventID, occurrence := event.RsvpEventId()
+		if event.Attendance, err = r.Repo.CountAttendance(ctx, rsvpEventID.String(), occurrence); err != nil {
+			return nil, err
+		}
+	}
+	return event, r.setHijriDates(ctx, event)
 }
 
+// Delete deletes an event, a recurring event with all its occurrences, or
+// the single occurrence named by an occurrence ID. Deleting an exception
+// also takes its occurrence out of the series. The cancellation of the
+// event, or the next occurrence of a recurring one, is notified to its
+// audience.
 func (r *EventService) Delete(ctx context.Context, id string) error {
-	return r.Repo.Delete(ctx, id)
+	if seriesID, start, ok := entity.ParseOccurrenceID(id); ok {
+		return r.DeleteOccurrence(ctx, seriesID.String(), start, entity.ThisEvent)
+	}
+	event, err := r.Repo.GetByID(ctx, id)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if err == nil && event.RecurringEventId != nil {
+		return r.DeleteOccurrence(ctx, event.RecurringEventId.String(), *event.OriginalStartTime, entity.ThisEvent)
+	}
+	var notices []entity.EventNotification
+	if err == nil {
+		if event.IsRecurring() {
+			event = r.nextOccurrence(ctx, event)
+		}
+		notices = r.cancellationNotices(ctx, event)
+	}
+	if err := r.Repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.queueNotices(ctx, notices)
+	return nil
+}
+
+// UpdateOccurrence applies the fields set in changes to the occurrence of a
+// recurring event originally starting at start. With ThisEvent the
+// occurrence becomes an exception, stored as an event of its own. With
+// ThisAndFollowing the series ends before it, and a new series carrying the
+// changes takes over from it; a new recurrence in changes applies from
+// there, and otherwise the rule carries on moved with the start time.
+func (r *EventService) UpdateOccurrence(ctx context.Context, seriesID string, start time.Time, scope entity.RecurrenceScope, changes *entity.Event) (*entity.Event, error) {
+	occurrence, series, set, err := r.getOccurrence(ctx, seriesID, start)
+	if err != nil {
+		return nil, err
+	}
+	if scope == entity.ThisAndFollowing {
+		return r.updateFollowing(ctx, occurrence, series, set, start, changes)
+	}
+	if changes.IsRecurring() {
+		return nil, helper.ErrRecurringException
+	}
+
+	before := *occurrence
+	applyEventChanges(occurrence, changes)
+	if occurrence.IsOccurrence() {
+		occurrence.ID = uuid.New()
+		occurrence.SetTypes(occurrence.TypeNames()...)
+		occurrence.CreatedAt = time.Now()
+		occurrence.UpdatedAt = occurrence.CreatedAt
+		occurrence.Bookings = changes.Bookings
+		if changes.Bookings == nil {
+			occurrence.SetRooms(roomsOf(series)...)
+		}
+		if err := r.checkRooms(ctx, occurrence); err != nil {
+			return nil, err
+		}
+		if _, err := r.Repo.Create(ctx, occurrence); err != nil {
+			return nil, err
+		}
+	} else {
+		changes.ID = occurrence.ID
+		if err := r.rebookRooms(ctx, changes); err != nil {
+			return nil, err
+		}
+		if _, err := r.Repo.Update(ctx, changes); err != nil {
+			return nil, err
+		}
+		if changes.Bookings != nil {
+			occurrence.Bookings = changes.Bookings
+		}
+	}
+	r.notifyRescheduled(ctx, &before, occurrence)
+	return occurrence, r.setHijriDates(ctx, occurrence)
+}
+
+// updateFollowing applies changes to occurrence, originally starting at
+// start, and those following it, notifying its audience if it moves.
+func (r *EventService) updateFollowing(ctx context.Context, occurrence, series *entity.Event, set *recurrence.Set, start time.Time, changes *entity.Event) (*entity.Event, error) {
+	if start.Equal(set.Start) {
+		changes.ID = series.ID
+		return r.Update(ctx, changes)
+	}
+
+	head, tail := set.Split(start)
+	following := *series.Occurrence(start)
+	following.ID = uuid.New()
+	following.SetTypes(following.TypeNames()...)
+	following.RecurringEventId, following.OriginalStartTime = nil, nil
+	following.CreatedAt = time.Now()
+	following.UpdatedAt = following.CreatedAt
+	applyEventChanges(&following, changes)
+	if changes.IsRecurring() {
+		if _, err := r.setRecurrence(ctx, &following); err != nil {
+			return nil, err
+		}
+	} else {
+		storeRecurrence(&following, tail.MoveTo(following.StartTime.In(tail.Start.Location())))
+	}
+	storeRecurrence(series, head)
+
+	following.Bookings = changes.Bookings
+	if changes.Bookings == nil {
+		following.SetRooms(roomsOf(series)...)
+	}
+	if err := r.checkRooms(ctx, &following); err != nil {
+		return nil, err
+	}
+	if len(following.Bookings) > 0 {
+		exceptions, err := r.Repo.ListExceptions(ctx, []string{series.ID.String()})
+		if err != nil {
+			return nil, err
+		}
+		var starts []time.Time
+		for _, exception := range exceptions {
+			if exception.OriginalStartTime.After(start) {
+				starts = append(starts, exception.OriginalStartTime.Add(following.StartTime.Sub(start)))
+			}
+		}
+		following.Bookings = withoutOccurrences(following.Bookings, starts)
+	}
+
+	if err := r.Repo.SplitSeries(ctx, series, &following, start); err != nil {
+		return nil, err
+	}
+	r.notifyRescheduled(ctx, occurrence, following.Occurrence(following.StartTime))
+	return &following, r.setHijriDates(ctx, &following)
+}
+
+// DeleteOccurrence deletes the occurrence of a recurring event originally
+// starting at start, or with ThisAndFollowing that one and all after it.
+// The cancellation of the occurrence is notified to its audience.
+func (r *EventService) DeleteOccurrence(ctx context.Context, seriesID string, start time.Time, scope entity.RecurrenceScope) error {
+	occurrence, series, set, err := r.getOccurrence(ctx, seriesID, start)
+	if err != nil {
+		return err
+	}
+	notices := r.cancellationNotices(ctx, occurrence)
+	switch {
+	case scope == entity.ThisAndFollowing && start.Equal(set.Start):
+		err = r.Repo.Delete(ctx, seriesID)
+	case scope == entity.ThisAndFollowing:
+		head, _ := set.Split(start)
+		storeRecurrence(series, head)
+		err = r.Repo.EndSeries(ctx, series, start)
+	default:
+		set.Exclude(start.In(set.Start.Location()))
+		storeRecurrence(series, set)
+		err = r.Repo.ExcludeOccurrence(ctx, series, start)
+	}
+	if err != nil {
+		return err
+	}
+	r.queueNotices(ctx, notices)
+	return nil
+}
+
+// getOccurrence returns the occurrence of a recurring event originally
+// starting at start, which is its exception if it has been edited on its
+// own, with the series and its recurrence. It returns
+// gorm.ErrRecordNotFound if the series has no such occurrence.
+func (r *EventService) getOccurrence(ctx context.Context, seriesID string, start time.Time) (*entity.Event, *entity.Event, *recurrence.Set, error) {
+	series, err := r.Repo.GetByID(ctx, seriesID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !series.IsRecurring() {
+		return nil, nil, nil, gorm.ErrRecordNotFound
+	}
+	set, err := r.recurrenceOf(ctx, series)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	exception, err := r.Repo.GetException(ctx, seriesID, start)
+	switch {
+	case err == nil:
+		return exception, series, set, nil
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, nil, nil, err
+	case !set.Includes(start):
+		return nil, nil, nil, gorm.ErrRecordNotFound
+	}
+	return series.Occurrence(start.In(set.Start.Location())), series, set, nil
+}
+
+// ListEvents returns a page of events, the token of the next page, which
+// is empty on the last, and the number of events on every page. When
+// params.HijriYear is set the events are limited to those starting in that
+// Hijri year, or in params.HijriMonth of it, as observed by
+// params.MasjidId when given. When the events are limited to a time window
+// that way or by params.StartFrom and params.StartBefore, recurring events
+// are expanded into their occurrences in it. Page tokens are offsets when
+// params.OrderBy is set.
+func (s *EventService) ListEvents(ctx context.Context, params *entity.ListEventsQueryParams) ([]*entity.Event, string, int, error) {
+	if params.HijriMonth != 0 && params.HijriYear == 0 {
+		return nil, "", 0, fmt.Errorf("%w: hijri month requires a hijri year", hijri.ErrInvalidDate)
+	}
+	if params.HijriYear != 0 {
+		loc, offset := time.UTC, 0
+		if params.MasjidId != "" {
+			masjid, err := s.MasjidRepo.GetByID(ctx, params.MasjidId)
+			if err != nil {
+				return nil, "", 0, err
+			}
+			loc, offset = eventCalendar(masjid)
+		}
+		start, end, err := hijri.MonthRange(params.HijriYear, params.HijriMonth, offset)
+		if err != nil {
+			return nil, "", 0, err
+		}
+		params.StartFrom = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+		params.StartBefore = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, loc)
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = DefaultEventsPageSize
+	}
+	if !params.StartFrom.IsZero() && !params.StartBefore.IsZero() {
+		return s.listOccurrences(ctx, params)
+	}
+
+	if len(params.OrderBy) > 0 {
+		offset, err := pageOffset(params.PageToken)
+		if err != nil {
+			return nil, "", 0, err
+		}
+		params.Offset = offset
+	}
+	events, err := s.Repo.ListEvents(ctx, params)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	total, err := s.Repo.CountEvents(ctx, params)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	next := ""
+	if len(events) == int(params.PageSize) {
+		if len(params.OrderBy) > 0 {
+			next = strconv.Itoa(params.Offset + len(events))
+		} else {
+			next = events[len(events)-1].ID.String()
+		}
+	}
+	return events, next, int(total), s.setHijriDates(ctx, events...)
+}
+
+// ListWeekEvents returns the events and occurrences of recurring events
+// of a masjid starting in the week, Monday to Sunday in the masjid's time
+// zone, containing weekOf. The other filters of params apply too.
+func (s *EventService) ListWeekEvents(ctx context.Context, masjidID string, weekOf time.Time, params *entity.ListEventsQueryParams) ([]*entity.Event, error) {
+	masjid, err := s.MasjidRepo.GetByID(ctx, masjidID)
+	if err != nil {
+		return nil, err
+	}
+	loc, _ := eventCalendar(masjid)
+	day := weekOf.In(loc)
+	monday := time.Date(day.Year(), day.Month(), day.Day()-(int(day.Weekday())+6)%7, 0, 0, 0, 0, loc)
+
+	params.MasjidId = masjidID
+	params.StartFrom = monday
+	params.StartBefore = monday.AddDate(0, 0, 7)
+	params.PageSize = maxOccurrences
+	params.PageToken = ""
+	events, _, _, err := s.listOccurrences(ctx, params)
+	return events, err
+}
+
+// listOccurrences returns a page of the events and occurrences of
+// recurring events starting in the window of params, by start time unless
+// params.OrderBy says otherwise, and their number. The page token is the
+// offset of the page.
+func (s *EventService) listOccurrences(ctx context.Context, params *entity.ListEventsQueryParams) ([]*entity.Event, string, int, error) {
+	from, before := params.StartFrom, params.StartBefore
+	if !before.After(from) || before.Sub(from) > MaxRecurrenceWindow {
+		return nil, "", 0, helper.ErrInvalidTimeWindow
+	}
+	offset, err := pageOffset(params.PageToken)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	pageSize := int(params.PageSize)
+
+	stored, err := s.Repo.ListEventsBetween(ctx, params)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	// Exceptions replace the occurrences they were edited from, even when
+	// they have been moved out of the window or no longer match the
+	// filters.
+	replaced := map[string]bool{}
+	for _, event := range stored {
+		if event.RecurringEventId != nil && event.OriginalStartTime != nil {
+			replaced[entity.OccurrenceID(*event.RecurringEventId, *event.OriginalStartTime)] = true
+		}
+	}
+	locations := map[string]*time.Location{}
+	var events []*entity.Event
+	for _, event := range stored {
+		if !params.Matches(event) {
+			continue
+		}
+		if !event.IsRecurring() {
+			if !event.StartTime.Before(from) && event.StartTime.Before(before) {
+				events = append(events, event)
+			}
+			continue
+		}
+		loc, ok := locations[event.MasjidId]
+		if !ok {
+			if loc, err = s.eventLocation(ctx, event.MasjidId); err != nil {
+				return nil, "", 0, err
+			}
+			locations[event.MasjidId] = loc
+		}
+		set, err := recurrence.Parse(event.RecurrenceLines(), event.StartTime.In(loc))
+		if err != nil {
+			return nil, "", 0, err
+		}
+		for _, start := range set.Between(from, before, maxOccurrences) {
+			if !replaced[entity.OccurrenceID(event.ID, start)] {
+				events = append(events, event.Occurrence(start))
+			}
+		}
+	}
+	order := params.OrderBy
+	if len(order) == 0 {
+		order = []entity.EventOrder{{Field: entity.OrderByStartTime}}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if c := compareEvents(events[i], events[j], order); c != 0 {
+			return c < 0
+		}
+		return events[i].PublicID() < events[j].PublicID()
+	})
+
+	total := len(events)
+	if offset >= len(events) {
+		return nil, "", total, nil
+	}
+	events = events[offset:]
+	next := ""
+	if len(events) > pageSize {
+		events = events[:pageSize]
+		next = strconv.Itoa(offset + pageSize)
+	}
+	return events, next, total, s.setHijriDates(ctx, events...)
+}
+
+// Rsvp registers a user for an event that requires RSVP, confirmed while
+// places remain and waitlisted after that. The user's gender must be one
+// the event admits. RSVPing again returns the RSVP the user already has.
+// A confirmed RSVP comes with its ticket. Events that sell tickets are
+// attended by ordering one instead.
+func (s *EventService) Rsvp(ctx context.Context, eventID, userID string) (*entity.EventRsvp, error) {
+	event, err := s.Repo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !event.RequiresRsvp {
+		return nil, helper.ErrRsvpNotRequired
+	}
+	if len(event.TicketTypes) > 0 {
+		return nil, helper.ErrTicketsRequired
+	}
+	now := time.Now()
+	user, err := s.checkAttendee(ctx, event, userID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	rsvp, err := s.Repo.CreateRsvp(ctx, &entity.EventRsvp{
+		ID:          uuid.New(),
+		EventId:     event.ID,
+		UserId:      user.ID,
+		RequestedAt: now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.setTickets(rsvp)
+	return rsvp, nil
+}
+
+// checkAttendee returns the user if they may still sign up for the event.
+// A series may be signed up for until its last occurrence has ended, and
+// one without an end always.
+func (s *EventService) checkAttendee(ctx context.Context, event *entity.Event, userID string, now time.Time) (*entity.User, error) {
+	end := event.EndTime
+	if event.IsRecurring() {
+		end = time.Time{}
+		if event.SeriesEnd != nil && !event.EndTime.IsZero() {
+			end = event.SeriesEnd.Add(event.EndTime.Sub(event.StartTime))
+		}
+	}
+	if !end.IsZero() && end.Before(now) {
+		return nil, helper.ErrEventEnded
+	}
+	user, err := s.UserRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !event.AdmitsGender(user.Gender) {
+		if user.Gender != entity.Male && user.Gender != entity.Female {
+			return nil, helper.ErrGenderNotSet
+		}
+		return nil, helper.ErrGenderRestricted
+	}
+	return user, nil
+}
+
+// CancelRsvp cancels a user's RSVP for an event. If it was confirmed, the
+// first waitlisted RSVP is confirmed in its place.
+func (s *EventService) CancelRsvp(ctx context.Context, eventID, userID string) (*entity.EventRsvp, error) {
+	return s.Repo.CancelRsvp(ctx, eventID, userID)
+}
+
+// ListEventAttendees returns a page of an event's RSVPs with the given
+// status, or those confirmed and waitlisted when it is unspecified, and the
+// token of the next page, which is empty on the last. The page token is
+// the offset of the page.
+func (s *EventService) ListEventAttendees(ctx context.Context, eventID string, status entity.RsvpStatus, pageSize int, pageToken string) ([]entity.EventRsvp, string, error) {
+	if _, err := s.Repo.GetByID(ctx, eventID); err != nil {
+		return nil, "", err
+	}
+	offset := 0
+	if pageToken != "" {
+		var err error
+		offset, err = strconv.Atoi(pageToken)
+		if err != nil || offset < 0 {
+			return nil, "", helper.ErrInvalidPageToken
+		}
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultAttendeesPageSize
+	}
+
+	// One more than asked for tells whether there is a next page.
+	rsvps, err := s.Repo.ListRsvps(ctx, eventID, status, offset, pageSize+1)
+	if err != nil {
+		return nil, "", err
+	}
+	next := ""
+	if len(rsvps) > pageSize {
+		rsvps = rsvps[:pageSize]
+		next = strconv.Itoa(offset + pageSize)
+	}
+	return rsvps, next, nil
+}
+
+// GetMyRsvps returns a user's RSVPs with their events, by the start time
+// of the event, and the tickets of those confirmed.
+func (s *EventService) GetMyRsvps(ctx context.Context, userID string, includeCancelled bool) ([]entity.EventRsvp, error) {
+	rsvps, err := s.Repo.ListUserRsvps(ctx, userID, includeCancelled)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*entity.Event, 0, len(rsvps))
+	for i := range rsvps {
+		s.setTickets(&rsvps[i])
+		if rsvps[i].Event != nil {
+			events = append(events, rsvps[i].Event)
+		}
+	}
+	return rsvps, s.setHijriDates(ctx, events...)
+}
+
+// setHijriDates fills in the Hijri dates of events using the time zone and
+// offset of each event's masjid. Events whose masjid no longer exists fall
+// back to UTC and the plain Umm al-Qura calendar.
+func (s *EventService) setHijriDates(ctx context.Context, events ...*entity.Event) error {
+	masjids := map[string]*entity.Masjid{}
+	for _, event := range events {
+		masjid, ok := masjids[event.MasjidId]
+		if !ok && event.MasjidId != "" {
+			var err error
+			masjid, err = s.MasjidRepo.GetByID(ctx, event.MasjidId)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			masjids[event.MasjidId] = masjid
+		}
+
+		loc, offset := time.UTC, 0
+		if masjid != nil {
+			loc, offset = eventCalendar(masjid)
+		}
+		event.HijriStart, _ = hijri.FromGregorian(event.StartTime.In(loc), offset)
+		event.HijriEnd, _ = hijri.FromGregorian(event.EndTime.In(loc), offset)
+	}
+	return nil
+}
+
+// nextOccurrence returns the first occurrence of a recurring event that has
+// not ended, or nil if it has none left within MaxRecurrenceWindow.
+func (s *EventService) nextOccurrence(ctx context.Context, series *entity.Event) *entity.Event {
+	set, err := s.recurrenceOf(ctx, series)
+	if err != nil {
+		return nil
+	}
+	now := time.Now()
+	starts := set.Between(now.Add(series.StartTime.Sub(series.EndTime)), now.Add(MaxRecurrenceWindow), 1)
+	if len(starts) == 0 {
+		return nil
+	}
+	return series.Occurrence(starts[0])
+}
+
+// notifyRescheduled tells the audience of an event or occurrence that it has
+// moved from before to after. Failing to does not fail the change, which
+// has been made.
+func (s *EventService) notifyRescheduled(ctx context.Context, before, after *entity.Event) {
+	if s.Notifications == nil || before == nil || after == nil {
+		return
+	}
+	if err := s.Notifications.EventRescheduled(ctx, before, after); err != nil {
+		log.Printf("failed to notify the rescheduling of event %s: %v", before.PublicID(), err)
+	}
+}
+
+// cancellationNotices returns the notices to queue once an event or
+// occurrence is cancelled.
+func (s *EventService) cancellationNotices(ctx context.Context, event *entity.Event) []entity.EventNotification {
+	if s.Notifications == nil || event == nil {
+		return nil
+	}
+	notices, err := s.Notifications.CancellationNotices(ctx, event)
+	if err != nil {
+		log.Printf("failed to notify the cancellation of event %s: %v", event.PublicID(), err)
+	}
+	return notices
+}
+
+func (s *EventService) queueNotices(ctx context.Context, notices []entity.EventNotification) {
+	if len(notices) == 0 {
+		return
+	}
+	if err := s.Notifications.Queue(ctx, notices); err != nil {
+		log.Printf("failed to queue event notices: %v", err)
+	}
+}
+
+// recurrenceOf parses the recurrence of a recurring event in the time zone
+// of its masjid.
+func (s *EventService) recurrenceOf(ctx context.Context, event *entity.Event) (*recurrence.Set, error) {
+	loc, err := s.eventLocation(ctx, event.MasjidId)
+	if err != nil {
+		return nil, err
+	}
+	return recurrence.Parse(event.RecurrenceLines(), event.StartTime.In(loc))
+}
+
+// setRecurrence checks the recurrence of event and stores it back with its
+// dates in UTC, along with the end of the series.
+func (s *EventService) setRecurrence(ctx context.Context, event *entity.Event) (*recurrence.Set, error) {
+	set, err := s.recurrenceOf(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	storeRecurrence(event, set)
+	return set, nil
+}
+
+func storeRecurrence(event *entity.Event, set *recurrence.Set) {
+	event.Recurrence = strings.Join(set.Lines(), "\n")
+	event.SeriesEnd = nil
+	if end, ok := set.End(); ok {
+		event.SeriesEnd = &end
+	}
+}
+
+// checkRooms checks that the rooms of event are rooms of its masjid that
+// suit its gender restriction and together hold its participants, and
+// turns them into bookings for its times. A recurring event books them for
+// each of its occurrences that bookedOccurrences returns.
+func (s *EventService) checkRooms(ctx context.Context, event *entity.Event) error {
+	if len(event.Bookings) == 0 {
+		return nil
+	}
+	if !event.EndTime.After(event.StartTime) {
+		return fmt.Errorf("%w: the event must end after it starts", helper.ErrInvalidBooking)
+	}
+	starts := []time.Time{event.StartTime}
+	if event.IsRecurring() {
+		set, err := s.recurrenceOf(ctx, event)
+		if err != nil {
+			return err
+		}
+		starts = bookedOccurrences(set, event.EndTime.Sub(event.StartTime), time.Now())
+	}
+
+	now := time.Now()
+	var capacity int32
+	bookings := make([]entity.RoomBooking, 0, len(event.Bookings)*len(starts))
+	for _, booking := range event.Bookings {
+		room, err := s.RoomRepo.GetRoom(ctx, booking.RoomId.String())
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: room %s not found", helper.ErrInvalidBooking, booking.RoomId)
+		}
+		if err != nil {
+			return err
+		}
+		switch {
+		case room.MasjidId != event.MasjidId:
+			return fmt.Errorf("%w: %s is not a room of the event's masjid", helper.ErrInvalidBooking, room.Name)
+		case !room.Suits(event.GenderRestriction):
+			return fmt.Errorf("%w: %s is set aside for the other gender", helper.ErrInvalidBooking, room.Name)
+		}
+		capacity += room.Capacity
+
+		eventID := event.ID
+		for _, start := range starts {
+			bookings = append(bookings, entity.RoomBooking{
+				ID:        uuid.New(),
+				RoomId:    room.ID,
+				EventId:   &eventID,
+				Purpose:   event.Name,
+				StartTime: start,
+				EndTime:   start.Add(event.EndTime.Sub(event.StartTime)),
+				CreatedAt: now,
+				UpdatedAt: now,
+			})
+		}
+	}
+	if event.MaxParticipants > capacity {
+		return fmt.Errorf("%w: the rooms hold %d people, fewer than the event's %d participants", helper.ErrInvalidBooking, capacity, event.MaxParticipants)
+	}
+	event.Bookings = bookings
+	return nil
+}
+
+// bookedOccurrences returns the starts of the occurrences of set, each
+// lasting length, that a recurring event books its rooms for: those not
+// over by now and starting within MaxRecurrenceWindow of now, or of the
+// start of the series if it is later, and no more than maxOccurrences.
+// Occurrences after that are not booked until the series is next changed,
+// but the series still holds its rooms for them by its rule.
+func bookedOccurrences(set *recurrence.Set, length time.Duration, now time.Time) []time.Time {
+	from := now.Add(-length)
+	if set.Start.After(from) {
+		from = set.Start
+	}
+	return set.Between(from, from.Add(MaxRecurrenceWindow), maxOccurrences)
+}
+
+// withoutOccurrences returns bookings without those of the occurrences
+// starting at starts, which have exceptions booking rooms of their own.
+func withoutOccurrences(bookings []entity.RoomBooking, starts []time.Time) []entity.RoomBooking {
+	return slices.DeleteFunc(bookings, func(booking entity.RoomBooking) bool {
+		return slices.ContainsFunc(starts, booking.StartTime.Equal)
+	})
+}
+
+// rebookRooms sets the bookings of an update to an event: for the rooms
+// changes names, or for those the event has when the update moves them,
+// checked against the event as updated. A recurring event keeps the
+// bookings of occurrences already over and books none for occurrences
+// that have exceptions. Otherwise changes.Bookings stays nil and the
+// bookings are left as they are.
+func (s *EventService) rebookRooms(ctx context.Context, changes *entity.Event) error {
+	if changes.Bookings == nil && !movesBookings(changes) {
+		return nil
+	}
+	stored, err := s.Repo.GetByID(ctx, changes.ID.String())
+	if err != nil {
+		return err
+	}
+	if changes.Bookings == nil {
+		if len(stored.Bookings) == 0 {
+			return nil
+		}
+		changes.SetRooms(roomsOf(stored)...)
+	}
+
+	now := time.Now()
+	updated := *stored
+	applyEventChanges(&updated, changes)
+	updated.Bookings = changes.Bookings
+	if err := s.checkRooms(ctx, &updated); err != nil {
+		return err
+	}
+	if updated.IsRecurring() && len(updated.Bookings) > 0 {
+		exceptions, err := s.Repo.ListExceptions(ctx, []string{stored.ID.String()})
+		if err != nil {
+			return err
+		}
+		starts := make([]time.Time, 0, len(exceptions))
+		for _, exception := range exceptions {
+			starts = append(starts, *exception.OriginalStartTime)
+		}
+		updated.Bookings = withoutOccurrences(updated.Bookings, starts)
+	}
+	if stored.IsRecurring() {
+		for _, booking := range stored.Bookings {
+			if booking.EndTime.Before(now) {
+				updated.Bookings = append(updated.Bookings, booking)
+			}
+		}
+	}
+	changes.Bookings = updated.Bookings
+	return nil
+}
+
+// roomsOf returns the rooms event is held in.
+func roomsOf(event *entity.Event) []uuid.UUID {
+	rooms := make([]uuid.UUID, 0, len(event.Bookings))
+	for _, booking := range event.Bookings {
+		rooms = append(rooms, booking.RoomId)
+	}
+	return rooms
+}
+
+// movesBookings reports whether changes to an event change what its rooms
+// are booked for.
+func movesBookings(changes *entity.Event) bool {
+	return changes.MasjidId != "" || changes.Name != "" ||
+		!changes.StartTime.IsZero() || !changes.EndTime.IsZero() ||
+		changes.GenderRestriction != entity.NO_RESTRICTION || changes.MaxParticipants != 0 ||
+		changes.Recurrence != ""
+}
+
+// applyEventChanges copies the fields set in changes to event, as an
+// update does.
+func applyEventChanges(event, changes *entity.Event) {
+	if changes.MasjidId != "" {
+		event.MasjidId = changes.MasjidId
+	}
+	if changes.Name != "" {
+		event.Name = changes.Name
+	}
+	if changes.Description != "" {
+		event.Description = changes.Description
+	}
+	if !changes.StartTime.IsZero() {
+		event.StartTime = changes.StartTime
+	}
+	if !changes.EndTime.IsZero() {
+		event.EndTime = changes.EndTime
+	}
+	if changes.GenderRestriction != entity.NO_RESTRICTION {
+		event.GenderRestriction = changes.GenderRestriction
+	}
+	if changes.Types != nil {
+		event.SetTypes(changes.TypeNames()...)
+	}
+	if changes.IsPaid {
+		event.IsPaid = true
+	}
+	if changes.RequiresRsvp {
+		event.RequiresRsvp = true
+	}
+	if changes.MaxParticipants != 0 {
+		event.MaxParticipants = changes.MaxParticipants
+	}
+	if changes.LivestreamLink != "" {
+		event.LivestreamLink = changes.LivestreamLink
+	}
+	if changes.Recurrence != "" {
+		event.Recurrence = changes.Recurrence
+	}
+	if !changes.UpdatedAt.IsZero() {
+		event.UpdatedAt = changes.UpdatedAt
+	}
+}
+
+// pageOffset reads a page token holding the offset of a page.
+func pageOffset(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	offset, err := strconv.Atoi(token)
+	if err != nil || offset < 0 {
+		return 0, helper.ErrInvalidPageToken
+	}
+	return offset, nil
+}
+
+// compareEvents compares a and b by each key of order in turn.
+func compareEvents(a, b *entity.Event, order []entity.EventOrder) int {
+	for _, key := range order {
+		var c int
+		switch key.Field {
+		case entity.OrderByStartTime:
+			c = a.StartTime.Compare(b.StartTime)
+		case entity.OrderByEndTime:
+			c = a.EndTime.Compare(b.EndTime)
+		case entity.OrderByName:
+			c = strings.Compare(a.Name, b.Name)
+		case entity.OrderByCreateTime:
+			c = a.CreatedAt.Compare(b.CreatedAt)
+		}
+		if key.Desc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// eventLocation returns the time zone of a masjid's events, UTC when the
+// event has no masjid or it no longer exists.
+func (s *EventService) eventLocation(ctx context.Context, masjidID string) (*time.Location, error) {
+	if masjidID == "" {
+		return time.UTC, nil
+	}
+	masjid, err := s.MasjidRepo.GetByID(ctx, masjidID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return time.UTC, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	loc, _ := eventCalendar(masjid)
+	return loc, nil
 }
 
-func (s *EventService) ListEvents(ctx context.Context, pageSize int32, pageToken string) ([]*entity.Event, error) {
-	return s.Repo.ListEvents(ctx, pageSize, pageToken)
+// eventCalendar returns the time zone and Hijri offset used to date a
+// masjid's events, defaulting to UTC when the masjid has no valid time zone.
+func eventCalendar(masjid *entity.Masjid) (*time.Location, int) {
+	loc, err := masjidTimeZone(masjid)
+	if err != nil {
+		loc = time.UTC
+	}
+	return loc, masjid.HijriDayOffset()
 }
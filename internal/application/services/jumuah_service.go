@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/repository"
+	"gorm.io/gorm"
+	"time"
+)
+
+type JumuahService struct {
+	Repo       repository.JumuahRepository
+	MasjidRepo repository.MasjidRepository
+	UserRepo   repository.UserRepository
+}
+
+func NewJumuahService(repo repository.JumuahRepository, masjidRepo repository.MasjidRepository, userRepo repository.UserRepository) *JumuahService {
+	return &JumuahService{Repo: repo, MasjidRepo: masjidRepo, UserRepo: userRepo}
+}
+
+func (s *JumuahService) CreatePrayerSlot(ctx context.Context, slot *entity.PrayerSlot) (*entity.PrayerSlot, error) {
+	if _, err := s.MasjidRepo.GetByID(ctx, slot.MasjidId); err != nil {
+		return nil, err
+	}
+	if err := s.validatePrayerSlot(ctx, slot); err != nil {
+		return nil, err
+	}
+
+	slot.ID = uuid.New()
+	slot.CreatedAt = time.Now()
+	slot.UpdatedAt = time.Now()
+	return s.Repo.Create(ctx, slot)
+}
+
+// UpdatePrayerSlot replaces every field of an existing slot of the same
+// masjid.
+func (s *JumuahService) UpdatePrayerSlot(ctx context.Context, slot *entity.PrayerSlot) (*entity.PrayerSlot, error) {
+	existing, err := s.GetPrayerSlot(ctx, slot.MasjidId, slot.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validatePrayerSlot(ctx, slot); err != nil {
+		return nil, err
+	}
+
+	slot.CreatedAt = existing.CreatedAt
+	slot.UpdatedAt = time.Now()
+	return s.Repo.Update(ctx, slot)
+}
+
+// GetPrayerSlot loads a slot and hides slots of other masjids behind
+// gorm.ErrRecordNotFound.
+func (s *JumuahService) GetPrayerSlot(ctx context.Context, masjidID, id string) (*entity.PrayerSlot, error) {
+	slot, err := s.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if slot.MasjidId != masjidID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return slot, nil
+}
+
+func (s *JumuahService) DeletePrayerSlot(ctx context.Context, masjidID, id string) error {
+	if _, err := s.GetPrayerSlot(ctx, masjidID, id); err != nil {
+		return err
+	}
+	return s.Repo.Delete(ctx, id)
+}
+
+func (s *JumuahService) ListPrayerSlots(ctx context.Context, params *entity.ListPrayerSlotsQueryParams) ([]entity.PrayerSlot, error) {
+	return s.Repo.List(ctx, params)
+}
+
+// GetNextJumuah returns the masjid's next Friday with an upcoming Jumu'ah
+// and the shifts still to start on it, evaluated in the masjid's time zone.
+func (s *JumuahService) GetNextJumuah(ctx context.Context, masjidID string, now time.Time) (time.Time, []prayertimes.ScheduledSlot, error) {
+	masjid, err := s.MasjidRepo.GetByID(ctx, masjidID)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	loc, err := masjidTimeZone(masjid)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	jumuah := entity.JUMUAH
+	slots, err := s.Repo.List(ctx, &entity.ListPrayerSlotsQueryParams{MasjidId: masjidID, Type: &jumuah})
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	return prayertimes.NextJumuah(slots, now, loc)
+}
+
+func (s *JumuahService) validatePrayerSlot(ctx context.Context, slot *entity.PrayerSlot) error {
+	if _, err := time.Parse("15:04", slot.StartTime); err != nil {
+		return fmt.Errorf("%w: start time %q must be in HH:MM format", helper.ErrInvalidPrayerSlot, slot.StartTime)
+	}
+	if slot.Capacity < 0 {
+		return fmt.Errorf("%w: capacity cannot be negative", helper.ErrInvalidPrayerSlot)
+	}
+
+	switch slot.Type {
+	case entity.JUMUAH:
+		for _, d := range []string{slot.StartDate, slot.EndDate} {
+			if d == "" {
+				continue
+			}
+			if _, err := time.Parse("2006-01-02", d); err != nil {
+				return fmt.Errorf("%w: date %q must be in YYYY-MM-DD format", helper.ErrInvalidPrayerSlot, d)
+			}
+		}
+		if slot.StartDate != "" && slot.EndDate != "" && slot.EndDate < slot.StartDate {
+			return fmt.Errorf("%w: end date is before start date", helper.ErrInvalidPrayerSlot)
+		}
+		slot.Date = ""
+	case entity.EID_AL_FITR, entity.EID_AL_ADHA:
+		if _, err := time.Parse("2006-01-02", slot.Date); err != nil {
+			return fmt.Errorf("%w: eid date %q must be in YYYY-MM-DD format", helper.ErrInvalidPrayerSlot, slot.Date)
+		}
+		slot.StartDate = ""
+		slot.EndDate = ""
+	default:
+		return fmt.Errorf("%w: unknown slot type", helper.ErrInvalidPrayerSlot)
+	}
+
+	if slot.KhateebId != "" {
+		if _, err := uuid.Parse(slot.KhateebId); err != nil {
+			return fmt.Errorf("%w: invalid khateeb ID format", helper.ErrInvalidPrayerSlot)
+		}
+		if _, err := s.UserRepo.GetByID(ctx, slot.KhateebId); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: khateeb %s does not exist", helper.ErrInvalidPrayerSlot, slot.KhateebId)
+			}
+			return err
+		}
+	}
+	return nil
+}
@@ -1,25 +1,53 @@
 package services
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"github.com/google/uuid"
 	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/geo"
+	"github.com/mnadev/limestone/internal/application/domain/hijri"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+	"github.com/mnadev/limestone/internal/application/domain/timetable"
+	"github.com/mnadev/limestone/internal/application/helper"
 	"github.com/mnadev/limestone/internal/application/repository"
+	"gorm.io/gorm"
+	"time"
 )
 
 type MasjidService struct {
-	Repo repository.MasjidRepository
+	Repo    repository.MasjidRepository
+	Changes *ScheduleNotifier
 }
 
 func NewMasjidService(repo repository.MasjidRepository) *MasjidService {
-	return &MasjidService{Repo: repo}
+	return &MasjidService{Repo: repo, Changes: defaultScheduleNotifier}
 }
 
+const (
+	defaultNearbyLimit = 20
+	maxNearbyLimit     = 100
+	maxNearbyRadiusKm  = 500
+)
+
 func (r *MasjidService) CreateMasjid(ctx context.Context, masjid *entity.Masjid) (*entity.Masjid, error) {
+	if err := validateMasjidLocation(masjid); err != nil {
+		return nil, err
+	}
 	return r.Repo.Create(ctx, masjid)
 }
 
 func (r *MasjidService) UpdateMasjid(ctx context.Context, masjid *entity.Masjid) (*entity.Masjid, error) {
-	return r.Repo.Update(ctx, masjid)
+	if err := validateMasjidLocation(masjid); err != nil {
+		return nil, err
+	}
+	updated, err := r.Repo.Update(ctx, masjid)
+	if err != nil {
+		return nil, err
+	}
+	r.Changes.Notify(masjid.ID.String())
+	return updated, nil
 }
 
 func (r *MasjidService) GetMasjid(ctx context.Context, id string) (*entity.Masjid, error) {
@@ -27,9 +55,480 @@ func (r *MasjidService) GetMasjid(ctx context.Context, id string) (*entity.Masji
 }
 
 func (r *MasjidService) DeleteMasjid(ctx context.Context, id string) error {
-	return r.Repo.Delete(ctx, id)
+	if err := r.Repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.Changes.Notify(id)
+	return nil
 }
 
 func (s *MasjidService) ListMasjids(ctx context.Context, params *entity.ListMasjidsQueryParams) ([]entity.Masjid, int32, error) {
 	return s.Repo.ListMasjids(ctx, params)
 }
+
+// SearchNearbyMasjids returns the masjids within params.RadiusKm of the given
+// point, nearest first.
+func (s *MasjidService) SearchNearbyMasjids(ctx context.Context, params *entity.SearchNearbyMasjidsQueryParams) ([]entity.NearbyMasjid, error) {
+	center := geo.Coordinates{Latitude: params.Latitude, Longitude: params.Longitude}
+	if !center.Valid() {
+		return nil, geo.ErrInvalidCoordinates
+	}
+	if params.RadiusKm <= 0 || params.RadiusKm > maxNearbyRadiusKm {
+		return nil, helper.ErrInvalidSearchRadius
+	}
+	if params.Limit <= 0 {
+		params.Limit = defaultNearbyLimit
+	}
+	if params.Limit > maxNearbyLimit {
+		params.Limit = maxNearbyLimit
+	}
+	return s.Repo.SearchNearby(ctx, params)
+}
+
+// GetQibla returns the direction and distance to the Kaaba from the given
+// point.
+func (s *MasjidService) GetQibla(latitude, longitude float64) (geo.Qibla, error) {
+	point := geo.Coordinates{Latitude: latitude, Longitude: longitude}
+	if !point.Valid() {
+		return geo.Qibla{}, geo.ErrInvalidCoordinates
+	}
+	return geo.QiblaFrom(point), nil
+}
+
+// GetPrayerTimes computes the masjid's prayer times for the calendar day of
+// date using its PrayerConfig. A zero date means today in the masjid's time
+// zone.
+func (s *MasjidService) GetPrayerTimes(ctx context.Context, id string, date time.Time) (*prayertimes.Times, error) {
+	masjid, err := s.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.prayerTimesFor(ctx, masjid, date)
+}
+
+// GetDailyPrayerSchedule returns the masjid's adhan times for the day together
+// with the iqamah times its rules resolve to.
+func (s *MasjidService) GetDailyPrayerSchedule(ctx context.Context, id string, date time.Time) (*prayertimes.Times, *prayertimes.Iqamah, error) {
+	masjid, err := s.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	times, err := s.prayerTimesFor(ctx, masjid, date)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rules, err := s.Repo.ListIqamahRules(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	iqamah, err := prayertimes.ResolveIqamah(rules, times)
+	if err != nil {
+		return nil, nil, err
+	}
+	return times, iqamah, nil
+}
+
+type ScheduleEventKind int64
+
+const (
+	ScheduleSnapshot ScheduleEventKind = iota
+	ScheduleUpdated
+	ScheduleAdhan
+	ScheduleNewDay
+)
+
+// ScheduleEvent is one message of a prayer schedule stream. Times and Iqamah
+// are nil for ScheduleAdhan events, which name the Prayer whose adhan time At
+// was reached.
+type ScheduleEvent struct {
+	Kind   ScheduleEventKind
+	Times  *prayertimes.Times
+	Iqamah *prayertimes.Iqamah
+	Prayer entity.Prayer
+	At     time.Time
+}
+
+// WatchPrayerSchedule sends the masjid's schedule for today, then sends it
+// again whenever its configuration, iqamah rules or imported times change and
+// at midnight in the masjid's time zone, and sends a tick at each adhan. It
+// returns when ctx is done, send fails or the schedule can no longer be
+// computed, e.g. because the masjid was deleted.
+func (s *MasjidService) WatchPrayerSchedule(ctx context.Context, id string, send func(*ScheduleEvent) error) error {
+	changes, unsubscribe := s.Changes.Subscribe(id)
+	defer unsubscribe()
+
+	kind := ScheduleSnapshot
+	for {
+		masjid, err := s.Repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		loc, err := masjidTimeZone(masjid)
+		if err != nil {
+			return err
+		}
+		now := time.Now().In(loc)
+		times, err := s.prayerTimesFor(ctx, masjid, now)
+		if err != nil {
+			return err
+		}
+		rules, err := s.Repo.ListIqamahRules(ctx, id)
+		if err != nil {
+			return err
+		}
+		iqamah, err := prayertimes.ResolveIqamah(rules, times)
+		if err != nil {
+			return err
+		}
+		if err := send(&ScheduleEvent{Kind: kind, Times: times, Iqamah: iqamah, At: now}); err != nil {
+			return err
+		}
+
+		if kind, err = waitForSchedule(ctx, times, loc, changes, send); err != nil || ctx.Err() != nil {
+			return err
+		}
+	}
+}
+
+// waitForSchedule sends an adhan tick at each of the day's remaining adhans
+// and returns once the schedule must be sent again, saying why.
+func waitForSchedule(ctx context.Context, times *prayertimes.Times, loc *time.Location, changes <-chan struct{}, send func(*ScheduleEvent) error) (ScheduleEventKind, error) {
+	for {
+		now := time.Now().In(loc)
+		wake := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, loc)
+		prayer, adhan, ok := times.NextAdhan(now)
+		ok = ok && adhan.Before(wake)
+		if ok {
+			wake = adhan
+		}
+
+		timer := time.NewTimer(wake.Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return 0, nil
+		case <-changes:
+			timer.Stop()
+			return ScheduleUpdated, nil
+		case <-timer.C:
+		}
+
+		if !ok {
+			return ScheduleNewDay, nil
+		}
+		if err := send(&ScheduleEvent{Kind: ScheduleAdhan, Prayer: prayer, At: adhan}); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// ExportPrayerTimetable renders the masjid's adhan and iqamah times for every
+// day of the given month in the requested format, with suhoor, iftar,
+// taraweeh and qiyam on the days of Ramadan.
+func (s *MasjidService) ExportPrayerTimetable(ctx context.Context, id string, year, month int, format timetable.Format) (*timetable.File, error) {
+	if err := timetable.ValidatePeriod(year, month); err != nil {
+		return nil, err
+	}
+	masjid, err := s.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := masjidTimeZone(masjid)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := s.Repo.ListIqamahRules(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	first := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1)
+	// The day after the month is loaded too, as its night's taraweeh is
+	// prayed on the month's last evening.
+	stored, err := s.Repo.ListPrayerTimeOverrides(ctx, id, first.Format("2006-01-02"), last.AddDate(0, 0, 1).Format("2006-01-02"))
+	if err != nil {
+		return nil, err
+	}
+	overrides := make(map[string]*entity.PrayerTimeOverride, len(stored))
+	for i := range stored {
+		overrides[stored[i].Date] = &stored[i]
+	}
+
+	t := &timetable.Timetable{
+		MasjidId:   id,
+		MasjidName: masjid.Name,
+		Year:       year,
+		Month:      time.Month(month),
+	}
+	nights := map[int]map[int]*entity.RamadanNight{}
+	for date := first; !date.After(last.AddDate(0, 0, 1)); date = date.AddDate(0, 0, 1) {
+		extra := date.After(last)
+		if extra {
+			if h, _ := hijri.FromGregorian(date, masjid.HijriDayOffset()); h.Month != hijri.Ramadan {
+				break
+			}
+		}
+
+		times, err := timesFor(masjid, loc, date, overrides[date.Format("2006-01-02")])
+		if err != nil {
+			return nil, err
+		}
+		ramadan, err := s.ramadanDayOf(ctx, masjid, times, nights)
+		if err != nil {
+			return nil, err
+		}
+		if ramadan != nil && len(t.Days) > 0 {
+			eve := &t.Days[len(t.Days)-1]
+			eve.Taraweeh, eve.Qiyam = ramadan.Taraweeh, ramadan.Qiyam
+		}
+		if extra {
+			break
+		}
+
+		iqamah, err := prayertimes.ResolveIqamah(rules, times)
+		if err != nil {
+			return nil, err
+		}
+		day := timetable.Day{Times: *times, Iqamah: *iqamah}
+		if ramadan != nil {
+			day.SuhoorEnd, day.Iftar = ramadan.SuhoorEnd, ramadan.Iftar
+		}
+		t.Days = append(t.Days, day)
+	}
+	return timetable.Render(t, format)
+}
+
+// ramadanDayOf returns the Ramadan times of the fast on times.Date, or nil
+// outside Ramadan. nights caches the stored nights of each Hijri year.
+func (s *MasjidService) ramadanDayOf(ctx context.Context, masjid *entity.Masjid, times *prayertimes.Times, nights map[int]map[int]*entity.RamadanNight) (*prayertimes.RamadanDay, error) {
+	if times.Hijri.Month != hijri.Ramadan {
+		return nil, nil
+	}
+	year := times.Hijri.Year
+	if _, ok := nights[year]; !ok {
+		stored, err := s.Repo.ListRamadanNights(ctx, masjid.ID.String(), int32(year))
+		if err != nil {
+			return nil, err
+		}
+		nights[year] = make(map[int]*entity.RamadanNight, len(stored))
+		for i := range stored {
+			nights[year][int(stored[i].Night)] = &stored[i]
+		}
+	}
+	return prayertimes.NewRamadanDay(times.Hijri.Day, times, masjid.PrayerConfig.SuhoorMargin, nights[year][times.Hijri.Day])
+}
+
+// ImportPrayerTimetable validates a CSV timetable and stores its days as
+// overrides of the calculated prayer times, replacing any overrides already
+// stored for those days.
+func (s *MasjidService) ImportPrayerTimetable(ctx context.Context, masjidID string, content []byte) ([]entity.PrayerTimeOverride, error) {
+	if _, err := s.Repo.GetByID(ctx, masjidID); err != nil {
+		return nil, err
+	}
+	overrides, err := timetable.ParseCSV(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for i := range overrides {
+		overrides[i].ID = uuid.New()
+		overrides[i].MasjidId = masjidID
+		overrides[i].CreatedAt = now
+		overrides[i].UpdatedAt = now
+	}
+	if err := s.Repo.ReplacePrayerTimeOverrides(ctx, masjidID, overrides); err != nil {
+		return nil, err
+	}
+	s.Changes.Notify(masjidID)
+	return overrides, nil
+}
+
+func (s *MasjidService) CreateIqamahRule(ctx context.Context, rule *entity.IqamahRule) (*entity.IqamahRule, error) {
+	if _, err := s.Repo.GetByID(ctx, rule.MasjidId); err != nil {
+		return nil, err
+	}
+	if err := validateIqamahRule(rule); err != nil {
+		return nil, err
+	}
+
+	rule.ID = uuid.New()
+	rule.CreatedAt = time.Now()
+	rule.UpdatedAt = time.Now()
+	created, err := s.Repo.CreateIqamahRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	s.Changes.Notify(rule.MasjidId)
+	return created, nil
+}
+
+// UpdateIqamahRule replaces the prayer, type, time and date range of an
+// existing rule of the same masjid.
+func (s *MasjidService) UpdateIqamahRule(ctx context.Context, rule *entity.IqamahRule) (*entity.IqamahRule, error) {
+	existing, err := s.getIqamahRule(ctx, rule.MasjidId, rule.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	if err := validateIqamahRule(rule); err != nil {
+		return nil, err
+	}
+
+	rule.CreatedAt = existing.CreatedAt
+	rule.UpdatedAt = time.Now()
+	updated, err := s.Repo.UpdateIqamahRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	s.Changes.Notify(rule.MasjidId)
+	return updated, nil
+}
+
+func (s *MasjidService) DeleteIqamahRule(ctx context.Context, masjidID, id string) error {
+	if _, err := s.getIqamahRule(ctx, masjidID, id); err != nil {
+		return err
+	}
+	if err := s.Repo.DeleteIqamahRule(ctx, id); err != nil {
+		return err
+	}
+	s.Changes.Notify(masjidID)
+	return nil
+}
+
+func (s *MasjidService) ListIqamahRules(ctx context.Context, masjidID string) ([]entity.IqamahRule, error) {
+	return s.Repo.ListIqamahRules(ctx, masjidID)
+}
+
+// getIqamahRule loads a rule and hides rules of other masjids behind
+// gorm.ErrRecordNotFound.
+func (s *MasjidService) getIqamahRule(ctx context.Context, masjidID, id string) (*entity.IqamahRule, error) {
+	rule, err := s.Repo.GetIqamahRuleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rule.MasjidId != masjidID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return rule, nil
+}
+
+// prayerTimesFor returns the masjid's prayer times for the calendar day of
+// date, preferring a timetable imported for that day over calculation. A zero
+// date means today in the masjid's time zone.
+func (s *MasjidService) prayerTimesFor(ctx context.Context, masjid *entity.Masjid, date time.Time) (*prayertimes.Times, error) {
+	loc, err := masjidTimeZone(masjid)
+	if err != nil {
+		return nil, err
+	}
+	if date.IsZero() {
+		date = time.Now().In(loc)
+	}
+
+	day := date.Format("2006-01-02")
+	overrides, err := s.Repo.ListPrayerTimeOverrides(ctx, masjid.ID.String(), day, day)
+	if err != nil {
+		return nil, err
+	}
+	if len(overrides) > 0 {
+		return timesFor(masjid, loc, date, &overrides[0])
+	}
+	return timesFor(masjid, loc, date, nil)
+}
+
+// timesFor builds the day's times from override when it is set and calculates
+// them from the masjid's coordinates and PrayerConfig otherwise. The Hijri
+// date is left zero outside the range of the Umm al-Qura tables.
+func timesFor(masjid *entity.Masjid, loc *time.Location, date time.Time, override *entity.PrayerTimeOverride) (*prayertimes.Times, error) {
+	var (
+		times *prayertimes.Times
+		err   error
+	)
+	switch {
+	case override != nil:
+		times, err = prayertimes.FromOverride(date, loc, override)
+	case masjid.Latitude == 0 && masjid.Longitude == 0:
+		return nil, helper.ErrMasjidLocationNotSet
+	default:
+		times, err = prayertimes.Calculate(date, masjidCoordinates(masjid), loc, masjid.PrayerConfig)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	times.Hijri, _ = hijri.FromGregorian(date, masjid.HijriDayOffset())
+	return times, nil
+}
+
+const maxMinutesAfterAdhan = 180
+
+func validateIqamahRule(rule *entity.IqamahRule) error {
+	if rule.Prayer < entity.FAJR || rule.Prayer > entity.ISHA {
+		return fmt.Errorf("%w: prayer must be one of FAJR, DHUHR, ASR, MAGHRIB or ISHA", prayertimes.ErrInvalidIqamahRule)
+	}
+
+	switch rule.Type {
+	case entity.FIXED_TIME:
+		if _, err := prayertimes.ParseClock(rule.FixedTime); err != nil {
+			return err
+		}
+		rule.MinutesAfterAdhan = 0
+	case entity.MINUTES_AFTER_ADHAN:
+		if rule.MinutesAfterAdhan < 0 || rule.MinutesAfterAdhan > maxMinutesAfterAdhan {
+			return fmt.Errorf("%w: minutes after adhan must be between 0 and %d", prayertimes.ErrInvalidIqamahRule, maxMinutesAfterAdhan)
+		}
+		rule.FixedTime = ""
+	default:
+		return fmt.Errorf("%w: unknown rule type", prayertimes.ErrInvalidIqamahRule)
+	}
+
+	for _, d := range []string{rule.StartDate, rule.EndDate} {
+		if d == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", d); err != nil {
+			return fmt.Errorf("%w: date %q must be in YYYY-MM-DD format", prayertimes.ErrInvalidIqamahRule, d)
+		}
+	}
+	if rule.StartDate != "" && rule.EndDate != "" && rule.EndDate < rule.StartDate {
+		return fmt.Errorf("%w: end date is before start date", prayertimes.ErrInvalidIqamahRule)
+	}
+	return nil
+}
+
+func masjidTimeZone(masjid *entity.Masjid) (*time.Location, error) {
+	if masjid.TimeZone == "" {
+		return nil, helper.ErrMasjidLocationNotSet
+	}
+	loc, err := time.LoadLocation(masjid.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", helper.ErrInvalidTimeZone, masjid.TimeZone)
+	}
+	return loc, nil
+}
+
+// validateMasjidLocation checks the coordinates, time zone, Hijri offset and
+// suhoor margin carried by a create or update. An empty time zone is left
+// alone so partial updates keep the stored value.
+func validateMasjidLocation(masjid *entity.Masjid) error {
+	if !masjidCoordinates(masjid).Valid() {
+		return geo.ErrInvalidCoordinates
+	}
+	if err := hijri.ValidateOffset(masjid.HijriDayOffset()); err != nil {
+		return err
+	}
+	if margin := masjid.PrayerConfig.SuhoorMargin; margin < 0 || margin > prayertimes.MaxSuhoorMargin {
+		return helper.ErrInvalidSuhoorMargin
+	}
+	if masjid.TimeZone != "" {
+		if _, err := time.LoadLocation(masjid.TimeZone); err != nil {
+			return fmt.Errorf("%w: %s", helper.ErrInvalidTimeZone, masjid.TimeZone)
+		}
+	}
+	return nil
+}
+
+func masjidCoordinates(masjid *entity.Masjid) geo.Coordinates {
+	return geo.Coordinates{Latitude: masjid.Latitude, Longitude: masjid.Longitude}
+}
@@ -0,0 +1,441 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/repository"
+	"github.com/mnadev/limestone/internal/infrastructure/notification"
+)
+
+// NotificationSweepInterval is how often the notification scheduler queues
+// the reminders that have come due and sends the notifications pending.
+const NotificationSweepInterval = time.Minute
+
+// MinReminderLead and MaxReminderLead bound how long before events members
+// may ask to be reminded of them.
+const (
+	MinReminderLead = 5 * time.Minute
+	MaxReminderLead = 48 * time.Hour
+)
+
+// MaxNotificationAttempts is how many times a notification is tried before
+// it is given up on.
+const MaxNotificationAttempts = 5
+
+// notificationBatchSize bounds the pending notifications loaded at a time.
+const notificationBatchSize = 100
+
+// NotificationService tells members about the events they have RSVPed to
+// and those of the masjids they follow: reminders before they start, and
+// notices when they are rescheduled or cancelled. Notifications are queued
+// and sent by Run, so that one failing to send is retried and none is sent
+// twice.
+type NotificationService struct {
+	Repo       repository.NotificationRepository
+	MasjidRepo repository.MasjidRepository
+	UserRepo   repository.UserRepository
+	// Events lists the events coming up to remind members of.
+	Events *EventService
+	// Channels are those notifications are sent over. Nothing is queued
+	// when there are none.
+	Channels []notification.Channel
+
+	wake chan struct{}
+}
+
+func NewNotificationService(repo repository.NotificationRepository, masjidRepo repository.MasjidRepository, userRepo repository.UserRepository, events *EventService, channels []notification.Channel) *NotificationService {
+	return &NotificationService{
+		Repo:       repo,
+		MasjidRepo: masjidRepo,
+		UserRepo:   userRepo,
+		Events:     events,
+		Channels:   channels,
+		wake:       make(chan struct{}, 1),
+	}
+}
+
+// GetPreference returns a member's notification preference, the default
+// one if they have not set their own.
+func (s *NotificationService) GetPreference(ctx context.Context, userID string) (*entity.NotificationPreference, error) {
+	pref, err := s.Repo.GetPreference(ctx, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return entity.DefaultNotificationPreference(uuid.MustParse(userID)), nil
+	}
+	return pref, err
+}
+
+// UpdatePreference replaces a member's notification preference. Reminders
+// are sent from MinReminderLead to MaxReminderLead before events.
+func (s *NotificationService) UpdatePreference(ctx context.Context, pref *entity.NotificationPreference) (*entity.NotificationPreference, error) {
+	if lead := pref.ReminderLead(); lead < MinReminderLead || lead > MaxReminderLead {
+		return nil, fmt.Errorf("%w: reminders are sent %d to %d minutes before events", helper.ErrInvalidPreferences,
+			int(MinReminderLead/time.Minute), int(MaxReminderLead/time.Minute))
+	}
+	pref.UpdatedAt = time.Now()
+	return s.Repo.SavePreference(ctx, pref)
+}
+
+// FollowMasjid has a member hear about every event of a masjid. Following
+// it again returns the follow they already have.
+func (s *NotificationService) FollowMasjid(ctx context.Context, userID, masjidID string) (*entity.MasjidFollow, error) {
+	masjid, err := s.MasjidRepo.GetByID(ctx, masjidID)
+	if err != nil {
+		return nil, err
+	}
+	return s.Repo.CreateFollow(ctx, &entity.MasjidFollow{
+		UserId:    uuid.MustParse(userID),
+		MasjidId:  masjid.ID.String(),
+		CreatedAt: time.Now(),
+	})
+}
+
+func (s *NotificationService) UnfollowMasjid(ctx context.Context, userID, masjidID string) error {
+	return s.Repo.DeleteFollow(ctx, userID, masjidID)
+}
+
+// ListFollowedMasjids returns the follows of a member, oldest first.
+func (s *NotificationService) ListFollowedMasjids(ctx context.Context, userID string) ([]entity.MasjidFollow, error) {
+	return s.Repo.ListFollows(ctx, userID)
+}
+
+// RegisterPushDevice has a member receive push notifications on a device.
+// A device registered by another member before is theirs no longer.
+func (s *NotificationService) RegisterPushDevice(ctx context.Context, device *entity.PushDevice) (*entity.PushDevice, error) {
+	device.Token = strings.TrimSpace(device.Token)
+	device.Platform = strings.ToLower(strings.TrimSpace(device.Platform))
+	switch {
+	case device.Token == "" || len(device.Token) > 512:
+		return nil, fmt.Errorf("%w: token must be 1 to 512 characters", helper.ErrInvalidPushDevice)
+	case utf8.RuneCountInString(device.Platform) > 16:
+		return nil, fmt.Errorf("%w: platform must be at most 16 characters", helper.ErrInvalidPushDevice)
+	}
+	now := time.Now()
+	device.CreatedAt = now
+	device.UpdatedAt = now
+	return s.Repo.SavePushDevice(ctx, device)
+}
+
+// UnregisterPushDevice stops push notifications to one of a member's
+// devices.
+func (s *NotificationService) UnregisterPushDevice(ctx context.Context, userID, token string) error {
+	return s.Repo.DeletePushDevices(ctx, userID, token)
+}
+
+// EventRescheduled queues notices that an event or occurrence has moved
+// from before's start time to after's, unless it had ended and still has.
+func (s *NotificationService) EventRescheduled(ctx context.Context, before, after *entity.Event) error {
+	now := time.Now()
+	if len(s.Channels) == 0 || before.StartTime.Equal(after.StartTime) ||
+		(!before.EndTime.After(now) && !after.EndTime.After(now)) {
+		return nil
+	}
+	audience, err := s.audience(ctx, before)
+	if err != nil {
+		return err
+	}
+	var notices []entity.EventNotification
+	for _, pref := range audience {
+		if pref.Changes {
+			notice := entity.NewEventNotification(entity.EventRescheduled, after, pref.UserId, now)
+			notice.PreviousStartTime = &before.StartTime
+			notices = append(notices, notice)
+		}
+	}
+	return s.Queue(ctx, notices)
+}
+
+// CancellationNotices returns the notices to queue once an event or
+// occurrence is cancelled, unless it has ended. They are made beforehand,
+// since cancelling an event takes its RSVPs with it.
+func (s *NotificationService) CancellationNotices(ctx context.Context, event *entity.Event) ([]entity.EventNotification, error) {
+	now := time.Now()
+	if len(s.Channels) == 0 || !event.EndTime.After(now) {
+		return nil, nil
+	}
+	audience, err := s.audience(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	var notices []entity.EventNotification
+	for _, pref := range audience {
+		if pref.Changes {
+			notices = append(notices, entity.NewEventNotification(entity.EventCancelled, event, pref.UserId, now))
+		}
+	}
+	return notices, nil
+}
+
+// Queue queues notifications to be sent soon.
+func (s *NotificationService) Queue(ctx context.Context, notifications []entity.EventNotification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	if err := s.Repo.QueueNotifications(ctx, notifications); err != nil {
+		return err
+	}
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Run sweeps for notifications to send every NotificationSweepInterval,
+// and soon after notices are queued, until ctx is done. Only one process
+// should run it, so that no notification is sent twice.
+func (s *NotificationService) Run(ctx context.Context) {
+	ticker := time.NewTicker(NotificationSweepInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.Sweep(ctx, time.Now()); err != nil {
+			log.Printf("failed to send event notifications: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.wake:
+		}
+	}
+}
+
+// Sweep queues the reminders due by now and sends the notifications
+// pending.
+func (s *NotificationService) Sweep(ctx context.Context, now time.Time) error {
+	if len(s.Channels) == 0 {
+		return nil
+	}
+	if err := s.QueueReminders(ctx, now); err != nil {
+		return err
+	}
+	return s.SendPending(ctx, now)
+}
+
+// QueueReminders queues a reminder of each event and occurrence starting
+// within MaxReminderLead of now for the members in its audience whose
+// reminder lead has been reached. A member is reminded of an event once
+// for each time it starts at.
+func (s *NotificationService) QueueReminders(ctx context.Context, now time.Time) error {
+	params := &entity.ListEventsQueryParams{
+		StartFrom:   now,
+		StartBefore: now.Add(MaxReminderLead),
+		PageSize:    maxOccurrences,
+	}
+	for {
+		events, next, _, err := s.Events.ListEvents(ctx, params)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			audience, err := s.audience(ctx, event)
+			if err != nil {
+				return err
+			}
+			var reminders []entity.EventNotification
+			for _, pref := range audience {
+				if pref.Reminders && !event.StartTime.Add(-pref.ReminderLead()).After(now) {
+					reminders = append(reminders, entity.NewEventNotification(entity.EventReminder, event, pref.UserId, now))
+				}
+			}
+			if err := s.Repo.QueueNotifications(ctx, reminders); err != nil {
+				return err
+			}
+		}
+		if next == "" {
+			return nil
+		}
+		params.PageToken = next
+	}
+}
+
+// SendPending sends the notifications pending, oldest first, until none
+// are left or one fails to send. Those that fail are retried by later
+// sweeps up to MaxNotificationAttempts times.
+func (s *NotificationService) SendPending(ctx context.Context, now time.Time) error {
+	for {
+		pending, err := s.Repo.ListPendingNotifications(ctx, notificationBatchSize)
+		if err != nil {
+			return err
+		}
+		failed := false
+		for i := range pending {
+			n := &pending[i]
+			if err := s.send(ctx, n, now); err != nil {
+				log.Printf("failed to send notification %s: %v", n.ID, err)
+				failed = true
+				n.Attempts++
+				if n.Attempts >= MaxNotificationAttempts {
+					n.Status = entity.NotificationFailed
+				}
+			}
+			if err := s.Repo.UpdateNotification(ctx, n); err != nil {
+				return err
+			}
+		}
+		if failed || len(pending) < notificationBatchSize {
+			return nil
+		}
+	}
+}
+
+// send sends a notification over the channels its member has turned on,
+// setting its status. It is skipped if the member has turned off what it
+// is for or cannot be reached, and reminders are skipped once their event
+// has started. It fails only if no channel reached the member.
+func (s *NotificationService) send(ctx context.Context, n *entity.EventNotification, now time.Time) error {
+	pref, err := s.GetPreference(ctx, n.UserId.String())
+	if err != nil {
+		return err
+	}
+	skip := !pref.Changes
+	if n.Kind == entity.EventReminder {
+		skip = !pref.Reminders || !n.StartTime.After(now)
+	}
+	user, err := s.UserRepo.GetByID(ctx, n.UserId.String())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		skip = true
+	} else if err != nil {
+		return err
+	}
+	if skip {
+		n.Status = entity.NotificationSkipped
+		return nil
+	}
+
+	msg, err := s.message(ctx, n)
+	if err != nil {
+		return err
+	}
+	delivered := false
+	var errs []error
+	for _, channel := range s.Channels {
+		switch channel.Kind() {
+		case notification.Email:
+			if !pref.Email {
+				continue
+			}
+			msg.Email = user.Email
+		case notification.Push:
+			if !pref.Push {
+				continue
+			}
+			devices, err := s.Repo.ListPushDevices(ctx, n.UserId.String())
+			if err != nil {
+				return err
+			}
+			msg.PushTokens = msg.PushTokens[:0]
+			for _, device := range devices {
+				msg.PushTokens = append(msg.PushTokens, device.Token)
+			}
+		}
+
+		err := channel.Send(ctx, msg)
+		var unregistered *notification.UnregisteredError
+		if errors.As(err, &unregistered) {
+			if err := s.Repo.DeletePushDevices(ctx, "", unregistered.Tokens...); err != nil {
+				log.Printf("failed to forget unregistered push devices: %v", err)
+			}
+			if unregistered.Delivered {
+				err = nil
+			}
+		}
+		switch {
+		case err == nil:
+			delivered = true
+		case !errors.Is(err, notification.ErrNoAddress) && !errors.Is(err, notification.ErrUnregistered):
+			errs = append(errs, fmt.Errorf("%s: %w", channel.Kind(), err))
+		}
+	}
+	switch {
+	case delivered:
+		n.Status = entity.NotificationSent
+		n.SentAt = &now
+	case len(errs) > 0:
+		return errors.Join(errs...)
+	default:
+		n.Status = entity.NotificationSkipped
+	}
+	return nil
+}
+
+// message writes a notification out, with its times in the time zone of
+// the event's masjid.
+func (s *NotificationService) message(ctx context.Context, n *entity.EventNotification) (*notification.Message, error) {
+	place := "the masjid"
+	loc := time.UTC
+	if n.MasjidId != "" {
+		masjid, err := s.MasjidRepo.GetByID(ctx, n.MasjidId)
+		switch {
+		case err == nil:
+			place = masjid.Name
+			loc, _ = eventCalendar(masjid)
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, err
+		}
+	}
+	when := func(t time.Time) string {
+		return t.In(loc).Format("Mon 2 Jan 2006 at 15:04 MST")
+	}
+
+	msg := &notification.Message{Data: map[string]string{"event_id": n.EventId}}
+	switch n.Kind {
+	case entity.EventReminder:
+		msg.Data["kind"] = "reminder"
+		msg.Subject = "Reminder: " + n.EventName
+		msg.Body = fmt.Sprintf("%s at %s starts %s.", n.EventName, place, when(n.StartTime))
+	case entity.EventRescheduled:
+		msg.Data["kind"] = "rescheduled"
+		msg.Subject = "Rescheduled: " + n.EventName
+		if n.PreviousStartTime != nil {
+			msg.Body = fmt.Sprintf("%s at %s has moved from %s to %s.", n.EventName, place, when(*n.PreviousStartTime), when(n.StartTime))
+		} else {
+			msg.Body = fmt.Sprintf("%s at %s has moved to %s.", n.EventName, place, when(n.StartTime))
+		}
+	case entity.EventCancelled:
+		msg.Data["kind"] = "cancelled"
+		msg.Subject = "Cancelled: " + n.EventName
+		msg.Body = fmt.Sprintf("%s at %s on %s has been cancelled.", n.EventName, place, when(n.StartTime))
+	default:
+		return nil, fmt.Errorf("unknown notification kind %d", n.Kind)
+	}
+	return msg, nil
+}
+
+// audience returns the preferences of the members to tell about an event:
+// those who have RSVPed to it, or to its series, and those following its
+// masjid.
+func (s *NotificationService) audience(ctx context.Context, event *entity.Event) ([]*entity.NotificationPreference, error) {
+	rsvpEventID, _ := event.RsvpEventId()
+	userIDs, err := s.Repo.ListAudience(ctx, rsvpEventID, event.MasjidId)
+	if err != nil || len(userIDs) == 0 {
+		return nil, err
+	}
+	stored, err := s.Repo.ListPreferences(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	byUser := make(map[uuid.UUID]*entity.NotificationPreference, len(stored))
+	for i := range stored {
+		byUser[stored[i].UserId] = &stored[i]
+	}
+	prefs := make([]*entity.NotificationPreference, 0, len(userIDs))
+	for _, userID := range userIDs {
+		pref, ok := byUser[userID]
+		if !ok {
+			pref = entity.DefaultNotificationPreference(userID)
+		}
+		prefs = append(prefs, pref)
+	}
+	return prefs, nil
+}
@@ -0,0 +1,402 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/repository"
+	"github.com/mnadev/limestone/internal/infrastructure/payment"
+)
+
+// OrderHoldTime is how long a pending order holds its tickets while the
+// buyer pays. Stripe checkouts last at least 30 minutes.
+const OrderHoldTime = 35 * time.Minute
+
+// DefaultOrdersPageSize is the number of orders listed at a time when no
+// page size is given.
+const DefaultOrdersPageSize = 50
+
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+type OrderService struct {
+	Repo   repository.OrderRepository
+	Events *EventService
+	// Payments takes payment for orders. Only free tickets are sold when it
+	// is nil.
+	Payments payment.Provider
+	// ReturnURL is the page buyers are sent back to from checkout, with
+	// order_id and checkout, "success" or "cancel", added to its query.
+	ReturnURL string
+}
+
+func NewOrderService(repo repository.OrderRepository, events *EventService, payments payment.Provider, returnURL string) *OrderService {
+	return &OrderService{Repo: repo, Events: events, Payments: payments, ReturnURL: returnURL}
+}
+
+// SetTicketTypes makes types the ticket types of an event, keeping those
+// given with their IDs and adding those without. Ticket types that have
+// been ordered cannot be removed, change currency or have their quota
+// lowered below the number ordered. It returns the event with its ticket
+// types.
+func (s *OrderService) SetTicketTypes(ctx context.Context, eventID string, types []entity.TicketType) (*entity.Event, error) {
+	event, err := s.Events.Repo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.RecurringEventId != nil {
+		return nil, fmt.Errorf("%w: set them on the recurring event", helper.ErrInvalidTicketTypes)
+	}
+	now := time.Now()
+	sold, err := s.Repo.CountTicketsSold(ctx, eventID, now)
+	if err != nil {
+		return nil, err
+	}
+	existing := map[uuid.UUID]entity.TicketType{}
+	for _, t := range event.TicketTypes {
+		existing[t.ID] = t
+	}
+
+	names := map[string]bool{}
+	for i := range types {
+		t := &types[i]
+		t.Name = strings.TrimSpace(t.Name)
+		t.Currency = strings.ToUpper(strings.TrimSpace(t.Currency))
+		switch {
+		case t.Name == "" || names[strings.ToLower(t.Name)]:
+			return nil, fmt.Errorf("%w: names must be given and distinct", helper.ErrInvalidTicketTypes)
+		case t.Price < 0 || t.Quota < 0:
+			return nil, fmt.Errorf("%w: prices and quotas must not be negative", helper.ErrInvalidTicketTypes)
+		case !currencyCode.MatchString(t.Currency):
+			return nil, fmt.Errorf("%w: currency must be an ISO 4217 code", helper.ErrInvalidTicketTypes)
+		case t.Currency != types[0].Currency:
+			return nil, fmt.Errorf("%w: an event's ticket types must share a currency", helper.ErrInvalidTicketTypes)
+		}
+		names[strings.ToLower(t.Name)] = true
+		t.EventId = event.ID
+		t.UpdatedAt = now
+		if t.ID == uuid.Nil {
+			t.ID = uuid.New()
+			t.CreatedAt = now
+			continue
+		}
+		old, ok := existing[t.ID]
+		if !ok {
+			return nil, fmt.Errorf("%w: the event has no ticket type %s", helper.ErrInvalidTicketTypes, t.ID)
+		}
+		if sold[t.ID] > 0 && (t.Currency != old.Currency || t.Quota > 0 && int64(t.Quota) < sold[t.ID]) {
+			return nil, helper.ErrTicketTypeInUse
+		}
+		t.CreatedAt = old.CreatedAt
+		delete(existing, t.ID)
+	}
+	for id := range existing {
+		if sold[id] > 0 {
+			return nil, helper.ErrTicketTypeInUse
+		}
+	}
+
+	if _, err := s.Repo.ReplaceTicketTypes(ctx, eventID, types); err != nil {
+		return nil, err
+	}
+	event, err = s.Events.GetById(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return event, s.SetTicketsSold(ctx, event)
+}
+
+// SetTicketsSold counts the tickets sold of each of the events' ticket
+// types. Occurrences count those of their series.
+func (s *OrderService) SetTicketsSold(ctx context.Context, events ...*entity.Event) error {
+	now := time.Now()
+	for _, event := range events {
+		if len(event.TicketTypes) == 0 {
+			continue
+		}
+		rsvpEventID, _ := event.RsvpEventId()
+		sold, err := s.Repo.CountTicketsSold(ctx, rsvpEventID.String(), now)
+		if err != nil {
+			return err
+		}
+		for i := range event.TicketTypes {
+			event.TicketTypes[i].Sold = sold[event.TicketTypes[i].ID]
+		}
+	}
+	return nil
+}
+
+// CreateOrder orders tickets for a user, holding them for OrderHoldTime
+// while the user pays at the order's checkout URL. Tickets of an
+// occurrence of a recurring event are for the series. A free order is
+// paid at once. The user's gender must be one the event admits.
+func (s *OrderService) CreateOrder(ctx context.Context, eventID, userID string, items []entity.OrderItem) (*entity.Order, error) {
+	event, err := s.Events.GetById(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if rsvpEventID, _ := event.RsvpEventId(); rsvpEventID != event.ID {
+		if event, err = s.Events.Repo.GetByID(ctx, rsvpEventID.String()); err != nil {
+			return nil, err
+		}
+	}
+	if len(event.TicketTypes) == 0 {
+		return nil, helper.ErrNoTicketTypes
+	}
+	now := time.Now()
+	user, err := s.Events.checkAttendee(ctx, event, userID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	order := &entity.Order{
+		ID:        uuid.New(),
+		EventId:   event.ID,
+		UserId:    user.ID,
+		Status:    entity.OrderPending,
+		ExpiresAt: now.Add(OrderHoldTime),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	types := map[uuid.UUID]entity.TicketType{}
+	for _, t := range event.TicketTypes {
+		types[t.ID] = t
+	}
+	if len(items) == 0 {
+		return nil, helper.ErrInvalidOrder
+	}
+	for _, item := range items {
+		t, ok := types[item.TicketTypeId]
+		if !ok || item.Quantity <= 0 {
+			return nil, helper.ErrInvalidOrder
+		}
+		delete(types, t.ID)
+		order.Items = append(order.Items, entity.OrderItem{
+			ID:           uuid.New(),
+			OrderId:      order.ID,
+			TicketTypeId: t.ID,
+			Name:         t.Name,
+			Quantity:     item.Quantity,
+			UnitPrice:    t.Price,
+		})
+		order.Total += t.Price * int64(item.Quantity)
+		order.Currency = t.Currency
+	}
+	if order.Total > 0 {
+		if s.Payments == nil {
+			return nil, helper.ErrPaymentsNotConfigured
+		}
+		order.Provider = s.Payments.Name()
+	}
+
+	if _, err := s.Repo.CreateOrder(ctx, order); err != nil {
+		return nil, err
+	}
+	if order.Total == 0 {
+		paid, err := s.Repo.PayOrder(ctx, order.ID.String(), "", now)
+		if err != nil || !paid.Unfilled() {
+			return paid, err
+		}
+		if _, err := s.Repo.CompleteRefund(ctx, order.ID.String()); err != nil {
+			return nil, err
+		}
+		return nil, helper.ErrTicketsSoldOut
+	}
+	checkout, err := s.Payments.CreateCheckout(ctx, s.checkoutRequest(order))
+	if err != nil {
+		// Release the tickets held for the order.
+		if _, cancelErr := s.Repo.CancelOrder(ctx, order.ID.String()); cancelErr != nil {
+			return nil, errors.Join(err, cancelErr)
+		}
+		return nil, err
+	}
+	order.CheckoutId = checkout.ID
+	order.CheckoutURL = checkout.URL
+	order.UpdatedAt = time.Now()
+	if err := s.Repo.SaveCheckout(ctx, order); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// GetOrder returns an order of the user's, or any order for organisers.
+func (s *OrderService) GetOrder(ctx context.Context, orderID, userID string, organiser bool) (*entity.Order, error) {
+	order, err := s.Repo.GetOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if !organiser && order.UserId.String() != userID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return order, nil
+}
+
+// ListOrders returns a page of the orders for an event or, when eventID is
+// empty, of a user, newest first, and the token of the next page, which is
+// empty on the last. The page token is the offset of the page.
+func (s *OrderService) ListOrders(ctx context.Context, eventID, userID string, pageSize int, pageToken string) ([]entity.Order, string, error) {
+	offset, err := pageOffset(pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultOrdersPageSize
+	}
+	orders, err := s.Repo.ListOrders(ctx, eventID, userID, offset, pageSize+1)
+	if err != nil {
+		return nil, "", err
+	}
+	next := ""
+	if len(orders) > pageSize {
+		orders = orders[:pageSize]
+		next = strconv.Itoa(offset + pageSize)
+	}
+	return orders, next, nil
+}
+
+// CancelOrder cancels an order of the user's, or any order for organisers.
+// A pending order's checkout is ended. A paid order is refunded in full,
+// cancelling the user's RSVP unless they have another paid order for the
+// event; buyers may do so until the event starts, organisers at any time.
+// Cancelling an order already cancelled or refunded returns it unchanged.
+func (s *OrderService) CancelOrder(ctx context.Context, orderID, userID string, organiser bool) (*entity.Order, error) {
+	order, err := s.GetOrder(ctx, orderID, userID, organiser)
+	if err != nil {
+		return nil, err
+	}
+
+	switch order.Status {
+	case entity.OrderPending:
+		if order.CheckoutId != "" {
+			if err := s.provider(order); err != nil {
+				return nil, err
+			}
+			if err := s.Payments.ExpireCheckout(ctx, order.CheckoutId); err != nil {
+				return nil, err
+			}
+		}
+		return s.Repo.CancelOrder(ctx, orderID)
+	case entity.OrderPaid:
+		if !organiser {
+			event, err := s.Events.Repo.GetByID(ctx, order.EventId.String())
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, err
+			}
+			if err == nil && !event.StartTime.After(time.Now()) {
+				return nil, helper.ErrOrderNotCancellable
+			}
+		}
+		if order.Total == 0 {
+			return s.Repo.CompleteRefund(ctx, orderID)
+		}
+		if err := s.provider(order); err != nil {
+			return nil, err
+		}
+		if err := s.Payments.Refund(ctx, order.PaymentId, order.Total, "refund-"+order.ID.String()); err != nil {
+			return nil, err
+		}
+		return s.Repo.RefundOrder(ctx, orderID)
+	}
+	return order, nil
+}
+
+// HandlePaymentEvent applies an event from the payment provider: a
+// completed checkout pays its order, even one whose hold has run out, an
+// expired one cancels it and a full refund completes its refund. A payment
+// made once the order's tickets or place have gone is refunded. Events
+// for orders the service does not know, such as other sales on the same
+// account, are ignored, as are partial refunds.
+func (s *OrderService) HandlePaymentEvent(ctx context.Context, event *payment.Event) error {
+	if s.Payments == nil {
+		return helper.ErrPaymentsNotConfigured
+	}
+	order, err := s.paymentEventOrder(ctx, event)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	switch event.Type {
+	case payment.CheckoutCompleted:
+		order, err = s.Repo.PayOrder(ctx, order.ID.String(), event.PaymentID, time.Now())
+		if err == nil && order.Unfilled() {
+			err = s.Payments.Refund(ctx, order.PaymentId, order.Total, "refund-"+order.ID.String())
+		}
+	case payment.CheckoutExpired:
+		_, err = s.Repo.CancelOrder(ctx, order.ID.String())
+	case payment.PaymentRefunded:
+		if event.Amount >= order.Total {
+			_, err = s.Repo.CompleteRefund(ctx, order.ID.String())
+		}
+	}
+	return err
+}
+
+// paymentEventOrder finds the order a payment event is about, by the order
+// ID the provider was given when there is one.
+func (s *OrderService) paymentEventOrder(ctx context.Context, event *payment.Event) (*entity.Order, error) {
+	provider := s.Payments.Name()
+	if _, err := uuid.Parse(event.OrderID); err == nil {
+		order, err := s.Repo.GetOrder(ctx, event.OrderID)
+		if err != nil {
+			return nil, err
+		}
+		if order.Provider != provider {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return order, nil
+	}
+	switch event.Type {
+	case payment.CheckoutCompleted, payment.CheckoutExpired:
+		return s.Repo.GetOrderByCheckout(ctx, provider, event.CheckoutID)
+	case payment.PaymentRefunded:
+		return s.Repo.GetOrderByPayment(ctx, provider, event.PaymentID)
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// provider checks that the order was paid through the configured provider.
+func (s *OrderService) provider(order *entity.Order) error {
+	if s.Payments == nil || s.Payments.Name() != order.Provider {
+		return helper.ErrPaymentsNotConfigured
+	}
+	return nil
+}
+
+func (s *OrderService) checkoutRequest(order *entity.Order) *payment.CheckoutRequest {
+	req := &payment.CheckoutRequest{
+		OrderID:    order.ID.String(),
+		Currency:   order.Currency,
+		SuccessURL: s.returnURL(order, "success"),
+		CancelURL:  s.returnURL(order, "cancel"),
+		ExpiresAt:  order.ExpiresAt,
+	}
+	for _, item := range order.Items {
+		req.Items = append(req.Items, payment.LineItem{Name: item.Name, UnitAmount: item.UnitPrice, Quantity: item.Quantity})
+	}
+	return req
+}
+
+func (s *OrderService) returnURL(order *entity.Order, result string) string {
+	u, err := url.Parse(s.ReturnURL)
+	if err != nil || s.ReturnURL == "" {
+		return s.ReturnURL
+	}
+	query := u.Query()
+	query.Set("order_id", order.ID.String())
+	query.Set("checkout", result)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
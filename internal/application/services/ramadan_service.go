@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/hijri"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+	"github.com/mnadev/limestone/internal/application/repository"
+	"gorm.io/gorm"
+	"time"
+)
+
+type RamadanService struct {
+	MasjidRepo repository.MasjidRepository
+	Events     *EventService
+	UserRepo   repository.UserRepository
+}
+
+func NewRamadanService(masjidRepo repository.MasjidRepository, events *EventService, userRepo repository.UserRepository) *RamadanService {
+	return &RamadanService{MasjidRepo: masjidRepo, Events: events, UserRepo: userRepo}
+}
+
+const defaultIftarDuration = 90 * time.Minute
+
+// GetRamadanSchedule returns the suhoor end, iftar, taraweeh and qiyam of
+// every day of Ramadan in hijriYear as observed by the masjid.
+func (s *RamadanService) GetRamadanSchedule(ctx context.Context, masjidID string, hijriYear int) ([]prayertimes.RamadanDay, error) {
+	masjid, err := s.MasjidRepo.GetByID(ctx, masjidID)
+	if err != nil {
+		return nil, err
+	}
+	return ramadanSchedule(ctx, s.MasjidRepo, masjid, hijriYear)
+}
+
+// SetRamadanNights replaces the taraweeh and qiyam schedule of the masjid's
+// Ramadan in hijriYear and returns the resulting schedule.
+func (s *RamadanService) SetRamadanNights(ctx context.Context, masjidID string, hijriYear int, nights []entity.RamadanNight) ([]prayertimes.RamadanDay, error) {
+	masjid, err := s.MasjidRepo.GetByID(ctx, masjidID)
+	if err != nil {
+		return nil, err
+	}
+	length, err := hijri.MonthLength(hijriYear, hijri.Ramadan)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[int32]bool{}
+	now := time.Now()
+	for i := range nights {
+		night := &nights[i]
+		if night.Night < 1 || int(night.Night) > length {
+			return nil, fmt.Errorf("%w: night must be between 1 and %d", prayertimes.ErrInvalidRamadanNight, length)
+		}
+		if seen[night.Night] {
+			return nil, fmt.Errorf("%w: night %d is listed more than once", prayertimes.ErrInvalidRamadanNight, night.Night)
+		}
+		seen[night.Night] = true
+		if err := s.validateImam(ctx, night.ImamId); err != nil {
+			return nil, err
+		}
+
+		night.ID = uuid.New()
+		night.MasjidId = masjidID
+		night.HijriYear = int32(hijriYear)
+		night.CreatedAt = now
+		night.UpdatedAt = now
+	}
+
+	// Building the schedule before storing the nights rejects malformed or
+	// out of order times without touching the stored ones.
+	if _, err := buildRamadanSchedule(ctx, s.MasjidRepo, masjid, hijriYear, nights); err != nil {
+		return nil, err
+	}
+	if err := s.MasjidRepo.ReplaceRamadanNights(ctx, masjidID, int32(hijriYear), nights); err != nil {
+		return nil, err
+	}
+	return buildRamadanSchedule(ctx, s.MasjidRepo, masjid, hijriYear, nights)
+}
+
+// CreateIftarEvents creates an RSVP event at Maghrib for every day of the
+// masjid's Ramadan in hijriYear that does not have one yet. template supplies
+// the description, capacity and rooms; a zero duration defaults to 90
+// minutes. The events are created as by EventService.Create, so their rooms
+// are checked and booked, and each is keyed by its day so that it is not
+// created again however it has been edited since.
+func (s *RamadanService) CreateIftarEvents(ctx context.Context, masjidID string, hijriYear int, template *entity.Event, duration time.Duration) ([]*entity.Event, error) {
+	masjid, err := s.MasjidRepo.GetByID(ctx, masjidID)
+	if err != nil {
+		return nil, err
+	}
+	if duration <= 0 {
+		duration = defaultIftarDuration
+	}
+	days, err := ramadanSchedule(ctx, s.MasjidRepo, masjid, hijriYear)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, len(days))
+	for i, day := range days {
+		keys[i] = iftarScheduleKey(masjidID, day)
+	}
+	existing, err := s.Events.Repo.ListByScheduleKeys(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	taken := map[string]bool{}
+	for _, event := range existing {
+		taken[*event.ScheduleKey] = true
+	}
+
+	var created []*entity.Event
+	for i, day := range days {
+		if taken[keys[i]] {
+			continue
+		}
+		event := &entity.Event{
+			ID:                uuid.New(),
+			MasjidId:          masjidID,
+			Name:              iftarEventName(day.Times.Hijri),
+			Description:       template.Description,
+			StartTime:         day.Iftar,
+			EndTime:           day.Iftar.Add(duration),
+			GenderRestriction: template.GenderRestriction,
+			RequiresRsvp:      true,
+			MaxParticipants:   template.MaxParticipants,
+			CreatedAt:         time.Now(),
+			UpdatedAt:         time.Now(),
+			ScheduleKey:       &keys[i],
+		}
+		event.SetTypes("COMMUNITY")
+		event.SetRooms(roomsOf(template)...)
+		event, err = s.Events.Create(ctx, event)
+		if err != nil {
+			return created, err
+		}
+		created = append(created, event)
+	}
+	return created, nil
+}
+
+func (s *RamadanService) validateImam(ctx context.Context, imamID string) error {
+	if imamID == "" {
+		return nil
+	}
+	if _, err := uuid.Parse(imamID); err != nil {
+		return fmt.Errorf("%w: invalid imam ID format", prayertimes.ErrInvalidRamadanNight)
+	}
+	if _, err := s.UserRepo.GetByID(ctx, imamID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: imam %s does not exist", prayertimes.ErrInvalidRamadanNight, imamID)
+		}
+		return err
+	}
+	return nil
+}
+
+func iftarEventName(day hijri.Date) string {
+	return "Iftar, " + day.Long()
+}
+
+// iftarScheduleKey is the schedule key of the masjid's iftar event on day.
+func iftarScheduleKey(masjidID string, day prayertimes.RamadanDay) string {
+	return masjidID + "/" + day.Times.Date.Format("2006-01-02") + "/iftar"
+}
+
+// ramadanSchedule builds the masjid's Ramadan of hijriYear from its prayer
+// times, imported overrides and stored nights.
+func ramadanSchedule(ctx context.Context, repo repository.MasjidRepository, masjid *entity.Masjid, hijriYear int) ([]prayertimes.RamadanDay, error) {
+	nights, err := repo.ListRamadanNights(ctx, masjid.ID.String(), int32(hijriYear))
+	if err != nil {
+		return nil, err
+	}
+	return buildRamadanSchedule(ctx, repo, masjid, hijriYear, nights)
+}
+
+func buildRamadanSchedule(ctx context.Context, repo repository.MasjidRepository, masjid *entity.Masjid, hijriYear int, nights []entity.RamadanNight) ([]prayertimes.RamadanDay, error) {
+	loc, err := masjidTimeZone(masjid)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := hijri.MonthRange(hijriYear, hijri.Ramadan, masjid.HijriDayOffset())
+	if err != nil {
+		return nil, err
+	}
+
+	last := end.AddDate(0, 0, -1)
+	stored, err := repo.ListPrayerTimeOverrides(ctx, masjid.ID.String(), start.Format("2006-01-02"), last.Format("2006-01-02"))
+	if err != nil {
+		return nil, err
+	}
+	overrides := make(map[string]*entity.PrayerTimeOverride, len(stored))
+	for i := range stored {
+		overrides[stored[i].Date] = &stored[i]
+	}
+	byNight := make(map[int]*entity.RamadanNight, len(nights))
+	for i := range nights {
+		byNight[int(nights[i].Night)] = &nights[i]
+	}
+
+	var days []prayertimes.RamadanDay
+	for date, n := start, 1; date.Before(end); date, n = date.AddDate(0, 0, 1), n+1 {
+		times, err := timesFor(masjid, loc, date, overrides[date.Format("2006-01-02")])
+		if err != nil {
+			return nil, err
+		}
+		day, err := prayertimes.NewRamadanDay(n, times, masjid.PrayerConfig.SuhoorMargin, byNight[n])
+		if err != nil {
+			return nil, err
+		}
+		days = append(days, *day)
+	}
+	return days, nil
+}
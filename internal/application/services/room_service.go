@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/repository"
+)
+
+// MaxBookingLength bounds the length of a member's room booking.
+const MaxBookingLength = 24 * time.Hour
+
+// DefaultAvailabilityWindow is the window GetRoomAvailability covers when
+// no end is given.
+const DefaultAvailabilityWindow = 7 * 24 * time.Hour
+
+type RoomService struct {
+	Repo       repository.RoomRepository
+	MasjidRepo repository.MasjidRepository
+}
+
+func NewRoomService(repo repository.RoomRepository, masjidRepo repository.MasjidRepository) *RoomService {
+	return &RoomService{Repo: repo, MasjidRepo: masjidRepo}
+}
+
+func (s *RoomService) CreateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error) {
+	if _, err := s.MasjidRepo.GetByID(ctx, room.MasjidId); err != nil {
+		return nil, err
+	}
+	if err := validateRoom(room); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	room.ID = uuid.New()
+	room.CreatedAt = now
+	room.UpdatedAt = now
+	return s.Repo.CreateRoom(ctx, room)
+}
+
+// UpdateRoom replaces the name, description, capacity and designation of a
+// room. Existing bookings are kept.
+func (s *RoomService) UpdateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error) {
+	existing, err := s.Repo.GetRoom(ctx, room.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRoom(room); err != nil {
+		return nil, err
+	}
+
+	room.MasjidId = existing.MasjidId
+	room.CreatedAt = existing.CreatedAt
+	room.UpdatedAt = time.Now()
+	return s.Repo.UpdateRoom(ctx, room)
+}
+
+func (s *RoomService) GetRoom(ctx context.Context, id string) (*entity.Room, error) {
+	return s.Repo.GetRoom(ctx, id)
+}
+
+// DeleteRoom deletes a room with its past bookings. Rooms booked from now
+// on are kept until their bookings are cancelled.
+func (s *RoomService) DeleteRoom(ctx context.Context, id string) error {
+	return s.Repo.DeleteRoom(ctx, id, time.Now())
+}
+
+func (s *RoomService) ListRooms(ctx context.Context, masjidID string) ([]entity.Room, error) {
+	if _, err := s.MasjidRepo.GetByID(ctx, masjidID); err != nil {
+		return nil, err
+	}
+	return s.Repo.ListRooms(ctx, masjidID)
+}
+
+// BookRoom books a room for a member, for at most MaxBookingLength from a
+// start in the future. Rooms already booked at the time are rejected with
+// helper.ErrRoomUnavailable.
+func (s *RoomService) BookRoom(ctx context.Context, booking *entity.RoomBooking, userID string) (*entity.RoomBooking, error) {
+	room, err := s.Repo.GetRoom(ctx, booking.RoomId.String())
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	booking.Purpose = strings.TrimSpace(booking.Purpose)
+	switch {
+	case booking.Purpose == "" || utf8.RuneCountInString(booking.Purpose) > 128:
+		return nil, fmt.Errorf("%w: purpose must be 1 to 128 characters", helper.ErrInvalidBooking)
+	case !booking.StartTime.After(now):
+		return nil, fmt.Errorf("%w: bookings must start in the future", helper.ErrInvalidBooking)
+	case !booking.EndTime.After(booking.StartTime):
+		return nil, fmt.Errorf("%w: end time must be after start time", helper.ErrInvalidBooking)
+	case booking.EndTime.Sub(booking.StartTime) > MaxBookingLength:
+		return nil, fmt.Errorf("%w: bookings last at most %v", helper.ErrInvalidBooking, MaxBookingLength)
+	case booking.Guests < 0 || booking.Guests > room.Capacity:
+		return nil, fmt.Errorf("%w: %s holds %d people", helper.ErrInvalidBooking, room.Name, room.Capacity)
+	}
+
+	user := uuid.MustParse(userID)
+	booking.ID = uuid.New()
+	booking.EventId = nil
+	booking.UserId = &user
+	booking.CreatedAt = now
+	booking.UpdatedAt = now
+	return s.Repo.CreateBooking(ctx, booking)
+}
+
+// CancelBooking cancels a booking. Members may cancel their own bookings
+// until they start; coordinators may cancel any booking, including those
+// of events, at any time. Bookings of other members are not found.
+func (s *RoomService) CancelBooking(ctx context.Context, id, userID string, coordinator bool) error {
+	booking, err := s.Repo.GetBooking(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !coordinator {
+		if booking.UserId == nil || booking.UserId.String() != userID {
+			return gorm.ErrRecordNotFound
+		}
+		if !booking.StartTime.After(time.Now()) {
+			return helper.ErrBookingStarted
+		}
+	}
+	return s.Repo.DeleteBooking(ctx, id)
+}
+
+// ListMyBookings returns a member's bookings that have not ended, by start
+// time.
+func (s *RoomService) ListMyBookings(ctx context.Context, userID string) ([]entity.RoomBooking, error) {
+	return s.Repo.ListUserBookings(ctx, userID, time.Now())
+}
+
+// GetRoomAvailability returns the bookings of a room overlapping a window
+// and the times in it the room is free. The window defaults to
+// DefaultAvailabilityWindow from now and may be at most
+// MaxRecurrenceWindow long.
+func (s *RoomService) GetRoomAvailability(ctx context.Context, roomID string, from, to time.Time) (*entity.RoomAvailability, error) {
+	if from.IsZero() {
+		from = time.Now()
+	}
+	if to.IsZero() {
+		to = from.Add(DefaultAvailabilityWindow)
+	}
+	if !to.After(from) || to.Sub(from) > MaxRecurrenceWindow {
+		return nil, helper.ErrInvalidTimeWindow
+	}
+	room, err := s.Repo.GetRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	bookings, err := s.Repo.ListBookings(ctx, roomID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.RoomAvailability{
+		Room:     room,
+		From:     from,
+		To:       to,
+		Bookings: bookings,
+		Free:     freeTimes(bookings, from, to),
+	}, nil
+}
+
+// freeTimes returns the times from from up to to that none of bookings,
+// ordered by start time, hold.
+func freeTimes(bookings []entity.RoomBooking, from, to time.Time) []entity.TimeRange {
+	var free []entity.TimeRange
+	next := from
+	for _, booking := range bookings {
+		if booking.StartTime.After(next) {
+			free = append(free, entity.TimeRange{Start: next, End: booking.StartTime})
+		}
+		if booking.EndTime.After(next) {
+			next = booking.EndTime
+		}
+	}
+	if to.After(next) {
+		free = append(free, entity.TimeRange{Start: next, End: to})
+	}
+	return free
+}
+
+func validateRoom(room *entity.Room) error {
+	room.Name = strings.TrimSpace(room.Name)
+	switch {
+	case room.Name == "" || utf8.RuneCountInString(room.Name) > 64:
+		return fmt.Errorf("%w: name must be 1 to 64 characters", helper.ErrInvalidRoom)
+	case room.Capacity <= 0:
+		return fmt.Errorf("%w: capacity must be positive", helper.ErrInvalidRoom)
+	case room.Designation < entity.NO_RESTRICTION || room.Designation > entity.FEMALE_ONLY:
+		return fmt.Errorf("%w: unknown designation", helper.ErrInvalidRoom)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package services
+
+import "sync"
+
+// ScheduleNotifier tells watchers of a masjid's prayer schedule that its
+// configuration, iqamah rules or imported times have changed. Notifications
+// are coalesced: a watcher that has not yet handled the previous one receives
+// a single signal for several changes.
+//
+// Notifications stay within the process, so every service instance that
+// changes schedules must share one notifier with the instances serving the
+// watchers.
+type ScheduleNotifier struct {
+	mu   sync.Mutex
+	subs map[string]map[chan struct{}]struct{}
+}
+
+func NewScheduleNotifier() *ScheduleNotifier {
+	return &ScheduleNotifier{subs: map[string]map[chan struct{}]struct{}{}}
+}
+
+// defaultScheduleNotifier is shared by every MasjidService of the process so
+// that a change made through the REST gateway reaches gRPC watchers.
+var defaultScheduleNotifier = NewScheduleNotifier()
+
+// Subscribe returns a channel signalled after each change to the masjid's
+// schedule and a function that ends the subscription.
+func (n *ScheduleNotifier) Subscribe(masjidID string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	n.mu.Lock()
+	if n.subs[masjidID] == nil {
+		n.subs[masjidID] = map[chan struct{}]struct{}{}
+	}
+	n.subs[masjidID][ch] = struct{}{}
+	n.mu.Unlock()
+
+	return ch, func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		delete(n.subs[masjidID], ch)
+		if len(n.subs[masjidID]) == 0 {
+			delete(n.subs, masjidID)
+		}
+	}
+}
+
+// Notify signals every watcher of the masjid without blocking.
+func (n *ScheduleNotifier) Notify(masjidID string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for ch := range n.subs[masjidID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/repository"
+)
+
+// MaxShiftLength bounds the length of a volunteer shift, and so the
+// minutes that can be recorded for one.
+const MaxShiftLength = 24 * time.Hour
+
+// DefaultMyShiftsWindow is how far ahead ListMyShifts looks when no end is
+// given.
+const DefaultMyShiftsWindow = 365 * 24 * time.Hour
+
+type VolunteerService struct {
+	Repo       repository.VolunteerRepository
+	EventRepo  repository.EventRepository
+	MasjidRepo repository.MasjidRepository
+}
+
+func NewVolunteerService(repo repository.VolunteerRepository, eventRepo repository.EventRepository, masjidRepo repository.MasjidRepository) *VolunteerService {
+	return &VolunteerService{Repo: repo, EventRepo: eventRepo, MasjidRepo: masjidRepo}
+}
+
+// CreateShift adds a shift to an event. A recurring event has no single
+// set of volunteers, so shifts are added to one of its occurrences once it
+// has been edited on its own.
+func (s *VolunteerService) CreateShift(ctx context.Context, shift *entity.VolunteerShift) (*entity.VolunteerShift, error) {
+	event, err := s.EventRepo.GetByID(ctx, shift.EventId.String())
+	if err != nil {
+		return nil, err
+	}
+	if event.Recurrence != "" {
+		return nil, helper.ErrShiftOnRecurringEvent
+	}
+	if err := validateShift(shift); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	shift.ID = uuid.New()
+	shift.MasjidId = event.MasjidId
+	shift.CreatedAt = now
+	shift.UpdatedAt = now
+	return s.Repo.CreateShift(ctx, shift)
+}
+
+// UpdateShift replaces the role, description, times and headcount of a
+// shift. The headcount cannot drop below the volunteers who have claimed
+// it.
+func (s *VolunteerService) UpdateShift(ctx context.Context, shift *entity.VolunteerShift) (*entity.VolunteerShift, error) {
+	existing, err := s.Repo.GetShift(ctx, shift.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	if err := validateShift(shift); err != nil {
+		return nil, err
+	}
+
+	shift.EventId = existing.EventId
+	shift.MasjidId = existing.MasjidId
+	shift.CreatedAt = existing.CreatedAt
+	shift.UpdatedAt = time.Now()
+	return s.Repo.UpdateShift(ctx, shift)
+}
+
+func (s *VolunteerService) GetShift(ctx context.Context, id string) (*entity.VolunteerShift, error) {
+	return s.Repo.GetShift(ctx, id)
+}
+
+// DeleteShift deletes a shift and its signups. Shifts with hours recorded
+// are kept for the volunteers' totals.
+func (s *VolunteerService) DeleteShift(ctx context.Context, id string) error {
+	return s.Repo.DeleteShift(ctx, id)
+}
+
+// ListShifts returns an event's shifts by start time. A roster also has the
+// volunteers holding places on each.
+func (s *VolunteerService) ListShifts(ctx context.Context, eventID string, roster bool) ([]entity.VolunteerShift, error) {
+	if _, err := s.EventRepo.GetByID(ctx, eventID); err != nil {
+		return nil, err
+	}
+	return s.Repo.ListShifts(ctx, eventID, roster)
+}
+
+// ClaimShift gives a volunteer a place on a shift that has not started.
+// Claiming again returns the place the volunteer already holds.
+func (s *VolunteerService) ClaimShift(ctx context.Context, shiftID, userID string) (*entity.ShiftSignup, error) {
+	shift, err := s.Repo.GetShift(ctx, shiftID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if !shift.StartTime.After(now) {
+		return nil, helper.ErrShiftStarted
+	}
+	return s.Repo.ClaimShift(ctx, shiftID, userID, now)
+}
+
+// ReleaseShift gives up a volunteer's place on a shift. Volunteers may
+// release their places until the shift starts, coordinators at any time.
+func (s *VolunteerService) ReleaseShift(ctx context.Context, shiftID, userID string, coordinator bool) (*entity.ShiftSignup, error) {
+	shift, err := s.Repo.GetShift(ctx, shiftID)
+	if err != nil {
+		return nil, err
+	}
+	if !coordinator && !shift.StartTime.After(time.Now()) {
+		return nil, helper.ErrShiftStarted
+	}
+	return s.Repo.ReleaseShift(ctx, shiftID, userID)
+}
+
+// RecordHours records the minutes a volunteer worked on a shift once it
+// has started, the whole shift when minutes is 0, or that they did not
+// come. Recording again replaces what was recorded.
+func (s *VolunteerService) RecordHours(ctx context.Context, shiftID, userID string, minutes int32, noShow bool, recordedBy string) (*entity.ShiftSignup, error) {
+	shift, err := s.Repo.GetShift(ctx, shiftID)
+	if err != nil {
+		return nil, err
+	}
+	if shift.StartTime.After(time.Now()) {
+		return nil, helper.ErrShiftNotStarted
+	}
+	switch {
+	case noShow:
+		minutes = 0
+	case minutes == 0:
+		minutes = shift.Minutes()
+	case minutes < 0 || minutes > int32(MaxShiftLength/time.Minute):
+		return nil, fmt.Errorf("%w: minutes worked must be between 0 and %d", helper.ErrInvalidShift, int(MaxShiftLength/time.Minute))
+	}
+	return s.Repo.RecordHours(ctx, shiftID, userID, minutes, recordedBy)
+}
+
+// ListMyShifts returns the shifts a volunteer holds places on that end
+// after from and start before to, by start time. They default to now and
+// DefaultMyShiftsWindow later.
+func (s *VolunteerService) ListMyShifts(ctx context.Context, userID string, from, to time.Time) ([]entity.ShiftSignup, error) {
+	if from.IsZero() {
+		from = time.Now()
+	}
+	if to.IsZero() {
+		to = from.Add(DefaultMyShiftsWindow)
+	}
+	if !to.After(from) {
+		return nil, helper.ErrInvalidTimeWindow
+	}
+	return s.Repo.ListUserSignups(ctx, userID, from, to)
+}
+
+// GetVolunteerHours totals the hours worked on shifts starting in a year,
+// by volunteer, most first. With a masjid it covers every volunteer at the
+// masjid, its year running in the masjid's time zone; without, it covers
+// one volunteer at every masjid, by the UTC year. The year defaults to the
+// current one and is returned with the totals.
+func (s *VolunteerService) GetVolunteerHours(ctx context.Context, masjidID, userID string, year int) ([]entity.VolunteerHours, int, error) {
+	loc := time.UTC
+	if masjidID != "" {
+		masjid, err := s.MasjidRepo.GetByID(ctx, masjidID)
+		if err != nil {
+			return nil, 0, err
+		}
+		loc, _ = eventCalendar(masjid)
+	}
+	if year == 0 {
+		year = time.Now().In(loc).Year()
+	}
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	hours, err := s.Repo.SumHours(ctx, masjidID, userID, from, from.AddDate(1, 0, 0))
+	return hours, year, err
+}
+
+func validateShift(shift *entity.VolunteerShift) error {
+	shift.Role = strings.TrimSpace(shift.Role)
+	switch {
+	case shift.Role == "" || utf8.RuneCountInString(shift.Role) > 64:
+		return fmt.Errorf("%w: role must be 1 to 64 characters", helper.ErrInvalidShift)
+	case !shift.EndTime.After(shift.StartTime):
+		return fmt.Errorf("%w: end time must be after start time", helper.ErrInvalidShift)
+	case shift.EndTime.Sub(shift.StartTime) > MaxShiftLength:
+		return fmt.Errorf("%w: shifts last at most %v", helper.ErrInvalidShift, MaxShiftLength)
+	case shift.Headcount <= 0:
+		return fmt.Errorf("%w: headcount must be positive", helper.ErrInvalidShift)
+	}
+	return nil
+}
@@ -72,6 +72,40 @@ func VerifyJWTInterceptor(ctx context.Context, req interface{}, info *grpc.Unary
 	if _, isUnprotected := UnprotectedRoutes[info.FullMethod]; isUnprotected {
 		return handler(ctx, req)
 	}
+	newCtx, err := authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(newCtx, req)
+}
+
+// VerifyJWTStreamInterceptor is the streaming counterpart of
+// VerifyJWTInterceptor. The token is checked once when the stream opens.
+func VerifyJWTStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if _, isUnprotected := UnprotectedRoutes[info.FullMethod]; isUnprotected {
+		return handler(srv, ss)
+	}
+	newCtx, err := authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: newCtx})
+}
+
+// authenticatedStream overrides the context of a stream with one carrying
+// the caller's user ID and role.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate verifies the bearer token in the incoming metadata and returns
+// ctx with the user ID and role of its claims.
+func authenticate(ctx context.Context) (context.Context, error) {
 	tokenString, err := grpcauth.AuthFromMD(ctx, "Bearer")
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "invalid authorization header: %v", err)
@@ -108,7 +142,7 @@ func VerifyJWTInterceptor(ctx context.Context, req interface{}, info *grpc.Unary
 		newCtx := context.WithValue(ctx, UserIDContextKey, userID)
 		newCtx = context.WithValue(newCtx, UserRoleContextKey, userRole)
 
-		return handler(newCtx, req)
+		return newCtx, nil
 	}
 
 	return nil, status.Errorf(codes.Unauthenticated, "invalid token")
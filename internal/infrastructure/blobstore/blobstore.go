@@ -0,0 +1,82 @@
+// Package blobstore keeps large binary objects such as adhan audio out of
+// the database.
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"os"
+	"strings"
+)
+
+var (
+	ErrNotFound   = errors.New("blob not found")
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+// Store is a flat namespace of immutable objects addressed by key.
+type Store interface {
+	// Put stores size bytes read from r under key, replacing any object
+	// already there.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get returns length bytes of the object under key starting at offset.
+	// A length of 0 reads to the end. It returns ErrNotFound if there is no
+	// such object.
+	Get(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error)
+	// Delete removes the object under key. Deleting a missing object is not
+	// an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// NewKey returns a fresh key for an object belonging to the record of the
+// given kind and ID, e.g. "adhans/<id>/<random>". Keys are never reused, so
+// replacing a record's object never races with readers of the old one.
+func NewKey(kind, id string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, id, uuid.New())
+}
+
+// ContentKey returns the key of an object addressed by the hex SHA-256 of
+// its content, e.g. "adhans/sha256/<hash>". Such a key is only ever written
+// with the same bytes, so it too is safe to read while being replaced.
+func ContentKey(kind, sha256 string) string {
+	return fmt.Sprintf("%s/sha256/%s", kind, sha256)
+}
+
+// NewFromEnv returns the store selected by BLOB_STORE: "filesystem" (the
+// default), rooted at BLOB_DIR, or "s3", configured by the S3_* variables.
+func NewFromEnv() (Store, error) {
+	switch backend := os.Getenv("BLOB_STORE"); backend {
+	case "", "filesystem":
+		dir := os.Getenv("BLOB_DIR")
+		if dir == "" {
+			dir = "blobs"
+		}
+		return NewFileStore(dir)
+	case "s3":
+		return NewS3Store(S3Config{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			Region:    os.Getenv("S3_REGION"),
+			Bucket:    os.Getenv("S3_BUCKET"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SECRET_KEY"),
+		})
+	default:
+		return nil, fmt.Errorf("unknown BLOB_STORE %q", backend)
+	}
+}
+
+// validateKey rejects keys that could escape a store's namespace.
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return fmt.Errorf("%w: %q", ErrInvalidKey, key)
+		}
+	}
+	return nil
+}
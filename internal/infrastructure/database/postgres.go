@@ -36,6 +36,18 @@ func SetupDatabase() *gorm.DB {
 	if err != nil {
 		return nil
 	}
+	err = DB.AutoMigrate(entity.AdhanAssignment{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.AdhanRendition{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.AdhanBlob{})
+	if err != nil {
+		return nil
+	}
 	err = DB.AutoMigrate(entity.Event{})
 	if err != nil {
 		return nil
@@ -44,10 +56,86 @@ func SetupDatabase() *gorm.DB {
 	if err != nil {
 		return nil
 	}
+	err = DB.AutoMigrate(entity.IqamahRule{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.PrayerTimeOverride{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.PrayerSlot{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.RamadanNight{})
+	if err != nil {
+		return nil
+	}
 	err = DB.AutoMigrate(entity.User{})
 	if err != nil {
 		return nil
 	}
+	err = DB.AutoMigrate(entity.EventRsvp{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.EventType{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.CalendarFeed{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.EventCheckIn{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.TicketType{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.Order{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.OrderItem{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.VolunteerShift{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.ShiftSignup{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.Room{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.RoomBooking{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.NotificationPreference{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.MasjidFollow{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.PushDevice{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.EventNotification{})
+	if err != nil {
+		return nil
+	}
 	err = DB.AutoMigrate(entity.NikkahProfile{})
 	if err != nil {
 		return nil
@@ -96,6 +184,18 @@ func SetupDatabaseTesting() *gorm.DB {
 	if err != nil {
 		return nil
 	}
+	err = DB.AutoMigrate(entity.AdhanAssignment{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.AdhanRendition{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.AdhanBlob{})
+	if err != nil {
+		return nil
+	}
 	err = DB.AutoMigrate(entity.Event{})
 	if err != nil {
 		return nil
@@ -104,10 +204,86 @@ func SetupDatabaseTesting() *gorm.DB {
 	if err != nil {
 		return nil
 	}
+	err = DB.AutoMigrate(entity.IqamahRule{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.PrayerTimeOverride{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.PrayerSlot{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.RamadanNight{})
+	if err != nil {
+		return nil
+	}
 	err = DB.AutoMigrate(entity.User{})
 	if err != nil {
 		return nil
 	}
+	err = DB.AutoMigrate(entity.EventRsvp{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.EventType{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.CalendarFeed{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.EventCheckIn{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.TicketType{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.Order{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.OrderItem{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.VolunteerShift{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.ShiftSignup{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.Room{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.RoomBooking{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.NotificationPreference{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.MasjidFollow{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.PushDevice{})
+	if err != nil {
+		return nil
+	}
+	err = DB.AutoMigrate(entity.EventNotification{})
+	if err != nil {
+		return nil
+	}
 	err = DB.AutoMigrate(entity.NikkahProfile{})
 	if err != nil {
 		return nil
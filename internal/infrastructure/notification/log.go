@@ -0,0 +1,48 @@
+package notification
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+)
+
+// LogChannel writes messages to the log instead of sending them, and keeps
+// them for tests to inspect.
+type LogChannel struct {
+	kind Kind
+
+	mu   sync.Mutex
+	sent []Message
+}
+
+func NewLogChannel(kind Kind) *LogChannel {
+	return &LogChannel{kind: kind}
+}
+
+func (c *LogChannel) Kind() Kind {
+	return c.kind
+}
+
+func (c *LogChannel) Send(ctx context.Context, msg *Message) error {
+	to := msg.Email
+	if c.kind == Push {
+		to = strings.Join(msg.PushTokens, ",")
+	}
+	if to == "" {
+		return ErrNoAddress
+	}
+	log.Printf("%s notification to %s: %s", c.kind, to, msg.Subject)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sent = append(c.sent, *msg)
+	return nil
+}
+
+// Sent returns the messages sent so far, oldest first.
+func (c *LogChannel) Sent() []Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Message(nil), c.sent...)
+}
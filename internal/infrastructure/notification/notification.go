@@ -0,0 +1,99 @@
+// Package notification delivers messages to members over the channels they
+// can be reached on: email, and push notifications to the devices they
+// have registered.
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ErrNoAddress is returned for messages without an address on the
+// channel, such as a push notification to a member with no devices.
+var ErrNoAddress = errors.New("no address for the channel")
+
+type Kind string
+
+const (
+	Email Kind = "email"
+	Push  Kind = "push"
+)
+
+// Message is a message for one member. Each channel sends it to the
+// address it has for its kind.
+type Message struct {
+	Email      string
+	PushTokens []string
+	Subject    string
+	Body       string
+	// Data is passed along with push notifications for apps to act on, e.g.
+	// the ID of the event to open.
+	Data map[string]string
+}
+
+// Channel is a way of reaching members.
+type Channel interface {
+	Kind() Kind
+	// Send delivers msg, returning ErrNoAddress if it has no address for
+	// the channel.
+	Send(ctx context.Context, msg *Message) error
+}
+
+// NewFromEnv returns the channels selected by NOTIFY_EMAIL, "smtp"
+// configured by the SMTP_* variables, and NOTIFY_PUSH, "fcm" configured by
+// the FCM_* variables. Either may be "log", which writes messages to the
+// log instead of sending them. Channels not selected are off, and no
+// notifications are sent when both are.
+func NewFromEnv() ([]Channel, error) {
+	var channels []Channel
+	switch email := os.Getenv("NOTIFY_EMAIL"); email {
+	case "":
+	case "smtp":
+		channel, err := NewSMTPChannel(SMTPConfig{
+			Addr:     os.Getenv("SMTP_ADDR"),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     os.Getenv("SMTP_FROM"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, channel)
+	case "log":
+		channels = append(channels, NewLogChannel(Email))
+	default:
+		return nil, fmt.Errorf("unknown NOTIFY_EMAIL %q", email)
+	}
+	switch push := os.Getenv("NOTIFY_PUSH"); push {
+	case "":
+	case "fcm":
+		key, err := os.ReadFile(os.Getenv("FCM_CREDENTIALS_FILE"))
+		if err != nil {
+			return nil, fmt.Errorf("FCM_CREDENTIALS_FILE: %w", err)
+		}
+		account, err := ParseServiceAccount(key)
+		if err != nil {
+			return nil, err
+		}
+		projectID := os.Getenv("FCM_PROJECT_ID")
+		if projectID == "" {
+			projectID = account.ProjectID
+		}
+		sender, err := NewFCMSender(FCMConfig{
+			APIURL:      os.Getenv("FCM_API_URL"),
+			ProjectID:   projectID,
+			TokenSource: account.TokenSource(context.Background(), FCMScope),
+		})
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, NewPushChannel(sender))
+	case "log":
+		channels = append(channels, NewLogChannel(Push))
+	default:
+		return nil, fmt.Errorf("unknown NOTIFY_PUSH %q", push)
+	}
+	return channels, nil
+}
@@ -0,0 +1,223 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/jwt"
+)
+
+// ErrUnregistered is returned by a PushSender for device tokens it no
+// longer accepts, as when an app has been uninstalled.
+var ErrUnregistered = errors.New("device token is no longer registered")
+
+// PushNotification is a notification shown on a device.
+type PushNotification struct {
+	Title string
+	Body  string
+	Data  map[string]string
+}
+
+// PushSender sends push notifications to devices by their registration
+// tokens, as Firebase Cloud Messaging does.
+type PushSender interface {
+	SendPush(ctx context.Context, token string, n *PushNotification) error
+}
+
+// UnregisteredError is returned by PushChannel.Send when some of a
+// message's tokens are no longer registered, so that they can be
+// forgotten. Delivered reports whether the message reached any of the
+// others.
+type UnregisteredError struct {
+	Tokens    []string
+	Delivered bool
+}
+
+func (e *UnregisteredError) Error() string {
+	return fmt.Sprintf("%d device tokens are no longer registered", len(e.Tokens))
+}
+
+func (e *UnregisteredError) Unwrap() error {
+	return ErrUnregistered
+}
+
+// PushChannel sends messages as push notifications to every device of a
+// member.
+type PushChannel struct {
+	Sender PushSender
+}
+
+func NewPushChannel(sender PushSender) *PushChannel {
+	return &PushChannel{Sender: sender}
+}
+
+func (c *PushChannel) Kind() Kind {
+	return Push
+}
+
+// Send sends msg to each of its tokens. It succeeds if any device is
+// reached and no token turns out to be unregistered.
+func (c *PushChannel) Send(ctx context.Context, msg *Message) error {
+	if len(msg.PushTokens) == 0 {
+		return ErrNoAddress
+	}
+	n := &PushNotification{Title: msg.Subject, Body: msg.Body, Data: msg.Data}
+	var unregistered []string
+	var errs []error
+	delivered := false
+	for _, token := range msg.PushTokens {
+		err := c.Sender.SendPush(ctx, token, n)
+		switch {
+		case err == nil:
+			delivered = true
+		case errors.Is(err, ErrUnregistered):
+			unregistered = append(unregistered, token)
+		default:
+			errs = append(errs, err)
+		}
+	}
+	if len(unregistered) > 0 {
+		return &UnregisteredError{Tokens: unregistered, Delivered: delivered}
+	}
+	if !delivered {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+// FCMScope is the OAuth 2.0 scope of access tokens that send messages
+// with Firebase Cloud Messaging.
+const FCMScope = "https://www.googleapis.com/auth/firebase.messaging"
+
+// ServiceAccount is the JSON key of a Google service account, as
+// downloaded from the Firebase or Google Cloud console.
+type ServiceAccount struct {
+	ProjectID    string `json:"project_id"`
+	ClientEmail  string `json:"client_email"`
+	PrivateKeyID string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// ParseServiceAccount reads a service account key.
+func ParseServiceAccount(data []byte) (*ServiceAccount, error) {
+	var account ServiceAccount
+	if err := json.Unmarshal(data, &account); err != nil {
+		return nil, fmt.Errorf("invalid service account key: %w", err)
+	}
+	if account.ClientEmail == "" || account.PrivateKey == "" {
+		return nil, errors.New("invalid service account key: client_email and private_key are required")
+	}
+	if account.TokenURI == "" {
+		account.TokenURI = "https://oauth2.googleapis.com/token"
+	}
+	return &account, nil
+}
+
+// TokenSource returns a source of access tokens for the account with the
+// given scopes. Tokens last about an hour and are renewed as they expire.
+func (a *ServiceAccount) TokenSource(ctx context.Context, scopes ...string) oauth2.TokenSource {
+	config := &jwt.Config{
+		Email:        a.ClientEmail,
+		PrivateKey:   []byte(a.PrivateKey),
+		PrivateKeyID: a.PrivateKeyID,
+		Scopes:       scopes,
+		TokenURL:     a.TokenURI,
+	}
+	return config.TokenSource(ctx)
+}
+
+// FCMConfig configures an FCMSender. APIURL defaults to
+// https://fcm.googleapis.com. TokenSource gives the OAuth 2.0 access
+// tokens of a service account allowed to send messages for the Firebase
+// project, with the FCMScope; ServiceAccount.TokenSource makes one.
+type FCMConfig struct {
+	APIURL      string
+	ProjectID   string
+	TokenSource oauth2.TokenSource
+}
+
+// FCMSender sends push notifications with the Firebase Cloud Messaging
+// HTTP v1 API.
+type FCMSender struct {
+	config FCMConfig
+	Client *http.Client
+}
+
+func NewFCMSender(config FCMConfig) (*FCMSender, error) {
+	if config.ProjectID == "" || config.TokenSource == nil {
+		return nil, errors.New("FCM project ID and token source are required")
+	}
+	if config.APIURL == "" {
+		config.APIURL = "https://fcm.googleapis.com"
+	}
+	config.APIURL = strings.TrimSuffix(config.APIURL, "/")
+	return &FCMSender{config: config, Client: http.DefaultClient}, nil
+}
+
+func (s *FCMSender) SendPush(ctx context.Context, token string, n *PushNotification) error {
+	var message struct {
+		Message struct {
+			Token        string            `json:"token"`
+			Notification map[string]string `json:"notification"`
+			Data         map[string]string `json:"data,omitempty"`
+		} `json:"message"`
+	}
+	message.Message.Token = token
+	message.Message.Notification = map[string]string{"title": n.Title, "body": n.Body}
+	message.Message.Data = n.Data
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	accessToken, err := s.config.TokenSource.Token()
+	if err != nil {
+		return fmt.Errorf("fcm access token: %w", err)
+	}
+	path := "/v1/projects/" + url.PathEscape(s.config.ProjectID) + "/messages:send"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.APIURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	accessToken.SetAuthHeader(req)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	var e struct {
+		Error struct {
+			Message string `json:"message"`
+			Details []struct {
+				ErrorCode string `json:"errorCode"`
+			} `json:"details"`
+		} `json:"error"`
+	}
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if json.Unmarshal(respBody, &e) != nil || e.Error.Message == "" {
+		e.Error.Message = http.StatusText(resp.StatusCode)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrUnregistered
+	}
+	for _, detail := range e.Error.Details {
+		if detail.ErrorCode == "UNREGISTERED" {
+			return ErrUnregistered
+		}
+	}
+	return fmt.Errorf("fcm POST %s: %s", path, e.Error.Message)
+}
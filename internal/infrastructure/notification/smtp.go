@@ -0,0 +1,93 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTPConfig configures an SMTPChannel. Addr is the host and port of the
+// mail server, e.g. "smtp.example.com:587". Username and Password are
+// used with PLAIN authentication when set. From is the sender's address,
+// optionally with a name, e.g. "Limestone <events@example.com>".
+type SMTPConfig struct {
+	Addr     string
+	Username string
+	Password string
+	From     string
+}
+
+// SMTPChannel sends messages by email through a mail server. The server
+// must offer STARTTLS for authentication to be used.
+type SMTPChannel struct {
+	config SMTPConfig
+	from   *mail.Address
+	// SendMail sends a message, as smtp.SendMail does.
+	SendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewSMTPChannel(config SMTPConfig) (*SMTPChannel, error) {
+	if config.Addr == "" || config.From == "" {
+		return nil, errors.New("SMTP server address and sender are required")
+	}
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP sender: %w", err)
+	}
+	return &SMTPChannel{config: config, from: from, SendMail: smtp.SendMail}, nil
+}
+
+func (c *SMTPChannel) Kind() Kind {
+	return Email
+}
+
+// Send sends msg as a plain text email. The context is not used, since
+// net/smtp does not take one.
+func (c *SMTPChannel) Send(ctx context.Context, msg *Message) error {
+	if msg.Email == "" {
+		return ErrNoAddress
+	}
+	to, err := mail.ParseAddress(msg.Email)
+	if err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "From: %s\r\n", c.from.String())
+	fmt.Fprintf(&body, "To: %s\r\n", to.String())
+	fmt.Fprintf(&body, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", headerText(msg.Subject)))
+	fmt.Fprintf(&body, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	body.WriteString("MIME-Version: 1.0\r\n")
+	body.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	body.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+	w := quotedprintable.NewWriter(&body)
+	if _, err := w.Write([]byte(msg.Body)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if c.config.Username != "" {
+		host, _, err := net.SplitHostPort(c.config.Addr)
+		if err != nil {
+			return fmt.Errorf("invalid SMTP server address: %w", err)
+		}
+		auth = smtp.PlainAuth("", c.config.Username, c.config.Password, host)
+	}
+	return c.SendMail(c.config.Addr, auth, c.from.Address, []string{to.Address}, body.Bytes())
+}
+
+// headerText keeps text on one line, so that it cannot add headers.
+func headerText(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,153 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// FakeSignatureHeader carries the signature of a FakeProvider webhook: the
+// hex HMAC-SHA256 of the body keyed by the webhook secret.
+const FakeSignatureHeader = "Fake-Signature"
+
+// FakeProvider takes no real payments. It keeps checkouts and refunds in
+// memory and makes the webhooks a provider would send, for tests. It is
+// not offered by NewFromEnv: anyone who knows its secret can pay.
+type FakeProvider struct {
+	secret string
+
+	mu        sync.Mutex
+	next      int
+	checkouts map[string]*FakeCheckout
+	refunds   []FakeRefund
+}
+
+// FakeCheckout is a checkout started with a FakeProvider.
+type FakeCheckout struct {
+	Request   CheckoutRequest
+	Expired   bool
+	PaymentID string
+}
+
+// FakeRefund is a refund made with a FakeProvider.
+type FakeRefund struct {
+	PaymentID      string
+	Amount         int64
+	IdempotencyKey string
+}
+
+func NewFakeProvider(webhookSecret string) *FakeProvider {
+	return &FakeProvider{secret: webhookSecret, checkouts: map[string]*FakeCheckout{}}
+}
+
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+func (p *FakeProvider) CreateCheckout(ctx context.Context, req *CheckoutRequest) (*Checkout, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.next++
+	id := fmt.Sprintf("fake_cs_%d", p.next)
+	p.checkouts[id] = &FakeCheckout{Request: *req}
+	return &Checkout{ID: id, URL: "https://payments.invalid/checkout/" + id}, nil
+}
+
+func (p *FakeProvider) ExpireCheckout(ctx context.Context, checkoutID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	checkout, ok := p.checkouts[checkoutID]
+	if !ok {
+		return fmt.Errorf("fake checkout %s not found", checkoutID)
+	}
+	if checkout.PaymentID != "" {
+		return fmt.Errorf("fake checkout %s has been paid", checkoutID)
+	}
+	checkout.Expired = true
+	return nil
+}
+
+func (p *FakeProvider) Refund(ctx context.Context, paymentID string, amount int64, idempotencyKey string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, refund := range p.refunds {
+		if refund.IdempotencyKey == idempotencyKey {
+			return nil
+		}
+	}
+	p.refunds = append(p.refunds, FakeRefund{PaymentID: paymentID, Amount: amount, IdempotencyKey: idempotencyKey})
+	return nil
+}
+
+func (p *FakeProvider) ParseWebhook(header http.Header, body []byte) (*Event, error) {
+	if p.secret == "" || !hmac.Equal([]byte(header.Get(FakeSignatureHeader)), []byte(p.sign(body))) {
+		return nil, ErrInvalidSignature
+	}
+	var event Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("invalid fake event: %w", err)
+	}
+	return &event, nil
+}
+
+// Checkout returns a checkout started with the provider.
+func (p *FakeProvider) Checkout(id string) (FakeCheckout, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	checkout, ok := p.checkouts[id]
+	if !ok {
+		return FakeCheckout{}, false
+	}
+	return *checkout, true
+}
+
+// Refunds returns the refunds made with the provider.
+func (p *FakeProvider) Refunds() []FakeRefund {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]FakeRefund(nil), p.refunds...)
+}
+
+// Pay pays a checkout as its buyer would and returns the event reporting
+// it.
+func (p *FakeProvider) Pay(checkoutID string) (*Event, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	checkout, ok := p.checkouts[checkoutID]
+	if !ok || checkout.Expired {
+		return nil, fmt.Errorf("fake checkout %s cannot be paid", checkoutID)
+	}
+	if checkout.PaymentID == "" {
+		checkout.PaymentID = "fake_pi_" + checkoutID
+	}
+	return &Event{
+		ID:         "fake_evt_paid_" + checkoutID,
+		Type:       CheckoutCompleted,
+		CheckoutID: checkoutID,
+		PaymentID:  checkout.PaymentID,
+		OrderID:    checkout.Request.OrderID,
+	}, nil
+}
+
+// Webhook returns the header and body of a webhook delivering event.
+func (p *FakeProvider) Webhook(event *Event) (http.Header, []byte) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		panic(err) // Events always encode.
+	}
+	header := http.Header{}
+	header.Set(FakeSignatureHeader, p.sign(body))
+	header.Set("Content-Type", "application/json")
+	return header, body
+}
+
+func (p *FakeProvider) sign(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(p.secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,104 @@
+// Package payment takes payments for event orders through a payment
+// provider. Buyers pay on a checkout page hosted by the provider, which
+// reports the outcome, and later refunds, by webhook.
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// LineItem is a line of a checkout. UnitAmount is in the smallest unit of
+// the checkout's currency, e.g. cents.
+type LineItem struct {
+	Name       string
+	UnitAmount int64
+	Quantity   int32
+}
+
+// CheckoutRequest asks for a buyer to pay for an order.
+type CheckoutRequest struct {
+	OrderID string
+	// Currency is an ISO 4217 code such as "CAD".
+	Currency string
+	Items    []LineItem
+	// SuccessURL and CancelURL are where the buyer is sent back to after
+	// paying or giving up.
+	SuccessURL string
+	CancelURL  string
+	// ExpiresAt is when the checkout stops taking payment.
+	ExpiresAt time.Time
+}
+
+// Checkout is a started payment. The buyer pays at URL.
+type Checkout struct {
+	ID  string
+	URL string
+}
+
+type EventType string
+
+const (
+	// CheckoutCompleted reports that the buyer paid. PaymentID is set.
+	CheckoutCompleted EventType = "checkout.completed"
+	// CheckoutExpired reports that the checkout ended unpaid, because it
+	// expired or the payment failed.
+	CheckoutExpired EventType = "checkout.expired"
+	// PaymentRefunded reports that Amount of the payment PaymentID has been
+	// refunded in all.
+	PaymentRefunded EventType = "payment.refunded"
+)
+
+// Event is a notification from a provider. Providers deliver an event at
+// least once, so applying one must be idempotent.
+type Event struct {
+	ID         string    `json:"id"`
+	Type       EventType `json:"type"`
+	CheckoutID string    `json:"checkout_id,omitempty"`
+	PaymentID  string    `json:"payment_id,omitempty"`
+	OrderID    string    `json:"order_id,omitempty"`
+	Amount     int64     `json:"amount,omitempty"`
+}
+
+// Provider is a payment provider.
+type Provider interface {
+	// Name identifies the provider on the orders paid through it.
+	Name() string
+	// CreateCheckout starts a payment for an order.
+	CreateCheckout(ctx context.Context, req *CheckoutRequest) (*Checkout, error)
+	// ExpireCheckout ends a checkout that has not been paid, so that it
+	// can no longer be.
+	ExpireCheckout(ctx context.Context, checkoutID string) error
+	// Refund returns amount of a payment to the buyer. Refunding again
+	// with the same key does not refund twice. The refund is confirmed
+	// later by a PaymentRefunded event.
+	Refund(ctx context.Context, paymentID string, amount int64, idempotencyKey string) error
+	// ParseWebhook checks that a webhook request came from the provider
+	// and returns the event it carries, or nil for events of no interest.
+	// It returns ErrInvalidSignature for requests it cannot vouch for.
+	ParseWebhook(header http.Header, body []byte) (*Event, error)
+}
+
+// NewFromEnv returns the provider selected by PAYMENT_PROVIDER: "stripe",
+// configured by the STRIPE_* variables. It returns nil when
+// PAYMENT_PROVIDER is not set, leaving only free tickets on sale.
+func NewFromEnv() (Provider, error) {
+	switch provider := os.Getenv("PAYMENT_PROVIDER"); provider {
+	case "":
+		return nil, nil
+	case "stripe":
+		return NewStripeProvider(StripeConfig{
+			APIURL:        os.Getenv("STRIPE_API_URL"),
+			SecretKey:     os.Getenv("STRIPE_SECRET_KEY"),
+			WebhookSecret: os.Getenv("STRIPE_WEBHOOK_SECRET"),
+		})
+	default:
+		return nil, fmt.Errorf("unknown PAYMENT_PROVIDER %q", provider)
+	}
+}
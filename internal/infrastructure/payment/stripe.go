@@ -0,0 +1,211 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StripeSignatureTolerance is how old a webhook's timestamp may be, to
+// stop captured requests being replayed.
+const StripeSignatureTolerance = 5 * time.Minute
+
+// StripeConfig configures a StripeProvider. APIURL defaults to
+// https://api.stripe.com. WebhookSecret is the signing secret of the
+// webhook endpoint, starting "whsec_".
+type StripeConfig struct {
+	APIURL        string
+	SecretKey     string
+	WebhookSecret string
+}
+
+// StripeProvider takes payments with Stripe Checkout, calling the Stripe
+// API directly. Orders are tied to checkout sessions by their metadata.
+type StripeProvider struct {
+	config StripeConfig
+	Client *http.Client
+}
+
+func NewStripeProvider(config StripeConfig) (*StripeProvider, error) {
+	if config.SecretKey == "" || config.WebhookSecret == "" {
+		return nil, errors.New("Stripe secret key and webhook secret are required")
+	}
+	if config.APIURL == "" {
+		config.APIURL = "https://api.stripe.com"
+	}
+	config.APIURL = strings.TrimSuffix(config.APIURL, "/")
+	return &StripeProvider{config: config, Client: http.DefaultClient}, nil
+}
+
+func (p *StripeProvider) Name() string {
+	return "stripe"
+}
+
+func (p *StripeProvider) CreateCheckout(ctx context.Context, req *CheckoutRequest) (*Checkout, error) {
+	form := url.Values{
+		"mode":                {"payment"},
+		"success_url":         {req.SuccessURL},
+		"cancel_url":          {req.CancelURL},
+		"client_reference_id": {req.OrderID},
+		"metadata[order_id]":  {req.OrderID},
+		"payment_intent_data[metadata][order_id]": {req.OrderID},
+	}
+	if !req.ExpiresAt.IsZero() {
+		form.Set("expires_at", strconv.FormatInt(req.ExpiresAt.Unix(), 10))
+	}
+	for i, item := range req.Items {
+		prefix := fmt.Sprintf("line_items[%d]", i)
+		form.Set(prefix+"[quantity]", strconv.Itoa(int(item.Quantity)))
+		form.Set(prefix+"[price_data][currency]", strings.ToLower(req.Currency))
+		form.Set(prefix+"[price_data][unit_amount]", strconv.FormatInt(item.UnitAmount, 10))
+		form.Set(prefix+"[price_data][product_data][name]", item.Name)
+	}
+	var session struct {
+		ID  string `json:"id"`
+		URL string `json:"url"`
+	}
+	if err := p.post(ctx, "/v1/checkout/sessions", form, "checkout-"+req.OrderID, &session); err != nil {
+		return nil, err
+	}
+	return &Checkout{ID: session.ID, URL: session.URL}, nil
+}
+
+func (p *StripeProvider) ExpireCheckout(ctx context.Context, checkoutID string) error {
+	return p.post(ctx, "/v1/checkout/sessions/"+url.PathEscape(checkoutID)+"/expire", nil, "", nil)
+}
+
+func (p *StripeProvider) Refund(ctx context.Context, paymentID string, amount int64, idempotencyKey string) error {
+	form := url.Values{
+		"payment_intent": {paymentID},
+		"amount":         {strconv.FormatInt(amount, 10)},
+	}
+	return p.post(ctx, "/v1/refunds", form, idempotencyKey, nil)
+}
+
+// stripeEvent is the part of a Stripe event the provider reads. The object
+// is a checkout session or a charge, depending on the type.
+type stripeEvent struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Data struct {
+		Object struct {
+			ID             string            `json:"id"`
+			PaymentStatus  string            `json:"payment_status"`
+			PaymentIntent  string            `json:"payment_intent"`
+			AmountRefunded int64             `json:"amount_refunded"`
+			Metadata       map[string]string `json:"metadata"`
+		} `json:"object"`
+	} `json:"data"`
+}
+
+func (p *StripeProvider) ParseWebhook(header http.Header, body []byte) (*Event, error) {
+	if err := p.verify(header.Get("Stripe-Signature"), body); err != nil {
+		return nil, err
+	}
+	var e stripeEvent
+	if err := json.Unmarshal(body, &e); err != nil {
+		return nil, fmt.Errorf("invalid Stripe event: %w", err)
+	}
+	object := e.Data.Object
+	event := &Event{ID: e.ID, OrderID: object.Metadata["order_id"], PaymentID: object.PaymentIntent}
+	switch e.Type {
+	case "checkout.session.completed":
+		// Payments that settle later complete with
+		// checkout.session.async_payment_succeeded.
+		if object.PaymentStatus != "paid" && object.PaymentStatus != "no_payment_required" {
+			return nil, nil
+		}
+		event.Type, event.CheckoutID = CheckoutCompleted, object.ID
+	case "checkout.session.async_payment_succeeded":
+		event.Type, event.CheckoutID = CheckoutCompleted, object.ID
+	case "checkout.session.expired", "checkout.session.async_payment_failed":
+		event.Type, event.CheckoutID = CheckoutExpired, object.ID
+	case "charge.refunded":
+		event.Type, event.Amount = PaymentRefunded, object.AmountRefunded
+	default:
+		return nil, nil
+	}
+	return event, nil
+}
+
+// verify checks a Stripe-Signature header, "t=<unix time>,v1=<hex>", where
+// the signature is the HMAC-SHA256 of "<unix time>.<body>" keyed by the
+// webhook secret. Any of several v1 signatures may match while the secret
+// is being rolled.
+func (p *StripeProvider) verify(signature string, body []byte) error {
+	var timestamp string
+	var signatures [][]byte
+	for _, part := range strings.Split(signature, ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			if sig, err := hex.DecodeString(value); err == nil {
+				signatures = append(signatures, sig)
+			}
+		}
+	}
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return ErrInvalidSignature
+	}
+	if age := time.Since(time.Unix(seconds, 0)); age > StripeSignatureTolerance || age < -StripeSignatureTolerance {
+		return ErrInvalidSignature
+	}
+	mac := hmac.New(sha256.New, []byte(p.config.WebhookSecret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	expected := mac.Sum(nil)
+	for _, sig := range signatures {
+		if hmac.Equal(sig, expected) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// post sends a form to the Stripe API and decodes the response into out
+// unless it is nil.
+func (p *StripeProvider) post(ctx context.Context, path string, form url.Values, idempotencyKey string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.APIURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.config.SecretKey)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var e struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if json.Unmarshal(body, &e) != nil || e.Error.Message == "" {
+			e.Error.Message = http.StatusText(resp.StatusCode)
+		}
+		return fmt.Errorf("stripe POST %s: %s", path, e.Error.Message)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -4,11 +4,17 @@
 package server
 
 import (
+	"context"
 	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/ticket"
 	"github.com/mnadev/limestone/internal/application/services"
 	"github.com/mnadev/limestone/internal/infrastructure/auth"
+	"github.com/mnadev/limestone/internal/infrastructure/blobstore"
+	"github.com/mnadev/limestone/internal/infrastructure/notification"
+	"github.com/mnadev/limestone/internal/infrastructure/payment"
 	"log"
 	"net"
+	"os"
 
 	"github.com/mnadev/limestone/internal/application/handler"
 	"github.com/mnadev/limestone/internal/infrastructure/storage"
@@ -17,7 +23,9 @@ import (
 	"gorm.io/gorm"
 )
 
-func SetupGRPCServer(db *gorm.DB, grpcEndpoint string) (*grpc.Server, net.Listener) {
+// SetupGRPCServer builds the gRPC server and starts its background work,
+// which runs until ctx is done.
+func SetupGRPCServer(ctx context.Context, db *gorm.DB, grpcEndpoint string) (*grpc.Server, net.Listener) {
 	listener, err := net.Listen("tcp", grpcEndpoint)
 	if err != nil {
 		log.Printf("failed to listen for gRPC: %s", err)
@@ -26,6 +34,7 @@ func SetupGRPCServer(db *gorm.DB, grpcEndpoint string) (*grpc.Server, net.Listen
 
 	server := grpc.NewServer(
 		grpc.UnaryInterceptor(auth.VerifyJWTInterceptor),
+		grpc.StreamInterceptor(auth.VerifyJWTStreamInterceptor),
 	)
 
 	// Initialize repositories and services
@@ -37,25 +46,53 @@ func SetupGRPCServer(db *gorm.DB, grpcEndpoint string) (*grpc.Server, net.Listen
 	masjidService := services.NewMasjidService(masjidRepo)
 	//adhan service
 	adhanRepo := storage.NewGormAdhanRepository(db)
-	adhanService := services.NewAdhanService(adhanRepo)
+	blobs, err := blobstore.NewFromEnv()
+	if err != nil {
+		log.Fatalf("failed to set up blob store: %s", err)
+	}
+	adhanService := services.NewAdhanService(adhanRepo, blobs)
+	// Only this server processes adhans, so that none is processed twice.
+	// It picks up the uploads the gateway leaves pending.
+	go adhanService.RunProcessing(ctx)
 	//event service
 	eventRepo := storage.NewGormEventRepository(db)
-	eventService := services.NewEventService(eventRepo)
+	roomRepo := storage.NewGormRoomRepository(db)
+	eventService := services.NewEventService(eventRepo, masjidRepo, userRepo, roomRepo, ticketSigner())
+	notificationService := services.NewNotificationService(storage.NewGormNotificationRepository(db), masjidRepo, userRepo, eventService, notificationChannels())
+	eventService.Notifications = notificationService
+	calendarFeedService := services.NewCalendarFeedService(storage.NewGormCalendarFeedRepository(db), eventService, os.Getenv("PUBLIC_BASE_URL"))
+	orderService := services.NewOrderService(storage.NewGormOrderRepository(db), eventService, paymentProvider(), os.Getenv("PAYMENT_RETURN_URL"))
+	// Only this server sends notifications, so that none is sent twice.
+	go notificationService.Run(context.Background())
 	//nikkah service
 	nikkahRepo := storage.NewGormNikkahRepository(db)
 	nikkahService := services.NewNikkahService(nikkahRepo)
 	//revert service
 	revertRepo := storage.NewGormRevertRepository(db)
 	revertService := services.NewRevertService(revertRepo)
+	//jumuah service
+	jumuahRepo := storage.NewGormJumuahRepository(db)
+	jumuahService := services.NewJumuahService(jumuahRepo, masjidRepo, userRepo)
+	//ramadan service
+	ramadanService := services.NewRamadanService(masjidRepo, eventService, userRepo)
+	//volunteer service
+	volunteerService := services.NewVolunteerService(storage.NewGormVolunteerRepository(db), eventRepo, masjidRepo)
+	//room service
+	roomService := services.NewRoomService(roomRepo, masjidRepo)
 
 	// Initialize handlers
 	userHandler := handler.NewUserGrpcHandler(userService)
 	authHandler := handler.NewAuthGrpcHandler(authService)
 	masjidHandler := handler.NewMasjidGrpcHandler(masjidService)
 	adhanHandler := handler.NewAdhanGrpcHandler(adhanService)
-	eventHandler := handler.NewEventGrpcHandler(eventService)
+	eventHandler := handler.NewEventGrpcHandler(eventService, calendarFeedService, orderService)
 	nikkahHandler := handler.NewNikkahIoGrpcHandler(nikkahService)
 	revertHandler := handler.NewRevertGrpcHandler(revertService)
+	jumuahHandler := handler.NewJumuahGrpcHandler(jumuahService)
+	ramadanHandler := handler.NewRamadanGrpcHandler(ramadanService)
+	volunteerHandler := handler.NewVolunteerGrpcHandler(volunteerService)
+	roomHandler := handler.NewRoomGrpcHandler(roomService)
+	notificationHandler := handler.NewNotificationGrpcHandler(notificationService)
 
 	// Register services with their handlers
 	pb.RegisterUserServiceServer(server, userHandler)
@@ -65,6 +102,11 @@ func SetupGRPCServer(db *gorm.DB, grpcEndpoint string) (*grpc.Server, net.Listen
 	pb.RegisterEventServiceServer(server, eventHandler)
 	pb.RegisterNikkahIoServiceServer(server, nikkahHandler)
 	pb.RegisterRevertsIoServiceServer(server, revertHandler)
+	pb.RegisterJumuahServiceServer(server, jumuahHandler)
+	pb.RegisterRamadanServiceServer(server, ramadanHandler)
+	pb.RegisterVolunteerServiceServer(server, volunteerHandler)
+	pb.RegisterRoomServiceServer(server, roomHandler)
+	pb.RegisterNotificationServiceServer(server, notificationHandler)
 
 	reflection.Register(server)
 
@@ -78,3 +120,46 @@ func StartGRPCServer(server *grpc.Server, lis net.Listener) {
 		}
 	}()
 }
+
+// ticketSigner returns the signer of event tickets configured by
+// TICKET_SIGNING_KEY, or nil when it is not set, leaving tickets off.
+func ticketSigner() *ticket.Signer {
+	key := os.Getenv("TICKET_SIGNING_KEY")
+	if key == "" {
+		log.Printf("TICKET_SIGNING_KEY is not set; event tickets are disabled")
+		return nil
+	}
+	signer, err := ticket.ParseSigningKey(key)
+	if err != nil {
+		log.Fatalf("failed to set up event tickets: %s", err)
+	}
+	return signer
+}
+
+// paymentProvider returns the payment provider configured by
+// PAYMENT_PROVIDER, or nil when it is not set, leaving only free tickets
+// on sale.
+func paymentProvider() payment.Provider {
+	provider, err := payment.NewFromEnv()
+	if err != nil {
+		log.Fatalf("failed to set up payments: %s", err)
+	}
+	if provider == nil {
+		log.Printf("PAYMENT_PROVIDER is not set; only free tickets can be ordered")
+	}
+	return provider
+}
+
+// notificationChannels returns the channels configured by NOTIFY_EMAIL and
+// NOTIFY_PUSH, or none when neither is set, leaving event notifications
+// off.
+func notificationChannels() []notification.Channel {
+	channels, err := notification.NewFromEnv()
+	if err != nil {
+		log.Fatalf("failed to set up notifications: %s", err)
+	}
+	if len(channels) == 0 {
+		log.Printf("NOTIFY_EMAIL and NOTIFY_PUSH are not set; event notifications are disabled")
+	}
+	return channels
+}
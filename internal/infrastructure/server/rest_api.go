@@ -11,12 +11,14 @@ import (
 	"google.golang.org/grpc/status"
 	"log"
 	"net/http"
+	"os"
 	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	pb "github.com/mnadev/limestone/gen/go"
 	"github.com/mnadev/limestone/internal/application/handler"
 	"github.com/mnadev/limestone/internal/application/services"
+	"github.com/mnadev/limestone/internal/infrastructure/blobstore"
 	"github.com/mnadev/limestone/internal/infrastructure/storage"
 	"gorm.io/gorm"
 )
@@ -52,20 +54,40 @@ func SetupRESTGateway(ctx context.Context, db *gorm.DB) *runtime.ServeMux {
 
 	//adhan service
 	adhanRepo := storage.NewGormAdhanRepository(db)
-	adhanService := services.NewAdhanService(adhanRepo)
+	blobs, err := blobstore.NewFromEnv()
+	if err != nil {
+		log.Fatalf("failed to set up blob store: %s", err)
+	}
+	// Without a processing queue: the gRPC server processes the adhans
+	// uploaded here.
+	adhanService := &services.AdhanService{Repo: adhanRepo, Blobs: blobs}
 	adhanHandler := handler.NewAdhanGrpcHandler(adhanService)
 	if err := pb.RegisterAdhanServiceHandlerServer(ctx, mux, adhanHandler); err != nil {
 		log.Fatalf("failed to register AdhanService handler: %s", err)
 	}
+	if err := handler.RegisterAdhanHTTPRoutes(mux, adhanHandler); err != nil {
+		log.Fatalf("failed to register AdhanService HTTP routes: %s", err)
+	}
 
 	//event service
 	eventRepo := storage.NewGormEventRepository(db)
-	eventService := services.NewEventService(eventRepo)
-	eventHandler := handler.NewEventGrpcHandler(eventService)
+	roomRepo := storage.NewGormRoomRepository(db)
+	eventService := services.NewEventService(eventRepo, masjidRepo, userRepo, roomRepo, ticketSigner())
+	notificationService := services.NewNotificationService(storage.NewGormNotificationRepository(db), masjidRepo, userRepo, eventService, notificationChannels())
+	eventService.Notifications = notificationService
+	calendarFeedService := services.NewCalendarFeedService(storage.NewGormCalendarFeedRepository(db), eventService, os.Getenv("PUBLIC_BASE_URL"))
+	orderService := services.NewOrderService(storage.NewGormOrderRepository(db), eventService, paymentProvider(), os.Getenv("PAYMENT_RETURN_URL"))
+	eventHandler := handler.NewEventGrpcHandler(eventService, calendarFeedService, orderService)
 	if err := pb.RegisterEventServiceHandlerServer(ctx, mux, eventHandler); err != nil {
 		log.Fatalf("failed to register EventService handler: %s", err)
 	}
 
+	//notification service
+	notificationHandler := handler.NewNotificationGrpcHandler(notificationService)
+	if err := pb.RegisterNotificationServiceHandlerServer(ctx, mux, notificationHandler); err != nil {
+		log.Fatalf("failed to register NotificationService handler: %s", err)
+	}
+
 	//nikkah service
 	nikkahRepo := storage.NewGormNikkahRepository(db)
 	nikkahService := services.NewNikkahService(nikkahRepo)
@@ -82,9 +104,62 @@ func SetupRESTGateway(ctx context.Context, db *gorm.DB) *runtime.ServeMux {
 		log.Fatalf("failed to register RevertsIoService handler: %s", err)
 	}
 
+	//jumuah service
+	jumuahRepo := storage.NewGormJumuahRepository(db)
+	jumuahService := services.NewJumuahService(jumuahRepo, masjidRepo, userRepo)
+	jumuahHandler := handler.NewJumuahGrpcHandler(jumuahService)
+	if err := pb.RegisterJumuahServiceHandlerServer(ctx, mux, jumuahHandler); err != nil {
+		log.Fatalf("failed to register JumuahService handler: %s", err)
+	}
+
+	//ramadan service
+	ramadanService := services.NewRamadanService(masjidRepo, eventService, userRepo)
+	ramadanHandler := handler.NewRamadanGrpcHandler(ramadanService)
+	if err := pb.RegisterRamadanServiceHandlerServer(ctx, mux, ramadanHandler); err != nil {
+		log.Fatalf("failed to register RamadanService handler: %s", err)
+	}
+
+	//volunteer service
+	volunteerService := services.NewVolunteerService(storage.NewGormVolunteerRepository(db), eventRepo, masjidRepo)
+	volunteerHandler := handler.NewVolunteerGrpcHandler(volunteerService)
+	if err := pb.RegisterVolunteerServiceHandlerServer(ctx, mux, volunteerHandler); err != nil {
+		log.Fatalf("failed to register VolunteerService handler: %s", err)
+	}
+
+	//room service
+	roomService := services.NewRoomService(roomRepo, masjidRepo)
+	roomHandler := handler.NewRoomGrpcHandler(roomService)
+	if err := pb.RegisterRoomServiceHandlerServer(ctx, mux, roomHandler); err != nil {
+		log.Fatalf("failed to register RoomService handler: %s", err)
+	}
+
 	return mux
 }
 
+// SetupCalendarFeeds returns the handler serving calendar feeds under
+// services.CalendarFeedPath.
+func SetupCalendarFeeds(db *gorm.DB) http.Handler {
+	masjidRepo := storage.NewGormMasjidRepository(db)
+	userRepo := storage.NewGormUserRepository(db)
+	// Feeds only read events, so the service needs no rooms, tickets or
+	// notifications.
+	eventService := services.NewEventService(storage.NewGormEventRepository(db), masjidRepo, userRepo, nil, nil)
+	calendarFeedService := services.NewCalendarFeedService(storage.NewGormCalendarFeedRepository(db), eventService, os.Getenv("PUBLIC_BASE_URL"))
+	return handler.NewCalendarFeedHTTPHandler(calendarFeedService)
+}
+
+// SetupPaymentWebhooks returns the handler receiving the events of the
+// payment provider.
+func SetupPaymentWebhooks(db *gorm.DB) http.Handler {
+	masjidRepo := storage.NewGormMasjidRepository(db)
+	userRepo := storage.NewGormUserRepository(db)
+	// Payments only confirm and cancel RSVPs, so the service needs no
+	// rooms, tickets or notifications.
+	eventService := services.NewEventService(storage.NewGormEventRepository(db), masjidRepo, userRepo, nil, nil)
+	orderService := services.NewOrderService(storage.NewGormOrderRepository(db), eventService, paymentProvider(), os.Getenv("PAYMENT_RETURN_URL"))
+	return handler.NewPaymentWebhookHTTPHandler(orderService)
+}
+
 func StartRESTGateway(handler http.Handler, httpEndpoint string) {
 	log.Printf("HTTP server listening on %s", httpEndpoint)
 	if err := http.ListenAndServe(httpEndpoint, handler); err != nil {
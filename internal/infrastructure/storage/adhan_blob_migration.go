@@ -0,0 +1,171 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/mnadev/limestone/internal/application/domain/audio"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/repository"
+	"github.com/mnadev/limestone/internal/infrastructure/blobstore"
+	"gorm.io/gorm"
+	"io"
+	"log"
+	"os"
+)
+
+// legacyAdhanFileColumn held adhan audio before it moved to the blob store.
+const legacyAdhanFileColumn = "file"
+
+// MigrateAdhanBlobs moves the audio of every adhan still stored in the
+// database into store under its content key, one row at a time, and returns how many rows it
+// moved. Rows are committed as they go, so an interrupted run can simply be
+// restarted. With dropColumn set, the emptied column is dropped once every
+// row has been moved.
+func MigrateAdhanBlobs(ctx context.Context, db *gorm.DB, store blobstore.Store, adhanBlobKind string, dropColumn bool) (int, error) {
+	db = db.WithContext(ctx)
+	if !db.Migrator().HasColumn(&entity.Adhan{}, legacyAdhanFileColumn) {
+		return 0, nil
+	}
+
+	repo := NewGormAdhanRepository(db)
+	moved := 0
+	for {
+		var row struct {
+			ID   string
+			File []byte
+		}
+		err := db.Table("adhans").Select("id, file").
+			Where("file IS NOT NULL AND (storage_key IS NULL OR storage_key = '')").
+			Limit(1).Take(&row).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			break
+		}
+		if err != nil {
+			return moved, err
+		}
+
+		sum := sha256.Sum256(row.File)
+		hash := hex.EncodeToString(sum[:])
+		key := blobstore.ContentKey(adhanBlobKind, hash)
+		updates := map[string]interface{}{
+			"storage_key":         key,
+			"sha256":              hash,
+			"size":                len(row.File),
+			"content_type":        helper.AudioContentType(row.File),
+			legacyAdhanFileColumn: nil,
+		}
+		// Audio uploaded before metadata was recorded may not pass today's
+		// checks; it is moved regardless, just without metadata.
+		if info, err := audio.Probe(bytes.NewReader(row.File), int64(len(row.File))); err == nil {
+			updates["content_type"] = info.ContentType()
+			updates["codec"] = info.Codec
+			updates["duration_ms"] = info.Duration.Milliseconds()
+			updates["sample_rate"] = info.SampleRate
+			updates["channels"] = info.Channels
+			updates["bitrate"] = info.Bitrate
+		} else {
+			log.Printf("could not read the metadata of adhan %s: %v", row.ID, err)
+		}
+		blob := &entity.AdhanBlob{Sha256: hash, StorageKey: key, Size: int64(len(row.File))}
+		err = repo.AcquireAdhanBlob(ctx, blob, func() error {
+			return store.Put(ctx, key, bytes.NewReader(row.File), int64(len(row.File)), updates["content_type"].(string))
+		})
+		if err != nil {
+			return moved, err
+		}
+		err = db.Table("adhans").Where("id = ?", row.ID).Updates(updates).Error
+		if err != nil {
+			return moved, err
+		}
+		moved++
+		log.Printf("moved adhan %s to %s (%d bytes)", row.ID, key, len(row.File))
+	}
+
+	if dropColumn {
+		if err := db.Migrator().DropColumn(&entity.Adhan{}, legacyAdhanFileColumn); err != nil {
+			return moved, err
+		}
+	}
+	return moved, nil
+}
+
+// HashAdhanBlobs moves the audio and renditions stored before content was
+// hashed to their content keys in store, so that identical audio is kept
+// once, and returns how many it moved. Each is committed as it goes, so an
+// interrupted run can simply be restarted.
+func HashAdhanBlobs(ctx context.Context, db *gorm.DB, store blobstore.Store, adhanBlobKind string) (int, error) {
+	db = db.WithContext(ctx)
+	repo := NewGormAdhanRepository(db)
+	hashed := 0
+	for _, table := range []string{"adhans", "adhan_renditions"} {
+		for {
+			var row struct {
+				StorageKey  string
+				ContentType string
+			}
+			err := db.Table(table).Select("storage_key, content_type").
+				Where("sha256 = '' AND storage_key <> ''").
+				Limit(1).Take(&row).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				break
+			}
+			if err != nil {
+				return hashed, err
+			}
+			hash, key, err := hashAdhanBlob(ctx, repo, store, adhanBlobKind, row.StorageKey, row.ContentType)
+			if err != nil {
+				return hashed, fmt.Errorf("%s: %w", row.StorageKey, err)
+			}
+			err = db.Table(table).Where("storage_key = ?", row.StorageKey).
+				Updates(map[string]interface{}{"storage_key": key, "sha256": hash}).Error
+			if err != nil {
+				return hashed, err
+			}
+			if err := store.Delete(ctx, row.StorageKey); err != nil {
+				log.Printf("failed to delete adhan blob %s: %v", row.StorageKey, err)
+			}
+			hashed++
+			log.Printf("moved %s to %s", row.StorageKey, key)
+		}
+	}
+	return hashed, nil
+}
+
+// hashAdhanBlob copies the blob under key to its content key, or adds a
+// reference to the copy already there, and returns its hash and new key.
+func hashAdhanBlob(ctx context.Context, repo repository.AdhanRepository, store blobstore.Store, adhanBlobKind, key, contentType string) (string, string, error) {
+	body, err := store.Get(ctx, key, 0, 0)
+	if err != nil {
+		return "", "", err
+	}
+	defer body.Close()
+	spool, err := os.CreateTemp("", "adhan-hash-*")
+	if err != nil {
+		return "", "", err
+	}
+	defer os.Remove(spool.Name())
+	defer spool.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(spool, hash), body)
+	if err != nil {
+		return "", "", err
+	}
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return "", "", err
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+	contentKey := blobstore.ContentKey(adhanBlobKind, sum)
+	err = repo.AcquireAdhanBlob(ctx, &entity.AdhanBlob{Sha256: sum, StorageKey: contentKey, Size: size}, func() error {
+		return store.Put(ctx, contentKey, spool, size, contentType)
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return sum, contentKey, nil
+}
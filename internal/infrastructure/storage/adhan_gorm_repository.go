@@ -2,9 +2,12 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"github.com/mnadev/limestone/internal/application/domain/entity"
 	"github.com/mnadev/limestone/internal/application/repository"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
 )
 
 type GormAdhanRepository struct {
@@ -23,7 +26,7 @@ func (r *GormAdhanRepository) CreateAdhan(ctx context.Context, adhan *entity.Adh
 }
 
 func (r *GormAdhanRepository) UpdateAdhan(ctx context.Context, adhan *entity.Adhan) (*entity.Adhan, error) {
-	if err := r.db.WithContext(ctx).Model(&entity.Adhan{}).Where("id = ?", adhan.ID).Updates(adhan).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&entity.Adhan{}).Where("id = ?", adhan.ID).Omit(clause.Associations).Updates(adhan).Error; err != nil {
 		return nil, err
 	}
 	return adhan, nil
@@ -31,12 +34,171 @@ func (r *GormAdhanRepository) UpdateAdhan(ctx context.Context, adhan *entity.Adh
 
 func (r *GormAdhanRepository) GetByIDAdhan(ctx context.Context, id string) (*entity.Adhan, error) {
 	var adhan entity.Adhan
-	if err := r.db.WithContext(ctx).First(&adhan, "id = ?", id).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Renditions", orderRenditions).First(&adhan, "id = ?", id).Error; err != nil {
 		return nil, err
 	}
 	return &adhan, nil
 }
 
+// DeleteAdhan also removes the adhan from any prayer it was assigned to,
+// and its renditions.
 func (r *GormAdhanRepository) DeleteAdhan(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&entity.Adhan{}, "id = ?", id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&entity.AdhanAssignment{}, "adhan_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&entity.AdhanRendition{}, "adhan_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entity.Adhan{}, "id = ?", id).Error
+	})
+}
+
+func (r *GormAdhanRepository) ListAdhans(ctx context.Context, masjidID string) ([]entity.Adhan, error) {
+	var adhans []entity.Adhan
+	err := r.db.WithContext(ctx).
+		Preload("Renditions", orderRenditions).
+		Where("masjid_id = ?", masjidID).
+		Order("title ASC, created_at ASC").
+		Find(&adhans).Error
+	if err != nil {
+		return nil, err
+	}
+	return adhans, nil
+}
+
+func (r *GormAdhanRepository) ListAdhanAssignments(ctx context.Context, masjidID string) ([]entity.AdhanAssignment, error) {
+	var assignments []entity.AdhanAssignment
+	err := r.db.WithContext(ctx).
+		Where("masjid_id = ?", masjidID).
+		Order("prayer ASC").
+		Find(&assignments).Error
+	if err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
+
+func (r *GormAdhanRepository) SetAdhanAssignment(ctx context.Context, assignment *entity.AdhanAssignment) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("masjid_id = ? AND prayer = ?", assignment.MasjidId, assignment.Prayer).
+			Delete(&entity.AdhanAssignment{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(assignment).Error
+	})
+}
+
+func (r *GormAdhanRepository) DeleteAdhanAssignment(ctx context.Context, masjidID string, prayer entity.Prayer) error {
+	return r.db.WithContext(ctx).
+		Where("masjid_id = ? AND prayer = ?", masjidID, prayer).
+		Delete(&entity.AdhanAssignment{}).Error
+}
+
+func (r *GormAdhanRepository) ListAdhansByProcessingStatus(ctx context.Context, statuses ...entity.AdhanProcessingStatus) ([]entity.Adhan, error) {
+	var adhans []entity.Adhan
+	err := r.db.WithContext(ctx).
+		Where("processing_status IN ?", statuses).
+		Order("updated_at ASC").
+		Find(&adhans).Error
+	if err != nil {
+		return nil, err
+	}
+	return adhans, nil
+}
+
+func (r *GormAdhanRepository) UpdateAdhanProcessing(ctx context.Context, adhan *entity.Adhan) ([]entity.AdhanRendition, error) {
+	var replaced []entity.AdhanRendition
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.Adhan{}).
+			Where("id = ? AND storage_key = ?", adhan.ID, adhan.StorageKey).
+			Updates(map[string]interface{}{
+				"processing_status": adhan.ProcessingStatus,
+				"processing_error":  adhan.ProcessingError,
+				"loudness_lufs":     adhan.LoudnessLufs,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if err := tx.Where("adhan_id = ?", adhan.ID).Find(&replaced).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&entity.AdhanRendition{}, "adhan_id = ?", adhan.ID).Error; err != nil {
+			return err
+		}
+		if len(adhan.Renditions) == 0 {
+			return nil
+		}
+		return tx.Create(&adhan.Renditions).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return replaced, nil
+}
+
+func (r *GormAdhanRepository) AcquireAdhanBlob(ctx context.Context, blob *entity.AdhanBlob, store func() error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing entity.AdhanBlob
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Take(&existing, "sha256 = ?", blob.Sha256).Error
+		if err == nil {
+			return tx.Model(&existing).Updates(map[string]interface{}{
+				"ref_count":  gorm.Expr("ref_count + 1"),
+				"updated_at": time.Now(),
+			}).Error
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if err := store(); err != nil {
+			return err
+		}
+		now := time.Now()
+		blob.RefCount = 1
+		blob.CreatedAt = now
+		blob.UpdatedAt = now
+		// A concurrent upload of the same content may have stored it too;
+		// both wrote the same bytes, so only the count needs merging.
+		return tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "sha256"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"ref_count":  gorm.Expr("adhan_blobs.ref_count + 1"),
+				"updated_at": now,
+			}),
+		}).Create(blob).Error
+	})
+}
+
+func (r *GormAdhanRepository) ReleaseAdhanBlob(ctx context.Context, sha256 string, remove func(storageKey string) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var blob entity.AdhanBlob
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Take(&blob, "sha256 = ?", sha256).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if blob.RefCount > 1 {
+			return tx.Model(&blob).Updates(map[string]interface{}{
+				"ref_count":  gorm.Expr("ref_count - 1"),
+				"updated_at": time.Now(),
+			}).Error
+		}
+		// The row stays locked while the content is removed, so an upload
+		// of the same content cannot find it in between.
+		if err := remove(blob.StorageKey); err != nil {
+			return err
+		}
+		return tx.Delete(&blob).Error
+	})
+}
+
+func orderRenditions(db *gorm.DB) *gorm.DB {
+	return db.Order("name ASC")
 }
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"context"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type GormCalendarFeedRepository struct {
+	db *gorm.DB
+}
+
+func NewGormCalendarFeedRepository(db *gorm.DB) repository.CalendarFeedRepository {
+	return &GormCalendarFeedRepository{db: db}
+}
+
+func (r *GormCalendarFeedRepository) GetCalendarFeed(ctx context.Context, masjidID, userID string) (*entity.CalendarFeed, error) {
+	var feed entity.CalendarFeed
+	query := r.db.WithContext(ctx)
+	if masjidID != "" {
+		query = query.Where("masjid_id = ?", masjidID)
+	} else {
+		query = query.Where("user_id = ?", userID)
+	}
+	if err := query.Take(&feed).Error; err != nil {
+		return nil, err
+	}
+	return &feed, nil
+}
+
+func (r *GormCalendarFeedRepository) GetCalendarFeedByToken(ctx context.Context, token string) (*entity.CalendarFeed, error) {
+	var feed entity.CalendarFeed
+	if err := r.db.WithContext(ctx).Take(&feed, "token = ?", token).Error; err != nil {
+		return nil, err
+	}
+	return &feed, nil
+}
+
+func (r *GormCalendarFeedRepository) CreateCalendarFeed(ctx context.Context, feed *entity.CalendarFeed) (*entity.CalendarFeed, error) {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(feed).Error
+	if err != nil {
+		return nil, err
+	}
+	userID := ""
+	if feed.UserId != nil {
+		userID = feed.UserId.String()
+	}
+	return r.GetCalendarFeed(ctx, feed.MasjidId, userID)
+}
+
+func (r *GormCalendarFeedRepository) UpdateCalendarFeedToken(ctx context.Context, feed *entity.CalendarFeed) (*entity.CalendarFeed, error) {
+	err := r.db.WithContext(ctx).Model(&entity.CalendarFeed{}).Where("id = ?", feed.ID).Updates(map[string]interface{}{
+		"token":      feed.Token,
+		"updated_at": time.Now(),
+	}).Error
+	if err != nil {
+		return nil, err
+	}
+	return feed, nil
+}
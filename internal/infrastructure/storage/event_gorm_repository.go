@@ -2,9 +2,13 @@ package storage
 
 import (
 	"context"
+	"errors"
+	"github.com/google/uuid"
 	"github.com/mnadev/limestone/internal/application/domain/entity"
 	"github.com/mnadev/limestone/internal/application/repository"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
 )
 
 type GormEventRepository struct {
@@ -15,15 +19,48 @@ func NewGormEventRepository(db *gorm.DB) repository.EventRepository {
 	return &GormEventRepository{db: db}
 }
 
+// Create stores an event with its types and room bookings. Ticket types
+// are set on their own, so exceptions and series split off carry none of
+// their own. An exception takes the place of the bookings of its series
+// for the occurrence it replaces.
 func (r *GormEventRepository) Create(ctx context.Context, event *entity.Event) (*entity.Event, error) {
-	if err := r.db.WithContext(ctx).Create(event).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if event.RecurringEventId != nil && event.OriginalStartTime != nil {
+			err := tx.Delete(&entity.RoomBooking{}, "event_id = ? AND start_time = ?", *event.RecurringEventId, *event.OriginalStartTime).Error
+			if err != nil {
+				return err
+			}
+		}
+		if err := checkBookings(tx, event, event.Bookings); err != nil {
+			return err
+		}
+		return tx.Omit("TicketTypes").Create(event).Error
+	})
+	if err != nil {
 		return nil, err
 	}
 	return event, nil
 }
 
+// Update stores the event's fields that are set and, when event.Types or
+// event.Bookings is not nil, replaces its types or room bookings.
 func (r *GormEventRepository) Update(ctx context.Context, event *entity.Event) (*entity.Event, error) {
-	if err := r.db.WithContext(ctx).Model(&entity.Event{}).Where("id = ?", event.ID).Updates(event).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&entity.Event{}).Where("id = ?", event.ID).Omit(clause.Associations).Updates(event).Error
+		if err != nil {
+			return err
+		}
+		if event.Types != nil {
+			if err := replaceTypes(tx, event); err != nil {
+				return err
+			}
+		}
+		if event.Bookings == nil {
+			return nil
+		}
+		return replaceBookings(tx, event)
+	})
+	if err != nil {
 		return nil, err
 	}
 	return event, nil
@@ -31,24 +68,477 @@ func (r *GormEventRepository) Update(ctx context.Context, event *entity.Event) (
 
 func (r *GormEventRepository) GetByID(ctx context.Context, id string) (*entity.Event, error) {
 	var event entity.Event
-	if err := r.db.WithContext(ctx).First(&event, "id = ?", id).Error; err != nil {
+	err := r.db.WithContext(ctx).Preload("Types").Preload("Bookings").
+		Preload("TicketTypes", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC, id ASC") }).
+		First(&event, "id = ?", id).Error
+	if err != nil {
 		return nil, err
 	}
 	return &event, nil
 }
 
+// Delete deletes an event and, if it recurs, its exceptions.
 func (r *GormEventRepository) Delete(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&entity.Event{}, "id = ?", id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		exceptions := tx.Model(&entity.Event{}).Select("id").Where("recurring_event_id = ?", id)
+		if err := tx.Delete(&entity.EventType{}, "event_id = ? OR event_id IN (?)", id, exceptions).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&entity.RoomBooking{}, "event_id = ? OR event_id IN (?)", id, exceptions).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&entity.Event{}, "recurring_event_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entity.Event{}, "id = ?", id).Error
+	})
 }
 
-func (r *GormEventRepository) ListEvents(ctx context.Context, pageSize int32, pageToken string) ([]*entity.Event, error) {
+func (r *GormEventRepository) ListEvents(ctx context.Context, params *entity.ListEventsQueryParams) ([]*entity.Event, error) {
 	var events []*entity.Event
-	query := r.db.WithContext(ctx).Limit(int(pageSize)).Order("id")
+	query := filterEvents(r.db.WithContext(ctx), params).Preload("Types").Preload("Bookings").Limit(int(params.PageSize))
 
-	if pageToken != "" {
-		query = query.Where("id > ?", pageToken)
+	if len(params.OrderBy) == 0 {
+		query = query.Order("id")
+		if params.PageToken != "" {
+			query = query.Where("id > ?", params.PageToken)
+		}
+	} else {
+		for _, order := range params.OrderBy {
+			query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: orderColumn(order.Field)}, Desc: order.Desc})
+		}
+		query = query.Order("id").Offset(params.Offset)
+	}
+	if !params.StartFrom.IsZero() {
+		query = query.Where("start_time >= ?", params.StartFrom)
+	}
+	if !params.StartBefore.IsZero() {
+		query = query.Where("start_time < ?", params.StartBefore)
 	}
 
 	result := query.Find(&events)
 	return events, result.Error
 }
+
+func (r *GormEventRepository) CountEvents(ctx context.Context, params *entity.ListEventsQueryParams) (int64, error) {
+	var count int64
+	query := filterEvents(r.db.WithContext(ctx).Model(&entity.Event{}), params)
+	if !params.StartFrom.IsZero() {
+		query = query.Where("start_time >= ?", params.StartFrom)
+	}
+	if !params.StartBefore.IsZero() {
+		query = query.Where("start_time < ?", params.StartBefore)
+	}
+	err := query.Count(&count).Error
+	return count, err
+}
+
+func (r *GormEventRepository) ListEventsBetween(ctx context.Context, params *entity.ListEventsQueryParams) ([]*entity.Event, error) {
+	var events []*entity.Event
+	from, before := params.StartFrom, params.StartBefore
+	query := r.db.WithContext(ctx).Preload("Types").Preload("Bookings").Where(
+		r.db.Where("recurrence = '' AND start_time >= ? AND start_time < ?", from, before).
+			Or("recurrence <> '' AND start_time < ? AND (series_end IS NULL OR series_end >= ?)", before, from).
+			Or("recurring_event_id IS NOT NULL AND original_start_time >= ? AND original_start_time < ?", from, before),
+	)
+	if params.MasjidId != "" {
+		query = query.Where("masjid_id = ?", params.MasjidId)
+	}
+	err := query.Order("start_time ASC, id ASC").Find(&events).Error
+	return events, err
+}
+
+func (r *GormEventRepository) ListByScheduleKeys(ctx context.Context, keys []string) ([]*entity.Event, error) {
+	var events []*entity.Event
+	if len(keys) == 0 {
+		return events, nil
+	}
+	err := r.db.WithContext(ctx).Where("schedule_key IN ?", keys).Find(&events).Error
+	return events, err
+}
+
+func (r *GormEventRepository) GetException(ctx context.Context, seriesID string, originalStart time.Time) (*entity.Event, error) {
+	var event entity.Event
+	err := r.db.WithContext(ctx).Preload("Types").Preload("Bookings").
+		First(&event, "recurring_event_id = ? AND original_start_time = ?", seriesID, originalStart).Error
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (r *GormEventRepository) ListExceptions(ctx context.Context, seriesIDs []string) ([]*entity.Event, error) {
+	var events []*entity.Event
+	if len(seriesIDs) == 0 {
+		return nil, nil
+	}
+	err := r.db.WithContext(ctx).Preload("Types").Preload("Bookings").
+		Where("recurring_event_id IN ?", seriesIDs).
+		Order("original_start_time ASC").Find(&events).Error
+	return events, err
+}
+
+func (r *GormEventRepository) SaveRecurrence(ctx context.Context, series *entity.Event) error {
+	return saveRecurrence(r.db.WithContext(ctx), series)
+}
+
+func (r *GormEventRepository) ExcludeOccurrence(ctx context.Context, series *entity.Event, originalStart time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := saveRecurrence(tx, series); err != nil {
+			return err
+		}
+		if err := tx.Delete(&entity.RoomBooking{}, "event_id = ? AND start_time = ?", series.ID, originalStart).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entity.Event{}, "recurring_event_id = ? AND original_start_time = ?", series.ID, originalStart).Error
+	})
+}
+
+func (r *GormEventRepository) EndSeries(ctx context.Context, series *entity.Event, at time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := saveRecurrence(tx, series); err != nil {
+			return err
+		}
+		if err := tx.Delete(&entity.RoomBooking{}, "event_id = ? AND start_time >= ?", series.ID, at).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entity.Event{}, "recurring_event_id = ? AND original_start_time >= ?", series.ID, at).Error
+	})
+}
+
+func (r *GormEventRepository) SplitSeries(ctx context.Context, head, tail *entity.Event, at time.Time) error {
+	shift := tail.StartTime.Sub(at)
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := saveRecurrence(tx, head); err != nil {
+			return err
+		}
+		if err := tx.Delete(&entity.RoomBooking{}, "event_id = ? AND start_time >= ?", head.ID, at).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&entity.Event{}, "recurring_event_id = ? AND original_start_time = ?", head.ID, at).Error; err != nil {
+			return err
+		}
+
+		// The exceptions following at move to the tail first, so that its
+		// rule is checked without the occurrences they replace.
+		var exceptions []entity.Event
+		if err := tx.Where("recurring_event_id = ? AND original_start_time > ?", head.ID, at).Find(&exceptions).Error; err != nil {
+			return err
+		}
+		for _, exception := range exceptions {
+			err := tx.Model(&entity.Event{}).Where("id = ?", exception.ID).Updates(map[string]interface{}{
+				"recurring_event_id":  tail.ID,
+				"original_start_time": exception.OriginalStartTime.Add(shift),
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+		if err := checkBookings(tx, tail, tail.Bookings); err != nil {
+			return err
+		}
+		return tx.Omit("TicketTypes").Create(tail).Error
+	})
+}
+
+func (r *GormEventRepository) CreateRsvp(ctx context.Context, rsvp *entity.EventRsvp) (*entity.EventRsvp, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		event, err := lockEvent(tx, rsvp.EventId.String())
+		if err != nil {
+			return err
+		}
+		var existing entity.EventRsvp
+		err = tx.Where("event_id = ? AND user_id = ?", rsvp.EventId, rsvp.UserId).Take(&existing).Error
+		switch {
+		case err == nil && existing.Status != entity.RsvpCancelled:
+			*rsvp = existing
+			return setWaitlistPositions(tx, rsvp)
+		case err == nil:
+			rsvp.ID = existing.ID
+			rsvp.CreatedAt = existing.CreatedAt
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return err
+		}
+
+		confirmed, err := countConfirmed(tx, event.ID.String())
+		if err != nil {
+			return err
+		}
+		rsvp.Status = entity.RsvpConfirmed
+		if event.PlacesLeft(confirmed) == 0 {
+			rsvp.Status = entity.RsvpWaitlisted
+		}
+		if err := tx.Save(rsvp).Error; err != nil {
+			return err
+		}
+		return setWaitlistPositions(tx, rsvp)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rsvp, nil
+}
+
+func (r *GormEventRepository) CancelRsvp(ctx context.Context, eventID, userID string) (*entity.EventRsvp, error) {
+	var rsvp entity.EventRsvp
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		event, err := lockEvent(tx, eventID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("event_id = ? AND user_id = ?", eventID, userID).Take(&rsvp).Error; err != nil {
+			return err
+		}
+		if rsvp.Status == entity.RsvpCancelled {
+			return nil
+		}
+		rsvp.Status = entity.RsvpCancelled
+		rsvp.UpdatedAt = time.Now()
+		if err := tx.Model(&rsvp).Select("status", "updated_at").Updates(&rsvp).Error; err != nil {
+			return err
+		}
+		return promoteWaitlist(tx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rsvp, nil
+}
+
+func (r *GormEventRepository) PromoteWaitlist(ctx context.Context, eventID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		event, err := lockEvent(tx, eventID)
+		if err != nil {
+			return err
+		}
+		return promoteWaitlist(tx, event)
+	})
+}
+
+func (r *GormEventRepository) ListRsvps(ctx context.Context, eventID string, status entity.RsvpStatus, offset, limit int) ([]entity.EventRsvp, error) {
+	query := r.db.WithContext(ctx).Preload("User").Where("event_id = ?", eventID)
+	if status == entity.RsvpStatusUnspecified {
+		query = query.Where("status IN ?", []entity.RsvpStatus{entity.RsvpConfirmed, entity.RsvpWaitlisted})
+	} else {
+		query = query.Where("status = ?", status)
+	}
+	var rsvps []entity.EventRsvp
+	err := query.Order("status ASC, requested_at ASC, id ASC").Offset(offset).Limit(limit).Find(&rsvps).Error
+	if err != nil {
+		return nil, err
+	}
+	return rsvps, setWaitlistPositions(r.db.WithContext(ctx), rsvpPointers(rsvps)...)
+}
+
+func (r *GormEventRepository) ListUserRsvps(ctx context.Context, userID string, includeCancelled bool) ([]entity.EventRsvp, error) {
+	query := r.db.WithContext(ctx).Preload("Event").Preload("Event.Types").
+		Joins("JOIN events ON events.id = event_rsvps.event_id").
+		Where("event_rsvps.user_id = ?", userID)
+	if !includeCancelled {
+		query = query.Where("event_rsvps.status <> ?", entity.RsvpCancelled)
+	}
+	var rsvps []entity.EventRsvp
+	if err := query.Order("events.start_time ASC").Find(&rsvps).Error; err != nil {
+		return nil, err
+	}
+	return rsvps, setWaitlistPositions(r.db.WithContext(ctx), rsvpPointers(rsvps)...)
+}
+
+func (r *GormEventRepository) GetRsvp(ctx context.Context, id string) (*entity.EventRsvp, error) {
+	var rsvp entity.EventRsvp
+	if err := r.db.WithContext(ctx).Preload("User").First(&rsvp, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &rsvp, nil
+}
+
+func (r *GormEventRepository) CreateCheckIn(ctx context.Context, checkIn *entity.EventCheckIn) (*entity.EventCheckIn, bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(checkIn)
+	if result.Error != nil {
+		return nil, false, result.Error
+	}
+	if result.RowsAffected == 1 {
+		return checkIn, true, nil
+	}
+	var existing entity.EventCheckIn
+	err := r.db.WithContext(ctx).
+		Where("rsvp_id = ? AND occurrence = ?", checkIn.RsvpId, checkIn.Occurrence).
+		Take(&existing).Error
+	if err != nil {
+		return nil, false, err
+	}
+	return &existing, false, nil
+}
+
+func (r *GormEventRepository) CountAttendance(ctx context.Context, eventID, occurrence string) (*entity.Attendance, error) {
+	db := r.db.WithContext(ctx)
+	confirmed, err := countConfirmed(db, eventID)
+	if err != nil {
+		return nil, err
+	}
+	var checkedIn int64
+	err = db.Model(&entity.EventCheckIn{}).
+		Where("event_id = ? AND occurrence = ?", eventID, occurrence).
+		Count(&checkedIn).Error
+	if err != nil {
+		return nil, err
+	}
+	return &entity.Attendance{Confirmed: confirmed, CheckedIn: checkedIn}, nil
+}
+
+// filterEvents restricts query to the events matching the masjid, type,
+// gender, payment, RSVP and livestream filters of params.
+func filterEvents(query *gorm.DB, params *entity.ListEventsQueryParams) *gorm.DB {
+	if params.MasjidId != "" {
+		query = query.Where("masjid_id = ?", params.MasjidId)
+	}
+	for _, group := range params.TypeGroups {
+		query = query.Where("EXISTS (SELECT 1 FROM event_types WHERE event_types.event_id = events.id AND event_types.type IN ?)", group)
+	}
+	if params.GenderRestriction != nil {
+		query = query.Where("gender_restriction = ?", *params.GenderRestriction)
+	}
+	if params.IsPaid != nil {
+		query = query.Where("is_paid = ?", *params.IsPaid)
+	}
+	if params.RequiresRsvp != nil {
+		query = query.Where("requires_rsvp = ?", *params.RequiresRsvp)
+	}
+	if params.HasLivestream != nil {
+		if *params.HasLivestream {
+			query = query.Where("livestream_link <> ''")
+		} else {
+			query = query.Where("livestream_link = ''")
+		}
+	}
+	return query
+}
+
+// orderColumn returns the column events are ordered by for field.
+func orderColumn(field entity.EventOrderField) string {
+	if field == entity.OrderByCreateTime {
+		return "created_at"
+	}
+	return string(field)
+}
+
+// replaceTypes replaces the stored types of event with event.Types.
+func replaceTypes(tx *gorm.DB, event *entity.Event) error {
+	if err := tx.Delete(&entity.EventType{}, "event_id = ?", event.ID).Error; err != nil {
+		return err
+	}
+	if len(event.Types) == 0 {
+		return nil
+	}
+	for i := range event.Types {
+		event.Types[i].EventId = event.ID
+	}
+	return tx.Create(&event.Types).Error
+}
+
+// replaceBookings replaces the stored room bookings of event with
+// event.Bookings, once checkBookings finds the rooms free for the event as
+// stored.
+func replaceBookings(tx *gorm.DB, event *entity.Event) error {
+	var stored entity.Event
+	if err := tx.Take(&stored, "id = ?", event.ID).Error; err != nil {
+		return err
+	}
+	if err := checkBookings(tx, &stored, event.Bookings); err != nil {
+		return err
+	}
+	if err := tx.Delete(&entity.RoomBooking{}, "event_id = ?", event.ID).Error; err != nil {
+		return err
+	}
+	if len(event.Bookings) == 0 {
+		return nil
+	}
+	for i := range event.Bookings {
+		event.Bookings[i].EventId = &event.ID
+	}
+	return tx.Omit("Room").Create(&event.Bookings).Error
+}
+
+// saveRecurrence stores the recurrence of a series, clearing SeriesEnd
+// when it no longer ends.
+func saveRecurrence(tx *gorm.DB, series *entity.Event) error {
+	return tx.Model(&entity.Event{}).Where("id = ?", series.ID).Updates(map[string]interface{}{
+		"recurrence": series.Recurrence,
+		"series_end": series.SeriesEnd,
+		"updated_at": time.Now(),
+	}).Error
+}
+
+// lockEvent reads an event and locks it until tx ends. Every change to the
+// event's RSVPs takes this lock first.
+func lockEvent(tx *gorm.DB, id string) (*entity.Event, error) {
+	var event entity.Event
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&event, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func countConfirmed(tx *gorm.DB, eventID string) (int64, error) {
+	var confirmed int64
+	err := tx.Model(&entity.EventRsvp{}).
+		Where("event_id = ? AND status = ?", eventID, entity.RsvpConfirmed).
+		Count(&confirmed).Error
+	return confirmed, err
+}
+
+// promoteWaitlist confirms the oldest waitlisted RSVPs into the places left
+// at a locked event.
+func promoteWaitlist(tx *gorm.DB, event *entity.Event) error {
+	confirmed, err := countConfirmed(tx, event.ID.String())
+	if err != nil {
+		return err
+	}
+	places := event.PlacesLeft(confirmed)
+	if places == 0 {
+		return nil
+	}
+	query := tx.Model(&entity.EventRsvp{}).
+		Where("event_id = ? AND status = ?", event.ID, entity.RsvpWaitlisted).
+		Order("requested_at ASC, id ASC")
+	if places > 0 {
+		query = query.Limit(int(places))
+	}
+	var ids []uuid.UUID
+	if err := query.Pluck("id", &ids).Error; err != nil || len(ids) == 0 {
+		return err
+	}
+	return tx.Model(&entity.EventRsvp{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+		"status":     entity.RsvpConfirmed,
+		"updated_at": time.Now(),
+	}).Error
+}
+
+// setWaitlistPositions fills in the waitlist position of each waitlisted
+// RSVP.
+func setWaitlistPositions(db *gorm.DB, rsvps ...*entity.EventRsvp) error {
+	for _, rsvp := range rsvps {
+		rsvp.WaitlistPosition = 0
+		if rsvp.Status != entity.RsvpWaitlisted {
+			continue
+		}
+		var ahead int64
+		err := db.Model(&entity.EventRsvp{}).
+			Where("event_id = ? AND status = ?", rsvp.EventId, entity.RsvpWaitlisted).
+			Where("requested_at < ? OR (requested_at = ? AND id < ?)", rsvp.RequestedAt, rsvp.RequestedAt, rsvp.ID).
+			Count(&ahead).Error
+		if err != nil {
+			return err
+		}
+		rsvp.WaitlistPosition = int(ahead) + 1
+	}
+	return nil
+}
+
+func rsvpPointers(rsvps []entity.EventRsvp) []*entity.EventRsvp {
+	pointers := make([]*entity.EventRsvp, len(rsvps))
+	for i := range rsvps {
+		pointers[i] = &rsvps[i]
+	}
+	return pointers
+}
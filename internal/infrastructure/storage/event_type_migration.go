@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"log"
+	"strings"
+)
+
+// legacyEventTypesColumn held the types of an event, joined by commas,
+// before they moved to the event_types table.
+const legacyEventTypesColumn = "event_types"
+
+// MigrateEventTypes copies the types of every event still stored in the
+// legacy column into the event_types table, one event at a time, and
+// returns how many events it migrated. Each event is committed as it goes,
+// so an interrupted run can simply be restarted. With dropColumn set, the
+// emptied column is dropped once every event has been migrated.
+func MigrateEventTypes(ctx context.Context, db *gorm.DB, dropColumn bool) (int, error) {
+	db = db.WithContext(ctx)
+	if !db.Migrator().HasColumn(&entity.Event{}, legacyEventTypesColumn) {
+		return 0, nil
+	}
+
+	migrated := 0
+	for {
+		var row struct {
+			ID         string
+			EventTypes string
+		}
+		err := db.Table("events").Select("id, " + legacyEventTypesColumn).
+			Where(legacyEventTypesColumn + " <> ''").
+			Limit(1).Take(&row).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			break
+		}
+		if err != nil {
+			return migrated, err
+		}
+
+		event := &entity.Event{}
+		if err := event.ID.UnmarshalText([]byte(row.ID)); err != nil {
+			return migrated, err
+		}
+		event.SetTypes(strings.Split(row.EventTypes, ",")...)
+		err = db.Transaction(func(tx *gorm.DB) error {
+			if len(event.Types) > 0 {
+				if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&event.Types).Error; err != nil {
+					return err
+				}
+			}
+			return tx.Table("events").Where("id = ?", row.ID).Update(legacyEventTypesColumn, "").Error
+		})
+		if err != nil {
+			return migrated, err
+		}
+		migrated++
+		log.Printf("migrated the types of event %s: %s", row.ID, strings.Join(event.TypeNames(), ", "))
+	}
+
+	if dropColumn {
+		if err := db.Migrator().DropColumn(&entity.Event{}, legacyEventTypesColumn); err != nil {
+			return migrated, err
+		}
+	}
+	return migrated, nil
+}
@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"context"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/repository"
+	"gorm.io/gorm"
+)
+
+type GormJumuahRepository struct {
+	db *gorm.DB
+}
+
+func NewGormJumuahRepository(db *gorm.DB) repository.JumuahRepository {
+	return &GormJumuahRepository{db: db}
+}
+
+func (r *GormJumuahRepository) Create(ctx context.Context, slot *entity.PrayerSlot) (*entity.PrayerSlot, error) {
+	if err := r.db.WithContext(ctx).Create(slot).Error; err != nil {
+		return nil, err
+	}
+	return slot, nil
+}
+
+func (r *GormJumuahRepository) Update(ctx context.Context, slot *entity.PrayerSlot) (*entity.PrayerSlot, error) {
+	if err := r.db.WithContext(ctx).Save(slot).Error; err != nil {
+		return nil, err
+	}
+	return slot, nil
+}
+
+func (r *GormJumuahRepository) GetByID(ctx context.Context, id string) (*entity.PrayerSlot, error) {
+	var slot entity.PrayerSlot
+	if err := r.db.WithContext(ctx).First(&slot, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &slot, nil
+}
+
+func (r *GormJumuahRepository) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Delete(&entity.PrayerSlot{}, "id = ?", id).Error
+}
+
+func (r *GormJumuahRepository) List(ctx context.Context, params *entity.ListPrayerSlotsQueryParams) ([]entity.PrayerSlot, error) {
+	db := r.db.WithContext(ctx).Where("masjid_id = ?", params.MasjidId)
+	if params.Type != nil {
+		db = db.Where("type = ?", *params.Type)
+	}
+
+	var slots []entity.PrayerSlot
+	if err := db.Order("type ASC, date ASC, start_date ASC, start_time ASC").Find(&slots).Error; err != nil {
+		return nil, err
+	}
+	return slots, nil
+}
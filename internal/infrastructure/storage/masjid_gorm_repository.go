@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/geo"
 	"github.com/mnadev/limestone/internal/application/repository"
 	"gorm.io/gorm"
 )
@@ -89,6 +90,130 @@ func (r *GormMasjidRepository) ListMasjids(ctx context.Context, params *entity.L
 	return masjids, int32(totalCount), nil
 }
 
+// haversineSQL computes the great-circle distance in kilometres between each
+// row and the point bound to its three placeholders (latitude, latitude,
+// longitude).
+const haversineSQL = "2 * 6371 * ASIN(LEAST(1, SQRT(POWER(SIN(RADIANS(latitude - ?) / 2), 2) + COS(RADIANS(?)) * COS(RADIANS(latitude)) * POWER(SIN(RADIANS(longitude - ?) / 2), 2))))"
+
+func (r *GormMasjidRepository) SearchNearby(ctx context.Context, params *entity.SearchNearbyMasjidsQueryParams) ([]entity.NearbyMasjid, error) {
+	center := geo.Coordinates{Latitude: params.Latitude, Longitude: params.Longitude}
+	minLat, maxLat, minLng, maxLng, wraps := geo.BoundingBox(center, params.RadiusKm)
+
+	// The bounding box lets Postgres use idx_masjids_coordinates before the
+	// exact distance is computed for the remaining rows.
+	db := r.db.WithContext(ctx).Model(&entity.Masjid{}).
+		Select("*, "+haversineSQL+" AS distance_km", center.Latitude, center.Latitude, center.Longitude).
+		Where("NOT (latitude = 0 AND longitude = 0)").
+		Where("latitude BETWEEN ? AND ?", minLat, maxLat)
+	if !wraps {
+		db = db.Where("longitude BETWEEN ? AND ?", minLng, maxLng)
+	}
+
+	var results []entity.NearbyMasjid
+	err := db.Where(haversineSQL+" <= ?", center.Latitude, center.Latitude, center.Longitude, params.RadiusKm).
+		Order("distance_km ASC, id ASC").
+		Limit(int(params.Limit)).
+		Scan(&results).Error
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (r *GormMasjidRepository) CreateIqamahRule(ctx context.Context, rule *entity.IqamahRule) (*entity.IqamahRule, error) {
+	if err := r.db.WithContext(ctx).Create(rule).Error; err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (r *GormMasjidRepository) UpdateIqamahRule(ctx context.Context, rule *entity.IqamahRule) (*entity.IqamahRule, error) {
+	if err := r.db.WithContext(ctx).Save(rule).Error; err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (r *GormMasjidRepository) GetIqamahRuleByID(ctx context.Context, id string) (*entity.IqamahRule, error) {
+	var rule entity.IqamahRule
+	if err := r.db.WithContext(ctx).First(&rule, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r *GormMasjidRepository) DeleteIqamahRule(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Delete(&entity.IqamahRule{}, "id = ?", id).Error
+}
+
+func (r *GormMasjidRepository) ListIqamahRules(ctx context.Context, masjidID string) ([]entity.IqamahRule, error) {
+	var rules []entity.IqamahRule
+	err := r.db.WithContext(ctx).
+		Where("masjid_id = ?", masjidID).
+		Order("prayer ASC, start_date ASC, created_at ASC").
+		Find(&rules).Error
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// ReplacePrayerTimeOverrides swaps the masjid's overrides between the first
+// and last date of overrides for the given ones in a single transaction, so a
+// re-import never leaves a half-updated timetable behind.
+func (r *GormMasjidRepository) ReplacePrayerTimeOverrides(ctx context.Context, masjidID string, overrides []entity.PrayerTimeOverride) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+	from, to := overrides[0].Date, overrides[len(overrides)-1].Date
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("masjid_id = ? AND date BETWEEN ? AND ?", masjidID, from, to).
+			Delete(&entity.PrayerTimeOverride{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.CreateInBatches(overrides, 100).Error
+	})
+}
+
+func (r *GormMasjidRepository) ListPrayerTimeOverrides(ctx context.Context, masjidID string, from string, to string) ([]entity.PrayerTimeOverride, error) {
+	var overrides []entity.PrayerTimeOverride
+	err := r.db.WithContext(ctx).
+		Where("masjid_id = ? AND date BETWEEN ? AND ?", masjidID, from, to).
+		Order("date ASC").
+		Find(&overrides).Error
+	if err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// ReplaceRamadanNights swaps every night stored for the masjid's Ramadan of
+// hijriYear for the given ones in a single transaction.
+func (r *GormMasjidRepository) ReplaceRamadanNights(ctx context.Context, masjidID string, hijriYear int32, nights []entity.RamadanNight) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("masjid_id = ? AND hijri_year = ?", masjidID, hijriYear).
+			Delete(&entity.RamadanNight{}).Error
+		if err != nil || len(nights) == 0 {
+			return err
+		}
+		return tx.Create(&nights).Error
+	})
+}
+
+func (r *GormMasjidRepository) ListRamadanNights(ctx context.Context, masjidID string, hijriYear int32) ([]entity.RamadanNight, error) {
+	var nights []entity.RamadanNight
+	err := r.db.WithContext(ctx).
+		Where("masjid_id = ? AND hijri_year = ?", masjidID, hijriYear).
+		Order("night ASC").
+		Find(&nights).Error
+	if err != nil {
+		return nil, err
+	}
+	return nights, nil
+}
+
 func (r *GormMasjidRepository) GetDB() *gorm.DB {
 	return r.db
 }
@@ -0,0 +1,163 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GormNotificationRepository struct {
+	db *gorm.DB
+}
+
+func NewGormNotificationRepository(db *gorm.DB) repository.NotificationRepository {
+	return &GormNotificationRepository{db: db}
+}
+
+func (r *GormNotificationRepository) GetPreference(ctx context.Context, userID string) (*entity.NotificationPreference, error) {
+	var pref entity.NotificationPreference
+	if err := r.db.WithContext(ctx).Take(&pref, "user_id = ?", userID).Error; err != nil {
+		return nil, err
+	}
+	return &pref, nil
+}
+
+func (r *GormNotificationRepository) ListPreferences(ctx context.Context, userIDs []uuid.UUID) ([]entity.NotificationPreference, error) {
+	var prefs []entity.NotificationPreference
+	if len(userIDs) == 0 {
+		return prefs, nil
+	}
+	if err := r.db.WithContext(ctx).Where("user_id IN ?", userIDs).Find(&prefs).Error; err != nil {
+		return nil, err
+	}
+	return prefs, nil
+}
+
+func (r *GormNotificationRepository) SavePreference(ctx context.Context, pref *entity.NotificationPreference) (*entity.NotificationPreference, error) {
+	if err := r.db.WithContext(ctx).Save(pref).Error; err != nil {
+		return nil, err
+	}
+	return pref, nil
+}
+
+func (r *GormNotificationRepository) CreateFollow(ctx context.Context, follow *entity.MasjidFollow) (*entity.MasjidFollow, error) {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(follow).Error
+	if err != nil {
+		return nil, err
+	}
+	var stored entity.MasjidFollow
+	err = r.db.WithContext(ctx).Take(&stored, "user_id = ? AND masjid_id = ?", follow.UserId, follow.MasjidId).Error
+	if err != nil {
+		return nil, err
+	}
+	return &stored, nil
+}
+
+func (r *GormNotificationRepository) DeleteFollow(ctx context.Context, userID, masjidID string) error {
+	result := r.db.WithContext(ctx).Delete(&entity.MasjidFollow{}, "user_id = ? AND masjid_id = ?", userID, masjidID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *GormNotificationRepository) ListFollows(ctx context.Context, userID string) ([]entity.MasjidFollow, error) {
+	var follows []entity.MasjidFollow
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at ASC, masjid_id ASC").
+		Find(&follows).Error
+	if err != nil {
+		return nil, err
+	}
+	return follows, nil
+}
+
+func (r *GormNotificationRepository) SavePushDevice(ctx context.Context, device *entity.PushDevice) (*entity.PushDevice, error) {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "token"}},
+		DoUpdates: clause.AssignmentColumns([]string{"user_id", "platform", "updated_at"}),
+	}).Create(device).Error
+	if err != nil {
+		return nil, err
+	}
+	return device, nil
+}
+
+func (r *GormNotificationRepository) DeletePushDevices(ctx context.Context, userID string, tokens ...string) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	query := r.db.WithContext(ctx).Where("token IN ?", tokens)
+	if userID != "" {
+		query = query.Where("user_id = ?", userID)
+	}
+	return query.Delete(&entity.PushDevice{}).Error
+}
+
+func (r *GormNotificationRepository) ListPushDevices(ctx context.Context, userID string) ([]entity.PushDevice, error) {
+	var devices []entity.PushDevice
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("updated_at DESC").
+		Find(&devices).Error
+	if err != nil {
+		return nil, err
+	}
+	return devices, nil
+}
+
+func (r *GormNotificationRepository) ListAudience(ctx context.Context, rsvpEventID uuid.UUID, masjidID string) ([]uuid.UUID, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).Raw(
+		`SELECT user_id FROM event_rsvps WHERE event_id = ? AND status IN ?
+		UNION SELECT user_id FROM masjid_follows WHERE masjid_id = ?`,
+		rsvpEventID, []entity.RsvpStatus{entity.RsvpConfirmed, entity.RsvpWaitlisted}, masjidID,
+	).Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		userID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, nil
+}
+
+func (r *GormNotificationRepository) QueueNotifications(ctx context.Context, notifications []entity.EventNotification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(notifications, 100).Error
+}
+
+func (r *GormNotificationRepository) ListPendingNotifications(ctx context.Context, limit int) ([]entity.EventNotification, error) {
+	var notifications []entity.EventNotification
+	err := r.db.WithContext(ctx).
+		Where("status = ?", entity.NotificationPending).
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Find(&notifications).Error
+	if err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+func (r *GormNotificationRepository) UpdateNotification(ctx context.Context, notification *entity.EventNotification) error {
+	return r.db.WithContext(ctx).Model(notification).
+		Select("status", "attempts", "sent_at").
+		Updates(notification).Error
+}
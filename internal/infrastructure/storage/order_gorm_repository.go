@@ -0,0 +1,340 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GormOrderRepository struct {
+	db *gorm.DB
+}
+
+func NewGormOrderRepository(db *gorm.DB) repository.OrderRepository {
+	return &GormOrderRepository{db: db}
+}
+
+func (r *GormOrderRepository) ReplaceTicketTypes(ctx context.Context, eventID string, types []entity.TicketType) ([]entity.TicketType, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := lockEvent(tx, eventID); err != nil {
+			return err
+		}
+		keep := make([]uuid.UUID, 0, len(types))
+		paid := false
+		for _, t := range types {
+			keep = append(keep, t.ID)
+			paid = paid || t.Price > 0
+		}
+		query := tx.Where("event_id = ?", eventID)
+		if len(keep) > 0 {
+			query = query.Where("id NOT IN ?", keep)
+		}
+		if err := query.Delete(&entity.TicketType{}).Error; err != nil {
+			return err
+		}
+		for i := range types {
+			if err := tx.Save(&types[i]).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&entity.Event{}).Where("id = ?", eventID).Updates(map[string]interface{}{
+			"is_paid":       paid,
+			"requires_rsvp": gorm.Expr("requires_rsvp OR ?", len(types) > 0),
+			"updated_at":    time.Now(),
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return types, nil
+}
+
+func (r *GormOrderRepository) CountTicketsSold(ctx context.Context, eventID string, now time.Time) (map[uuid.UUID]int64, error) {
+	return countTicketsSold(r.db.WithContext(ctx), eventID, now, uuid.Nil)
+}
+
+func (r *GormOrderRepository) CreateOrder(ctx context.Context, order *entity.Order) (*entity.Order, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		event, err := lockEvent(tx, order.EventId.String())
+		if err != nil {
+			return err
+		}
+		if err := checkAvailable(tx, event, order, order.CreatedAt); err != nil {
+			return err
+		}
+		return tx.Create(order).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+func (r *GormOrderRepository) GetOrder(ctx context.Context, id string) (*entity.Order, error) {
+	var order entity.Order
+	if err := r.db.WithContext(ctx).Preload("Items").First(&order, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (r *GormOrderRepository) GetOrderByCheckout(ctx context.Context, provider, checkoutID string) (*entity.Order, error) {
+	var order entity.Order
+	err := r.db.WithContext(ctx).Preload("Items").
+		First(&order, "provider = ? AND checkout_id = ?", provider, checkoutID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (r *GormOrderRepository) GetOrderByPayment(ctx context.Context, provider, paymentID string) (*entity.Order, error) {
+	var order entity.Order
+	err := r.db.WithContext(ctx).Preload("Items").
+		First(&order, "provider = ? AND payment_id = ?", provider, paymentID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (r *GormOrderRepository) ListOrders(ctx context.Context, eventID, userID string, offset, limit int) ([]entity.Order, error) {
+	query := r.db.WithContext(ctx).Preload("Items")
+	if eventID != "" {
+		query = query.Where("event_id = ?", eventID)
+	} else {
+		query = query.Where("user_id = ?", userID)
+	}
+	var orders []entity.Order
+	err := query.Order("created_at DESC, id ASC").Offset(offset).Limit(limit).Find(&orders).Error
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+func (r *GormOrderRepository) SaveCheckout(ctx context.Context, order *entity.Order) error {
+	return r.db.WithContext(ctx).Model(order).
+		Select("provider", "checkout_id", "checkout_url", "updated_at").
+		Updates(order).Error
+}
+
+func (r *GormOrderRepository) PayOrder(ctx context.Context, orderID, paymentID string, paidAt time.Time) (*entity.Order, error) {
+	return r.transition(ctx, orderID, func(tx *gorm.DB, event *entity.Event, order *entity.Order) error {
+		if order.Status != entity.OrderPending && order.Status != entity.OrderCancelled {
+			return nil
+		}
+		order.PaymentId = paymentID
+		order.PaidAt = &paidAt
+		order.UpdatedAt = paidAt
+		if event != nil {
+			err := checkAvailable(tx, event, order, paidAt)
+			if errors.Is(err, helper.ErrTicketsSoldOut) {
+				// The tickets or the place went while the buyer paid, as
+				// when a cancelled order is paid late.
+				order.Status = entity.OrderRefundPending
+				return tx.Model(order).Select("status", "payment_id", "paid_at", "updated_at").Updates(order).Error
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		var rsvp entity.EventRsvp
+		err := tx.Where("event_id = ? AND user_id = ?", order.EventId, order.UserId).Take(&rsvp).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			rsvp = entity.EventRsvp{ID: uuid.New(), EventId: order.EventId, UserId: order.UserId, RequestedAt: paidAt, CreatedAt: paidAt}
+		case err != nil:
+			return err
+		case rsvp.Status != entity.RsvpConfirmed:
+			rsvp.RequestedAt = paidAt
+		}
+		rsvp.Status = entity.RsvpConfirmed
+		rsvp.UpdatedAt = paidAt
+		if err := tx.Save(&rsvp).Error; err != nil {
+			return err
+		}
+
+		order.Status = entity.OrderPaid
+		order.RsvpId = &rsvp.ID
+		return tx.Model(order).Select("status", "payment_id", "paid_at", "rsvp_id", "updated_at").Updates(order).Error
+	})
+}
+
+func (r *GormOrderRepository) CancelOrder(ctx context.Context, orderID string) (*entity.Order, error) {
+	return r.transition(ctx, orderID, func(tx *gorm.DB, event *entity.Event, order *entity.Order) error {
+		if order.Status != entity.OrderPending {
+			return nil
+		}
+		return setOrderStatus(tx, order, entity.OrderCancelled)
+	})
+}
+
+func (r *GormOrderRepository) RefundOrder(ctx context.Context, orderID string) (*entity.Order, error) {
+	return r.transition(ctx, orderID, func(tx *gorm.DB, event *entity.Event, order *entity.Order) error {
+		if order.Status != entity.OrderPaid {
+			return nil
+		}
+		return refundOrder(tx, order, entity.OrderRefundPending)
+	})
+}
+
+func (r *GormOrderRepository) CompleteRefund(ctx context.Context, orderID string) (*entity.Order, error) {
+	return r.transition(ctx, orderID, func(tx *gorm.DB, event *entity.Event, order *entity.Order) error {
+		switch order.Status {
+		case entity.OrderPaid:
+			return refundOrder(tx, order, entity.OrderRefunded)
+		case entity.OrderRefundPending:
+			return setOrderStatus(tx, order, entity.OrderRefunded)
+		}
+		return nil
+	})
+}
+
+// transition applies change to an order in a transaction holding the lock
+// of its event, which every change to the event's RSVPs takes first, and
+// then of the order. The event is nil if it has been deleted.
+func (r *GormOrderRepository) transition(ctx context.Context, orderID string, change func(tx *gorm.DB, event *entity.Event, order *entity.Order) error) (*entity.Order, error) {
+	var order entity.Order
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var eventID string
+		if err := tx.Model(&entity.Order{}).Where("id = ?", orderID).Pluck("event_id", &eventID).Error; err != nil {
+			return err
+		}
+		if eventID == "" {
+			return gorm.ErrRecordNotFound
+		}
+		// The event may have been deleted since the order was made.
+		event, err := lockEvent(tx, eventID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Items").First(&order, "id = ?", orderID).Error
+		if err != nil {
+			return err
+		}
+		return change(tx, event, &order)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func setOrderStatus(tx *gorm.DB, order *entity.Order, status entity.OrderStatus) error {
+	order.Status = status
+	order.UpdatedAt = time.Now()
+	return tx.Model(order).Select("status", "updated_at").Updates(order).Error
+}
+
+// refundOrder moves a paid order to status, cancelling the buyer's RSVP
+// unless another of their orders for the event is paid.
+func refundOrder(tx *gorm.DB, order *entity.Order, status entity.OrderStatus) error {
+	if err := setOrderStatus(tx, order, status); err != nil {
+		return err
+	}
+	var paid int64
+	err := tx.Model(&entity.Order{}).
+		Where("event_id = ? AND user_id = ? AND status = ?", order.EventId, order.UserId, entity.OrderPaid).
+		Count(&paid).Error
+	if err != nil || paid > 0 {
+		return err
+	}
+	result := tx.Model(&entity.EventRsvp{}).
+		Where("event_id = ? AND user_id = ? AND status <> ?", order.EventId, order.UserId, entity.RsvpCancelled).
+		Updates(map[string]interface{}{"status": entity.RsvpCancelled, "updated_at": time.Now()})
+	if result.Error != nil || result.RowsAffected == 0 {
+		return result.Error
+	}
+	var event entity.Event
+	if err := tx.First(&event, "id = ?", order.EventId).Error; err != nil {
+		return err
+	}
+	return promoteWaitlist(tx, &event)
+}
+
+// checkAvailable returns helper.ErrTicketsSoldOut unless a locked event
+// has the tickets an order is for left at now, and a place for its buyer
+// if they do not have one already. Places are taken by confirmed RSVPs and
+// held by the pending orders of other buyers. The order itself is not
+// counted.
+func checkAvailable(tx *gorm.DB, event *entity.Event, order *entity.Order, now time.Time) error {
+	var types []entity.TicketType
+	if err := tx.Where("event_id = ?", event.ID).Find(&types).Error; err != nil {
+		return err
+	}
+	sold, err := countTicketsSold(tx, event.ID.String(), now, order.ID)
+	if err != nil {
+		return err
+	}
+	for _, t := range types {
+		t.Sold = sold[t.ID]
+		for _, item := range order.Items {
+			if item.TicketTypeId == t.ID && t.Remaining() >= 0 && int64(item.Quantity) > t.Remaining() {
+				return helper.ErrTicketsSoldOut
+			}
+		}
+	}
+
+	if event.MaxParticipants <= 0 {
+		return nil
+	}
+	var attending int64
+	err = tx.Model(&entity.EventRsvp{}).
+		Where("event_id = ? AND user_id = ? AND status = ?", event.ID, order.UserId, entity.RsvpConfirmed).
+		Count(&attending).Error
+	if err != nil || attending > 0 {
+		return err
+	}
+	confirmed, err := countConfirmed(tx, event.ID.String())
+	if err != nil {
+		return err
+	}
+	var holding int64
+	err = tx.Model(&entity.Order{}).
+		Where("event_id = ? AND status = ? AND expires_at > ?", event.ID, entity.OrderPending, now).
+		Where("id <> ? AND user_id <> ?", order.ID, order.UserId).
+		Where("user_id NOT IN (?)", tx.Model(&entity.EventRsvp{}).Select("user_id").
+			Where("event_id = ? AND status = ?", event.ID, entity.RsvpConfirmed)).
+		Distinct("user_id").
+		Count(&holding).Error
+	if err != nil {
+		return err
+	}
+	if event.PlacesLeft(confirmed+holding) == 0 {
+		return helper.ErrTicketsSoldOut
+	}
+	return nil
+}
+
+// countTicketsSold counts the tickets of each of an event's ticket types
+// held by orders at now, other than the order except.
+func countTicketsSold(db *gorm.DB, eventID string, now time.Time, except uuid.UUID) (map[uuid.UUID]int64, error) {
+	var rows []struct {
+		TicketTypeId uuid.UUID
+		Sold         int64
+	}
+	err := db.Model(&entity.OrderItem{}).
+		Select("order_items.ticket_type_id, SUM(order_items.quantity) AS sold").
+		Joins("JOIN orders ON orders.id = order_items.order_id").
+		Where("orders.event_id = ? AND orders.id <> ?", eventID, except).
+		Where("orders.status = ? OR (orders.status = ? AND orders.expires_at > ?)", entity.OrderPaid, entity.OrderPending, now).
+		Group("order_items.ticket_type_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	sold := make(map[uuid.UUID]int64, len(rows))
+	for _, row := range rows {
+		sold[row.TicketTypeId] = row.Sold
+	}
+	return sold, nil
+}
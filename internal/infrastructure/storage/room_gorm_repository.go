@@ -0,0 +1,345 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GormRoomRepository struct {
+	db *gorm.DB
+}
+
+func NewGormRoomRepository(db *gorm.DB) repository.RoomRepository {
+	return &GormRoomRepository{db: db}
+}
+
+func (r *GormRoomRepository) CreateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error) {
+	if err := r.db.WithContext(ctx).Create(room).Error; err != nil {
+		return nil, err
+	}
+	return room, nil
+}
+
+func (r *GormRoomRepository) UpdateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error) {
+	result := r.db.WithContext(ctx).Model(room).
+		Select("name", "description", "capacity", "designation", "updated_at").
+		Updates(room)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return room, nil
+}
+
+func (r *GormRoomRepository) GetRoom(ctx context.Context, id string) (*entity.Room, error) {
+	var room entity.Room
+	if err := r.db.WithContext(ctx).First(&room, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &room, nil
+}
+
+func (r *GormRoomRepository) DeleteRoom(ctx context.Context, id string, now time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := lockRoom(tx, id); err != nil {
+			return err
+		}
+		var booked int64
+		err := tx.Model(&entity.RoomBooking{}).
+			Where("room_id = ? AND end_time > ?", id, now).
+			Count(&booked).Error
+		if err != nil {
+			return err
+		}
+		if booked > 0 {
+			return helper.ErrRoomHasBookings
+		}
+		if err := tx.Delete(&entity.RoomBooking{}, "room_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entity.Room{}, "id = ?", id).Error
+	})
+}
+
+func (r *GormRoomRepository) ListRooms(ctx context.Context, masjidID string) ([]entity.Room, error) {
+	var rooms []entity.Room
+	err := r.db.WithContext(ctx).
+		Where("masjid_id = ?", masjidID).
+		Order("name ASC, id ASC").
+		Find(&rooms).Error
+	if err != nil {
+		return nil, err
+	}
+	return rooms, nil
+}
+
+func (r *GormRoomRepository) CreateBooking(ctx context.Context, booking *entity.RoomBooking) (*entity.RoomBooking, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkBookings(tx, nil, []entity.RoomBooking{*booking}); err != nil {
+			return err
+		}
+		return tx.Omit("Room").Create(booking).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return booking, nil
+}
+
+func (r *GormRoomRepository) GetBooking(ctx context.Context, id string) (*entity.RoomBooking, error) {
+	var booking entity.RoomBooking
+	if err := r.db.WithContext(ctx).First(&booking, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &booking, nil
+}
+
+func (r *GormRoomRepository) DeleteBooking(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Delete(&entity.RoomBooking{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *GormRoomRepository) ListBookings(ctx context.Context, roomID string, from, to time.Time) ([]entity.RoomBooking, error) {
+	var bookings []entity.RoomBooking
+	err := r.db.WithContext(ctx).
+		Where("room_id = ? AND start_time < ? AND end_time > ?", roomID, to, from).
+		Order("start_time ASC, id ASC").
+		Find(&bookings).Error
+	if err != nil {
+		return nil, err
+	}
+	return bookings, nil
+}
+
+func (r *GormRoomRepository) ListUserBookings(ctx context.Context, userID string, from time.Time) ([]entity.RoomBooking, error) {
+	var bookings []entity.RoomBooking
+	err := r.db.WithContext(ctx).Preload("Room").
+		Where("user_id = ? AND end_time > ?", userID, from).
+		Order("start_time ASC, id ASC").
+		Find(&bookings).Error
+	if err != nil {
+		return nil, err
+	}
+	return bookings, nil
+}
+
+// checkBookings locks the rooms of bookings until tx ends and returns
+// helper.ErrRoomUnavailable if any of them is booked at the time, other
+// than by event, which is nil for a member's booking. Recurring events
+// hold their rooms by their rules beyond the occurrences booked for them,
+// and checkHolds checks bookings against those too. Every booking is
+// checked here, and rooms are locked in order of ID so bookings of several
+// rooms cannot deadlock.
+func checkBookings(tx *gorm.DB, event *entity.Event, bookings []entity.RoomBooking) error {
+	sorted := append([]entity.RoomBooking(nil), bookings...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].RoomId.String() < sorted[j].RoomId.String() })
+	for i := 0; i < len(sorted); {
+		n := i + 1
+		for n < len(sorted) && sorted[n].RoomId == sorted[i].RoomId {
+			n++
+		}
+		room, err := lockRoom(tx, sorted[i].RoomId.String())
+		if err != nil {
+			return err
+		}
+		for _, booking := range sorted[i:n] {
+			query := tx.Where("room_id = ? AND start_time < ? AND end_time > ?", booking.RoomId, booking.EndTime, booking.StartTime)
+			if event != nil {
+				query = query.Where("(event_id IS NULL OR event_id <> ?)", event.ID)
+			}
+			var existing entity.RoomBooking
+			err = query.Order("start_time ASC").Take(&existing).Error
+			if err == nil {
+				return roomUnavailable(room, existing.StartTime, existing.EndTime)
+			}
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+		}
+		if err := checkHolds(tx, room, event, sorted[i:n]); err != nil {
+			return err
+		}
+		i = n
+	}
+	return nil
+}
+
+// checkHolds returns helper.ErrRoomUnavailable if the rules of recurring
+// events other than event hold room at the time of one of bookings, its
+// bookings of room. A recurring event is itself checked by its rule
+// against the bookings and rules of others.
+func checkHolds(tx *gorm.DB, room *entity.Room, event *entity.Event, bookings []entity.RoomBooking) error {
+	loc, err := eventLocation(tx, room.MasjidId)
+	if err != nil {
+		return err
+	}
+	holds, err := listHolds(tx, room, event, loc)
+	if err != nil {
+		return err
+	}
+	for _, booking := range bookings {
+		for _, hold := range holds {
+			if start, ok := hold.Conflict(booking.StartTime, booking.EndTime); ok {
+				return roomUnavailable(room, start, start.Add(hold.Length()))
+			}
+		}
+	}
+	if event == nil || !event.IsRecurring() {
+		return nil
+	}
+
+	var bookedUntil time.Time
+	for _, booking := range bookings {
+		if booking.StartTime.After(bookedUntil) {
+			bookedUntil = booking.StartTime
+		}
+	}
+	var except []time.Time
+	err = tx.Model(&entity.Event{}).Where("recurring_event_id = ? AND original_start_time IS NOT NULL", event.ID).
+		Pluck("original_start_time", &except).Error
+	if err != nil {
+		return err
+	}
+	own, err := entity.NewSeriesHold(event, loc, bookedUntil, except)
+	if err != nil {
+		return err
+	}
+
+	query := tx.Where("room_id = ? AND end_time > ? AND (event_id IS NULL OR event_id <> ?)", room.ID, bookedUntil, event.ID)
+	if event.SeriesEnd != nil {
+		query = query.Where("start_time < ?", event.SeriesEnd.Add(own.Length()))
+	}
+	var others []entity.RoomBooking
+	if err := query.Order("start_time ASC").Find(&others).Error; err != nil {
+		return err
+	}
+	for _, other := range others {
+		if _, ok := own.Conflict(other.StartTime, other.EndTime); ok {
+			return roomUnavailable(room, other.StartTime, other.EndTime)
+		}
+	}
+	for _, hold := range holds {
+		if start, ok := own.ConflictWith(hold); ok {
+			return roomUnavailable(room, start, start.Add(hold.Length()))
+		}
+	}
+	return nil
+}
+
+// listHolds returns the holds on room of the recurring events booked in it
+// other than event, reading their rules in loc. An exception event stands
+// in for the occurrence of its series it replaces.
+func listHolds(tx *gorm.DB, room *entity.Room, event *entity.Event, loc *time.Location) ([]*entity.SeriesHold, error) {
+	var booked []struct {
+		EventId     uuid.UUID
+		BookedUntil time.Time
+	}
+	query := tx.Model(&entity.RoomBooking{}).
+		Select("event_id, MAX(start_time) AS booked_until").
+		Where("room_id = ? AND event_id IS NOT NULL", room.ID)
+	if event != nil {
+		query = query.Where("event_id <> ?", event.ID)
+	}
+	if err := query.Group("event_id").Scan(&booked).Error; err != nil {
+		return nil, err
+	}
+	if len(booked) == 0 {
+		return nil, nil
+	}
+	bookedUntil := make(map[uuid.UUID]time.Time, len(booked))
+	ids := make([]uuid.UUID, 0, len(booked))
+	for _, b := range booked {
+		bookedUntil[b.EventId] = b.BookedUntil
+		ids = append(ids, b.EventId)
+	}
+
+	var series []*entity.Event
+	if err := tx.Where("id IN ? AND recurrence <> ''", ids).Order("id ASC").Find(&series).Error; err != nil {
+		return nil, err
+	}
+	if len(series) == 0 {
+		return nil, nil
+	}
+	seriesIDs := make([]uuid.UUID, 0, len(series))
+	for _, s := range series {
+		seriesIDs = append(seriesIDs, s.ID)
+	}
+	var exceptions []entity.Event
+	err := tx.Select("recurring_event_id", "original_start_time").
+		Where("recurring_event_id IN ? AND original_start_time IS NOT NULL", seriesIDs).Find(&exceptions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	holds := make([]*entity.SeriesHold, 0, len(series))
+	for _, s := range series {
+		until := bookedUntil[s.ID]
+		if s.SeriesEnd != nil && !s.SeriesEnd.After(until) {
+			continue
+		}
+		var except []time.Time
+		for _, exception := range exceptions {
+			if *exception.RecurringEventId == s.ID {
+				except = append(except, *exception.OriginalStartTime)
+			}
+		}
+		if event != nil && event.RecurringEventId != nil && *event.RecurringEventId == s.ID && event.OriginalStartTime != nil {
+			except = append(except, *event.OriginalStartTime)
+		}
+		hold, err := entity.NewSeriesHold(s, loc, until, except)
+		if err != nil {
+			return nil, err
+		}
+		holds = append(holds, hold)
+	}
+	return holds, nil
+}
+
+// eventLocation returns the time zone the rules of a masjid's events are
+// read in, UTC when it has no valid one, as the event service reads them.
+func eventLocation(tx *gorm.DB, masjidID string) (*time.Location, error) {
+	var masjid entity.Masjid
+	err := tx.Select("time_zone").Take(&masjid, "id = ?", masjidID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return time.UTC, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(masjid.TimeZone)
+	if err != nil {
+		return time.UTC, nil
+	}
+	return loc, nil
+}
+
+func roomUnavailable(room *entity.Room, start, end time.Time) error {
+	return fmt.Errorf("%w: %s is booked from %s to %s", helper.ErrRoomUnavailable, room.Name,
+		start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339))
+}
+
+func lockRoom(tx *gorm.DB, id string) (*entity.Room, error) {
+	var room entity.Room
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&room, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &room, nil
+}
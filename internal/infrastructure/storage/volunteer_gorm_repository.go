@@ -0,0 +1,311 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// placeStatuses are the signup statuses holding a place on a shift.
+var placeStatuses = []entity.ShiftSignupStatus{entity.ShiftClaimed, entity.ShiftWorked, entity.ShiftNoShow}
+
+type GormVolunteerRepository struct {
+	db *gorm.DB
+}
+
+func NewGormVolunteerRepository(db *gorm.DB) repository.VolunteerRepository {
+	return &GormVolunteerRepository{db: db}
+}
+
+func (r *GormVolunteerRepository) CreateShift(ctx context.Context, shift *entity.VolunteerShift) (*entity.VolunteerShift, error) {
+	if err := r.db.WithContext(ctx).Omit("Signups").Create(shift).Error; err != nil {
+		return nil, err
+	}
+	return shift, nil
+}
+
+func (r *GormVolunteerRepository) UpdateShift(ctx context.Context, shift *entity.VolunteerShift) (*entity.VolunteerShift, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := lockShift(tx, shift.ID.String()); err != nil {
+			return err
+		}
+		claimed, err := countClaimed(tx, shift.ID.String())
+		if err != nil {
+			return err
+		}
+		if int64(shift.Headcount) < claimed {
+			return helper.ErrShiftHeadcountTooLow
+		}
+		shift.Claimed = claimed
+		return tx.Model(shift).
+			Select("role", "description", "start_time", "end_time", "headcount", "updated_at").
+			Updates(shift).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return shift, nil
+}
+
+func (r *GormVolunteerRepository) GetShift(ctx context.Context, id string) (*entity.VolunteerShift, error) {
+	db := r.db.WithContext(ctx)
+	var shift entity.VolunteerShift
+	if err := db.First(&shift, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	claimed, err := countClaimed(db, id)
+	if err != nil {
+		return nil, err
+	}
+	shift.Claimed = claimed
+	return &shift, nil
+}
+
+func (r *GormVolunteerRepository) DeleteShift(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := lockShift(tx, id); err != nil {
+			return err
+		}
+		var worked int64
+		err := tx.Model(&entity.ShiftSignup{}).
+			Where("shift_id = ? AND status = ?", id, entity.ShiftWorked).
+			Count(&worked).Error
+		if err != nil {
+			return err
+		}
+		if worked > 0 {
+			return helper.ErrShiftHasHours
+		}
+		if err := tx.Where("shift_id = ?", id).Delete(&entity.ShiftSignup{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entity.VolunteerShift{}, "id = ?", id).Error
+	})
+}
+
+func (r *GormVolunteerRepository) ListShifts(ctx context.Context, eventID string, roster bool) ([]entity.VolunteerShift, error) {
+	db := r.db.WithContext(ctx)
+	query := db.Where("event_id = ?", eventID)
+	if roster {
+		query = query.Preload("Signups", func(db *gorm.DB) *gorm.DB {
+			return db.Where("status IN ?", placeStatuses).Order("claimed_at ASC, id ASC")
+		}).Preload("Signups.User")
+	}
+	var shifts []entity.VolunteerShift
+	if err := query.Order("start_time ASC, role ASC, id ASC").Find(&shifts).Error; err != nil {
+		return nil, err
+	}
+	if len(shifts) == 0 {
+		return shifts, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(shifts))
+	for _, shift := range shifts {
+		ids = append(ids, shift.ID)
+	}
+	var counts []struct {
+		ShiftId uuid.UUID
+		Claimed int64
+	}
+	err := db.Model(&entity.ShiftSignup{}).
+		Select("shift_id, COUNT(*) AS claimed").
+		Where("shift_id IN ? AND status IN ?", ids, placeStatuses).
+		Group("shift_id").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	claimed := make(map[uuid.UUID]int64, len(counts))
+	for _, c := range counts {
+		claimed[c.ShiftId] = c.Claimed
+	}
+	for i := range shifts {
+		shifts[i].Claimed = claimed[shifts[i].ID]
+	}
+	return shifts, nil
+}
+
+func (r *GormVolunteerRepository) ClaimShift(ctx context.Context, shiftID, userID string, now time.Time) (*entity.ShiftSignup, error) {
+	var signup entity.ShiftSignup
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		shift, err := lockShift(tx, shiftID)
+		if err != nil {
+			return err
+		}
+		err = tx.Where("shift_id = ? AND user_id = ?", shiftID, userID).Take(&signup).Error
+		switch {
+		case err == nil && signup.Status.HoldsPlace():
+			return nil
+		case err == nil:
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			signup = entity.ShiftSignup{
+				ID:        uuid.New(),
+				ShiftId:   shift.ID,
+				UserId:    uuid.MustParse(userID),
+				CreatedAt: now,
+			}
+		default:
+			return err
+		}
+
+		// The volunteer is locked as well as the shift, so that claims
+		// of overlapping shifts made at once are checked one by one.
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&entity.User{}, "id = ?", userID).Error; err != nil {
+			return err
+		}
+		var overlapping int64
+		err = tx.Model(&entity.ShiftSignup{}).
+			Joins("JOIN volunteer_shifts ON volunteer_shifts.id = shift_signups.shift_id").
+			Where("shift_signups.user_id = ? AND shift_signups.status IN ?", userID, placeStatuses).
+			Where("volunteer_shifts.start_time < ? AND volunteer_shifts.end_time > ?", shift.EndTime, shift.StartTime).
+			Count(&overlapping).Error
+		if err != nil {
+			return err
+		}
+		if overlapping > 0 {
+			return helper.ErrShiftOverlap
+		}
+		claimed, err := countClaimed(tx, shiftID)
+		if err != nil {
+			return err
+		}
+		if claimed >= int64(shift.Headcount) {
+			return helper.ErrShiftFull
+		}
+
+		signup.Status = entity.ShiftClaimed
+		signup.ClaimedAt = now
+		signup.UpdatedAt = now
+		return tx.Save(&signup).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &signup, nil
+}
+
+func (r *GormVolunteerRepository) ReleaseShift(ctx context.Context, shiftID, userID string) (*entity.ShiftSignup, error) {
+	var signup entity.ShiftSignup
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := lockShift(tx, shiftID); err != nil {
+			return err
+		}
+		if err := tx.Where("shift_id = ? AND user_id = ?", shiftID, userID).Take(&signup).Error; err != nil {
+			return err
+		}
+		if signup.Status == entity.ShiftReleased {
+			return nil
+		}
+		signup.Status = entity.ShiftReleased
+		signup.UpdatedAt = time.Now()
+		return tx.Model(&signup).Select("status", "updated_at").Updates(&signup).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &signup, nil
+}
+
+func (r *GormVolunteerRepository) RecordHours(ctx context.Context, shiftID, userID string, minutes int32, recordedBy string) (*entity.ShiftSignup, error) {
+	var signup entity.ShiftSignup
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("shift_id = ? AND user_id = ? AND status IN ?", shiftID, userID, placeStatuses).
+			Take(&signup).Error
+		if err != nil {
+			return err
+		}
+		recorder := uuid.MustParse(recordedBy)
+		signup.Status = entity.ShiftWorked
+		if minutes == 0 {
+			signup.Status = entity.ShiftNoShow
+		}
+		signup.MinutesWorked = minutes
+		signup.RecordedBy = &recorder
+		signup.UpdatedAt = time.Now()
+		return tx.Model(&signup).Select("status", "minutes_worked", "recorded_by", "updated_at").Updates(&signup).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &signup, nil
+}
+
+func (r *GormVolunteerRepository) ListUserSignups(ctx context.Context, userID string, from, to time.Time) ([]entity.ShiftSignup, error) {
+	var signups []entity.ShiftSignup
+	err := r.db.WithContext(ctx).Preload("Shift").
+		Joins("JOIN volunteer_shifts ON volunteer_shifts.id = shift_signups.shift_id").
+		Where("shift_signups.user_id = ? AND shift_signups.status IN ?", userID, placeStatuses).
+		Where("volunteer_shifts.end_time > ? AND volunteer_shifts.start_time < ?", from, to).
+		Order("volunteer_shifts.start_time ASC").
+		Find(&signups).Error
+	if err != nil {
+		return nil, err
+	}
+	return signups, nil
+}
+
+func (r *GormVolunteerRepository) SumHours(ctx context.Context, masjidID, userID string, from, to time.Time) ([]entity.VolunteerHours, error) {
+	db := r.db.WithContext(ctx)
+	query := db.Model(&entity.ShiftSignup{}).
+		Select("shift_signups.user_id, SUM(shift_signups.minutes_worked) AS minutes_worked, COUNT(*) AS shifts_worked").
+		Joins("JOIN volunteer_shifts ON volunteer_shifts.id = shift_signups.shift_id").
+		Where("shift_signups.status = ?", entity.ShiftWorked).
+		Where("volunteer_shifts.start_time >= ? AND volunteer_shifts.start_time < ?", from, to)
+	if masjidID != "" {
+		query = query.Where("volunteer_shifts.masjid_id = ?", masjidID)
+	} else {
+		query = query.Where("shift_signups.user_id = ?", userID)
+	}
+	var hours []entity.VolunteerHours
+	err := query.Group("shift_signups.user_id").
+		Order("minutes_worked DESC, shift_signups.user_id ASC").
+		Scan(&hours).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(hours) == 0 {
+		return hours, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(hours))
+	for _, h := range hours {
+		ids = append(ids, h.UserId)
+	}
+	var users []entity.User
+	if err := db.Where("id IN ?", ids).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*entity.User, len(users))
+	for i := range users {
+		byID[users[i].ID] = &users[i]
+	}
+	for i := range hours {
+		hours[i].User = byID[hours[i].UserId]
+	}
+	return hours, nil
+}
+
+func lockShift(tx *gorm.DB, id string) (*entity.VolunteerShift, error) {
+	var shift entity.VolunteerShift
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&shift, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &shift, nil
+}
+
+// countClaimed counts the signups holding places on a shift.
+func countClaimed(tx *gorm.DB, shiftID string) (int64, error) {
+	var claimed int64
+	err := tx.Model(&entity.ShiftSignup{}).
+		Where("shift_id = ? AND status IN ?", shiftID, placeStatuses).
+		Count(&claimed).Error
+	return claimed, err
+}
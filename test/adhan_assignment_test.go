@@ -0,0 +1,98 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+)
+
+func TestAdhanAssignments_FallBackToDefaultExceptFajr(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _ := newTestAdhanService(t)
+	data := wavFile(8000, 1, time.Second, 8000)
+
+	makkah, err := svc.CreateAdhan(ctx, &entity.Adhan{MasjidId: "m1", Title: "Makkah", Muezzin: "Ali Ahmed Mulla"}, data)
+	require.NoError(t, err)
+	fajr, err := svc.CreateAdhan(ctx, &entity.Adhan{MasjidId: "m1", Title: "Fajr", Muezzin: "Ali Ahmed Mulla"}, data)
+	require.NoError(t, err)
+	maghrib, err := svc.CreateAdhan(ctx, &entity.Adhan{MasjidId: "m1", Title: "Maghrib"}, data)
+	require.NoError(t, err)
+
+	library, err := svc.ListAdhans(ctx, "m1")
+	require.NoError(t, err)
+	require.Len(t, library, 3)
+	assert.Equal(t, "Fajr", library[0].Title)
+
+	_, err = svc.AssignAdhan(ctx, "m1", entity.PRAYER_UNSPECIFIED, makkah.ID.String())
+	require.NoError(t, err)
+	_, err = svc.AssignAdhan(ctx, "m1", entity.MAGHRIB, maghrib.ID.String())
+	require.NoError(t, err)
+
+	resolved, err := svc.GetAdhanAssignments(ctx, "m1")
+	require.NoError(t, err)
+	require.Len(t, resolved, 5)
+	assert.Equal(t, entity.FAJR, resolved[0].Prayer)
+	assert.Nil(t, resolved[0].Adhan, "Fajr must not inherit the default")
+	assert.Equal(t, makkah.ID, resolved[1].Adhan.ID)
+	assert.True(t, resolved[1].Inherited)
+	assert.Equal(t, maghrib.ID, resolved[3].Adhan.ID)
+	assert.False(t, resolved[3].Inherited)
+
+	resolved, err = svc.AssignAdhan(ctx, "m1", entity.FAJR, fajr.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, fajr.ID, resolved[0].Adhan.ID)
+	assert.False(t, resolved[0].Inherited)
+
+	// Clearing the default leaves only explicit assignments.
+	resolved, err = svc.AssignAdhan(ctx, "m1", entity.PRAYER_UNSPECIFIED, "")
+	require.NoError(t, err)
+	assert.Nil(t, resolved[1].Adhan)
+	assert.Equal(t, maghrib.ID, resolved[3].Adhan.ID)
+
+	// Deleting an adhan unassigns it.
+	require.NoError(t, svc.DeleteAdhan(ctx, maghrib.ID.String()))
+	resolved, err = svc.GetAdhanAssignments(ctx, "m1")
+	require.NoError(t, err)
+	assert.Nil(t, resolved[3].Adhan)
+}
+
+func TestAssignAdhan_RejectsOtherMasjidsAndInvalidPrayers(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _ := newTestAdhanService(t)
+
+	adhan, err := svc.CreateAdhan(ctx, &entity.Adhan{MasjidId: "m1"}, wavFile(8000, 1, time.Second, 8000))
+	require.NoError(t, err)
+
+	_, err = svc.AssignAdhan(ctx, "m2", entity.ASR, adhan.ID.String())
+	assert.ErrorIs(t, err, helper.ErrAdhanNotInMasjid)
+	_, err = svc.AssignAdhan(ctx, "m1", entity.Prayer(9), adhan.ID.String())
+	assert.ErrorIs(t, err, helper.ErrInvalidPrayer)
+}
+
+func TestUpdateAdhan_WithoutAudioKeepsFile(t *testing.T) {
+	ctx := context.Background()
+	svc, _, blobs := newTestAdhanService(t)
+
+	data := wavFile(8000, 1, time.Second, 8000)
+	created, err := svc.CreateAdhan(ctx, &entity.Adhan{MasjidId: "m1", Title: "Old"}, data)
+	require.NoError(t, err)
+
+	updated, err := svc.UpdateAdhan(ctx, &entity.Adhan{ID: created.ID, Muezzin: "Mishary Rashid"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Old", updated.Title)
+	assert.Equal(t, "Mishary Rashid", updated.Muezzin)
+	assert.Equal(t, created.StorageKey, updated.StorageKey)
+	assert.Equal(t, data, storedAudio(t, blobs, *updated))
+
+	// Replacing the audio keeps the title and muezzin.
+	replaced, err := svc.UploadAdhan(ctx, &entity.Adhan{ID: created.ID}, chunked(data, 1024))
+	require.NoError(t, err)
+	assert.Equal(t, "Old", replaced.Title)
+	assert.Equal(t, "Mishary Rashid", replaced.Muezzin)
+}
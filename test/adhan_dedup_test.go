@@ -0,0 +1,149 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/infrastructure/blobstore"
+)
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestUploadAdhan_StoresIdenticalAudioOnce(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, blobs := newTestAdhanService(t)
+	data := wavFile(8000, 1, time.Second, 3000)
+	hash := sha256Hex(data)
+
+	first, err := svc.UploadAdhan(ctx, &entity.Adhan{MasjidId: "m1"}, chunked(data, 1000))
+	require.NoError(t, err)
+	second, err := svc.UploadAdhan(ctx, &entity.Adhan{MasjidId: "m2"}, chunked(data, 333))
+	require.NoError(t, err)
+
+	assert.Equal(t, hash, first.Sha256)
+	assert.Equal(t, blobstore.ContentKey("adhans", hash), first.StorageKey)
+	assert.Equal(t, first.StorageKey, second.StorageKey)
+	assert.Equal(t, 1, blobs.puts)
+	assert.Equal(t, int64(2), repo.blobs[hash].RefCount)
+	assert.Equal(t, int64(len(data)), repo.blobs[hash].Size)
+
+	// The audio outlives the first adhan using it.
+	require.NoError(t, svc.DeleteAdhan(ctx, first.ID.String()))
+	assert.Equal(t, data, storedAudio(t, blobs, repo.adhans[second.ID]))
+	assert.Equal(t, int64(1), repo.blobs[hash].RefCount)
+
+	// Replacing the audio of the last releases it.
+	_, err = svc.UploadAdhan(ctx, &entity.Adhan{ID: second.ID}, chunked(wavFile(8000, 1, 2*time.Second, 3000), 1000))
+	require.NoError(t, err)
+	assert.NotContains(t, repo.blobs, hash)
+	_, err = blobs.Get(ctx, first.StorageKey, 0, 0)
+	assert.ErrorIs(t, err, blobstore.ErrNotFound)
+}
+
+func TestUploadAdhan_SameAudioKeepsProcessing(t *testing.T) {
+	ctx := context.Background()
+	svc, _, blobs := newTestAdhanService(t)
+	data := wavFile(8000, 1, time.Second, 3000)
+	adhan := uploadAndProcess(t, svc, data)
+	require.Equal(t, entity.AdhanProcessingReady, adhan.ProcessingStatus)
+	puts := blobs.puts
+
+	again, err := svc.UploadAdhan(ctx, &entity.Adhan{ID: adhan.ID, Title: "Makkah"}, chunked(data, 1000))
+	require.NoError(t, err)
+	assert.Equal(t, "Makkah", again.Title)
+	assert.Equal(t, adhan.StorageKey, again.StorageKey)
+	assert.Equal(t, entity.AdhanProcessingReady, again.ProcessingStatus)
+	assert.Len(t, again.Renditions, 2)
+	assert.Equal(t, puts, blobs.puts)
+}
+
+func TestProcessAdhan_SharesRenditionsOfIdenticalAudio(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, blobs := newTestAdhanService(t)
+	data := wavFile(8000, 1, time.Second, 3000)
+
+	first := uploadAndProcess(t, svc, data)
+	second := uploadAndProcess(t, svc, data)
+	require.Len(t, second.Renditions, 2)
+	for i, rendition := range second.Renditions {
+		assert.Equal(t, first.Renditions[i].StorageKey, rendition.StorageKey)
+		assert.Equal(t, int64(2), repo.blobs[rendition.Sha256].RefCount)
+	}
+
+	require.NoError(t, svc.DeleteAdhan(ctx, first.ID.String()))
+	r := decodeRendition(t, svc, second.ID.String(), "speaker")
+	assert.Equal(t, 16000, r.Format().SampleRate)
+
+	require.NoError(t, svc.DeleteAdhan(ctx, second.ID.String()))
+	assert.Empty(t, repo.blobs)
+	for _, rendition := range second.Renditions {
+		_, err := blobs.Get(ctx, rendition.StorageKey, 0, 0)
+		assert.ErrorIs(t, err, blobstore.ErrNotFound)
+	}
+}
+
+func TestProcessAdhan_RejectsCorruptedAudio(t *testing.T) {
+	ctx := context.Background()
+	svc, _, blobs := newTestAdhanService(t)
+	created, err := svc.CreateAdhan(ctx, &entity.Adhan{MasjidId: "m1"}, wavFile(8000, 1, time.Second, 3000))
+	require.NoError(t, err)
+
+	damaged := wavFile(8000, 1, time.Second, 3001)
+	require.NoError(t, blobs.Put(ctx, created.StorageKey, bytes.NewReader(damaged), int64(len(damaged)), "audio/wav"))
+	require.NoError(t, svc.ProcessAdhan(ctx, created.ID.String()))
+
+	adhan, err := svc.GetAdhanByID(ctx, created.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, entity.AdhanProcessingFailed, adhan.ProcessingStatus)
+	assert.Equal(t, helper.ErrAdhanChecksumMismatch.Error(), adhan.ProcessingError)
+	assert.Empty(t, adhan.Renditions)
+}
+
+func TestAdhanAudio_ETag(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _ := newTestAdhanService(t)
+	data := wavFile(8000, 1, time.Second, 3000)
+	created, err := svc.UploadAdhan(ctx, &entity.Adhan{MasjidId: "m1"}, chunked(data, 1000))
+	require.NoError(t, err)
+
+	audio, err := svc.OpenAdhanAudio(ctx, created.ID.String(), "")
+	require.NoError(t, err)
+	defer audio.Close()
+	assert.Equal(t, `"`+sha256Hex(data)+`"`, audio.ETag)
+	assert.Equal(t, audio.ETag, helper.ToProtoAdhanFile(created).GetEtag())
+
+	// Served as the download handler does, a matching If-None-Match skips
+	// the body.
+	req := httptest.NewRequest(http.MethodGet, "/v1/adhan/x/audio", nil)
+	req.Header.Set("If-None-Match", `"stale", `+audio.ETag)
+	rec := httptest.NewRecorder()
+	rec.Header().Set("ETag", audio.ETag)
+	http.ServeContent(rec, req, "", audio.ModTime, audio)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.Bytes())
+}
+
+func TestETagMatches(t *testing.T) {
+	etag := helper.ContentETag("abc")
+	assert.Equal(t, `"abc"`, etag)
+	assert.True(t, helper.ETagMatches(`"abc"`, etag))
+	assert.True(t, helper.ETagMatches(`"x", W/"abc"`, etag))
+	assert.True(t, helper.ETagMatches("*", etag))
+	assert.False(t, helper.ETagMatches(`"abcd"`, etag))
+	assert.False(t, helper.ETagMatches("", etag))
+	assert.False(t, helper.ETagMatches("*", helper.ContentETag("")))
+}
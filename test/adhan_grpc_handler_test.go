@@ -3,23 +3,67 @@ package test
 import (
 	"bytes"
 	"context"
+	"io"
+	"testing"
+	"time"
+
 	"github.com/google/uuid"
-	pb "github.com/mnadev/limestone/gen/go"
-	"github.com/mnadev/limestone/internal/application/domain/entity"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"time"
+	"gorm.io/gorm"
+
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/audio"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/handler"
+	"github.com/mnadev/limestone/internal/application/services"
+	"github.com/mnadev/limestone/internal/infrastructure/blobstore"
 )
 
 const maxAdhanFileSizeMB = 5
 
 func createValidAudioContent() []byte {
-	return []byte{0xFF, 0xF3}
+	return wavFile(8000, 1, time.Second, 8000)
 }
 
-func (suite *GrpcHandlerTestSuite) TestCreateAdhan_Success() {
+// AdhanGrpcHandlerTestSuite runs the adhan handler with adhans kept in
+// memory and their audio in a filesystem blob store.
+type AdhanGrpcHandlerTestSuite struct {
+	suite.Suite
+	Repo         *memoryAdhanRepo
+	Blobs        *countingStore
+	AdhanService *services.AdhanService
+	AdhanHandler *handler.AdhanGrpcHandler
+}
+
+func (suite *AdhanGrpcHandlerTestSuite) SetupTest() {
+	suite.AdhanService, suite.Repo, suite.Blobs = newTestAdhanService(suite.T())
+	suite.AdhanHandler = handler.NewAdhanGrpcHandler(suite.AdhanService)
+}
+
+// createAdhan uploads content as a new adhan of a masjid.
+func (suite *AdhanGrpcHandlerTestSuite) createAdhan(masjidID string, content []byte) *pb.AdhanFile {
+	resp, err := suite.AdhanHandler.CreateAdhan(context.Background(), &pb.CreateAdhanFileRequest{
+		AdhanFile: &pb.AdhanFile{MasjidId: masjidID, File: content},
+	})
+	require.NoError(suite.T(), err)
+	return resp.GetData().(*pb.StandardAdhanResponse_AdhanFile).AdhanFile
+}
+
+// storedAudio reads the audio stored under key.
+func (suite *AdhanGrpcHandlerTestSuite) storedAudio(key string) ([]byte, error) {
+	body, err := suite.Blobs.Get(context.Background(), key, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
+
+func (suite *AdhanGrpcHandlerTestSuite) TestCreateAdhan_Success() {
 	ctx := context.Background()
 	masjidID := uuid.New().String()
 	validAudioContent := createValidAudioContent()
@@ -41,19 +85,21 @@ func (suite *GrpcHandlerTestSuite) TestCreateAdhan_Success() {
 	retrievedAdhan := resp.GetData().(*pb.StandardAdhanResponse_AdhanFile).AdhanFile
 	assert.NotEmpty(suite.T(), retrievedAdhan.GetId())
 	assert.Equal(suite.T(), masjidID, retrievedAdhan.GetMasjidId())
-	assert.Equal(suite.T(), validAudioContent, retrievedAdhan.GetFile())
+	assert.Equal(suite.T(), int64(len(validAudioContent)), retrievedAdhan.GetSizeBytes())
 
-	var createdAdhan entity.Adhan
-	err = suite.DB.Where("id = ?", retrievedAdhan.GetId()).First(&createdAdhan).Error
+	createdAdhan, err := suite.Repo.GetByIDAdhan(ctx, retrievedAdhan.GetId())
 	require.NoError(suite.T(), err)
 	assert.Equal(suite.T(), masjidID, createdAdhan.MasjidId)
-	assert.Equal(suite.T(), validAudioContent, createdAdhan.File)
+	assert.Equal(suite.T(), int64(len(validAudioContent)), createdAdhan.Size)
+	assert.Equal(suite.T(), "audio/wav", createdAdhan.ContentType)
 
-	err = suite.DB.Delete(&createdAdhan).Error
+	stored, err := suite.storedAudio(createdAdhan.StorageKey)
 	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), validAudioContent, stored)
+	assert.Equal(suite.T(), 1, suite.Blobs.puts)
 }
 
-func (suite *GrpcHandlerTestSuite) TestCreateAdhan_NoAdhanFile() {
+func (suite *AdhanGrpcHandlerTestSuite) TestCreateAdhan_NoAdhanFile() {
 	ctx := context.Background()
 	req := &pb.CreateAdhanFileRequest{}
 
@@ -67,7 +113,7 @@ func (suite *GrpcHandlerTestSuite) TestCreateAdhan_NoAdhanFile() {
 	assert.Nil(suite.T(), resp)
 }
 
-func (suite *GrpcHandlerTestSuite) TestCreateAdhan_NoMasjidID() {
+func (suite *AdhanGrpcHandlerTestSuite) TestCreateAdhan_NoMasjidID() {
 	ctx := context.Background()
 	validAudioContent := createValidAudioContent()
 	req := &pb.CreateAdhanFileRequest{
@@ -86,7 +132,7 @@ func (suite *GrpcHandlerTestSuite) TestCreateAdhan_NoMasjidID() {
 	assert.Nil(suite.T(), resp)
 }
 
-func (suite *GrpcHandlerTestSuite) TestCreateAdhan_NoFileContent() {
+func (suite *AdhanGrpcHandlerTestSuite) TestCreateAdhan_NoFileContent() {
 	ctx := context.Background()
 	masjidID := uuid.New().String()
 	req := &pb.CreateAdhanFileRequest{
@@ -106,7 +152,7 @@ func (suite *GrpcHandlerTestSuite) TestCreateAdhan_NoFileContent() {
 	assert.Nil(suite.T(), resp)
 }
 
-func (suite *GrpcHandlerTestSuite) TestCreateAdhan_FileSizeExceedsLimit() {
+func (suite *AdhanGrpcHandlerTestSuite) TestCreateAdhan_FileSizeExceedsLimit() {
 	ctx := context.Background()
 	masjidID := uuid.New().String()
 	exceedingSize := bytes.Repeat([]byte{0x01}, int(maxAdhanFileSizeMB*1024*1024+1))
@@ -128,7 +174,7 @@ func (suite *GrpcHandlerTestSuite) TestCreateAdhan_FileSizeExceedsLimit() {
 	assert.Nil(suite.T(), resp)
 }
 
-func (suite *GrpcHandlerTestSuite) TestCreateAdhan_InvalidFileType() {
+func (suite *AdhanGrpcHandlerTestSuite) TestCreateAdhan_InvalidFileType() {
 	ctx := context.Background()
 	masjidID := uuid.New().String()
 	invalidAudioContent := []byte{0x00, 0x00, 0x00}
@@ -146,30 +192,23 @@ func (suite *GrpcHandlerTestSuite) TestCreateAdhan_InvalidFileType() {
 	st, ok := status.FromError(err)
 	require.True(suite.T(), ok)
 	assert.Equal(suite.T(), codes.InvalidArgument, st.Code())
-	assert.Equal(suite.T(), "invalid adhan file type. Only MP3 and WAV are supported for now.", st.Message())
+	assert.Equal(suite.T(), audio.ErrUnsupportedFormat.Error(), st.Message())
 	assert.Nil(suite.T(), resp)
 }
 
-func (suite *GrpcHandlerTestSuite) TestUpdateAdhan_Success() {
+func (suite *AdhanGrpcHandlerTestSuite) TestUpdateAdhan_Success() {
 	ctx := context.Background()
 	masjidID := uuid.New().String()
-	initialAudioContent := createValidAudioContent()
-
-	initialAdhan := &entity.Adhan{
-		ID:        uuid.New(),
-		MasjidId:  masjidID,
-		File:      initialAudioContent,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-	}
-	err := suite.DB.Create(&initialAdhan).Error
-	require.NoError(suite.T(), err, "Failed to create initial adhan file")
+	initialAdhan := suite.createAdhan(masjidID, createValidAudioContent())
+	initial, err := suite.Repo.GetByIDAdhan(ctx, initialAdhan.GetId())
+	require.NoError(suite.T(), err)
+	updatedAudioContent := wavFile(16000, 1, 2*time.Second, 8000)
 
 	req := &pb.UpdateAdhanFileRequest{
-		Id: initialAdhan.ID.String(),
+		Id: initialAdhan.GetId(),
 		AdhanFile: &pb.AdhanFile{
 			MasjidId: masjidID,
-			File:     initialAudioContent,
+			File:     updatedAudioContent,
 		},
 	}
 
@@ -181,22 +220,24 @@ func (suite *GrpcHandlerTestSuite) TestUpdateAdhan_Success() {
 	assert.Equal(suite.T(), "success", resp.GetStatus())
 	assert.Equal(suite.T(), "adhan file updated successfully", resp.Message)
 	updatedAdhanResp := resp.GetData().(*pb.StandardAdhanResponse_AdhanFile).AdhanFile
-	assert.Equal(suite.T(), initialAdhan.ID.String(), updatedAdhanResp.GetId())
+	assert.Equal(suite.T(), initialAdhan.GetId(), updatedAdhanResp.GetId())
 	assert.Equal(suite.T(), masjidID, updatedAdhanResp.GetMasjidId())
-	assert.Equal(suite.T(), initialAudioContent, updatedAdhanResp.GetFile())
+	assert.Equal(suite.T(), int64(len(updatedAudioContent)), updatedAdhanResp.GetSizeBytes())
 
-	var updatedAdhanEntity entity.Adhan
-	err = suite.DB.Where("id = ?", initialAdhan.ID).First(&updatedAdhanEntity).Error
+	updatedAdhanEntity, err := suite.Repo.GetByIDAdhan(ctx, initialAdhan.GetId())
 	require.NoError(suite.T(), err)
 	assert.Equal(suite.T(), masjidID, updatedAdhanEntity.MasjidId)
-	assert.Equal(suite.T(), initialAudioContent, updatedAdhanEntity.File)
+	assert.Equal(suite.T(), int64(len(updatedAudioContent)), updatedAdhanEntity.Size)
 
-	err = suite.DB.Delete(&updatedAdhanEntity).Error
+	// The new audio replaces the old in the blob store.
+	stored, err := suite.storedAudio(updatedAdhanEntity.StorageKey)
 	require.NoError(suite.T(), err)
-	//suite.T().Logf("Error Message: %s", err)
+	assert.Equal(suite.T(), updatedAudioContent, stored)
+	_, err = suite.storedAudio(initial.StorageKey)
+	assert.ErrorIs(suite.T(), err, blobstore.ErrNotFound)
 }
 
-func (suite *GrpcHandlerTestSuite) TestUpdateAdhan_NoAdhanFileData() {
+func (suite *AdhanGrpcHandlerTestSuite) TestUpdateAdhan_NoAdhanFileData() {
 	ctx := context.Background()
 	adhanID := uuid.New().String()
 	req := &pb.UpdateAdhanFileRequest{
@@ -213,7 +254,7 @@ func (suite *GrpcHandlerTestSuite) TestUpdateAdhan_NoAdhanFileData() {
 	assert.Nil(suite.T(), resp)
 }
 
-func (suite *GrpcHandlerTestSuite) TestUpdateAdhan_NoAdhanID() {
+func (suite *AdhanGrpcHandlerTestSuite) TestUpdateAdhan_NoAdhanID() {
 	ctx := context.Background()
 	validAudioContent := createValidAudioContent()
 	req := &pb.UpdateAdhanFileRequest{
@@ -233,7 +274,7 @@ func (suite *GrpcHandlerTestSuite) TestUpdateAdhan_NoAdhanID() {
 	assert.Nil(suite.T(), resp)
 }
 
-func (suite *GrpcHandlerTestSuite) TestGetAdhanById_Success() {
+func (suite *AdhanGrpcHandlerTestSuite) TestGetAdhanById_Success() {
 	ctx := context.Background()
 	masjidID := uuid.New().String()
 	validAudioContent := createValidAudioContent()
@@ -241,11 +282,11 @@ func (suite *GrpcHandlerTestSuite) TestGetAdhanById_Success() {
 	existingAdhan := &entity.Adhan{
 		ID:        uuid.New(),
 		MasjidId:  masjidID,
-		File:      validAudioContent,
+		Size:      int64(len(validAudioContent)),
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
-	err := suite.DB.Create(&existingAdhan).Error
+	_, err := suite.Repo.CreateAdhan(ctx, existingAdhan)
 	require.NoError(suite.T(), err, "Failed to create existing adhan file")
 
 	req := &pb.GetAdhanFileRequest{
@@ -262,13 +303,10 @@ func (suite *GrpcHandlerTestSuite) TestGetAdhanById_Success() {
 	retrievedAdhan := resp.GetData().(*pb.StandardAdhanResponse_AdhanFile).AdhanFile
 	assert.Equal(suite.T(), existingAdhan.ID.String(), retrievedAdhan.GetId())
 	assert.Equal(suite.T(), masjidID, retrievedAdhan.GetMasjidId())
-	assert.Equal(suite.T(), validAudioContent, retrievedAdhan.GetFile())
-
-	err = suite.DB.Delete(&existingAdhan).Error
-	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(len(validAudioContent)), retrievedAdhan.GetSizeBytes())
 }
 
-func (suite *GrpcHandlerTestSuite) TestGetAdhanById_AdhanNotFound() {
+func (suite *AdhanGrpcHandlerTestSuite) TestGetAdhanById_AdhanNotFound() {
 	ctx := context.Background()
 	nonExistentAdhanID := uuid.New().String()
 	req := &pb.GetAdhanFileRequest{
@@ -287,23 +325,14 @@ func (suite *GrpcHandlerTestSuite) TestGetAdhanById_AdhanNotFound() {
 	//suite.T().Logf("Error Message: %s", err)
 }
 
-func (suite *GrpcHandlerTestSuite) TestDeleteAdhan_Success() {
+func (suite *AdhanGrpcHandlerTestSuite) TestDeleteAdhan_Success() {
 	ctx := context.Background()
-	masjidID := uuid.New().String()
-	validAudioContent := createValidAudioContent()
-
-	existingAdhan := &entity.Adhan{
-		ID:        uuid.New(),
-		MasjidId:  masjidID,
-		File:      validAudioContent,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-	}
-	err := suite.DB.Create(&existingAdhan).Error
-	require.NoError(suite.T(), err, "Failed to create existing adhan file")
+	existingAdhan := suite.createAdhan(uuid.New().String(), createValidAudioContent())
+	existing, err := suite.Repo.GetByIDAdhan(ctx, existingAdhan.GetId())
+	require.NoError(suite.T(), err)
 
 	req := &pb.DeleteAdhanFileRequest{
-		Id: existingAdhan.ID.String(),
+		Id: existingAdhan.GetId(),
 	}
 
 	resp, err := suite.AdhanHandler.DeleteAdhan(ctx, req)
@@ -317,13 +346,13 @@ func (suite *GrpcHandlerTestSuite) TestDeleteAdhan_Success() {
 	_, ok := resp.GetData().(*pb.StandardAdhanResponse_DeleteAdhanFileResponse)
 	assert.True(suite.T(), ok)
 
-	var deletedAdhan entity.Adhan
-	err = suite.DB.Where("id = ?", existingAdhan.ID).First(&deletedAdhan).Error
-	assert.Error(suite.T(), err)
-	assert.Equal(suite.T(), err.Error(), "record not found")
+	_, err = suite.Repo.GetByIDAdhan(ctx, existingAdhan.GetId())
+	assert.ErrorIs(suite.T(), err, gorm.ErrRecordNotFound)
+	_, err = suite.storedAudio(existing.StorageKey)
+	assert.ErrorIs(suite.T(), err, blobstore.ErrNotFound)
 }
 
-func (suite *GrpcHandlerTestSuite) TestDeleteAdhan_NoAdhanID() {
+func (suite *AdhanGrpcHandlerTestSuite) TestDeleteAdhan_NoAdhanID() {
 	ctx := context.Background()
 	req := &pb.DeleteAdhanFileRequest{}
 
@@ -336,3 +365,7 @@ func (suite *GrpcHandlerTestSuite) TestDeleteAdhan_NoAdhanID() {
 	assert.Equal(suite.T(), "id is required", st.Message())
 	assert.Nil(suite.T(), resp)
 }
+
+func TestAdhanGrpcHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(AdhanGrpcHandlerTestSuite))
+}
@@ -0,0 +1,222 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mnadev/limestone/internal/application/domain/audio"
+	"github.com/mnadev/limestone/internal/application/domain/audio/pcm"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/services"
+	"github.com/mnadev/limestone/internal/infrastructure/blobstore"
+)
+
+func uploadAndProcess(t *testing.T, svc *services.AdhanService, data []byte) *entity.Adhan {
+	ctx := context.Background()
+	created, err := svc.CreateAdhan(ctx, &entity.Adhan{MasjidId: "m1"}, data)
+	require.NoError(t, err)
+	assert.Equal(t, entity.AdhanProcessingPending, created.ProcessingStatus)
+
+	require.NoError(t, svc.ProcessAdhan(ctx, created.ID.String()))
+	processed, err := svc.GetAdhanByID(ctx, created.ID.String())
+	require.NoError(t, err)
+	return processed
+}
+
+// decodeRendition decodes a rendition as a client would download it.
+func decodeRendition(t *testing.T, svc *services.AdhanService, id, name string) pcm.Reader {
+	audioFile, err := svc.OpenAdhanAudio(context.Background(), id, name)
+	require.NoError(t, err)
+	defer audioFile.Close()
+	var data bytes.Buffer
+	_, err = data.ReadFrom(audioFile)
+	require.NoError(t, err)
+	assert.Equal(t, audioFile.Size, int64(data.Len()))
+
+	r, err := pcm.Decode(audio.Detect(data.Bytes()), &data)
+	require.NoError(t, err)
+	return r
+}
+
+func TestProcessAdhan_NormalisesLoudnessAndMakesRenditions(t *testing.T) {
+	svc, _, blobs := newTestAdhanService(t)
+
+	adhan := uploadAndProcess(t, svc, wavFile(44100, 2, 3*time.Second, 1000))
+	assert.Equal(t, entity.AdhanProcessingReady, adhan.ProcessingStatus)
+	assert.Empty(t, adhan.ProcessingError)
+	require.NotNil(t, adhan.LoudnessLufs)
+	assert.Less(t, *adhan.LoudnessLufs, -20.0)
+	require.Len(t, adhan.Renditions, 2)
+
+	normalized := adhan.Rendition("normalized")
+	require.NotNil(t, normalized)
+	assert.Equal(t, "audio/wav", normalized.ContentType)
+	assert.Equal(t, "pcm_s16le", normalized.Codec)
+	assert.Equal(t, int32(44100), normalized.SampleRate)
+	assert.Equal(t, int32(2), normalized.Channels)
+	assert.Equal(t, int64(3000), normalized.DurationMs)
+	require.NotNil(t, normalized.LoudnessLufs)
+	assert.InDelta(t, services.AdhanTargetLoudness, *normalized.LoudnessLufs, 0.1)
+
+	r := decodeRendition(t, svc, adhan.ID.String(), "normalized")
+	assert.Equal(t, pcm.Format{SampleRate: 44100, Channels: 2}, r.Format())
+	assert.InDelta(t, services.AdhanTargetLoudness, measure(t, r).Loudness(), 0.1)
+
+	speaker := adhan.Rendition("speaker")
+	require.NotNil(t, speaker)
+	assert.Equal(t, "audio/mpeg", speaker.ContentType)
+	assert.Equal(t, "mp3", speaker.Codec)
+	assert.Equal(t, int32(16000), speaker.SampleRate)
+	assert.Equal(t, int32(1), speaker.Channels)
+	assert.Equal(t, int32(32000), speaker.Bitrate)
+	assert.InDelta(t, 3000, speaker.DurationMs, 1)
+	// A fraction of the size of the normalized rendition.
+	assert.Less(t, speaker.Size*20, normalized.Size)
+
+	r = decodeRendition(t, svc, adhan.ID.String(), "speaker")
+	assert.Equal(t, pcm.Format{SampleRate: 16000, Channels: 1}, r.Format())
+	// Mixing two identical channels down to one halves the power.
+	assert.InDelta(t, services.AdhanTargetLoudness-3, measure(t, r).Loudness(), 0.5)
+
+	// The original is untouched.
+	assert.Equal(t, wavFile(44100, 2, 3*time.Second, 1000), storedAudio(t, blobs, *adhan))
+}
+
+func TestProcessAdhan_KeepsPeaksBelowCeiling(t *testing.T) {
+	svc, _, _ := newTestAdhanService(t)
+
+	// A quiet tone with one loud click cannot be raised to the target
+	// without clipping.
+	tone := sine(48000, 1, 440, 0.01, 3*time.Second)
+	tone.samples[48000] = 0.9
+	path, _ := writeWAV(t, tone, pcm.PCM16)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	adhan := uploadAndProcess(t, svc, data)
+	require.Equal(t, entity.AdhanProcessingReady, adhan.ProcessingStatus)
+
+	m := measure(t, decodeRendition(t, svc, adhan.ID.String(), "normalized"))
+	assert.LessOrEqual(t, m.Peak(), math.Pow(10, services.AdhanPeakCeiling/20)+1e-4)
+	assert.Less(t, m.Loudness(), services.AdhanTargetLoudness)
+}
+
+func TestProcessAdhan_SkipsFormatsWithoutDecoder(t *testing.T) {
+	svc, _, _ := newTestAdhanService(t)
+
+	adhan := uploadAndProcess(t, svc, adtsFile(100))
+	assert.Equal(t, entity.AdhanProcessingSkipped, adhan.ProcessingStatus)
+	assert.Contains(t, adhan.ProcessingError, pcm.ErrNoDecoder.Error())
+	assert.Nil(t, adhan.LoudnessLufs)
+	assert.Empty(t, adhan.Renditions)
+
+	_, err := svc.OpenAdhanAudio(context.Background(), adhan.ID.String(), "speaker")
+	assert.ErrorIs(t, err, helper.ErrAdhanRenditionNotFound)
+}
+
+func TestProcessAdhan_DecodesMP3(t *testing.T) {
+	svc, _, _ := newTestAdhanService(t)
+
+	path, _ := writeMP3(t, sine(44100, 2, 440, 0.05, 3*time.Second), 128000)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	adhan := uploadAndProcess(t, svc, data)
+	require.Equal(t, entity.AdhanProcessingReady, adhan.ProcessingStatus)
+	assert.Empty(t, adhan.ProcessingError)
+	require.Len(t, adhan.Renditions, 2)
+
+	m := measure(t, decodeRendition(t, svc, adhan.ID.String(), "normalized"))
+	assert.InDelta(t, services.AdhanTargetLoudness, m.Loudness(), 0.1)
+	m = measure(t, decodeRendition(t, svc, adhan.ID.String(), "speaker"))
+	assert.InDelta(t, services.AdhanTargetLoudness-3, m.Loudness(), 0.5)
+}
+
+func TestProcessAdhan_ReplacedAndDeletedAudio(t *testing.T) {
+	ctx := context.Background()
+	svc, _, blobs := newTestAdhanService(t)
+
+	adhan := uploadAndProcess(t, svc, wavFile(8000, 1, time.Second, 3000))
+	require.Len(t, adhan.Renditions, 2)
+	old := adhan.Renditions
+
+	// New audio clears the renditions of the old until it is processed.
+	replaced, err := svc.UploadAdhan(ctx, &entity.Adhan{ID: adhan.ID}, chunked(wavFile(8000, 1, 2*time.Second, 3000), 4096))
+	require.NoError(t, err)
+	assert.Equal(t, entity.AdhanProcessingPending, replaced.ProcessingStatus)
+	current, err := svc.GetAdhanByID(ctx, adhan.ID.String())
+	require.NoError(t, err)
+	assert.Empty(t, current.Renditions)
+	assert.Nil(t, current.LoudnessLufs)
+	for _, r := range old {
+		_, err := blobs.Get(ctx, r.StorageKey, 0, 0)
+		assert.ErrorIs(t, err, blobstore.ErrNotFound)
+	}
+
+	// Processing then makes renditions of the new audio.
+	err = svc.ProcessAdhan(ctx, adhan.ID.String())
+	require.NoError(t, err)
+	current, err = svc.GetAdhanByID(ctx, adhan.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, entity.AdhanProcessingReady, current.ProcessingStatus)
+	assert.Equal(t, int64(2000), current.Rendition("normalized").DurationMs)
+
+	keys := []string{current.StorageKey}
+	for _, r := range current.Renditions {
+		keys = append(keys, r.StorageKey)
+	}
+	require.NoError(t, svc.DeleteAdhan(ctx, adhan.ID.String()))
+	for _, key := range keys {
+		_, err := blobs.Get(ctx, key, 0, 0)
+		assert.ErrorIs(t, err, blobstore.ErrNotFound)
+	}
+}
+
+func TestAdhanService_ProcessesUploadsInBackground(t *testing.T) {
+	ctx := context.Background()
+	files, err := blobstore.NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	repo := newMemoryAdhanRepo()
+
+	// An upload through a service without a queue, as the gateway's, is
+	// left pending for the processing service.
+	gateway := &services.AdhanService{Repo: repo, Blobs: files}
+	uploaded, err := gateway.CreateAdhan(ctx, &entity.Adhan{MasjidId: "m1"}, wavFile(8000, 1, time.Second, 3000))
+	require.NoError(t, err)
+
+	svc := services.NewAdhanService(repo, files)
+	created, err := svc.CreateAdhan(ctx, &entity.Adhan{MasjidId: "m1"}, wavFile(8000, 1, time.Second, 2000))
+	require.NoError(t, err)
+	// Nothing is processed before the processing runs.
+	adhan, err := svc.GetAdhanByID(ctx, created.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, entity.AdhanProcessingPending, adhan.ProcessingStatus)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		svc.RunProcessing(runCtx)
+		close(done)
+	}()
+	for _, id := range []string{uploaded.ID.String(), created.ID.String()} {
+		require.Eventually(t, func() bool {
+			adhan, err := svc.GetAdhanByID(ctx, id)
+			return err == nil && adhan.ProcessingStatus == entity.AdhanProcessingReady
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("RunProcessing did not stop when its context was cancelled")
+	}
+}
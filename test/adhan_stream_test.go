@@ -0,0 +1,378 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mnadev/limestone/internal/application/domain/audio"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/services"
+	"github.com/mnadev/limestone/internal/infrastructure/blobstore"
+)
+
+// memoryAdhanRepo keeps adhan metadata in memory.
+type memoryAdhanRepo struct {
+	mu          sync.Mutex
+	adhans      map[uuid.UUID]entity.Adhan
+	renditions  map[uuid.UUID][]entity.AdhanRendition
+	assignments map[string]map[entity.Prayer]entity.AdhanAssignment
+	blobs       map[string]entity.AdhanBlob
+}
+
+func newMemoryAdhanRepo() *memoryAdhanRepo {
+	return &memoryAdhanRepo{
+		adhans:      map[uuid.UUID]entity.Adhan{},
+		renditions:  map[uuid.UUID][]entity.AdhanRendition{},
+		assignments: map[string]map[entity.Prayer]entity.AdhanAssignment{},
+		blobs:       map[string]entity.AdhanBlob{},
+	}
+}
+
+func (r *memoryAdhanRepo) CreateAdhan(ctx context.Context, adhan *entity.Adhan) (*entity.Adhan, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *adhan
+	stored.Renditions = nil
+	r.adhans[adhan.ID] = stored
+	return adhan, nil
+}
+
+// UpdateAdhan writes the non-zero fields of adhan, as gorm's Updates does.
+func (r *memoryAdhanRepo) UpdateAdhan(ctx context.Context, adhan *entity.Adhan) (*entity.Adhan, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := r.adhans[adhan.ID]
+	dst := reflect.ValueOf(&stored).Elem()
+	src := reflect.ValueOf(adhan).Elem()
+	for i := 0; i < src.NumField(); i++ {
+		if src.Type().Field(i).Name != "Renditions" && !src.Field(i).IsZero() {
+			dst.Field(i).Set(src.Field(i))
+		}
+	}
+	r.adhans[adhan.ID] = stored
+	return adhan, nil
+}
+
+func (r *memoryAdhanRepo) GetByIDAdhan(ctx context.Context, id string) (*entity.Adhan, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	adhan, ok := r.adhans[uuid.MustParse(id)]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	adhan.Renditions = append([]entity.AdhanRendition(nil), r.renditions[adhan.ID]...)
+	return &adhan, nil
+}
+
+func (r *memoryAdhanRepo) DeleteAdhan(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	adhanID := uuid.MustParse(id)
+	delete(r.adhans, adhanID)
+	delete(r.renditions, adhanID)
+	for _, byPrayer := range r.assignments {
+		for prayer, a := range byPrayer {
+			if a.AdhanId == adhanID {
+				delete(byPrayer, prayer)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *memoryAdhanRepo) ListAdhans(ctx context.Context, masjidID string) ([]entity.Adhan, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var adhans []entity.Adhan
+	for _, adhan := range r.adhans {
+		if adhan.MasjidId == masjidID {
+			adhan.Renditions = r.renditions[adhan.ID]
+			adhans = append(adhans, adhan)
+		}
+	}
+	sort.Slice(adhans, func(i, j int) bool { return adhans[i].Title < adhans[j].Title })
+	return adhans, nil
+}
+
+func (r *memoryAdhanRepo) ListAdhanAssignments(ctx context.Context, masjidID string) ([]entity.AdhanAssignment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var assignments []entity.AdhanAssignment
+	for _, a := range r.assignments[masjidID] {
+		assignments = append(assignments, a)
+	}
+	return assignments, nil
+}
+
+func (r *memoryAdhanRepo) SetAdhanAssignment(ctx context.Context, assignment *entity.AdhanAssignment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.assignments[assignment.MasjidId] == nil {
+		r.assignments[assignment.MasjidId] = map[entity.Prayer]entity.AdhanAssignment{}
+	}
+	r.assignments[assignment.MasjidId][assignment.Prayer] = *assignment
+	return nil
+}
+
+func (r *memoryAdhanRepo) DeleteAdhanAssignment(ctx context.Context, masjidID string, prayer entity.Prayer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.assignments[masjidID], prayer)
+	return nil
+}
+
+func (r *memoryAdhanRepo) ListAdhansByProcessingStatus(ctx context.Context, statuses ...entity.AdhanProcessingStatus) ([]entity.Adhan, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var adhans []entity.Adhan
+	for _, adhan := range r.adhans {
+		for _, s := range statuses {
+			if adhan.ProcessingStatus == s {
+				adhans = append(adhans, adhan)
+			}
+		}
+	}
+	return adhans, nil
+}
+
+func (r *memoryAdhanRepo) UpdateAdhanProcessing(ctx context.Context, adhan *entity.Adhan) ([]entity.AdhanRendition, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.adhans[adhan.ID]
+	if !ok || stored.StorageKey != adhan.StorageKey {
+		return nil, gorm.ErrRecordNotFound
+	}
+	stored.ProcessingStatus = adhan.ProcessingStatus
+	stored.ProcessingError = adhan.ProcessingError
+	stored.LoudnessLufs = adhan.LoudnessLufs
+	r.adhans[adhan.ID] = stored
+	replaced := r.renditions[adhan.ID]
+	r.renditions[adhan.ID] = append([]entity.AdhanRendition(nil), adhan.Renditions...)
+	return replaced, nil
+}
+
+func (r *memoryAdhanRepo) AcquireAdhanBlob(ctx context.Context, blob *entity.AdhanBlob, store func() error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if existing, ok := r.blobs[blob.Sha256]; ok {
+		existing.RefCount++
+		r.blobs[blob.Sha256] = existing
+		return nil
+	}
+	if err := store(); err != nil {
+		return err
+	}
+	blob.RefCount = 1
+	r.blobs[blob.Sha256] = *blob
+	return nil
+}
+
+func (r *memoryAdhanRepo) ReleaseAdhanBlob(ctx context.Context, sha256 string, remove func(storageKey string) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	blob, ok := r.blobs[sha256]
+	if !ok {
+		return nil
+	}
+	if blob.RefCount > 1 {
+		blob.RefCount--
+		r.blobs[sha256] = blob
+		return nil
+	}
+	if err := remove(blob.StorageKey); err != nil {
+		return err
+	}
+	delete(r.blobs, sha256)
+	return nil
+}
+
+// countingStore counts the reads and writes made through a blob store.
+type countingStore struct {
+	blobstore.Store
+	gets int
+	puts int
+}
+
+func (s *countingStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	s.puts++
+	return s.Store.Put(ctx, key, r, size, contentType)
+}
+
+func (s *countingStore) Get(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	s.gets++
+	return s.Store.Get(ctx, key, offset, length)
+}
+
+func newTestAdhanService(t *testing.T) (*services.AdhanService, *memoryAdhanRepo, *countingStore) {
+	files, err := blobstore.NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	repo := newMemoryAdhanRepo()
+	blobs := &countingStore{Store: files}
+	// Without a processing queue, so that tests process adhans themselves.
+	return &services.AdhanService{Repo: repo, Blobs: blobs}, repo, blobs
+}
+
+// chunked returns a next function yielding data in pieces of size n.
+func chunked(data []byte, n int) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		if len(data) == 0 {
+			return nil, io.EOF
+		}
+		if n > len(data) {
+			n = len(data)
+		}
+		chunk := data[:n]
+		data = data[n:]
+		return chunk, nil
+	}
+}
+
+func storedAudio(t *testing.T, blobs blobstore.Store, adhan entity.Adhan) []byte {
+	return readBlob(t, blobs, adhan.StorageKey, 0, 0)
+}
+
+func TestAudioContentType(t *testing.T) {
+	assert.Equal(t, "audio/mpeg", helper.AudioContentType([]byte("ID3\x04")))
+	assert.Equal(t, "audio/mpeg", helper.AudioContentType([]byte{0xFF, 0xFB, 0x90, 0x00}))
+	assert.Equal(t, "audio/wav", helper.AudioContentType([]byte("RIFF\x00\x00\x00\x00WAVE")))
+	assert.Equal(t, "audio/flac", helper.AudioContentType([]byte("fLaC")))
+	assert.Equal(t, "audio/ogg", helper.AudioContentType([]byte("OggS")))
+	assert.Equal(t, "audio/mp4", helper.AudioContentType([]byte("\x00\x00\x00\x20ftypM4A ")))
+	assert.Equal(t, "application/octet-stream", helper.AudioContentType([]byte("%PDF")))
+}
+
+func TestUploadAdhan_StreamsAndValidates(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, blobs := newTestAdhanService(t)
+
+	// The header is split across chunks.
+	data := wavFile(8000, 1, time.Second, 8000)
+	created, err := svc.UploadAdhan(ctx, &entity.Adhan{MasjidId: "m1"}, chunked(data, 3))
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, created.ID)
+	assert.Equal(t, int64(len(data)), created.Size)
+	assert.Equal(t, "audio/wav", created.ContentType)
+	assert.Equal(t, "pcm_s16le", created.Codec)
+	assert.Equal(t, int64(1000), created.DurationMs)
+	assert.Equal(t, int32(8000), created.SampleRate)
+	assert.Equal(t, int32(1), created.Channels)
+	assert.Equal(t, int32(128000), created.Bitrate)
+	assert.Equal(t, data, storedAudio(t, blobs, repo.adhans[created.ID]))
+
+	_, err = svc.UploadAdhan(ctx, &entity.Adhan{MasjidId: "m1"}, chunked([]byte("%PDF-1.7"), 2))
+	assert.ErrorIs(t, err, audio.ErrUnsupportedFormat)
+
+	truncated := data[:len(data)-100]
+	_, err = svc.UploadAdhan(ctx, &entity.Adhan{MasjidId: "m1"}, chunked(truncated, 1000))
+	assert.ErrorIs(t, err, audio.ErrTruncated)
+
+	silent := wavFile(8000, 1, time.Second, 0)
+	_, err = svc.UploadAdhan(ctx, &entity.Adhan{MasjidId: "m1"}, chunked(silent, 1000))
+	assert.ErrorIs(t, err, audio.ErrSilent)
+
+	_, err = svc.UploadAdhan(ctx, &entity.Adhan{MasjidId: "m1"}, chunked(nil, 1))
+	assert.ErrorIs(t, err, helper.ErrEmptyAdhanAudio)
+
+	first := true
+	tooLarge := func() ([]byte, error) {
+		if first {
+			first = false
+			return data[:44], nil
+		}
+		return make([]byte, 1<<20), nil
+	}
+	_, err = svc.UploadAdhan(ctx, &entity.Adhan{MasjidId: "m1"}, tooLarge)
+	assert.ErrorIs(t, err, helper.ErrAdhanTooLarge)
+	assert.Len(t, repo.adhans, 1)
+
+	_, err = svc.UploadAdhan(ctx, &entity.Adhan{ID: uuid.New()}, chunked(data, 100))
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	oldKey := created.StorageKey
+	replacement := mp3File(20, false)
+	replaced, err := svc.UploadAdhan(ctx, &entity.Adhan{ID: created.ID}, chunked(replacement, 128))
+	require.NoError(t, err)
+	assert.Equal(t, "m1", replaced.MasjidId)
+	assert.Equal(t, created.CreatedAt, replaced.CreatedAt)
+	assert.Equal(t, "audio/mpeg", replaced.ContentType)
+	assert.Equal(t, "mp3", replaced.Codec)
+	assert.NotEqual(t, oldKey, replaced.StorageKey)
+	assert.Equal(t, replacement, storedAudio(t, blobs, repo.adhans[created.ID]))
+	_, err = blobs.Get(ctx, oldKey, 0, 0)
+	assert.ErrorIs(t, err, blobstore.ErrNotFound)
+
+	require.NoError(t, svc.DeleteAdhan(ctx, created.ID.String()))
+	assert.Empty(t, repo.adhans)
+	_, err = blobs.Get(ctx, replaced.StorageKey, 0, 0)
+	assert.ErrorIs(t, err, blobstore.ErrNotFound)
+}
+
+func TestAdhanAudio_ReadsSequentiallyAndSeeks(t *testing.T) {
+	ctx := context.Background()
+	svc, _, blobs := newTestAdhanService(t)
+	data := wavFile(44100, 2, 3*time.Second, 8000)
+	created, err := svc.UploadAdhan(ctx, &entity.Adhan{MasjidId: "m1"}, chunked(data, 4096))
+	require.NoError(t, err)
+
+	audio, err := svc.OpenAdhanAudio(ctx, created.ID.String(), "")
+	require.NoError(t, err)
+	defer audio.Close()
+	assert.Equal(t, int64(len(data)), audio.Size)
+	assert.Equal(t, "audio/wav", audio.ContentType)
+
+	blobs.gets = 0
+	got, err := io.ReadAll(audio)
+	require.NoError(t, err)
+	assert.Equal(t, data, got)
+	assert.Equal(t, 1, blobs.gets)
+
+	pos, err := audio.Seek(-100, io.SeekEnd)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(data)-100), pos)
+	got, err = io.ReadAll(audio)
+	require.NoError(t, err)
+	assert.Equal(t, data[len(data)-100:], got)
+	assert.Equal(t, 2, blobs.gets)
+}
+
+func TestAdhanAudio_ServesRanges(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _ := newTestAdhanService(t)
+	data := wavFile(8000, 1, time.Second, 8000)
+	created, err := svc.UploadAdhan(ctx, &entity.Adhan{MasjidId: "m1"}, chunked(data, 100))
+	require.NoError(t, err)
+	audio, err := svc.OpenAdhanAudio(ctx, created.ID.String(), "")
+	require.NoError(t, err)
+	defer audio.Close()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/adhan/x/audio", nil)
+	req.Header.Set("Range", "bytes=100-199")
+	rec := httptest.NewRecorder()
+	http.ServeContent(rec, req, "", time.Time{}, audio)
+
+	assert.Equal(t, http.StatusPartialContent, rec.Code)
+	assert.Equal(t, fmt.Sprintf("bytes 100-199/%d", len(data)), rec.Header().Get("Content-Range"))
+	assert.True(t, bytes.Equal(data[100:200], rec.Body.Bytes()))
+}
+
+func TestAdhanAudio_MissingAdhan(t *testing.T) {
+	svc, _, _ := newTestAdhanService(t)
+	_, err := svc.OpenAdhanAudio(context.Background(), uuid.New().String(), "")
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+}
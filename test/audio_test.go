@@ -0,0 +1,300 @@
+package test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mnadev/limestone/internal/application/domain/audio"
+)
+
+// The builders below write just enough of each format for audio.Probe,
+// with no real encoded audio.
+
+// wavFile returns 16-bit PCM carrying a square wave of the given amplitude.
+func wavFile(rate, channels int, duration time.Duration, amplitude int16) []byte {
+	frames := int(duration.Seconds() * float64(rate))
+	data := make([]byte, frames*channels*2)
+	for i := 0; i < frames*channels; i++ {
+		v := amplitude
+		if (i/channels/50)%2 == 1 {
+			v = -amplitude
+		}
+		binary.LittleEndian.PutUint16(data[i*2:], uint16(v))
+	}
+	var b bytes.Buffer
+	b.WriteString("RIFF")
+	binary.Write(&b, binary.LittleEndian, uint32(36+len(data)))
+	b.WriteString("WAVEfmt ")
+	for _, v := range []any{
+		uint32(16), uint16(1), uint16(channels), uint32(rate),
+		uint32(rate * channels * 2), uint16(channels * 2), uint16(16),
+	} {
+		binary.Write(&b, binary.LittleEndian, v)
+	}
+	b.WriteString("data")
+	binary.Write(&b, binary.LittleEndian, uint32(len(data)))
+	b.Write(data)
+	return b.Bytes()
+}
+
+// mp3File returns MPEG-1 Layer III frames at 128 kbit/s, 44.1 kHz stereo,
+// preceded by an ID3 tag and a Xing header declaring the frame count.
+func mp3File(frames int, silent bool) []byte {
+	const frameLength = 417
+	frame := func() []byte {
+		f := make([]byte, frameLength)
+		copy(f, []byte{0xFF, 0xFB, 0x90, 0x00})
+		if !silent {
+			f[4+3] = 0xFF // inside part2_3_length of the first granule
+		}
+		return f
+	}
+	var b bytes.Buffer
+	b.Write([]byte{'I', 'D', '3', 4, 0, 0, 0, 0, 0, 20})
+	b.Write(make([]byte, 20))
+	xing := frame()
+	copy(xing[4:], make([]byte, 32))
+	copy(xing[36:], "Xing")
+	binary.BigEndian.PutUint32(xing[40:], 1)
+	binary.BigEndian.PutUint32(xing[44:], uint32(frames))
+	b.Write(xing)
+	for i := 0; i < frames; i++ {
+		b.Write(frame())
+	}
+	return b.Bytes()
+}
+
+// adtsFile returns AAC-LC frames at 44.1 kHz stereo.
+func adtsFile(frames int) []byte {
+	const frameLength = 200
+	var b bytes.Buffer
+	for i := 0; i < frames; i++ {
+		f := make([]byte, frameLength)
+		copy(f, []byte{
+			0xFF, 0xF1, 0x50, 0x80 | frameLength>>11,
+			byte(frameLength >> 3), byte(frameLength&7)<<5 | 0x1F, 0xFC,
+		})
+		b.Write(f)
+	}
+	return b.Bytes()
+}
+
+func testCRC8(data []byte) byte {
+	var crc byte
+	for _, d := range data {
+		crc ^= d
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// flacFile returns 4096-sample stereo frames at 44.1 kHz. Silent frames
+// hold CONSTANT subframes; otherwise the first frame is VERBATIM.
+func flacFile(frames int, silent bool) []byte {
+	var b bytes.Buffer
+	b.WriteString("fLaC")
+	b.Write([]byte{0x80, 0, 0, 34})
+	info := make([]byte, 34)
+	binary.BigEndian.PutUint16(info[0:], 4096)
+	binary.BigEndian.PutUint16(info[2:], 4096)
+	// 20 bits of rate, 3 of channels-1, 5 of bps-1, 36 of total samples.
+	packed := uint64(44100)<<44 | uint64(1)<<41 | uint64(15)<<36 | uint64(frames*4096)
+	binary.BigEndian.PutUint64(info[10:], packed)
+	b.Write(info)
+	for i := 0; i < frames; i++ {
+		header := []byte{0xFF, 0xF8, 0xC9, 0x18, byte(i)}
+		header = append(header, testCRC8(header))
+		b.Write(header)
+		if !silent && i == 0 {
+			b.WriteByte(0x02)
+			b.Write(make([]byte, 4096*2))
+			b.WriteByte(0x02)
+			b.Write(make([]byte, 4096*2))
+		} else {
+			b.Write([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+		}
+		b.Write([]byte{0x12, 0x34}) // CRC-16, unchecked
+	}
+	return b.Bytes()
+}
+
+func oggPage(flags byte, granule uint64, packet []byte) []byte {
+	var b bytes.Buffer
+	b.WriteString("OggS")
+	b.Write([]byte{0, flags})
+	binary.Write(&b, binary.LittleEndian, granule)
+	binary.Write(&b, binary.LittleEndian, []uint32{1, 0, 0}) // serial, sequence, CRC
+	var segments []byte
+	for n := len(packet); ; n -= 255 {
+		if n < 255 {
+			segments = append(segments, byte(n))
+			break
+		}
+		segments = append(segments, 255)
+	}
+	b.WriteByte(byte(len(segments)))
+	b.Write(segments)
+	b.Write(packet)
+	return b.Bytes()
+}
+
+// opusFile returns an Ogg Opus stream lasting duration.
+func opusFile(duration time.Duration, ended bool) []byte {
+	const preSkip = 312
+	head := []byte("OpusHead")
+	head = append(head, 1, 2)
+	head = binary.LittleEndian.AppendUint16(head, preSkip)
+	head = binary.LittleEndian.AppendUint32(head, 44100)
+	head = append(head, 0, 0, 0)
+	end := uint64(duration.Seconds()*48000) + preSkip
+
+	var b bytes.Buffer
+	b.Write(oggPage(0x02, 0, head))
+	b.Write(oggPage(0, 0, []byte("OpusTags")))
+	b.Write(oggPage(0, end/2, make([]byte, 300)))
+	if ended {
+		b.Write(oggPage(0x04, end, make([]byte, 300)))
+	}
+	return b.Bytes()
+}
+
+func mp4Box(kind string, payload ...[]byte) []byte {
+	body := bytes.Join(payload, nil)
+	box := binary.BigEndian.AppendUint32(nil, uint32(8+len(body)))
+	return append(append(box, kind...), body...)
+}
+
+// m4aFile returns an AAC track of duration at 44.1 kHz stereo.
+func m4aFile(duration time.Duration, mdatSize int) []byte {
+	mdhd := make([]byte, 24)
+	binary.BigEndian.PutUint32(mdhd[12:], 44100)
+	binary.BigEndian.PutUint32(mdhd[16:], uint32(duration.Seconds()*44100))
+	hdlr := make([]byte, 24)
+	copy(hdlr[8:], "soun")
+	entry := make([]byte, 28)
+	binary.BigEndian.PutUint16(entry[16:], 2)
+	binary.BigEndian.PutUint16(entry[18:], 16)
+	binary.BigEndian.PutUint32(entry[24:], 44100<<16)
+	stsd := append(make([]byte, 4), 0, 0, 0, 1)
+	stsd = append(stsd, mp4Box("mp4a", entry)...)
+
+	moov := mp4Box("moov", mp4Box("trak", mp4Box("mdia",
+		mp4Box("mdhd", mdhd),
+		mp4Box("hdlr", hdlr),
+		mp4Box("minf", mp4Box("stbl", mp4Box("stsd", stsd))),
+	)))
+	return bytes.Join([][]byte{
+		mp4Box("ftyp", []byte("M4A "), make([]byte, 4)),
+		moov,
+		mp4Box("mdat", make([]byte, mdatSize)),
+	}, nil)
+}
+
+func probe(t *testing.T, data []byte) (*audio.Info, error) {
+	t.Helper()
+	return audio.Probe(bytes.NewReader(data), int64(len(data)))
+}
+
+func TestProbe_Formats(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       []byte
+		format     string
+		codec      string
+		duration   time.Duration
+		sampleRate int
+		channels   int
+	}{
+		{"wav", wavFile(8000, 1, 2*time.Second, 8000), audio.FormatWAV, "pcm_s16le", 2 * time.Second, 8000, 1},
+		{"mp3", mp3File(100, false), audio.FormatMP3, "mp3", 115200 * time.Second / 44100, 44100, 2},
+		{"adts", adtsFile(43), audio.FormatADTS, "aac", 43 * 1024 * time.Second / 44100, 44100, 2},
+		{"flac", flacFile(10, false), audio.FormatFLAC, "flac", 40960 * time.Second / 44100, 44100, 2},
+		{"opus", opusFile(3*time.Second, true), audio.FormatOgg, "opus", 3 * time.Second, 44100, 2},
+		{"m4a", m4aFile(4*time.Second, 1000), audio.FormatMP4, "aac", 4 * time.Second, 44100, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.format, audio.Detect(tt.data[:audio.HeaderSize]))
+			info, err := probe(t, tt.data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.format, info.Format)
+			assert.Equal(t, tt.codec, info.Codec)
+			assert.InDelta(t, tt.duration.Seconds(), info.Duration.Seconds(), 0.001)
+			assert.Equal(t, tt.sampleRate, info.SampleRate)
+			assert.Equal(t, tt.channels, info.Channels)
+			assert.Positive(t, info.Bitrate)
+			assert.False(t, info.Silent)
+			assert.NoError(t, info.Check())
+		})
+	}
+}
+
+func TestProbe_MP3Bitrate(t *testing.T) {
+	info, err := probe(t, mp3File(100, false))
+	require.NoError(t, err)
+	assert.InDelta(t, 128000, info.Bitrate, 500)
+}
+
+func TestProbe_RejectsTruncated(t *testing.T) {
+	wav := wavFile(8000, 1, time.Second, 8000)
+	mp3 := mp3File(100, false)
+	adts := adtsFile(10)
+	flac := flacFile(10, false)
+	m4a := m4aFile(time.Second, 1000)
+	for name, data := range map[string][]byte{
+		"wav":           wav[:len(wav)-10],
+		"mp3 mid-frame": mp3[:len(mp3)-100],
+		"mp3 frames":    mp3[:len(mp3)-417],
+		"adts":          adts[:len(adts)-50],
+		"flac":          flac[:len(flac)-10],
+		"opus":          opusFile(time.Second, false),
+		"m4a":           m4a[:len(m4a)-10],
+		"header only":   wav[:44],
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := probe(t, data)
+			assert.ErrorIs(t, err, audio.ErrTruncated)
+		})
+	}
+}
+
+func TestProbe_RejectsUnsupported(t *testing.T) {
+	_, err := probe(t, []byte("%PDF-1.7 not audio"))
+	assert.ErrorIs(t, err, audio.ErrUnsupportedFormat)
+
+	speex := oggPage(0x02, 0, []byte("Speex   1.2"))
+	_, err = probe(t, speex)
+	assert.ErrorIs(t, err, audio.ErrUnsupportedFormat)
+}
+
+func TestProbe_DetectsSilence(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"wav":  wavFile(8000, 2, time.Second, 10),
+		"mp3":  mp3File(50, true),
+		"flac": flacFile(5, true),
+	} {
+		t.Run(name, func(t *testing.T) {
+			info, err := probe(t, data)
+			require.NoError(t, err)
+			assert.True(t, info.Silent)
+			assert.ErrorIs(t, info.Check(), audio.ErrSilent)
+		})
+	}
+}
+
+func TestProbe_RejectsTooLong(t *testing.T) {
+	info, err := probe(t, opusFile(11*time.Minute, true))
+	require.NoError(t, err)
+	assert.ErrorIs(t, info.Check(), audio.ErrTooLong)
+}
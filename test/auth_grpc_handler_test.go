@@ -14,7 +14,7 @@ import (
 	"os"
 )
 
-func (suite *GrpcHandlerTestSuite) TestAuthenticateUser_SuccessWithUsername() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestAuthenticateUser_SuccessWithUsername() {
 	ctx := context.Background()
 	user := &entity.User{
 		ID:             uuid.New(),
@@ -49,7 +49,7 @@ func (suite *GrpcHandlerTestSuite) TestAuthenticateUser_SuccessWithUsername() {
 	require.NoError(suite.T(), err, "Failed to delete test user")
 }
 
-func (suite *GrpcHandlerTestSuite) TestAuthenticateUser_SuccessWithEmail() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestAuthenticateUser_SuccessWithEmail() {
 	ctx := context.Background()
 	user := &entity.User{
 		ID:             uuid.New(),
@@ -84,7 +84,7 @@ func (suite *GrpcHandlerTestSuite) TestAuthenticateUser_SuccessWithEmail() {
 	require.NoError(suite.T(), err, "Failed to delete test user")
 }
 
-func (suite *GrpcHandlerTestSuite) TestAuthenticateUser_NoIdentifier() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestAuthenticateUser_NoIdentifier() {
 	ctx := context.Background()
 	authHandler := handler.NewAuthGrpcHandler(suite.AuthService)
 
@@ -102,7 +102,7 @@ func (suite *GrpcHandlerTestSuite) TestAuthenticateUser_NoIdentifier() {
 	assert.Nil(suite.T(), resp)
 }
 
-func (suite *GrpcHandlerTestSuite) TestAuthenticateUser_InvalidCredentials() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestAuthenticateUser_InvalidCredentials() {
 	ctx := context.Background()
 	authHandler := handler.NewAuthGrpcHandler(suite.AuthService)
 
@@ -123,7 +123,7 @@ func (suite *GrpcHandlerTestSuite) TestAuthenticateUser_InvalidCredentials() {
 	assert.Nil(suite.T(), resp)
 }
 
-func (suite *GrpcHandlerTestSuite) TestRefreshToken_Success() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestRefreshToken_Success() {
 	require.NotEmpty(suite.T(), os.Getenv("REFRESH_SECRET"), "REFRESH_SECRET environment variable must be set for this test")
 	require.NotEmpty(suite.T(), os.Getenv("ACCESS_SECRET"), "ACCESS_SECRET environment variable must be set for this test")
 	require.NotEmpty(suite.T(), os.Getenv("ACCESS_EXPIRATION"), "ACCESS_EXPIRATION environment variable must be set for this test")
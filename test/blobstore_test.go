@@ -0,0 +1,158 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mnadev/limestone/internal/infrastructure/blobstore"
+)
+
+// fakeS3 is a MinIO-style stand-in serving path-style object requests for
+// a single bucket from memory.
+type fakeS3 struct {
+	bucket    string
+	accessKey string
+
+	mu      sync.Mutex
+	objects map[string][]byte
+	types   map[string]string
+}
+
+func newFakeS3(bucket, accessKey string) *fakeS3 {
+	return &fakeS3{bucket: bucket, accessKey: accessKey, objects: map[string][]byte{}, types: map[string]string{}}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential="+f.accessKey+"/") ||
+		!strings.Contains(auth, "SignedHeaders=host;") || !strings.Contains(auth, "Signature=") ||
+		r.Header.Get("X-Amz-Date") == "" || r.Header.Get("X-Amz-Content-Sha256") == "" {
+		http.Error(w, "<Error><Code>AccessDenied</Code></Error>", http.StatusForbidden)
+		return
+	}
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+f.bucket+"/")
+	if !ok {
+		http.Error(w, "<Error><Code>NoSuchBucket</Code></Error>", http.StatusNotFound)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil || int64(len(data)) != r.ContentLength {
+			http.Error(w, "<Error><Code>IncompleteBody</Code></Error>", http.StatusBadRequest)
+			return
+		}
+		f.objects[key] = data
+		f.types[key] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		data, ok := f.objects[key]
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestS3Store(t *testing.T) (*blobstore.S3Store, *fakeS3) {
+	fake := newFakeS3("adhans", "test-access")
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	store, err := blobstore.NewS3Store(blobstore.S3Config{
+		Endpoint:  server.URL,
+		Bucket:    "adhans",
+		AccessKey: "test-access",
+		SecretKey: "test-secret",
+	})
+	require.NoError(t, err)
+	return store, fake
+}
+
+func readBlob(t *testing.T, store blobstore.Store, key string, offset, length int64) []byte {
+	body, err := store.Get(context.Background(), key, offset, length)
+	require.NoError(t, err)
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+	return data
+}
+
+func testBlobStore(t *testing.T, store blobstore.Store) {
+	ctx := context.Background()
+	data := []byte("RIFF0123456789")
+	key := blobstore.NewKey("adhans", "a1")
+	assert.True(t, strings.HasPrefix(key, "adhans/a1/"))
+
+	require.NoError(t, store.Put(ctx, key, bytes.NewReader(data), int64(len(data)), "audio/wav"))
+	assert.Equal(t, data, readBlob(t, store, key, 0, 0))
+	assert.Equal(t, data[4:8], readBlob(t, store, key, 4, 4))
+	assert.Equal(t, data[10:], readBlob(t, store, key, 10, 0))
+	assert.Empty(t, readBlob(t, store, key, int64(len(data)), 0))
+
+	_, err := store.Get(ctx, "adhans/a1/missing", 0, 0)
+	assert.ErrorIs(t, err, blobstore.ErrNotFound)
+	err = store.Put(ctx, "../escape", bytes.NewReader(data), int64(len(data)), "")
+	assert.ErrorIs(t, err, blobstore.ErrInvalidKey)
+
+	require.NoError(t, store.Delete(ctx, key))
+	_, err = store.Get(ctx, key, 0, 0)
+	assert.ErrorIs(t, err, blobstore.ErrNotFound)
+	assert.NoError(t, store.Delete(ctx, key))
+}
+
+func TestFileStore(t *testing.T) {
+	store, err := blobstore.NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	testBlobStore(t, store)
+
+	// A short read leaves no object behind.
+	err = store.Put(context.Background(), "adhans/a2/x", strings.NewReader("RIFF"), 10, "")
+	assert.Error(t, err)
+	_, err = store.Get(context.Background(), "adhans/a2/x", 0, 0)
+	assert.ErrorIs(t, err, blobstore.ErrNotFound)
+}
+
+func TestS3Store(t *testing.T) {
+	store, fake := newTestS3Store(t)
+	testBlobStore(t, store)
+
+	key := "adhans/a3/with space"
+	require.NoError(t, store.Put(context.Background(), key, strings.NewReader("ID3x"), 4, "audio/mpeg"))
+	assert.Equal(t, "audio/mpeg", fake.types[key])
+	assert.Equal(t, []byte("ID3x"), readBlob(t, store, key, 0, 0))
+}
+
+func TestS3Store_SurfacesErrors(t *testing.T) {
+	fake := newFakeS3("adhans", "someone-else")
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	store, err := blobstore.NewS3Store(blobstore.S3Config{
+		Endpoint: server.URL, Bucket: "adhans", AccessKey: "test-access", SecretKey: "test-secret",
+	})
+	require.NoError(t, err)
+
+	err = store.Put(context.Background(), "adhans/a4/x", strings.NewReader("RIFF"), 4, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Forbidden")
+
+	_, err = blobstore.NewS3Store(blobstore.S3Config{Endpoint: server.URL})
+	assert.Error(t, err)
+}
@@ -0,0 +1,359 @@
+package test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/ical"
+	"github.com/mnadev/limestone/internal/application/handler"
+	"github.com/mnadev/limestone/internal/application/services"
+)
+
+// memoryCalendarFeedRepo keeps calendar feeds in memory.
+type memoryCalendarFeedRepo struct {
+	mu    sync.Mutex
+	feeds []*entity.CalendarFeed
+}
+
+func (r *memoryCalendarFeedRepo) find(match func(*entity.CalendarFeed) bool) (*entity.CalendarFeed, error) {
+	for _, feed := range r.feeds {
+		if match(feed) {
+			copied := *feed
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memoryCalendarFeedRepo) GetCalendarFeed(ctx context.Context, masjidID, userID string) (*entity.CalendarFeed, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.find(func(f *entity.CalendarFeed) bool {
+		if masjidID != "" {
+			return f.MasjidId == masjidID
+		}
+		return f.UserId != nil && f.UserId.String() == userID
+	})
+}
+
+func (r *memoryCalendarFeedRepo) GetCalendarFeedByToken(ctx context.Context, token string) (*entity.CalendarFeed, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.find(func(f *entity.CalendarFeed) bool { return f.Token == token })
+}
+
+func (r *memoryCalendarFeedRepo) CreateCalendarFeed(ctx context.Context, feed *entity.CalendarFeed) (*entity.CalendarFeed, error) {
+	r.mu.Lock()
+	copied := *feed
+	r.feeds = append(r.feeds, &copied)
+	r.mu.Unlock()
+	return feed, nil
+}
+
+func (r *memoryCalendarFeedRepo) UpdateCalendarFeedToken(ctx context.Context, feed *entity.CalendarFeed) (*entity.CalendarFeed, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stored := range r.feeds {
+		if stored.ID == feed.ID {
+			stored.Token = feed.Token
+		}
+	}
+	return feed, nil
+}
+
+// icsLines unfolds an iCalendar object into its content lines.
+func icsLines(body []byte) []string {
+	return strings.Split(strings.TrimSuffix(strings.ReplaceAll(string(body), "\r\n ", ""), "\r\n"), "\r\n")
+}
+
+func TestICal_Encode(t *testing.T) {
+	start := time.Date(2026, time.January, 2, 18, 30, 0, 0, toronto)
+	updated := time.Date(2025, time.December, 1, 12, 0, 0, 0, time.UTC)
+	calendar := &ical.Calendar{
+		Name:     "Masjid Al-Noor events",
+		TimeZone: toronto,
+		Events: []ical.Event{
+			{
+				UID:         "series",
+				Start:       start,
+				End:         start.Add(90 * time.Minute).UTC(),
+				Summary:     "Tafsir; Surah Al-Kahf, part 1",
+				Description: "Bring a mushaf.\nAll welcome.",
+				Location:    "Masjid Al-Noor, 1 Main St",
+				Livestream:  "https://youtube.com/live/abc",
+				Categories:  []string{"EDUCATIONAL", "YOUTH"},
+				Recurrence:  []string{"RRULE:FREQ=WEEKLY;BYDAY=FR", "EXDATE:20260109T233000Z"},
+				Updated:     updated,
+			},
+			{
+				UID:          "series",
+				Start:        start.AddDate(0, 0, 14).Add(time.Hour),
+				End:          start.AddDate(0, 0, 14).Add(150 * time.Minute),
+				Summary:      "Tafsir (moved)",
+				RecurrenceID: start.AddDate(0, 0, 14),
+				Updated:      updated,
+			},
+			{
+				UID:     "one-off",
+				Start:   time.Date(2026, time.July, 4, 16, 0, 0, 0, time.UTC),
+				End:     time.Date(2026, time.July, 4, 20, 0, 0, 0, time.UTC),
+				Summary: "Community picnic",
+				Status:  "TENTATIVE",
+				Updated: updated,
+			},
+		},
+	}
+	body := calendar.Encode()
+	assert.Equal(t, body, calendar.Encode(), "an unchanged calendar encodes the same")
+	for _, line := range strings.Split(string(body), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75, line)
+	}
+
+	lines := icsLines(body)
+	assert.Equal(t, "BEGIN:VCALENDAR", lines[0])
+	assert.Equal(t, "END:VCALENDAR", lines[len(lines)-1])
+	for _, want := range []string{
+		"X-WR-CALNAME:Masjid Al-Noor events",
+		"X-WR-TIMEZONE:America/Toronto",
+		"TZID:America/Toronto",
+		"DTSTART;TZID=America/Toronto:20260102T183000",
+		"DTEND;TZID=America/Toronto:20260102T200000",
+		"RRULE:FREQ=WEEKLY;BYDAY=FR",
+		"EXDATE:20260109T233000Z",
+		`SUMMARY:Tafsir\; Surah Al-Kahf\, part 1`,
+		`DESCRIPTION:Bring a mushaf.\nAll welcome.\n\nLivestream: https://youtube.com/live/abc`,
+		`LOCATION:Masjid Al-Noor\, 1 Main St`,
+		"URL;VALUE=URI:https://youtube.com/live/abc",
+		"CONFERENCE;VALUE=URI;FEATURE=VIDEO;LABEL=Livestream:https://youtube.com/live/abc",
+		"CATEGORIES:EDUCATIONAL,YOUTH",
+		"DTSTAMP:20251201T120000Z",
+		"RECURRENCE-ID;TZID=America/Toronto:20260116T183000",
+		"DTSTART;TZID=America/Toronto:20260116T193000",
+		"DTSTART:20260704T160000Z",
+		"STATUS:TENTATIVE",
+	} {
+		assert.Contains(t, lines, want)
+	}
+
+	// Daylight saving starts at 2:00 on 8 March 2026 and ends at 2:00 on 1
+	// November.
+	assert.Contains(t, string(body), "BEGIN:DAYLIGHT\r\nDTSTART:20260308T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\nTZNAME:EDT\r\nEND:DAYLIGHT")
+	assert.Contains(t, string(body), "BEGIN:STANDARD\r\nDTSTART:20261101T020000\r\nTZOFFSETFROM:-0400\r\nTZOFFSETTO:-0500\r\nTZNAME:EST\r\nEND:STANDARD")
+}
+
+type calendarFeedFixture struct {
+	*rsvpFixture
+	feeds  *services.CalendarFeedService
+	server *httptest.Server
+}
+
+func newCalendarFeedFixture(t *testing.T) *calendarFeedFixture {
+	f := &calendarFeedFixture{rsvpFixture: newRsvpFixture()}
+	f.svc.MasjidRepo = &eventMasjidRepo{masjid: &entity.Masjid{ID: uuid.New(), Name: "Masjid Al-Noor", TimeZone: "America/Toronto"}}
+	f.feeds = services.NewCalendarFeedService(&memoryCalendarFeedRepo{}, f.svc, "https://api.example.com/")
+	mux := http.NewServeMux()
+	mux.Handle("GET /calendar/", handler.NewCalendarFeedHTTPHandler(f.feeds))
+	f.server = httptest.NewServer(mux)
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *calendarFeedFixture) get(t *testing.T, url, etag string) (*http.Response, string) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, strings.ReplaceAll(string(body), "\r\n ", "")
+}
+
+func (f *calendarFeedFixture) feedURL(feed *entity.CalendarFeed) string {
+	return f.server.URL + strings.TrimPrefix(f.feeds.URL(feed), "https://api.example.com")
+}
+
+func TestCalendarFeed_Tokens(t *testing.T) {
+	ctx := context.Background()
+	f := newCalendarFeedFixture(t)
+
+	feed, err := f.feeds.GetFeed(ctx, "m1", "")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(f.feeds.URL(feed), "https://api.example.com/calendar/"))
+	assert.True(t, strings.HasSuffix(f.feeds.URL(feed), ".ics"))
+	again, err := f.feeds.GetFeed(ctx, "m1", "")
+	require.NoError(t, err)
+	assert.Equal(t, feed.Token, again.Token)
+
+	userID := uuid.New().String()
+	mine, err := f.feeds.GetFeed(ctx, "", userID)
+	require.NoError(t, err)
+	assert.NotEqual(t, feed.Token, mine.Token)
+	assert.Equal(t, userID, mine.UserId.String())
+
+	reset, err := f.feeds.ResetFeed(ctx, "m1", "")
+	require.NoError(t, err)
+	assert.NotEqual(t, feed.Token, reset.Token)
+
+	resp, _ := f.get(t, f.feedURL(feed), "")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp, _ = f.get(t, f.feedURL(reset), "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, _ = f.get(t, f.server.URL+"/calendar/"+reset.Token, "")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestCalendarFeed_Masjid(t *testing.T) {
+	ctx := context.Background()
+	f := newCalendarFeedFixture(t)
+	series := f.series(t, "RRULE:FREQ=WEEKLY")
+	series.MasjidId = "m1"
+	series.LivestreamLink = "https://youtube.com/live/abc"
+	series.SetTypes("EDUCATIONAL")
+	f.repo.events[series.ID] = *series
+	feed, err := f.feeds.GetFeed(ctx, "m1", "")
+	require.NoError(t, err)
+
+	resp, body := f.get(t, f.feedURL(feed), "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/calendar; charset=utf-8", resp.Header.Get("Content-Type"))
+	etag := resp.Header.Get("ETag")
+	require.NotEmpty(t, etag)
+	assert.Contains(t, body, "X-WR-CALNAME:Masjid Al-Noor events")
+	assert.Contains(t, body, "UID:"+series.ID.String())
+	assert.Contains(t, body, "DTSTART;TZID=America/Toronto:20260102T140000")
+	assert.Contains(t, body, "RRULE:FREQ=WEEKLY")
+	assert.Contains(t, body, "URL;VALUE=URI:https://youtube.com/live/abc")
+	assert.Contains(t, body, "CATEGORIES:EDUCATIONAL")
+
+	// Clients polling with the tag they have are told nothing changed.
+	resp, body = f.get(t, f.feedURL(feed), etag)
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+	assert.Empty(t, body)
+
+	// Moving next week's occurrence adds an exception and changes the tag.
+	// The series keeps its local time of day, 14:00 in Toronto.
+	next := friday.In(toronto).AddDate(0, 0, 7*(int(time.Since(friday)/(7*24*time.Hour))+1))
+	_, err = f.svc.UpdateOccurrence(ctx, series.ID.String(), next, entity.ThisEvent, &entity.Event{StartTime: next.Add(time.Hour), UpdatedAt: time.Now()})
+	require.NoError(t, err)
+	resp, body = f.get(t, f.feedURL(feed), etag)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.NotEqual(t, etag, resp.Header.Get("ETag"))
+	assert.Contains(t, body, "RECURRENCE-ID;TZID=America/Toronto:"+next.In(toronto).Format("20060102T150405"))
+	assert.Equal(t, 2, strings.Count(body, "UID:"+series.ID.String()))
+}
+
+func TestCalendarFeed_User(t *testing.T) {
+	ctx := context.Background()
+	f := newCalendarFeedFixture(t)
+	full := f.event(t, 1, entity.NO_RESTRICTION)
+	open := f.event(t, 0, entity.NO_RESTRICTION)
+	other := f.event(t, 0, entity.NO_RESTRICTION)
+	_, err := f.svc.Rsvp(ctx, full.ID.String(), f.user(entity.Female, "Aisha"))
+	require.NoError(t, err)
+	userID := f.user(entity.Male, "Bilal")
+	_, err = f.svc.Rsvp(ctx, full.ID.String(), userID)
+	require.NoError(t, err)
+	_, err = f.svc.Rsvp(ctx, open.ID.String(), userID)
+	require.NoError(t, err)
+
+	feed, err := f.feeds.GetFeed(ctx, "", userID)
+	require.NoError(t, err)
+	resp, body := f.get(t, f.feedURL(feed), "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, body, "X-WR-CALNAME:My events")
+	assert.NotContains(t, body, other.ID.String())
+
+	events := strings.Split(body, "BEGIN:VEVENT")[1:]
+	require.Len(t, events, 2)
+	for _, event := range events {
+		switch {
+		case strings.Contains(event, full.ID.String()):
+			assert.Contains(t, event, "STATUS:TENTATIVE")
+		case strings.Contains(event, open.ID.String()):
+			assert.Contains(t, event, "STATUS:CONFIRMED")
+		default:
+			t.Errorf("unexpected event in feed: %s", event)
+		}
+	}
+}
+
+// getFeedsConcurrently asks for a calendar feed n times at once and
+// returns the URLs given.
+func (suite *DatabaseGrpcHandlerTestSuite) getFeedsConcurrently(ctx context.Context, masjidID string, n int) []string {
+	urls := make([]string, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := range urls {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := suite.EventHandler.GetCalendarFeed(ctx, &pb.GetCalendarFeedRequest{MasjidId: masjidID})
+			errs[i] = err
+			if err == nil {
+				urls[i] = resp.GetCalendarFeed().GetUrl()
+			}
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(suite.T(), err)
+	}
+	return urls
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) TestGetCalendarFeed_ConcurrentRequestsCreateOneFeed() {
+	user, ctx := suite.createMember("subscriber")
+	masjid := &entity.Masjid{ID: uuid.New(), Name: "Masjid Al-Noor"}
+	require.NoError(suite.T(), suite.DB.Create(masjid).Error)
+	suite.T().Cleanup(func() {
+		suite.DB.Delete(&entity.CalendarFeed{}, "user_id = ? OR masjid_id = ?", user.ID, masjid.ID.String())
+		suite.DB.Delete(&entity.Masjid{}, "id = ?", masjid.ID)
+	})
+
+	urls := suite.getFeedsConcurrently(ctx, "", 8)
+	for _, url := range urls {
+		assert.Equal(suite.T(), urls[0], url, "every request gets the feed created first")
+	}
+	var feeds []entity.CalendarFeed
+	require.NoError(suite.T(), suite.DB.Where("user_id = ?", user.ID).Find(&feeds).Error)
+	require.Len(suite.T(), feeds, 1)
+	assert.Equal(suite.T(), suite.FeedService.URL(&feeds[0]), urls[0])
+
+	masjidURLs := suite.getFeedsConcurrently(ctx, masjid.ID.String(), 8)
+	for _, url := range masjidURLs {
+		assert.Equal(suite.T(), masjidURLs[0], url)
+	}
+	assert.NotEqual(suite.T(), urls[0], masjidURLs[0])
+	var count int64
+	require.NoError(suite.T(), suite.DB.Model(&entity.CalendarFeed{}).Where("masjid_id = ?", masjid.ID.String()).Count(&count).Error)
+	assert.Equal(suite.T(), int64(1), count)
+
+	// Resetting the feed replaces its token.
+	resp, err := suite.EventHandler.ResetCalendarFeed(ctx, &pb.ResetCalendarFeedRequest{})
+	require.NoError(suite.T(), err)
+	assert.NotEqual(suite.T(), urls[0], resp.GetCalendarFeed().GetUrl())
+	_, err = suite.FeedService.Render(context.Background(), feeds[0].Token)
+	assert.ErrorIs(suite.T(), err, gorm.ErrRecordNotFound)
+	var reset entity.CalendarFeed
+	require.NoError(suite.T(), suite.DB.Take(&reset, "user_id = ?", user.ID).Error)
+	assert.Equal(suite.T(), feeds[0].ID, reset.ID)
+	assert.Equal(suite.T(), suite.FeedService.URL(&reset), resp.GetCalendarFeed().GetUrl())
+}
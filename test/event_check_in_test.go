@@ -0,0 +1,228 @@
+package test
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/ticket"
+	"github.com/mnadev/limestone/internal/application/helper"
+)
+
+func (r *memoryEventRepo) GetRsvp(ctx context.Context, id string) (*entity.EventRsvp, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rsvp := range r.rsvps {
+		if rsvp.ID.String() == id {
+			found := *rsvp
+			found.User = r.users[rsvp.UserId]
+			return &found, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memoryEventRepo) CreateCheckIn(ctx context.Context, checkIn *entity.EventCheckIn) (*entity.EventCheckIn, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.checkIns {
+		if existing.RsvpId == checkIn.RsvpId && existing.Occurrence == checkIn.Occurrence {
+			return &existing, false, nil
+		}
+	}
+	r.checkIns = append(r.checkIns, *checkIn)
+	return checkIn, true, nil
+}
+
+func (r *memoryEventRepo) CountAttendance(ctx context.Context, eventID, occurrence string) (*entity.Attendance, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id, err := uuid.Parse(eventID)
+	if err != nil {
+		return nil, err
+	}
+	attendance := &entity.Attendance{Confirmed: r.confirmed(id)}
+	for _, checkIn := range r.checkIns {
+		if checkIn.EventId == id && checkIn.Occurrence == occurrence {
+			attendance.CheckedIn++
+		}
+	}
+	return attendance, nil
+}
+
+func mustSigner(t *testing.T, seed byte) *ticket.Signer {
+	t.Helper()
+	key := make([]byte, 32)
+	key[0] = seed
+	signer, err := ticket.ParseSigningKey(base64.StdEncoding.EncodeToString(key))
+	require.NoError(t, err)
+	return signer
+}
+
+// ticketFor returns the ticket GetMyRsvps gives a user for an event.
+func (f *rsvpFixture) ticketFor(t *testing.T, userID string, eventID uuid.UUID) string {
+	t.Helper()
+	rsvps, err := f.svc.GetMyRsvps(context.Background(), userID, true)
+	require.NoError(t, err)
+	for _, rsvp := range rsvps {
+		if rsvp.EventId == eventID {
+			return rsvp.Ticket
+		}
+	}
+	t.Fatalf("user %s has no rsvp for %s", userID, eventID)
+	return ""
+}
+
+func TestTicket_SignAndVerify(t *testing.T) {
+	signer := mustSigner(t, 1)
+	claims := ticket.Claims{RsvpID: uuid.New(), EventID: uuid.New(), UserID: uuid.New()}
+	signed := signer.Sign(claims)
+	assert.True(t, strings.HasPrefix(signed, "LT1."))
+	assert.Equal(t, signed, signer.Sign(claims))
+
+	verified, err := ticket.Verify(signer.PublicKey(), signed)
+	require.NoError(t, err)
+	assert.Equal(t, claims, *verified)
+
+	// Another user's claims cannot be swapped in under the signature.
+	parts := strings.Split(signed, ".")
+	other := strings.Split(signer.Sign(ticket.Claims{RsvpID: uuid.New(), EventID: claims.EventID, UserID: uuid.New()}), ".")
+	for _, forged := range []string{
+		parts[0] + "." + other[1] + "." + parts[2],
+		strings.TrimSuffix(signed, parts[2][len(parts[2])-2:]),
+		mustSigner(t, 2).Sign(claims),
+		"LT1.",
+		"",
+		"not a ticket",
+	} {
+		_, err := ticket.Verify(signer.PublicKey(), forged)
+		assert.ErrorIs(t, err, ticket.ErrInvalid, forged)
+	}
+
+	for _, key := range []string{"", "c2hvcnQ=", "not base64!"} {
+		_, err := ticket.ParseSigningKey(key)
+		assert.ErrorIs(t, err, ticket.ErrInvalidKey, key)
+	}
+}
+
+func TestCheckIn_RejectsDuplicateScans(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	f.svc.Tickets = mustSigner(t, 1)
+	event := f.event(t, 1, entity.NO_RESTRICTION)
+	volunteer := uuid.New().String()
+
+	amina := f.user(entity.Female, "Amina")
+	rsvp, err := f.svc.Rsvp(ctx, event.ID.String(), amina)
+	require.NoError(t, err)
+	require.NotEmpty(t, rsvp.Ticket)
+	assert.Equal(t, rsvp.Ticket, f.ticketFor(t, amina, event.ID))
+	waitlisted, err := f.svc.Rsvp(ctx, event.ID.String(), f.user(entity.Male, "Bilal"))
+	require.NoError(t, err)
+	assert.Empty(t, waitlisted.Ticket)
+
+	checkIn, checked, err := f.svc.CheckIn(ctx, event.ID.String(), rsvp.Ticket, volunteer)
+	require.NoError(t, err)
+	assert.Equal(t, rsvp.ID, checkIn.RsvpId)
+	assert.Equal(t, volunteer, checkIn.CheckedInBy.String())
+	assert.Equal(t, "Amina", checkIn.User.FirstName)
+	assert.Equal(t, &entity.Attendance{Confirmed: 1, CheckedIn: 1}, checked.Attendance)
+
+	again, _, err := f.svc.CheckIn(ctx, event.ID.String(), rsvp.Ticket, uuid.New().String())
+	assert.ErrorIs(t, err, helper.ErrAlreadyCheckedIn)
+	assert.Equal(t, checkIn.ID, again.ID)
+	assert.Equal(t, volunteer, again.CheckedInBy.String())
+
+	got, err := f.svc.GetById(ctx, event.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, &entity.Attendance{Confirmed: 1, CheckedIn: 1}, got.Attendance)
+}
+
+func TestCheckIn_RejectsInvalidTickets(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	volunteer := uuid.New().String()
+	event := f.event(t, 1, entity.NO_RESTRICTION)
+	other := f.event(t, 0, entity.NO_RESTRICTION)
+	amina, bilal := f.user(entity.Female, "Amina"), f.user(entity.Male, "Bilal")
+
+	// Without a signing key no tickets are issued or accepted.
+	rsvp, err := f.svc.Rsvp(ctx, event.ID.String(), amina)
+	require.NoError(t, err)
+	assert.Empty(t, rsvp.Ticket)
+	_, _, err = f.svc.CheckIn(ctx, event.ID.String(), "LT1.x.y", volunteer)
+	assert.ErrorIs(t, err, helper.ErrTicketsNotConfigured)
+
+	f.svc.Tickets = mustSigner(t, 1)
+	cancelled := f.ticketFor(t, amina, event.ID)
+	_, err = f.svc.Rsvp(ctx, event.ID.String(), bilal)
+	require.NoError(t, err)
+	_, err = f.svc.CancelRsvp(ctx, event.ID.String(), amina)
+	require.NoError(t, err)
+	assert.Empty(t, f.ticketFor(t, amina, event.ID))
+	_, _, err = f.svc.CheckIn(ctx, event.ID.String(), cancelled, volunteer)
+	assert.ErrorIs(t, err, helper.ErrTicketNotConfirmed)
+
+	// Bilal was confirmed into the freed place, but his ticket is not for
+	// the other event.
+	promoted := f.ticketFor(t, bilal, event.ID)
+	require.NotEmpty(t, promoted)
+	_, _, err = f.svc.CheckIn(ctx, other.ID.String(), promoted, volunteer)
+	assert.ErrorIs(t, err, helper.ErrTicketForAnotherEvent)
+
+	forged := mustSigner(t, 2).Sign(ticket.Claims{RsvpID: uuid.New(), EventID: event.ID, UserID: uuid.New()})
+	_, _, err = f.svc.CheckIn(ctx, event.ID.String(), forged, volunteer)
+	assert.ErrorIs(t, err, ticket.ErrInvalid)
+
+	_, _, err = f.svc.CheckIn(ctx, uuid.New().String(), promoted, volunteer)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	_, _, err = f.svc.CheckIn(ctx, event.ID.String(), promoted, volunteer)
+	assert.NoError(t, err)
+}
+
+func TestCheckIn_RecurringEventsByOccurrence(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	f.svc.Tickets = mustSigner(t, 1)
+	volunteer := uuid.New().String()
+	// A series that started two weeks ago is still open to RSVPs.
+	start := time.Now().UTC().Truncate(time.Hour).AddDate(0, 0, -14)
+	week := func(n int) time.Time { return start.AddDate(0, 0, 7*n) }
+	series := f.pastSeries(t, start, "RRULE:FREQ=WEEKLY;COUNT=52")
+	amina := f.user(entity.Female, "Amina")
+	rsvp, err := f.svc.Rsvp(ctx, series.ID.String(), amina)
+	require.NoError(t, err)
+	assert.Equal(t, entity.RsvpConfirmed, rsvp.Status)
+	signed := f.ticketFor(t, amina, series.ID)
+
+	_, _, err = f.svc.CheckIn(ctx, series.ID.String(), signed, volunteer)
+	assert.ErrorIs(t, err, helper.ErrCheckInToSeries)
+
+	// One ticket is good for each occurrence once.
+	for _, n := range []int{1, 2} {
+		checkIn, occurrence, err := f.svc.CheckIn(ctx, entity.OccurrenceID(series.ID, week(n)), signed, volunteer)
+		require.NoError(t, err)
+		assert.Equal(t, series.ID, checkIn.EventId)
+		assert.Equal(t, week(n), occurrence.StartTime.UTC())
+		assert.Equal(t, int64(1), occurrence.Attendance.CheckedIn)
+	}
+	_, _, err = f.svc.CheckIn(ctx, entity.OccurrenceID(series.ID, week(1)), signed, volunteer)
+	assert.ErrorIs(t, err, helper.ErrAlreadyCheckedIn)
+
+	// An occurrence edited on its own is checked in to as the occurrence.
+	moved, err := f.svc.UpdateOccurrence(ctx, series.ID.String(), week(3), entity.ThisEvent, &entity.Event{StartTime: week(3).Add(time.Hour)})
+	require.NoError(t, err)
+	_, exception, err := f.svc.CheckIn(ctx, moved.ID.String(), signed, volunteer)
+	require.NoError(t, err)
+	assert.Equal(t, &entity.Attendance{Confirmed: 1, CheckedIn: 1}, exception.Attendance)
+	_, _, err = f.svc.CheckIn(ctx, entity.OccurrenceID(series.ID, week(3)), signed, volunteer)
+	assert.ErrorIs(t, err, helper.ErrAlreadyCheckedIn)
+}
@@ -0,0 +1,178 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/repository"
+	"github.com/mnadev/limestone/internal/application/services"
+)
+
+// eventMasjidRepo serves a single masjid. Methods the event service does
+// not use panic through the nil embedded interface.
+type eventMasjidRepo struct {
+	repository.MasjidRepository
+	masjid *entity.Masjid
+}
+
+func (r *eventMasjidRepo) GetByID(ctx context.Context, id string) (*entity.Masjid, error) {
+	return r.masjid, nil
+}
+
+func TestParseEventFilter(t *testing.T) {
+	params := &entity.ListEventsQueryParams{}
+	err := services.ParseEventFilter(`masjid_id = "m1" AND start_time >= "2026-01-05T00:00:00Z" AND start_time < "2026-01-12T00:00:00Z"
+		AND types:(YOUTH OR CHILDREN_SPECIFIC) AND type = EDUCATIONAL AND gender_restriction = FEMALE_ONLY
+		AND is_paid = false AND requires_rsvp != false AND has_livestream = true`, params)
+	require.NoError(t, err)
+
+	assert.Equal(t, "m1", params.MasjidId)
+	assert.Equal(t, time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC), params.StartFrom)
+	assert.Equal(t, time.Date(2026, time.January, 12, 0, 0, 0, 0, time.UTC), params.StartBefore)
+	assert.Equal(t, [][]string{{"YOUTH", "CHILDREN_SPECIFIC"}, {"EDUCATIONAL"}}, params.TypeGroups)
+	require.NotNil(t, params.GenderRestriction)
+	assert.Equal(t, entity.FEMALE_ONLY, *params.GenderRestriction)
+	assert.Equal(t, false, *params.IsPaid)
+	assert.Equal(t, true, *params.RequiresRsvp)
+	assert.Equal(t, true, *params.HasLivestream)
+
+	// An exact start time is a window a nanosecond long.
+	params = &entity.ListEventsQueryParams{}
+	require.NoError(t, services.ParseEventFilter(`start_time = "2026-01-05T18:00:00-05:00"`, params))
+	assert.Equal(t, time.Nanosecond, params.StartBefore.Sub(params.StartFrom))
+
+	require.NoError(t, services.ParseEventFilter("", &entity.ListEventsQueryParams{}))
+}
+
+func TestParseEventFilter_Invalid(t *testing.T) {
+	for _, filter := range []string{
+		`colour = "green"`,
+		`masjid_id > "m1"`,
+		`start_time >= 2026-01-05`,
+		`start_time >= "next week"`,
+		`types:MARKET`,
+		`types:(YOUTH AND EDUCATIONAL)`,
+		`types:(YOUTH OR`,
+		`is_paid = maybe`,
+		`gender_restriction = EVERYONE`,
+		`is_paid = true OR requires_rsvp = true`,
+		`masjid_id = "m1`,
+		`is_paid ! true`,
+		`is_paid`,
+		`is_paid = true AND`,
+		`is_paid = true AND is_paid = false`,
+		`is_paid = true AND is_paid = true`,
+		`masjid_id = "m1" AND masjid_id = "m1"`,
+		`gender_restriction = MALE_ONLY AND gender_restriction = FEMALE_ONLY`,
+		`start_time >= "2026-01-12T00:00:00Z" AND start_time < "2026-01-05T00:00:00Z"`,
+		`start_time > "2026-01-05T00:00:00Z" AND start_time < "2026-01-05T00:00:00Z"`,
+		`start_time >= "2026-01-05T00:00:00Z" AND start_time > "2026-01-06T00:00:00Z"`,
+		`start_time = "2026-01-05T00:00:00Z" AND start_time < "2026-01-06T00:00:00Z"`,
+	} {
+		err := services.ParseEventFilter(filter, &entity.ListEventsQueryParams{})
+		assert.ErrorIs(t, err, helper.ErrInvalidFilter, filter)
+	}
+
+	// A request's masjid cannot be widened by its filter.
+	err := services.ParseEventFilter(`masjid_id = "m2"`, &entity.ListEventsQueryParams{MasjidId: "m1"})
+	assert.ErrorIs(t, err, helper.ErrInvalidFilter)
+
+	// Nor can its window be narrowed to nothing.
+	err = services.ParseEventFilter(`start_time < "2026-01-05T00:00:00Z"`,
+		&entity.ListEventsQueryParams{StartFrom: time.Date(2026, time.January, 6, 0, 0, 0, 0, time.UTC)})
+	assert.ErrorIs(t, err, helper.ErrInvalidFilter)
+}
+
+func TestParseEventOrder(t *testing.T) {
+	order, err := services.ParseEventOrder("start_time desc, name,create_time ASC")
+	require.NoError(t, err)
+	assert.Equal(t, []entity.EventOrder{
+		{Field: entity.OrderByStartTime, Desc: true},
+		{Field: entity.OrderByName},
+		{Field: entity.OrderByCreateTime},
+	}, order)
+
+	order, err = services.ParseEventOrder(" ")
+	require.NoError(t, err)
+	assert.Empty(t, order)
+
+	for _, orderBy := range []string{"id", "name sideways", "name,", "start_time desc asc"} {
+		_, err := services.ParseEventOrder(orderBy)
+		assert.ErrorIs(t, err, helper.ErrInvalidOrderBy, orderBy)
+	}
+}
+
+func TestListEvents_FiltersAndOrdersOccurrences(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	halaqa := f.series(t, "RRULE:FREQ=WEEKLY;COUNT=4")
+	halaqa.SetTypes("EDUCATIONAL", "YOUTH")
+	f.repo.events[halaqa.ID] = *halaqa
+	bazaar := f.event(t, 0, entity.NO_RESTRICTION)
+	bazaar.Name = "Bazaar"
+	bazaar.StartTime = week(1).Add(-time.Hour)
+	bazaar.IsPaid = true
+	bazaar.SetTypes("FUNDRAISING", "COMMUNITY")
+	f.repo.events[bazaar.ID] = *bazaar
+
+	// Moving one occurrence into a paid class keeps it out of free listings
+	// without bringing back the occurrence it replaced.
+	_, err := f.svc.UpdateOccurrence(ctx, halaqa.ID.String(), week(2), entity.ThisEvent, &entity.Event{IsPaid: true})
+	require.NoError(t, err)
+
+	params := &entity.ListEventsQueryParams{StartFrom: week(0), StartBefore: week(4)}
+	require.NoError(t, services.ParseEventFilter("types:(EDUCATIONAL OR FUNDRAISING) AND is_paid = false", params))
+	events, _, total, err := f.svc.ListEvents(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{week(0), week(1), week(3)}, starts(events))
+	assert.Equal(t, 3, total)
+	assert.Equal(t, []string{"EDUCATIONAL", "YOUTH"}, events[0].TypeNames())
+
+	params = &entity.ListEventsQueryParams{StartFrom: week(0), StartBefore: week(4), PageSize: 2}
+	params.OrderBy, err = services.ParseEventOrder("name, start_time desc")
+	require.NoError(t, err)
+	events, next, total, err := f.svc.ListEvents(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{bazaar.StartTime, week(3)}, starts(events))
+	assert.Equal(t, "2", next)
+	assert.Equal(t, 5, total)
+}
+
+func TestListWeekEvents(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	f.svc.MasjidRepo = &eventMasjidRepo{masjid: &entity.Masjid{ID: uuid.New(), TimeZone: "America/Toronto"}}
+	masjidID := "m1"
+	halaqa := f.series(t, "RRULE:FREQ=DAILY;COUNT=30")
+	halaqa.MasjidId = masjidID
+	f.repo.events[halaqa.ID] = *halaqa
+	elsewhere := f.series(t, "RRULE:FREQ=DAILY;COUNT=30")
+	elsewhere.MasjidId = "m2"
+	f.repo.events[elsewhere.ID] = *elsewhere
+
+	// Friday 2 January 2026 falls in the week from Monday 29 December. The
+	// halaqa starts at 14:00 in Toronto.
+	events, err := f.svc.ListWeekEvents(ctx, masjidID, time.Date(2026, time.January, 2, 23, 0, 0, 0, time.UTC), &entity.ListEventsQueryParams{})
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{week(0), week(0).AddDate(0, 0, 1), week(0).AddDate(0, 0, 2)}, starts(events))
+
+	events, err = f.svc.ListWeekEvents(ctx, masjidID, week(1), &entity.ListEventsQueryParams{})
+	require.NoError(t, err)
+	assert.Len(t, events, 7)
+	monday := time.Date(2026, time.January, 5, 0, 0, 0, 0, mustLoadLocation("America/Toronto"))
+	assert.Equal(t, week(0).AddDate(0, 0, 3), events[0].StartTime.UTC())
+	assert.False(t, events[0].StartTime.Before(monday))
+
+	params := &entity.ListEventsQueryParams{}
+	require.NoError(t, services.ParseEventFilter("types:WORSHIP", params))
+	events, err = f.svc.ListWeekEvents(ctx, masjidID, week(1), params)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
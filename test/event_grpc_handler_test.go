@@ -2,18 +2,21 @@ package test
 
 import (
 	"context"
+	"fmt"
 	"github.com/google/uuid"
 	pb "github.com/mnadev/limestone/gen/go"
 	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/infrastructure/auth"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"sync"
 	"time"
 )
 
-func (suite *GrpcHandlerTestSuite) TestCreateEvent_Success() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestCreateEvent_Success() {
 	ctx := context.Background()
 	masjidID := uuid.New().String()
 	startTime := time.Now().Add(time.Hour)
@@ -61,7 +64,7 @@ func (suite *GrpcHandlerTestSuite) TestCreateEvent_Success() {
 	require.NoError(suite.T(), err)
 }
 
-func (suite *GrpcHandlerTestSuite) TestGetEvent_Success() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestGetEvent_Success() {
 	ctx := context.Background()
 	masjidID := uuid.New().String()
 	startTime := time.Now().Add(time.Hour)
@@ -101,7 +104,7 @@ func (suite *GrpcHandlerTestSuite) TestGetEvent_Success() {
 	require.NoError(suite.T(), err)
 }
 
-func (suite *GrpcHandlerTestSuite) TestGetEvent_NotFound() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestGetEvent_NotFound() {
 	ctx := context.Background()
 	nonExistingID := uuid.New().String()
 	req := &pb.GetEventRequest{
@@ -117,7 +120,7 @@ func (suite *GrpcHandlerTestSuite) TestGetEvent_NotFound() {
 	assert.Nil(suite.T(), resp)
 }
 
-func (suite *GrpcHandlerTestSuite) TestDeleteEvent_Success() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestDeleteEvent_Success() {
 	ctx := context.Background()
 	masjidID := uuid.New().String()
 	startTime := time.Now().Add(time.Hour)
@@ -154,7 +157,7 @@ func (suite *GrpcHandlerTestSuite) TestDeleteEvent_Success() {
 	assert.Equal(suite.T(), err.Error(), "record not found")
 }
 
-func (suite *GrpcHandlerTestSuite) TestUpdateEvent_Success() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestUpdateEvent_Success() {
 	ctx := context.Background()
 	masjidID := uuid.New().String()
 	startTime := time.Now().Add(time.Hour)
@@ -229,7 +232,7 @@ func (suite *GrpcHandlerTestSuite) TestUpdateEvent_Success() {
 	require.NoError(suite.T(), err)
 }
 
-func (suite *GrpcHandlerTestSuite) TestUpdateEvent_NoEventID() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestUpdateEvent_NoEventID() {
 	ctx := context.Background()
 	startTime := time.Now().Add(time.Hour)
 	endTime := startTime.Add(2 * time.Hour)
@@ -250,3 +253,139 @@ func (suite *GrpcHandlerTestSuite) TestUpdateEvent_NoEventID() {
 	assert.Equal(suite.T(), "event ID is required for update", st.Message())
 	assert.Nil(suite.T(), resp)
 }
+
+// createMember stores a member and returns them with a context carrying
+// their ID and role, as the auth interceptor sets it.
+func (suite *DatabaseGrpcHandlerTestSuite) createMember(name string) (*entity.User, context.Context) {
+	return suite.createUser(name, entity.MASJID_MEMBER)
+}
+
+// createUser stores a user with role and returns them with a context
+// carrying their ID and role.
+func (suite *DatabaseGrpcHandlerTestSuite) createUser(name string, role entity.Role) (*entity.User, context.Context) {
+	id := uuid.New()
+	user := &entity.User{
+		ID:             id,
+		Email:          fmt.Sprintf("%s-%s@example.com", name, id),
+		Username:       fmt.Sprintf("%s-%s", name, id),
+		HashedPassword: "not a real hash",
+		FirstName:      name,
+		LastName:       "Member",
+		PhoneNumber:    "1234567890",
+		Gender:         entity.Male,
+		Role:           role,
+	}
+	require.NoError(suite.T(), suite.DB.Create(user).Error)
+	ctx := context.WithValue(context.Background(), auth.UserIDContextKey, id.String())
+	ctx = context.WithValue(ctx, auth.UserRoleContextKey, string(role))
+	return user, ctx
+}
+
+// createRsvpEvent stores an event requiring RSVP with places for
+// maxParticipants, deleted with its RSVPs when the test ends.
+func (suite *DatabaseGrpcHandlerTestSuite) createRsvpEvent(maxParticipants int32) *entity.Event {
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	event, err := suite.EventService.Create(context.Background(), &entity.Event{
+		MasjidId:        uuid.New().String(),
+		Name:            "Seerah night",
+		StartTime:       start,
+		EndTime:         start.Add(2 * time.Hour),
+		RequiresRsvp:    true,
+		MaxParticipants: maxParticipants,
+	})
+	require.NoError(suite.T(), err)
+	suite.T().Cleanup(func() {
+		suite.DB.Delete(&entity.EventRsvp{}, "event_id = ?", event.ID)
+		suite.DB.Delete(&entity.Event{}, "id = ?", event.ID)
+	})
+	return event
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) TestRsvpEvent_ConcurrentRsvpsToFullEvent() {
+	event := suite.createRsvpEvent(3)
+	const members = 10
+	ctxs := make([]context.Context, members)
+	for i := range ctxs {
+		_, ctxs[i] = suite.createMember(fmt.Sprintf("attendee%d", i))
+	}
+
+	responses := make([]*pb.Rsvp, members)
+	errs := make([]error, members)
+	var wg sync.WaitGroup
+	for i := range ctxs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := suite.EventHandler.RsvpEvent(ctxs[i], &pb.RsvpEventRequest{EventId: event.ID.String()})
+			errs[i] = err
+			if err == nil {
+				responses[i] = resp.GetRsvp()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	confirmed := 0
+	var positions []int32
+	for i, rsvp := range responses {
+		require.NoError(suite.T(), errs[i])
+		switch rsvp.GetStatus() {
+		case pb.Rsvp_CONFIRMED:
+			confirmed++
+		case pb.Rsvp_WAITLISTED:
+			positions = append(positions, rsvp.GetWaitlistPosition())
+		}
+	}
+	assert.Equal(suite.T(), 3, confirmed, "no more RSVPs are confirmed than the event has places")
+	assert.ElementsMatch(suite.T(), []int32{1, 2, 3, 4, 5, 6, 7}, positions)
+
+	var stored int64
+	err := suite.DB.Model(&entity.EventRsvp{}).Where("event_id = ? AND status = ?", event.ID, entity.RsvpConfirmed).Count(&stored).Error
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), stored)
+
+	// Cancelling a confirmed RSVP confirms the head of the waitlist.
+	var head string
+	for _, rsvp := range responses {
+		if rsvp.GetWaitlistPosition() == 1 {
+			head = rsvp.GetId()
+		}
+	}
+	for i, rsvp := range responses {
+		if rsvp.GetStatus() == pb.Rsvp_CONFIRMED {
+			_, err := suite.EventHandler.CancelRsvp(ctxs[i], &pb.CancelRsvpRequest{EventId: event.ID.String()})
+			require.NoError(suite.T(), err)
+			break
+		}
+	}
+	var promoted entity.EventRsvp
+	require.NoError(suite.T(), suite.DB.First(&promoted, "id = ?", head).Error)
+	assert.Equal(suite.T(), entity.RsvpConfirmed, promoted.Status)
+	err = suite.DB.Model(&entity.EventRsvp{}).Where("event_id = ? AND status = ?", event.ID, entity.RsvpConfirmed).Count(&stored).Error
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), stored)
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) TestRsvpEvent_ConcurrentRsvpsBySameMember() {
+	event := suite.createRsvpEvent(3)
+	user, ctx := suite.createMember("attendee")
+
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = suite.EventHandler.RsvpEvent(ctx, &pb.RsvpEventRequest{EventId: event.ID.String()})
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(suite.T(), err)
+	}
+
+	var rsvps []entity.EventRsvp
+	require.NoError(suite.T(), suite.DB.Where("event_id = ? AND user_id = ?", event.ID, user.ID).Find(&rsvps).Error)
+	require.Len(suite.T(), rsvps, 1, "a member has one RSVP however often they register")
+	assert.Equal(suite.T(), entity.RsvpConfirmed, rsvps[0].Status)
+}
@@ -0,0 +1,762 @@
+package test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/handler"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/services"
+	"github.com/mnadev/limestone/internal/infrastructure/payment"
+)
+
+// memoryOrderRepo keeps orders in memory alongside the events and RSVPs of
+// a memoryEventRepo, under its mutex.
+type memoryOrderRepo struct {
+	events *memoryEventRepo
+	orders []*entity.Order
+}
+
+func (r *memoryOrderRepo) ReplaceTicketTypes(ctx context.Context, eventID string, types []entity.TicketType) ([]entity.TicketType, error) {
+	r.events.mu.Lock()
+	defer r.events.mu.Unlock()
+	event, ok := r.events.events[uuid.MustParse(eventID)]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	event.TicketTypes = append([]entity.TicketType(nil), types...)
+	event.IsPaid = false
+	for _, t := range types {
+		event.IsPaid = event.IsPaid || t.Price > 0
+	}
+	event.RequiresRsvp = event.RequiresRsvp || len(types) > 0
+	r.events.events[event.ID] = event
+	return types, nil
+}
+
+func (r *memoryOrderRepo) sold(eventID uuid.UUID, now time.Time, except uuid.UUID) map[uuid.UUID]int64 {
+	sold := map[uuid.UUID]int64{}
+	for _, order := range r.orders {
+		if order.EventId != eventID || order.ID == except || !order.HoldsTickets(now) {
+			continue
+		}
+		for _, item := range order.Items {
+			sold[item.TicketTypeId] += int64(item.Quantity)
+		}
+	}
+	return sold
+}
+
+func (r *memoryOrderRepo) CountTicketsSold(ctx context.Context, eventID string, now time.Time) (map[uuid.UUID]int64, error) {
+	r.events.mu.Lock()
+	defer r.events.mu.Unlock()
+	return r.sold(uuid.MustParse(eventID), now, uuid.Nil), nil
+}
+
+func (r *memoryOrderRepo) CreateOrder(ctx context.Context, order *entity.Order) (*entity.Order, error) {
+	r.events.mu.Lock()
+	defer r.events.mu.Unlock()
+	event, ok := r.events.events[order.EventId]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	if err := r.available(event, order, order.CreatedAt); err != nil {
+		return nil, err
+	}
+	stored := *order
+	r.orders = append(r.orders, &stored)
+	return order, nil
+}
+
+// available checks the tickets and place an order needs as
+// checkAvailable does.
+func (r *memoryOrderRepo) available(event entity.Event, order *entity.Order, now time.Time) error {
+	sold := r.sold(event.ID, now, order.ID)
+	for _, t := range event.TicketTypes {
+		t.Sold = sold[t.ID]
+		for _, item := range order.Items {
+			if item.TicketTypeId == t.ID && t.Remaining() >= 0 && int64(item.Quantity) > t.Remaining() {
+				return helper.ErrTicketsSoldOut
+			}
+		}
+	}
+	if rsvp := r.events.find(event.ID, order.UserId); event.MaxParticipants <= 0 || rsvp != nil && rsvp.Status == entity.RsvpConfirmed {
+		return nil
+	}
+	holding := map[uuid.UUID]bool{}
+	for _, other := range r.orders {
+		if other.EventId != event.ID || other.ID == order.ID || other.UserId == order.UserId ||
+			other.Status != entity.OrderPending || !now.Before(other.ExpiresAt) {
+			continue
+		}
+		if rsvp := r.events.find(event.ID, other.UserId); rsvp == nil || rsvp.Status != entity.RsvpConfirmed {
+			holding[other.UserId] = true
+		}
+	}
+	if event.PlacesLeft(r.events.confirmed(event.ID)+int64(len(holding))) == 0 {
+		return helper.ErrTicketsSoldOut
+	}
+	return nil
+}
+
+func (r *memoryOrderRepo) find(match func(*entity.Order) bool) (*entity.Order, error) {
+	r.events.mu.Lock()
+	defer r.events.mu.Unlock()
+	for _, order := range r.orders {
+		if match(order) {
+			out := *order
+			return &out, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memoryOrderRepo) GetOrder(ctx context.Context, id string) (*entity.Order, error) {
+	return r.find(func(o *entity.Order) bool { return o.ID.String() == id })
+}
+
+func (r *memoryOrderRepo) GetOrderByCheckout(ctx context.Context, provider, checkoutID string) (*entity.Order, error) {
+	return r.find(func(o *entity.Order) bool { return o.Provider == provider && o.CheckoutId == checkoutID })
+}
+
+func (r *memoryOrderRepo) GetOrderByPayment(ctx context.Context, provider, paymentID string) (*entity.Order, error) {
+	return r.find(func(o *entity.Order) bool { return o.Provider == provider && o.PaymentId == paymentID })
+}
+
+func (r *memoryOrderRepo) ListOrders(ctx context.Context, eventID, userID string, offset, limit int) ([]entity.Order, error) {
+	r.events.mu.Lock()
+	defer r.events.mu.Unlock()
+	var orders []entity.Order
+	for _, order := range r.orders {
+		if eventID != "" && order.EventId.String() == eventID || eventID == "" && order.UserId.String() == userID {
+			orders = append(orders, *order)
+		}
+	}
+	sort.SliceStable(orders, func(i, j int) bool { return orders[i].CreatedAt.After(orders[j].CreatedAt) })
+	if offset > len(orders) {
+		return nil, nil
+	}
+	orders = orders[offset:]
+	if len(orders) > limit {
+		orders = orders[:limit]
+	}
+	return orders, nil
+}
+
+func (r *memoryOrderRepo) SaveCheckout(ctx context.Context, order *entity.Order) error {
+	_, err := r.transition(order.ID.String(), func(stored *entity.Order) {
+		stored.Provider, stored.CheckoutId, stored.CheckoutURL = order.Provider, order.CheckoutId, order.CheckoutURL
+	})
+	return err
+}
+
+func (r *memoryOrderRepo) PayOrder(ctx context.Context, orderID, paymentID string, paidAt time.Time) (*entity.Order, error) {
+	return r.transition(orderID, func(order *entity.Order) {
+		if order.Status != entity.OrderPending && order.Status != entity.OrderCancelled {
+			return
+		}
+		order.PaymentId = paymentID
+		order.PaidAt = &paidAt
+		if event, ok := r.events.events[order.EventId]; ok && r.available(event, order, paidAt) != nil {
+			order.Status = entity.OrderRefundPending
+			return
+		}
+		rsvp := r.events.find(order.EventId, order.UserId)
+		if rsvp == nil {
+			rsvp = &entity.EventRsvp{ID: uuid.New(), EventId: order.EventId, UserId: order.UserId, CreatedAt: paidAt}
+			r.events.rsvps = append(r.events.rsvps, rsvp)
+		}
+		if rsvp.Status != entity.RsvpConfirmed {
+			rsvp.RequestedAt = paidAt
+		}
+		rsvp.Status = entity.RsvpConfirmed
+		order.Status = entity.OrderPaid
+		order.RsvpId = &rsvp.ID
+	})
+}
+
+func (r *memoryOrderRepo) CancelOrder(ctx context.Context, orderID string) (*entity.Order, error) {
+	return r.transition(orderID, func(order *entity.Order) {
+		if order.Status == entity.OrderPending {
+			order.Status = entity.OrderCancelled
+		}
+	})
+}
+
+func (r *memoryOrderRepo) RefundOrder(ctx context.Context, orderID string) (*entity.Order, error) {
+	return r.transition(orderID, func(order *entity.Order) {
+		if order.Status == entity.OrderPaid {
+			r.refund(order, entity.OrderRefundPending)
+		}
+	})
+}
+
+func (r *memoryOrderRepo) CompleteRefund(ctx context.Context, orderID string) (*entity.Order, error) {
+	return r.transition(orderID, func(order *entity.Order) {
+		switch order.Status {
+		case entity.OrderPaid:
+			r.refund(order, entity.OrderRefunded)
+		case entity.OrderRefundPending:
+			order.Status = entity.OrderRefunded
+		}
+	})
+}
+
+func (r *memoryOrderRepo) transition(orderID string, change func(*entity.Order)) (*entity.Order, error) {
+	r.events.mu.Lock()
+	defer r.events.mu.Unlock()
+	for _, order := range r.orders {
+		if order.ID.String() == orderID {
+			change(order)
+			out := *order
+			return &out, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memoryOrderRepo) refund(order *entity.Order, status entity.OrderStatus) {
+	order.Status = status
+	for _, other := range r.orders {
+		if other.EventId == order.EventId && other.UserId == order.UserId && other.Status == entity.OrderPaid {
+			return
+		}
+	}
+	if rsvp := r.events.find(order.EventId, order.UserId); rsvp != nil && rsvp.Status != entity.RsvpCancelled {
+		rsvp.Status = entity.RsvpCancelled
+		r.events.promote(r.events.events[order.EventId])
+	}
+}
+
+type orderFixture struct {
+	*rsvpFixture
+	orders    *services.OrderService
+	orderRepo *memoryOrderRepo
+	payments  *payment.FakeProvider
+}
+
+func newOrderFixture() *orderFixture {
+	f := newRsvpFixture()
+	repo := &memoryOrderRepo{events: f.repo}
+	payments := payment.NewFakeProvider("fake-secret")
+	orders := services.NewOrderService(repo, f.svc, payments, "https://app.example.com/orders?from=app")
+	return &orderFixture{rsvpFixture: f, orders: orders, orderRepo: repo, payments: payments}
+}
+
+// ticketed makes an event selling adult tickets at 15.00 CAD, limited to
+// quota, and free child tickets.
+func (f *orderFixture) ticketed(t *testing.T, quota int32) (*entity.Event, entity.TicketType, entity.TicketType) {
+	event := f.event(t, 0, entity.NO_RESTRICTION)
+	event, err := f.orders.SetTicketTypes(context.Background(), event.ID.String(), []entity.TicketType{
+		{Name: "Adult", Price: 1500, Currency: "cad", Quota: quota},
+		{Name: "Child", Price: 0, Currency: "CAD"},
+	})
+	require.NoError(t, err)
+	require.Len(t, event.TicketTypes, 2)
+	return event, event.TicketTypes[0], event.TicketTypes[1]
+}
+
+// deliver posts a webhook to the webhook handler and returns its status.
+func (f *orderFixture) deliver(t *testing.T, header http.Header, body []byte) int {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/payments", strings.NewReader(string(body)))
+	req.Header = header
+	rec := httptest.NewRecorder()
+	handler.NewPaymentWebhookHTTPHandler(f.orders).ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestSetTicketTypes_ValidatesAndProtectsOrderedTypes(t *testing.T) {
+	ctx := context.Background()
+	f := newOrderFixture()
+	event, adult, child := f.ticketed(t, 10)
+	assert.Equal(t, "CAD", adult.Currency)
+	assert.Equal(t, int64(-1), child.Remaining())
+	stored, err := f.repo.GetByID(ctx, event.ID.String())
+	require.NoError(t, err)
+	assert.True(t, stored.IsPaid)
+	assert.True(t, stored.RequiresRsvp)
+
+	for name, types := range map[string][]entity.TicketType{
+		"duplicate names":  {{Name: "Adult", Currency: "CAD"}, {Name: "adult", Currency: "CAD"}},
+		"mixed currencies": {{Name: "Adult", Currency: "CAD"}, {Name: "Child", Currency: "USD"}},
+		"negative price":   {{Name: "Adult", Price: -1, Currency: "CAD"}},
+		"bad currency":     {{Name: "Adult", Currency: "dollars"}},
+		"unknown id":       {{ID: uuid.New(), Name: "Adult", Currency: "CAD"}},
+	} {
+		_, err := f.orders.SetTicketTypes(ctx, event.ID.String(), types)
+		assert.ErrorIs(t, err, helper.ErrInvalidTicketTypes, name)
+	}
+
+	_, err = f.orders.CreateOrder(ctx, event.ID.String(), f.user(entity.Female, "Amina"), []entity.OrderItem{{TicketTypeId: adult.ID, Quantity: 3}})
+	require.NoError(t, err)
+
+	_, err = f.orders.SetTicketTypes(ctx, event.ID.String(), []entity.TicketType{child})
+	assert.ErrorIs(t, err, helper.ErrTicketTypeInUse, "removing an ordered type")
+	lowered := adult
+	lowered.Quota = 2
+	_, err = f.orders.SetTicketTypes(ctx, event.ID.String(), []entity.TicketType{lowered, child})
+	assert.ErrorIs(t, err, helper.ErrTicketTypeInUse, "lowering a quota below the number sold")
+
+	// Unordered types may go, and ordered ones may be repriced.
+	adult.Price = 2000
+	updated, err := f.orders.SetTicketTypes(ctx, event.ID.String(), []entity.TicketType{adult})
+	require.NoError(t, err)
+	require.Len(t, updated.TicketTypes, 1)
+	assert.Equal(t, int64(2000), updated.TicketTypes[0].Price)
+	assert.Equal(t, int64(3), updated.TicketTypes[0].Sold)
+}
+
+func TestCreateOrder_SeriesStartedInThePast(t *testing.T) {
+	ctx := context.Background()
+	f := newOrderFixture()
+	start := time.Now().UTC().Truncate(time.Hour).AddDate(0, 0, -14)
+	series := f.pastSeries(t, start, "RRULE:FREQ=WEEKLY;COUNT=52")
+	series, err := f.orders.SetTicketTypes(ctx, series.ID.String(), []entity.TicketType{{Name: "Adult", Price: 1500, Currency: "CAD"}})
+	require.NoError(t, err)
+
+	order, err := f.orders.CreateOrder(ctx, series.ID.String(), f.user(entity.Female, "Amina"), []entity.OrderItem{{TicketTypeId: series.TicketTypes[0].ID, Quantity: 1}})
+	require.NoError(t, err)
+	assert.Equal(t, series.ID, order.EventId)
+}
+
+func TestCreateOrder_HoldsTicketsUntilSoldOut(t *testing.T) {
+	ctx := context.Background()
+	f := newOrderFixture()
+	event, adult, _ := f.ticketed(t, 2)
+	amina, bilal := f.user(entity.Female, "Amina"), f.user(entity.Male, "Bilal")
+
+	_, err := f.svc.Rsvp(ctx, event.ID.String(), bilal)
+	assert.ErrorIs(t, err, helper.ErrTicketsRequired)
+	_, err = f.orders.CreateOrder(ctx, event.ID.String(), bilal, []entity.OrderItem{{TicketTypeId: uuid.New(), Quantity: 1}})
+	assert.ErrorIs(t, err, helper.ErrInvalidOrder)
+	_, err = f.orders.CreateOrder(ctx, event.ID.String(), bilal, []entity.OrderItem{{TicketTypeId: adult.ID, Quantity: 0}})
+	assert.ErrorIs(t, err, helper.ErrInvalidOrder)
+
+	order, err := f.orders.CreateOrder(ctx, event.ID.String(), amina, []entity.OrderItem{{TicketTypeId: adult.ID, Quantity: 2}})
+	require.NoError(t, err)
+	assert.Equal(t, entity.OrderPending, order.Status)
+	assert.Equal(t, int64(3000), order.Total)
+	assert.Equal(t, "CAD", order.Currency)
+	assert.NotEmpty(t, order.CheckoutURL)
+	assert.WithinDuration(t, time.Now().Add(services.OrderHoldTime), order.ExpiresAt, time.Minute)
+
+	_, err = f.orders.CreateOrder(ctx, event.ID.String(), bilal, []entity.OrderItem{{TicketTypeId: adult.ID, Quantity: 1}})
+	assert.ErrorIs(t, err, helper.ErrTicketsSoldOut)
+	got, err := f.svc.GetById(ctx, event.ID.String())
+	require.NoError(t, err)
+	require.NoError(t, f.orders.SetTicketsSold(ctx, got))
+	assert.Equal(t, int64(2), got.TicketTypes[0].Sold)
+	assert.Equal(t, int64(0), got.TicketTypes[0].Remaining())
+
+	// Unpaid orders release their tickets when their hold runs out.
+	f.orderRepo.orders[0].ExpiresAt = time.Now().Add(-time.Second)
+	_, err = f.orders.CreateOrder(ctx, event.ID.String(), bilal, []entity.OrderItem{{TicketTypeId: adult.ID, Quantity: 1}})
+	assert.NoError(t, err)
+}
+
+func TestCreateOrder_FreeTicketsConfirmAtOnce(t *testing.T) {
+	ctx := context.Background()
+	f := newOrderFixture()
+	f.orders = services.NewOrderService(f.orderRepo, f.svc, nil, "")
+	event, adult, child := f.ticketed(t, 0)
+	amina := f.user(entity.Female, "Amina")
+
+	_, err := f.orders.CreateOrder(ctx, event.ID.String(), amina, []entity.OrderItem{{TicketTypeId: adult.ID, Quantity: 1}})
+	assert.ErrorIs(t, err, helper.ErrPaymentsNotConfigured)
+
+	order, err := f.orders.CreateOrder(ctx, event.ID.String(), amina, []entity.OrderItem{{TicketTypeId: child.ID, Quantity: 3}})
+	require.NoError(t, err)
+	assert.Equal(t, entity.OrderPaid, order.Status)
+	assert.Equal(t, int64(0), order.Total)
+	require.NotNil(t, order.RsvpId)
+	rsvp := f.repo.find(event.ID, uuid.MustParse(amina))
+	require.NotNil(t, rsvp)
+	assert.Equal(t, *order.RsvpId, rsvp.ID)
+	assert.Equal(t, entity.RsvpConfirmed, rsvp.Status)
+
+	// Free orders are cancelled without going through a provider.
+	cancelled, err := f.orders.CancelOrder(ctx, order.ID.String(), amina, false)
+	require.NoError(t, err)
+	assert.Equal(t, entity.OrderRefunded, cancelled.Status)
+	assert.Equal(t, entity.RsvpCancelled, rsvp.Status)
+}
+
+func TestPaymentWebhook_PaysOrderAndConfirmsRsvp(t *testing.T) {
+	ctx := context.Background()
+	f := newOrderFixture()
+	f.svc.Tickets = mustSigner(t, 3)
+	event, adult, child := f.ticketed(t, 0)
+	amina := f.user(entity.Female, "Amina")
+
+	order, err := f.orders.CreateOrder(ctx, event.ID.String(), amina, []entity.OrderItem{
+		{TicketTypeId: adult.ID, Quantity: 2},
+		{TicketTypeId: child.ID, Quantity: 1},
+	})
+	require.NoError(t, err)
+	checkout, ok := f.payments.Checkout(order.CheckoutId)
+	require.True(t, ok)
+	assert.Equal(t, []payment.LineItem{{Name: "Adult", UnitAmount: 1500, Quantity: 2}, {Name: "Child", UnitAmount: 0, Quantity: 1}}, checkout.Request.Items)
+	success, err := url.Parse(checkout.Request.SuccessURL)
+	require.NoError(t, err)
+	assert.Equal(t, "app", success.Query().Get("from"))
+	assert.Equal(t, order.ID.String(), success.Query().Get("order_id"))
+	assert.Equal(t, "success", success.Query().Get("checkout"))
+
+	paid, err := f.payments.Pay(order.CheckoutId)
+	require.NoError(t, err)
+	header, body := f.payments.Webhook(paid)
+
+	tampered := header.Clone()
+	tampered.Set(payment.FakeSignatureHeader, strings.Repeat("0", 64))
+	assert.Equal(t, http.StatusBadRequest, f.deliver(t, tampered, body))
+	got, err := f.orders.GetOrder(ctx, order.ID.String(), amina, false)
+	require.NoError(t, err)
+	assert.Equal(t, entity.OrderPending, got.Status)
+
+	// Providers deliver events at least once.
+	for range 2 {
+		assert.Equal(t, http.StatusOK, f.deliver(t, header, body))
+	}
+	got, err = f.orders.GetOrder(ctx, order.ID.String(), amina, false)
+	require.NoError(t, err)
+	assert.Equal(t, entity.OrderPaid, got.Status)
+	assert.Equal(t, paid.PaymentID, got.PaymentId)
+	require.NotNil(t, got.PaidAt)
+
+	rsvps, err := f.svc.GetMyRsvps(ctx, amina, false)
+	require.NoError(t, err)
+	require.Len(t, rsvps, 1)
+	assert.Equal(t, entity.RsvpConfirmed, rsvps[0].Status)
+	assert.Equal(t, *got.RsvpId, rsvps[0].ID)
+	assert.Equal(t, f.ticketFor(t, amina, event.ID), rsvps[0].Ticket)
+
+	// Others cannot see the order, and events of other sales are ignored.
+	_, err = f.orders.GetOrder(ctx, order.ID.String(), f.user(entity.Male, "Bilal"), false)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	header, body = f.payments.Webhook(&payment.Event{ID: "evt_other", Type: payment.CheckoutCompleted, CheckoutID: "cs_other"})
+	assert.Equal(t, http.StatusOK, f.deliver(t, header, body))
+
+	unconfigured := services.NewOrderService(f.orderRepo, f.svc, nil, "")
+	rec := httptest.NewRecorder()
+	handler.NewPaymentWebhookHTTPHandler(unconfigured).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhooks/payments", strings.NewReader(string(body))))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestCancelOrder_ExpiresCheckoutsAndRefundsPayments(t *testing.T) {
+	ctx := context.Background()
+	f := newOrderFixture()
+	event, adult, _ := f.ticketed(t, 1)
+	amina, bilal := f.user(entity.Female, "Amina"), f.user(entity.Male, "Bilal")
+	item := []entity.OrderItem{{TicketTypeId: adult.ID, Quantity: 1}}
+
+	pending, err := f.orders.CreateOrder(ctx, event.ID.String(), amina, item)
+	require.NoError(t, err)
+	_, err = f.orders.CancelOrder(ctx, pending.ID.String(), bilal, false)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	cancelled, err := f.orders.CancelOrder(ctx, pending.ID.String(), amina, false)
+	require.NoError(t, err)
+	assert.Equal(t, entity.OrderCancelled, cancelled.Status)
+	checkout, _ := f.payments.Checkout(pending.CheckoutId)
+	assert.True(t, checkout.Expired)
+
+	// The cancelled order's ticket is free again.
+	order, err := f.orders.CreateOrder(ctx, event.ID.String(), bilal, item)
+	require.NoError(t, err)
+	paid, err := f.payments.Pay(order.CheckoutId)
+	require.NoError(t, err)
+	require.NoError(t, f.orders.HandlePaymentEvent(ctx, paid))
+
+	// Buyers can no longer refund once the event has started.
+	stored := f.repo.events[event.ID]
+	start := stored.StartTime
+	stored.StartTime = time.Now().Add(-time.Minute)
+	f.repo.events[event.ID] = stored
+	_, err = f.orders.CancelOrder(ctx, order.ID.String(), bilal, false)
+	assert.ErrorIs(t, err, helper.ErrOrderNotCancellable)
+	stored.StartTime = start
+	f.repo.events[event.ID] = stored
+
+	for range 2 {
+		refunding, err := f.orders.CancelOrder(ctx, order.ID.String(), bilal, false)
+		require.NoError(t, err)
+		assert.Equal(t, entity.OrderRefundPending, refunding.Status)
+	}
+	assert.Equal(t, []payment.FakeRefund{{PaymentID: paid.PaymentID, Amount: 1500, IdempotencyKey: "refund-" + order.ID.String()}}, f.payments.Refunds())
+	assert.Equal(t, entity.RsvpCancelled, f.repo.find(event.ID, uuid.MustParse(bilal)).Status)
+
+	// A partial refund leaves the order as it is; a full one completes it.
+	partial := &payment.Event{ID: "evt_partial", Type: payment.PaymentRefunded, PaymentID: paid.PaymentID, Amount: 500}
+	require.NoError(t, f.orders.HandlePaymentEvent(ctx, partial))
+	got, err := f.orders.GetOrder(ctx, order.ID.String(), "", true)
+	require.NoError(t, err)
+	assert.Equal(t, entity.OrderRefundPending, got.Status)
+	full := &payment.Event{ID: "evt_full", Type: payment.PaymentRefunded, PaymentID: paid.PaymentID, Amount: 1500}
+	require.NoError(t, f.orders.HandlePaymentEvent(ctx, full))
+	got, err = f.orders.GetOrder(ctx, order.ID.String(), "", true)
+	require.NoError(t, err)
+	assert.Equal(t, entity.OrderRefunded, got.Status)
+
+	orders, next, err := f.orders.ListOrders(ctx, event.ID.String(), "", 1, "")
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	assert.Equal(t, "1", next)
+	orders, next, err = f.orders.ListOrders(ctx, "", amina, 0, "")
+	require.NoError(t, err)
+	assert.Len(t, orders, 1)
+	assert.Empty(t, next)
+}
+
+func TestCreateOrder_KeepsToEventCapacity(t *testing.T) {
+	ctx := context.Background()
+	f := newOrderFixture()
+	event, err := f.orders.SetTicketTypes(ctx, f.event(t, 1, entity.NO_RESTRICTION).ID.String(), []entity.TicketType{
+		{Name: "Adult", Price: 1500, Currency: "CAD"},
+	})
+	require.NoError(t, err)
+	adult := []entity.OrderItem{{TicketTypeId: event.TicketTypes[0].ID, Quantity: 1}}
+	amina, bilal := f.user(entity.Female, "Amina"), f.user(entity.Male, "Bilal")
+
+	late, err := f.orders.CreateOrder(ctx, event.ID.String(), amina, adult)
+	require.NoError(t, err)
+	_, err = f.orders.CreateOrder(ctx, event.ID.String(), bilal, adult)
+	assert.ErrorIs(t, err, helper.ErrTicketsSoldOut, "the only place is held by a pending order")
+
+	// Once the hold runs out the place goes to another buyer.
+	f.orderRepo.orders[0].ExpiresAt = time.Now().Add(-time.Second)
+	order, err := f.orders.CreateOrder(ctx, event.ID.String(), bilal, adult)
+	require.NoError(t, err)
+	paid, err := f.payments.Pay(order.CheckoutId)
+	require.NoError(t, err)
+	require.NoError(t, f.orders.HandlePaymentEvent(ctx, paid))
+
+	// A buyer who already has a place may order more tickets.
+	_, err = f.orders.CreateOrder(ctx, event.ID.String(), bilal, adult)
+	assert.NoError(t, err)
+
+	// Paying the order after it was cancelled refunds it rather than
+	// overfilling the event, however many times the provider says so.
+	expired := &payment.Event{ID: "evt_expired", Type: payment.CheckoutExpired, CheckoutID: late.CheckoutId}
+	require.NoError(t, f.orders.HandlePaymentEvent(ctx, expired))
+	paid, err = f.payments.Pay(late.CheckoutId)
+	require.NoError(t, err)
+	for range 2 {
+		require.NoError(t, f.orders.HandlePaymentEvent(ctx, paid))
+	}
+	got, err := f.orders.GetOrder(ctx, late.ID.String(), amina, false)
+	require.NoError(t, err)
+	assert.Equal(t, entity.OrderRefundPending, got.Status)
+	assert.Equal(t, paid.PaymentID, got.PaymentId)
+	assert.Nil(t, got.RsvpId)
+	assert.Equal(t, []payment.FakeRefund{{PaymentID: paid.PaymentID, Amount: 1500, IdempotencyKey: "refund-" + late.ID.String()}}, f.payments.Refunds())
+	assert.Nil(t, f.repo.find(event.ID, uuid.MustParse(amina)))
+	assert.Equal(t, int64(1), f.repo.confirmed(event.ID))
+
+	refunded := &payment.Event{ID: "evt_refunded", Type: payment.PaymentRefunded, PaymentID: paid.PaymentID, Amount: 1500}
+	require.NoError(t, f.orders.HandlePaymentEvent(ctx, refunded))
+	got, err = f.orders.GetOrder(ctx, late.ID.String(), amina, false)
+	require.NoError(t, err)
+	assert.Equal(t, entity.OrderRefunded, got.Status)
+}
+
+// stripeSignature signs a webhook body as Stripe does.
+func stripeSignature(secret string, at time.Time, body []byte) string {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return fmt.Sprintf("t=%s,v1=%s", timestamp, hex.EncodeToString(mac.Sum(nil)))
+}
+
+func TestStripeProvider_CreatesCheckoutsAndVerifiesWebhooks(t *testing.T) {
+	var got *http.Request
+	var form url.Values
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		require.NoError(t, r.ParseForm())
+		form = r.PostForm
+		if r.URL.Path == "/v1/refunds" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":{"message":"Charge has already been refunded."}}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":"cs_test_1","url":"https://checkout.stripe.com/c/pay/cs_test_1"}`)
+	}))
+	defer api.Close()
+
+	const secret = "whsec_test"
+	stripe, err := payment.NewStripeProvider(payment.StripeConfig{APIURL: api.URL, SecretKey: "sk_test", WebhookSecret: secret})
+	require.NoError(t, err)
+	expires := time.Now().Add(services.OrderHoldTime)
+	checkout, err := stripe.CreateCheckout(context.Background(), &payment.CheckoutRequest{
+		OrderID:    "order-1",
+		Currency:   "CAD",
+		Items:      []payment.LineItem{{Name: "Adult", UnitAmount: 1500, Quantity: 2}},
+		SuccessURL: "https://app.example.com/ok",
+		CancelURL:  "https://app.example.com/cancel",
+		ExpiresAt:  expires,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &payment.Checkout{ID: "cs_test_1", URL: "https://checkout.stripe.com/c/pay/cs_test_1"}, checkout)
+	assert.Equal(t, "/v1/checkout/sessions", got.URL.Path)
+	assert.Equal(t, "Bearer sk_test", got.Header.Get("Authorization"))
+	assert.Equal(t, "checkout-order-1", got.Header.Get("Idempotency-Key"))
+	assert.Equal(t, "order-1", form.Get("metadata[order_id]"))
+	assert.Equal(t, "cad", form.Get("line_items[0][price_data][currency]"))
+	assert.Equal(t, "1500", form.Get("line_items[0][price_data][unit_amount]"))
+	assert.Equal(t, "2", form.Get("line_items[0][quantity]"))
+	assert.Equal(t, strconv.FormatInt(expires.Unix(), 10), form.Get("expires_at"))
+
+	err = stripe.Refund(context.Background(), "pi_1", 1500, "refund-order-1")
+	assert.ErrorContains(t, err, "Charge has already been refunded.")
+	assert.Equal(t, "refund-order-1", got.Header.Get("Idempotency-Key"))
+
+	body := []byte(`{"id":"evt_1","type":"checkout.session.completed","data":{"object":{"id":"cs_test_1","payment_status":"paid","payment_intent":"pi_1","metadata":{"order_id":"order-1"}}}}`)
+	header := http.Header{}
+	header.Set("Stripe-Signature", stripeSignature(secret, time.Now(), body))
+	event, err := stripe.ParseWebhook(header, body)
+	require.NoError(t, err)
+	assert.Equal(t, &payment.Event{ID: "evt_1", Type: payment.CheckoutCompleted, CheckoutID: "cs_test_1", PaymentID: "pi_1", OrderID: "order-1"}, event)
+
+	for name, signature := range map[string]string{
+		"wrong secret": stripeSignature("whsec_other", time.Now(), body),
+		"too old":      stripeSignature(secret, time.Now().Add(-time.Hour), body),
+		"missing":      "",
+	} {
+		header.Set("Stripe-Signature", signature)
+		_, err := stripe.ParseWebhook(header, body)
+		assert.ErrorIs(t, err, payment.ErrInvalidSignature, name)
+	}
+
+	unpaid := []byte(`{"id":"evt_2","type":"checkout.session.completed","data":{"object":{"id":"cs_test_2","payment_status":"unpaid"}}}`)
+	header.Set("Stripe-Signature", stripeSignature(secret, time.Now(), unpaid))
+	event, err = stripe.ParseWebhook(header, unpaid)
+	require.NoError(t, err)
+	assert.Nil(t, event, "delayed payments complete later")
+}
+
+// createTicketedEvent stores an event with places for maxParticipants
+// selling adult tickets at 15.00 CAD, limited to quota, and free child
+// tickets. Its orders are deleted with it when the test ends.
+func (suite *DatabaseGrpcHandlerTestSuite) createTicketedEvent(maxParticipants, quota int32) (*entity.Event, entity.TicketType, entity.TicketType) {
+	event := suite.createRsvpEvent(maxParticipants)
+	suite.T().Cleanup(func() {
+		orders := suite.DB.Model(&entity.Order{}).Select("id").Where("event_id = ?", event.ID)
+		suite.DB.Delete(&entity.OrderItem{}, "order_id IN (?)", orders)
+		suite.DB.Delete(&entity.Order{}, "event_id = ?", event.ID)
+		suite.DB.Delete(&entity.TicketType{}, "event_id = ?", event.ID)
+	})
+	event, err := suite.OrderService.SetTicketTypes(context.Background(), event.ID.String(), []entity.TicketType{
+		{Name: "Adult", Price: 1500, Currency: "CAD", Quota: quota},
+		{Name: "Child", Price: 0, Currency: "CAD"},
+	})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), event.TicketTypes, 2)
+	return event, event.TicketTypes[0], event.TicketTypes[1]
+}
+
+// orderConcurrently has each of n new members order items at once and
+// returns the orders made and how many were sold out.
+func (suite *DatabaseGrpcHandlerTestSuite) orderConcurrently(event *entity.Event, n int, items []entity.OrderItem) ([]*entity.Order, int) {
+	users := make([]string, n)
+	for i := range users {
+		user, _ := suite.createMember(fmt.Sprintf("buyer%d", i))
+		users[i] = user.ID.String()
+	}
+	orders := make([]*entity.Order, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := range users {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			orders[i], errs[i] = suite.OrderService.CreateOrder(context.Background(), event.ID.String(), users[i], items)
+		}(i)
+	}
+	wg.Wait()
+
+	var made []*entity.Order
+	soldOut := 0
+	for i, err := range errs {
+		if errors.Is(err, helper.ErrTicketsSoldOut) {
+			soldOut++
+			continue
+		}
+		require.NoError(suite.T(), err)
+		made = append(made, orders[i])
+	}
+	return made, soldOut
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) TestCreateOrder_ConcurrentOrdersKeepToQuota() {
+	event, adult, _ := suite.createTicketedEvent(0, 3)
+
+	orders, soldOut := suite.orderConcurrently(event, 8, []entity.OrderItem{{TicketTypeId: adult.ID, Quantity: 1}})
+	assert.Len(suite.T(), orders, 3, "no more tickets are held than the quota")
+	assert.Equal(suite.T(), 5, soldOut)
+
+	var held int64
+	err := suite.DB.Model(&entity.Order{}).Where("event_id = ? AND status = ?", event.ID, entity.OrderPending).Count(&held).Error
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), held)
+
+	// Webhooks delivered at once pay the order once.
+	paid, err := suite.Payments.Pay(orders[0].CheckoutId)
+	require.NoError(suite.T(), err)
+	var wg sync.WaitGroup
+	errs := make([]error, 4)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = suite.OrderService.HandlePaymentEvent(context.Background(), paid)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(suite.T(), err)
+	}
+	var stored entity.Order
+	require.NoError(suite.T(), suite.DB.First(&stored, "id = ?", orders[0].ID).Error)
+	assert.Equal(suite.T(), entity.OrderPaid, stored.Status)
+	var rsvps []entity.EventRsvp
+	require.NoError(suite.T(), suite.DB.Where("event_id = ? AND user_id = ?", event.ID, orders[0].UserId).Find(&rsvps).Error)
+	require.Len(suite.T(), rsvps, 1)
+	assert.Equal(suite.T(), entity.RsvpConfirmed, rsvps[0].Status)
+	assert.Equal(suite.T(), rsvps[0].ID, *stored.RsvpId)
+	assert.Empty(suite.T(), suite.Payments.Refunds())
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) TestCreateOrder_ConcurrentFreeOrdersKeepToCapacity() {
+	event, _, child := suite.createTicketedEvent(2, 0)
+
+	orders, soldOut := suite.orderConcurrently(event, 6, []entity.OrderItem{{TicketTypeId: child.ID, Quantity: 1}})
+	require.Len(suite.T(), orders, 2, "no more places are taken than the event has")
+	assert.Equal(suite.T(), 4, soldOut)
+	for _, order := range orders {
+		assert.Equal(suite.T(), entity.OrderPaid, order.Status)
+	}
+
+	var confirmed int64
+	err := suite.DB.Model(&entity.EventRsvp{}).Where("event_id = ? AND status = ?", event.ID, entity.RsvpConfirmed).Count(&confirmed).Error
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), confirmed)
+}
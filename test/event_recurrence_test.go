@@ -0,0 +1,388 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/recurrence"
+	"github.com/mnadev/limestone/internal/application/helper"
+)
+
+func (r *memoryEventRepo) ListEventsBetween(ctx context.Context, params *entity.ListEventsQueryParams) ([]*entity.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	from, before := params.StartFrom, params.StartBefore
+	in := func(t time.Time) bool { return !t.Before(from) && t.Before(before) }
+	var events []*entity.Event
+	for _, event := range r.events {
+		switch {
+		case params.MasjidId != "" && event.MasjidId != params.MasjidId:
+			continue
+		case !event.IsRecurring() && in(event.StartTime),
+			event.IsRecurring() && event.StartTime.Before(before) && (event.SeriesEnd == nil || !event.SeriesEnd.Before(from)),
+			event.OriginalStartTime != nil && in(*event.OriginalStartTime):
+			event := event
+			events = append(events, &event)
+		}
+	}
+	return events, nil
+}
+
+func (r *memoryEventRepo) GetException(ctx context.Context, seriesID string, originalStart time.Time) (*entity.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if exception := r.exception(seriesID, originalStart); exception != nil {
+		return exception, nil
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memoryEventRepo) ListExceptions(ctx context.Context, seriesIDs []string) ([]*entity.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var events []*entity.Event
+	for _, event := range r.events {
+		if event.RecurringEventId != nil && slices.Contains(seriesIDs, event.RecurringEventId.String()) {
+			event := event
+			events = append(events, &event)
+		}
+	}
+	return events, nil
+}
+
+func (r *memoryEventRepo) exception(seriesID string, originalStart time.Time) *entity.Event {
+	for _, event := range r.events {
+		if event.RecurringEventId != nil && event.RecurringEventId.String() == seriesID && event.OriginalStartTime.Equal(originalStart) {
+			return &event
+		}
+	}
+	return nil
+}
+
+func (r *memoryEventRepo) saveRecurrence(series *entity.Event) {
+	stored := r.events[series.ID]
+	stored.Recurrence, stored.SeriesEnd = series.Recurrence, series.SeriesEnd
+	r.events[series.ID] = stored
+}
+
+func (r *memoryEventRepo) SaveRecurrence(ctx context.Context, series *entity.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.saveRecurrence(series)
+	return nil
+}
+
+func (r *memoryEventRepo) ExcludeOccurrence(ctx context.Context, series *entity.Event, originalStart time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.saveRecurrence(series)
+	if r.rooms != nil {
+		r.rooms.release(series.ID, originalStart.Equal)
+	}
+	if exception := r.exception(series.ID.String(), originalStart); exception != nil {
+		delete(r.events, exception.ID)
+	}
+	return nil
+}
+
+func (r *memoryEventRepo) EndSeries(ctx context.Context, series *entity.Event, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.saveRecurrence(series)
+	if r.rooms != nil {
+		r.rooms.release(series.ID, func(start time.Time) bool { return !start.Before(at) })
+	}
+	for id, event := range r.events {
+		if event.RecurringEventId != nil && *event.RecurringEventId == series.ID && !event.OriginalStartTime.Before(at) {
+			delete(r.events, id)
+		}
+	}
+	return nil
+}
+
+func (r *memoryEventRepo) SplitSeries(ctx context.Context, head, tail *entity.Event, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.saveRecurrence(head)
+	for id, event := range r.events {
+		if event.RecurringEventId == nil || *event.RecurringEventId != head.ID || event.OriginalStartTime.Before(at) {
+			continue
+		}
+		if event.OriginalStartTime.Equal(at) {
+			delete(r.events, id)
+			continue
+		}
+		originalStart := event.OriginalStartTime.Add(tail.StartTime.Sub(at))
+		event.RecurringEventId, event.OriginalStartTime = &tail.ID, &originalStart
+		r.events[id] = event
+	}
+	if r.rooms != nil {
+		r.rooms.release(head.ID, func(start time.Time) bool { return !start.Before(at) })
+		if err := r.rooms.replace(tail, tail.Bookings); err != nil {
+			return err
+		}
+	}
+	r.events[tail.ID] = *tail
+	return nil
+}
+
+// friday is the first of ten weekly halaqas.
+var friday = time.Date(2026, time.January, 2, 19, 0, 0, 0, time.UTC)
+
+func (f *rsvpFixture) series(t *testing.T, lines ...string) *entity.Event {
+	t.Helper()
+	event, err := f.svc.Create(context.Background(), &entity.Event{
+		ID:         uuid.New(),
+		Name:       "Friday halaqa",
+		StartTime:  friday,
+		EndTime:    friday.Add(90 * time.Minute),
+		Recurrence: strings.Join(lines, "\n"),
+	})
+	require.NoError(t, err)
+	return event
+}
+
+// pastSeries makes a series requiring RSVP that started at start.
+func (f *rsvpFixture) pastSeries(t *testing.T, start time.Time, lines ...string) *entity.Event {
+	t.Helper()
+	series, err := f.svc.Create(context.Background(), &entity.Event{
+		ID:           uuid.New(),
+		Name:         "Weekly halaqa",
+		StartTime:    start,
+		EndTime:      start.Add(90 * time.Minute),
+		Recurrence:   strings.Join(lines, "\n"),
+		RequiresRsvp: true,
+	})
+	require.NoError(t, err)
+	return series
+}
+
+// week returns the start of the n-th weekly occurrence after friday.
+func week(n int) time.Time {
+	return friday.AddDate(0, 0, 7*n)
+}
+
+func (f *rsvpFixture) list(t *testing.T, from, before time.Time) []*entity.Event {
+	t.Helper()
+	events, _, _, err := f.svc.ListEvents(context.Background(), &entity.ListEventsQueryParams{StartFrom: from, StartBefore: before})
+	require.NoError(t, err)
+	return events
+}
+
+func starts(events []*entity.Event) []time.Time {
+	out := make([]time.Time, len(events))
+	for i, event := range events {
+		out[i] = event.StartTime.UTC()
+	}
+	return out
+}
+
+func TestCreateEvent_StoresRecurrenceInUTC(t *testing.T) {
+	f := newRsvpFixture()
+	series := f.series(t, "RRULE:FREQ=WEEKLY;UNTIL=20260301", "EXDATE;VALUE=DATE:20260109")
+	assert.Equal(t, "RRULE:FREQ=WEEKLY;UNTIL=20260301T235959Z\nEXDATE:20260109T190000Z", series.Recurrence)
+	require.NotNil(t, series.SeriesEnd)
+	assert.Equal(t, time.Date(2026, time.March, 1, 23, 59, 59, 0, time.UTC), *series.SeriesEnd)
+
+	endless := f.series(t, "RRULE:FREQ=DAILY")
+	assert.Nil(t, endless.SeriesEnd)
+
+	_, err := f.svc.Create(context.Background(), &entity.Event{ID: uuid.New(), StartTime: friday, Recurrence: "RRULE:FREQ=FORTNIGHTLY"})
+	assert.ErrorIs(t, err, recurrence.ErrInvalidRule)
+}
+
+func TestListEvents_ExpandsRecurringEvents(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	series := f.series(t, "RRULE:FREQ=WEEKLY;COUNT=10")
+	oneOff := f.event(t, 0, entity.NO_RESTRICTION)
+	oneOff.StartTime = time.Date(2026, time.January, 10, 12, 0, 0, 0, time.UTC)
+	f.repo.events[oneOff.ID] = *oneOff
+
+	january := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	params := &entity.ListEventsQueryParams{StartFrom: january, StartBefore: january.AddDate(0, 1, 0), PageSize: 4}
+	page, next, total, err := f.svc.ListEvents(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{week(0), week(1), oneOff.StartTime, week(2)}, starts(page))
+	assert.Equal(t, "4", next)
+	assert.Equal(t, 6, total)
+	assert.Equal(t, entity.OccurrenceID(series.ID, week(1)), page[1].PublicID())
+	assert.Equal(t, series.ID, *page[1].RecurringEventId)
+	assert.Equal(t, 90*time.Minute, page[1].EndTime.Sub(page[1].StartTime))
+	assert.Equal(t, oneOff.ID.String(), page[2].PublicID())
+
+	params.PageToken = next
+	page, next, _, err = f.svc.ListEvents(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{week(3), week(4)}, starts(page))
+	assert.Empty(t, next)
+
+	// The series ends after ten weeks.
+	assert.Equal(t, []time.Time{week(9)}, starts(f.list(t, week(8).Add(time.Hour), week(20))))
+
+	_, _, _, err = f.svc.ListEvents(ctx, &entity.ListEventsQueryParams{StartFrom: january, StartBefore: january.AddDate(2, 0, 0)})
+	assert.ErrorIs(t, err, helper.ErrInvalidTimeWindow)
+}
+
+func TestUpdateOccurrence_ThisEvent(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	series := f.series(t, "RRULE:FREQ=WEEKLY;COUNT=10")
+
+	moved := week(2).AddDate(0, 0, 1)
+	exception, err := f.svc.UpdateOccurrence(ctx, series.ID.String(), week(2), entity.ThisEvent, &entity.Event{
+		Name:      "Tafsir night",
+		StartTime: moved,
+		EndTime:   moved.Add(2 * time.Hour),
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, series.ID, exception.ID)
+	assert.Equal(t, series.ID, *exception.RecurringEventId)
+	assert.True(t, week(2).Equal(*exception.OriginalStartTime))
+	assert.Equal(t, "Tafsir night", exception.Name)
+	assert.Empty(t, exception.Recurrence)
+
+	january := f.list(t, week(0), week(5))
+	assert.Equal(t, []time.Time{week(0), week(1), moved, week(3), week(4)}, starts(january))
+	assert.Equal(t, exception.ID.String(), january[2].PublicID())
+	assert.Empty(t, f.list(t, week(2), week(2).Add(time.Hour)))
+
+	// The occurrence ID keeps naming the occurrence, now the exception.
+	got, err := f.svc.GetById(ctx, entity.OccurrenceID(series.ID, week(2)))
+	require.NoError(t, err)
+	assert.Equal(t, exception.ID, got.ID)
+	again, err := f.svc.UpdateOccurrence(ctx, series.ID.String(), week(2), entity.ThisEvent, &entity.Event{Name: "Tafsir"})
+	require.NoError(t, err)
+	assert.Equal(t, exception.ID, again.ID)
+	assert.Equal(t, "Tafsir", f.repo.events[exception.ID].Name)
+	assert.Len(t, f.repo.events, 2)
+
+	// Others are left alone.
+	got, err = f.svc.GetById(ctx, entity.OccurrenceID(series.ID, week(3)))
+	require.NoError(t, err)
+	assert.Equal(t, "Friday halaqa", got.Name)
+	assert.True(t, got.IsOccurrence())
+
+	_, err = f.svc.UpdateOccurrence(ctx, series.ID.String(), week(3), entity.ThisEvent, &entity.Event{Recurrence: "RRULE:FREQ=DAILY"})
+	assert.ErrorIs(t, err, helper.ErrRecurringException)
+	_, err = f.svc.GetById(ctx, entity.OccurrenceID(series.ID, week(3).Add(time.Hour)))
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	_, err = f.svc.GetById(ctx, entity.OccurrenceID(series.ID, week(10)))
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestUpdateOccurrence_ThisAndFollowing(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	series := f.series(t, "RRULE:FREQ=WEEKLY;COUNT=10")
+	special, err := f.svc.UpdateOccurrence(ctx, series.ID.String(), week(6), entity.ThisEvent, &entity.Event{Name: "Guest speaker"})
+	require.NoError(t, err)
+
+	// From the fifth week on the halaqa starts an hour later.
+	later := week(4).Add(time.Hour)
+	following, err := f.svc.UpdateOccurrence(ctx, series.ID.String(), week(4), entity.ThisAndFollowing, &entity.Event{
+		StartTime: later,
+		EndTime:   later.Add(90 * time.Minute),
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, series.ID, following.ID)
+	assert.Nil(t, following.RecurringEventId)
+	assert.Equal(t, "RRULE:FREQ=WEEKLY;COUNT=6", following.Recurrence)
+	assert.Equal(t, "Friday halaqa", following.Name)
+
+	head := f.repo.events[series.ID]
+	assert.Equal(t, "RRULE:FREQ=WEEKLY;COUNT=4", head.Recurrence)
+	assert.True(t, week(3).Equal(*head.SeriesEnd))
+
+	// The exception moved to the new series along with its occurrence.
+	moved := f.repo.events[special.ID]
+	assert.Equal(t, following.ID, *moved.RecurringEventId)
+	assert.True(t, week(6).Add(time.Hour).Equal(*moved.OriginalStartTime))
+
+	all := f.list(t, week(0), week(12))
+	hour := time.Hour
+	assert.Equal(t, []time.Time{
+		week(0), week(1), week(2), week(3),
+		week(4).Add(hour), week(5).Add(hour), week(6), week(7).Add(hour), week(8).Add(hour), week(9).Add(hour),
+	}, starts(all))
+	assert.Equal(t, "Guest speaker", all[6].Name)
+
+	// Changing all of the following from the first changes the series.
+	renamed, err := f.svc.UpdateOccurrence(ctx, following.ID.String(), later, entity.ThisAndFollowing, &entity.Event{Name: "Evening halaqa"})
+	require.NoError(t, err)
+	assert.Equal(t, following.ID, renamed.ID)
+	assert.Equal(t, "Evening halaqa", f.repo.events[following.ID].Name)
+}
+
+func TestDeleteOccurrence(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	series := f.series(t, "RRULE:FREQ=WEEKLY;COUNT=10")
+
+	require.NoError(t, f.svc.DeleteOccurrence(ctx, series.ID.String(), week(1), entity.ThisEvent))
+	assert.Equal(t, "RRULE:FREQ=WEEKLY;COUNT=10\nEXDATE:20260109T190000Z", f.repo.events[series.ID].Recurrence)
+
+	// Deleting an exception takes its occurrence out too.
+	exception, err := f.svc.UpdateOccurrence(ctx, series.ID.String(), week(2), entity.ThisEvent, &entity.Event{Name: "Moved"})
+	require.NoError(t, err)
+	require.NoError(t, f.svc.Delete(ctx, exception.ID.String()))
+	assert.NotContains(t, f.repo.events, exception.ID)
+
+	require.NoError(t, f.svc.Delete(ctx, entity.OccurrenceID(series.ID, week(3))))
+	assert.Equal(t, []time.Time{week(0), week(4), week(5)}, starts(f.list(t, week(0), week(6))))
+
+	require.NoError(t, f.svc.DeleteOccurrence(ctx, series.ID.String(), week(5), entity.ThisAndFollowing))
+	assert.Equal(t, []time.Time{week(0), week(4)}, starts(f.list(t, week(0), week(12))))
+	assert.True(t, week(4).Equal(*f.repo.events[series.ID].SeriesEnd))
+
+	err = f.svc.DeleteOccurrence(ctx, series.ID.String(), week(3), entity.ThisEvent)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+	// From the first occurrence on is the whole series.
+	require.NoError(t, f.svc.DeleteOccurrence(ctx, series.ID.String(), week(0), entity.ThisAndFollowing))
+	assert.Empty(t, f.repo.events)
+}
+
+func TestUpdateEvent_ChangesRecurrence(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	series := f.series(t, "RRULE:FREQ=WEEKLY;COUNT=10")
+
+	_, err := f.svc.Update(ctx, &entity.Event{ID: series.ID, Recurrence: "RRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=3"})
+	require.NoError(t, err)
+	stored := f.repo.events[series.ID]
+	assert.Equal(t, "RRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=3", stored.Recurrence)
+	assert.True(t, week(4).Equal(*stored.SeriesEnd))
+
+	_, err = f.svc.Update(ctx, &entity.Event{ID: series.ID, Recurrence: "RRULE:FREQ=DAILY;BYHOUR=7"})
+	assert.ErrorIs(t, err, recurrence.ErrUnsupported)
+	_, err = f.svc.Update(ctx, &entity.Event{ID: series.ID, Recurrence: "RRULE:FREQ=DAILY"})
+	require.NoError(t, err)
+	assert.Nil(t, f.repo.events[series.ID].SeriesEnd)
+}
+
+func TestRsvp_SeriesUntilItsLastOccurrenceEnds(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	user := f.user(entity.Male, "Bilal")
+	start := time.Now().UTC().Truncate(time.Hour).AddDate(0, 0, -14)
+
+	open := f.pastSeries(t, start, "RRULE:FREQ=WEEKLY")
+	_, err := f.svc.Rsvp(ctx, open.ID.String(), user)
+	assert.NoError(t, err, "a series without an end")
+
+	// The last of two occurrences ended a week ago.
+	ended := f.pastSeries(t, start, "RRULE:FREQ=WEEKLY;COUNT=2")
+	_, err = f.svc.Rsvp(ctx, ended.ID.String(), user)
+	assert.ErrorIs(t, err, helper.ErrEventEnded)
+}
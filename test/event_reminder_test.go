@@ -0,0 +1,670 @@
+package test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/services"
+	"github.com/mnadev/limestone/internal/infrastructure/notification"
+)
+
+// memoryNotificationRepo keeps preferences, follows, devices and queued
+// notifications in memory, taking RSVPs from events.
+type memoryNotificationRepo struct {
+	mu            sync.Mutex
+	events        *memoryEventRepo
+	prefs         map[uuid.UUID]entity.NotificationPreference
+	follows       []entity.MasjidFollow
+	devices       map[string]entity.PushDevice
+	notifications []*entity.EventNotification
+}
+
+func newMemoryNotificationRepo(events *memoryEventRepo) *memoryNotificationRepo {
+	return &memoryNotificationRepo{
+		events:  events,
+		prefs:   map[uuid.UUID]entity.NotificationPreference{},
+		devices: map[string]entity.PushDevice{},
+	}
+}
+
+func (r *memoryNotificationRepo) GetPreference(ctx context.Context, userID string) (*entity.NotificationPreference, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pref, ok := r.prefs[uuid.MustParse(userID)]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &pref, nil
+}
+
+func (r *memoryNotificationRepo) ListPreferences(ctx context.Context, userIDs []uuid.UUID) ([]entity.NotificationPreference, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var prefs []entity.NotificationPreference
+	for _, id := range userIDs {
+		if pref, ok := r.prefs[id]; ok {
+			prefs = append(prefs, pref)
+		}
+	}
+	return prefs, nil
+}
+
+func (r *memoryNotificationRepo) SavePreference(ctx context.Context, pref *entity.NotificationPreference) (*entity.NotificationPreference, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.prefs[pref.UserId] = *pref
+	return pref, nil
+}
+
+func (r *memoryNotificationRepo) CreateFollow(ctx context.Context, follow *entity.MasjidFollow) (*entity.MasjidFollow, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.follows {
+		if existing.UserId == follow.UserId && existing.MasjidId == follow.MasjidId {
+			return &existing, nil
+		}
+	}
+	r.follows = append(r.follows, *follow)
+	return follow, nil
+}
+
+func (r *memoryNotificationRepo) DeleteFollow(ctx context.Context, userID, masjidID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, follow := range r.follows {
+		if follow.UserId.String() == userID && follow.MasjidId == masjidID {
+			r.follows = slices.Delete(r.follows, i, i+1)
+			return nil
+		}
+	}
+	return gorm.ErrRecordNotFound
+}
+
+func (r *memoryNotificationRepo) ListFollows(ctx context.Context, userID string) ([]entity.MasjidFollow, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var follows []entity.MasjidFollow
+	for _, follow := range r.follows {
+		if follow.UserId.String() == userID {
+			follows = append(follows, follow)
+		}
+	}
+	return follows, nil
+}
+
+func (r *memoryNotificationRepo) SavePushDevice(ctx context.Context, device *entity.PushDevice) (*entity.PushDevice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.devices[device.Token] = *device
+	return device, nil
+}
+
+func (r *memoryNotificationRepo) DeletePushDevices(ctx context.Context, userID string, tokens ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, token := range tokens {
+		if device, ok := r.devices[token]; ok && (userID == "" || device.UserId.String() == userID) {
+			delete(r.devices, token)
+		}
+	}
+	return nil
+}
+
+func (r *memoryNotificationRepo) ListPushDevices(ctx context.Context, userID string) ([]entity.PushDevice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var devices []entity.PushDevice
+	for _, device := range r.devices {
+		if device.UserId.String() == userID {
+			devices = append(devices, device)
+		}
+	}
+	sort.Slice(devices, func(i, j int) bool { return devices[i].Token < devices[j].Token })
+	return devices, nil
+}
+
+func (r *memoryNotificationRepo) ListAudience(ctx context.Context, rsvpEventID uuid.UUID, masjidID string) ([]uuid.UUID, error) {
+	r.events.mu.Lock()
+	var userIDs []uuid.UUID
+	for _, rsvp := range r.events.rsvps {
+		if rsvp.EventId == rsvpEventID && (rsvp.Status == entity.RsvpConfirmed || rsvp.Status == entity.RsvpWaitlisted) {
+			userIDs = append(userIDs, rsvp.UserId)
+		}
+	}
+	r.events.mu.Unlock()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, follow := range r.follows {
+		if follow.MasjidId == masjidID && !slices.Contains(userIDs, follow.UserId) {
+			userIDs = append(userIDs, follow.UserId)
+		}
+	}
+	return userIDs, nil
+}
+
+func (r *memoryNotificationRepo) QueueNotifications(ctx context.Context, notifications []entity.EventNotification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, n := range notifications {
+		queued := slices.ContainsFunc(r.notifications, func(existing *entity.EventNotification) bool {
+			return existing.UserId == n.UserId && existing.Key == n.Key
+		})
+		if !queued {
+			n := n
+			r.notifications = append(r.notifications, &n)
+		}
+	}
+	return nil
+}
+
+func (r *memoryNotificationRepo) ListPendingNotifications(ctx context.Context, limit int) ([]entity.EventNotification, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var pending []entity.EventNotification
+	for _, n := range r.notifications {
+		if n.Status == entity.NotificationPending && len(pending) < limit {
+			pending = append(pending, *n)
+		}
+	}
+	return pending, nil
+}
+
+func (r *memoryNotificationRepo) UpdateNotification(ctx context.Context, notification *entity.EventNotification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, n := range r.notifications {
+		if n.ID == notification.ID {
+			n.Status, n.Attempts, n.SentAt = notification.Status, notification.Attempts, notification.SentAt
+			return nil
+		}
+	}
+	return gorm.ErrRecordNotFound
+}
+
+// failingPushSender rejects some tokens as unregistered and fails all of
+// them when down.
+type failingPushSender struct {
+	mu           sync.Mutex
+	unregistered []string
+	down         bool
+	sent         []string
+}
+
+func (s *failingPushSender) SendPush(ctx context.Context, token string, n *notification.PushNotification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case s.down:
+		return errors.New("service unavailable")
+	case slices.Contains(s.unregistered, token):
+		return notification.ErrUnregistered
+	}
+	s.sent = append(s.sent, token)
+	return nil
+}
+
+type reminderFixture struct {
+	*rsvpFixture
+	notifications *services.NotificationService
+	repo          *memoryNotificationRepo
+	email         *notification.LogChannel
+	masjidID      string
+}
+
+func newReminderFixture(t *testing.T, channels ...notification.Channel) *reminderFixture {
+	f := newRsvpFixture()
+	masjid := &entity.Masjid{ID: uuid.New(), Name: "Masjid Al-Noor", TimeZone: "America/Toronto"}
+	f.svc.MasjidRepo = &eventMasjidRepo{masjid: masjid}
+	email := notification.NewLogChannel(notification.Email)
+	repo := newMemoryNotificationRepo(f.repo)
+	svc := services.NewNotificationService(repo, f.svc.MasjidRepo, f.users, f.svc, append([]notification.Channel{email}, channels...))
+	f.svc.Notifications = svc
+	return &reminderFixture{rsvpFixture: f, notifications: svc, repo: repo, email: email, masjidID: masjid.ID.String()}
+}
+
+// member adds a member with an email address.
+func (f *reminderFixture) member(name string) string {
+	id := f.user(entity.Male, name)
+	f.repo.events.users[uuid.MustParse(id)].Email = strings.ToLower(name) + "@example.com"
+	return id
+}
+
+func (f *reminderFixture) halaqa(t *testing.T, start time.Time) *entity.Event {
+	event, err := f.svc.Create(context.Background(), &entity.Event{
+		ID:           uuid.New(),
+		MasjidId:     f.masjidID,
+		Name:         "Tafsir halaqa",
+		StartTime:    start,
+		EndTime:      start.Add(time.Hour),
+		RequiresRsvp: true,
+	})
+	require.NoError(t, err)
+	return event
+}
+
+// mail returns the subjects of the emails sent to each address.
+func (f *reminderFixture) mail() map[string][]string {
+	mail := map[string][]string{}
+	for _, msg := range f.email.Sent() {
+		mail[msg.Email] = append(mail[msg.Email], msg.Subject)
+	}
+	return mail
+}
+
+func TestReminders_SentOnceWhenTheLeadIsReached(t *testing.T) {
+	ctx := context.Background()
+	f := newReminderFixture(t)
+	now := time.Now()
+	event := f.halaqa(t, now.Add(90*time.Minute))
+
+	attendee := f.member("Bilal")
+	_, err := f.svc.Rsvp(ctx, event.ID.String(), attendee)
+	require.NoError(t, err)
+	follower := f.member("Yusuf")
+	_, err = f.notifications.FollowMasjid(ctx, follower, f.masjidID)
+	require.NoError(t, err)
+	pref := entity.DefaultNotificationPreference(uuid.MustParse(follower))
+	pref.ReminderMinutes = 120
+	_, err = f.notifications.UpdatePreference(ctx, pref)
+	require.NoError(t, err)
+	f.member("Zaid")
+
+	// Only the follower, reminded two hours ahead, is due yet.
+	require.NoError(t, f.notifications.Sweep(ctx, now))
+	assert.Equal(t, map[string][]string{"yusuf@example.com": {"Reminder: Tafsir halaqa"}}, f.mail())
+
+	// The attendee is reminded an hour ahead, and nobody twice.
+	require.NoError(t, f.notifications.Sweep(ctx, now.Add(31*time.Minute)))
+	require.NoError(t, f.notifications.Sweep(ctx, now.Add(40*time.Minute)))
+	assert.Equal(t, map[string][]string{
+		"yusuf@example.com": {"Reminder: Tafsir halaqa"},
+		"bilal@example.com": {"Reminder: Tafsir halaqa"},
+	}, f.mail())
+
+	sent := f.email.Sent()
+	assert.Equal(t, event.ID.String(), sent[0].Data["event_id"])
+	assert.Equal(t, "Tafsir halaqa at Masjid Al-Noor starts "+
+		event.StartTime.In(mustLoadLocation("America/Toronto")).Format("Mon 2 Jan 2006 at 15:04 MST")+".", sent[0].Body)
+}
+
+func TestReminders_RespectPreferences(t *testing.T) {
+	ctx := context.Background()
+	f := newReminderFixture(t)
+	now := time.Now()
+	event := f.halaqa(t, now.Add(30*time.Minute))
+
+	quiet := f.member("Hamza")
+	_, err := f.svc.Rsvp(ctx, event.ID.String(), quiet)
+	require.NoError(t, err)
+	pref := entity.DefaultNotificationPreference(uuid.MustParse(quiet))
+	pref.Reminders = false
+	_, err = f.notifications.UpdatePreference(ctx, pref)
+	require.NoError(t, err)
+
+	noEmail := f.member("Umar")
+	_, err = f.svc.Rsvp(ctx, event.ID.String(), noEmail)
+	require.NoError(t, err)
+	pref = entity.DefaultNotificationPreference(uuid.MustParse(noEmail))
+	pref.Email = false
+	_, err = f.notifications.UpdatePreference(ctx, pref)
+	require.NoError(t, err)
+
+	require.NoError(t, f.notifications.Sweep(ctx, now))
+	assert.Empty(t, f.mail())
+	for _, n := range f.repo.notifications {
+		assert.Equal(t, noEmail, n.UserId.String(), "members who turned reminders off are not queued any")
+		assert.Equal(t, entity.NotificationSkipped, n.Status, "members reached on no channel are skipped")
+	}
+
+	// Reminders of events that started before they could be sent are
+	// skipped.
+	late := f.member("Khalid")
+	_, err = f.svc.Rsvp(ctx, event.ID.String(), late)
+	require.NoError(t, err)
+	require.NoError(t, f.notifications.QueueReminders(ctx, now))
+	require.NoError(t, f.notifications.SendPending(ctx, now.Add(time.Hour)))
+	assert.Empty(t, f.mail())
+
+	for _, minutes := range []int32{0, 4, 48*60 + 1} {
+		pref.ReminderMinutes = minutes
+		_, err = f.notifications.UpdatePreference(ctx, pref)
+		assert.ErrorIs(t, err, helper.ErrInvalidPreferences, minutes)
+	}
+}
+
+func TestEventChanges_NotifyTheAudience(t *testing.T) {
+	ctx := context.Background()
+	f := newReminderFixture(t)
+	now := time.Now()
+	start := now.Add(3 * time.Hour).Truncate(time.Minute)
+	event := f.halaqa(t, start)
+
+	attendee := f.member("Bilal")
+	_, err := f.svc.Rsvp(ctx, event.ID.String(), attendee)
+	require.NoError(t, err)
+	optedOut := f.member("Hamza")
+	_, err = f.svc.Rsvp(ctx, event.ID.String(), optedOut)
+	require.NoError(t, err)
+	pref := entity.DefaultNotificationPreference(uuid.MustParse(optedOut))
+	pref.Changes = false
+	_, err = f.notifications.UpdatePreference(ctx, pref)
+	require.NoError(t, err)
+
+	// Renaming the event tells nobody; moving it tells those who want to
+	// know.
+	_, err = f.svc.Update(ctx, &entity.Event{ID: event.ID, Name: "Tafsir circle"})
+	require.NoError(t, err)
+	_, err = f.svc.Update(ctx, &entity.Event{ID: event.ID, StartTime: start.Add(30 * time.Minute), EndTime: start.Add(90 * time.Minute)})
+	require.NoError(t, err)
+	require.NoError(t, f.notifications.SendPending(ctx, now))
+	assert.Equal(t, map[string][]string{"bilal@example.com": {"Rescheduled: Tafsir circle"}}, f.mail())
+	assert.Contains(t, f.email.Sent()[0].Body, "has moved from ")
+
+	// The reminder is for the new time.
+	require.NoError(t, f.notifications.Sweep(ctx, start.Add(-20*time.Minute)))
+	assert.Len(t, f.mail()["bilal@example.com"], 2)
+	assert.Contains(t, f.email.Sent()[1].Body, start.Add(30*time.Minute).In(mustLoadLocation("America/Toronto")).Format("15:04"))
+
+	require.NoError(t, f.svc.Delete(ctx, event.ID.String()))
+	require.NoError(t, f.notifications.SendPending(ctx, now))
+	assert.Equal(t, map[string][]string{
+		"bilal@example.com": {"Rescheduled: Tafsir circle", "Reminder: Tafsir circle", "Cancelled: Tafsir circle"},
+		// Turning changes off leaves reminders on.
+		"hamza@example.com": {"Reminder: Tafsir circle"},
+	}, f.mail())
+}
+
+func TestEventChanges_CancellingAnOccurrence(t *testing.T) {
+	ctx := context.Background()
+	f := newReminderFixture(t)
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+	series, err := f.svc.Create(ctx, &entity.Event{
+		ID:           uuid.New(),
+		MasjidId:     f.masjidID,
+		Name:         "Weekly halaqa",
+		StartTime:    start,
+		EndTime:      start.Add(time.Hour),
+		RequiresRsvp: true,
+		Recurrence:   "RRULE:FREQ=WEEKLY;COUNT=4",
+	})
+	require.NoError(t, err)
+	follower := f.member("Yusuf")
+	_, err = f.notifications.FollowMasjid(ctx, follower, f.masjidID)
+	require.NoError(t, err)
+
+	second := start.AddDate(0, 0, 7)
+	require.NoError(t, f.svc.DeleteOccurrence(ctx, series.ID.String(), second, entity.ThisEvent))
+	require.NoError(t, f.notifications.SendPending(ctx, time.Now()))
+	require.Len(t, f.email.Sent(), 1)
+	msg := f.email.Sent()[0]
+	assert.Equal(t, "Cancelled: Weekly halaqa", msg.Subject)
+	assert.Equal(t, entity.OccurrenceID(series.ID, second), msg.Data["event_id"])
+}
+
+func TestPushNotifications_ForgetUnregisteredDevicesAndRetry(t *testing.T) {
+	ctx := context.Background()
+	sender := &failingPushSender{unregistered: []string{"old-phone"}}
+	f := newReminderFixture(t, notification.NewPushChannel(sender))
+	now := time.Now()
+	event := f.halaqa(t, now.Add(30*time.Minute))
+
+	member := f.member("Bilal")
+	_, err := f.svc.Rsvp(ctx, event.ID.String(), member)
+	require.NoError(t, err)
+	pref := entity.DefaultNotificationPreference(uuid.MustParse(member))
+	pref.Email = false
+	_, err = f.notifications.UpdatePreference(ctx, pref)
+	require.NoError(t, err)
+	for _, token := range []string{"new-phone", "old-phone"} {
+		_, err = f.notifications.RegisterPushDevice(ctx, &entity.PushDevice{Token: token, UserId: uuid.MustParse(member), Platform: "Android"})
+		require.NoError(t, err)
+	}
+
+	// A failing push service is retried on later sweeps.
+	sender.down = true
+	require.NoError(t, f.notifications.Sweep(ctx, now))
+	require.Len(t, f.repo.notifications, 1)
+	assert.Equal(t, entity.NotificationPending, f.repo.notifications[0].Status)
+	assert.EqualValues(t, 1, f.repo.notifications[0].Attempts)
+
+	sender.down = false
+	require.NoError(t, f.notifications.Sweep(ctx, now.Add(time.Minute)))
+	assert.Equal(t, entity.NotificationSent, f.repo.notifications[0].Status)
+	assert.Equal(t, []string{"new-phone"}, sender.sent)
+	devices, err := f.repo.ListPushDevices(ctx, member)
+	require.NoError(t, err)
+	require.Len(t, devices, 1)
+	assert.Equal(t, "new-phone", devices[0].Token)
+	assert.Equal(t, "android", devices[0].Platform)
+	assert.Empty(t, f.email.Sent())
+}
+
+func TestFCMSender_RenewsServiceAccountTokens(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	var issued int
+	var auths []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case "/token":
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.PostForm.Get("grant_type"))
+			issued++
+			// Tokens about to expire are renewed before they are used.
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":1}`, issued)
+		case "/v1/projects/masjid-app/messages:send":
+			auths = append(auths, r.Header.Get("Authorization"))
+			var body struct{ Message struct{ Token string } }
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			if body.Message.Token == "gone" {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"error":{"message":"Requested entity was not found."}}`)
+				return
+			}
+			fmt.Fprint(w, `{"name":"projects/masjid-app/messages/1"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer api.Close()
+
+	credentials, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"project_id":     "masjid-app",
+		"client_email":   "push@masjid-app.iam.gserviceaccount.com",
+		"private_key_id": "key-1",
+		"private_key":    string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"token_uri":      api.URL + "/token",
+	})
+	require.NoError(t, err)
+	account, err := notification.ParseServiceAccount(credentials)
+	require.NoError(t, err)
+	_, err = notification.ParseServiceAccount([]byte(`{"project_id":"masjid-app"}`))
+	assert.Error(t, err)
+
+	sender, err := notification.NewFCMSender(notification.FCMConfig{
+		APIURL:      api.URL,
+		ProjectID:   account.ProjectID,
+		TokenSource: account.TokenSource(context.Background(), notification.FCMScope),
+	})
+	require.NoError(t, err)
+	n := &notification.PushNotification{Title: "Weekly halaqa", Body: "Starts at 7:00 PM"}
+	for range 2 {
+		require.NoError(t, sender.SendPush(context.Background(), "device-1", n))
+	}
+	assert.ErrorIs(t, sender.SendPush(context.Background(), "gone", n), notification.ErrUnregistered)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"Bearer token-1", "Bearer token-2", "Bearer token-3"}, auths)
+}
+
+// createFollowedEvent stores a masjid with an event requiring RSVP
+// starting at start, deleted with their RSVPs, follows and notifications
+// when the test ends.
+func (suite *DatabaseGrpcHandlerTestSuite) createFollowedEvent(start time.Time) *entity.Event {
+	masjid := &entity.Masjid{ID: uuid.New(), Name: "Masjid Al-Noor", TimeZone: "America/Toronto"}
+	require.NoError(suite.T(), suite.DB.Create(masjid).Error)
+	event, err := suite.EventService.Create(context.Background(), &entity.Event{
+		MasjidId:        masjid.ID.String(),
+		Name:            "Tafsir halaqa",
+		StartTime:       start,
+		EndTime:         start.Add(time.Hour),
+		RequiresRsvp:    true,
+		MaxParticipants: 50,
+	})
+	require.NoError(suite.T(), err)
+	suite.T().Cleanup(func() {
+		suite.DB.Delete(&entity.EventNotification{}, "event_id = ?", event.PublicID())
+		suite.DB.Delete(&entity.MasjidFollow{}, "masjid_id = ?", masjid.ID.String())
+		suite.DB.Delete(&entity.EventRsvp{}, "event_id = ?", event.ID)
+		suite.DB.Delete(&entity.Event{}, "id = ?", event.ID)
+		suite.DB.Delete(&entity.Masjid{}, "id = ?", masjid.ID)
+	})
+	return event
+}
+
+// concurrently runs f n times at once, returning the error of each run.
+func concurrently(n int, f func(i int) error) []error {
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = f(i)
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) TestQueueReminders_ConcurrentSweepsQueueOnce() {
+	ctx := context.Background()
+	now := time.Now()
+	event := suite.createFollowedEvent(now.Add(30 * time.Minute).Truncate(time.Second))
+
+	// The attendee also follows the masjid, and is reminded once all the
+	// same.
+	attendee, _ := suite.createMember("bilal")
+	_, err := suite.EventService.Rsvp(ctx, event.ID.String(), attendee.ID.String())
+	require.NoError(suite.T(), err)
+	follower, _ := suite.createMember("yusuf")
+	for _, user := range []*entity.User{attendee, follower} {
+		_, err := suite.NotificationService.FollowMasjid(ctx, user.ID.String(), event.MasjidId)
+		require.NoError(suite.T(), err)
+	}
+
+	for _, err := range concurrently(5, func(int) error {
+		return suite.NotificationService.QueueReminders(ctx, now)
+	}) {
+		require.NoError(suite.T(), err)
+	}
+
+	var queued []entity.EventNotification
+	require.NoError(suite.T(), suite.DB.Where("event_id = ?", event.PublicID()).Find(&queued).Error)
+	require.Len(suite.T(), queued, 2, "each member is reminded once however many sweeps run at once")
+	var users []uuid.UUID
+	for _, n := range queued {
+		assert.Equal(suite.T(), entity.EventReminder, n.Kind)
+		users = append(users, n.UserId)
+	}
+	assert.ElementsMatch(suite.T(), []uuid.UUID{attendee.ID, follower.ID}, users)
+
+	require.NoError(suite.T(), suite.NotificationService.SendPending(ctx, now))
+	mail := map[string][]string{}
+	for _, msg := range suite.Email.Sent() {
+		if msg.Email == attendee.Email || msg.Email == follower.Email {
+			mail[msg.Email] = append(mail[msg.Email], msg.Subject)
+		}
+	}
+	assert.Equal(suite.T(), map[string][]string{
+		attendee.Email: {"Reminder: Tafsir halaqa"},
+		follower.Email: {"Reminder: Tafsir halaqa"},
+	}, mail)
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) TestFollowMasjid_ConcurrentFollowsKeepOne() {
+	ctx := context.Background()
+	event := suite.createFollowedEvent(time.Now().Add(24 * time.Hour).Truncate(time.Second))
+	user, _ := suite.createMember("yusuf")
+
+	follows := make([]*entity.MasjidFollow, 5)
+	for _, err := range concurrently(len(follows), func(i int) error {
+		var err error
+		follows[i], err = suite.NotificationService.FollowMasjid(ctx, user.ID.String(), event.MasjidId)
+		return err
+	}) {
+		require.NoError(suite.T(), err)
+	}
+
+	var stored []entity.MasjidFollow
+	require.NoError(suite.T(), suite.DB.Where("user_id = ?", user.ID).Find(&stored).Error)
+	require.Len(suite.T(), stored, 1)
+	for _, follow := range follows {
+		assert.True(suite.T(), stored[0].CreatedAt.Equal(follow.CreatedAt), "following again returns the earlier follow")
+	}
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) TestRegisterPushDevice_ConcurrentRegistrationsOfOneToken() {
+	ctx := context.Background()
+	token := "device-" + uuid.NewString()
+	suite.T().Cleanup(func() {
+		suite.DB.Delete(&entity.PushDevice{}, "token = ?", token)
+	})
+	members := make([]*entity.User, 4)
+	for i := range members {
+		members[i], _ = suite.createMember(fmt.Sprintf("member%d", i))
+	}
+
+	for _, err := range concurrently(len(members), func(i int) error {
+		_, err := suite.NotificationService.RegisterPushDevice(ctx, &entity.PushDevice{
+			Token:    token,
+			UserId:   members[i].ID,
+			Platform: "android",
+		})
+		return err
+	}) {
+		require.NoError(suite.T(), err)
+	}
+
+	var devices []entity.PushDevice
+	require.NoError(suite.T(), suite.DB.Where("token = ?", token).Find(&devices).Error)
+	require.Len(suite.T(), devices, 1, "a token belongs to one member")
+	owners := 0
+	for _, member := range members {
+		listed, err := suite.NotificationService.Repo.ListPushDevices(ctx, member.ID.String())
+		require.NoError(suite.T(), err)
+		owners += len(listed)
+	}
+	assert.Equal(suite.T(), 1, owners)
+}
@@ -0,0 +1,498 @@
+package test
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/services"
+	"github.com/mnadev/limestone/test/mocks"
+)
+
+// memoryEventRepo keeps events and RSVPs in memory. A single mutex stands
+// in for the event lock of the Gorm repository. Room bookings are kept in
+// rooms when it is set.
+type memoryEventRepo struct {
+	mu       sync.Mutex
+	events   map[uuid.UUID]entity.Event
+	rsvps    []*entity.EventRsvp
+	users    map[uuid.UUID]*entity.User
+	checkIns []entity.EventCheckIn
+	rooms    *memoryRoomRepo
+}
+
+func newMemoryEventRepo() *memoryEventRepo {
+	return &memoryEventRepo{events: map[uuid.UUID]entity.Event{}, users: map[uuid.UUID]*entity.User{}}
+}
+
+func (r *memoryEventRepo) Create(ctx context.Context, event *entity.Event) (*entity.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.rooms != nil {
+		if event.RecurringEventId != nil && event.OriginalStartTime != nil {
+			r.rooms.release(*event.RecurringEventId, event.OriginalStartTime.Equal)
+		}
+		if err := r.rooms.replace(event, event.Bookings); err != nil {
+			return nil, err
+		}
+	}
+	r.events[event.ID] = *event
+	return event, nil
+}
+
+func (r *memoryEventRepo) Update(ctx context.Context, event *entity.Event) (*entity.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.events[event.ID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	if event.Name != "" {
+		stored.Name = event.Name
+	}
+	if !event.StartTime.IsZero() {
+		stored.StartTime = event.StartTime
+	}
+	if !event.EndTime.IsZero() {
+		stored.EndTime = event.EndTime
+	}
+	if event.MaxParticipants != 0 {
+		stored.MaxParticipants = event.MaxParticipants
+	}
+	if event.Recurrence != "" {
+		stored.Recurrence = event.Recurrence
+	}
+	if event.Types != nil {
+		stored.Types = event.Types
+	}
+	if event.Bookings != nil && r.rooms != nil {
+		if err := r.rooms.replace(&stored, event.Bookings); err != nil {
+			return nil, err
+		}
+		stored.Bookings = event.Bookings
+	}
+	r.events[event.ID] = stored
+	return &stored, nil
+}
+
+func (r *memoryEventRepo) GetByID(ctx context.Context, id string) (*entity.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	event, ok := r.events[uuid.MustParse(id)]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &event, nil
+}
+
+func (r *memoryEventRepo) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, event := range r.events {
+		if event.RecurringEventId != nil && event.RecurringEventId.String() == id {
+			delete(r.events, event.ID)
+		}
+	}
+	delete(r.events, uuid.MustParse(id))
+	return nil
+}
+
+func (r *memoryEventRepo) ListEvents(ctx context.Context, params *entity.ListEventsQueryParams) ([]*entity.Event, error) {
+	return nil, nil
+}
+
+func (r *memoryEventRepo) CountEvents(ctx context.Context, params *entity.ListEventsQueryParams) (int64, error) {
+	return 0, nil
+}
+
+func (r *memoryEventRepo) find(eventID, userID uuid.UUID) *entity.EventRsvp {
+	for _, rsvp := range r.rsvps {
+		if rsvp.EventId == eventID && rsvp.UserId == userID {
+			return rsvp
+		}
+	}
+	return nil
+}
+
+func (r *memoryEventRepo) confirmed(eventID uuid.UUID) int64 {
+	var n int64
+	for _, rsvp := range r.rsvps {
+		if rsvp.EventId == eventID && rsvp.Status == entity.RsvpConfirmed {
+			n++
+		}
+	}
+	return n
+}
+
+// ordered returns an event's RSVPs as the Gorm repository orders them.
+func (r *memoryEventRepo) ordered(eventID uuid.UUID) []*entity.EventRsvp {
+	var rsvps []*entity.EventRsvp
+	for _, rsvp := range r.rsvps {
+		if rsvp.EventId == eventID {
+			rsvps = append(rsvps, rsvp)
+		}
+	}
+	sort.SliceStable(rsvps, func(i, j int) bool {
+		if rsvps[i].Status != rsvps[j].Status {
+			return rsvps[i].Status < rsvps[j].Status
+		}
+		return rsvps[i].RequestedAt.Before(rsvps[j].RequestedAt)
+	})
+	return rsvps
+}
+
+func (r *memoryEventRepo) withPosition(rsvp *entity.EventRsvp) entity.EventRsvp {
+	out := *rsvp
+	out.WaitlistPosition = 0
+	if rsvp.Status == entity.RsvpWaitlisted {
+		for _, other := range r.ordered(rsvp.EventId) {
+			if other.Status == entity.RsvpWaitlisted {
+				out.WaitlistPosition++
+			}
+			if other == rsvp {
+				break
+			}
+		}
+	}
+	return out
+}
+
+func (r *memoryEventRepo) promote(event entity.Event) {
+	places := event.PlacesLeft(r.confirmed(event.ID))
+	for _, rsvp := range r.ordered(event.ID) {
+		if places == 0 {
+			return
+		}
+		if rsvp.Status == entity.RsvpWaitlisted {
+			rsvp.Status = entity.RsvpConfirmed
+			places--
+		}
+	}
+}
+
+func (r *memoryEventRepo) CreateRsvp(ctx context.Context, rsvp *entity.EventRsvp) (*entity.EventRsvp, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	event, ok := r.events[rsvp.EventId]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	stored := r.find(rsvp.EventId, rsvp.UserId)
+	if stored != nil && stored.Status != entity.RsvpCancelled {
+		out := r.withPosition(stored)
+		return &out, nil
+	}
+	if stored == nil {
+		stored = &entity.EventRsvp{ID: rsvp.ID, EventId: rsvp.EventId, UserId: rsvp.UserId, CreatedAt: rsvp.CreatedAt}
+		r.rsvps = append(r.rsvps, stored)
+	}
+	stored.RequestedAt = rsvp.RequestedAt
+	stored.Status = entity.RsvpWaitlisted
+	if event.PlacesLeft(r.confirmed(event.ID)) != 0 {
+		stored.Status = entity.RsvpConfirmed
+	}
+	out := r.withPosition(stored)
+	return &out, nil
+}
+
+func (r *memoryEventRepo) CancelRsvp(ctx context.Context, eventID, userID string) (*entity.EventRsvp, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	event, ok := r.events[uuid.MustParse(eventID)]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	stored := r.find(event.ID, uuid.MustParse(userID))
+	if stored == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	if stored.Status != entity.RsvpCancelled {
+		stored.Status = entity.RsvpCancelled
+		r.promote(event)
+	}
+	out := *stored
+	return &out, nil
+}
+
+func (r *memoryEventRepo) PromoteWaitlist(ctx context.Context, eventID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.promote(r.events[uuid.MustParse(eventID)])
+	return nil
+}
+
+func (r *memoryEventRepo) ListRsvps(ctx context.Context, eventID string, status entity.RsvpStatus, offset, limit int) ([]entity.EventRsvp, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var rsvps []entity.EventRsvp
+	for _, rsvp := range r.ordered(uuid.MustParse(eventID)) {
+		if status == entity.RsvpStatusUnspecified && rsvp.Status == entity.RsvpCancelled ||
+			status != entity.RsvpStatusUnspecified && rsvp.Status != status {
+			continue
+		}
+		out := r.withPosition(rsvp)
+		out.User = r.users[rsvp.UserId]
+		rsvps = append(rsvps, out)
+	}
+	if offset > len(rsvps) {
+		return nil, nil
+	}
+	rsvps = rsvps[offset:]
+	if len(rsvps) > limit {
+		rsvps = rsvps[:limit]
+	}
+	return rsvps, nil
+}
+
+func (r *memoryEventRepo) ListUserRsvps(ctx context.Context, userID string, includeCancelled bool) ([]entity.EventRsvp, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var rsvps []entity.EventRsvp
+	for _, rsvp := range r.rsvps {
+		if rsvp.UserId.String() != userID || rsvp.Status == entity.RsvpCancelled && !includeCancelled {
+			continue
+		}
+		out := r.withPosition(rsvp)
+		event := r.events[rsvp.EventId]
+		out.Event = &event
+		rsvps = append(rsvps, out)
+	}
+	sort.SliceStable(rsvps, func(i, j int) bool {
+		return rsvps[i].Event.StartTime.Before(rsvps[j].Event.StartTime)
+	})
+	return rsvps, nil
+}
+
+type rsvpFixture struct {
+	svc   *services.EventService
+	repo  *memoryEventRepo
+	users *mocks.MockUserRepository
+}
+
+func newRsvpFixture() *rsvpFixture {
+	repo := newMemoryEventRepo()
+	users := new(mocks.MockUserRepository)
+	return &rsvpFixture{svc: services.NewEventService(repo, nil, users, nil, nil), repo: repo, users: users}
+}
+
+func (f *rsvpFixture) event(t *testing.T, max int32, restriction entity.GenderRestriction) *entity.Event {
+	start := time.Now().Add(24 * time.Hour)
+	event, err := f.repo.Create(context.Background(), &entity.Event{
+		ID:                uuid.New(),
+		Name:              "Weekly halaqa",
+		StartTime:         start,
+		EndTime:           start.Add(time.Hour),
+		RequiresRsvp:      true,
+		MaxParticipants:   max,
+		GenderRestriction: restriction,
+	})
+	require.NoError(t, err)
+	return event
+}
+
+func (f *rsvpFixture) user(gender entity.Gender, name string) string {
+	user := &entity.User{ID: uuid.New(), FirstName: name, Gender: gender}
+	f.repo.users[user.ID] = user
+	f.users.On("GetByID", mock.Anything, user.ID.String()).Return(user, nil)
+	return user.ID.String()
+}
+
+func TestRsvp_ConfirmsUntilFullThenWaitlists(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	event := f.event(t, 2, entity.NO_RESTRICTION)
+	eventID := event.ID.String()
+
+	var users []string
+	for i := 0; i < 4; i++ {
+		users = append(users, f.user(entity.Male, "user"+strconv.Itoa(i)))
+	}
+	want := []struct {
+		status   entity.RsvpStatus
+		position int
+	}{
+		{entity.RsvpConfirmed, 0},
+		{entity.RsvpConfirmed, 0},
+		{entity.RsvpWaitlisted, 1},
+		{entity.RsvpWaitlisted, 2},
+	}
+	for i, user := range users {
+		rsvp, err := f.svc.Rsvp(ctx, eventID, user)
+		require.NoError(t, err)
+		assert.Equal(t, want[i].status, rsvp.Status, "user %d", i)
+		assert.Equal(t, want[i].position, rsvp.WaitlistPosition, "user %d", i)
+	}
+
+	// RSVPing twice keeps the place the user has.
+	again, err := f.svc.Rsvp(ctx, eventID, users[2])
+	require.NoError(t, err)
+	assert.Equal(t, entity.RsvpWaitlisted, again.Status)
+	assert.Equal(t, 1, again.WaitlistPosition)
+
+	// A cancellation confirms the head of the waitlist.
+	cancelled, err := f.svc.CancelRsvp(ctx, eventID, users[0])
+	require.NoError(t, err)
+	assert.Equal(t, entity.RsvpCancelled, cancelled.Status)
+	attendees, next, err := f.svc.ListEventAttendees(ctx, eventID, entity.RsvpStatusUnspecified, 0, "")
+	require.NoError(t, err)
+	assert.Empty(t, next)
+	require.Len(t, attendees, 3)
+	assert.Equal(t, "user1", attendees[0].User.FirstName)
+	assert.Equal(t, "user2", attendees[1].User.FirstName)
+	assert.Equal(t, entity.RsvpConfirmed, attendees[1].Status)
+	assert.Equal(t, "user3", attendees[2].User.FirstName)
+	assert.Equal(t, 1, attendees[2].WaitlistPosition)
+
+	// Coming back after cancelling joins the back of the waitlist.
+	renewed, err := f.svc.Rsvp(ctx, eventID, users[0])
+	require.NoError(t, err)
+	assert.Equal(t, entity.RsvpWaitlisted, renewed.Status)
+	assert.Equal(t, 2, renewed.WaitlistPosition)
+
+	// Raising the capacity confirms the waitlist into the new places.
+	_, err = f.svc.Update(ctx, &entity.Event{ID: event.ID, MaxParticipants: 4})
+	require.NoError(t, err)
+	confirmed, _, err := f.svc.ListEventAttendees(ctx, eventID, entity.RsvpConfirmed, 0, "")
+	require.NoError(t, err)
+	assert.Len(t, confirmed, 4)
+}
+
+func TestRsvp_ConcurrentRegistrationsNeverOverbook(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	event := f.event(t, 5, entity.NO_RESTRICTION)
+
+	var users []string
+	for i := 0; i < 20; i++ {
+		users = append(users, f.user(entity.Female, "user"+strconv.Itoa(i)))
+	}
+	var wg sync.WaitGroup
+	for _, user := range users {
+		wg.Add(1)
+		go func(user string) {
+			defer wg.Done()
+			_, err := f.svc.Rsvp(ctx, event.ID.String(), user)
+			assert.NoError(t, err)
+		}(user)
+	}
+	wg.Wait()
+
+	confirmed, _, err := f.svc.ListEventAttendees(ctx, event.ID.String(), entity.RsvpConfirmed, 0, "")
+	require.NoError(t, err)
+	assert.Len(t, confirmed, 5)
+	waitlisted, _, err := f.svc.ListEventAttendees(ctx, event.ID.String(), entity.RsvpWaitlisted, 0, "")
+	require.NoError(t, err)
+	assert.Len(t, waitlisted, 15)
+}
+
+func TestRsvp_EnforcesGenderRestriction(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	sisters := f.event(t, 0, entity.FEMALE_ONLY)
+
+	_, err := f.svc.Rsvp(ctx, sisters.ID.String(), f.user(entity.Male, "brother"))
+	assert.ErrorIs(t, err, helper.ErrGenderRestricted)
+	_, err = f.svc.Rsvp(ctx, sisters.ID.String(), f.user(entity.GenderUnspecified, "unknown"))
+	assert.ErrorIs(t, err, helper.ErrGenderNotSet)
+
+	rsvp, err := f.svc.Rsvp(ctx, sisters.ID.String(), f.user(entity.Female, "sister"))
+	require.NoError(t, err)
+	assert.Equal(t, entity.RsvpConfirmed, rsvp.Status)
+
+	open := f.event(t, 0, entity.NO_RESTRICTION)
+	_, err = f.svc.Rsvp(ctx, open.ID.String(), f.user(entity.GenderUnspecified, "anyone"))
+	assert.NoError(t, err)
+}
+
+func TestRsvp_RejectsEventsWithoutRsvpAndPastEvents(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	user := f.user(entity.Male, "user")
+
+	dropIn := f.event(t, 0, entity.NO_RESTRICTION)
+	dropIn.RequiresRsvp = false
+	f.repo.events[dropIn.ID] = *dropIn
+	_, err := f.svc.Rsvp(ctx, dropIn.ID.String(), user)
+	assert.ErrorIs(t, err, helper.ErrRsvpNotRequired)
+
+	past := f.event(t, 0, entity.NO_RESTRICTION)
+	past.EndTime = time.Now().Add(-time.Hour)
+	f.repo.events[past.ID] = *past
+	_, err = f.svc.Rsvp(ctx, past.ID.String(), user)
+	assert.ErrorIs(t, err, helper.ErrEventEnded)
+
+	_, err = f.svc.CancelRsvp(ctx, past.ID.String(), user)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestListEventAttendees_Pages(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	event := f.event(t, 0, entity.NO_RESTRICTION)
+	for i := 0; i < 5; i++ {
+		_, err := f.svc.Rsvp(ctx, event.ID.String(), f.user(entity.Male, "user"+strconv.Itoa(i)))
+		require.NoError(t, err)
+	}
+
+	var names []string
+	token := ""
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 3)
+		page, next, err := f.svc.ListEventAttendees(ctx, event.ID.String(), entity.RsvpStatusUnspecified, 2, token)
+		require.NoError(t, err)
+		for _, rsvp := range page {
+			names = append(names, rsvp.User.FirstName)
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	assert.Equal(t, []string{"user0", "user1", "user2", "user3", "user4"}, names)
+
+	_, _, err := f.svc.ListEventAttendees(ctx, event.ID.String(), entity.RsvpStatusUnspecified, 2, "x")
+	assert.ErrorIs(t, err, helper.ErrInvalidPageToken)
+}
+
+func TestGetMyRsvps(t *testing.T) {
+	ctx := context.Background()
+	f := newRsvpFixture()
+	user := f.user(entity.Female, "user")
+	later := f.event(t, 0, entity.NO_RESTRICTION)
+	sooner := f.event(t, 0, entity.NO_RESTRICTION)
+	sooner.StartTime = later.StartTime.Add(-time.Hour)
+	f.repo.events[sooner.ID] = *sooner
+
+	for _, event := range []*entity.Event{later, sooner} {
+		_, err := f.svc.Rsvp(ctx, event.ID.String(), user)
+		require.NoError(t, err)
+	}
+	_, err := f.svc.CancelRsvp(ctx, later.ID.String(), user)
+	require.NoError(t, err)
+
+	rsvps, err := f.svc.GetMyRsvps(ctx, user, false)
+	require.NoError(t, err)
+	require.Len(t, rsvps, 1)
+	assert.Equal(t, sooner.ID, rsvps[0].Event.ID)
+	assert.NotZero(t, rsvps[0].Event.HijriStart.Year)
+
+	rsvps, err = f.svc.GetMyRsvps(ctx, user, true)
+	require.NoError(t, err)
+	require.Len(t, rsvps, 2)
+	assert.Equal(t, sooner.ID, rsvps[0].Event.ID)
+	assert.Equal(t, entity.RsvpCancelled, rsvps[1].Status)
+
+	proto := helper.ToProtoRsvp(&rsvps[0])
+	assert.Equal(t, sooner.ID.String(), proto.GetEvent().GetId())
+}
@@ -0,0 +1,60 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mnadev/limestone/internal/application/domain/geo"
+)
+
+func TestDistanceKm(t *testing.T) {
+	london := geo.Coordinates{Latitude: 51.5074, Longitude: -0.1278}
+	paris := geo.Coordinates{Latitude: 48.8566, Longitude: 2.3522}
+
+	assert.InDelta(t, 343.5, geo.DistanceKm(london, paris), 1)
+	assert.InDelta(t, geo.DistanceKm(london, paris), geo.DistanceKm(paris, london), 1e-9)
+	assert.Zero(t, geo.DistanceKm(london, london))
+}
+
+func TestBoundingBox(t *testing.T) {
+	toronto := geo.Coordinates{Latitude: 43.6532, Longitude: -79.3832}
+
+	minLat, maxLat, minLng, maxLng, wraps := geo.BoundingBox(toronto, 25)
+	assert.False(t, wraps)
+	assert.Less(t, minLat, toronto.Latitude)
+	assert.Greater(t, maxLat, toronto.Latitude)
+	assert.Less(t, minLng, toronto.Longitude)
+	assert.Greater(t, maxLng, toronto.Longitude)
+
+	edge := geo.Coordinates{Latitude: toronto.Latitude, Longitude: maxLng}
+	assert.InDelta(t, 25, geo.DistanceKm(geo.Coordinates{Latitude: toronto.Latitude, Longitude: toronto.Longitude}, edge), 0.5)
+
+	_, _, _, _, wraps = geo.BoundingBox(geo.Coordinates{Latitude: 0, Longitude: 179.9}, 50)
+	assert.True(t, wraps)
+}
+
+func TestQiblaFrom(t *testing.T) {
+	cases := []struct {
+		name     string
+		point    geo.Coordinates
+		bearing  float64
+		distance float64
+	}{
+		{"New York", geo.Coordinates{Latitude: 40.7128, Longitude: -74.0060}, 58.48, 10300},
+		{"London", geo.Coordinates{Latitude: 51.5074, Longitude: -0.1278}, 118.99, 4790},
+		{"Jakarta", geo.Coordinates{Latitude: -6.2088, Longitude: 106.8456}, 295.15, 7920},
+		{"Madinah", geo.Coordinates{Latitude: 24.4686, Longitude: 39.6142}, 176.3, 339},
+	}
+	for _, c := range cases {
+		q := geo.QiblaFrom(c.point)
+		assert.InDelta(t, c.bearing, q.BearingDegrees, 0.1, c.name)
+		assert.InDelta(t, c.distance, q.DistanceKm, c.distance*0.01, c.name)
+	}
+}
+
+func TestQiblaFrom_AtKaaba(t *testing.T) {
+	q := geo.QiblaFrom(geo.Kaaba)
+	assert.Equal(t, 0.0, q.BearingDegrees)
+	assert.Equal(t, 0.0, q.DistanceKm)
+}
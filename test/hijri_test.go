@@ -0,0 +1,72 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mnadev/limestone/internal/application/domain/hijri"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHijriFromGregorian(t *testing.T) {
+	d, err := hijri.FromGregorian(time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC), 0)
+	require.NoError(t, err)
+	assert.Equal(t, hijri.Date{Year: 1445, Month: 9, Day: 1}, d)
+	assert.Equal(t, "1 Ramadan 1445", d.Long())
+	assert.Equal(t, "1445-09-01", d.String())
+}
+
+func TestHijriFromGregorian_Offset(t *testing.T) {
+	d, err := hijri.FromGregorian(time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC), -1)
+	require.NoError(t, err)
+	assert.Equal(t, hijri.Date{Year: 1445, Month: 8, Day: 29}, d)
+
+	_, err = hijri.FromGregorian(time.Now(), 3)
+	assert.ErrorIs(t, err, hijri.ErrInvalidOffset)
+}
+
+func TestHijriFromGregorian_OutOfRange(t *testing.T) {
+	_, err := hijri.FromGregorian(time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC), 0)
+	assert.ErrorIs(t, err, hijri.ErrOutOfRange)
+	_, err = hijri.FromGregorian(time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC), 0)
+	assert.ErrorIs(t, err, hijri.ErrOutOfRange)
+}
+
+func TestHijriRoundTrip(t *testing.T) {
+	day := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 3*366; i++ {
+		date := day.AddDate(0, 0, i)
+		for _, offset := range []int{-2, 0, 2} {
+			h, err := hijri.FromGregorian(date, offset)
+			require.NoError(t, err)
+			back, err := hijri.ToGregorian(h, offset)
+			require.NoError(t, err)
+			require.True(t, back.Equal(date), "%s -> %s -> %s", date.Format("2006-01-02"), h, back.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestHijriToGregorian_InvalidDate(t *testing.T) {
+	_, err := hijri.ToGregorian(hijri.Date{Year: 1445, Month: 13, Day: 1}, 0)
+	assert.ErrorIs(t, err, hijri.ErrInvalidDate)
+	_, err = hijri.ToGregorian(hijri.Date{Year: 1445, Month: 9, Day: 31}, 0)
+	assert.ErrorIs(t, err, hijri.ErrInvalidDate)
+}
+
+func TestHijriMonthRange(t *testing.T) {
+	start, end, err := hijri.MonthRange(1448, hijri.Ramadan, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "2027-02-08", start.Format("2006-01-02"))
+	assert.Equal(t, "2027-03-09", end.Format("2006-01-02"))
+
+	start, end, err = hijri.MonthRange(1445, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "2023-07-19", start.Format("2006-01-02"))
+	assert.Equal(t, "2024-07-07", end.Format("2006-01-02"))
+
+	// A masjid running a day behind starts and ends Ramadan a day later.
+	start, _, err = hijri.MonthRange(1448, hijri.Ramadan, -1)
+	require.NoError(t, err)
+	assert.Equal(t, "2027-02-09", start.Format("2006-01-02"))
+}
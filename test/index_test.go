@@ -1,87 +1,111 @@
 package test
 
-//
-//import (
-//	"github.com/mnadev/limestone/internal/application/domain/entity"
-//	"testing"
-//
-//	"github.com/lpernett/godotenv"
-//	"github.com/stretchr/testify/require"
-//	"github.com/stretchr/testify/suite"
-//	"gorm.io/gorm"
-//
-//	"github.com/mnadev/limestone/internal/application/handler"
-//	"github.com/mnadev/limestone/internal/application/services"
-//	"github.com/mnadev/limestone/internal/infrastructure/database"
-//	"github.com/mnadev/limestone/internal/infrastructure/storage"
-//)
-//
-//type GrpcHandlerTestSuite struct {
-//	suite.Suite
-//	DB            *gorm.DB
-//	UserHandler   *handler.UserGrpcHandler
-//	UserService   *services.UserService
-//	AuthService   *services.AuthService
-//	AuthHandler   *handler.AuthGrpcHandler
-//	AdhanService  *services.AdhanService
-//	AdhanHandler  *handler.AdhanGrpcHandler
-//	MasjidService *services.MasjidService
-//	MasjidHandler *handler.MasjidGrpcHandler
-//	EventService  *services.EventService
-//	EventHandler  *handler.EventGrpcHandler
-//	NikkahService *services.NikkahService
-//	NikkahHandler *handler.NikkahIoGrpcHandler
-//}
-//
-//func (suite *GrpcHandlerTestSuite) SetupSuite() {
-//	err := godotenv.Load("../.env")
-//	require.NoError(suite.T(), err, "Failed to load .env file")
-//
-//	suite.DB = database.SetupDatabaseTesting()
-//	require.NotNil(suite.T(), suite.DB, "Failed to setup test database")
-//
-//	//user service
-//	userRepo := storage.NewGormUserRepository(suite.DB)
-//	suite.UserService = services.NewUserService(userRepo)
-//	suite.UserHandler = handler.NewUserGrpcHandler(suite.UserService)
-//
-//	//auth service
-//	authService := services.NewAuthService(userRepo)
-//	suite.AuthService = authService
-//	suite.AuthHandler = handler.NewAuthGrpcHandler(suite.AuthService)
-//
-//	//adhan service
-//	adhanRepo := storage.NewGormAdhanRepository(suite.DB)
-//	suite.AdhanService = services.NewAdhanService(adhanRepo)
-//	suite.AdhanHandler = handler.NewAdhanGrpcHandler(suite.AdhanService)
-//
-//	//masjid service
-//	masjidrepo := storage.NewGormMasjidRepository(suite.DB)
-//	suite.MasjidService = services.NewMasjidService(masjidrepo)
-//	suite.MasjidHandler = handler.NewMasjidGrpcHandler(suite.MasjidService)
-//
-//	//event service
-//	eventRepo := storage.NewGormEventRepository(suite.DB)
-//	suite.EventService = services.NewEventService(eventRepo)
-//	suite.EventHandler = handler.NewEventGrpcHandler(suite.EventService)
-//
-//	nikkahRepo := storage.NewGormNikkahRepository(suite.DB)
-//	suite.NikkahService = services.NewNikkahService(nikkahRepo)
-//	suite.NikkahHandler = handler.NewNikkahIoGrpcHandler(suite.NikkahService)
-//}
-//
-//func (suite *GrpcHandlerTestSuite) TearDownSuite() {
-//	if suite.DB != nil {
-//		err := suite.DB.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(&entity.User{}).Error
-//		require.NoError(suite.T(), err, "Failed to clean up users table")
-//
-//		db, err := suite.DB.DB()
-//		require.NoError(suite.T(), err, "Failed to get underlying DB connection")
-//		err = db.Close()
-//		require.NoError(suite.T(), err, "Failed to close database connection")
-//	}
-//}
-//
-//func Test(t *testing.T) {
-//	suite.Run(t, new(GrpcHandlerTestSuite))
-//}
+import (
+	"os"
+	"testing"
+
+	"github.com/lpernett/godotenv"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/handler"
+	"github.com/mnadev/limestone/internal/application/services"
+	"github.com/mnadev/limestone/internal/infrastructure/database"
+	"github.com/mnadev/limestone/internal/infrastructure/notification"
+	"github.com/mnadev/limestone/internal/infrastructure/payment"
+	"github.com/mnadev/limestone/internal/infrastructure/storage"
+)
+
+// DatabaseGrpcHandlerTestSuite runs the gRPC handlers against the test
+// database named by TEST_DB_NAME, read from the environment or ../.env.
+// It is skipped when no test database is configured.
+type DatabaseGrpcHandlerTestSuite struct {
+	suite.Suite
+	DB                  *gorm.DB
+	UserHandler         *handler.UserGrpcHandler
+	UserService         *services.UserService
+	AuthService         *services.AuthService
+	AuthHandler         *handler.AuthGrpcHandler
+	MasjidService       *services.MasjidService
+	MasjidHandler       *handler.MasjidGrpcHandler
+	EventService        *services.EventService
+	EventHandler        *handler.EventGrpcHandler
+	FeedService         *services.CalendarFeedService
+	OrderService        *services.OrderService
+	Payments            *payment.FakeProvider
+	VolunteerService    *services.VolunteerService
+	VolunteerHandler    *handler.VolunteerGrpcHandler
+	RoomService         *services.RoomService
+	RoomHandler         *handler.RoomGrpcHandler
+	Email               *notification.LogChannel
+	NotificationService *services.NotificationService
+	NikkahService       *services.NikkahService
+	NikkahHandler       *handler.NikkahIoGrpcHandler
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) SetupSuite() {
+	_ = godotenv.Load("../.env")
+	if os.Getenv("TEST_DB_NAME") == "" {
+		suite.T().Skip("TEST_DB_NAME is not set")
+	}
+
+	suite.DB = database.SetupDatabaseTesting()
+	require.NotNil(suite.T(), suite.DB, "Failed to setup test database")
+
+	//user service
+	userRepo := storage.NewGormUserRepository(suite.DB)
+	suite.UserService = services.NewUserService(userRepo)
+	suite.UserHandler = handler.NewUserGrpcHandler(suite.UserService)
+
+	//auth service
+	suite.AuthService = services.NewAuthService(userRepo)
+	suite.AuthHandler = handler.NewAuthGrpcHandler(suite.AuthService)
+
+	//masjid service
+	masjidRepo := storage.NewGormMasjidRepository(suite.DB)
+	suite.MasjidService = services.NewMasjidService(masjidRepo)
+	suite.MasjidHandler = handler.NewMasjidGrpcHandler(suite.MasjidService)
+
+	//event service
+	eventRepo := storage.NewGormEventRepository(suite.DB)
+	roomRepo := storage.NewGormRoomRepository(suite.DB)
+	suite.EventService = services.NewEventService(eventRepo, masjidRepo, userRepo, roomRepo, nil)
+	suite.FeedService = services.NewCalendarFeedService(storage.NewGormCalendarFeedRepository(suite.DB), suite.EventService, "")
+	suite.Payments = payment.NewFakeProvider("fake-secret")
+	suite.OrderService = services.NewOrderService(storage.NewGormOrderRepository(suite.DB), suite.EventService, suite.Payments, "")
+	suite.EventHandler = handler.NewEventGrpcHandler(suite.EventService, suite.FeedService, suite.OrderService)
+
+	//volunteer service
+	suite.VolunteerService = services.NewVolunteerService(storage.NewGormVolunteerRepository(suite.DB), eventRepo, masjidRepo)
+	suite.VolunteerHandler = handler.NewVolunteerGrpcHandler(suite.VolunteerService)
+
+	//room service
+	suite.RoomService = services.NewRoomService(roomRepo, masjidRepo)
+	suite.RoomHandler = handler.NewRoomGrpcHandler(suite.RoomService)
+
+	//notification service
+	suite.Email = notification.NewLogChannel(notification.Email)
+	suite.NotificationService = services.NewNotificationService(storage.NewGormNotificationRepository(suite.DB), masjidRepo, userRepo, suite.EventService, []notification.Channel{suite.Email})
+
+	//nikkah service
+	suite.NikkahService = services.NewNikkahService(storage.NewGormNikkahRepository(suite.DB))
+	suite.NikkahHandler = handler.NewNikkahIoGrpcHandler(suite.NikkahService)
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) TearDownSuite() {
+	if suite.DB != nil {
+		err := suite.DB.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(&entity.User{}).Error
+		require.NoError(suite.T(), err, "Failed to clean up users table")
+
+		db, err := suite.DB.DB()
+		require.NoError(suite.T(), err, "Failed to get underlying DB connection")
+		err = db.Close()
+		require.NoError(suite.T(), err, "Failed to close database connection")
+	}
+}
+
+func TestDatabaseGrpcHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(DatabaseGrpcHandlerTestSuite))
+}
@@ -0,0 +1,57 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+)
+
+func sampleTimes(loc *time.Location) *prayertimes.Times {
+	at := func(h, m int) time.Time { return time.Date(2024, time.March, 15, h, m, 0, 0, loc) }
+	return &prayertimes.Times{
+		Date:    at(0, 0),
+		Fajr:    at(5, 52),
+		Sunrise: at(7, 7),
+		Dhuhr:   at(13, 6),
+		Asr:     at(16, 26),
+		Maghrib: at(19, 3),
+		Isha:    at(20, 19),
+	}
+}
+
+func TestResolveIqamah_SeasonalRuleOverridesDefault(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	times := sampleTimes(loc)
+
+	rules := []entity.IqamahRule{
+		{Prayer: entity.DHUHR, Type: entity.FIXED_TIME, FixedTime: "13:30"},
+		{Prayer: entity.DHUHR, Type: entity.FIXED_TIME, FixedTime: "13:45", StartDate: "2024-03-10", EndDate: "2024-11-03"},
+		{Prayer: entity.MAGHRIB, Type: entity.MINUTES_AFTER_ADHAN, MinutesAfterAdhan: 5},
+		{Prayer: entity.ISHA, Type: entity.FIXED_TIME, FixedTime: "20:00"},
+		{Prayer: entity.FAJR, Type: entity.FIXED_TIME, FixedTime: "06:15", EndDate: "2024-03-09"},
+	}
+
+	iqamah, err := prayertimes.ResolveIqamah(rules, times)
+	require.NoError(t, err)
+
+	assert.Equal(t, "13:45", iqamah.Dhuhr.Format("15:04"))
+	assert.Equal(t, "19:08", iqamah.Maghrib.Format("15:04"))
+	// A fixed time that falls before the adhan is moved to the adhan.
+	assert.Equal(t, times.Isha, iqamah.Isha)
+	// The Fajr rule ended before this date and Asr has no rule.
+	assert.True(t, iqamah.Fajr.IsZero())
+	assert.True(t, iqamah.Asr.IsZero())
+}
+
+func TestResolveIqamah_InvalidFixedTime(t *testing.T) {
+	rules := []entity.IqamahRule{{Prayer: entity.ASR, Type: entity.FIXED_TIME, FixedTime: "5pm"}}
+
+	_, err := prayertimes.ResolveIqamah(rules, sampleTimes(time.UTC))
+	assert.ErrorIs(t, err, prayertimes.ErrInvalidIqamahRule)
+}
@@ -0,0 +1,64 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+)
+
+func TestNextJumuah_PicksUpcomingShiftsOfNextFriday(t *testing.T) {
+	loc, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+
+	slots := []entity.PrayerSlot{
+		{Type: entity.JUMUAH, StartTime: "14:00", Language: "Arabic"},
+		{Type: entity.JUMUAH, StartTime: "13:00", Language: "English"},
+		{Type: entity.EID_AL_FITR, StartTime: "08:00", Date: "2024-04-12"},
+	}
+
+	// Wednesday: both shifts of the coming Friday, earliest first.
+	date, next, err := prayertimes.NextJumuah(slots, time.Date(2024, time.April, 10, 9, 0, 0, 0, loc), loc)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-04-12", date.Format("2006-01-02"))
+	require.Len(t, next, 2)
+	assert.Equal(t, "English", next[0].Slot.Language)
+	assert.Equal(t, time.Date(2024, time.April, 12, 13, 0, 0, 0, loc), next[0].Start)
+
+	// Friday between the shifts: only the later shift remains.
+	date, next, err = prayertimes.NextJumuah(slots, time.Date(2024, time.April, 12, 13, 30, 0, 0, loc), loc)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-04-12", date.Format("2006-01-02"))
+	require.Len(t, next, 1)
+	assert.Equal(t, "Arabic", next[0].Slot.Language)
+
+	// Friday after the last shift: the following week.
+	date, next, err = prayertimes.NextJumuah(slots, time.Date(2024, time.April, 12, 15, 0, 0, 0, loc), loc)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-04-19", date.Format("2006-01-02"))
+	assert.Len(t, next, 2)
+}
+
+func TestNextJumuah_RespectsSeasonalRanges(t *testing.T) {
+	slots := []entity.PrayerSlot{
+		{Type: entity.JUMUAH, StartTime: "12:30", EndDate: "2024-03-09"},
+		{Type: entity.JUMUAH, StartTime: "13:30", StartDate: "2024-03-10"},
+	}
+
+	_, next, err := prayertimes.NextJumuah(slots, time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC), time.UTC)
+	require.NoError(t, err)
+	require.Len(t, next, 1)
+	assert.Equal(t, "12:30", next[0].Slot.StartTime)
+
+	_, next, err = prayertimes.NextJumuah(slots, time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC), time.UTC)
+	require.NoError(t, err)
+	require.Len(t, next, 1)
+	assert.Equal(t, "13:30", next[0].Slot.StartTime)
+
+	_, _, err = prayertimes.NextJumuah(slots[:1], time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC), time.UTC)
+	assert.ErrorIs(t, err, prayertimes.ErrNoUpcomingJumuah)
+}
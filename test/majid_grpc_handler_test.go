@@ -12,7 +12,7 @@ import (
 	"time"
 )
 
-func (suite *GrpcHandlerTestSuite) TestCreateMasjid_Success() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestCreateMasjid_Success() {
 	ctx := context.Background()
 	req := &pb.CreateMasjidRequest{
 		Masjid: &pb.Masjid{
@@ -53,7 +53,7 @@ func (suite *GrpcHandlerTestSuite) TestCreateMasjid_Success() {
 	require.NoError(suite.T(), err)
 }
 
-func (suite *GrpcHandlerTestSuite) TestGetMasjid_Success() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestGetMasjid_Success() {
 	ctx := context.Background()
 	existingMasjid := &entity.Masjid{
 		ID:   uuid.New(),
@@ -94,7 +94,7 @@ func (suite *GrpcHandlerTestSuite) TestGetMasjid_Success() {
 	require.NoError(suite.T(), err)
 }
 
-func (suite *GrpcHandlerTestSuite) TestGetMasjid_NotFound() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestGetMasjid_NotFound() {
 	ctx := context.Background()
 	nonExistingID := uuid.New().String()
 	req := &pb.GetMasjidRequest{
@@ -110,7 +110,7 @@ func (suite *GrpcHandlerTestSuite) TestGetMasjid_NotFound() {
 	assert.Nil(suite.T(), resp)
 }
 
-func (suite *GrpcHandlerTestSuite) TestDeleteMasjid_Success() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestDeleteMasjid_Success() {
 	ctx := context.Background()
 
 	existingMasjid := &entity.Masjid{
@@ -142,7 +142,7 @@ func (suite *GrpcHandlerTestSuite) TestDeleteMasjid_Success() {
 	assert.Equal(suite.T(), err.Error(), "record not found")
 }
 
-func (suite *GrpcHandlerTestSuite) TestUpdateMasjid_Success() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestUpdateMasjid_Success() {
 	ctx := context.Background()
 
 	existingMasjid := &entity.Masjid{
@@ -13,7 +13,7 @@ import (
 	"time"
 )
 
-func (suite *GrpcHandlerTestSuite) TestCreateNikkahProfile_Success() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestCreateNikkahProfile_Success() {
 
 	userCtx := context.Background()
 	userReq := &pb.CreateUserRequest{
@@ -82,7 +82,7 @@ func (suite *GrpcHandlerTestSuite) TestCreateNikkahProfile_Success() {
 	assert.Equal(suite.T(), testUserID, createdProfile.UserID, "DB profile user ID should match")
 }
 
-func (suite *GrpcHandlerTestSuite) TestCreateNikkahProfile_Unauthenticated() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestCreateNikkahProfile_Unauthenticated() {
 	ctx := context.Background()
 	birthDate := time.Date(1990, 5, 15, 0, 0, 0, 0, time.UTC)
 	req := &pb.CreateNikkahProfileRequest{
@@ -108,7 +108,7 @@ func (suite *GrpcHandlerTestSuite) TestCreateNikkahProfile_Unauthenticated() {
 	assert.Nil(suite.T(), resp)
 }
 
-func (suite *GrpcHandlerTestSuite) TestGetSelfNikkahProfile_Success() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestGetSelfNikkahProfile_Success() {
 	userCtx := context.Background()
 	userReq := &pb.CreateUserRequest{
 		Email:           "getselfprofile@example.com",
@@ -178,7 +178,7 @@ func (suite *GrpcHandlerTestSuite) TestGetSelfNikkahProfile_Success() {
 	assert.NotNil(suite.T(), retrievedProfileProto.GetUpdateTime(), "Expected UpdateTime to be set")
 }
 
-func (suite *GrpcHandlerTestSuite) TestGetSelfNikkahProfile_ProfileNotFound() {
+func (suite *DatabaseGrpcHandlerTestSuite) TestGetSelfNikkahProfile_ProfileNotFound() {
 	userCtx := context.Background()
 	userReq := &pb.CreateUserRequest{
 		Email:           "nouserprofile@example.com",
@@ -0,0 +1,299 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mnadev/limestone/internal/application/domain/audio"
+	"github.com/mnadev/limestone/internal/application/domain/audio/pcm"
+)
+
+// sliceReader reads interleaved samples held in memory.
+type sliceReader struct {
+	f       pcm.Format
+	samples []float64
+}
+
+func (r *sliceReader) Format() pcm.Format { return r.f }
+
+func (r *sliceReader) Read(buf []float64) (int, error) {
+	if len(r.samples) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(buf[:len(buf)/r.f.Channels*r.f.Channels], r.samples)
+	r.samples = r.samples[n:]
+	return n, nil
+}
+
+// sine returns a tone of the given frequency and peak amplitude on every
+// channel.
+func sine(rate, channels int, freq, amplitude float64, d time.Duration) *sliceReader {
+	frames := int(math.Round(d.Seconds() * float64(rate)))
+	samples := make([]float64, frames*channels)
+	for i := 0; i < frames; i++ {
+		v := amplitude * math.Sin(2*math.Pi*freq*float64(i)/float64(rate))
+		for c := 0; c < channels; c++ {
+			samples[i*channels+c] = v
+		}
+	}
+	return &sliceReader{f: pcm.Format{SampleRate: rate, Channels: channels}, samples: samples}
+}
+
+func readAll(t *testing.T, r pcm.Reader) []float64 {
+	var out []float64
+	buf := make([]float64, 1000*r.Format().Channels)
+	for {
+		n, err := r.Read(buf)
+		out = append(out, buf[:n]...)
+		if errors.Is(err, io.EOF) {
+			return out
+		}
+		require.NoError(t, err)
+	}
+}
+
+func rms(samples []float64) float64 {
+	var sum float64
+	for _, v := range samples {
+		sum += v * v
+	}
+	return math.Sqrt(sum / float64(len(samples)))
+}
+
+func measure(t *testing.T, r pcm.Reader) *pcm.Meter {
+	m := pcm.NewMeter(r.Format())
+	_, err := pcm.Copy(m, r)
+	require.NoError(t, err)
+	return m
+}
+
+func TestMeter_MatchesBS1770Reference(t *testing.T) {
+	// A full-scale 1 kHz tone on one channel measures -3.01 LUFS.
+	for _, rate := range []int{44100, 48000} {
+		m := measure(t, sine(rate, 1, 1000, 1, 5*time.Second))
+		assert.InDelta(t, -3.01, m.Loudness(), 0.05, "mono at %d Hz", rate)
+		assert.InDelta(t, 1, m.Peak(), 1e-4)
+
+		m = measure(t, sine(rate, 2, 1000, 1, 5*time.Second))
+		assert.InDelta(t, 0, m.Loudness(), 0.05, "stereo at %d Hz", rate)
+	}
+
+	// Halving the amplitude lowers the loudness by 6 dB.
+	m := measure(t, sine(48000, 2, 1000, 0.5, 5*time.Second))
+	assert.InDelta(t, -6.02, m.Loudness(), 0.05)
+}
+
+func TestMeter_GatesSilence(t *testing.T) {
+	tone := sine(48000, 1, 1000, 0.1, 3*time.Second)
+	want := measure(t, sine(48000, 1, 1000, 0.1, 3*time.Second)).Loudness()
+
+	// Averaged over the whole 13 s, the tone would be 6.4 dB quieter. Only
+	// the few blocks straddling its start still include some silence.
+	padded := &sliceReader{f: tone.f, samples: append(make([]float64, 48000*10), tone.samples...)}
+	assert.InDelta(t, want, measure(t, padded).Loudness(), 0.3)
+
+	silent := &sliceReader{f: tone.f, samples: make([]float64, 48000*3)}
+	assert.True(t, math.IsInf(measure(t, silent).Loudness(), -1))
+}
+
+func TestNormalizationGain(t *testing.T) {
+	// -26 LUFS brought to -16 LUFS is a gain of 10 dB.
+	assert.InDelta(t, math.Pow(10, 10.0/20), pcm.NormalizationGain(-26, 0.1, -16, -1), 1e-9)
+	// The peak would reach 0.9 * 3.16, so the gain stops at -1 dBFS.
+	assert.InDelta(t, math.Pow(10, -1.0/20)/0.9, pcm.NormalizationGain(-26, 0.9, -16, -1), 1e-9)
+	// Loud audio is turned down.
+	assert.InDelta(t, math.Pow(10, -4.0/20), pcm.NormalizationGain(-12, 1, -16, -1), 1e-9)
+	assert.Equal(t, 1.0, pcm.NormalizationGain(math.Inf(-1), 0, -16, -1))
+}
+
+func TestResample(t *testing.T) {
+	out := readAll(t, pcm.Resample(sine(44100, 1, 440, 0.5, time.Second), 8000))
+	assert.InDelta(t, 8000, len(out), 2)
+	// The tone passes unchanged away from the edges.
+	assert.InDelta(t, 0.5/math.Sqrt2, rms(out[400:7600]), 0.005)
+	for i := 400; i < 7600; i += 97 {
+		assert.InDelta(t, 0.5*math.Sin(2*math.Pi*440*float64(i)/8000), out[i], 0.01, "sample %d", i)
+	}
+
+	// A tone above the new Nyquist frequency is filtered out rather than
+	// aliased.
+	out = readAll(t, pcm.Resample(sine(44100, 1, 6000, 0.5, time.Second), 8000))
+	assert.Less(t, rms(out[400:7600]), 0.005)
+
+	// Upsampling keeps the channels apart.
+	stereo := &sliceReader{f: pcm.Format{SampleRate: 8000, Channels: 2}, samples: make([]float64, 16000)}
+	for i := 0; i < 8000; i++ {
+		stereo.samples[2*i] = 0.25
+		stereo.samples[2*i+1] = -0.25
+	}
+	r := pcm.Resample(stereo, 16000)
+	assert.Equal(t, pcm.Format{SampleRate: 16000, Channels: 2}, r.Format())
+	out = readAll(t, r)
+	assert.InDelta(t, 32000, len(out), 4)
+	assert.InDelta(t, 0.25, out[8000], 1e-3)
+	assert.InDelta(t, -0.25, out[8001], 1e-3)
+}
+
+func TestMix(t *testing.T) {
+	stereo := &sliceReader{f: pcm.Format{SampleRate: 8000, Channels: 2}, samples: []float64{1, 0, 0.5, -0.5}}
+	mono := pcm.Mix(stereo, 1)
+	assert.Equal(t, 1, mono.Format().Channels)
+	assert.Equal(t, []float64{0.5, 0}, readAll(t, mono))
+
+	up := pcm.Mix(&sliceReader{f: pcm.Format{SampleRate: 8000, Channels: 1}, samples: []float64{0.25}}, 2)
+	assert.Equal(t, []float64{0.25, 0.25}, readAll(t, up))
+}
+
+func writeWAV(t *testing.T, r pcm.Reader, enc pcm.Encoding) (string, *pcm.WAVWriter) {
+	path := filepath.Join(t.TempDir(), "out.wav")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	w, err := pcm.NewWAVWriter(f, r.Format(), enc)
+	require.NoError(t, err)
+	_, err = pcm.Copy(w, r)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return path, w
+}
+
+func TestWAVWriter_RoundTrips(t *testing.T) {
+	for _, tc := range []struct {
+		enc       pcm.Encoding
+		codec     string
+		bitrate   int
+		tolerance float64
+	}{
+		{pcm.PCM16, "pcm_s16le", 128000, 1e-4},
+		// The steps of µ-law reach 1024/32768 near full scale.
+		{pcm.MuLaw, "pcm_mulaw", 64000, 0.016},
+	} {
+		src := sine(8000, 1, 300, 0.8, 1001*time.Millisecond)
+		want := append([]float64(nil), src.samples...)
+		path, w := writeWAV(t, src, tc.enc)
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, w.Size(), int64(len(data)))
+
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		info, err := audio.Probe(f, int64(len(data)))
+		require.NoError(t, err)
+		assert.Equal(t, tc.codec, info.Codec)
+		assert.Equal(t, 8000, info.SampleRate)
+		assert.Equal(t, tc.bitrate, info.Bitrate)
+		assert.Equal(t, tc.bitrate, tc.enc.Bitrate(pcm.Format{SampleRate: 8000, Channels: 1}))
+		assert.Equal(t, 1001*time.Millisecond, info.Duration)
+		f.Close()
+
+		f, err = os.Open(path)
+		require.NoError(t, err)
+		r, err := pcm.Decode(audio.FormatWAV, f)
+		require.NoError(t, err)
+		got := readAll(t, r)
+		f.Close()
+		require.Len(t, got, len(want))
+		for i := range want {
+			assert.InDelta(t, want[i], got[i], tc.tolerance, "%s sample %d", tc.codec, i)
+		}
+	}
+}
+
+func writeMP3(t *testing.T, r pcm.Reader, bitrate int) (string, *pcm.MP3Writer) {
+	path := filepath.Join(t.TempDir(), "out.mp3")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	w, err := pcm.NewMP3Writer(f, r.Format(), bitrate)
+	require.NoError(t, err)
+	_, err = pcm.Copy(w, r)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return path, w
+}
+
+func TestMP3Writer_RoundTrips(t *testing.T) {
+	for _, tc := range []struct {
+		rate, channels, bitrate int
+	}{
+		{16000, 1, 32000},
+		{22050, 1, 32000},
+		{44100, 2, 128000},
+		{48000, 2, 64000},
+	} {
+		name := fmt.Sprintf("%d Hz, %d channels", tc.rate, tc.channels)
+		src := sine(tc.rate, tc.channels, 440, 0.8, 2*time.Second)
+		want := append([]float64(nil), src.samples...)
+		path, w := writeMP3(t, src, tc.bitrate)
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, w.Size(), int64(len(data)), name)
+		assert.Equal(t, audio.FormatMP3, audio.Detect(data))
+
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		info, err := audio.Probe(f, int64(len(data)))
+		require.NoError(t, err)
+		f.Close()
+		assert.Equal(t, "mp3", info.Codec, name)
+		assert.Equal(t, tc.rate, info.SampleRate, name)
+		assert.Equal(t, tc.channels, info.Channels, name)
+		assert.InDelta(t, tc.bitrate, info.Bitrate, float64(tc.bitrate)/100, name)
+		assert.False(t, info.Silent, name)
+
+		f, err = os.Open(path)
+		require.NoError(t, err)
+		r, err := pcm.Decode(audio.FormatMP3, f)
+		require.NoError(t, err)
+		assert.Equal(t, pcm.Format{SampleRate: tc.rate, Channels: tc.channels}, r.Format(), name)
+		got := readAll(t, r)
+		f.Close()
+
+		// Past the delay, the decoded audio follows the tone closely.
+		delay := pcm.MP3Delay * tc.channels
+		require.Greater(t, len(got), len(want)+delay, name)
+		got = got[delay : delay+len(want)]
+		var signal, noise float64
+		for i := range want {
+			signal += want[i] * want[i]
+			noise += (want[i] - got[i]) * (want[i] - got[i])
+		}
+		assert.Greater(t, 10*math.Log10(signal/noise), 30.0, name)
+		assert.InDelta(t, rms(want), rms(got), 0.01, name)
+	}
+}
+
+func TestMP3Writer_RejectsUnsupportedFormats(t *testing.T) {
+	_, err := pcm.NewMP3Writer(io.Discard, pcm.Format{SampleRate: 8000, Channels: 1}, 16000)
+	assert.ErrorIs(t, err, pcm.ErrNoEncoder)
+	_, err = pcm.NewMP3Writer(io.Discard, pcm.Format{SampleRate: 44100, Channels: 6}, 128000)
+	assert.ErrorIs(t, err, pcm.ErrNoEncoder)
+	// 24 kbit/s is an MPEG-2 bitrate only.
+	_, err = pcm.NewMP3Writer(io.Discard, pcm.Format{SampleRate: 44100, Channels: 1}, 24000)
+	assert.ErrorIs(t, err, pcm.ErrNoEncoder)
+}
+
+func TestDecode_UnsupportedFormats(t *testing.T) {
+	_, err := pcm.Decode(audio.FormatOgg, nil)
+	assert.ErrorIs(t, err, pcm.ErrNoDecoder)
+	assert.False(t, pcm.CanDecode(audio.FormatOgg))
+	assert.True(t, pcm.CanDecode(audio.FormatWAV))
+	assert.True(t, pcm.CanDecode(audio.FormatMP3))
+
+	// AAC behind an ID3 tag is detected as MP3, but is not decoded.
+	aac := append([]byte{'I', 'D', '3', 4, 0, 0, 0, 0, 0, 0}, adtsFile(10)...)
+	_, err = pcm.Decode(audio.Detect(aac), bytes.NewReader(aac))
+	assert.ErrorIs(t, err, pcm.ErrNoDecoder)
+}
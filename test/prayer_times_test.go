@@ -0,0 +1,123 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/geo"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+)
+
+func hm(t time.Time) string {
+	return t.Format("15:04")
+}
+
+func TestCalculatePrayerTimes_NorthAmerica(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	times, err := prayertimes.Calculate(
+		time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC),
+		geo.Coordinates{Latitude: 40.7128, Longitude: -74.0060},
+		loc,
+		entity.PrayerTimesConfiguration{CalculationMethod: entity.NORTH_AMERICA},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "2024-03-15", times.Date.Format("2006-01-02"))
+	assert.Equal(t, "05:52", hm(times.Fajr))
+	assert.Equal(t, "07:07", hm(times.Sunrise))
+	assert.Equal(t, "13:06", hm(times.Dhuhr))
+	assert.Equal(t, "16:26", hm(times.Asr))
+	assert.Equal(t, "19:03", hm(times.Maghrib))
+	assert.Equal(t, "20:19", hm(times.Isha))
+}
+
+func TestCalculatePrayerTimes_IshaIntervalAndAdjustments(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Riyadh")
+	require.NoError(t, err)
+
+	date := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	coords := geo.Coordinates{Latitude: 21.4225, Longitude: 39.8262}
+	cfg := entity.PrayerTimesConfiguration{CalculationMethod: entity.UMM_AL_QURA}
+
+	times, err := prayertimes.Calculate(date, coords, loc, cfg)
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Minute, times.Isha.Sub(times.Maghrib))
+
+	cfg.Adjustments = entity.PrayerAdjustments{FajrAdjustment: 2, IshaAdjustment: -5}
+	adjusted, err := prayertimes.Calculate(date, coords, loc, cfg)
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Minute, adjusted.Fajr.Sub(times.Fajr))
+	assert.Equal(t, -5*time.Minute, adjusted.Isha.Sub(times.Isha))
+	assert.Equal(t, times.Dhuhr, adjusted.Dhuhr)
+}
+
+func TestCalculatePrayerTimes_HanafiAsrIsLater(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Karachi")
+	require.NoError(t, err)
+
+	date := time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)
+	coords := geo.Coordinates{Latitude: 24.8607, Longitude: 67.0011}
+
+	shafi, err := prayertimes.Calculate(date, coords, loc, entity.PrayerTimesConfiguration{CalculationMethod: entity.KARACHI})
+	require.NoError(t, err)
+	hanafi, err := prayertimes.Calculate(date, coords, loc, entity.PrayerTimesConfiguration{CalculationMethod: entity.KARACHI, AsrMethod: entity.HANAFI})
+	require.NoError(t, err)
+
+	assert.True(t, hanafi.Asr.After(shafi.Asr))
+	assert.Equal(t, shafi.Fajr, hanafi.Fajr)
+}
+
+func TestCalculatePrayerTimes_HighLatitudeRules(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/London")
+	require.NoError(t, err)
+
+	date := time.Date(2024, time.June, 21, 0, 0, 0, 0, time.UTC)
+	coords := geo.Coordinates{Latitude: 51.5074, Longitude: -0.1278}
+
+	for _, rule := range []entity.HighLatitudeRule{
+		entity.NO_HIGH_LATITUDE_RULE,
+		entity.MIDDLE_OF_THE_NIGHT,
+		entity.SEVENTH_OF_THE_NIGHT,
+		entity.TWILIGHT_ANGLE,
+	} {
+		times, err := prayertimes.Calculate(date, coords, loc, entity.PrayerTimesConfiguration{
+			CalculationMethod: entity.MUSLIM_WORLD_LEAGUE,
+			HighLatitudeRule:  rule,
+		})
+		require.NoError(t, err)
+		assert.True(t, times.Fajr.Before(times.Sunrise), "rule %d", rule)
+		assert.True(t, times.Isha.After(times.Maghrib), "rule %d", rule)
+	}
+
+	seventh, err := prayertimes.Calculate(date, coords, loc, entity.PrayerTimesConfiguration{
+		CalculationMethod: entity.MUSLIM_WORLD_LEAGUE,
+		HighLatitudeRule:  entity.SEVENTH_OF_THE_NIGHT,
+	})
+	require.NoError(t, err)
+	night := seventh.Sunrise.Add(24 * time.Hour).Sub(seventh.Maghrib)
+	assert.InDelta(t, (night / 7).Minutes(), seventh.Sunrise.Sub(seventh.Fajr).Minutes(), 2)
+}
+
+func TestCalculatePrayerTimes_OtherRequiresAngles(t *testing.T) {
+	_, err := prayertimes.Calculate(
+		time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC),
+		geo.Coordinates{Latitude: 40.7128, Longitude: -74.0060},
+		time.UTC,
+		entity.PrayerTimesConfiguration{CalculationMethod: entity.OTHER},
+	)
+	assert.ErrorIs(t, err, prayertimes.ErrIncompleteParams)
+
+	_, err = prayertimes.Calculate(
+		time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC),
+		geo.Coordinates{Latitude: 40.7128, Longitude: -74.0060},
+		time.UTC,
+		entity.PrayerTimesConfiguration{CalculationMethod: entity.OTHER, FajrAngle: 16, IshaAngle: 14},
+	)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,192 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+	"github.com/mnadev/limestone/internal/application/domain/timetable"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/services"
+)
+
+func ramadanTimes(t *testing.T, loc *time.Location) *prayertimes.Times {
+	override := &entity.PrayerTimeOverride{
+		Fajr: "05:40", Sunrise: "07:05", Dhuhr: "13:10", Asr: "16:30", Maghrib: "19:10", Isha: "20:30",
+	}
+	times, err := prayertimes.FromOverride(time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC), loc, override)
+	require.NoError(t, err)
+	return times
+}
+
+func TestNewRamadanDay_SuhoorAndIftar(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	times := ramadanTimes(t, loc)
+
+	day, err := prayertimes.NewRamadanDay(5, times, 10, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 5, day.Day)
+	assert.Equal(t, time.Date(2024, time.March, 15, 5, 30, 0, 0, loc), day.SuhoorEnd)
+	assert.Equal(t, times.Maghrib, day.Iftar)
+	assert.True(t, day.Taraweeh.IsZero())
+	assert.True(t, day.Qiyam.IsZero())
+}
+
+func TestNewRamadanDay_NightPrecedesFast(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	times := ramadanTimes(t, loc)
+
+	night := &entity.RamadanNight{Night: 5, TaraweehTime: "20:45", QiyamTime: "03:30", ImamId: "imam"}
+	day, err := prayertimes.NewRamadanDay(5, times, 10, night)
+	require.NoError(t, err)
+	// Taraweeh is on the evening before the fast, qiyam after midnight.
+	assert.Equal(t, time.Date(2024, time.March, 14, 20, 45, 0, 0, loc), day.Taraweeh)
+	assert.Equal(t, time.Date(2024, time.March, 15, 3, 30, 0, 0, loc), day.Qiyam)
+	assert.Equal(t, "imam", day.ImamId)
+
+	// A qiyam in the evening stays on the night's first day.
+	night.QiyamTime = "23:00"
+	day, err = prayertimes.NewRamadanDay(5, times, 10, night)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 14, 23, 0, 0, 0, loc), day.Qiyam)
+}
+
+func TestNewRamadanDay_RejectsInvalidNight(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	times := ramadanTimes(t, loc)
+
+	for _, night := range []*entity.RamadanNight{
+		{TaraweehTime: "8:45pm"},
+		{QiyamTime: "05:35"},
+		{TaraweehTime: "23:00", QiyamTime: "22:00"},
+	} {
+		_, err := prayertimes.NewRamadanDay(5, times, 10, night)
+		assert.ErrorIs(t, err, prayertimes.ErrInvalidRamadanNight, "%+v", night)
+	}
+}
+
+func TestRenderTimetable_RamadanColumns(t *testing.T) {
+	tt := buildTimetable(t)
+	loc := tt.Days[0].Times.Date.Location()
+	// 10 March 2024 is the eve of 1 Ramadan 1445.
+	tt.Days[9].Taraweeh = time.Date(2024, time.March, 10, 20, 45, 0, 0, loc)
+	tt.Days[10].SuhoorEnd = tt.Days[10].Times.Fajr.Add(-10 * time.Minute)
+	tt.Days[10].Iftar = tt.Days[10].Times.Maghrib
+
+	file, err := timetable.Render(tt, timetable.CSV)
+	require.NoError(t, err)
+	rows, err := csv.NewReader(bytes.NewReader(file.Content)).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Suhoor Ends", "Iftar", "Taraweeh", "Qiyam"}, rows[0][13:])
+	assert.Equal(t, []string{"", "", "20:45", ""}, rows[10][13:])
+	assert.Equal(t, "", rows[11][15])
+	assert.NotEmpty(t, rows[11][13])
+	assert.Equal(t, rows[11][9], rows[11][14])
+
+	file, err = timetable.Render(tt, timetable.ICS)
+	require.NoError(t, err)
+	ics := string(file.Content)
+	assert.Contains(t, ics, "UID:2024-03-10-taraweeh@m1\r\n")
+	assert.Contains(t, ics, "DTSTART:20240311T004500Z\r\n")
+
+	file, err = timetable.Render(tt, timetable.PDF)
+	require.NoError(t, err)
+	assert.True(t, strings.Contains(string(file.Content), "Taraweeh"))
+}
+
+func TestRenderTimetable_NoRamadanColumnsOutsideRamadan(t *testing.T) {
+	file, err := timetable.Render(buildTimetable(t), timetable.CSV)
+	require.NoError(t, err)
+	rows, err := csv.NewReader(bytes.NewReader(file.Content)).ReadAll()
+	require.NoError(t, err)
+	assert.Len(t, rows[0], 13)
+}
+
+func (r *memoryEventRepo) ListByScheduleKeys(ctx context.Context, keys []string) ([]*entity.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var events []*entity.Event
+	for _, event := range r.events {
+		if event.ScheduleKey != nil && slices.Contains(keys, *event.ScheduleKey) {
+			event := event
+			events = append(events, &event)
+		}
+	}
+	return events, nil
+}
+
+// ramadanMasjidRepo serves a single masjid with no imported times or
+// Ramadan nights.
+type ramadanMasjidRepo struct {
+	*eventMasjidRepo
+}
+
+func (r ramadanMasjidRepo) ListPrayerTimeOverrides(ctx context.Context, masjidID string, from string, to string) ([]entity.PrayerTimeOverride, error) {
+	return nil, nil
+}
+
+func (r ramadanMasjidRepo) ListRamadanNights(ctx context.Context, masjidID string, hijriYear int32) ([]entity.RamadanNight, error) {
+	return nil, nil
+}
+
+func newIftarFixture(t *testing.T) (*roomFixture, *services.RamadanService) {
+	f := newRoomFixture(t)
+	masjids := f.svc.MasjidRepo.(*eventMasjidRepo)
+	masjids.masjid.Latitude, masjids.masjid.Longitude = 40.7128, -74.0060
+	masjids.masjid.TimeZone = "America/New_York"
+	masjids.masjid.PrayerConfig.CalculationMethod = entity.NORTH_AMERICA
+	return f, services.NewRamadanService(ramadanMasjidRepo{masjids}, f.svc, nil)
+}
+
+func TestCreateIftarEvents_BooksRoomsOnce(t *testing.T) {
+	ctx := context.Background()
+	f, ramadan := newIftarFixture(t)
+	hall := f.room(t, "Main hall", 150, entity.NO_RESTRICTION)
+	template := &entity.Event{Description: "Community iftar", MaxParticipants: 120}
+	template.SetRooms(hall.ID)
+
+	created, err := ramadan.CreateIftarEvents(ctx, f.masjidID, 1446, template, 0)
+	require.NoError(t, err)
+	require.Len(t, created, 29)
+	assert.Equal(t, "Iftar, 1 Ramadan 1446", created[0].Name)
+	assert.Equal(t, 1, created[0].HijriStart.Day)
+	assert.Equal(t, []string{hall.ID.String()}, created[0].RoomIds())
+	bookings, err := f.roomRepo.ListBookings(ctx, hall.ID.String(), created[0].StartTime, created[28].EndTime)
+	require.NoError(t, err)
+	assert.Len(t, bookings, 29)
+
+	// A renamed iftar is still that night's iftar.
+	_, err = f.svc.Update(ctx, &entity.Event{ID: created[3].ID, Name: "Iftar with the youth circle"})
+	require.NoError(t, err)
+	again, err := ramadan.CreateIftarEvents(ctx, f.masjidID, 1446, template, 0)
+	require.NoError(t, err)
+	assert.Empty(t, again)
+}
+
+func TestCreateIftarEvents_ChecksRooms(t *testing.T) {
+	ctx := context.Background()
+	f, ramadan := newIftarFixture(t)
+	hall := f.room(t, "Main hall", 50, entity.NO_RESTRICTION)
+	template := &entity.Event{MaxParticipants: 120}
+	template.SetRooms(hall.ID)
+
+	_, err := ramadan.CreateIftarEvents(ctx, f.masjidID, 1446, template, 0)
+	assert.ErrorIs(t, err, helper.ErrInvalidBooking)
+
+	template.SetRooms(uuid.New())
+	_, err = ramadan.CreateIftarEvents(ctx, f.masjidID, 1446, template, 0)
+	assert.ErrorIs(t, err, helper.ErrInvalidBooking)
+}
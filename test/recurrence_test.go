@@ -0,0 +1,185 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mnadev/limestone/internal/application/domain/recurrence"
+)
+
+var toronto = mustLoadLocation("America/Toronto")
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+func occurrences(t *testing.T, start time.Time, lines ...string) []time.Time {
+	t.Helper()
+	set, err := recurrence.Parse(lines, start)
+	require.NoError(t, err)
+	return set.Between(start, start.AddDate(10, 0, 0), 0)
+}
+
+func dates(times []time.Time) []string {
+	out := make([]string, len(times))
+	for i, t := range times {
+		out[i] = t.Format("2006-01-02 15:04 MST")
+	}
+	return out
+}
+
+func TestRecurrence_Weekly(t *testing.T) {
+	start := time.Date(2026, time.January, 5, 18, 0, 0, 0, toronto)
+	got := occurrences(t, start, "RRULE:FREQ=WEEKLY;BYDAY=MO,WE;COUNT=5")
+	assert.Equal(t, []string{
+		"2026-01-05 18:00 EST",
+		"2026-01-07 18:00 EST",
+		"2026-01-12 18:00 EST",
+		"2026-01-14 18:00 EST",
+		"2026-01-19 18:00 EST",
+	}, dates(got))
+
+	got = occurrences(t, start, "RRULE:FREQ=WEEKLY;INTERVAL=2;UNTIL=20260202")
+	assert.Equal(t, []string{
+		"2026-01-05 18:00 EST",
+		"2026-01-19 18:00 EST",
+		"2026-02-02 18:00 EST",
+	}, dates(got))
+}
+
+func TestRecurrence_KeepsLocalTimeAcrossDaylightSaving(t *testing.T) {
+	start := time.Date(2026, time.October, 30, 6, 30, 0, 0, toronto)
+	got := occurrences(t, start, "RRULE:FREQ=DAILY;COUNT=4")
+	assert.Equal(t, []string{
+		"2026-10-30 06:30 EDT",
+		"2026-10-31 06:30 EDT",
+		"2026-11-01 06:30 EST",
+		"2026-11-02 06:30 EST",
+	}, dates(got))
+}
+
+func TestRecurrence_Monthly(t *testing.T) {
+	start := time.Date(2026, time.January, 30, 20, 0, 0, 0, time.UTC)
+
+	// The last Friday of each month.
+	got := occurrences(t, start, "RRULE:FREQ=MONTHLY;BYDAY=-1FR;COUNT=3")
+	assert.Equal(t, []string{"2026-01-30 20:00 UTC", "2026-02-27 20:00 UTC", "2026-03-27 20:00 UTC"}, dates(got))
+
+	// Months without a 31st are skipped.
+	start = time.Date(2026, time.January, 31, 20, 0, 0, 0, time.UTC)
+	got = occurrences(t, start, "RRULE:FREQ=MONTHLY;COUNT=3")
+	assert.Equal(t, []string{"2026-01-31 20:00 UTC", "2026-03-31 20:00 UTC", "2026-05-31 20:00 UTC"}, dates(got))
+
+	// The last weekday of each month.
+	start = time.Date(2026, time.January, 30, 20, 0, 0, 0, time.UTC)
+	got = occurrences(t, start, "RRULE:FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=3")
+	assert.Equal(t, []string{"2026-01-30 20:00 UTC", "2026-02-27 20:00 UTC", "2026-03-31 20:00 UTC"}, dates(got))
+}
+
+func TestRecurrence_Yearly(t *testing.T) {
+	start := time.Date(2024, time.February, 29, 12, 0, 0, 0, time.UTC)
+	got := occurrences(t, start, "RRULE:FREQ=YEARLY;COUNT=3")
+	assert.Equal(t, []string{"2024-02-29 12:00 UTC", "2028-02-29 12:00 UTC", "2032-02-29 12:00 UTC"}, dates(got))
+
+	// The first Sunday of the year.
+	start = time.Date(2026, time.January, 4, 12, 0, 0, 0, time.UTC)
+	got = occurrences(t, start, "RRULE:FREQ=YEARLY;BYDAY=1SU;COUNT=2")
+	assert.Equal(t, []string{"2026-01-04 12:00 UTC", "2027-01-03 12:00 UTC"}, dates(got))
+}
+
+func TestRecurrence_ExDatesAndRDates(t *testing.T) {
+	start := time.Date(2026, time.January, 5, 18, 0, 0, 0, toronto)
+	got := occurrences(t, start,
+		"RRULE:FREQ=WEEKLY;COUNT=4",
+		"EXDATE;TZID=America/Toronto:20260112T180000",
+		"EXDATE;VALUE=DATE:20260119",
+		"RDATE:20260110T150000Z",
+	)
+	assert.Equal(t, []string{"2026-01-05 18:00 EST", "2026-01-10 10:00 EST", "2026-01-26 18:00 EST"}, dates(got))
+}
+
+func TestRecurrence_Between(t *testing.T) {
+	start := time.Date(2026, time.January, 1, 9, 0, 0, 0, time.UTC)
+	set, err := recurrence.Parse([]string{"RRULE:FREQ=DAILY"}, start)
+	require.NoError(t, err)
+
+	got := set.Between(time.Date(2027, time.March, 1, 0, 0, 0, 0, time.UTC), time.Date(2027, time.March, 4, 0, 0, 0, 0, time.UTC), 0)
+	assert.Equal(t, []string{"2027-03-01 09:00 UTC", "2027-03-02 09:00 UTC", "2027-03-03 09:00 UTC"}, dates(got))
+	assert.Len(t, set.Between(start, start.AddDate(1, 0, 0), 10), 10)
+
+	_, ends := set.End()
+	assert.False(t, ends)
+	assert.True(t, set.Includes(time.Date(2026, time.June, 1, 9, 0, 0, 0, time.UTC)))
+	assert.False(t, set.Includes(time.Date(2026, time.June, 1, 10, 0, 0, 0, time.UTC)))
+}
+
+func TestRecurrence_Split(t *testing.T) {
+	start := time.Date(2026, time.January, 5, 18, 0, 0, 0, toronto)
+	set, err := recurrence.Parse([]string{"RRULE:FREQ=WEEKLY;COUNT=6", "EXDATE:20260112T230000Z,20260202T230000Z"}, start)
+	require.NoError(t, err)
+
+	at := time.Date(2026, time.January, 26, 18, 0, 0, 0, toronto)
+	head, tail := set.Split(at)
+	assert.Equal(t, []string{"RRULE:FREQ=WEEKLY;COUNT=3", "EXDATE:20260112T230000Z"}, head.Lines())
+	assert.Equal(t, []string{"RRULE:FREQ=WEEKLY;COUNT=3", "EXDATE:20260202T230000Z"}, tail.Lines())
+	assert.Equal(t,
+		dates(set.Between(start, start.AddDate(1, 0, 0), 0)),
+		append(dates(head.Between(start, start.AddDate(1, 0, 0), 0)), dates(tail.Between(start, start.AddDate(1, 0, 0), 0))...),
+	)
+
+	end, ends := head.End()
+	assert.True(t, ends)
+	assert.Equal(t, time.Date(2026, time.January, 19, 18, 0, 0, 0, toronto), end)
+
+	// An hour later from then on.
+	moved := tail.MoveTo(at.Add(time.Hour))
+	assert.Equal(t, []string{"2026-01-26 19:00 EST", "2026-02-09 19:00 EST"}, dates(moved.Between(start, start.AddDate(1, 0, 0), 0)))
+}
+
+func TestRecurrence_Invalid(t *testing.T) {
+	start := time.Date(2026, time.January, 5, 18, 0, 0, 0, time.UTC)
+	for _, lines := range [][]string{
+		{"RRULE:INTERVAL=2"},
+		{"RRULE:FREQ=FORTNIGHTLY"},
+		{"RRULE:FREQ=WEEKLY;COUNT=2;UNTIL=20260301"},
+		{"RRULE:FREQ=WEEKLY;BYDAY=2MO"},
+		{"RRULE:FREQ=MONTHLY;BYMONTHDAY=0"},
+		{"EXDATE:20260112T180000Z"},
+		{"RDATE:tomorrow"},
+		{"SUMMARY:Halaqa"},
+	} {
+		_, err := recurrence.Parse(lines, start)
+		assert.ErrorIs(t, err, recurrence.ErrInvalidRule, "%v", lines)
+	}
+
+	_, err := recurrence.Parse([]string{"RRULE:FREQ=HOURLY"}, start)
+	assert.ErrorIs(t, err, recurrence.ErrUnsupported)
+	_, err = recurrence.Parse([]string{"RRULE:FREQ=DAILY;BYHOUR=9,18"}, start)
+	assert.ErrorIs(t, err, recurrence.ErrUnsupported)
+	_, err = recurrence.Parse([]string{"RRULE:FREQ=DAILY", "RRULE:FREQ=WEEKLY"}, start)
+	assert.ErrorIs(t, err, recurrence.ErrUnsupported)
+}
+
+func TestRecurrence_Lines(t *testing.T) {
+	start := time.Date(2026, time.January, 5, 18, 0, 0, 0, toronto)
+	set, err := recurrence.Parse([]string{
+		"rrule:freq=monthly;byday=+1mo,-1FR;until=20261231T000000;wkst=SU",
+		"EXDATE;TZID=America/Toronto:20260202T180000",
+	}, start)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"RRULE:FREQ=MONTHLY;UNTIL=20261231T050000Z;BYDAY=1MO,-1FR;WKST=SU",
+		"EXDATE:20260202T230000Z",
+	}, set.Lines())
+
+	again, err := recurrence.Parse(set.Lines(), start)
+	require.NoError(t, err)
+	assert.Equal(t, set.Between(start, start.AddDate(1, 0, 0), 0), again.Between(start, start.AddDate(1, 0, 0), 0))
+}
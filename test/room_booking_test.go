@@ -0,0 +1,830 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/services"
+)
+
+// memoryRoomRepo keeps rooms and bookings in memory. A single mutex stands
+// in for the room locks of the Gorm repository. The rules of recurring
+// events hold rooms beyond their bookings when events is set.
+type memoryRoomRepo struct {
+	mu       sync.Mutex
+	rooms    map[uuid.UUID]*entity.Room
+	bookings []*entity.RoomBooking
+	events   *memoryEventRepo
+}
+
+func newMemoryRoomRepo() *memoryRoomRepo {
+	return &memoryRoomRepo{rooms: map[uuid.UUID]*entity.Room{}}
+}
+
+// check returns helper.ErrRoomUnavailable if a room of bookings is booked
+// at the time other than by event, which is nil for a member's booking, or
+// held then by the rule of a recurring event. The caller holds r.mu, and
+// the mutex of r.events when it is set.
+func (r *memoryRoomRepo) check(event *entity.Event, bookings []entity.RoomBooking) error {
+	byRoom := map[uuid.UUID][]entity.RoomBooking{}
+	for _, booking := range bookings {
+		for _, other := range r.bookings {
+			if other.RoomId != booking.RoomId || !other.Overlaps(booking.StartTime, booking.EndTime) {
+				continue
+			}
+			if event == nil || other.EventId == nil || *other.EventId != event.ID {
+				return helper.ErrRoomUnavailable
+			}
+		}
+		byRoom[booking.RoomId] = append(byRoom[booking.RoomId], booking)
+	}
+	if r.events == nil {
+		return nil
+	}
+	for roomID, bookings := range byRoom {
+		if err := r.checkHolds(roomID, event, bookings); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkHolds checks bookings of a room against the rules of recurring
+// events other than event, and a recurring event by its own rule, as the
+// Gorm repository does.
+func (r *memoryRoomRepo) checkHolds(roomID uuid.UUID, event *entity.Event, bookings []entity.RoomBooking) error {
+	bookedUntil := map[uuid.UUID]time.Time{}
+	for _, booking := range r.bookings {
+		if booking.RoomId != roomID || booking.EventId == nil || event != nil && *booking.EventId == event.ID {
+			continue
+		}
+		if booking.StartTime.After(bookedUntil[*booking.EventId]) {
+			bookedUntil[*booking.EventId] = booking.StartTime
+		}
+	}
+	var holds []*entity.SeriesHold
+	for id, until := range bookedUntil {
+		series, ok := r.events.events[id]
+		if !ok || !series.IsRecurring() || series.SeriesEnd != nil && !series.SeriesEnd.After(until) {
+			continue
+		}
+		hold, err := r.hold(&series, until, event)
+		if err != nil {
+			return err
+		}
+		holds = append(holds, hold)
+	}
+	for _, booking := range bookings {
+		for _, hold := range holds {
+			if _, ok := hold.Conflict(booking.StartTime, booking.EndTime); ok {
+				return helper.ErrRoomUnavailable
+			}
+		}
+	}
+	if event == nil || !event.IsRecurring() {
+		return nil
+	}
+
+	var until time.Time
+	for _, booking := range bookings {
+		if booking.StartTime.After(until) {
+			until = booking.StartTime
+		}
+	}
+	own, err := r.hold(event, until, nil)
+	if err != nil {
+		return err
+	}
+	for _, other := range r.bookings {
+		if other.RoomId != roomID || other.EventId != nil && *other.EventId == event.ID {
+			continue
+		}
+		if _, ok := own.Conflict(other.StartTime, other.EndTime); ok {
+			return helper.ErrRoomUnavailable
+		}
+	}
+	for _, hold := range holds {
+		if _, ok := own.ConflictWith(hold); ok {
+			return helper.ErrRoomUnavailable
+		}
+	}
+	return nil
+}
+
+// hold returns the hold of series on a room it is booked in up to
+// bookedUntil, without the occurrences its exceptions replace, event
+// among them when it is one.
+func (r *memoryRoomRepo) hold(series *entity.Event, bookedUntil time.Time, event *entity.Event) (*entity.SeriesHold, error) {
+	var except []time.Time
+	for _, exception := range r.events.events {
+		if exception.RecurringEventId != nil && *exception.RecurringEventId == series.ID && exception.OriginalStartTime != nil {
+			except = append(except, *exception.OriginalStartTime)
+		}
+	}
+	if event != nil && event.RecurringEventId != nil && *event.RecurringEventId == series.ID && event.OriginalStartTime != nil {
+		except = append(except, *event.OriginalStartTime)
+	}
+	return entity.NewSeriesHold(series, time.UTC, bookedUntil, except)
+}
+
+// replace checks and stores the bookings of an event in place of those it
+// has.
+func (r *memoryRoomRepo) replace(event *entity.Event, bookings []entity.RoomBooking) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.check(event, bookings); err != nil {
+		return err
+	}
+	var kept []*entity.RoomBooking
+	for _, booking := range r.bookings {
+		if booking.EventId == nil || *booking.EventId != event.ID {
+			kept = append(kept, booking)
+		}
+	}
+	for i := range bookings {
+		booking := bookings[i]
+		kept = append(kept, &booking)
+	}
+	r.bookings = kept
+	return nil
+}
+
+// release deletes the bookings of the event eventID starting at the times
+// matched.
+func (r *memoryRoomRepo) release(eventID uuid.UUID, match func(time.Time) bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bookings = slices.DeleteFunc(r.bookings, func(booking *entity.RoomBooking) bool {
+		return booking.EventId != nil && *booking.EventId == eventID && match(booking.StartTime)
+	})
+}
+
+func (r *memoryRoomRepo) CreateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *room
+	r.rooms[room.ID] = &stored
+	return room, nil
+}
+
+func (r *memoryRoomRepo) UpdateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.rooms[room.ID]; !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	stored := *room
+	r.rooms[room.ID] = &stored
+	return room, nil
+}
+
+func (r *memoryRoomRepo) GetRoom(ctx context.Context, id string) (*entity.Room, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	room, ok := r.rooms[uuid.MustParse(id)]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	out := *room
+	return &out, nil
+}
+
+func (r *memoryRoomRepo) DeleteRoom(ctx context.Context, id string, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	roomID := uuid.MustParse(id)
+	if _, ok := r.rooms[roomID]; !ok {
+		return gorm.ErrRecordNotFound
+	}
+	for _, booking := range r.bookings {
+		if booking.RoomId == roomID && booking.EndTime.After(now) {
+			return helper.ErrRoomHasBookings
+		}
+	}
+	delete(r.rooms, roomID)
+	return nil
+}
+
+func (r *memoryRoomRepo) ListRooms(ctx context.Context, masjidID string) ([]entity.Room, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var rooms []entity.Room
+	for _, room := range r.rooms {
+		if room.MasjidId == masjidID {
+			rooms = append(rooms, *room)
+		}
+	}
+	sort.Slice(rooms, func(i, j int) bool { return rooms[i].Name < rooms[j].Name })
+	return rooms, nil
+}
+
+func (r *memoryRoomRepo) CreateBooking(ctx context.Context, booking *entity.RoomBooking) (*entity.RoomBooking, error) {
+	if r.events != nil {
+		r.events.mu.Lock()
+		defer r.events.mu.Unlock()
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.check(nil, []entity.RoomBooking{*booking}); err != nil {
+		return nil, err
+	}
+	stored := *booking
+	r.bookings = append(r.bookings, &stored)
+	return booking, nil
+}
+
+func (r *memoryRoomRepo) GetBooking(ctx context.Context, id string) (*entity.RoomBooking, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, booking := range r.bookings {
+		if booking.ID.String() == id {
+			out := *booking
+			return &out, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memoryRoomRepo) DeleteBooking(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, booking := range r.bookings {
+		if booking.ID.String() == id {
+			r.bookings = append(r.bookings[:i], r.bookings[i+1:]...)
+			return nil
+		}
+	}
+	return gorm.ErrRecordNotFound
+}
+
+func (r *memoryRoomRepo) ListBookings(ctx context.Context, roomID string, from, to time.Time) ([]entity.RoomBooking, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var bookings []entity.RoomBooking
+	for _, booking := range r.bookings {
+		if booking.RoomId.String() == roomID && booking.Overlaps(from, to) {
+			bookings = append(bookings, *booking)
+		}
+	}
+	sort.Slice(bookings, func(i, j int) bool { return bookings[i].StartTime.Before(bookings[j].StartTime) })
+	return bookings, nil
+}
+
+func (r *memoryRoomRepo) ListUserBookings(ctx context.Context, userID string, from time.Time) ([]entity.RoomBooking, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var bookings []entity.RoomBooking
+	for _, booking := range r.bookings {
+		if booking.UserId != nil && booking.UserId.String() == userID && booking.EndTime.After(from) {
+			out := *booking
+			out.Room = r.rooms[booking.RoomId]
+			bookings = append(bookings, out)
+		}
+	}
+	sort.Slice(bookings, func(i, j int) bool { return bookings[i].StartTime.Before(bookings[j].StartTime) })
+	return bookings, nil
+}
+
+type roomFixture struct {
+	*rsvpFixture
+	rooms    *services.RoomService
+	roomRepo *memoryRoomRepo
+	masjidID string
+	// day is 18:00 UTC two days from now.
+	day time.Time
+}
+
+func newRoomFixture(t *testing.T) *roomFixture {
+	f := newRsvpFixture()
+	repo := newMemoryRoomRepo()
+	repo.events = f.repo
+	f.repo.rooms = repo
+	f.svc.RoomRepo = repo
+	masjid := &entity.Masjid{ID: uuid.New(), Name: "Masjid Al-Noor"}
+	f.svc.MasjidRepo = &eventMasjidRepo{masjid: masjid}
+	day := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 2).Add(18 * time.Hour)
+	return &roomFixture{
+		rsvpFixture: f,
+		rooms:       services.NewRoomService(repo, f.svc.MasjidRepo),
+		roomRepo:    repo,
+		masjidID:    masjid.ID.String(),
+		day:         day,
+	}
+}
+
+func (f *roomFixture) room(t *testing.T, name string, capacity int32, designation entity.GenderRestriction) *entity.Room {
+	room, err := f.rooms.CreateRoom(context.Background(), &entity.Room{
+		MasjidId:    f.masjidID,
+		Name:        name,
+		Capacity:    capacity,
+		Designation: designation,
+	})
+	require.NoError(t, err)
+	return room
+}
+
+// book creates an event from hour to hour on f.day in the given rooms.
+func (f *roomFixture) book(name string, from, to float64, rooms ...*entity.Room) (*entity.Event, error) {
+	event := &entity.Event{
+		ID:        uuid.New(),
+		MasjidId:  f.masjidID,
+		Name:      name,
+		StartTime: f.day.Add(time.Duration(from * float64(time.Hour))),
+		EndTime:   f.day.Add(time.Duration(to * float64(time.Hour))),
+	}
+	ids := make([]uuid.UUID, 0, len(rooms))
+	for _, room := range rooms {
+		ids = append(ids, room.ID)
+	}
+	event.SetRooms(ids...)
+	return f.svc.Create(context.Background(), event)
+}
+
+func TestCreateEvent_RejectsOverlappingRoomBookings(t *testing.T) {
+	ctx := context.Background()
+	f := newRoomFixture(t)
+	hall := f.room(t, "Main hall", 200, entity.NO_RESTRICTION)
+	sisters := f.room(t, "Sisters' hall", 60, entity.FEMALE_ONLY)
+
+	lecture, err := f.book("Lecture", 0, 2, hall, sisters)
+	require.NoError(t, err)
+	require.Len(t, lecture.Bookings, 2)
+	assert.Equal(t, lecture.ID, *lecture.Bookings[0].EventId)
+	assert.Equal(t, "Lecture", lecture.Bookings[0].Purpose)
+	assert.Equal(t, lecture.StartTime, lecture.Bookings[0].StartTime)
+
+	_, err = f.book("Dinner", 1.5, 3, hall)
+	assert.ErrorIs(t, err, helper.ErrRoomUnavailable)
+	_, err = f.book("Dinner", 2, 3, hall)
+	assert.NoError(t, err, "bookings that only touch do not overlap")
+
+	_, err = f.rooms.BookRoom(ctx, &entity.RoomBooking{
+		RoomId:    sisters.ID,
+		Purpose:   "Aqiqah",
+		StartTime: f.day.Add(3 * time.Hour),
+		EndTime:   f.day.Add(5 * time.Hour),
+	}, uuid.New().String())
+	require.NoError(t, err)
+	_, err = f.book("Sisters' circle", 4, 6, sisters)
+	assert.ErrorIs(t, err, helper.ErrRoomUnavailable, "member bookings hold rooms against events")
+
+	elsewhere, err := f.rooms.Repo.CreateRoom(ctx, &entity.Room{ID: uuid.New(), MasjidId: uuid.New().String(), Name: "Gym", Capacity: 50})
+	require.NoError(t, err)
+	_, err = f.book("Basketball", 8, 10, elsewhere)
+	assert.ErrorIs(t, err, helper.ErrInvalidBooking)
+
+	brothers := &entity.Event{ID: uuid.New(), MasjidId: f.masjidID, Name: "Brothers' halaqa", StartTime: f.day.Add(8 * time.Hour), EndTime: f.day.Add(9 * time.Hour), GenderRestriction: entity.MALE_ONLY}
+	brothers.SetRooms(sisters.ID)
+	_, err = f.svc.Create(ctx, brothers)
+	assert.ErrorIs(t, err, helper.ErrInvalidBooking)
+
+	crowded := &entity.Event{ID: uuid.New(), MasjidId: f.masjidID, Name: "Eid dinner", StartTime: f.day.Add(8 * time.Hour), EndTime: f.day.Add(9 * time.Hour), MaxParticipants: 250}
+	crowded.SetRooms(hall.ID)
+	_, err = f.svc.Create(ctx, crowded)
+	assert.ErrorIs(t, err, helper.ErrInvalidBooking)
+	crowded.SetRooms(hall.ID, sisters.ID)
+	_, err = f.svc.Create(ctx, crowded)
+	assert.NoError(t, err, "the rooms together hold the participants")
+}
+
+func TestRecurringEvent_BooksRoomsForEachOccurrence(t *testing.T) {
+	ctx := context.Background()
+	f := newRoomFixture(t)
+	hall := f.room(t, "Main hall", 200, entity.NO_RESTRICTION)
+	week := func(n int, hours float64) time.Time {
+		return f.day.AddDate(0, 0, 7*n).Add(time.Duration(hours * float64(time.Hour)))
+	}
+	at := func(name string, n int, from, to float64) *entity.Event {
+		event := &entity.Event{ID: uuid.New(), MasjidId: f.masjidID, Name: name, StartTime: week(n, from), EndTime: week(n, to)}
+		event.SetRooms(hall.ID)
+		return event
+	}
+	hallFree := func(n int, from, to float64) bool {
+		bookings, err := f.roomRepo.ListBookings(ctx, hall.ID.String(), week(n, from), week(n, to))
+		require.NoError(t, err)
+		return len(bookings) == 0
+	}
+
+	_, err := f.svc.Create(ctx, at("Wedding", 2, 1, 3))
+	require.NoError(t, err)
+	class := at("Weekly class", 0, 0, 2)
+	class.Recurrence = "RRULE:FREQ=WEEKLY;COUNT=4"
+	_, err = f.svc.Create(ctx, class)
+	assert.ErrorIs(t, err, helper.ErrRoomUnavailable, "a later occurrence clashes with the wedding")
+
+	class = at("Weekly class", 0, 3, 5)
+	class.Recurrence = "RRULE:FREQ=WEEKLY;COUNT=4"
+	created, err := f.svc.Create(ctx, class)
+	require.NoError(t, err)
+	assert.Equal(t, []string{hall.ID.String()}, created.RoomIds())
+	require.Len(t, created.Bookings, 4)
+	for n := range 4 {
+		assert.False(t, hallFree(n, 3, 5), "week %d", n)
+	}
+	_, err = f.rooms.BookRoom(ctx, &entity.RoomBooking{RoomId: hall.ID, Purpose: "Aqiqah", StartTime: week(3, 4), EndTime: week(3, 6)}, uuid.New().String())
+	assert.ErrorIs(t, err, helper.ErrRoomUnavailable)
+
+	// Editing an occurrence on its own moves its booking and frees the
+	// time it had.
+	moved, err := f.svc.UpdateOccurrence(ctx, created.ID.String(), week(1, 3), entity.ThisEvent, &entity.Event{StartTime: week(1, 6), EndTime: week(1, 8)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{hall.ID.String()}, moved.RoomIds())
+	assert.True(t, hallFree(1, 3, 5))
+	assert.False(t, hallFree(1, 6, 8))
+
+	require.NoError(t, f.svc.DeleteOccurrence(ctx, created.ID.String(), week(3, 3), entity.ThisEvent))
+	assert.True(t, hallFree(3, 3, 5))
+
+	// Moving the series moves its bookings.
+	_, err = f.svc.Update(ctx, &entity.Event{ID: created.ID, StartTime: week(0, 1), EndTime: week(0, 2)})
+	assert.ErrorIs(t, err, helper.ErrRoomUnavailable, "the third occurrence would clash with the wedding")
+	_, err = f.svc.Update(ctx, &entity.Event{ID: created.ID, StartTime: week(0, 8), EndTime: week(0, 10)})
+	require.NoError(t, err)
+	assert.True(t, hallFree(0, 3, 5))
+	assert.False(t, hallFree(0, 8, 10))
+	assert.False(t, hallFree(1, 6, 8), "the edited occurrence keeps its own booking")
+
+	// Splitting the series books the rooms for the series split off.
+	following, err := f.svc.UpdateOccurrence(ctx, created.ID.String(), week(2, 8), entity.ThisAndFollowing, &entity.Event{StartTime: week(2, 11), EndTime: week(2, 13)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{hall.ID.String()}, following.RoomIds())
+	assert.True(t, hallFree(2, 8, 10))
+	assert.False(t, hallFree(2, 11, 13))
+	assert.False(t, hallFree(0, 8, 10))
+
+	// Series without an end book their occurrences in the window only.
+	open := at("Daily dars", 10, 12, 13)
+	open.Recurrence = "RRULE:FREQ=DAILY"
+	created, err = f.svc.Create(ctx, open)
+	require.NoError(t, err)
+	assert.Len(t, created.Bookings, int(services.MaxRecurrenceWindow/(24*time.Hour)))
+}
+
+func TestRecurringEvent_HoldsRoomsBeyondItsBookings(t *testing.T) {
+	ctx := context.Background()
+	f := newRoomFixture(t)
+	hall := f.room(t, "Main hall", 200, entity.NO_RESTRICTION)
+	week := func(n int, hours float64) time.Time {
+		return f.day.AddDate(0, 0, 7*n).Add(time.Duration(hours * float64(time.Hour)))
+	}
+	at := func(name string, start, end time.Time) *entity.Event {
+		event := &entity.Event{ID: uuid.New(), MasjidId: f.masjidID, Name: name, StartTime: start, EndTime: end}
+		event.SetRooms(hall.ID)
+		return event
+	}
+	bookRoom := func(start, end time.Time) error {
+		_, err := f.rooms.BookRoom(ctx, &entity.RoomBooking{RoomId: hall.ID, Purpose: "Aqiqah", StartTime: start, EndTime: end}, uuid.New().String())
+		return err
+	}
+
+	// A one-off event booked first holds the hall against a series
+	// reaching it only by its rule.
+	_, err := f.svc.Create(ctx, at("Nikah", week(100, 6), week(100, 7)))
+	require.NoError(t, err)
+	evening := at("Evening class", week(0, 6), week(0, 7))
+	evening.Recurrence = "RRULE:FREQ=WEEKLY"
+	_, err = f.svc.Create(ctx, evening)
+	assert.ErrorIs(t, err, helper.ErrRoomUnavailable, "the class would meet in the hall during the nikah")
+
+	halaqa := at("Friday halaqa", week(0, 1), week(0, 3))
+	halaqa.Recurrence = "RRULE:FREQ=WEEKLY"
+	created, err := f.svc.Create(ctx, halaqa)
+	require.NoError(t, err)
+	last := created.Bookings[len(created.Bookings)-1].StartTime
+	require.True(t, last.Before(week(70, 0)), "week 70 is beyond the bookings of the series")
+
+	_, err = f.svc.Create(ctx, at("Walima", week(70, 2), week(70, 4)))
+	assert.ErrorIs(t, err, helper.ErrRoomUnavailable)
+	_, err = f.svc.Create(ctx, at("Walima", week(70, 3), week(70, 5)))
+	assert.NoError(t, err, "bookings that only touch do not overlap")
+	_, err = f.svc.Create(ctx, at("Walima", week(70, 25), week(70, 27)))
+	assert.NoError(t, err, "the series holds the hall at its own times only")
+	assert.ErrorIs(t, bookRoom(week(80, 0), week(80, 2)), helper.ErrRoomUnavailable)
+	assert.NoError(t, bookRoom(week(80, 4), week(80, 6)))
+
+	// An occurrence moved on its own no longer holds its time.
+	_, err = f.svc.UpdateOccurrence(ctx, created.ID.String(), week(90, 1), entity.ThisEvent, &entity.Event{StartTime: week(90, 8), EndTime: week(90, 10)})
+	require.NoError(t, err)
+	assert.NoError(t, bookRoom(week(90, 1), week(90, 3)))
+	assert.ErrorIs(t, bookRoom(week(90, 9), week(90, 11)), helper.ErrRoomUnavailable)
+
+	// Two series are compared by their rules beyond the bookings of both:
+	// a yearly dinner first falling on the halaqa's weekday after its
+	// bookings clashes with it.
+	start := f.day.AddDate(0, 0, 1)
+	for start.Weekday() == f.day.Weekday() || start.AddDate(1, 0, 0).Weekday() == f.day.Weekday() {
+		start = start.AddDate(0, 0, 1)
+	}
+	dinner := at("Annual dinner", start.Add(time.Hour), start.Add(3*time.Hour))
+	dinner.Recurrence = "RRULE:FREQ=YEARLY"
+	_, err = f.svc.Create(ctx, dinner)
+	assert.ErrorIs(t, err, helper.ErrRoomUnavailable)
+	dinner = at("Annual dinner", start.Add(3*time.Hour), start.Add(5*time.Hour))
+	dinner.Recurrence = "RRULE:FREQ=YEARLY"
+	_, err = f.svc.Create(ctx, dinner)
+	assert.NoError(t, err)
+
+	// A series that ends holds the hall no further.
+	require.NoError(t, f.svc.DeleteOccurrence(ctx, created.ID.String(), week(60, 1), entity.ThisAndFollowing))
+	assert.NoError(t, bookRoom(week(80, 0), week(80, 2)))
+}
+
+func TestUpdateEvent_MovesRoomBookings(t *testing.T) {
+	ctx := context.Background()
+	f := newRoomFixture(t)
+	hall := f.room(t, "Main hall", 200, entity.NO_RESTRICTION)
+	classroom := f.room(t, "Classroom", 30, entity.NO_RESTRICTION)
+	lecture, err := f.book("Lecture", 0, 2, hall)
+	require.NoError(t, err)
+	dinner, err := f.book("Dinner", 2, 3, hall)
+	require.NoError(t, err)
+
+	_, err = f.svc.Update(ctx, &entity.Event{ID: lecture.ID, EndTime: f.day.Add(150 * time.Minute)})
+	assert.ErrorIs(t, err, helper.ErrRoomUnavailable)
+	stored, err := f.svc.Repo.GetByID(ctx, lecture.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, f.day.Add(2*time.Hour), stored.EndTime, "the event is left as it was")
+
+	// Moving the lecture earlier moves its booking, which it may overlap.
+	_, err = f.svc.Update(ctx, &entity.Event{ID: lecture.ID, StartTime: f.day.Add(-time.Hour), EndTime: f.day.Add(time.Hour)})
+	require.NoError(t, err)
+	availability, err := f.rooms.GetRoomAvailability(ctx, hall.ID.String(), f.day.Add(-2*time.Hour), f.day.Add(4*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, availability.Bookings, 2)
+	assert.Equal(t, f.day.Add(-time.Hour), availability.Bookings[0].StartTime)
+	assert.Equal(t, []entity.TimeRange{
+		{Start: f.day.Add(-2 * time.Hour), End: f.day.Add(-time.Hour)},
+		{Start: f.day.Add(time.Hour), End: f.day.Add(2 * time.Hour)},
+		{Start: f.day.Add(3 * time.Hour), End: f.day.Add(4 * time.Hour)},
+	}, availability.Free)
+
+	// Changes that leave the times alone leave the bookings alone.
+	_, err = f.svc.Update(ctx, &entity.Event{ID: lecture.ID, Description: "Tafsir of Surah al-Kahf"})
+	require.NoError(t, err)
+	require.Len(t, f.roomRepo.bookings, 2)
+
+	// Moving the lecture to the classroom frees the hall for the dinner.
+	updated, err := f.svc.Update(ctx, &entity.Event{ID: lecture.ID, Bookings: []entity.RoomBooking{{RoomId: classroom.ID}}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{classroom.ID.String()}, updated.RoomIds())
+	_, err = f.svc.Update(ctx, &entity.Event{ID: dinner.ID, StartTime: f.day})
+	assert.NoError(t, err)
+
+	_, err = f.svc.Update(ctx, &entity.Event{ID: dinner.ID, MaxParticipants: 500})
+	assert.ErrorIs(t, err, helper.ErrInvalidBooking)
+}
+
+func TestBookRoom_ValidatesAndCancels(t *testing.T) {
+	ctx := context.Background()
+	f := newRoomFixture(t)
+	hall := f.room(t, "Main hall", 200, entity.NO_RESTRICTION)
+	groom, guest := uuid.New().String(), uuid.New().String()
+	nikkah := func(from, to float64, guests int32) *entity.RoomBooking {
+		return &entity.RoomBooking{
+			RoomId:    hall.ID,
+			Purpose:   " Nikkah ceremony ",
+			StartTime: f.day.Add(time.Duration(from * float64(time.Hour))),
+			EndTime:   f.day.Add(time.Duration(to * float64(time.Hour))),
+			Guests:    guests,
+		}
+	}
+
+	for name, bad := range map[string]*entity.RoomBooking{
+		"past":         {RoomId: hall.ID, Purpose: "Nikkah", StartTime: time.Now().Add(-time.Hour), EndTime: time.Now().Add(time.Hour)},
+		"ends first":   nikkah(2, 1, 0),
+		"too long":     nikkah(0, 25, 0),
+		"too many":     nikkah(0, 2, 201),
+		"with purpose": {RoomId: hall.ID, Purpose: "  ", StartTime: f.day, EndTime: f.day.Add(time.Hour)},
+	} {
+		_, err := f.rooms.BookRoom(ctx, bad, groom)
+		assert.ErrorIs(t, err, helper.ErrInvalidBooking, name)
+	}
+
+	booking, err := f.rooms.BookRoom(ctx, nikkah(0, 2, 150), groom)
+	require.NoError(t, err)
+	assert.Equal(t, "Nikkah ceremony", booking.Purpose)
+	assert.Equal(t, groom, booking.UserId.String())
+	_, err = f.rooms.BookRoom(ctx, nikkah(1, 3, 0), guest)
+	assert.ErrorIs(t, err, helper.ErrRoomUnavailable)
+	_, err = f.book("Lecture", 1, 3, hall)
+	assert.ErrorIs(t, err, helper.ErrRoomUnavailable)
+
+	mine, err := f.rooms.ListMyBookings(ctx, groom)
+	require.NoError(t, err)
+	require.Len(t, mine, 1)
+	assert.Equal(t, "Main hall", mine[0].Room.Name)
+
+	assert.ErrorIs(t, f.rooms.DeleteRoom(ctx, hall.ID.String()), helper.ErrRoomHasBookings)
+	assert.ErrorIs(t, f.rooms.CancelBooking(ctx, booking.ID.String(), guest, false), gorm.ErrRecordNotFound)
+	require.NoError(t, f.rooms.CancelBooking(ctx, booking.ID.String(), groom, false))
+	_, err = f.book("Lecture", 1, 3, hall)
+	require.NoError(t, err)
+
+	lecture := f.roomRepo.bookings[0]
+	assert.ErrorIs(t, f.rooms.CancelBooking(ctx, lecture.ID.String(), groom, false), gorm.ErrRecordNotFound, "members cannot cancel events")
+	require.NoError(t, f.rooms.CancelBooking(ctx, lecture.ID.String(), uuid.New().String(), true))
+	assert.NoError(t, f.rooms.DeleteRoom(ctx, hall.ID.String()))
+}
+
+func TestBookRoom_ConcurrentBookingsNeverOverlap(t *testing.T) {
+	ctx := context.Background()
+	f := newRoomFixture(t)
+	hall := f.room(t, "Main hall", 200, entity.NO_RESTRICTION)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	booked := 0
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start := f.day.Add(time.Duration(i) * 10 * time.Minute)
+			_, err := f.rooms.BookRoom(ctx, &entity.RoomBooking{RoomId: hall.ID, Purpose: "Aqiqah", StartTime: start, EndTime: start.Add(2 * time.Hour)}, uuid.New().String())
+			if err != nil {
+				assert.ErrorIs(t, err, helper.ErrRoomUnavailable)
+				return
+			}
+			mu.Lock()
+			booked++
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, booked)
+}
+
+// createRoom stores a masjid with a room in it, deleted with the events
+// and bookings of the masjid when the test ends. It returns the room and
+// 18:00 UTC two days from now. The masjid has no time zone, so recurring
+// events keep to UTC.
+func (suite *DatabaseGrpcHandlerTestSuite) createRoom() (*entity.Room, time.Time) {
+	masjid := &entity.Masjid{ID: uuid.New(), Name: "Masjid Al-Noor"}
+	require.NoError(suite.T(), suite.DB.Create(masjid).Error)
+	room, err := suite.RoomService.CreateRoom(context.Background(), &entity.Room{
+		MasjidId: masjid.ID.String(),
+		Name:     "Main hall",
+		Capacity: 200,
+	})
+	require.NoError(suite.T(), err)
+	suite.T().Cleanup(func() {
+		suite.DB.Delete(&entity.RoomBooking{}, "room_id = ?", room.ID)
+		suite.DB.Delete(&entity.Event{}, "masjid_id = ?", masjid.ID.String())
+		suite.DB.Delete(&entity.Room{}, "id = ?", room.ID)
+		suite.DB.Delete(&entity.Masjid{}, "id = ?", masjid.ID)
+	})
+	return room, time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 2).Add(18 * time.Hour)
+}
+
+// roomEvent returns an event from start to end held in room.
+func roomEvent(room *entity.Room, name string, start, end time.Time) *entity.Event {
+	event := &entity.Event{MasjidId: room.MasjidId, Name: name, StartTime: start, EndTime: end}
+	event.SetRooms(room.ID)
+	return event
+}
+
+// createEventsConcurrently creates events at once, returning the error of
+// each.
+func (suite *DatabaseGrpcHandlerTestSuite) createEventsConcurrently(events ...*entity.Event) []error {
+	errs := make([]error, len(events))
+	var wg sync.WaitGroup
+	for i := range events {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = suite.EventService.Create(context.Background(), events[i])
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) countBookings(room *entity.Room) int64 {
+	var bookings int64
+	require.NoError(suite.T(), suite.DB.Model(&entity.RoomBooking{}).Where("room_id = ?", room.ID).Count(&bookings).Error)
+	return bookings
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) TestBookRoom_ConcurrentOverlappingBookings() {
+	room, day := suite.createRoom()
+	const members = 8
+	ctxs := make([]context.Context, members)
+	for i := range ctxs {
+		_, ctxs[i] = suite.createMember(fmt.Sprintf("host%d", i))
+	}
+
+	errs := make([]error, members)
+	var wg sync.WaitGroup
+	for i := range ctxs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// Each booking overlaps every other by at least an hour.
+			start := day.Add(time.Duration(i) * 10 * time.Minute)
+			_, errs[i] = suite.RoomHandler.BookRoom(ctxs[i], &pb.BookRoomRequest{
+				RoomId: room.ID.String(),
+				Booking: &pb.RoomBooking{
+					Purpose:   "Aqiqah",
+					StartTime: timestamppb.New(start),
+					EndTime:   timestamppb.New(start.Add(3 * time.Hour)),
+				},
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	booked := 0
+	for _, err := range errs {
+		if err == nil {
+			booked++
+			continue
+		}
+		st, ok := status.FromError(err)
+		require.True(suite.T(), ok)
+		assert.Equal(suite.T(), codes.FailedPrecondition, st.Code(), "%v", err)
+	}
+	assert.Equal(suite.T(), 1, booked, "the room is booked once at a time")
+	assert.Equal(suite.T(), int64(1), suite.countBookings(room))
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) TestCreateEvent_ConcurrentEventsInOneRoom() {
+	room, day := suite.createRoom()
+	var events []*entity.Event
+	for i := 0; i < 5; i++ {
+		start := day.Add(time.Duration(i) * 15 * time.Minute)
+		events = append(events, roomEvent(room, fmt.Sprintf("Lecture %d", i+1), start, start.Add(2*time.Hour)))
+	}
+
+	created := 0
+	for _, err := range suite.createEventsConcurrently(events...) {
+		if err == nil {
+			created++
+			continue
+		}
+		assert.ErrorIs(suite.T(), err, helper.ErrRoomUnavailable)
+	}
+	assert.Equal(suite.T(), 1, created)
+	assert.Equal(suite.T(), int64(1), suite.countBookings(room))
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) TestRecurringEvent_HoldsRoomsBeyondItsBookings() {
+	ctx := context.Background()
+	room, day := suite.createRoom()
+	week := func(n int, hours float64) time.Time {
+		return day.AddDate(0, 0, 7*n).Add(time.Duration(hours * float64(time.Hour)))
+	}
+
+	halaqa := roomEvent(room, "Friday halaqa", week(0, 1), week(0, 3))
+	halaqa.Recurrence = "RRULE:FREQ=WEEKLY"
+	series, err := suite.EventService.Create(ctx, halaqa)
+	require.NoError(suite.T(), err)
+	last := series.Bookings[len(series.Bookings)-1].StartTime
+	require.True(suite.T(), last.Before(week(70, 0)), "week 70 is beyond the bookings of the series")
+
+	_, err = suite.EventService.Create(ctx, roomEvent(room, "Walima", week(70, 2), week(70, 4)))
+	assert.ErrorIs(suite.T(), err, helper.ErrRoomUnavailable)
+	_, err = suite.EventService.Create(ctx, roomEvent(room, "Walima", week(70, 3), week(70, 5)))
+	assert.NoError(suite.T(), err, "bookings that only touch do not overlap")
+
+	_, member := suite.createMember("host")
+	_, err = suite.RoomHandler.BookRoom(member, &pb.BookRoomRequest{
+		RoomId: room.ID.String(),
+		Booking: &pb.RoomBooking{
+			Purpose:   "Aqiqah",
+			StartTime: timestamppb.New(week(80, 0)),
+			EndTime:   timestamppb.New(week(80, 2)),
+		},
+	})
+	st, ok := status.FromError(err)
+	require.True(suite.T(), ok)
+	assert.Equal(suite.T(), codes.FailedPrecondition, st.Code(), "%v", err)
+
+	// A series and an event it reaches only by its rule, created at once,
+	// are checked one after the other.
+	evening := roomEvent(room, "Evening class", week(0, 6), week(0, 7))
+	evening.Recurrence = "RRULE:FREQ=WEEKLY"
+	created := 0
+	for _, err := range suite.createEventsConcurrently(evening, roomEvent(room, "Nikah", week(100, 6), week(100, 7))) {
+		if err == nil {
+			created++
+			continue
+		}
+		assert.ErrorIs(suite.T(), err, helper.ErrRoomUnavailable)
+	}
+	assert.Equal(suite.T(), 1, created)
+}
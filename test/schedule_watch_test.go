@@ -0,0 +1,133 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+	"github.com/mnadev/limestone/internal/application/repository"
+	"github.com/mnadev/limestone/internal/application/services"
+)
+
+// watchMasjidRepo serves a single masjid with no iqamah rules or overrides.
+// Methods the watch does not use panic through the nil embedded interface.
+type watchMasjidRepo struct {
+	repository.MasjidRepository
+	masjid *entity.Masjid
+}
+
+func (r *watchMasjidRepo) GetByID(ctx context.Context, id string) (*entity.Masjid, error) {
+	return r.masjid, nil
+}
+
+func (r *watchMasjidRepo) ListIqamahRules(ctx context.Context, masjidID string) ([]entity.IqamahRule, error) {
+	return nil, nil
+}
+
+func (r *watchMasjidRepo) ListPrayerTimeOverrides(ctx context.Context, masjidID string, from string, to string) ([]entity.PrayerTimeOverride, error) {
+	return nil, nil
+}
+
+func TestScheduleNotifier_CoalescesAndUnsubscribes(t *testing.T) {
+	n := services.NewScheduleNotifier()
+	ch, unsubscribe := n.Subscribe("m1")
+	other, unsubscribeOther := n.Subscribe("m2")
+	defer unsubscribeOther()
+
+	n.Notify("m1")
+	n.Notify("m1")
+	assert.Len(t, ch, 1)
+	assert.Len(t, other, 0)
+	<-ch
+
+	unsubscribe()
+	n.Notify("m1")
+	assert.Len(t, ch, 0)
+}
+
+func TestTimesNextAdhan(t *testing.T) {
+	day := time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC)
+	times := &prayertimes.Times{
+		Fajr:    day.Add(5 * time.Hour),
+		Dhuhr:   day.Add(13 * time.Hour),
+		Asr:     day.Add(16 * time.Hour),
+		Maghrib: day.Add(19 * time.Hour),
+		Isha:    day.Add(20 * time.Hour),
+	}
+
+	prayer, at, ok := times.NextAdhan(day)
+	assert.True(t, ok)
+	assert.Equal(t, entity.FAJR, prayer)
+	assert.Equal(t, times.Fajr, at)
+
+	// An adhan that is exactly now has already been announced.
+	prayer, _, ok = times.NextAdhan(times.Dhuhr)
+	assert.True(t, ok)
+	assert.Equal(t, entity.ASR, prayer)
+
+	_, _, ok = times.NextAdhan(times.Isha)
+	assert.False(t, ok)
+}
+
+func TestWatchPrayerSchedule_SnapshotThenUpdate(t *testing.T) {
+	masjid := &entity.Masjid{
+		ID:           uuid.New(),
+		Latitude:     43.6532,
+		Longitude:    -79.3832,
+		TimeZone:     "America/Toronto",
+		PrayerConfig: entity.PrayerTimesConfiguration{CalculationMethod: entity.NORTH_AMERICA},
+	}
+	svc := services.NewMasjidService(&watchMasjidRepo{masjid: masjid})
+	svc.Changes = services.NewScheduleNotifier()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan *services.ScheduleEvent, 8)
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.WatchPrayerSchedule(ctx, masjid.ID.String(), func(e *services.ScheduleEvent) error {
+			events <- e
+			return nil
+		})
+	}()
+
+	next := func() *services.ScheduleEvent {
+		select {
+		case e := <-events:
+			return e
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for a schedule event")
+			return nil
+		}
+	}
+
+	first := next()
+	assert.Equal(t, services.ScheduleSnapshot, first.Kind)
+	require.NotNil(t, first.Times)
+	require.NotNil(t, first.Iqamah)
+	assert.Equal(t, "America/Toronto", first.Times.Fajr.Location().String())
+
+	// The watcher subscribes before sending the snapshot, so this change
+	// cannot be missed.
+	svc.Changes.Notify(masjid.ID.String())
+	update := next()
+	// An adhan may fall between the two events on a slow machine.
+	for update.Kind == services.ScheduleAdhan {
+		update = next()
+	}
+	assert.Equal(t, services.ScheduleUpdated, update.Kind)
+	assert.NotNil(t, update.Times)
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("watch did not stop after cancellation")
+	}
+}
@@ -0,0 +1,64 @@
+package test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+	"github.com/mnadev/limestone/internal/application/domain/timetable"
+)
+
+func TestParseTimetableCSV(t *testing.T) {
+	overrides, err := timetable.ParseCSV(strings.NewReader(
+		"Date,Fajr,Sunrise,Dhuhr,Asr,Maghrib,Isha\n" +
+			"2024-06-20,2:45,4:43 AM,1:02 pm,17:25,21:21,23:59:40\n" +
+			"2024-06-21,02:46,04:43,13:02,17:25,21:22,00:05\n"))
+	require.NoError(t, err)
+	require.Len(t, overrides, 2)
+	assert.Equal(t, entity.PrayerTimeOverride{
+		Date: "2024-06-20", Fajr: "02:45", Sunrise: "04:43", Dhuhr: "13:02", Asr: "17:25", Maghrib: "21:21", Isha: "23:59",
+	}, overrides[0])
+
+	// Isha after midnight belongs to the following day.
+	loc, err := time.LoadLocation("Europe/London")
+	require.NoError(t, err)
+	times, err := prayertimes.FromOverride(time.Date(2024, time.June, 21, 0, 0, 0, 0, time.UTC), loc, &overrides[1])
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.June, 22, 0, 5, 0, 0, loc), times.Isha)
+	assert.Equal(t, time.Date(2024, time.June, 21, 13, 2, 0, 0, loc), times.Dhuhr)
+}
+
+func TestParseTimetableCSV_RoundTripsExport(t *testing.T) {
+	file, err := timetable.Render(buildTimetable(t), timetable.CSV)
+	require.NoError(t, err)
+
+	overrides, err := timetable.ParseCSV(bytes.NewReader(file.Content))
+	require.NoError(t, err)
+	require.Len(t, overrides, 31)
+	assert.Equal(t, "2024-03-15", overrides[14].Date)
+	assert.Equal(t, "05:52", overrides[14].Fajr)
+	assert.Equal(t, "20:19", overrides[14].Isha)
+}
+
+func TestParseTimetableCSV_Rejects(t *testing.T) {
+	const header = "date,fajr,sunrise,dhuhr,asr,maghrib,isha\n"
+	for name, csv := range map[string]string{
+		"missing column":  "date,fajr,sunrise,dhuhr,asr,maghrib\n2024-01-01,05:00,06:00,12:00,15:00,17:00\n",
+		"no rows":         header,
+		"bad date":        header + "01/02/2024,05:00,06:00,12:00,15:00,17:00,18:30\n",
+		"bad time":        header + "2024-01-01,5am,06:00,12:00,15:00,17:00,18:30\n",
+		"gap":             header + "2024-01-01,05:00,06:00,12:00,15:00,17:00,18:30\n2024-01-03,05:00,06:00,12:00,15:00,17:00,18:30\n",
+		"duplicate":       header + "2024-01-01,05:00,06:00,12:00,15:00,17:00,18:30\n2024-01-01,05:00,06:00,12:00,15:00,17:00,18:30\n",
+		"not monotonic":   header + "2024-01-01,05:00,06:00,15:00,12:00,17:00,18:30\n",
+		"sunrise at fajr": header + "2024-01-01,06:00,06:00,12:00,15:00,17:00,18:30\n",
+	} {
+		_, err := timetable.ParseCSV(strings.NewReader(csv))
+		assert.ErrorIs(t, err, timetable.ErrInvalidTimetable, name)
+	}
+}
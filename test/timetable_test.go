@@ -0,0 +1,122 @@
+package test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/domain/geo"
+	"github.com/mnadev/limestone/internal/application/domain/hijri"
+	"github.com/mnadev/limestone/internal/application/domain/prayertimes"
+	"github.com/mnadev/limestone/internal/application/domain/timetable"
+)
+
+func buildTimetable(t *testing.T) *timetable.Timetable {
+	return buildMonthTimetable(t, 2024, time.March)
+}
+
+func buildMonthTimetable(t *testing.T, year int, month time.Month) *timetable.Timetable {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	rules := []entity.IqamahRule{
+		{Prayer: entity.DHUHR, Type: entity.FIXED_TIME, FixedTime: "13:30"},
+		{Prayer: entity.MAGHRIB, Type: entity.MINUTES_AFTER_ADHAN, MinutesAfterAdhan: 5},
+	}
+	tt := &timetable.Timetable{MasjidId: "m1", MasjidName: "Masjid (Al-Noor)", Year: year, Month: month}
+	days := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for day := 1; day <= days; day++ {
+		date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		times, err := prayertimes.Calculate(date, geo.Coordinates{Latitude: 40.7128, Longitude: -74.0060}, loc,
+			entity.PrayerTimesConfiguration{CalculationMethod: entity.NORTH_AMERICA})
+		require.NoError(t, err)
+		times.Hijri, err = hijri.FromGregorian(date, 0)
+		require.NoError(t, err)
+		iqamah, err := prayertimes.ResolveIqamah(rules, times)
+		require.NoError(t, err)
+		tt.Days = append(tt.Days, timetable.Day{Times: *times, Iqamah: *iqamah})
+	}
+	return tt
+}
+
+func TestRenderTimetable_CSV(t *testing.T) {
+	file, err := timetable.Render(buildTimetable(t), timetable.CSV)
+	require.NoError(t, err)
+	assert.Equal(t, "prayer-timetable-2024-03.csv", file.Name)
+
+	rows, err := csv.NewReader(bytes.NewReader(file.Content)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 32)
+	assert.Equal(t, []string{"Date", "Hijri Date", "Fajr", "Fajr Iqamah", "Sunrise"}, rows[0][:5])
+	assert.Equal(t, []string{"2024-03-15", "1445-09-05", "05:52", "", "07:07", "13:06", "13:30", "16:26", "", "19:03", "19:08", "20:19", ""}, rows[15])
+}
+
+func TestRenderTimetable_ICS(t *testing.T) {
+	file, err := timetable.Render(buildTimetable(t), timetable.ICS)
+	require.NoError(t, err)
+	assert.Equal(t, "text/calendar; charset=utf-8", file.ContentType)
+
+	ics := string(file.Content)
+	assert.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\n"))
+	assert.Equal(t, 31*5, strings.Count(ics, "BEGIN:VEVENT"))
+	// 13:30 EDT on 15 March is 17:30 UTC.
+	assert.Contains(t, ics, "UID:2024-03-15-dhuhr@m1\r\nDTSTAMP:")
+	assert.Contains(t, ics, "DTEND:20240315T173000Z")
+	for _, line := range strings.Split(ics, "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+}
+
+func TestRenderTimetable_PDF(t *testing.T) {
+	file, err := timetable.Render(buildTimetable(t), timetable.PDF)
+	require.NoError(t, err)
+	assert.Equal(t, "application/pdf", file.ContentType)
+
+	pdf := string(file.Content)
+	assert.True(t, strings.HasPrefix(pdf, "%PDF-1.4"))
+	assert.True(t, strings.HasSuffix(pdf, "%%EOF\n"))
+	assert.Contains(t, pdf, `(Masjid \(Al-Noor\)) Tj`)
+	assert.Contains(t, pdf, `March 2024 \(Shaban - Ramadan 1445 AH\)`)
+
+	// Every xref entry must point at the object it names.
+	xref := strings.Index(pdf, "xref\n")
+	require.Positive(t, xref)
+	entries := strings.Split(pdf[xref:], "\n")[3:10]
+	for i, entry := range entries {
+		var offset int
+		_, err := fmt.Sscanf(entry, "%d", &offset)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(pdf[offset:], strconv.Itoa(i+1)+" 0 obj"))
+	}
+
+	_, err = timetable.Render(buildTimetable(t), timetable.Format(42))
+	assert.ErrorIs(t, err, timetable.ErrUnsupportedFormat)
+}
+
+func TestRenderTimetable_PDFLongHijriDates(t *testing.T) {
+	// December 2024 runs through Jumada al-Thaniyah 1446, the longest
+	// month name.
+	file, err := timetable.Render(buildMonthTimetable(t, 2024, time.December), timetable.PDF)
+	require.NoError(t, err)
+
+	var rows []string
+	for _, line := range strings.Split(string(file.Content), "\n") {
+		if text, ok := strings.CutPrefix(line, "BT /F1 8.5 Tf 36 "); ok {
+			rows = append(rows, text[strings.Index(text, "(")+1:strings.LastIndex(text, ")")])
+		}
+	}
+	require.Len(t, rows, 31)
+	assert.True(t, strings.HasPrefix(rows[28], "Sun Dec 29 28 Jumada al-Thaniyah 1446 05:58"), rows[28])
+	for _, row := range rows {
+		// 150 Courier characters fit between the margins at 8.5pt.
+		assert.LessOrEqual(t, len(row), 150)
+	}
+}
@@ -0,0 +1,590 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "github.com/mnadev/limestone/gen/go"
+	"github.com/mnadev/limestone/internal/application/domain/entity"
+	"github.com/mnadev/limestone/internal/application/helper"
+	"github.com/mnadev/limestone/internal/application/services"
+)
+
+// memoryVolunteerRepo keeps shifts and signups in memory. A single mutex
+// stands in for the shift lock of the Gorm repository.
+type memoryVolunteerRepo struct {
+	mu      sync.Mutex
+	shifts  map[uuid.UUID]*entity.VolunteerShift
+	signups []*entity.ShiftSignup
+	users   map[uuid.UUID]*entity.User
+}
+
+func newMemoryVolunteerRepo(users map[uuid.UUID]*entity.User) *memoryVolunteerRepo {
+	return &memoryVolunteerRepo{shifts: map[uuid.UUID]*entity.VolunteerShift{}, users: users}
+}
+
+func (r *memoryVolunteerRepo) claimed(shiftID uuid.UUID) int64 {
+	var n int64
+	for _, signup := range r.signups {
+		if signup.ShiftId == shiftID && signup.Status.HoldsPlace() {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *memoryVolunteerRepo) find(shiftID, userID uuid.UUID) *entity.ShiftSignup {
+	for _, signup := range r.signups {
+		if signup.ShiftId == shiftID && signup.UserId == userID {
+			return signup
+		}
+	}
+	return nil
+}
+
+func (r *memoryVolunteerRepo) CreateShift(ctx context.Context, shift *entity.VolunteerShift) (*entity.VolunteerShift, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *shift
+	r.shifts[shift.ID] = &stored
+	return shift, nil
+}
+
+func (r *memoryVolunteerRepo) UpdateShift(ctx context.Context, shift *entity.VolunteerShift) (*entity.VolunteerShift, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.shifts[shift.ID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	if int64(shift.Headcount) < r.claimed(shift.ID) {
+		return nil, helper.ErrShiftHeadcountTooLow
+	}
+	stored.Role, stored.Description, stored.Headcount = shift.Role, shift.Description, shift.Headcount
+	stored.StartTime, stored.EndTime, stored.UpdatedAt = shift.StartTime, shift.EndTime, shift.UpdatedAt
+	shift.Claimed = r.claimed(shift.ID)
+	return shift, nil
+}
+
+func (r *memoryVolunteerRepo) GetShift(ctx context.Context, id string) (*entity.VolunteerShift, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.shifts[uuid.MustParse(id)]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	out := *stored
+	out.Claimed = r.claimed(stored.ID)
+	return &out, nil
+}
+
+func (r *memoryVolunteerRepo) DeleteShift(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	shiftID := uuid.MustParse(id)
+	if _, ok := r.shifts[shiftID]; !ok {
+		return gorm.ErrRecordNotFound
+	}
+	var kept []*entity.ShiftSignup
+	for _, signup := range r.signups {
+		if signup.ShiftId == shiftID && signup.Status == entity.ShiftWorked {
+			return helper.ErrShiftHasHours
+		}
+		if signup.ShiftId != shiftID {
+			kept = append(kept, signup)
+		}
+	}
+	r.signups = kept
+	delete(r.shifts, shiftID)
+	return nil
+}
+
+func (r *memoryVolunteerRepo) ListShifts(ctx context.Context, eventID string, roster bool) ([]entity.VolunteerShift, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var shifts []entity.VolunteerShift
+	for _, stored := range r.shifts {
+		if stored.EventId.String() != eventID {
+			continue
+		}
+		shift := *stored
+		shift.Claimed = r.claimed(shift.ID)
+		if roster {
+			for _, signup := range r.signups {
+				if signup.ShiftId == shift.ID && signup.Status.HoldsPlace() {
+					out := *signup
+					out.User = r.users[signup.UserId]
+					shift.Signups = append(shift.Signups, out)
+				}
+			}
+			sort.SliceStable(shift.Signups, func(i, j int) bool {
+				return shift.Signups[i].ClaimedAt.Before(shift.Signups[j].ClaimedAt)
+			})
+		}
+		shifts = append(shifts, shift)
+	}
+	sort.Slice(shifts, func(i, j int) bool { return shifts[i].StartTime.Before(shifts[j].StartTime) })
+	return shifts, nil
+}
+
+func (r *memoryVolunteerRepo) ClaimShift(ctx context.Context, shiftID, userID string, now time.Time) (*entity.ShiftSignup, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	shift, ok := r.shifts[uuid.MustParse(shiftID)]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	user := uuid.MustParse(userID)
+	signup := r.find(shift.ID, user)
+	if signup != nil && signup.Status.HoldsPlace() {
+		out := *signup
+		return &out, nil
+	}
+	for _, other := range r.signups {
+		o := r.shifts[other.ShiftId]
+		if other.UserId == user && other.Status.HoldsPlace() && o.StartTime.Before(shift.EndTime) && o.EndTime.After(shift.StartTime) {
+			return nil, helper.ErrShiftOverlap
+		}
+	}
+	if r.claimed(shift.ID) >= int64(shift.Headcount) {
+		return nil, helper.ErrShiftFull
+	}
+	if signup == nil {
+		signup = &entity.ShiftSignup{ID: uuid.New(), ShiftId: shift.ID, UserId: user, CreatedAt: now}
+		r.signups = append(r.signups, signup)
+	}
+	signup.Status = entity.ShiftClaimed
+	signup.ClaimedAt = now
+	out := *signup
+	return &out, nil
+}
+
+func (r *memoryVolunteerRepo) ReleaseShift(ctx context.Context, shiftID, userID string) (*entity.ShiftSignup, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	signup := r.find(uuid.MustParse(shiftID), uuid.MustParse(userID))
+	if signup == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	signup.Status = entity.ShiftReleased
+	out := *signup
+	return &out, nil
+}
+
+func (r *memoryVolunteerRepo) RecordHours(ctx context.Context, shiftID, userID string, minutes int32, recordedBy string) (*entity.ShiftSignup, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	signup := r.find(uuid.MustParse(shiftID), uuid.MustParse(userID))
+	if signup == nil || !signup.Status.HoldsPlace() {
+		return nil, gorm.ErrRecordNotFound
+	}
+	recorder := uuid.MustParse(recordedBy)
+	signup.Status = entity.ShiftWorked
+	if minutes == 0 {
+		signup.Status = entity.ShiftNoShow
+	}
+	signup.MinutesWorked = minutes
+	signup.RecordedBy = &recorder
+	out := *signup
+	return &out, nil
+}
+
+func (r *memoryVolunteerRepo) ListUserSignups(ctx context.Context, userID string, from, to time.Time) ([]entity.ShiftSignup, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var signups []entity.ShiftSignup
+	for _, signup := range r.signups {
+		shift := r.shifts[signup.ShiftId]
+		if signup.UserId.String() == userID && signup.Status.HoldsPlace() && shift.EndTime.After(from) && shift.StartTime.Before(to) {
+			out := *signup
+			out.Shift = shift
+			signups = append(signups, out)
+		}
+	}
+	sort.Slice(signups, func(i, j int) bool { return signups[i].Shift.StartTime.Before(signups[j].Shift.StartTime) })
+	return signups, nil
+}
+
+func (r *memoryVolunteerRepo) SumHours(ctx context.Context, masjidID, userID string, from, to time.Time) ([]entity.VolunteerHours, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	totals := map[uuid.UUID]*entity.VolunteerHours{}
+	for _, signup := range r.signups {
+		shift := r.shifts[signup.ShiftId]
+		if signup.Status != entity.ShiftWorked || shift.StartTime.Before(from) || !shift.StartTime.Before(to) ||
+			masjidID != "" && shift.MasjidId != masjidID || masjidID == "" && signup.UserId.String() != userID {
+			continue
+		}
+		total, ok := totals[signup.UserId]
+		if !ok {
+			total = &entity.VolunteerHours{UserId: signup.UserId, User: r.users[signup.UserId]}
+			totals[signup.UserId] = total
+		}
+		total.MinutesWorked += int64(signup.MinutesWorked)
+		total.ShiftsWorked++
+	}
+	var hours []entity.VolunteerHours
+	for _, total := range totals {
+		hours = append(hours, *total)
+	}
+	sort.Slice(hours, func(i, j int) bool { return hours[i].MinutesWorked > hours[j].MinutesWorked })
+	return hours, nil
+}
+
+type volunteerFixture struct {
+	*rsvpFixture
+	volunteers *services.VolunteerService
+	shiftRepo  *memoryVolunteerRepo
+}
+
+func newVolunteerFixture() *volunteerFixture {
+	f := newRsvpFixture()
+	repo := newMemoryVolunteerRepo(f.repo.users)
+	masjids := &eventMasjidRepo{masjid: &entity.Masjid{ID: uuid.New(), Name: "Masjid Al-Noor", TimeZone: "America/Toronto"}}
+	return &volunteerFixture{
+		rsvpFixture: f,
+		volunteers:  services.NewVolunteerService(repo, f.repo, masjids),
+		shiftRepo:   repo,
+	}
+}
+
+func (f *volunteerFixture) shift(t *testing.T, event *entity.Event, role string, start time.Time, hours int, headcount int32) *entity.VolunteerShift {
+	shift, err := f.volunteers.CreateShift(context.Background(), &entity.VolunteerShift{
+		EventId:   event.ID,
+		Role:      role,
+		StartTime: start,
+		EndTime:   start.Add(time.Duration(hours) * time.Hour),
+		Headcount: headcount,
+	})
+	require.NoError(t, err)
+	return shift
+}
+
+// backdate moves a shift to start at start, as if it were claimed before
+// it started.
+func (f *volunteerFixture) backdate(shift *entity.VolunteerShift, start time.Time) {
+	stored := f.shiftRepo.shifts[shift.ID]
+	length := stored.EndTime.Sub(stored.StartTime)
+	stored.StartTime, stored.EndTime = start, start.Add(length)
+}
+
+func TestCreateVolunteerShift_Validates(t *testing.T) {
+	ctx := context.Background()
+	f := newVolunteerFixture()
+	event := f.event(t, 0, entity.NO_RESTRICTION)
+	event.MasjidId = "m1"
+	f.repo.events[event.ID] = *event
+
+	shift := f.shift(t, event, "  Parking ", event.StartTime.Add(-time.Hour), 3, 4)
+	assert.Equal(t, "Parking", shift.Role)
+	assert.Equal(t, "m1", shift.MasjidId)
+	assert.Equal(t, int32(180), shift.Minutes())
+
+	for name, bad := range map[string]entity.VolunteerShift{
+		"no role":      {Role: " ", StartTime: event.StartTime, EndTime: event.EndTime, Headcount: 1},
+		"ends first":   {Role: "Food", StartTime: event.EndTime, EndTime: event.StartTime, Headcount: 1},
+		"too long":     {Role: "Food", StartTime: event.StartTime, EndTime: event.StartTime.Add(25 * time.Hour), Headcount: 1},
+		"no headcount": {Role: "Food", StartTime: event.StartTime, EndTime: event.EndTime},
+	} {
+		bad.EventId = event.ID
+		_, err := f.volunteers.CreateShift(ctx, &bad)
+		assert.ErrorIs(t, err, helper.ErrInvalidShift, name)
+	}
+
+	series := f.event(t, 0, entity.NO_RESTRICTION)
+	series.Recurrence = "RRULE:FREQ=WEEKLY"
+	f.repo.events[series.ID] = *series
+	_, err := f.volunteers.CreateShift(ctx, &entity.VolunteerShift{EventId: series.ID, Role: "Food", StartTime: series.StartTime, EndTime: series.EndTime, Headcount: 1})
+	assert.ErrorIs(t, err, helper.ErrShiftOnRecurringEvent)
+	_, err = f.volunteers.CreateShift(ctx, &entity.VolunteerShift{EventId: uuid.New(), Role: "Food", StartTime: series.StartTime, EndTime: series.EndTime, Headcount: 1})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestClaimShift_FillsHeadcountAndRejectsOverlaps(t *testing.T) {
+	ctx := context.Background()
+	f := newVolunteerFixture()
+	event := f.event(t, 0, entity.NO_RESTRICTION)
+	parking := f.shift(t, event, "Parking", event.StartTime, 2, 2)
+	security := f.shift(t, event, "Security", event.StartTime.Add(time.Hour), 2, 5)
+	cleanup := f.shift(t, event, "Cleanup", event.StartTime.Add(2*time.Hour), 1, 5)
+	amina, bilal, yusuf := f.user(entity.Female, "Amina"), f.user(entity.Male, "Bilal"), f.user(entity.Male, "Yusuf")
+
+	first, err := f.volunteers.ClaimShift(ctx, parking.ID.String(), amina)
+	require.NoError(t, err)
+	again, err := f.volunteers.ClaimShift(ctx, parking.ID.String(), amina)
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, again.ID)
+	_, err = f.volunteers.ClaimShift(ctx, parking.ID.String(), bilal)
+	require.NoError(t, err)
+	_, err = f.volunteers.ClaimShift(ctx, parking.ID.String(), yusuf)
+	assert.ErrorIs(t, err, helper.ErrShiftFull)
+
+	_, err = f.volunteers.ClaimShift(ctx, security.ID.String(), amina)
+	assert.ErrorIs(t, err, helper.ErrShiftOverlap)
+	_, err = f.volunteers.ClaimShift(ctx, cleanup.ID.String(), amina)
+	assert.NoError(t, err, "shifts that only touch do not overlap")
+
+	_, err = f.volunteers.UpdateShift(ctx, &entity.VolunteerShift{ID: parking.ID, Role: "Parking", StartTime: parking.StartTime, EndTime: parking.EndTime, Headcount: 1})
+	assert.ErrorIs(t, err, helper.ErrShiftHeadcountTooLow)
+
+	released, err := f.volunteers.ReleaseShift(ctx, parking.ID.String(), bilal, false)
+	require.NoError(t, err)
+	assert.Equal(t, entity.ShiftReleased, released.Status)
+	_, err = f.volunteers.ClaimShift(ctx, parking.ID.String(), yusuf)
+	require.NoError(t, err)
+
+	roster, err := f.volunteers.ListShifts(ctx, event.ID.String(), true)
+	require.NoError(t, err)
+	require.Len(t, roster, 3)
+	assert.Equal(t, "Parking", roster[0].Role)
+	assert.Equal(t, int64(0), roster[0].PlacesLeft())
+	var names []string
+	for _, signup := range roster[0].Signups {
+		names = append(names, signup.User.FirstName)
+	}
+	assert.Equal(t, []string{"Amina", "Yusuf"}, names)
+
+	mine, err := f.volunteers.ListMyShifts(ctx, amina, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, mine, 2)
+	assert.Equal(t, "Parking", mine[0].Shift.Role)
+	assert.Equal(t, "Cleanup", mine[1].Shift.Role)
+}
+
+func TestClaimShift_ConcurrentClaimsNeverOverfill(t *testing.T) {
+	ctx := context.Background()
+	f := newVolunteerFixture()
+	event := f.event(t, 0, entity.NO_RESTRICTION)
+	shift := f.shift(t, event, "Iftar serving", event.StartTime, 2, 5)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		userID := f.user(entity.Male, "Volunteer")
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := f.volunteers.ClaimShift(ctx, shift.ID.String(), userID)
+			if err != nil {
+				assert.ErrorIs(t, err, helper.ErrShiftFull)
+			}
+		}()
+	}
+	wg.Wait()
+
+	got, err := f.volunteers.GetShift(ctx, shift.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), got.Claimed)
+}
+
+func TestRecordHours_TotalsHoursByYear(t *testing.T) {
+	ctx := context.Background()
+	f := newVolunteerFixture()
+	masjidID := f.volunteers.MasjidRepo.(*eventMasjidRepo).masjid.ID.String()
+	event := f.event(t, 0, entity.NO_RESTRICTION)
+	event.MasjidId = masjidID
+	f.repo.events[event.ID] = *event
+	coordinator := uuid.New().String()
+	amina, bilal := f.user(entity.Female, "Amina"), f.user(entity.Male, "Bilal")
+
+	setup := f.shift(t, event, "Setup", event.StartTime.Add(-2*time.Hour), 2, 3)
+	eid := f.shift(t, event, "Eid parking", event.StartTime, 4, 3)
+	for _, shift := range []*entity.VolunteerShift{setup, eid} {
+		for _, user := range []string{amina, bilal} {
+			_, err := f.volunteers.ClaimShift(ctx, shift.ID.String(), user)
+			require.NoError(t, err)
+		}
+	}
+
+	_, err := f.volunteers.RecordHours(ctx, setup.ID.String(), amina, 0, false, coordinator)
+	assert.ErrorIs(t, err, helper.ErrShiftNotStarted)
+
+	// Setup started late on New Year's Eve in Toronto, which is already
+	// 2026 in UTC.
+	f.backdate(setup, time.Date(2026, time.January, 1, 3, 0, 0, 0, time.UTC))
+	f.backdate(eid, time.Date(2025, time.June, 6, 12, 0, 0, 0, time.UTC))
+
+	_, err = f.volunteers.ReleaseShift(ctx, eid.ID.String(), bilal, false)
+	assert.ErrorIs(t, err, helper.ErrShiftStarted)
+	_, err = f.volunteers.ClaimShift(ctx, eid.ID.String(), f.user(entity.Male, "Yusuf"))
+	assert.ErrorIs(t, err, helper.ErrShiftStarted)
+	_, err = f.volunteers.RecordHours(ctx, setup.ID.String(), amina, -5, false, coordinator)
+	assert.ErrorIs(t, err, helper.ErrInvalidShift)
+
+	worked, err := f.volunteers.RecordHours(ctx, setup.ID.String(), amina, 0, false, coordinator)
+	require.NoError(t, err)
+	assert.Equal(t, entity.ShiftWorked, worked.Status)
+	assert.Equal(t, int32(120), worked.MinutesWorked, "the whole shift by default")
+	assert.Equal(t, coordinator, worked.RecordedBy.String())
+	_, err = f.volunteers.RecordHours(ctx, setup.ID.String(), bilal, 90, false, coordinator)
+	require.NoError(t, err)
+	_, err = f.volunteers.RecordHours(ctx, eid.ID.String(), amina, 0, false, coordinator)
+	require.NoError(t, err)
+	noShow, err := f.volunteers.RecordHours(ctx, eid.ID.String(), bilal, 240, true, coordinator)
+	require.NoError(t, err)
+	assert.Equal(t, entity.ShiftNoShow, noShow.Status)
+	assert.Zero(t, noShow.MinutesWorked)
+
+	hours, year, err := f.volunteers.GetVolunteerHours(ctx, masjidID, "", 2025)
+	require.NoError(t, err)
+	assert.Equal(t, 2025, year)
+	require.Len(t, hours, 2)
+	assert.Equal(t, "Amina", hours[0].User.FirstName)
+	assert.Equal(t, int64(360), hours[0].MinutesWorked)
+	assert.Equal(t, int64(2), hours[0].ShiftsWorked)
+	assert.Equal(t, "Bilal", hours[1].User.FirstName)
+	assert.Equal(t, int64(90), hours[1].MinutesWorked)
+
+	// Without a masjid, a volunteer's own hours go by the UTC year.
+	own, _, err := f.volunteers.GetVolunteerHours(ctx, "", amina, 2025)
+	require.NoError(t, err)
+	require.Len(t, own, 1)
+	assert.Equal(t, int64(240), own[0].MinutesWorked)
+
+	assert.ErrorIs(t, f.volunteers.DeleteShift(ctx, setup.ID.String()), helper.ErrShiftHasHours)
+	_, err = f.volunteers.ReleaseShift(ctx, eid.ID.String(), bilal, true)
+	assert.NoError(t, err, "coordinators may release places after the shift starts")
+}
+
+// createShifts stores an event with a shift for each start, each lasting
+// two hours and needing headcount volunteers. They are deleted with their
+// signups when the test ends.
+func (suite *DatabaseGrpcHandlerTestSuite) createShifts(headcount int32, starts ...time.Time) []*entity.VolunteerShift {
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	event, err := suite.EventService.Create(context.Background(), &entity.Event{
+		MasjidId:  uuid.New().String(),
+		Name:      "Eid prayer",
+		StartTime: start,
+		EndTime:   start.Add(4 * time.Hour),
+	})
+	require.NoError(suite.T(), err)
+	suite.T().Cleanup(func() {
+		suite.DB.Where("shift_id IN (?)", suite.DB.Model(&entity.VolunteerShift{}).Select("id").Where("event_id = ?", event.ID)).
+			Delete(&entity.ShiftSignup{})
+		suite.DB.Delete(&entity.VolunteerShift{}, "event_id = ?", event.ID)
+		suite.DB.Delete(&entity.Event{}, "id = ?", event.ID)
+	})
+
+	var shifts []*entity.VolunteerShift
+	for i, at := range starts {
+		shift, err := suite.VolunteerService.CreateShift(context.Background(), &entity.VolunteerShift{
+			EventId:   event.ID,
+			Role:      fmt.Sprintf("Parking %d", i+1),
+			StartTime: at,
+			EndTime:   at.Add(2 * time.Hour),
+			Headcount: headcount,
+		})
+		require.NoError(suite.T(), err)
+		shifts = append(shifts, shift)
+	}
+	return shifts
+}
+
+// claimConcurrently claims shifts[i] in ctxs[i] for each i at once,
+// returning the error of each claim.
+func (suite *DatabaseGrpcHandlerTestSuite) claimConcurrently(ctxs []context.Context, shifts []*entity.VolunteerShift) []error {
+	errs := make([]error, len(ctxs))
+	var wg sync.WaitGroup
+	for i := range ctxs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = suite.VolunteerHandler.ClaimShift(ctxs[i], &pb.ClaimShiftRequest{ShiftId: shifts[i].ID.String()})
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) countClaimed(shift *entity.VolunteerShift) int64 {
+	var claimed int64
+	err := suite.DB.Model(&entity.ShiftSignup{}).Where("shift_id = ? AND status = ?", shift.ID, entity.ShiftClaimed).Count(&claimed).Error
+	require.NoError(suite.T(), err)
+	return claimed
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) TestClaimShift_ConcurrentClaimsKeepToHeadcount() {
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+	shift := suite.createShifts(3, start)[0]
+	const volunteers = 10
+	ctxs := make([]context.Context, volunteers)
+	shifts := make([]*entity.VolunteerShift, volunteers)
+	for i := range ctxs {
+		_, ctxs[i] = suite.createUser(fmt.Sprintf("volunteer%d", i), entity.MASJID_VOLUNTEER)
+		shifts[i] = shift
+	}
+
+	errs := suite.claimConcurrently(ctxs, shifts)
+	var claimed, full []int
+	for i, err := range errs {
+		if err == nil {
+			claimed = append(claimed, i)
+			continue
+		}
+		st, ok := status.FromError(err)
+		require.True(suite.T(), ok)
+		require.Equal(suite.T(), codes.ResourceExhausted, st.Code(), "%v", err)
+		full = append(full, i)
+	}
+	assert.Len(suite.T(), claimed, 3, "no more volunteers claim the shift than it needs")
+	assert.Len(suite.T(), full, 7)
+	assert.Equal(suite.T(), int64(3), suite.countClaimed(shift))
+
+	// A place given up can be claimed by a volunteer turned away.
+	_, err := suite.VolunteerHandler.ReleaseShift(ctxs[claimed[0]], &pb.ReleaseShiftRequest{ShiftId: shift.ID.String()})
+	require.NoError(suite.T(), err)
+	_, err = suite.VolunteerHandler.ClaimShift(ctxs[full[0]], &pb.ClaimShiftRequest{ShiftId: shift.ID.String()})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), suite.countClaimed(shift))
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) TestClaimShift_ConcurrentClaimsBySameVolunteer() {
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+	shift := suite.createShifts(3, start)[0]
+	user, ctx := suite.createUser("volunteer", entity.MASJID_VOLUNTEER)
+
+	ctxs := []context.Context{ctx, ctx, ctx, ctx, ctx}
+	shifts := []*entity.VolunteerShift{shift, shift, shift, shift, shift}
+	for _, err := range suite.claimConcurrently(ctxs, shifts) {
+		require.NoError(suite.T(), err)
+	}
+
+	var signups []entity.ShiftSignup
+	require.NoError(suite.T(), suite.DB.Where("shift_id = ? AND user_id = ?", shift.ID, user.ID).Find(&signups).Error)
+	require.Len(suite.T(), signups, 1, "a volunteer has one signup however often they claim")
+	assert.Equal(suite.T(), entity.ShiftClaimed, signups[0].Status)
+}
+
+func (suite *DatabaseGrpcHandlerTestSuite) TestClaimShift_ConcurrentClaimsOfOverlappingShifts() {
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+	shifts := suite.createShifts(3, start, start.Add(time.Hour), start.Add(90*time.Minute))
+	_, ctx := suite.createUser("volunteer", entity.MASJID_VOLUNTEER)
+
+	errs := suite.claimConcurrently([]context.Context{ctx, ctx, ctx}, shifts)
+	claimed := 0
+	for _, err := range errs {
+		if err == nil {
+			claimed++
+			continue
+		}
+		st, ok := status.FromError(err)
+		require.True(suite.T(), ok)
+		assert.Equal(suite.T(), codes.FailedPrecondition, st.Code(), "%v", err)
+	}
+	assert.Equal(suite.T(), 1, claimed, "a volunteer holds a place on one of the overlapping shifts")
+	var total int64
+	for _, shift := range shifts {
+		total += suite.countClaimed(shift)
+	}
+	assert.Equal(suite.T(), int64(1), total)
+}